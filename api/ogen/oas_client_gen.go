@@ -31,8 +31,11 @@ func trimTrailingSlashes(u *url.URL) {
 type Invoker interface {
 	AuthInvoker
 	LobbiesInvoker
+	MapsInvoker
 	MultiplayerInvoker
+	PanoramaInvoker
 	SingleplayerInvoker
+	StreakInvoker
 	UsersInvoker
 	// GetHealth invokes getHealth operation.
 	//
@@ -156,7 +159,8 @@ type AuthInvoker interface {
 type LobbiesInvoker interface {
 	// GetLobbies invokes getLobbies operation.
 	//
-	// Get all available lobbies.
+	// Get available lobbies matching all of the set filters, unlisted and invite-only lobbies are not
+	// listed.
 	//
 	// GET /v1/lobbies
 	GetLobbies(ctx context.Context, params GetLobbiesParams) (GetLobbiesRes, error)
@@ -174,6 +178,48 @@ type LobbiesInvoker interface {
 	NewLobby(ctx context.Context, request *NewLobby) (NewLobbyRes, error)
 }
 
+// MapsInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Maps
+type MapsInvoker interface {
+	// AddMapLocations invokes addMapLocations operation.
+	//
+	// Add existing panorama locations of the map provider to a map created by the authenticated user.
+	//
+	// POST /v1/maps/{id}/locations
+	AddMapLocations(ctx context.Context, request *AddMapLocationsRequest, params AddMapLocationsParams) (AddMapLocationsRes, error)
+	// GetMap invokes getMap operation.
+	//
+	// Get map information by ID. Private maps are available only to their creator.
+	//
+	// GET /v1/maps/{id}
+	GetMap(ctx context.Context, params GetMapParams) (GetMapRes, error)
+	// GetMaps invokes getMaps operation.
+	//
+	// Get public maps, or all maps of the authenticated user if `mine` is set.
+	//
+	// GET /v1/maps
+	GetMaps(ctx context.Context, params GetMapsParams) (GetMapsRes, error)
+	// ImportMapLocations invokes importMapLocations operation.
+	//
+	// Import locations from CSV or GeoJSON to a map created by the authenticated user.
+	//
+	// POST /v1/maps/{id}/import
+	ImportMapLocations(ctx context.Context, request *ImportMapLocationsRequest, params ImportMapLocationsParams) (ImportMapLocationsRes, error)
+	// NewMap invokes newMap operation.
+	//
+	// Create new map, optionally with existing panorama locations of the provider.
+	//
+	// POST /v1/maps
+	NewMap(ctx context.Context, request *NewMapRequest) (NewMapRes, error)
+	// UpdateMap invokes updateMap operation.
+	//
+	// Update name, description and visibility of a map created by the authenticated user.
+	//
+	// PATCH /v1/maps/{id}
+	UpdateMap(ctx context.Context, request *UpdateMapRequest, params UpdateMapParams) (UpdateMapRes, error)
+}
+
 // MultiplayerInvoker invokes operations described by OpenAPI v3 specification.
 //
 // x-gen-operation-group: Multiplayer
@@ -186,7 +232,8 @@ type MultiplayerInvoker interface {
 	GetMultiplayerGame(ctx context.Context, params GetMultiplayerGameParams) (GetMultiplayerGameRes, error)
 	// GetMultiplayerGameGuesses invokes getMultiplayerGameGuesses operation.
 	//
-	// Get multiplayer game user guesses.
+	// Get multiplayer game user guesses of finished rounds.
+	// Spectators get guesses of a round only after the spectator delay of the game.
 	//
 	// GET /v1/multiplayer/{id}/guesses
 	GetMultiplayerGameGuesses(ctx context.Context, params GetMultiplayerGameGuessesParams) (GetMultiplayerGameGuessesRes, error)
@@ -204,10 +251,34 @@ type MultiplayerInvoker interface {
 	NewMultiplayerRound(ctx context.Context, params NewMultiplayerRoundParams) (NewMultiplayerRoundRes, error)
 }
 
+// PanoramaInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Panorama
+type PanoramaInvoker interface {
+	// GetPanorama invokes getPanorama operation.
+	//
+	// Resolve opaque panorama token of the current round to panorama ID (without coordinates).
+	//
+	// GET /v1/panorama/{token}
+	GetPanorama(ctx context.Context, params GetPanoramaParams) (GetPanoramaRes, error)
+	// GetPanoramaProviders invokes getPanoramaProviders operation.
+	//
+	// Get names of panorama providers, that games can be played on.
+	//
+	// GET /v1/panorama/providers
+	GetPanoramaProviders(ctx context.Context) (GetPanoramaProvidersRes, error)
+}
+
 // SingleplayerInvoker invokes operations described by OpenAPI v3 specification.
 //
 // x-gen-operation-group: Singleplayer
 type SingleplayerInvoker interface {
+	// AcceptSingleplayerChallenge invokes acceptSingleplayerChallenge operation.
+	//
+	// Create new singleplayer game with locations and settings of the challenge.
+	//
+	// POST /v1/challenges/{id}/accept
+	AcceptSingleplayerChallenge(ctx context.Context, params AcceptSingleplayerChallengeParams) (AcceptSingleplayerChallengeRes, error)
 	// EndSingleplayerGame invokes endSingleplayerGame operation.
 	//
 	// End singleplayer game.
@@ -220,6 +291,20 @@ type SingleplayerInvoker interface {
 	//
 	// POST /v1/singleplayer/{id}/round/end
 	EndSingleplayerRound(ctx context.Context, request *SingleplayerRoundGuess, params EndSingleplayerRoundParams) (EndSingleplayerRoundRes, error)
+	// GetDailyChallengeLeaderboard invokes getDailyChallengeLeaderboard operation.
+	//
+	// Get finished daily challenge games of a date, ranked by score. Players with equal score share the
+	// same place.
+	//
+	// GET /v1/daily-challenge/leaderboard
+	GetDailyChallengeLeaderboard(ctx context.Context, params GetDailyChallengeLeaderboardParams) (GetDailyChallengeLeaderboardRes, error)
+	// GetSingleplayerChallenge invokes getSingleplayerChallenge operation.
+	//
+	// Get challenge settings and results of its creator and challengers. Guesses of the games are only
+	// included after the user finished their own game of the challenge.
+	//
+	// GET /v1/challenges/{id}
+	GetSingleplayerChallenge(ctx context.Context, params GetSingleplayerChallengeParams) (GetSingleplayerChallengeRes, error)
 	// GetSingleplayerGame invokes getSingleplayerGame operation.
 	//
 	// Get singleplayer game information by ID.
@@ -244,6 +329,14 @@ type SingleplayerInvoker interface {
 	//
 	// GET /v1/singleplayer/{id}/round
 	GetSingleplayerRound(ctx context.Context, params GetSingleplayerRoundParams) (GetSingleplayerRoundRes, error)
+	// NewSingleplayerChallenge invokes newSingleplayerChallenge operation.
+	//
+	// Create a shareable challenge, that lets other players replay locations of a finished game with the
+	// same settings. Returns the existing challenge if it was already created. Daily challenge games
+	// can't be shared.
+	//
+	// POST /v1/singleplayer/{id}/challenge
+	NewSingleplayerChallenge(ctx context.Context, params NewSingleplayerChallengeParams) (NewSingleplayerChallengeRes, error)
 	// NewSingleplayerGame invokes newSingleplayerGame operation.
 	//
 	// Create new singleplayer game.
@@ -258,6 +351,55 @@ type SingleplayerInvoker interface {
 	NewSingleplayerRound(ctx context.Context, params NewSingleplayerRoundParams) (NewSingleplayerRoundRes, error)
 }
 
+// StreakInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Streak
+type StreakInvoker interface {
+	// EndStreakRound invokes endStreakRound operation.
+	//
+	// Guess the country of the current round. The game ends if the country is wrong or the round timer
+	// has ended.
+	//
+	// POST /v1/streak/{id}/round/end
+	EndStreakRound(ctx context.Context, request *StreakRoundGuess, params EndStreakRoundParams) (EndStreakRoundRes, error)
+	// GetBestStreak invokes getBestStreak operation.
+	//
+	// Get the longest streak among finished country streak games of the current user.
+	//
+	// GET /v1/streak/best
+	GetBestStreak(ctx context.Context) (GetBestStreakRes, error)
+	// GetStreakGame invokes getStreakGame operation.
+	//
+	// Get country streak game information by ID.
+	//
+	// GET /v1/streak/{id}
+	GetStreakGame(ctx context.Context, params GetStreakGameParams) (GetStreakGameRes, error)
+	// GetStreakLeaderboard invokes getStreakLeaderboard operation.
+	//
+	// Get best streaks of users, ranked by streak. Players with equal streak share the same place.
+	//
+	// GET /v1/streak/leaderboard
+	GetStreakLeaderboard(ctx context.Context, params GetStreakLeaderboardParams) (GetStreakLeaderboardRes, error)
+	// GetStreakRound invokes getStreakRound operation.
+	//
+	// Get current round of country streak game.
+	//
+	// GET /v1/streak/{id}/round
+	GetStreakRound(ctx context.Context, params GetStreakRoundParams) (GetStreakRoundRes, error)
+	// NewStreakGame invokes newStreakGame operation.
+	//
+	// Create new country streak game, that continues until the first wrongly guessed country.
+	//
+	// POST /v1/streak
+	NewStreakGame(ctx context.Context, request *NewStreakGameRequest) (NewStreakGameRes, error)
+	// NewStreakRound invokes newStreakRound operation.
+	//
+	// Create next round of country streak game, current round is returned if it's not finished.
+	//
+	// POST /v1/streak/{id}/round
+	NewStreakRound(ctx context.Context, params NewStreakRoundParams) (NewStreakRoundRes, error)
+}
+
 // UsersInvoker invokes operations described by OpenAPI v3 specification.
 //
 // x-gen-operation-group: Users
@@ -333,21 +475,21 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 	return u
 }
 
-// DeleteDiscord invokes deleteDiscord operation.
+// AcceptSingleplayerChallenge invokes acceptSingleplayerChallenge operation.
 //
-// Delete login with Discord OAuth.
+// Create new singleplayer game with locations and settings of the challenge.
 //
-// DELETE /v1/auth/discord
-func (c *Client) DeleteDiscord(ctx context.Context) (DeleteDiscordRes, error) {
-	res, err := c.sendDeleteDiscord(ctx)
+// POST /v1/challenges/{id}/accept
+func (c *Client) AcceptSingleplayerChallenge(ctx context.Context, params AcceptSingleplayerChallengeParams) (AcceptSingleplayerChallengeRes, error) {
+	res, err := c.sendAcceptSingleplayerChallenge(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDeleteDiscord(ctx context.Context) (res DeleteDiscordRes, err error) {
+func (c *Client) sendAcceptSingleplayerChallenge(ctx context.Context, params AcceptSingleplayerChallengeParams) (res AcceptSingleplayerChallengeRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteDiscord"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/v1/auth/discord"),
+		otelogen.OperationID("acceptSingleplayerChallenge"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/challenges/{id}/accept"),
 	}
 
 	// Run stopwatch.
@@ -362,7 +504,7 @@ func (c *Client) sendDeleteDiscord(ctx context.Context) (res DeleteDiscordRes, e
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteDiscordOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AcceptSingleplayerChallengeOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -379,12 +521,31 @@ func (c *Client) sendDeleteDiscord(ctx context.Context) (res DeleteDiscordRes, e
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/auth/discord"
+	var pathParts [3]string
+	pathParts[0] = "/v1/challenges/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/accept"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -394,7 +555,7 @@ func (c *Client) sendDeleteDiscord(ctx context.Context) (res DeleteDiscordRes, e
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, DeleteDiscordOperation, r); {
+			switch err := c.securityBearer(ctx, AcceptSingleplayerChallengeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -430,7 +591,7 @@ func (c *Client) sendDeleteDiscord(ctx context.Context) (res DeleteDiscordRes, e
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteDiscordResponse(resp)
+	result, err := decodeAcceptSingleplayerChallengeResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -438,21 +599,21 @@ func (c *Client) sendDeleteDiscord(ctx context.Context) (res DeleteDiscordRes, e
 	return result, nil
 }
 
-// DeleteUserSession invokes deleteUserSession operation.
+// AddMapLocations invokes addMapLocations operation.
 //
-// Delete user session.
+// Add existing panorama locations of the map provider to a map created by the authenticated user.
 //
-// DELETE /v1/auth/sessions/{id}
-func (c *Client) DeleteUserSession(ctx context.Context, params DeleteUserSessionParams) (DeleteUserSessionRes, error) {
-	res, err := c.sendDeleteUserSession(ctx, params)
+// POST /v1/maps/{id}/locations
+func (c *Client) AddMapLocations(ctx context.Context, request *AddMapLocationsRequest, params AddMapLocationsParams) (AddMapLocationsRes, error) {
+	res, err := c.sendAddMapLocations(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendDeleteUserSession(ctx context.Context, params DeleteUserSessionParams) (res DeleteUserSessionRes, err error) {
+func (c *Client) sendAddMapLocations(ctx context.Context, request *AddMapLocationsRequest, params AddMapLocationsParams) (res AddMapLocationsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteUserSession"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/v1/auth/sessions/{id}"),
+		otelogen.OperationID("addMapLocations"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/maps/{id}/locations"),
 	}
 
 	// Run stopwatch.
@@ -467,7 +628,7 @@ func (c *Client) sendDeleteUserSession(ctx context.Context, params DeleteUserSes
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteUserSessionOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AddMapLocationsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -484,8 +645,8 @@ func (c *Client) sendDeleteUserSession(ctx context.Context, params DeleteUserSes
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/v1/auth/sessions/"
+	var pathParts [3]string
+	pathParts[0] = "/v1/maps/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -494,7 +655,7 @@ func (c *Client) sendDeleteUserSession(ctx context.Context, params DeleteUserSes
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ID))
+			return e.EncodeValue(conv.IntToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -504,20 +665,24 @@ func (c *Client) sendDeleteUserSession(ctx context.Context, params DeleteUserSes
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/locations"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeAddMapLocationsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, DeleteUserSessionOperation, r); {
+			switch err := c.securityBearer(ctx, AddMapLocationsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -553,7 +718,7 @@ func (c *Client) sendDeleteUserSession(ctx context.Context, params DeleteUserSes
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteUserSessionResponse(resp)
+	result, err := decodeAddMapLocationsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -561,21 +726,21 @@ func (c *Client) sendDeleteUserSession(ctx context.Context, params DeleteUserSes
 	return result, nil
 }
 
-// DeleteYandex invokes deleteYandex operation.
+// DeleteDiscord invokes deleteDiscord operation.
 //
-// Delete login with Yandex OAuth.
+// Delete login with Discord OAuth.
 //
-// DELETE /v1/auth/yandex
-func (c *Client) DeleteYandex(ctx context.Context) (DeleteYandexRes, error) {
-	res, err := c.sendDeleteYandex(ctx)
+// DELETE /v1/auth/discord
+func (c *Client) DeleteDiscord(ctx context.Context) (DeleteDiscordRes, error) {
+	res, err := c.sendDeleteDiscord(ctx)
 	return res, err
 }
 
-func (c *Client) sendDeleteYandex(ctx context.Context) (res DeleteYandexRes, err error) {
+func (c *Client) sendDeleteDiscord(ctx context.Context) (res DeleteDiscordRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteYandex"),
+		otelogen.OperationID("deleteDiscord"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/v1/auth/yandex"),
+		semconv.HTTPRouteKey.String("/v1/auth/discord"),
 	}
 
 	// Run stopwatch.
@@ -590,7 +755,7 @@ func (c *Client) sendDeleteYandex(ctx context.Context) (res DeleteYandexRes, err
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteYandexOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteDiscordOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -608,7 +773,7 @@ func (c *Client) sendDeleteYandex(ctx context.Context) (res DeleteYandexRes, err
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/auth/yandex"
+	pathParts[0] = "/v1/auth/discord"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -622,7 +787,7 @@ func (c *Client) sendDeleteYandex(ctx context.Context) (res DeleteYandexRes, err
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, DeleteYandexOperation, r); {
+			switch err := c.securityBearer(ctx, DeleteDiscordOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -658,7 +823,7 @@ func (c *Client) sendDeleteYandex(ctx context.Context) (res DeleteYandexRes, err
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteYandexResponse(resp)
+	result, err := decodeDeleteDiscordResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -666,21 +831,21 @@ func (c *Client) sendDeleteYandex(ctx context.Context) (res DeleteYandexRes, err
 	return result, nil
 }
 
-// DiscordLogin invokes discordLogin operation.
+// DeleteUserSession invokes deleteUserSession operation.
 //
-// Log in using Discord OAuth.
+// Delete user session.
 //
-// GET /v1/auth/discord/login
-func (c *Client) DiscordLogin(ctx context.Context) (*DiscordLoginTemporaryRedirect, error) {
-	res, err := c.sendDiscordLogin(ctx)
+// DELETE /v1/auth/sessions/{id}
+func (c *Client) DeleteUserSession(ctx context.Context, params DeleteUserSessionParams) (DeleteUserSessionRes, error) {
+	res, err := c.sendDeleteUserSession(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDiscordLogin(ctx context.Context) (res *DiscordLoginTemporaryRedirect, err error) {
+func (c *Client) sendDeleteUserSession(ctx context.Context, params DeleteUserSessionParams) (res DeleteUserSessionRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("discordLogin"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/auth/discord/login"),
+		otelogen.OperationID("deleteUserSession"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/v1/auth/sessions/{id}"),
 	}
 
 	// Run stopwatch.
@@ -695,7 +860,7 @@ func (c *Client) sendDiscordLogin(ctx context.Context) (res *DiscordLoginTempora
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DiscordLoginOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteUserSessionOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -712,16 +877,67 @@ func (c *Client) sendDiscordLogin(ctx context.Context) (res *DiscordLoginTempora
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/auth/discord/login"
+	var pathParts [2]string
+	pathParts[0] = "/v1/auth/sessions/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Bearer"
+			switch err := c.securityBearer(ctx, DeleteUserSessionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearer\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -730,7 +946,7 @@ func (c *Client) sendDiscordLogin(ctx context.Context) (res *DiscordLoginTempora
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDiscordLoginResponse(resp)
+	result, err := decodeDeleteUserSessionResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -738,21 +954,21 @@ func (c *Client) sendDiscordLogin(ctx context.Context) (res *DiscordLoginTempora
 	return result, nil
 }
 
-// DiscordLoginCallback invokes discordLoginCallback operation.
+// DeleteYandex invokes deleteYandex operation.
 //
-// A callback for Discord OAuth login.
+// Delete login with Yandex OAuth.
 //
-// GET /v1/auth/discord/login/callback
-func (c *Client) DiscordLoginCallback(ctx context.Context, params DiscordLoginCallbackParams) (DiscordLoginCallbackRes, error) {
-	res, err := c.sendDiscordLoginCallback(ctx, params)
+// DELETE /v1/auth/yandex
+func (c *Client) DeleteYandex(ctx context.Context) (DeleteYandexRes, error) {
+	res, err := c.sendDeleteYandex(ctx)
 	return res, err
 }
 
-func (c *Client) sendDiscordLoginCallback(ctx context.Context, params DiscordLoginCallbackParams) (res DiscordLoginCallbackRes, err error) {
+func (c *Client) sendDeleteYandex(ctx context.Context) (res DeleteYandexRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("discordLoginCallback"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/auth/discord/login/callback"),
+		otelogen.OperationID("deleteYandex"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/v1/auth/yandex"),
 	}
 
 	// Run stopwatch.
@@ -767,7 +983,7 @@ func (c *Client) sendDiscordLoginCallback(ctx context.Context, params DiscordLog
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DiscordLoginCallbackOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteYandexOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -785,20 +1001,197 @@ func (c *Client) sendDiscordLoginCallback(ctx context.Context, params DiscordLog
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/auth/discord/login/callback"
+	pathParts[0] = "/v1/auth/yandex"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
 	{
-		// Encode "code" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "code",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Bearer"
+			switch err := c.securityBearer(ctx, DeleteYandexOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearer\"")
+			}
 		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeleteYandexResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DiscordLogin invokes discordLogin operation.
+//
+// Log in using Discord OAuth.
+//
+// GET /v1/auth/discord/login
+func (c *Client) DiscordLogin(ctx context.Context) (*DiscordLoginTemporaryRedirect, error) {
+	res, err := c.sendDiscordLogin(ctx)
+	return res, err
+}
+
+func (c *Client) sendDiscordLogin(ctx context.Context) (res *DiscordLoginTemporaryRedirect, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("discordLogin"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/auth/discord/login"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DiscordLoginOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/auth/discord/login"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDiscordLoginResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DiscordLoginCallback invokes discordLoginCallback operation.
+//
+// A callback for Discord OAuth login.
+//
+// GET /v1/auth/discord/login/callback
+func (c *Client) DiscordLoginCallback(ctx context.Context, params DiscordLoginCallbackParams) (DiscordLoginCallbackRes, error) {
+	res, err := c.sendDiscordLoginCallback(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDiscordLoginCallback(ctx context.Context, params DiscordLoginCallbackParams) (res DiscordLoginCallbackRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("discordLoginCallback"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/auth/discord/login/callback"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DiscordLoginCallbackOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/auth/discord/login/callback"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "code" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "code",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
 			return e.EncodeValue(conv.StringToString(params.Code))
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
@@ -1107,21 +1500,22 @@ func (c *Client) sendEndSingleplayerRound(ctx context.Context, request *Singlepl
 	return result, nil
 }
 
-// GetHealth invokes getHealth operation.
+// EndStreakRound invokes endStreakRound operation.
 //
-// Check API health status.
+// Guess the country of the current round. The game ends if the country is wrong or the round timer
+// has ended.
 //
-// GET /health
-func (c *Client) GetHealth(ctx context.Context) (*GetHealthOK, error) {
-	res, err := c.sendGetHealth(ctx)
+// POST /v1/streak/{id}/round/end
+func (c *Client) EndStreakRound(ctx context.Context, request *StreakRoundGuess, params EndStreakRoundParams) (EndStreakRoundRes, error) {
+	res, err := c.sendEndStreakRound(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGetHealth(ctx context.Context) (res *GetHealthOK, err error) {
+func (c *Client) sendEndStreakRound(ctx context.Context, request *StreakRoundGuess, params EndStreakRoundParams) (res EndStreakRoundRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getHealth"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/health"),
+		otelogen.OperationID("endStreakRound"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/streak/{id}/round/end"),
 	}
 
 	// Run stopwatch.
@@ -1136,7 +1530,7 @@ func (c *Client) sendGetHealth(ctx context.Context) (res *GetHealthOK, err error
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetHealthOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, EndStreakRoundOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1153,15 +1547,70 @@ func (c *Client) sendGetHealth(ctx context.Context) (res *GetHealthOK, err error
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/health"
+	var pathParts [3]string
+	pathParts[0] = "/v1/streak/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/round/end"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeEndStreakRoundRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Bearer"
+			switch err := c.securityBearer(ctx, EndStreakRoundOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearer\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -1171,7 +1620,7 @@ func (c *Client) sendGetHealth(ctx context.Context) (res *GetHealthOK, err error
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetHealthResponse(resp)
+	result, err := decodeEndStreakRoundResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1179,21 +1628,21 @@ func (c *Client) sendGetHealth(ctx context.Context) (res *GetHealthOK, err error
 	return result, nil
 }
 
-// GetLobbies invokes getLobbies operation.
+// GetBestStreak invokes getBestStreak operation.
 //
-// Get all available lobbies.
+// Get the longest streak among finished country streak games of the current user.
 //
-// GET /v1/lobbies
-func (c *Client) GetLobbies(ctx context.Context, params GetLobbiesParams) (GetLobbiesRes, error) {
-	res, err := c.sendGetLobbies(ctx, params)
+// GET /v1/streak/best
+func (c *Client) GetBestStreak(ctx context.Context) (GetBestStreakRes, error) {
+	res, err := c.sendGetBestStreak(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetLobbies(ctx context.Context, params GetLobbiesParams) (res GetLobbiesRes, err error) {
+func (c *Client) sendGetBestStreak(ctx context.Context) (res GetBestStreakRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getLobbies"),
+		otelogen.OperationID("getBestStreak"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/lobbies"),
+		semconv.HTTPRouteKey.String("/v1/streak/best"),
 	}
 
 	// Run stopwatch.
@@ -1208,7 +1657,7 @@ func (c *Client) sendGetLobbies(ctx context.Context, params GetLobbiesParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetLobbiesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetBestStreakOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1226,40 +1675,1992 @@ func (c *Client) sendGetLobbies(ctx context.Context, params GetLobbiesParams) (r
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/lobbies"
+	pathParts[0] = "/v1/streak/best"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
 	{
-		// Encode "page" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Bearer"
+			switch err := c.securityBearer(ctx, GetBestStreakOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearer\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetBestStreakResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetDailyChallengeLeaderboard invokes getDailyChallengeLeaderboard operation.
+//
+// Get finished daily challenge games of a date, ranked by score. Players with equal score share the
+// same place.
+//
+// GET /v1/daily-challenge/leaderboard
+func (c *Client) GetDailyChallengeLeaderboard(ctx context.Context, params GetDailyChallengeLeaderboardParams) (GetDailyChallengeLeaderboardRes, error) {
+	res, err := c.sendGetDailyChallengeLeaderboard(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetDailyChallengeLeaderboard(ctx context.Context, params GetDailyChallengeLeaderboardParams) (res GetDailyChallengeLeaderboardRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getDailyChallengeLeaderboard"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/daily-challenge/leaderboard"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetDailyChallengeLeaderboardOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/daily-challenge/leaderboard"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "provider" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "provider",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if unwrapped := string(params.Provider); true {
+				return e.EncodeValue(conv.StringToString(unwrapped))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "date" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "date",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Date.Get(); ok {
+				return e.EncodeValue(conv.DateToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
 			Name:    "page",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.IntToString(params.Page))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.Page))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "page-size" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page-size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.PageSize))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetDailyChallengeLeaderboardResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetHealth invokes getHealth operation.
+//
+// Check API health status.
+//
+// GET /health
+func (c *Client) GetHealth(ctx context.Context) (*GetHealthOK, error) {
+	res, err := c.sendGetHealth(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetHealth(ctx context.Context) (res *GetHealthOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getHealth"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/health"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetHealthOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/health"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetHealthResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetLobbies invokes getLobbies operation.
+//
+// Get available lobbies matching all of the set filters, unlisted and invite-only lobbies are not
+// listed.
+//
+// GET /v1/lobbies
+func (c *Client) GetLobbies(ctx context.Context, params GetLobbiesParams) (GetLobbiesRes, error) {
+	res, err := c.sendGetLobbies(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetLobbies(ctx context.Context, params GetLobbiesParams) (res GetLobbiesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getLobbies"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/lobbies"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetLobbiesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/lobbies"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "provider" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "provider",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Provider.Get(); ok {
+				if unwrapped := string(val); true {
+					return e.EncodeValue(conv.StringToString(unwrapped))
+				}
+				return nil
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "rounds-min" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "rounds-min",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.RoundsMin.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "rounds-max" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "rounds-max",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.RoundsMax.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "timer-seconds" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "timer-seconds",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.TimerSeconds.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "movement-allowed" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "movement-allowed",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MovementAllowed.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "free-slots" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "free-slots",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.FreeSlots.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "creator" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "creator",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Creator.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.Page))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "page-size" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page-size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.PageSize))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Bearer"
+			switch err := c.securityBearer(ctx, GetLobbiesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearer\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetLobbiesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetLobby invokes getLobby operation.
+//
+// Get lobby information by ID.
+//
+// GET /v1/lobbies/{id}
+func (c *Client) GetLobby(ctx context.Context, params GetLobbyParams) (GetLobbyRes, error) {
+	res, err := c.sendGetLobby(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetLobby(ctx context.Context, params GetLobbyParams) (res GetLobbyRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getLobby"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/lobbies/{id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetLobbyOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/lobbies/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Bearer"
+			switch err := c.securityBearer(ctx, GetLobbyOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearer\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetLobbyResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetMap invokes getMap operation.
+//
+// Get map information by ID. Private maps are available only to their creator.
+//
+// GET /v1/maps/{id}
+func (c *Client) GetMap(ctx context.Context, params GetMapParams) (GetMapRes, error) {
+	res, err := c.sendGetMap(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetMap(ctx context.Context, params GetMapParams) (res GetMapRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getMap"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/maps/{id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetMapOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/maps/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Bearer"
+			switch err := c.securityBearer(ctx, GetMapOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearer\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetMapResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetMaps invokes getMaps operation.
+//
+// Get public maps, or all maps of the authenticated user if `mine` is set.
+//
+// GET /v1/maps
+func (c *Client) GetMaps(ctx context.Context, params GetMapsParams) (GetMapsRes, error) {
+	res, err := c.sendGetMaps(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetMaps(ctx context.Context, params GetMapsParams) (res GetMapsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getMaps"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/maps"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetMapsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/maps"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "mine" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "mine",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Mine.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.Page))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "page-size" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page-size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.PageSize))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Bearer"
+			switch err := c.securityBearer(ctx, GetMapsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearer\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetMapsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetMultiplayerGame invokes getMultiplayerGame operation.
+//
+// Get multiplayer game information by ID.
+//
+// GET /v1/multiplayer/{id}
+func (c *Client) GetMultiplayerGame(ctx context.Context, params GetMultiplayerGameParams) (GetMultiplayerGameRes, error) {
+	res, err := c.sendGetMultiplayerGame(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetMultiplayerGame(ctx context.Context, params GetMultiplayerGameParams) (res GetMultiplayerGameRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getMultiplayerGame"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/multiplayer/{id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetMultiplayerGameOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/multiplayer/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Bearer"
+			switch err := c.securityBearer(ctx, GetMultiplayerGameOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearer\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetMultiplayerGameResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetMultiplayerGameGuesses invokes getMultiplayerGameGuesses operation.
+//
+// Get multiplayer game user guesses of finished rounds.
+// Spectators get guesses of a round only after the spectator delay of the game.
+//
+// GET /v1/multiplayer/{id}/guesses
+func (c *Client) GetMultiplayerGameGuesses(ctx context.Context, params GetMultiplayerGameGuessesParams) (GetMultiplayerGameGuessesRes, error) {
+	res, err := c.sendGetMultiplayerGameGuesses(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetMultiplayerGameGuesses(ctx context.Context, params GetMultiplayerGameGuessesParams) (res GetMultiplayerGameGuessesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getMultiplayerGameGuesses"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/multiplayer/{id}/guesses"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetMultiplayerGameGuessesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1/multiplayer/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/guesses"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Bearer"
+			switch err := c.securityBearer(ctx, GetMultiplayerGameGuessesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearer\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetMultiplayerGameGuessesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetMultiplayerRound invokes getMultiplayerRound operation.
+//
+// Get multiplayer game round.
+//
+// GET /v1/multiplayer/{id}/round
+func (c *Client) GetMultiplayerRound(ctx context.Context, params GetMultiplayerRoundParams) (GetMultiplayerRoundRes, error) {
+	res, err := c.sendGetMultiplayerRound(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetMultiplayerRound(ctx context.Context, params GetMultiplayerRoundParams) (res GetMultiplayerRoundRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getMultiplayerRound"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/multiplayer/{id}/round"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetMultiplayerRoundOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1/multiplayer/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/round"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Bearer"
+			switch err := c.securityBearer(ctx, GetMultiplayerRoundOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearer\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetMultiplayerRoundResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetOAuthProviders invokes getOAuthProviders operation.
+//
+// Get all connected OAuth providers.
+//
+// GET /v1/auth/providers
+func (c *Client) GetOAuthProviders(ctx context.Context) (GetOAuthProvidersRes, error) {
+	res, err := c.sendGetOAuthProviders(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetOAuthProviders(ctx context.Context) (res GetOAuthProvidersRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOAuthProviders"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/auth/providers"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetOAuthProvidersOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/auth/providers"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Bearer"
+			switch err := c.securityBearer(ctx, GetOAuthProvidersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearer\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetOAuthProvidersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetPanorama invokes getPanorama operation.
+//
+// Resolve opaque panorama token of the current round to panorama ID (without coordinates).
+//
+// GET /v1/panorama/{token}
+func (c *Client) GetPanorama(ctx context.Context, params GetPanoramaParams) (GetPanoramaRes, error) {
+	res, err := c.sendGetPanorama(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetPanorama(ctx context.Context, params GetPanoramaParams) (res GetPanoramaRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getPanorama"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/panorama/{token}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPanoramaOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/panorama/"
+	{
+		// Encode "token" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "token",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Token))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Bearer"
+			switch err := c.securityBearer(ctx, GetPanoramaOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearer\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetPanoramaResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetPanoramaProviders invokes getPanoramaProviders operation.
+//
+// Get names of panorama providers, that games can be played on.
+//
+// GET /v1/panorama/providers
+func (c *Client) GetPanoramaProviders(ctx context.Context) (GetPanoramaProvidersRes, error) {
+	res, err := c.sendGetPanoramaProviders(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetPanoramaProviders(ctx context.Context) (res GetPanoramaProvidersRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getPanoramaProviders"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/panorama/providers"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPanoramaProvidersOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/panorama/providers"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetPanoramaProvidersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetPrivateProfile invokes getPrivateProfile operation.
+//
+// Retrieve authenticated user profile details.
+//
+// GET /v1/users/me
+func (c *Client) GetPrivateProfile(ctx context.Context) (GetPrivateProfileRes, error) {
+	res, err := c.sendGetPrivateProfile(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetPrivateProfile(ctx context.Context) (res GetPrivateProfileRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getPrivateProfile"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/users/me"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPrivateProfileOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/users/me"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Bearer"
+			switch err := c.securityBearer(ctx, GetPrivateProfileOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearer\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetPrivateProfileResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetPublicProfile invokes getPublicProfile operation.
+//
+// Retrieve public user profile by ID.
+//
+// GET /v1/users/{id}
+func (c *Client) GetPublicProfile(ctx context.Context, params GetPublicProfileParams) (GetPublicProfileRes, error) {
+	res, err := c.sendGetPublicProfile(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetPublicProfile(ctx context.Context, params GetPublicProfileParams) (res GetPublicProfileRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getPublicProfile"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/users/{id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPublicProfileOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/users/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
 	}
+
 	{
-		// Encode "page-size" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "page-size",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Bearer"
+			switch err := c.securityBearer(ctx, GetPublicProfileOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearer\"")
+			}
 		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.IntToString(params.PageSize))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
 		}
 	}
-	u.RawQuery = q.Values().Encode()
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetPublicProfileResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetRoot invokes getRoot operation.
+//
+// Redirect to documentation page.
+//
+// GET /
+func (c *Client) GetRoot(ctx context.Context) (*GetRootFound, error) {
+	res, err := c.sendGetRoot(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetRoot(ctx context.Context) (res *GetRootFound, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getRoot"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetRootOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetRootResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetSingleplayerChallenge invokes getSingleplayerChallenge operation.
+//
+// Get challenge settings and results of its creator and challengers. Guesses of the games are only
+// included after the user finished their own game of the challenge.
+//
+// GET /v1/challenges/{id}
+func (c *Client) GetSingleplayerChallenge(ctx context.Context, params GetSingleplayerChallengeParams) (GetSingleplayerChallengeRes, error) {
+	res, err := c.sendGetSingleplayerChallenge(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetSingleplayerChallenge(ctx context.Context, params GetSingleplayerChallengeParams) (res GetSingleplayerChallengeRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getSingleplayerChallenge"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/challenges/{id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetSingleplayerChallengeOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/challenges/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -1272,7 +3673,7 @@ func (c *Client) sendGetLobbies(ctx context.Context, params GetLobbiesParams) (r
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, GetLobbiesOperation, r); {
+			switch err := c.securityBearer(ctx, GetSingleplayerChallengeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1308,7 +3709,7 @@ func (c *Client) sendGetLobbies(ctx context.Context, params GetLobbiesParams) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetLobbiesResponse(resp)
+	result, err := decodeGetSingleplayerChallengeResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1316,21 +3717,21 @@ func (c *Client) sendGetLobbies(ctx context.Context, params GetLobbiesParams) (r
 	return result, nil
 }
 
-// GetLobby invokes getLobby operation.
+// GetSingleplayerGame invokes getSingleplayerGame operation.
 //
-// Get lobby information by ID.
+// Get singleplayer game information by ID.
 //
-// GET /v1/lobbies/{id}
-func (c *Client) GetLobby(ctx context.Context, params GetLobbyParams) (GetLobbyRes, error) {
-	res, err := c.sendGetLobby(ctx, params)
+// GET /v1/singleplayer/{id}
+func (c *Client) GetSingleplayerGame(ctx context.Context, params GetSingleplayerGameParams) (GetSingleplayerGameRes, error) {
+	res, err := c.sendGetSingleplayerGame(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetLobby(ctx context.Context, params GetLobbyParams) (res GetLobbyRes, err error) {
+func (c *Client) sendGetSingleplayerGame(ctx context.Context, params GetSingleplayerGameParams) (res GetSingleplayerGameRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getLobby"),
+		otelogen.OperationID("getSingleplayerGame"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/lobbies/{id}"),
+		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}"),
 	}
 
 	// Run stopwatch.
@@ -1345,7 +3746,7 @@ func (c *Client) sendGetLobby(ctx context.Context, params GetLobbyParams) (res G
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetLobbyOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetSingleplayerGameOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1363,7 +3764,7 @@ func (c *Client) sendGetLobby(ctx context.Context, params GetLobbyParams) (res G
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/v1/lobbies/"
+	pathParts[0] = "/v1/singleplayer/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -1372,7 +3773,7 @@ func (c *Client) sendGetLobby(ctx context.Context, params GetLobbyParams) (res G
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ID))
+			return e.EncodeValue(conv.IntToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1395,7 +3796,7 @@ func (c *Client) sendGetLobby(ctx context.Context, params GetLobbyParams) (res G
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, GetLobbyOperation, r); {
+			switch err := c.securityBearer(ctx, GetSingleplayerGameOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1431,7 +3832,7 @@ func (c *Client) sendGetLobby(ctx context.Context, params GetLobbyParams) (res G
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetLobbyResponse(resp)
+	result, err := decodeGetSingleplayerGameResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1439,21 +3840,21 @@ func (c *Client) sendGetLobby(ctx context.Context, params GetLobbyParams) (res G
 	return result, nil
 }
 
-// GetMultiplayerGame invokes getMultiplayerGame operation.
+// GetSingleplayerGameRounds invokes getSingleplayerGameRounds operation.
 //
-// Get multiplayer game information by ID.
+// Get singleplayer game rounds.
 //
-// GET /v1/multiplayer/{id}
-func (c *Client) GetMultiplayerGame(ctx context.Context, params GetMultiplayerGameParams) (GetMultiplayerGameRes, error) {
-	res, err := c.sendGetMultiplayerGame(ctx, params)
+// GET /v1/singleplayer/{id}/rounds
+func (c *Client) GetSingleplayerGameRounds(ctx context.Context, params GetSingleplayerGameRoundsParams) (GetSingleplayerGameRoundsRes, error) {
+	res, err := c.sendGetSingleplayerGameRounds(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetMultiplayerGame(ctx context.Context, params GetMultiplayerGameParams) (res GetMultiplayerGameRes, err error) {
+func (c *Client) sendGetSingleplayerGameRounds(ctx context.Context, params GetSingleplayerGameRoundsParams) (res GetSingleplayerGameRoundsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getMultiplayerGame"),
+		otelogen.OperationID("getSingleplayerGameRounds"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/multiplayer/{id}"),
+		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}/rounds"),
 	}
 
 	// Run stopwatch.
@@ -1468,7 +3869,7 @@ func (c *Client) sendGetMultiplayerGame(ctx context.Context, params GetMultiplay
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetMultiplayerGameOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetSingleplayerGameRoundsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1485,8 +3886,8 @@ func (c *Client) sendGetMultiplayerGame(ctx context.Context, params GetMultiplay
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/v1/multiplayer/"
+	var pathParts [3]string
+	pathParts[0] = "/v1/singleplayer/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -1505,6 +3906,7 @@ func (c *Client) sendGetMultiplayerGame(ctx context.Context, params GetMultiplay
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/rounds"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1518,7 +3920,7 @@ func (c *Client) sendGetMultiplayerGame(ctx context.Context, params GetMultiplay
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, GetMultiplayerGameOperation, r); {
+			switch err := c.securityBearer(ctx, GetSingleplayerGameRoundsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1554,7 +3956,7 @@ func (c *Client) sendGetMultiplayerGame(ctx context.Context, params GetMultiplay
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetMultiplayerGameResponse(resp)
+	result, err := decodeGetSingleplayerGameRoundsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1562,21 +3964,21 @@ func (c *Client) sendGetMultiplayerGame(ctx context.Context, params GetMultiplay
 	return result, nil
 }
 
-// GetMultiplayerGameGuesses invokes getMultiplayerGameGuesses operation.
+// GetSingleplayerGames invokes getSingleplayerGames operation.
 //
-// Get multiplayer game user guesses.
+// Get all singleplayer user games.
 //
-// GET /v1/multiplayer/{id}/guesses
-func (c *Client) GetMultiplayerGameGuesses(ctx context.Context, params GetMultiplayerGameGuessesParams) (GetMultiplayerGameGuessesRes, error) {
-	res, err := c.sendGetMultiplayerGameGuesses(ctx, params)
+// GET /v1/singleplayer
+func (c *Client) GetSingleplayerGames(ctx context.Context, params GetSingleplayerGamesParams) (GetSingleplayerGamesRes, error) {
+	res, err := c.sendGetSingleplayerGames(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetMultiplayerGameGuesses(ctx context.Context, params GetMultiplayerGameGuessesParams) (res GetMultiplayerGameGuessesRes, err error) {
+func (c *Client) sendGetSingleplayerGames(ctx context.Context, params GetSingleplayerGamesParams) (res GetSingleplayerGamesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getMultiplayerGameGuesses"),
+		otelogen.OperationID("getSingleplayerGames"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/multiplayer/{id}/guesses"),
+		semconv.HTTPRouteKey.String("/v1/singleplayer"),
 	}
 
 	// Run stopwatch.
@@ -1591,7 +3993,7 @@ func (c *Client) sendGetMultiplayerGameGuesses(ctx context.Context, params GetMu
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetMultiplayerGameGuessesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetSingleplayerGamesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1608,28 +4010,41 @@ func (c *Client) sendGetMultiplayerGameGuesses(ctx context.Context, params GetMu
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/v1/multiplayer/"
+	var pathParts [1]string
+	pathParts[0] = "/v1/singleplayer"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.Page))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[1] = encoded
 	}
-	pathParts[2] = "/guesses"
-	uri.AddPathParts(u, pathParts[:]...)
+	{
+		// Encode "page-size" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page-size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.PageSize))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -1642,7 +4057,7 @@ func (c *Client) sendGetMultiplayerGameGuesses(ctx context.Context, params GetMu
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, GetMultiplayerGameGuessesOperation, r); {
+			switch err := c.securityBearer(ctx, GetSingleplayerGamesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1678,7 +4093,7 @@ func (c *Client) sendGetMultiplayerGameGuesses(ctx context.Context, params GetMu
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetMultiplayerGameGuessesResponse(resp)
+	result, err := decodeGetSingleplayerGamesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1686,21 +4101,21 @@ func (c *Client) sendGetMultiplayerGameGuesses(ctx context.Context, params GetMu
 	return result, nil
 }
 
-// GetMultiplayerRound invokes getMultiplayerRound operation.
+// GetSingleplayerRound invokes getSingleplayerRound operation.
 //
-// Get multiplayer game round.
+// Get singleplayer game round.
 //
-// GET /v1/multiplayer/{id}/round
-func (c *Client) GetMultiplayerRound(ctx context.Context, params GetMultiplayerRoundParams) (GetMultiplayerRoundRes, error) {
-	res, err := c.sendGetMultiplayerRound(ctx, params)
+// GET /v1/singleplayer/{id}/round
+func (c *Client) GetSingleplayerRound(ctx context.Context, params GetSingleplayerRoundParams) (GetSingleplayerRoundRes, error) {
+	res, err := c.sendGetSingleplayerRound(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetMultiplayerRound(ctx context.Context, params GetMultiplayerRoundParams) (res GetMultiplayerRoundRes, err error) {
+func (c *Client) sendGetSingleplayerRound(ctx context.Context, params GetSingleplayerRoundParams) (res GetSingleplayerRoundRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getMultiplayerRound"),
+		otelogen.OperationID("getSingleplayerRound"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/multiplayer/{id}/round"),
+		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}/round"),
 	}
 
 	// Run stopwatch.
@@ -1715,7 +4130,7 @@ func (c *Client) sendGetMultiplayerRound(ctx context.Context, params GetMultipla
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetMultiplayerRoundOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetSingleplayerRoundOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1733,7 +4148,7 @@ func (c *Client) sendGetMultiplayerRound(ctx context.Context, params GetMultipla
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/v1/multiplayer/"
+	pathParts[0] = "/v1/singleplayer/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -1766,7 +4181,7 @@ func (c *Client) sendGetMultiplayerRound(ctx context.Context, params GetMultipla
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, GetMultiplayerRoundOperation, r); {
+			switch err := c.securityBearer(ctx, GetSingleplayerRoundOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1802,7 +4217,7 @@ func (c *Client) sendGetMultiplayerRound(ctx context.Context, params GetMultipla
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetMultiplayerRoundResponse(resp)
+	result, err := decodeGetSingleplayerRoundResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1810,21 +4225,21 @@ func (c *Client) sendGetMultiplayerRound(ctx context.Context, params GetMultipla
 	return result, nil
 }
 
-// GetOAuthProviders invokes getOAuthProviders operation.
+// GetStreakGame invokes getStreakGame operation.
 //
-// Get all connected OAuth providers.
+// Get country streak game information by ID.
 //
-// GET /v1/auth/providers
-func (c *Client) GetOAuthProviders(ctx context.Context) (GetOAuthProvidersRes, error) {
-	res, err := c.sendGetOAuthProviders(ctx)
+// GET /v1/streak/{id}
+func (c *Client) GetStreakGame(ctx context.Context, params GetStreakGameParams) (GetStreakGameRes, error) {
+	res, err := c.sendGetStreakGame(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetOAuthProviders(ctx context.Context) (res GetOAuthProvidersRes, err error) {
+func (c *Client) sendGetStreakGame(ctx context.Context, params GetStreakGameParams) (res GetStreakGameRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getOAuthProviders"),
+		otelogen.OperationID("getStreakGame"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/auth/providers"),
+		semconv.HTTPRouteKey.String("/v1/streak/{id}"),
 	}
 
 	// Run stopwatch.
@@ -1839,7 +4254,7 @@ func (c *Client) sendGetOAuthProviders(ctx context.Context) (res GetOAuthProvide
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetOAuthProvidersOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetStreakGameOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1856,8 +4271,26 @@ func (c *Client) sendGetOAuthProviders(ctx context.Context) (res GetOAuthProvide
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/auth/providers"
+	var pathParts [2]string
+	pathParts[0] = "/v1/streak/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1871,7 +4304,7 @@ func (c *Client) sendGetOAuthProviders(ctx context.Context) (res GetOAuthProvide
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, GetOAuthProvidersOperation, r); {
+			switch err := c.securityBearer(ctx, GetStreakGameOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1907,7 +4340,7 @@ func (c *Client) sendGetOAuthProviders(ctx context.Context) (res GetOAuthProvide
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetOAuthProvidersResponse(resp)
+	result, err := decodeGetStreakGameResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1915,21 +4348,21 @@ func (c *Client) sendGetOAuthProviders(ctx context.Context) (res GetOAuthProvide
 	return result, nil
 }
 
-// GetPrivateProfile invokes getPrivateProfile operation.
+// GetStreakLeaderboard invokes getStreakLeaderboard operation.
 //
-// Retrieve authenticated user profile details.
+// Get best streaks of users, ranked by streak. Players with equal streak share the same place.
 //
-// GET /v1/users/me
-func (c *Client) GetPrivateProfile(ctx context.Context) (GetPrivateProfileRes, error) {
-	res, err := c.sendGetPrivateProfile(ctx)
+// GET /v1/streak/leaderboard
+func (c *Client) GetStreakLeaderboard(ctx context.Context, params GetStreakLeaderboardParams) (GetStreakLeaderboardRes, error) {
+	res, err := c.sendGetStreakLeaderboard(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetPrivateProfile(ctx context.Context) (res GetPrivateProfileRes, err error) {
+func (c *Client) sendGetStreakLeaderboard(ctx context.Context, params GetStreakLeaderboardParams) (res GetStreakLeaderboardRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPrivateProfile"),
+		otelogen.OperationID("getStreakLeaderboard"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/users/me"),
+		semconv.HTTPRouteKey.String("/v1/streak/leaderboard"),
 	}
 
 	// Run stopwatch.
@@ -1944,7 +4377,7 @@ func (c *Client) sendGetPrivateProfile(ctx context.Context) (res GetPrivateProfi
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetPrivateProfileOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetStreakLeaderboardOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1962,47 +4395,46 @@ func (c *Client) sendGetPrivateProfile(ctx context.Context) (res GetPrivateProfi
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/users/me"
+	pathParts[0] = "/v1/streak/leaderboard"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.Page))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, GetPrivateProfileOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"Bearer\"")
-			}
+		// Encode "page-size" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page-size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
 
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.PageSize))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -2012,7 +4444,7 @@ func (c *Client) sendGetPrivateProfile(ctx context.Context) (res GetPrivateProfi
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetPrivateProfileResponse(resp)
+	result, err := decodeGetStreakLeaderboardResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2020,21 +4452,21 @@ func (c *Client) sendGetPrivateProfile(ctx context.Context) (res GetPrivateProfi
 	return result, nil
 }
 
-// GetPublicProfile invokes getPublicProfile operation.
+// GetStreakRound invokes getStreakRound operation.
 //
-// Retrieve public user profile by ID.
+// Get current round of country streak game.
 //
-// GET /v1/users/{id}
-func (c *Client) GetPublicProfile(ctx context.Context, params GetPublicProfileParams) (GetPublicProfileRes, error) {
-	res, err := c.sendGetPublicProfile(ctx, params)
+// GET /v1/streak/{id}/round
+func (c *Client) GetStreakRound(ctx context.Context, params GetStreakRoundParams) (GetStreakRoundRes, error) {
+	res, err := c.sendGetStreakRound(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetPublicProfile(ctx context.Context, params GetPublicProfileParams) (res GetPublicProfileRes, err error) {
+func (c *Client) sendGetStreakRound(ctx context.Context, params GetStreakRoundParams) (res GetStreakRoundRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPublicProfile"),
+		otelogen.OperationID("getStreakRound"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/users/{id}"),
+		semconv.HTTPRouteKey.String("/v1/streak/{id}/round"),
 	}
 
 	// Run stopwatch.
@@ -2049,7 +4481,7 @@ func (c *Client) sendGetPublicProfile(ctx context.Context, params GetPublicProfi
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetPublicProfileOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetStreakRoundOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2066,8 +4498,8 @@ func (c *Client) sendGetPublicProfile(ctx context.Context, params GetPublicProfi
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/v1/users/"
+	var pathParts [3]string
+	pathParts[0] = "/v1/streak/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -2086,6 +4518,7 @@ func (c *Client) sendGetPublicProfile(ctx context.Context, params GetPublicProfi
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/round"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2099,7 +4532,7 @@ func (c *Client) sendGetPublicProfile(ctx context.Context, params GetPublicProfi
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, GetPublicProfileOperation, r); {
+			switch err := c.securityBearer(ctx, GetStreakRoundOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2135,7 +4568,7 @@ func (c *Client) sendGetPublicProfile(ctx context.Context, params GetPublicProfi
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetPublicProfileResponse(resp)
+	result, err := decodeGetStreakRoundResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2143,21 +4576,21 @@ func (c *Client) sendGetPublicProfile(ctx context.Context, params GetPublicProfi
 	return result, nil
 }
 
-// GetRoot invokes getRoot operation.
+// GetUserSessions invokes getUserSessions operation.
 //
-// Redirect to documentation page.
+// Get user sessions associated with the authenticated user.
 //
-// GET /
-func (c *Client) GetRoot(ctx context.Context) (*GetRootFound, error) {
-	res, err := c.sendGetRoot(ctx)
+// GET /v1/auth/sessions
+func (c *Client) GetUserSessions(ctx context.Context) (GetUserSessionsRes, error) {
+	res, err := c.sendGetUserSessions(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetRoot(ctx context.Context) (res *GetRootFound, err error) {
+func (c *Client) sendGetUserSessions(ctx context.Context) (res GetUserSessionsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRoot"),
+		otelogen.OperationID("getUserSessions"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/"),
+		semconv.HTTPRouteKey.String("/v1/auth/sessions"),
 	}
 
 	// Run stopwatch.
@@ -2172,7 +4605,7 @@ func (c *Client) sendGetRoot(ctx context.Context) (res *GetRootFound, err error)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetRootOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetUserSessionsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2190,13 +4623,46 @@ func (c *Client) sendGetRoot(ctx context.Context) (res *GetRootFound, err error)
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/"
+	pathParts[0] = "/v1/auth/sessions"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Bearer"
+			switch err := c.securityBearer(ctx, GetUserSessionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearer\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
 	}
 
 	stage = "SendRequest"
@@ -2207,7 +4673,7 @@ func (c *Client) sendGetRoot(ctx context.Context) (res *GetRootFound, err error)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetRootResponse(resp)
+	result, err := decodeGetUserSessionsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2215,21 +4681,21 @@ func (c *Client) sendGetRoot(ctx context.Context) (res *GetRootFound, err error)
 	return result, nil
 }
 
-// GetSingleplayerGame invokes getSingleplayerGame operation.
+// ImportMapLocations invokes importMapLocations operation.
 //
-// Get singleplayer game information by ID.
+// Import locations from CSV or GeoJSON to a map created by the authenticated user.
 //
-// GET /v1/singleplayer/{id}
-func (c *Client) GetSingleplayerGame(ctx context.Context, params GetSingleplayerGameParams) (GetSingleplayerGameRes, error) {
-	res, err := c.sendGetSingleplayerGame(ctx, params)
+// POST /v1/maps/{id}/import
+func (c *Client) ImportMapLocations(ctx context.Context, request *ImportMapLocationsRequest, params ImportMapLocationsParams) (ImportMapLocationsRes, error) {
+	res, err := c.sendImportMapLocations(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGetSingleplayerGame(ctx context.Context, params GetSingleplayerGameParams) (res GetSingleplayerGameRes, err error) {
+func (c *Client) sendImportMapLocations(ctx context.Context, request *ImportMapLocationsRequest, params ImportMapLocationsParams) (res ImportMapLocationsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getSingleplayerGame"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}"),
+		otelogen.OperationID("importMapLocations"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/maps/{id}/import"),
 	}
 
 	// Run stopwatch.
@@ -2244,7 +4710,7 @@ func (c *Client) sendGetSingleplayerGame(ctx context.Context, params GetSinglepl
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetSingleplayerGameOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ImportMapLocationsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2261,8 +4727,8 @@ func (c *Client) sendGetSingleplayerGame(ctx context.Context, params GetSinglepl
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/v1/singleplayer/"
+	var pathParts [3]string
+	pathParts[0] = "/v1/maps/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -2281,20 +4747,24 @@ func (c *Client) sendGetSingleplayerGame(ctx context.Context, params GetSinglepl
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/import"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeImportMapLocationsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, GetSingleplayerGameOperation, r); {
+			switch err := c.securityBearer(ctx, ImportMapLocationsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2330,7 +4800,7 @@ func (c *Client) sendGetSingleplayerGame(ctx context.Context, params GetSinglepl
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetSingleplayerGameResponse(resp)
+	result, err := decodeImportMapLocationsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2338,21 +4808,21 @@ func (c *Client) sendGetSingleplayerGame(ctx context.Context, params GetSinglepl
 	return result, nil
 }
 
-// GetSingleplayerGameRounds invokes getSingleplayerGameRounds operation.
+// Login invokes login operation.
 //
-// Get singleplayer game rounds.
+// Log in using username and password.
 //
-// GET /v1/singleplayer/{id}/rounds
-func (c *Client) GetSingleplayerGameRounds(ctx context.Context, params GetSingleplayerGameRoundsParams) (GetSingleplayerGameRoundsRes, error) {
-	res, err := c.sendGetSingleplayerGameRounds(ctx, params)
+// POST /v1/auth/login
+func (c *Client) Login(ctx context.Context, request *LoginRequest, params LoginParams) (LoginRes, error) {
+	res, err := c.sendLogin(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGetSingleplayerGameRounds(ctx context.Context, params GetSingleplayerGameRoundsParams) (res GetSingleplayerGameRoundsRes, err error) {
+func (c *Client) sendLogin(ctx context.Context, request *LoginRequest, params LoginParams) (res LoginRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getSingleplayerGameRounds"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}/rounds"),
+		otelogen.OperationID("login"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/auth/login"),
 	}
 
 	// Run stopwatch.
@@ -2367,7 +4837,7 @@ func (c *Client) sendGetSingleplayerGameRounds(ctx context.Context, params GetSi
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetSingleplayerGameRoundsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, LoginOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2384,27 +4854,111 @@ func (c *Client) sendGetSingleplayerGameRounds(ctx context.Context, params GetSi
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/v1/singleplayer/"
+	var pathParts [1]string
+	pathParts[0] = "/v1/auth/login"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeLoginRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
 	{
-		// Encode "id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
-			Style:   uri.PathStyleSimple,
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "User-Agent",
 			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.UserAgent))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
 		}
-		pathParts[1] = encoded
 	}
-	pathParts[2] = "/rounds"
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "X-Captcha-Token",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.XCaptchaToken.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeLoginResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// NewDiscord invokes newDiscord operation.
+//
+// Create Discord OAuth connection.
+//
+// GET /v1/auth/discord/new
+func (c *Client) NewDiscord(ctx context.Context) (NewDiscordRes, error) {
+	res, err := c.sendNewDiscord(ctx)
+	return res, err
+}
+
+func (c *Client) sendNewDiscord(ctx context.Context) (res NewDiscordRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("newDiscord"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/auth/discord/new"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, NewDiscordOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/auth/discord/new"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2418,7 +4972,7 @@ func (c *Client) sendGetSingleplayerGameRounds(ctx context.Context, params GetSi
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, GetSingleplayerGameRoundsOperation, r); {
+			switch err := c.securityBearer(ctx, NewDiscordOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2454,7 +5008,7 @@ func (c *Client) sendGetSingleplayerGameRounds(ctx context.Context, params GetSi
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetSingleplayerGameRoundsResponse(resp)
+	result, err := decodeNewDiscordResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2462,21 +5016,21 @@ func (c *Client) sendGetSingleplayerGameRounds(ctx context.Context, params GetSi
 	return result, nil
 }
 
-// GetSingleplayerGames invokes getSingleplayerGames operation.
+// NewDiscordCallback invokes newDiscordCallback operation.
 //
-// Get all singleplayer user games.
+// A callback for new Discord OAuth.
 //
-// GET /v1/singleplayer
-func (c *Client) GetSingleplayerGames(ctx context.Context, params GetSingleplayerGamesParams) (GetSingleplayerGamesRes, error) {
-	res, err := c.sendGetSingleplayerGames(ctx, params)
+// GET /v1/auth/discord/new/callback
+func (c *Client) NewDiscordCallback(ctx context.Context, params NewDiscordCallbackParams) (NewDiscordCallbackRes, error) {
+	res, err := c.sendNewDiscordCallback(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetSingleplayerGames(ctx context.Context, params GetSingleplayerGamesParams) (res GetSingleplayerGamesRes, err error) {
+func (c *Client) sendNewDiscordCallback(ctx context.Context, params NewDiscordCallbackParams) (res NewDiscordCallbackRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getSingleplayerGames"),
+		otelogen.OperationID("newDiscordCallback"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/singleplayer"),
+		semconv.HTTPRouteKey.String("/v1/auth/discord/new/callback"),
 	}
 
 	// Run stopwatch.
@@ -2491,7 +5045,7 @@ func (c *Client) sendGetSingleplayerGames(ctx context.Context, params GetSinglep
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetSingleplayerGamesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, NewDiscordCallbackOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2509,35 +5063,35 @@ func (c *Client) sendGetSingleplayerGames(ctx context.Context, params GetSinglep
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/singleplayer"
+	pathParts[0] = "/v1/auth/discord/new/callback"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "page" parameter.
+		// Encode "code" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "page",
+			Name:    "code",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.IntToString(params.Page))
+			return e.EncodeValue(conv.StringToString(params.Code))
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
 	{
-		// Encode "page-size" parameter.
+		// Encode "state" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "page-size",
+			Name:    "state",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.IntToString(params.PageSize))
+			return e.EncodeValue(conv.StringToString(params.State))
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
@@ -2550,36 +5104,17 @@ func (c *Client) sendGetSingleplayerGames(ctx context.Context, params GetSinglep
 		return res, errors.Wrap(err, "create request")
 	}
 
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
 	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, GetSingleplayerGamesOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"Bearer\"")
-			}
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Cookie",
+			Explode: false,
 		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Cookie))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
 		}
 	}
 
@@ -2591,7 +5126,7 @@ func (c *Client) sendGetSingleplayerGames(ctx context.Context, params GetSinglep
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetSingleplayerGamesResponse(resp)
+	result, err := decodeNewDiscordCallbackResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2599,21 +5134,21 @@ func (c *Client) sendGetSingleplayerGames(ctx context.Context, params GetSinglep
 	return result, nil
 }
 
-// GetSingleplayerRound invokes getSingleplayerRound operation.
+// NewLobby invokes newLobby operation.
 //
-// Get singleplayer game round.
+// Create new lobby with specified parameters.
 //
-// GET /v1/singleplayer/{id}/round
-func (c *Client) GetSingleplayerRound(ctx context.Context, params GetSingleplayerRoundParams) (GetSingleplayerRoundRes, error) {
-	res, err := c.sendGetSingleplayerRound(ctx, params)
+// POST /v1/lobbies
+func (c *Client) NewLobby(ctx context.Context, request *NewLobby) (NewLobbyRes, error) {
+	res, err := c.sendNewLobby(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetSingleplayerRound(ctx context.Context, params GetSingleplayerRoundParams) (res GetSingleplayerRoundRes, err error) {
+func (c *Client) sendNewLobby(ctx context.Context, request *NewLobby) (res NewLobbyRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getSingleplayerRound"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}/round"),
+		otelogen.OperationID("newLobby"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/lobbies"),
 	}
 
 	// Run stopwatch.
@@ -2628,7 +5163,7 @@ func (c *Client) sendGetSingleplayerRound(ctx context.Context, params GetSinglep
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetSingleplayerRoundOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, NewLobbyOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2645,41 +5180,25 @@ func (c *Client) sendGetSingleplayerRound(ctx context.Context, params GetSinglep
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/v1/singleplayer/"
-	{
-		// Encode "id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/round"
+	var pathParts [1]string
+	pathParts[0] = "/v1/lobbies"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeNewLobbyRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, GetSingleplayerRoundOperation, r); {
+			switch err := c.securityBearer(ctx, NewLobbyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2715,7 +5234,7 @@ func (c *Client) sendGetSingleplayerRound(ctx context.Context, params GetSinglep
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetSingleplayerRoundResponse(resp)
+	result, err := decodeNewLobbyResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2723,21 +5242,21 @@ func (c *Client) sendGetSingleplayerRound(ctx context.Context, params GetSinglep
 	return result, nil
 }
 
-// GetUserSessions invokes getUserSessions operation.
+// NewMap invokes newMap operation.
 //
-// Get user sessions associated with the authenticated user.
+// Create new map, optionally with existing panorama locations of the provider.
 //
-// GET /v1/auth/sessions
-func (c *Client) GetUserSessions(ctx context.Context) (GetUserSessionsRes, error) {
-	res, err := c.sendGetUserSessions(ctx)
+// POST /v1/maps
+func (c *Client) NewMap(ctx context.Context, request *NewMapRequest) (NewMapRes, error) {
+	res, err := c.sendNewMap(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetUserSessions(ctx context.Context) (res GetUserSessionsRes, err error) {
+func (c *Client) sendNewMap(ctx context.Context, request *NewMapRequest) (res NewMapRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUserSessions"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/auth/sessions"),
+		otelogen.OperationID("newMap"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/maps"),
 	}
 
 	// Run stopwatch.
@@ -2752,7 +5271,7 @@ func (c *Client) sendGetUserSessions(ctx context.Context) (res GetUserSessionsRe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetUserSessionsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, NewMapOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2770,21 +5289,24 @@ func (c *Client) sendGetUserSessions(ctx context.Context) (res GetUserSessionsRe
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/auth/sessions"
+	pathParts[0] = "/v1/maps"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeNewMapRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, GetUserSessionsOperation, r); {
+			switch err := c.securityBearer(ctx, NewMapOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2820,7 +5342,7 @@ func (c *Client) sendGetUserSessions(ctx context.Context) (res GetUserSessionsRe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetUserSessionsResponse(resp)
+	result, err := decodeNewMapResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2828,21 +5350,21 @@ func (c *Client) sendGetUserSessions(ctx context.Context) (res GetUserSessionsRe
 	return result, nil
 }
 
-// Login invokes login operation.
+// NewMultiplayerRound invokes newMultiplayerRound operation.
 //
-// Log in using username and password.
+// Get or generate multiplayer game round.
 //
-// POST /v1/auth/login
-func (c *Client) Login(ctx context.Context, request *LoginRequest, params LoginParams) (LoginRes, error) {
-	res, err := c.sendLogin(ctx, request, params)
+// POST /v1/multiplayer/{id}/round
+func (c *Client) NewMultiplayerRound(ctx context.Context, params NewMultiplayerRoundParams) (NewMultiplayerRoundRes, error) {
+	res, err := c.sendNewMultiplayerRound(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendLogin(ctx context.Context, request *LoginRequest, params LoginParams) (res LoginRes, err error) {
+func (c *Client) sendNewMultiplayerRound(ctx context.Context, params NewMultiplayerRoundParams) (res NewMultiplayerRoundRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("login"),
+		otelogen.OperationID("newMultiplayerRound"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v1/auth/login"),
+		semconv.HTTPRouteKey.String("/v1/multiplayer/{id}/round"),
 	}
 
 	// Run stopwatch.
@@ -2857,7 +5379,7 @@ func (c *Client) sendLogin(ctx context.Context, request *LoginRequest, params Lo
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, LoginOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, NewMultiplayerRoundOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2874,115 +5396,31 @@ func (c *Client) sendLogin(ctx context.Context, request *LoginRequest, params Lo
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/auth/login"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-	if err := encodeLoginRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
-
-	stage = "EncodeHeaderParams"
-	h := uri.NewHeaderEncoder(r.Header)
-	{
-		cfg := uri.HeaderParameterEncodingConfig{
-			Name:    "User-Agent",
-			Explode: false,
-		}
-		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.UserAgent))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode header")
-		}
-	}
+	var pathParts [3]string
+	pathParts[0] = "/v1/multiplayer/"
 	{
-		cfg := uri.HeaderParameterEncodingConfig{
-			Name:    "X-Captcha-Token",
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
 			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.XCaptchaToken.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode header")
-		}
-	}
-
-	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
-	if err != nil {
-		return res, errors.Wrap(err, "do request")
-	}
-	defer resp.Body.Close()
-
-	stage = "DecodeResponse"
-	result, err := decodeLoginResponse(resp)
-	if err != nil {
-		return res, errors.Wrap(err, "decode response")
-	}
-
-	return result, nil
-}
-
-// NewDiscord invokes newDiscord operation.
-//
-// Create Discord OAuth connection.
-//
-// GET /v1/auth/discord/new
-func (c *Client) NewDiscord(ctx context.Context) (NewDiscordRes, error) {
-	res, err := c.sendNewDiscord(ctx)
-	return res, err
-}
-
-func (c *Client) sendNewDiscord(ctx context.Context) (res NewDiscordRes, err error) {
-	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("newDiscord"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/auth/discord/new"),
-	}
-
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
-
-	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, NewDiscordOperation,
-		trace.WithAttributes(otelAttrs...),
-		clientSpanKind,
-	)
-	// Track stage for error reporting.
-	var stage string
-	defer func() {
+		encoded, err := e.Result()
 		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			return res, errors.Wrap(err, "encode path")
 		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/auth/discord/new"
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/round"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -2992,7 +5430,7 @@ func (c *Client) sendNewDiscord(ctx context.Context) (res NewDiscordRes, err err
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, NewDiscordOperation, r); {
+			switch err := c.securityBearer(ctx, NewMultiplayerRoundOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3028,7 +5466,7 @@ func (c *Client) sendNewDiscord(ctx context.Context) (res NewDiscordRes, err err
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeNewDiscordResponse(resp)
+	result, err := decodeNewMultiplayerRoundResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3036,21 +5474,23 @@ func (c *Client) sendNewDiscord(ctx context.Context) (res NewDiscordRes, err err
 	return result, nil
 }
 
-// NewDiscordCallback invokes newDiscordCallback operation.
+// NewSingleplayerChallenge invokes newSingleplayerChallenge operation.
 //
-// A callback for new Discord OAuth.
+// Create a shareable challenge, that lets other players replay locations of a finished game with the
+// same settings. Returns the existing challenge if it was already created. Daily challenge games
+// can't be shared.
 //
-// GET /v1/auth/discord/new/callback
-func (c *Client) NewDiscordCallback(ctx context.Context, params NewDiscordCallbackParams) (NewDiscordCallbackRes, error) {
-	res, err := c.sendNewDiscordCallback(ctx, params)
+// POST /v1/singleplayer/{id}/challenge
+func (c *Client) NewSingleplayerChallenge(ctx context.Context, params NewSingleplayerChallengeParams) (NewSingleplayerChallengeRes, error) {
+	res, err := c.sendNewSingleplayerChallenge(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendNewDiscordCallback(ctx context.Context, params NewDiscordCallbackParams) (res NewDiscordCallbackRes, err error) {
+func (c *Client) sendNewSingleplayerChallenge(ctx context.Context, params NewSingleplayerChallengeParams) (res NewSingleplayerChallengeRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("newDiscordCallback"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/auth/discord/new/callback"),
+		otelogen.OperationID("newSingleplayerChallenge"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}/challenge"),
 	}
 
 	// Run stopwatch.
@@ -3065,7 +5505,7 @@ func (c *Client) sendNewDiscordCallback(ctx context.Context, params NewDiscordCa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, NewDiscordCallbackOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, NewSingleplayerChallengeOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3075,66 +5515,72 @@ func (c *Client) sendNewDiscordCallback(ctx context.Context, params NewDiscordCa
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
-		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/auth/discord/new/callback"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "code" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "code",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Code))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "state" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "state",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
+		span.End()
+	}()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.State))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1/singleplayer/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/challenge"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
 
-	stage = "EncodeHeaderParams"
-	h := uri.NewHeaderEncoder(r.Header)
 	{
-		cfg := uri.HeaderParameterEncodingConfig{
-			Name:    "Cookie",
-			Explode: false,
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Bearer"
+			switch err := c.securityBearer(ctx, NewSingleplayerChallengeOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearer\"")
+			}
 		}
-		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Cookie))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode header")
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
 		}
 	}
 
@@ -3146,7 +5592,7 @@ func (c *Client) sendNewDiscordCallback(ctx context.Context, params NewDiscordCa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeNewDiscordCallbackResponse(resp)
+	result, err := decodeNewSingleplayerChallengeResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3154,21 +5600,21 @@ func (c *Client) sendNewDiscordCallback(ctx context.Context, params NewDiscordCa
 	return result, nil
 }
 
-// NewLobby invokes newLobby operation.
+// NewSingleplayerGame invokes newSingleplayerGame operation.
 //
-// Create new lobby with specified parameters.
+// Create new singleplayer game.
 //
-// POST /v1/lobbies
-func (c *Client) NewLobby(ctx context.Context, request *NewLobby) (NewLobbyRes, error) {
-	res, err := c.sendNewLobby(ctx, request)
+// POST /v1/singleplayer
+func (c *Client) NewSingleplayerGame(ctx context.Context, request *NewSingleplayerGameRequest) (NewSingleplayerGameRes, error) {
+	res, err := c.sendNewSingleplayerGame(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendNewLobby(ctx context.Context, request *NewLobby) (res NewLobbyRes, err error) {
+func (c *Client) sendNewSingleplayerGame(ctx context.Context, request *NewSingleplayerGameRequest) (res NewSingleplayerGameRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("newLobby"),
+		otelogen.OperationID("newSingleplayerGame"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v1/lobbies"),
+		semconv.HTTPRouteKey.String("/v1/singleplayer"),
 	}
 
 	// Run stopwatch.
@@ -3183,7 +5629,7 @@ func (c *Client) sendNewLobby(ctx context.Context, request *NewLobby) (res NewLo
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, NewLobbyOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, NewSingleplayerGameOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3201,7 +5647,7 @@ func (c *Client) sendNewLobby(ctx context.Context, request *NewLobby) (res NewLo
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/lobbies"
+	pathParts[0] = "/v1/singleplayer"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -3209,7 +5655,7 @@ func (c *Client) sendNewLobby(ctx context.Context, request *NewLobby) (res NewLo
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeNewLobbyRequest(request, r); err != nil {
+	if err := encodeNewSingleplayerGameRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -3218,7 +5664,7 @@ func (c *Client) sendNewLobby(ctx context.Context, request *NewLobby) (res NewLo
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, NewLobbyOperation, r); {
+			switch err := c.securityBearer(ctx, NewSingleplayerGameOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3254,7 +5700,7 @@ func (c *Client) sendNewLobby(ctx context.Context, request *NewLobby) (res NewLo
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeNewLobbyResponse(resp)
+	result, err := decodeNewSingleplayerGameResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3262,21 +5708,21 @@ func (c *Client) sendNewLobby(ctx context.Context, request *NewLobby) (res NewLo
 	return result, nil
 }
 
-// NewMultiplayerRound invokes newMultiplayerRound operation.
+// NewSingleplayerRound invokes newSingleplayerRound operation.
 //
-// Get or generate multiplayer game round.
+// Create singleplayer game round.
 //
-// POST /v1/multiplayer/{id}/round
-func (c *Client) NewMultiplayerRound(ctx context.Context, params NewMultiplayerRoundParams) (NewMultiplayerRoundRes, error) {
-	res, err := c.sendNewMultiplayerRound(ctx, params)
+// POST /v1/singleplayer/{id}/round
+func (c *Client) NewSingleplayerRound(ctx context.Context, params NewSingleplayerRoundParams) (NewSingleplayerRoundRes, error) {
+	res, err := c.sendNewSingleplayerRound(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendNewMultiplayerRound(ctx context.Context, params NewMultiplayerRoundParams) (res NewMultiplayerRoundRes, err error) {
+func (c *Client) sendNewSingleplayerRound(ctx context.Context, params NewSingleplayerRoundParams) (res NewSingleplayerRoundRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("newMultiplayerRound"),
+		otelogen.OperationID("newSingleplayerRound"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v1/multiplayer/{id}/round"),
+		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}/round"),
 	}
 
 	// Run stopwatch.
@@ -3291,7 +5737,7 @@ func (c *Client) sendNewMultiplayerRound(ctx context.Context, params NewMultipla
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, NewMultiplayerRoundOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, NewSingleplayerRoundOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3309,7 +5755,7 @@ func (c *Client) sendNewMultiplayerRound(ctx context.Context, params NewMultipla
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/v1/multiplayer/"
+	pathParts[0] = "/v1/singleplayer/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -3342,7 +5788,7 @@ func (c *Client) sendNewMultiplayerRound(ctx context.Context, params NewMultipla
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, NewMultiplayerRoundOperation, r); {
+			switch err := c.securityBearer(ctx, NewSingleplayerRoundOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3378,7 +5824,7 @@ func (c *Client) sendNewMultiplayerRound(ctx context.Context, params NewMultipla
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeNewMultiplayerRoundResponse(resp)
+	result, err := decodeNewSingleplayerRoundResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3386,21 +5832,21 @@ func (c *Client) sendNewMultiplayerRound(ctx context.Context, params NewMultipla
 	return result, nil
 }
 
-// NewSingleplayerGame invokes newSingleplayerGame operation.
+// NewStreakGame invokes newStreakGame operation.
 //
-// Create new singleplayer game.
+// Create new country streak game, that continues until the first wrongly guessed country.
 //
-// POST /v1/singleplayer
-func (c *Client) NewSingleplayerGame(ctx context.Context, request *NewSingleplayerGameRequest) (NewSingleplayerGameRes, error) {
-	res, err := c.sendNewSingleplayerGame(ctx, request)
+// POST /v1/streak
+func (c *Client) NewStreakGame(ctx context.Context, request *NewStreakGameRequest) (NewStreakGameRes, error) {
+	res, err := c.sendNewStreakGame(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendNewSingleplayerGame(ctx context.Context, request *NewSingleplayerGameRequest) (res NewSingleplayerGameRes, err error) {
+func (c *Client) sendNewStreakGame(ctx context.Context, request *NewStreakGameRequest) (res NewStreakGameRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("newSingleplayerGame"),
+		otelogen.OperationID("newStreakGame"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v1/singleplayer"),
+		semconv.HTTPRouteKey.String("/v1/streak"),
 	}
 
 	// Run stopwatch.
@@ -3415,7 +5861,7 @@ func (c *Client) sendNewSingleplayerGame(ctx context.Context, request *NewSingle
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, NewSingleplayerGameOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, NewStreakGameOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3433,7 +5879,7 @@ func (c *Client) sendNewSingleplayerGame(ctx context.Context, request *NewSingle
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/singleplayer"
+	pathParts[0] = "/v1/streak"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -3441,7 +5887,7 @@ func (c *Client) sendNewSingleplayerGame(ctx context.Context, request *NewSingle
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeNewSingleplayerGameRequest(request, r); err != nil {
+	if err := encodeNewStreakGameRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -3450,7 +5896,7 @@ func (c *Client) sendNewSingleplayerGame(ctx context.Context, request *NewSingle
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, NewSingleplayerGameOperation, r); {
+			switch err := c.securityBearer(ctx, NewStreakGameOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3486,7 +5932,7 @@ func (c *Client) sendNewSingleplayerGame(ctx context.Context, request *NewSingle
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeNewSingleplayerGameResponse(resp)
+	result, err := decodeNewStreakGameResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3494,21 +5940,21 @@ func (c *Client) sendNewSingleplayerGame(ctx context.Context, request *NewSingle
 	return result, nil
 }
 
-// NewSingleplayerRound invokes newSingleplayerRound operation.
+// NewStreakRound invokes newStreakRound operation.
 //
-// Create singleplayer game round.
+// Create next round of country streak game, current round is returned if it's not finished.
 //
-// POST /v1/singleplayer/{id}/round
-func (c *Client) NewSingleplayerRound(ctx context.Context, params NewSingleplayerRoundParams) (NewSingleplayerRoundRes, error) {
-	res, err := c.sendNewSingleplayerRound(ctx, params)
+// POST /v1/streak/{id}/round
+func (c *Client) NewStreakRound(ctx context.Context, params NewStreakRoundParams) (NewStreakRoundRes, error) {
+	res, err := c.sendNewStreakRound(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendNewSingleplayerRound(ctx context.Context, params NewSingleplayerRoundParams) (res NewSingleplayerRoundRes, err error) {
+func (c *Client) sendNewStreakRound(ctx context.Context, params NewStreakRoundParams) (res NewStreakRoundRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("newSingleplayerRound"),
+		otelogen.OperationID("newStreakRound"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}/round"),
+		semconv.HTTPRouteKey.String("/v1/streak/{id}/round"),
 	}
 
 	// Run stopwatch.
@@ -3523,7 +5969,7 @@ func (c *Client) sendNewSingleplayerRound(ctx context.Context, params NewSinglep
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, NewSingleplayerRoundOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, NewStreakRoundOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3541,7 +5987,7 @@ func (c *Client) sendNewSingleplayerRound(ctx context.Context, params NewSinglep
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/v1/singleplayer/"
+	pathParts[0] = "/v1/streak/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -3574,7 +6020,7 @@ func (c *Client) sendNewSingleplayerRound(ctx context.Context, params NewSinglep
 		var satisfied bitset
 		{
 			stage = "Security:Bearer"
-			switch err := c.securityBearer(ctx, NewSingleplayerRoundOperation, r); {
+			switch err := c.securityBearer(ctx, NewStreakRoundOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3610,7 +6056,7 @@ func (c *Client) sendNewSingleplayerRound(ctx context.Context, params NewSinglep
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeNewSingleplayerRoundResponse(resp)
+	result, err := decodeNewStreakRoundResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4008,6 +6454,132 @@ func (c *Client) sendRegister(ctx context.Context, request *RegisterRequest, par
 	return result, nil
 }
 
+// UpdateMap invokes updateMap operation.
+//
+// Update name, description and visibility of a map created by the authenticated user.
+//
+// PATCH /v1/maps/{id}
+func (c *Client) UpdateMap(ctx context.Context, request *UpdateMapRequest, params UpdateMapParams) (UpdateMapRes, error) {
+	res, err := c.sendUpdateMap(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendUpdateMap(ctx context.Context, request *UpdateMapRequest, params UpdateMapParams) (res UpdateMapRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("updateMap"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/v1/maps/{id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateMapOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/maps/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpdateMapRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:Bearer"
+			switch err := c.securityBearer(ctx, UpdateMapOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearer\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUpdateMapResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // UpdateUser invokes updateUser operation.
 //
 // Update authenticated user's profile information.
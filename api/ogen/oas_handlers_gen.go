@@ -30,22 +30,22 @@ func (c *codeRecorder) WriteHeader(status int) {
 	c.ResponseWriter.WriteHeader(status)
 }
 
-// handleDeleteDiscordRequest handles deleteDiscord operation.
+// handleAcceptSingleplayerChallengeRequest handles acceptSingleplayerChallenge operation.
 //
-// Delete login with Discord OAuth.
+// Create new singleplayer game with locations and settings of the challenge.
 //
-// DELETE /v1/auth/discord
-func (s *Server) handleDeleteDiscordRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/challenges/{id}/accept
+func (s *Server) handleAcceptSingleplayerChallengeRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteDiscord"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/v1/auth/discord"),
+		otelogen.OperationID("acceptSingleplayerChallenge"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/challenges/{id}/accept"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteDiscordOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AcceptSingleplayerChallengeOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -100,15 +100,15 @@ func (s *Server) handleDeleteDiscordRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteDiscordOperation,
-			ID:   "deleteDiscord",
+			Name: AcceptSingleplayerChallengeOperation,
+			ID:   "acceptSingleplayerChallenge",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, DeleteDiscordOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, AcceptSingleplayerChallengeOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -148,23 +148,38 @@ func (s *Server) handleDeleteDiscordRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
+	params, err := decodeAcceptSingleplayerChallengeParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response DeleteDiscordRes
+	var response AcceptSingleplayerChallengeRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteDiscordOperation,
-			OperationSummary: "Delete Discord auth",
-			OperationID:      "deleteDiscord",
+			OperationName:    AcceptSingleplayerChallengeOperation,
+			OperationSummary: "Accept challenge",
+			OperationID:      "acceptSingleplayerChallenge",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = DeleteDiscordRes
+			Params   = AcceptSingleplayerChallengeParams
+			Response = AcceptSingleplayerChallengeRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -173,14 +188,14 @@ func (s *Server) handleDeleteDiscordRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackAcceptSingleplayerChallengeParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteDiscord(ctx)
+				response, err = s.h.AcceptSingleplayerChallenge(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteDiscord(ctx)
+		response, err = s.h.AcceptSingleplayerChallenge(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -188,7 +203,7 @@ func (s *Server) handleDeleteDiscordRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeDeleteDiscordResponse(response, w, span); err != nil {
+	if err := encodeAcceptSingleplayerChallengeResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -197,22 +212,22 @@ func (s *Server) handleDeleteDiscordRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleDeleteUserSessionRequest handles deleteUserSession operation.
+// handleAddMapLocationsRequest handles addMapLocations operation.
 //
-// Delete user session.
+// Add existing panorama locations of the map provider to a map created by the authenticated user.
 //
-// DELETE /v1/auth/sessions/{id}
-func (s *Server) handleDeleteUserSessionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/maps/{id}/locations
+func (s *Server) handleAddMapLocationsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteUserSession"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/v1/auth/sessions/{id}"),
+		otelogen.OperationID("addMapLocations"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/maps/{id}/locations"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteUserSessionOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AddMapLocationsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -267,15 +282,15 @@ func (s *Server) handleDeleteUserSessionRequest(args [1]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteUserSessionOperation,
-			ID:   "deleteUserSession",
+			Name: AddMapLocationsOperation,
+			ID:   "addMapLocations",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, DeleteUserSessionOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, AddMapLocationsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -315,7 +330,7 @@ func (s *Server) handleDeleteUserSessionRequest(args [1]string, argsEscaped bool
 			return
 		}
 	}
-	params, err := decodeDeleteUserSessionParams(args, argsEscaped, r)
+	params, err := decodeAddMapLocationsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -325,15 +340,30 @@ func (s *Server) handleDeleteUserSessionRequest(args [1]string, argsEscaped bool
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeAddMapLocationsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response DeleteUserSessionRes
+	var response AddMapLocationsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteUserSessionOperation,
-			OperationSummary: "Delete user session",
-			OperationID:      "deleteUserSession",
-			Body:             nil,
+			OperationName:    AddMapLocationsOperation,
+			OperationSummary: "Add existing locations to map",
+			OperationID:      "addMapLocations",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "id",
@@ -344,9 +374,9 @@ func (s *Server) handleDeleteUserSessionRequest(args [1]string, argsEscaped bool
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteUserSessionParams
-			Response = DeleteUserSessionRes
+			Request  = *AddMapLocationsRequest
+			Params   = AddMapLocationsParams
+			Response = AddMapLocationsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -355,14 +385,14 @@ func (s *Server) handleDeleteUserSessionRequest(args [1]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackDeleteUserSessionParams,
+			unpackAddMapLocationsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteUserSession(ctx, params)
+				response, err = s.h.AddMapLocations(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteUserSession(ctx, params)
+		response, err = s.h.AddMapLocations(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -370,7 +400,7 @@ func (s *Server) handleDeleteUserSessionRequest(args [1]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeDeleteUserSessionResponse(response, w, span); err != nil {
+	if err := encodeAddMapLocationsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -379,22 +409,22 @@ func (s *Server) handleDeleteUserSessionRequest(args [1]string, argsEscaped bool
 	}
 }
 
-// handleDeleteYandexRequest handles deleteYandex operation.
+// handleDeleteDiscordRequest handles deleteDiscord operation.
 //
-// Delete login with Yandex OAuth.
+// Delete login with Discord OAuth.
 //
-// DELETE /v1/auth/yandex
-func (s *Server) handleDeleteYandexRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /v1/auth/discord
+func (s *Server) handleDeleteDiscordRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteYandex"),
+		otelogen.OperationID("deleteDiscord"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/v1/auth/yandex"),
+		semconv.HTTPRouteKey.String("/v1/auth/discord"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteYandexOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteDiscordOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -449,15 +479,15 @@ func (s *Server) handleDeleteYandexRequest(args [0]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteYandexOperation,
-			ID:   "deleteYandex",
+			Name: DeleteDiscordOperation,
+			ID:   "deleteDiscord",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, DeleteYandexOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, DeleteDiscordOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -498,13 +528,13 @@ func (s *Server) handleDeleteYandexRequest(args [0]string, argsEscaped bool, w h
 		}
 	}
 
-	var response DeleteYandexRes
+	var response DeleteDiscordRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteYandexOperation,
-			OperationSummary: "Delete Yandex auth",
-			OperationID:      "deleteYandex",
+			OperationName:    DeleteDiscordOperation,
+			OperationSummary: "Delete Discord auth",
+			OperationID:      "deleteDiscord",
 			Body:             nil,
 			Params:           middleware.Parameters{},
 			Raw:              r,
@@ -513,7 +543,7 @@ func (s *Server) handleDeleteYandexRequest(args [0]string, argsEscaped bool, w h
 		type (
 			Request  = struct{}
 			Params   = struct{}
-			Response = DeleteYandexRes
+			Response = DeleteDiscordRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -524,12 +554,12 @@ func (s *Server) handleDeleteYandexRequest(args [0]string, argsEscaped bool, w h
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteYandex(ctx)
+				response, err = s.h.DeleteDiscord(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteYandex(ctx)
+		response, err = s.h.DeleteDiscord(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -537,7 +567,7 @@ func (s *Server) handleDeleteYandexRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeDeleteYandexResponse(response, w, span); err != nil {
+	if err := encodeDeleteDiscordResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -546,22 +576,22 @@ func (s *Server) handleDeleteYandexRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handleDiscordLoginRequest handles discordLogin operation.
+// handleDeleteUserSessionRequest handles deleteUserSession operation.
 //
-// Log in using Discord OAuth.
+// Delete user session.
 //
-// GET /v1/auth/discord/login
-func (s *Server) handleDiscordLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /v1/auth/sessions/{id}
+func (s *Server) handleDeleteUserSessionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("discordLogin"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/auth/discord/login"),
+		otelogen.OperationID("deleteUserSession"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/v1/auth/sessions/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DiscordLoginOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteUserSessionOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -614,25 +644,88 @@ func (s *Server) handleDiscordLoginRequest(args [0]string, argsEscaped bool, w h
 
 			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeleteUserSessionOperation,
+			ID:   "deleteUserSession",
+		}
 	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, DeleteUserSessionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
 
-	var response *DiscordLoginTemporaryRedirect
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteUserSessionParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response DeleteUserSessionRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DiscordLoginOperation,
-			OperationSummary: "Discord login",
-			OperationID:      "discordLogin",
+			OperationName:    DeleteUserSessionOperation,
+			OperationSummary: "Delete user session",
+			OperationID:      "deleteUserSession",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = *DiscordLoginTemporaryRedirect
+			Params   = DeleteUserSessionParams
+			Response = DeleteUserSessionRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -641,14 +734,14 @@ func (s *Server) handleDiscordLoginRequest(args [0]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			nil,
+			unpackDeleteUserSessionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DiscordLogin(ctx)
+				response, err = s.h.DeleteUserSession(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DiscordLogin(ctx)
+		response, err = s.h.DeleteUserSession(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -656,7 +749,7 @@ func (s *Server) handleDiscordLoginRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeDiscordLoginResponse(response, w, span); err != nil {
+	if err := encodeDeleteUserSessionResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -665,22 +758,22 @@ func (s *Server) handleDiscordLoginRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handleDiscordLoginCallbackRequest handles discordLoginCallback operation.
+// handleDeleteYandexRequest handles deleteYandex operation.
 //
-// A callback for Discord OAuth login.
+// Delete login with Yandex OAuth.
 //
-// GET /v1/auth/discord/login/callback
-func (s *Server) handleDiscordLoginCallbackRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /v1/auth/yandex
+func (s *Server) handleDeleteYandexRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("discordLoginCallback"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/auth/discord/login/callback"),
+		otelogen.OperationID("deleteYandex"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/v1/auth/yandex"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DiscordLoginCallbackOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteYandexOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -735,50 +828,3138 @@ func (s *Server) handleDiscordLoginCallbackRequest(args [0]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DiscordLoginCallbackOperation,
-			ID:   "discordLoginCallback",
+			Name: DeleteYandexOperation,
+			ID:   "deleteYandex",
 		}
 	)
-	params, err := decodeDiscordLoginCallbackParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-
-	var response DiscordLoginCallbackRes
-	if m := s.cfg.Middleware; m != nil {
-		mreq := middleware.Request{
-			Context:          ctx,
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, DeleteYandexOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response DeleteYandexRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeleteYandexOperation,
+			OperationSummary: "Delete Yandex auth",
+			OperationID:      "deleteYandex",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = DeleteYandexRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DeleteYandex(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DeleteYandex(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeleteYandexResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDiscordLoginRequest handles discordLogin operation.
+//
+// Log in using Discord OAuth.
+//
+// GET /v1/auth/discord/login
+func (s *Server) handleDiscordLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("discordLogin"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/auth/discord/login"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DiscordLoginOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err error
+	)
+
+	var response *DiscordLoginTemporaryRedirect
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DiscordLoginOperation,
+			OperationSummary: "Discord login",
+			OperationID:      "discordLogin",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *DiscordLoginTemporaryRedirect
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DiscordLogin(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DiscordLogin(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDiscordLoginResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDiscordLoginCallbackRequest handles discordLoginCallback operation.
+//
+// A callback for Discord OAuth login.
+//
+// GET /v1/auth/discord/login/callback
+func (s *Server) handleDiscordLoginCallbackRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("discordLoginCallback"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/auth/discord/login/callback"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DiscordLoginCallbackOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DiscordLoginCallbackOperation,
+			ID:   "discordLoginCallback",
+		}
+	)
+	params, err := decodeDiscordLoginCallbackParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response DiscordLoginCallbackRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
 			OperationName:    DiscordLoginCallbackOperation,
 			OperationSummary: "Discord login callback",
 			OperationID:      "discordLoginCallback",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "Cookie",
-					In:   "header",
-				}: params.Cookie,
-				{
-					Name: "code",
-					In:   "query",
-				}: params.Code,
-				{
-					Name: "state",
-					In:   "query",
-				}: params.State,
-			},
-			Raw: r,
+			Params: middleware.Parameters{
+				{
+					Name: "Cookie",
+					In:   "header",
+				}: params.Cookie,
+				{
+					Name: "code",
+					In:   "query",
+				}: params.Code,
+				{
+					Name: "state",
+					In:   "query",
+				}: params.State,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DiscordLoginCallbackParams
+			Response = DiscordLoginCallbackRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDiscordLoginCallbackParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DiscordLoginCallback(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DiscordLoginCallback(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDiscordLoginCallbackResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleEndSingleplayerGameRequest handles endSingleplayerGame operation.
+//
+// End singleplayer game.
+//
+// POST /v1/singleplayer/{id}/end
+func (s *Server) handleEndSingleplayerGameRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("endSingleplayerGame"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}/end"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), EndSingleplayerGameOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: EndSingleplayerGameOperation,
+			ID:   "endSingleplayerGame",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, EndSingleplayerGameOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeEndSingleplayerGameParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response EndSingleplayerGameRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    EndSingleplayerGameOperation,
+			OperationSummary: "End singleplayer game",
+			OperationID:      "endSingleplayerGame",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = EndSingleplayerGameParams
+			Response = EndSingleplayerGameRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackEndSingleplayerGameParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.EndSingleplayerGame(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.EndSingleplayerGame(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeEndSingleplayerGameResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleEndSingleplayerRoundRequest handles endSingleplayerRound operation.
+//
+// End singleplayer game round and get results.
+//
+// POST /v1/singleplayer/{id}/round/end
+func (s *Server) handleEndSingleplayerRoundRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("endSingleplayerRound"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}/round/end"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), EndSingleplayerRoundOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: EndSingleplayerRoundOperation,
+			ID:   "endSingleplayerRound",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, EndSingleplayerRoundOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeEndSingleplayerRoundParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeEndSingleplayerRoundRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response EndSingleplayerRoundRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    EndSingleplayerRoundOperation,
+			OperationSummary: "End singleplayer game round",
+			OperationID:      "endSingleplayerRound",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *SingleplayerRoundGuess
+			Params   = EndSingleplayerRoundParams
+			Response = EndSingleplayerRoundRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackEndSingleplayerRoundParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.EndSingleplayerRound(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.EndSingleplayerRound(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeEndSingleplayerRoundResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleEndStreakRoundRequest handles endStreakRound operation.
+//
+// Guess the country of the current round. The game ends if the country is wrong or the round timer
+// has ended.
+//
+// POST /v1/streak/{id}/round/end
+func (s *Server) handleEndStreakRoundRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("endStreakRound"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/streak/{id}/round/end"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), EndStreakRoundOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: EndStreakRoundOperation,
+			ID:   "endStreakRound",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, EndStreakRoundOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeEndStreakRoundParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeEndStreakRoundRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response EndStreakRoundRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    EndStreakRoundOperation,
+			OperationSummary: "End country streak game round",
+			OperationID:      "endStreakRound",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *StreakRoundGuess
+			Params   = EndStreakRoundParams
+			Response = EndStreakRoundRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackEndStreakRoundParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.EndStreakRound(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.EndStreakRound(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeEndStreakRoundResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetBestStreakRequest handles getBestStreak operation.
+//
+// Get the longest streak among finished country streak games of the current user.
+//
+// GET /v1/streak/best
+func (s *Server) handleGetBestStreakRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBestStreak"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/streak/best"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetBestStreakOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetBestStreakOperation,
+			ID:   "getBestStreak",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, GetBestStreakOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response GetBestStreakRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetBestStreakOperation,
+			OperationSummary: "Get best country streak of user",
+			OperationID:      "getBestStreak",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetBestStreakRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetBestStreak(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetBestStreak(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetBestStreakResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetDailyChallengeLeaderboardRequest handles getDailyChallengeLeaderboard operation.
+//
+// Get finished daily challenge games of a date, ranked by score. Players with equal score share the
+// same place.
+//
+// GET /v1/daily-challenge/leaderboard
+func (s *Server) handleGetDailyChallengeLeaderboardRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getDailyChallengeLeaderboard"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/daily-challenge/leaderboard"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetDailyChallengeLeaderboardOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetDailyChallengeLeaderboardOperation,
+			ID:   "getDailyChallengeLeaderboard",
+		}
+	)
+	params, err := decodeGetDailyChallengeLeaderboardParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetDailyChallengeLeaderboardRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetDailyChallengeLeaderboardOperation,
+			OperationSummary: "Get daily challenge leaderboard",
+			OperationID:      "getDailyChallengeLeaderboard",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "provider",
+					In:   "query",
+				}: params.Provider,
+				{
+					Name: "date",
+					In:   "query",
+				}: params.Date,
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+				{
+					Name: "page-size",
+					In:   "query",
+				}: params.PageSize,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetDailyChallengeLeaderboardParams
+			Response = GetDailyChallengeLeaderboardRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetDailyChallengeLeaderboardParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetDailyChallengeLeaderboard(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetDailyChallengeLeaderboard(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetDailyChallengeLeaderboardResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetHealthRequest handles getHealth operation.
+//
+// Check API health status.
+//
+// GET /health
+func (s *Server) handleGetHealthRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getHealth"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/health"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetHealthOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err error
+	)
+
+	var response *GetHealthOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetHealthOperation,
+			OperationSummary: "Check API health",
+			OperationID:      "getHealth",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *GetHealthOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetHealth(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetHealth(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetHealthResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetLobbiesRequest handles getLobbies operation.
+//
+// Get available lobbies matching all of the set filters, unlisted and invite-only lobbies are not
+// listed.
+//
+// GET /v1/lobbies
+func (s *Server) handleGetLobbiesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getLobbies"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/lobbies"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetLobbiesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetLobbiesOperation,
+			ID:   "getLobbies",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, GetLobbiesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetLobbiesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetLobbiesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetLobbiesOperation,
+			OperationSummary: "Get available lobbies",
+			OperationID:      "getLobbies",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "provider",
+					In:   "query",
+				}: params.Provider,
+				{
+					Name: "rounds-min",
+					In:   "query",
+				}: params.RoundsMin,
+				{
+					Name: "rounds-max",
+					In:   "query",
+				}: params.RoundsMax,
+				{
+					Name: "timer-seconds",
+					In:   "query",
+				}: params.TimerSeconds,
+				{
+					Name: "movement-allowed",
+					In:   "query",
+				}: params.MovementAllowed,
+				{
+					Name: "free-slots",
+					In:   "query",
+				}: params.FreeSlots,
+				{
+					Name: "creator",
+					In:   "query",
+				}: params.Creator,
+				{
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+				{
+					Name: "page-size",
+					In:   "query",
+				}: params.PageSize,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetLobbiesParams
+			Response = GetLobbiesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetLobbiesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetLobbies(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetLobbies(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetLobbiesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetLobbyRequest handles getLobby operation.
+//
+// Get lobby information by ID.
+//
+// GET /v1/lobbies/{id}
+func (s *Server) handleGetLobbyRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getLobby"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/lobbies/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetLobbyOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetLobbyOperation,
+			ID:   "getLobby",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, GetLobbyOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetLobbyParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetLobbyRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetLobbyOperation,
+			OperationSummary: "Get lobby by ID",
+			OperationID:      "getLobby",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetLobbyParams
+			Response = GetLobbyRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetLobbyParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetLobby(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetLobby(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetLobbyResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetMapRequest handles getMap operation.
+//
+// Get map information by ID. Private maps are available only to their creator.
+//
+// GET /v1/maps/{id}
+func (s *Server) handleGetMapRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getMap"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/maps/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetMapOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetMapOperation,
+			ID:   "getMap",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, GetMapOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetMapParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetMapRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetMapOperation,
+			OperationSummary: "Get map by ID",
+			OperationID:      "getMap",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetMapParams
+			Response = GetMapRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetMapParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetMap(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetMap(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetMapResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetMapsRequest handles getMaps operation.
+//
+// Get public maps, or all maps of the authenticated user if `mine` is set.
+//
+// GET /v1/maps
+func (s *Server) handleGetMapsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getMaps"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/maps"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetMapsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetMapsOperation,
+			ID:   "getMaps",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, GetMapsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetMapsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetMapsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetMapsOperation,
+			OperationSummary: "Get maps",
+			OperationID:      "getMaps",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "mine",
+					In:   "query",
+				}: params.Mine,
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+				{
+					Name: "page-size",
+					In:   "query",
+				}: params.PageSize,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetMapsParams
+			Response = GetMapsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetMapsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetMaps(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetMaps(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetMapsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetMultiplayerGameRequest handles getMultiplayerGame operation.
+//
+// Get multiplayer game information by ID.
+//
+// GET /v1/multiplayer/{id}
+func (s *Server) handleGetMultiplayerGameRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getMultiplayerGame"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/multiplayer/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetMultiplayerGameOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetMultiplayerGameOperation,
+			ID:   "getMultiplayerGame",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, GetMultiplayerGameOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetMultiplayerGameParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetMultiplayerGameRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetMultiplayerGameOperation,
+			OperationSummary: "Get multiplayer game by ID",
+			OperationID:      "getMultiplayerGame",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetMultiplayerGameParams
+			Response = GetMultiplayerGameRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetMultiplayerGameParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetMultiplayerGame(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetMultiplayerGame(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetMultiplayerGameResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetMultiplayerGameGuessesRequest handles getMultiplayerGameGuesses operation.
+//
+// Get multiplayer game user guesses of finished rounds.
+// Spectators get guesses of a round only after the spectator delay of the game.
+//
+// GET /v1/multiplayer/{id}/guesses
+func (s *Server) handleGetMultiplayerGameGuessesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getMultiplayerGameGuesses"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/multiplayer/{id}/guesses"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetMultiplayerGameGuessesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetMultiplayerGameGuessesOperation,
+			ID:   "getMultiplayerGameGuesses",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, GetMultiplayerGameGuessesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetMultiplayerGameGuessesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetMultiplayerGameGuessesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetMultiplayerGameGuessesOperation,
+			OperationSummary: "Get multiplayer game guesses",
+			OperationID:      "getMultiplayerGameGuesses",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetMultiplayerGameGuessesParams
+			Response = GetMultiplayerGameGuessesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetMultiplayerGameGuessesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetMultiplayerGameGuesses(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetMultiplayerGameGuesses(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetMultiplayerGameGuessesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetMultiplayerRoundRequest handles getMultiplayerRound operation.
+//
+// Get multiplayer game round.
+//
+// GET /v1/multiplayer/{id}/round
+func (s *Server) handleGetMultiplayerRoundRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getMultiplayerRound"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/multiplayer/{id}/round"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetMultiplayerRoundOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetMultiplayerRoundOperation,
+			ID:   "getMultiplayerRound",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, GetMultiplayerRoundOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetMultiplayerRoundParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetMultiplayerRoundRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetMultiplayerRoundOperation,
+			OperationSummary: "Get multiplayer game round",
+			OperationID:      "getMultiplayerRound",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetMultiplayerRoundParams
+			Response = GetMultiplayerRoundRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetMultiplayerRoundParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetMultiplayerRound(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetMultiplayerRound(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetMultiplayerRoundResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOAuthProvidersRequest handles getOAuthProviders operation.
+//
+// Get all connected OAuth providers.
+//
+// GET /v1/auth/providers
+func (s *Server) handleGetOAuthProvidersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOAuthProviders"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/auth/providers"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOAuthProvidersOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOAuthProvidersOperation,
+			ID:   "getOAuthProviders",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, GetOAuthProvidersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response GetOAuthProvidersRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOAuthProvidersOperation,
+			OperationSummary: "Get all connected OAuth providers",
+			OperationID:      "getOAuthProviders",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetOAuthProvidersRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOAuthProviders(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOAuthProviders(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOAuthProvidersResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetPanoramaRequest handles getPanorama operation.
+//
+// Resolve opaque panorama token of the current round to panorama ID (without coordinates).
+//
+// GET /v1/panorama/{token}
+func (s *Server) handleGetPanoramaRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getPanorama"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/panorama/{token}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPanoramaOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetPanoramaOperation,
+			ID:   "getPanorama",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, GetPanoramaOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetPanoramaParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetPanoramaRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetPanoramaOperation,
+			OperationSummary: "Resolve panorama token",
+			OperationID:      "getPanorama",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "token",
+					In:   "path",
+				}: params.Token,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetPanoramaParams
+			Response = GetPanoramaRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetPanoramaParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetPanorama(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetPanorama(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetPanoramaResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetPanoramaProvidersRequest handles getPanoramaProviders operation.
+//
+// Get names of panorama providers, that games can be played on.
+//
+// GET /v1/panorama/providers
+func (s *Server) handleGetPanoramaProvidersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getPanoramaProviders"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/panorama/providers"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPanoramaProvidersOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err error
+	)
+
+	var response GetPanoramaProvidersRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetPanoramaProvidersOperation,
+			OperationSummary: "Get panorama providers",
+			OperationID:      "getPanoramaProviders",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DiscordLoginCallbackParams
-			Response = DiscordLoginCallbackRes
+			Params   = struct{}
+			Response = GetPanoramaProvidersRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -787,14 +3968,14 @@ func (s *Server) handleDiscordLoginCallbackRequest(args [0]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackDiscordLoginCallbackParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DiscordLoginCallback(ctx, params)
+				response, err = s.h.GetPanoramaProviders(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DiscordLoginCallback(ctx, params)
+		response, err = s.h.GetPanoramaProviders(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -802,7 +3983,7 @@ func (s *Server) handleDiscordLoginCallbackRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	if err := encodeDiscordLoginCallbackResponse(response, w, span); err != nil {
+	if err := encodeGetPanoramaProvidersResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -811,22 +3992,22 @@ func (s *Server) handleDiscordLoginCallbackRequest(args [0]string, argsEscaped b
 	}
 }
 
-// handleEndSingleplayerGameRequest handles endSingleplayerGame operation.
+// handleGetPrivateProfileRequest handles getPrivateProfile operation.
 //
-// End singleplayer game.
+// Retrieve authenticated user profile details.
 //
-// POST /v1/singleplayer/{id}/end
-func (s *Server) handleEndSingleplayerGameRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v1/users/me
+func (s *Server) handleGetPrivateProfileRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("endSingleplayerGame"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}/end"),
+		otelogen.OperationID("getPrivateProfile"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/users/me"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), EndSingleplayerGameOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPrivateProfileOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -881,15 +4062,15 @@ func (s *Server) handleEndSingleplayerGameRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: EndSingleplayerGameOperation,
-			ID:   "endSingleplayerGame",
+			Name: GetPrivateProfileOperation,
+			ID:   "getPrivateProfile",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, EndSingleplayerGameOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, GetPrivateProfileOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -929,38 +4110,23 @@ func (s *Server) handleEndSingleplayerGameRequest(args [1]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeEndSingleplayerGameParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response EndSingleplayerGameRes
+	var response GetPrivateProfileRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    EndSingleplayerGameOperation,
-			OperationSummary: "End singleplayer game",
-			OperationID:      "endSingleplayerGame",
+			OperationName:    GetPrivateProfileOperation,
+			OperationSummary: "Get current user profile",
+			OperationID:      "getPrivateProfile",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = EndSingleplayerGameParams
-			Response = EndSingleplayerGameRes
+			Params   = struct{}
+			Response = GetPrivateProfileRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -969,14 +4135,14 @@ func (s *Server) handleEndSingleplayerGameRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackEndSingleplayerGameParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.EndSingleplayerGame(ctx, params)
+				response, err = s.h.GetPrivateProfile(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.EndSingleplayerGame(ctx, params)
+		response, err = s.h.GetPrivateProfile(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -984,7 +4150,7 @@ func (s *Server) handleEndSingleplayerGameRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeEndSingleplayerGameResponse(response, w, span); err != nil {
+	if err := encodeGetPrivateProfileResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -993,22 +4159,22 @@ func (s *Server) handleEndSingleplayerGameRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleEndSingleplayerRoundRequest handles endSingleplayerRound operation.
+// handleGetPublicProfileRequest handles getPublicProfile operation.
 //
-// End singleplayer game round and get results.
+// Retrieve public user profile by ID.
 //
-// POST /v1/singleplayer/{id}/round/end
-func (s *Server) handleEndSingleplayerRoundRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v1/users/{id}
+func (s *Server) handleGetPublicProfileRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("endSingleplayerRound"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}/round/end"),
+		otelogen.OperationID("getPublicProfile"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/users/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), EndSingleplayerRoundOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPublicProfileOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1063,15 +4229,15 @@ func (s *Server) handleEndSingleplayerRoundRequest(args [1]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: EndSingleplayerRoundOperation,
-			ID:   "endSingleplayerRound",
+			Name: GetPublicProfileOperation,
+			ID:   "getPublicProfile",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, EndSingleplayerRoundOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, GetPublicProfileOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1111,7 +4277,7 @@ func (s *Server) handleEndSingleplayerRoundRequest(args [1]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeEndSingleplayerRoundParams(args, argsEscaped, r)
+	params, err := decodeGetPublicProfileParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1121,30 +4287,15 @@ func (s *Server) handleEndSingleplayerRoundRequest(args [1]string, argsEscaped b
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeEndSingleplayerRoundRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response EndSingleplayerRoundRes
+	var response GetPublicProfileRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    EndSingleplayerRoundOperation,
-			OperationSummary: "End singleplayer game round",
-			OperationID:      "endSingleplayerRound",
-			Body:             request,
+			OperationName:    GetPublicProfileOperation,
+			OperationSummary: "Get user by ID",
+			OperationID:      "getPublicProfile",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "id",
@@ -1155,9 +4306,9 @@ func (s *Server) handleEndSingleplayerRoundRequest(args [1]string, argsEscaped b
 		}
 
 		type (
-			Request  = *SingleplayerRoundGuess
-			Params   = EndSingleplayerRoundParams
-			Response = EndSingleplayerRoundRes
+			Request  = struct{}
+			Params   = GetPublicProfileParams
+			Response = GetPublicProfileRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1166,14 +4317,14 @@ func (s *Server) handleEndSingleplayerRoundRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackEndSingleplayerRoundParams,
+			unpackGetPublicProfileParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.EndSingleplayerRound(ctx, request, params)
+				response, err = s.h.GetPublicProfile(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.EndSingleplayerRound(ctx, request, params)
+		response, err = s.h.GetPublicProfile(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1181,7 +4332,7 @@ func (s *Server) handleEndSingleplayerRoundRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeEndSingleplayerRoundResponse(response, w, span); err != nil {
+	if err := encodeGetPublicProfileResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1190,22 +4341,22 @@ func (s *Server) handleEndSingleplayerRoundRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleGetHealthRequest handles getHealth operation.
+// handleGetRootRequest handles getRoot operation.
 //
-// Check API health status.
+// Redirect to documentation page.
 //
-// GET /health
-func (s *Server) handleGetHealthRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /
+func (s *Server) handleGetRootRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getHealth"),
+		otelogen.OperationID("getRoot"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/health"),
+		semconv.HTTPRouteKey.String("/"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetHealthOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetRootOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1261,13 +4412,13 @@ func (s *Server) handleGetHealthRequest(args [0]string, argsEscaped bool, w http
 		err error
 	)
 
-	var response *GetHealthOK
+	var response *GetRootFound
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetHealthOperation,
-			OperationSummary: "Check API health",
-			OperationID:      "getHealth",
+			OperationName:    GetRootOperation,
+			OperationSummary: "Redirect to documentation",
+			OperationID:      "getRoot",
 			Body:             nil,
 			Params:           middleware.Parameters{},
 			Raw:              r,
@@ -1276,7 +4427,7 @@ func (s *Server) handleGetHealthRequest(args [0]string, argsEscaped bool, w http
 		type (
 			Request  = struct{}
 			Params   = struct{}
-			Response = *GetHealthOK
+			Response = *GetRootFound
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1287,12 +4438,12 @@ func (s *Server) handleGetHealthRequest(args [0]string, argsEscaped bool, w http
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetHealth(ctx)
+				response, err = s.h.GetRoot(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetHealth(ctx)
+		response, err = s.h.GetRoot(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1300,7 +4451,7 @@ func (s *Server) handleGetHealthRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeGetHealthResponse(response, w, span); err != nil {
+	if err := encodeGetRootResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1309,22 +4460,23 @@ func (s *Server) handleGetHealthRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleGetLobbiesRequest handles getLobbies operation.
+// handleGetSingleplayerChallengeRequest handles getSingleplayerChallenge operation.
 //
-// Get all available lobbies.
+// Get challenge settings and results of its creator and challengers. Guesses of the games are only
+// included after the user finished their own game of the challenge.
 //
-// GET /v1/lobbies
-func (s *Server) handleGetLobbiesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v1/challenges/{id}
+func (s *Server) handleGetSingleplayerChallengeRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getLobbies"),
+		otelogen.OperationID("getSingleplayerChallenge"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/lobbies"),
+		semconv.HTTPRouteKey.String("/v1/challenges/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetLobbiesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetSingleplayerChallengeOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1379,15 +4531,15 @@ func (s *Server) handleGetLobbiesRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetLobbiesOperation,
-			ID:   "getLobbies",
+			Name: GetSingleplayerChallengeOperation,
+			ID:   "getSingleplayerChallenge",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, GetLobbiesOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, GetSingleplayerChallengeOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1427,7 +4579,7 @@ func (s *Server) handleGetLobbiesRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeGetLobbiesParams(args, argsEscaped, r)
+	params, err := decodeGetSingleplayerChallengeParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1438,31 +4590,27 @@ func (s *Server) handleGetLobbiesRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	var response GetLobbiesRes
+	var response GetSingleplayerChallengeRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetLobbiesOperation,
-			OperationSummary: "Get available lobbies",
-			OperationID:      "getLobbies",
+			OperationName:    GetSingleplayerChallengeOperation,
+			OperationSummary: "Get challenge by token",
+			OperationID:      "getSingleplayerChallenge",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "page",
-					In:   "query",
-				}: params.Page,
-				{
-					Name: "page-size",
-					In:   "query",
-				}: params.PageSize,
+					Name: "id",
+					In:   "path",
+				}: params.ID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetLobbiesParams
-			Response = GetLobbiesRes
+			Params   = GetSingleplayerChallengeParams
+			Response = GetSingleplayerChallengeRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1471,14 +4619,14 @@ func (s *Server) handleGetLobbiesRequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackGetLobbiesParams,
+			unpackGetSingleplayerChallengeParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetLobbies(ctx, params)
+				response, err = s.h.GetSingleplayerChallenge(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetLobbies(ctx, params)
+		response, err = s.h.GetSingleplayerChallenge(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1486,7 +4634,7 @@ func (s *Server) handleGetLobbiesRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeGetLobbiesResponse(response, w, span); err != nil {
+	if err := encodeGetSingleplayerChallengeResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1495,22 +4643,22 @@ func (s *Server) handleGetLobbiesRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleGetLobbyRequest handles getLobby operation.
+// handleGetSingleplayerGameRequest handles getSingleplayerGame operation.
 //
-// Get lobby information by ID.
+// Get singleplayer game information by ID.
 //
-// GET /v1/lobbies/{id}
-func (s *Server) handleGetLobbyRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v1/singleplayer/{id}
+func (s *Server) handleGetSingleplayerGameRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getLobby"),
+		otelogen.OperationID("getSingleplayerGame"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/lobbies/{id}"),
+		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetLobbyOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetSingleplayerGameOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1565,15 +4713,15 @@ func (s *Server) handleGetLobbyRequest(args [1]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetLobbyOperation,
-			ID:   "getLobby",
+			Name: GetSingleplayerGameOperation,
+			ID:   "getSingleplayerGame",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, GetLobbyOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, GetSingleplayerGameOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1613,7 +4761,7 @@ func (s *Server) handleGetLobbyRequest(args [1]string, argsEscaped bool, w http.
 			return
 		}
 	}
-	params, err := decodeGetLobbyParams(args, argsEscaped, r)
+	params, err := decodeGetSingleplayerGameParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1624,13 +4772,13 @@ func (s *Server) handleGetLobbyRequest(args [1]string, argsEscaped bool, w http.
 		return
 	}
 
-	var response GetLobbyRes
+	var response GetSingleplayerGameRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetLobbyOperation,
-			OperationSummary: "Get lobby by ID",
-			OperationID:      "getLobby",
+			OperationName:    GetSingleplayerGameOperation,
+			OperationSummary: "Get singleplayer game by ID",
+			OperationID:      "getSingleplayerGame",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -1643,8 +4791,8 @@ func (s *Server) handleGetLobbyRequest(args [1]string, argsEscaped bool, w http.
 
 		type (
 			Request  = struct{}
-			Params   = GetLobbyParams
-			Response = GetLobbyRes
+			Params   = GetSingleplayerGameParams
+			Response = GetSingleplayerGameRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1653,14 +4801,14 @@ func (s *Server) handleGetLobbyRequest(args [1]string, argsEscaped bool, w http.
 		](
 			m,
 			mreq,
-			unpackGetLobbyParams,
+			unpackGetSingleplayerGameParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetLobby(ctx, params)
+				response, err = s.h.GetSingleplayerGame(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetLobby(ctx, params)
+		response, err = s.h.GetSingleplayerGame(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1668,7 +4816,7 @@ func (s *Server) handleGetLobbyRequest(args [1]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeGetLobbyResponse(response, w, span); err != nil {
+	if err := encodeGetSingleplayerGameResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1677,22 +4825,22 @@ func (s *Server) handleGetLobbyRequest(args [1]string, argsEscaped bool, w http.
 	}
 }
 
-// handleGetMultiplayerGameRequest handles getMultiplayerGame operation.
+// handleGetSingleplayerGameRoundsRequest handles getSingleplayerGameRounds operation.
 //
-// Get multiplayer game information by ID.
+// Get singleplayer game rounds.
 //
-// GET /v1/multiplayer/{id}
-func (s *Server) handleGetMultiplayerGameRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v1/singleplayer/{id}/rounds
+func (s *Server) handleGetSingleplayerGameRoundsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getMultiplayerGame"),
+		otelogen.OperationID("getSingleplayerGameRounds"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/multiplayer/{id}"),
+		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}/rounds"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetMultiplayerGameOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetSingleplayerGameRoundsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1747,15 +4895,15 @@ func (s *Server) handleGetMultiplayerGameRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetMultiplayerGameOperation,
-			ID:   "getMultiplayerGame",
+			Name: GetSingleplayerGameRoundsOperation,
+			ID:   "getSingleplayerGameRounds",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, GetMultiplayerGameOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, GetSingleplayerGameRoundsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1795,7 +4943,7 @@ func (s *Server) handleGetMultiplayerGameRequest(args [1]string, argsEscaped boo
 			return
 		}
 	}
-	params, err := decodeGetMultiplayerGameParams(args, argsEscaped, r)
+	params, err := decodeGetSingleplayerGameRoundsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1806,13 +4954,13 @@ func (s *Server) handleGetMultiplayerGameRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	var response GetMultiplayerGameRes
+	var response GetSingleplayerGameRoundsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetMultiplayerGameOperation,
-			OperationSummary: "Get multiplayer game by ID",
-			OperationID:      "getMultiplayerGame",
+			OperationName:    GetSingleplayerGameRoundsOperation,
+			OperationSummary: "Get singleplayer game rounds",
+			OperationID:      "getSingleplayerGameRounds",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -1825,8 +4973,8 @@ func (s *Server) handleGetMultiplayerGameRequest(args [1]string, argsEscaped boo
 
 		type (
 			Request  = struct{}
-			Params   = GetMultiplayerGameParams
-			Response = GetMultiplayerGameRes
+			Params   = GetSingleplayerGameRoundsParams
+			Response = GetSingleplayerGameRoundsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1835,14 +4983,14 @@ func (s *Server) handleGetMultiplayerGameRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackGetMultiplayerGameParams,
+			unpackGetSingleplayerGameRoundsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetMultiplayerGame(ctx, params)
+				response, err = s.h.GetSingleplayerGameRounds(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetMultiplayerGame(ctx, params)
+		response, err = s.h.GetSingleplayerGameRounds(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1850,7 +4998,7 @@ func (s *Server) handleGetMultiplayerGameRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeGetMultiplayerGameResponse(response, w, span); err != nil {
+	if err := encodeGetSingleplayerGameRoundsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1859,22 +5007,22 @@ func (s *Server) handleGetMultiplayerGameRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleGetMultiplayerGameGuessesRequest handles getMultiplayerGameGuesses operation.
+// handleGetSingleplayerGamesRequest handles getSingleplayerGames operation.
 //
-// Get multiplayer game user guesses.
+// Get all singleplayer user games.
 //
-// GET /v1/multiplayer/{id}/guesses
-func (s *Server) handleGetMultiplayerGameGuessesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v1/singleplayer
+func (s *Server) handleGetSingleplayerGamesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getMultiplayerGameGuesses"),
+		otelogen.OperationID("getSingleplayerGames"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/multiplayer/{id}/guesses"),
+		semconv.HTTPRouteKey.String("/v1/singleplayer"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetMultiplayerGameGuessesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetSingleplayerGamesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1929,15 +5077,15 @@ func (s *Server) handleGetMultiplayerGameGuessesRequest(args [1]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetMultiplayerGameGuessesOperation,
-			ID:   "getMultiplayerGameGuesses",
+			Name: GetSingleplayerGamesOperation,
+			ID:   "getSingleplayerGames",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, GetMultiplayerGameGuessesOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, GetSingleplayerGamesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1977,7 +5125,7 @@ func (s *Server) handleGetMultiplayerGameGuessesRequest(args [1]string, argsEsca
 			return
 		}
 	}
-	params, err := decodeGetMultiplayerGameGuessesParams(args, argsEscaped, r)
+	params, err := decodeGetSingleplayerGamesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1988,27 +5136,31 @@ func (s *Server) handleGetMultiplayerGameGuessesRequest(args [1]string, argsEsca
 		return
 	}
 
-	var response GetMultiplayerGameGuessesRes
+	var response GetSingleplayerGamesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetMultiplayerGameGuessesOperation,
-			OperationSummary: "Get multiplayer game guesses",
-			OperationID:      "getMultiplayerGameGuesses",
+			OperationName:    GetSingleplayerGamesOperation,
+			OperationSummary: "Get all singleplayer user games",
+			OperationID:      "getSingleplayerGames",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+				{
+					Name: "page-size",
+					In:   "query",
+				}: params.PageSize,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetMultiplayerGameGuessesParams
-			Response = GetMultiplayerGameGuessesRes
+			Params   = GetSingleplayerGamesParams
+			Response = GetSingleplayerGamesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2017,14 +5169,14 @@ func (s *Server) handleGetMultiplayerGameGuessesRequest(args [1]string, argsEsca
 		](
 			m,
 			mreq,
-			unpackGetMultiplayerGameGuessesParams,
+			unpackGetSingleplayerGamesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetMultiplayerGameGuesses(ctx, params)
+				response, err = s.h.GetSingleplayerGames(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetMultiplayerGameGuesses(ctx, params)
+		response, err = s.h.GetSingleplayerGames(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2032,7 +5184,7 @@ func (s *Server) handleGetMultiplayerGameGuessesRequest(args [1]string, argsEsca
 		return
 	}
 
-	if err := encodeGetMultiplayerGameGuessesResponse(response, w, span); err != nil {
+	if err := encodeGetSingleplayerGamesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2041,22 +5193,22 @@ func (s *Server) handleGetMultiplayerGameGuessesRequest(args [1]string, argsEsca
 	}
 }
 
-// handleGetMultiplayerRoundRequest handles getMultiplayerRound operation.
+// handleGetSingleplayerRoundRequest handles getSingleplayerRound operation.
 //
-// Get multiplayer game round.
+// Get singleplayer game round.
 //
-// GET /v1/multiplayer/{id}/round
-func (s *Server) handleGetMultiplayerRoundRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v1/singleplayer/{id}/round
+func (s *Server) handleGetSingleplayerRoundRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getMultiplayerRound"),
+		otelogen.OperationID("getSingleplayerRound"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/multiplayer/{id}/round"),
+		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}/round"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetMultiplayerRoundOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetSingleplayerRoundOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2111,15 +5263,15 @@ func (s *Server) handleGetMultiplayerRoundRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetMultiplayerRoundOperation,
-			ID:   "getMultiplayerRound",
+			Name: GetSingleplayerRoundOperation,
+			ID:   "getSingleplayerRound",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, GetMultiplayerRoundOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, GetSingleplayerRoundOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2159,7 +5311,7 @@ func (s *Server) handleGetMultiplayerRoundRequest(args [1]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeGetMultiplayerRoundParams(args, argsEscaped, r)
+	params, err := decodeGetSingleplayerRoundParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2170,13 +5322,13 @@ func (s *Server) handleGetMultiplayerRoundRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	var response GetMultiplayerRoundRes
+	var response GetSingleplayerRoundRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetMultiplayerRoundOperation,
-			OperationSummary: "Get multiplayer game round",
-			OperationID:      "getMultiplayerRound",
+			OperationName:    GetSingleplayerRoundOperation,
+			OperationSummary: "Get singleplayer game round",
+			OperationID:      "getSingleplayerRound",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -2189,8 +5341,8 @@ func (s *Server) handleGetMultiplayerRoundRequest(args [1]string, argsEscaped bo
 
 		type (
 			Request  = struct{}
-			Params   = GetMultiplayerRoundParams
-			Response = GetMultiplayerRoundRes
+			Params   = GetSingleplayerRoundParams
+			Response = GetSingleplayerRoundRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2199,14 +5351,14 @@ func (s *Server) handleGetMultiplayerRoundRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetMultiplayerRoundParams,
+			unpackGetSingleplayerRoundParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetMultiplayerRound(ctx, params)
+				response, err = s.h.GetSingleplayerRound(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetMultiplayerRound(ctx, params)
+		response, err = s.h.GetSingleplayerRound(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2214,7 +5366,7 @@ func (s *Server) handleGetMultiplayerRoundRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetMultiplayerRoundResponse(response, w, span); err != nil {
+	if err := encodeGetSingleplayerRoundResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2223,22 +5375,22 @@ func (s *Server) handleGetMultiplayerRoundRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleGetOAuthProvidersRequest handles getOAuthProviders operation.
+// handleGetStreakGameRequest handles getStreakGame operation.
 //
-// Get all connected OAuth providers.
+// Get country streak game information by ID.
 //
-// GET /v1/auth/providers
-func (s *Server) handleGetOAuthProvidersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v1/streak/{id}
+func (s *Server) handleGetStreakGameRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getOAuthProviders"),
+		otelogen.OperationID("getStreakGame"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/auth/providers"),
+		semconv.HTTPRouteKey.String("/v1/streak/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOAuthProvidersOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetStreakGameOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2293,15 +5445,15 @@ func (s *Server) handleGetOAuthProvidersRequest(args [0]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetOAuthProvidersOperation,
-			ID:   "getOAuthProviders",
+			Name: GetStreakGameOperation,
+			ID:   "getStreakGame",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, GetOAuthProvidersOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, GetStreakGameOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2341,23 +5493,38 @@ func (s *Server) handleGetOAuthProvidersRequest(args [0]string, argsEscaped bool
 			return
 		}
 	}
+	params, err := decodeGetStreakGameParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response GetOAuthProvidersRes
+	var response GetStreakGameRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetOAuthProvidersOperation,
-			OperationSummary: "Get all connected OAuth providers",
-			OperationID:      "getOAuthProviders",
+			OperationName:    GetStreakGameOperation,
+			OperationSummary: "Get country streak game by ID",
+			OperationID:      "getStreakGame",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = GetOAuthProvidersRes
+			Params   = GetStreakGameParams
+			Response = GetStreakGameRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2366,14 +5533,14 @@ func (s *Server) handleGetOAuthProvidersRequest(args [0]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetStreakGameParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetOAuthProviders(ctx)
+				response, err = s.h.GetStreakGame(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetOAuthProviders(ctx)
+		response, err = s.h.GetStreakGame(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2381,7 +5548,7 @@ func (s *Server) handleGetOAuthProvidersRequest(args [0]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeGetOAuthProvidersResponse(response, w, span); err != nil {
+	if err := encodeGetStreakGameResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2390,22 +5557,22 @@ func (s *Server) handleGetOAuthProvidersRequest(args [0]string, argsEscaped bool
 	}
 }
 
-// handleGetPrivateProfileRequest handles getPrivateProfile operation.
+// handleGetStreakLeaderboardRequest handles getStreakLeaderboard operation.
 //
-// Retrieve authenticated user profile details.
+// Get best streaks of users, ranked by streak. Players with equal streak share the same place.
 //
-// GET /v1/users/me
-func (s *Server) handleGetPrivateProfileRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v1/streak/leaderboard
+func (s *Server) handleGetStreakLeaderboardRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPrivateProfile"),
+		otelogen.OperationID("getStreakLeaderboard"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/users/me"),
+		semconv.HTTPRouteKey.String("/v1/streak/leaderboard"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPrivateProfileOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetStreakLeaderboardOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2460,71 +5627,46 @@ func (s *Server) handleGetPrivateProfileRequest(args [0]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPrivateProfileOperation,
-			ID:   "getPrivateProfile",
+			Name: GetStreakLeaderboardOperation,
+			ID:   "getStreakLeaderboard",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityBearer(ctx, GetPrivateProfileOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "Bearer",
-					Err:              err,
-				}
-				defer recordError("Security:Bearer", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
+	params, err := decodeGetStreakLeaderboardParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
 		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
 	}
 
-	var response GetPrivateProfileRes
+	var response GetStreakLeaderboardRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPrivateProfileOperation,
-			OperationSummary: "Get current user profile",
-			OperationID:      "getPrivateProfile",
+			OperationName:    GetStreakLeaderboardOperation,
+			OperationSummary: "Get country streak leaderboard",
+			OperationID:      "getStreakLeaderboard",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+				{
+					Name: "page-size",
+					In:   "query",
+				}: params.PageSize,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = GetPrivateProfileRes
+			Params   = GetStreakLeaderboardParams
+			Response = GetStreakLeaderboardRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2533,14 +5675,14 @@ func (s *Server) handleGetPrivateProfileRequest(args [0]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetStreakLeaderboardParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPrivateProfile(ctx)
+				response, err = s.h.GetStreakLeaderboard(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPrivateProfile(ctx)
+		response, err = s.h.GetStreakLeaderboard(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2548,7 +5690,7 @@ func (s *Server) handleGetPrivateProfileRequest(args [0]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeGetPrivateProfileResponse(response, w, span); err != nil {
+	if err := encodeGetStreakLeaderboardResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2557,22 +5699,22 @@ func (s *Server) handleGetPrivateProfileRequest(args [0]string, argsEscaped bool
 	}
 }
 
-// handleGetPublicProfileRequest handles getPublicProfile operation.
+// handleGetStreakRoundRequest handles getStreakRound operation.
 //
-// Retrieve public user profile by ID.
+// Get current round of country streak game.
 //
-// GET /v1/users/{id}
-func (s *Server) handleGetPublicProfileRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v1/streak/{id}/round
+func (s *Server) handleGetStreakRoundRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPublicProfile"),
+		otelogen.OperationID("getStreakRound"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/users/{id}"),
+		semconv.HTTPRouteKey.String("/v1/streak/{id}/round"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPublicProfileOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetStreakRoundOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2627,15 +5769,15 @@ func (s *Server) handleGetPublicProfileRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPublicProfileOperation,
-			ID:   "getPublicProfile",
+			Name: GetStreakRoundOperation,
+			ID:   "getStreakRound",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, GetPublicProfileOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, GetStreakRoundOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2675,7 +5817,7 @@ func (s *Server) handleGetPublicProfileRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeGetPublicProfileParams(args, argsEscaped, r)
+	params, err := decodeGetStreakRoundParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2686,13 +5828,13 @@ func (s *Server) handleGetPublicProfileRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	var response GetPublicProfileRes
+	var response GetStreakRoundRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPublicProfileOperation,
-			OperationSummary: "Get user by ID",
-			OperationID:      "getPublicProfile",
+			OperationName:    GetStreakRoundOperation,
+			OperationSummary: "Get country streak game round",
+			OperationID:      "getStreakRound",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -2705,8 +5847,8 @@ func (s *Server) handleGetPublicProfileRequest(args [1]string, argsEscaped bool,
 
 		type (
 			Request  = struct{}
-			Params   = GetPublicProfileParams
-			Response = GetPublicProfileRes
+			Params   = GetStreakRoundParams
+			Response = GetStreakRoundRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2715,14 +5857,14 @@ func (s *Server) handleGetPublicProfileRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetPublicProfileParams,
+			unpackGetStreakRoundParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPublicProfile(ctx, params)
+				response, err = s.h.GetStreakRound(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPublicProfile(ctx, params)
+		response, err = s.h.GetStreakRound(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2730,7 +5872,7 @@ func (s *Server) handleGetPublicProfileRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetPublicProfileResponse(response, w, span); err != nil {
+	if err := encodeGetStreakRoundResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2739,22 +5881,22 @@ func (s *Server) handleGetPublicProfileRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetRootRequest handles getRoot operation.
+// handleGetUserSessionsRequest handles getUserSessions operation.
 //
-// Redirect to documentation page.
+// Get user sessions associated with the authenticated user.
 //
-// GET /
-func (s *Server) handleGetRootRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v1/auth/sessions
+func (s *Server) handleGetUserSessionsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRoot"),
+		otelogen.OperationID("getUserSessions"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/"),
+		semconv.HTTPRouteKey.String("/v1/auth/sessions"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetRootOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserSessionsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2807,16 +5949,64 @@ func (s *Server) handleGetRootRequest(args [0]string, argsEscaped bool, w http.R
 
 			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUserSessionsOperation,
+			ID:   "getUserSessions",
+		}
 	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, GetUserSessionsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
 
-	var response *GetRootFound
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response GetUserSessionsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetRootOperation,
-			OperationSummary: "Redirect to documentation",
-			OperationID:      "getRoot",
+			OperationName:    GetUserSessionsOperation,
+			OperationSummary: "User sessions",
+			OperationID:      "getUserSessions",
 			Body:             nil,
 			Params:           middleware.Parameters{},
 			Raw:              r,
@@ -2825,7 +6015,7 @@ func (s *Server) handleGetRootRequest(args [0]string, argsEscaped bool, w http.R
 		type (
 			Request  = struct{}
 			Params   = struct{}
-			Response = *GetRootFound
+			Response = GetUserSessionsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2836,12 +6026,12 @@ func (s *Server) handleGetRootRequest(args [0]string, argsEscaped bool, w http.R
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRoot(ctx)
+				response, err = s.h.GetUserSessions(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRoot(ctx)
+		response, err = s.h.GetUserSessions(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2849,7 +6039,7 @@ func (s *Server) handleGetRootRequest(args [0]string, argsEscaped bool, w http.R
 		return
 	}
 
-	if err := encodeGetRootResponse(response, w, span); err != nil {
+	if err := encodeGetUserSessionsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2858,22 +6048,22 @@ func (s *Server) handleGetRootRequest(args [0]string, argsEscaped bool, w http.R
 	}
 }
 
-// handleGetSingleplayerGameRequest handles getSingleplayerGame operation.
+// handleImportMapLocationsRequest handles importMapLocations operation.
 //
-// Get singleplayer game information by ID.
+// Import locations from CSV or GeoJSON to a map created by the authenticated user.
 //
-// GET /v1/singleplayer/{id}
-func (s *Server) handleGetSingleplayerGameRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/maps/{id}/import
+func (s *Server) handleImportMapLocationsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getSingleplayerGame"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}"),
+		otelogen.OperationID("importMapLocations"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/maps/{id}/import"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetSingleplayerGameOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ImportMapLocationsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2928,15 +6118,15 @@ func (s *Server) handleGetSingleplayerGameRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetSingleplayerGameOperation,
-			ID:   "getSingleplayerGame",
+			Name: ImportMapLocationsOperation,
+			ID:   "importMapLocations",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, GetSingleplayerGameOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, ImportMapLocationsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2976,7 +6166,7 @@ func (s *Server) handleGetSingleplayerGameRequest(args [1]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeGetSingleplayerGameParams(args, argsEscaped, r)
+	params, err := decodeImportMapLocationsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2986,15 +6176,30 @@ func (s *Server) handleGetSingleplayerGameRequest(args [1]string, argsEscaped bo
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeImportMapLocationsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetSingleplayerGameRes
+	var response ImportMapLocationsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetSingleplayerGameOperation,
-			OperationSummary: "Get singleplayer game by ID",
-			OperationID:      "getSingleplayerGame",
-			Body:             nil,
+			OperationName:    ImportMapLocationsOperation,
+			OperationSummary: "Import locations to map",
+			OperationID:      "importMapLocations",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "id",
@@ -3005,9 +6210,9 @@ func (s *Server) handleGetSingleplayerGameRequest(args [1]string, argsEscaped bo
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetSingleplayerGameParams
-			Response = GetSingleplayerGameRes
+			Request  = *ImportMapLocationsRequest
+			Params   = ImportMapLocationsParams
+			Response = ImportMapLocationsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3016,14 +6221,14 @@ func (s *Server) handleGetSingleplayerGameRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetSingleplayerGameParams,
+			unpackImportMapLocationsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetSingleplayerGame(ctx, params)
+				response, err = s.h.ImportMapLocations(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetSingleplayerGame(ctx, params)
+		response, err = s.h.ImportMapLocations(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3031,7 +6236,7 @@ func (s *Server) handleGetSingleplayerGameRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetSingleplayerGameResponse(response, w, span); err != nil {
+	if err := encodeImportMapLocationsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3040,22 +6245,22 @@ func (s *Server) handleGetSingleplayerGameRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleGetSingleplayerGameRoundsRequest handles getSingleplayerGameRounds operation.
+// handleLoginRequest handles login operation.
 //
-// Get singleplayer game rounds.
+// Log in using username and password.
 //
-// GET /v1/singleplayer/{id}/rounds
-func (s *Server) handleGetSingleplayerGameRoundsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/auth/login
+func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getSingleplayerGameRounds"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}/rounds"),
+		otelogen.OperationID("login"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/auth/login"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetSingleplayerGameRoundsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), LoginOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3110,55 +6315,11 @@ func (s *Server) handleGetSingleplayerGameRoundsRequest(args [1]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetSingleplayerGameRoundsOperation,
-			ID:   "getSingleplayerGameRounds",
+			Name: LoginOperation,
+			ID:   "login",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityBearer(ctx, GetSingleplayerGameRoundsOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "Bearer",
-					Err:              err,
-				}
-				defer recordError("Security:Bearer", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeGetSingleplayerGameRoundsParams(args, argsEscaped, r)
+	params, err := decodeLoginParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3168,28 +6329,47 @@ func (s *Server) handleGetSingleplayerGameRoundsRequest(args [1]string, argsEsca
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeLoginRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetSingleplayerGameRoundsRes
+	var response LoginRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetSingleplayerGameRoundsOperation,
-			OperationSummary: "Get singleplayer game rounds",
-			OperationID:      "getSingleplayerGameRounds",
-			Body:             nil,
+			OperationName:    LoginOperation,
+			OperationSummary: "Login user",
+			OperationID:      "login",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
+					Name: "User-Agent",
+					In:   "header",
+				}: params.UserAgent,
+				{
+					Name: "X-Captcha-Token",
+					In:   "header",
+				}: params.XCaptchaToken,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetSingleplayerGameRoundsParams
-			Response = GetSingleplayerGameRoundsRes
+			Request  = *LoginRequest
+			Params   = LoginParams
+			Response = LoginRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3198,14 +6378,14 @@ func (s *Server) handleGetSingleplayerGameRoundsRequest(args [1]string, argsEsca
 		](
 			m,
 			mreq,
-			unpackGetSingleplayerGameRoundsParams,
+			unpackLoginParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetSingleplayerGameRounds(ctx, params)
+				response, err = s.h.Login(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetSingleplayerGameRounds(ctx, params)
+		response, err = s.h.Login(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3213,7 +6393,7 @@ func (s *Server) handleGetSingleplayerGameRoundsRequest(args [1]string, argsEsca
 		return
 	}
 
-	if err := encodeGetSingleplayerGameRoundsResponse(response, w, span); err != nil {
+	if err := encodeLoginResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3222,22 +6402,22 @@ func (s *Server) handleGetSingleplayerGameRoundsRequest(args [1]string, argsEsca
 	}
 }
 
-// handleGetSingleplayerGamesRequest handles getSingleplayerGames operation.
+// handleNewDiscordRequest handles newDiscord operation.
 //
-// Get all singleplayer user games.
+// Create Discord OAuth connection.
 //
-// GET /v1/singleplayer
-func (s *Server) handleGetSingleplayerGamesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v1/auth/discord/new
+func (s *Server) handleNewDiscordRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getSingleplayerGames"),
+		otelogen.OperationID("newDiscord"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/singleplayer"),
+		semconv.HTTPRouteKey.String("/v1/auth/discord/new"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetSingleplayerGamesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), NewDiscordOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3292,15 +6472,15 @@ func (s *Server) handleGetSingleplayerGamesRequest(args [0]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetSingleplayerGamesOperation,
-			ID:   "getSingleplayerGames",
+			Name: NewDiscordOperation,
+			ID:   "newDiscord",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, GetSingleplayerGamesOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, NewDiscordOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3340,42 +6520,23 @@ func (s *Server) handleGetSingleplayerGamesRequest(args [0]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeGetSingleplayerGamesParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response GetSingleplayerGamesRes
+	var response NewDiscordRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetSingleplayerGamesOperation,
-			OperationSummary: "Get all singleplayer user games",
-			OperationID:      "getSingleplayerGames",
+			OperationName:    NewDiscordOperation,
+			OperationSummary: "New Discord auth",
+			OperationID:      "newDiscord",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "page",
-					In:   "query",
-				}: params.Page,
-				{
-					Name: "page-size",
-					In:   "query",
-				}: params.PageSize,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetSingleplayerGamesParams
-			Response = GetSingleplayerGamesRes
+			Params   = struct{}
+			Response = NewDiscordRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3384,14 +6545,14 @@ func (s *Server) handleGetSingleplayerGamesRequest(args [0]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackGetSingleplayerGamesParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetSingleplayerGames(ctx, params)
+				response, err = s.h.NewDiscord(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetSingleplayerGames(ctx, params)
+		response, err = s.h.NewDiscord(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3399,7 +6560,7 @@ func (s *Server) handleGetSingleplayerGamesRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetSingleplayerGamesResponse(response, w, span); err != nil {
+	if err := encodeNewDiscordResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3408,22 +6569,22 @@ func (s *Server) handleGetSingleplayerGamesRequest(args [0]string, argsEscaped b
 	}
 }
 
-// handleGetSingleplayerRoundRequest handles getSingleplayerRound operation.
+// handleNewDiscordCallbackRequest handles newDiscordCallback operation.
 //
-// Get singleplayer game round.
+// A callback for new Discord OAuth.
 //
-// GET /v1/singleplayer/{id}/round
-func (s *Server) handleGetSingleplayerRoundRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v1/auth/discord/new/callback
+func (s *Server) handleNewDiscordCallbackRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getSingleplayerRound"),
+		otelogen.OperationID("newDiscordCallback"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}/round"),
+		semconv.HTTPRouteKey.String("/v1/auth/discord/new/callback"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetSingleplayerRoundOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), NewDiscordCallbackOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3478,55 +6639,11 @@ func (s *Server) handleGetSingleplayerRoundRequest(args [1]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetSingleplayerRoundOperation,
-			ID:   "getSingleplayerRound",
+			Name: NewDiscordCallbackOperation,
+			ID:   "newDiscordCallback",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityBearer(ctx, GetSingleplayerRoundOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "Bearer",
-					Err:              err,
-				}
-				defer recordError("Security:Bearer", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeGetSingleplayerRoundParams(args, argsEscaped, r)
+	params, err := decodeNewDiscordCallbackParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3537,27 +6654,35 @@ func (s *Server) handleGetSingleplayerRoundRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	var response GetSingleplayerRoundRes
+	var response NewDiscordCallbackRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetSingleplayerRoundOperation,
-			OperationSummary: "Get singleplayer game round",
-			OperationID:      "getSingleplayerRound",
+			OperationName:    NewDiscordCallbackOperation,
+			OperationSummary: "Discord new auth callback",
+			OperationID:      "newDiscordCallback",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
+					Name: "Cookie",
+					In:   "header",
+				}: params.Cookie,
+				{
+					Name: "code",
+					In:   "query",
+				}: params.Code,
+				{
+					Name: "state",
+					In:   "query",
+				}: params.State,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetSingleplayerRoundParams
-			Response = GetSingleplayerRoundRes
+			Params   = NewDiscordCallbackParams
+			Response = NewDiscordCallbackRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3566,14 +6691,14 @@ func (s *Server) handleGetSingleplayerRoundRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackGetSingleplayerRoundParams,
+			unpackNewDiscordCallbackParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetSingleplayerRound(ctx, params)
+				response, err = s.h.NewDiscordCallback(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetSingleplayerRound(ctx, params)
+		response, err = s.h.NewDiscordCallback(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3581,7 +6706,7 @@ func (s *Server) handleGetSingleplayerRoundRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetSingleplayerRoundResponse(response, w, span); err != nil {
+	if err := encodeNewDiscordCallbackResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3590,22 +6715,22 @@ func (s *Server) handleGetSingleplayerRoundRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleGetUserSessionsRequest handles getUserSessions operation.
+// handleNewLobbyRequest handles newLobby operation.
 //
-// Get user sessions associated with the authenticated user.
+// Create new lobby with specified parameters.
 //
-// GET /v1/auth/sessions
-func (s *Server) handleGetUserSessionsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/lobbies
+func (s *Server) handleNewLobbyRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUserSessions"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/auth/sessions"),
+		otelogen.OperationID("newLobby"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/lobbies"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserSessionsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), NewLobbyOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3660,15 +6785,15 @@ func (s *Server) handleGetUserSessionsRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetUserSessionsOperation,
-			ID:   "getUserSessions",
+			Name: NewLobbyOperation,
+			ID:   "newLobby",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, GetUserSessionsOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, NewLobbyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3708,23 +6833,38 @@ func (s *Server) handleGetUserSessionsRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
+	request, close, err := s.decodeNewLobbyRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetUserSessionsRes
+	var response NewLobbyRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUserSessionsOperation,
-			OperationSummary: "User sessions",
-			OperationID:      "getUserSessions",
-			Body:             nil,
+			OperationName:    NewLobbyOperation,
+			OperationSummary: "Create new lobby",
+			OperationID:      "newLobby",
+			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
+			Request  = *NewLobby
 			Params   = struct{}
-			Response = GetUserSessionsRes
+			Response = NewLobbyRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3735,12 +6875,12 @@ func (s *Server) handleGetUserSessionsRequest(args [0]string, argsEscaped bool,
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUserSessions(ctx)
+				response, err = s.h.NewLobby(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUserSessions(ctx)
+		response, err = s.h.NewLobby(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3748,7 +6888,7 @@ func (s *Server) handleGetUserSessionsRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetUserSessionsResponse(response, w, span); err != nil {
+	if err := encodeNewLobbyResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3757,22 +6897,22 @@ func (s *Server) handleGetUserSessionsRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleLoginRequest handles login operation.
+// handleNewMapRequest handles newMap operation.
 //
-// Log in using username and password.
+// Create new map, optionally with existing panorama locations of the provider.
 //
-// POST /v1/auth/login
-func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/maps
+func (s *Server) handleNewMapRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("login"),
+		otelogen.OperationID("newMap"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v1/auth/login"),
+		semconv.HTTPRouteKey.String("/v1/maps"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), LoginOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), NewMapOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3816,32 +6956,66 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 			if code >= 100 && code < 500 {
 				span.SetStatus(codes.Error, stage)
 			}
-
-			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
-			if code != 0 {
-				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: NewMapOperation,
+			ID:   "newMap",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, NewMapOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
 			}
-
-			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
-		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: LoginOperation,
-			ID:   "login",
 		}
-	)
-	params, err := decodeLoginParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
 		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
 	}
-	request, close, err := s.decodeLoginRequest(r)
+	request, close, err := s.decodeNewMapRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -3857,31 +7031,22 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 		}
 	}()
 
-	var response LoginRes
+	var response NewMapRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    LoginOperation,
-			OperationSummary: "Login user",
-			OperationID:      "login",
+			OperationName:    NewMapOperation,
+			OperationSummary: "Create new map",
+			OperationID:      "newMap",
 			Body:             request,
-			Params: middleware.Parameters{
-				{
-					Name: "User-Agent",
-					In:   "header",
-				}: params.UserAgent,
-				{
-					Name: "X-Captcha-Token",
-					In:   "header",
-				}: params.XCaptchaToken,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = *LoginRequest
-			Params   = LoginParams
-			Response = LoginRes
+			Request  = *NewMapRequest
+			Params   = struct{}
+			Response = NewMapRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3890,14 +7055,14 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 		](
 			m,
 			mreq,
-			unpackLoginParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.Login(ctx, request, params)
+				response, err = s.h.NewMap(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.Login(ctx, request, params)
+		response, err = s.h.NewMap(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3905,7 +7070,7 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 		return
 	}
 
-	if err := encodeLoginResponse(response, w, span); err != nil {
+	if err := encodeNewMapResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3914,22 +7079,22 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 	}
 }
 
-// handleNewDiscordRequest handles newDiscord operation.
+// handleNewMultiplayerRoundRequest handles newMultiplayerRound operation.
 //
-// Create Discord OAuth connection.
+// Get or generate multiplayer game round.
 //
-// GET /v1/auth/discord/new
-func (s *Server) handleNewDiscordRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/multiplayer/{id}/round
+func (s *Server) handleNewMultiplayerRoundRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("newDiscord"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/auth/discord/new"),
+		otelogen.OperationID("newMultiplayerRound"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/multiplayer/{id}/round"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), NewDiscordOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), NewMultiplayerRoundOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3984,15 +7149,15 @@ func (s *Server) handleNewDiscordRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: NewDiscordOperation,
-			ID:   "newDiscord",
+			Name: NewMultiplayerRoundOperation,
+			ID:   "newMultiplayerRound",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, NewDiscordOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, NewMultiplayerRoundOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4032,23 +7197,38 @@ func (s *Server) handleNewDiscordRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
+	params, err := decodeNewMultiplayerRoundParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response NewDiscordRes
+	var response NewMultiplayerRoundRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    NewDiscordOperation,
-			OperationSummary: "New Discord auth",
-			OperationID:      "newDiscord",
+			OperationName:    NewMultiplayerRoundOperation,
+			OperationSummary: "Get or generate multiplayer game round",
+			OperationID:      "newMultiplayerRound",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = NewDiscordRes
+			Params   = NewMultiplayerRoundParams
+			Response = NewMultiplayerRoundRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4057,14 +7237,14 @@ func (s *Server) handleNewDiscordRequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			nil,
+			unpackNewMultiplayerRoundParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.NewDiscord(ctx)
+				response, err = s.h.NewMultiplayerRound(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.NewDiscord(ctx)
+		response, err = s.h.NewMultiplayerRound(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4072,7 +7252,7 @@ func (s *Server) handleNewDiscordRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeNewDiscordResponse(response, w, span); err != nil {
+	if err := encodeNewMultiplayerRoundResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4081,22 +7261,24 @@ func (s *Server) handleNewDiscordRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleNewDiscordCallbackRequest handles newDiscordCallback operation.
+// handleNewSingleplayerChallengeRequest handles newSingleplayerChallenge operation.
 //
-// A callback for new Discord OAuth.
+// Create a shareable challenge, that lets other players replay locations of a finished game with the
+// same settings. Returns the existing challenge if it was already created. Daily challenge games
+// can't be shared.
 //
-// GET /v1/auth/discord/new/callback
-func (s *Server) handleNewDiscordCallbackRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/singleplayer/{id}/challenge
+func (s *Server) handleNewSingleplayerChallengeRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("newDiscordCallback"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/auth/discord/new/callback"),
+		otelogen.OperationID("newSingleplayerChallenge"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}/challenge"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), NewDiscordCallbackOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), NewSingleplayerChallengeOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4151,11 +7333,55 @@ func (s *Server) handleNewDiscordCallbackRequest(args [0]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: NewDiscordCallbackOperation,
-			ID:   "newDiscordCallback",
+			Name: NewSingleplayerChallengeOperation,
+			ID:   "newSingleplayerChallenge",
 		}
 	)
-	params, err := decodeNewDiscordCallbackParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, NewSingleplayerChallengeOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeNewSingleplayerChallengeParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4166,35 +7392,27 @@ func (s *Server) handleNewDiscordCallbackRequest(args [0]string, argsEscaped boo
 		return
 	}
 
-	var response NewDiscordCallbackRes
+	var response NewSingleplayerChallengeRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    NewDiscordCallbackOperation,
-			OperationSummary: "Discord new auth callback",
-			OperationID:      "newDiscordCallback",
+			OperationName:    NewSingleplayerChallengeOperation,
+			OperationSummary: "Create challenge from singleplayer game",
+			OperationID:      "newSingleplayerChallenge",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "Cookie",
-					In:   "header",
-				}: params.Cookie,
-				{
-					Name: "code",
-					In:   "query",
-				}: params.Code,
-				{
-					Name: "state",
-					In:   "query",
-				}: params.State,
+					Name: "id",
+					In:   "path",
+				}: params.ID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = NewDiscordCallbackParams
-			Response = NewDiscordCallbackRes
+			Params   = NewSingleplayerChallengeParams
+			Response = NewSingleplayerChallengeRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4203,14 +7421,14 @@ func (s *Server) handleNewDiscordCallbackRequest(args [0]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackNewDiscordCallbackParams,
+			unpackNewSingleplayerChallengeParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.NewDiscordCallback(ctx, params)
+				response, err = s.h.NewSingleplayerChallenge(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.NewDiscordCallback(ctx, params)
+		response, err = s.h.NewSingleplayerChallenge(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4218,7 +7436,7 @@ func (s *Server) handleNewDiscordCallbackRequest(args [0]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeNewDiscordCallbackResponse(response, w, span); err != nil {
+	if err := encodeNewSingleplayerChallengeResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4227,22 +7445,22 @@ func (s *Server) handleNewDiscordCallbackRequest(args [0]string, argsEscaped boo
 	}
 }
 
-// handleNewLobbyRequest handles newLobby operation.
+// handleNewSingleplayerGameRequest handles newSingleplayerGame operation.
 //
-// Create new lobby with specified parameters.
+// Create new singleplayer game.
 //
-// POST /v1/lobbies
-func (s *Server) handleNewLobbyRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/singleplayer
+func (s *Server) handleNewSingleplayerGameRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("newLobby"),
+		otelogen.OperationID("newSingleplayerGame"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v1/lobbies"),
+		semconv.HTTPRouteKey.String("/v1/singleplayer"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), NewLobbyOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), NewSingleplayerGameOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4297,15 +7515,15 @@ func (s *Server) handleNewLobbyRequest(args [0]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: NewLobbyOperation,
-			ID:   "newLobby",
+			Name: NewSingleplayerGameOperation,
+			ID:   "newSingleplayerGame",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, NewLobbyOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, NewSingleplayerGameOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4345,7 +7563,7 @@ func (s *Server) handleNewLobbyRequest(args [0]string, argsEscaped bool, w http.
 			return
 		}
 	}
-	request, close, err := s.decodeNewLobbyRequest(r)
+	request, close, err := s.decodeNewSingleplayerGameRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -4361,22 +7579,22 @@ func (s *Server) handleNewLobbyRequest(args [0]string, argsEscaped bool, w http.
 		}
 	}()
 
-	var response NewLobbyRes
+	var response NewSingleplayerGameRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    NewLobbyOperation,
-			OperationSummary: "Create new lobby",
-			OperationID:      "newLobby",
+			OperationName:    NewSingleplayerGameOperation,
+			OperationSummary: "Create new singleplayer game",
+			OperationID:      "newSingleplayerGame",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *NewLobby
+			Request  = *NewSingleplayerGameRequest
 			Params   = struct{}
-			Response = NewLobbyRes
+			Response = NewSingleplayerGameRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4387,12 +7605,12 @@ func (s *Server) handleNewLobbyRequest(args [0]string, argsEscaped bool, w http.
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.NewLobby(ctx, request)
+				response, err = s.h.NewSingleplayerGame(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.NewLobby(ctx, request)
+		response, err = s.h.NewSingleplayerGame(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4400,7 +7618,7 @@ func (s *Server) handleNewLobbyRequest(args [0]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeNewLobbyResponse(response, w, span); err != nil {
+	if err := encodeNewSingleplayerGameResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4409,22 +7627,22 @@ func (s *Server) handleNewLobbyRequest(args [0]string, argsEscaped bool, w http.
 	}
 }
 
-// handleNewMultiplayerRoundRequest handles newMultiplayerRound operation.
+// handleNewSingleplayerRoundRequest handles newSingleplayerRound operation.
 //
-// Get or generate multiplayer game round.
+// Create singleplayer game round.
 //
-// POST /v1/multiplayer/{id}/round
-func (s *Server) handleNewMultiplayerRoundRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/singleplayer/{id}/round
+func (s *Server) handleNewSingleplayerRoundRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("newMultiplayerRound"),
+		otelogen.OperationID("newSingleplayerRound"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v1/multiplayer/{id}/round"),
+		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}/round"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), NewMultiplayerRoundOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), NewSingleplayerRoundOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4479,15 +7697,15 @@ func (s *Server) handleNewMultiplayerRoundRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: NewMultiplayerRoundOperation,
-			ID:   "newMultiplayerRound",
+			Name: NewSingleplayerRoundOperation,
+			ID:   "newSingleplayerRound",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, NewMultiplayerRoundOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, NewSingleplayerRoundOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4527,7 +7745,7 @@ func (s *Server) handleNewMultiplayerRoundRequest(args [1]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeNewMultiplayerRoundParams(args, argsEscaped, r)
+	params, err := decodeNewSingleplayerRoundParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4538,13 +7756,13 @@ func (s *Server) handleNewMultiplayerRoundRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	var response NewMultiplayerRoundRes
+	var response NewSingleplayerRoundRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    NewMultiplayerRoundOperation,
-			OperationSummary: "Get or generate multiplayer game round",
-			OperationID:      "newMultiplayerRound",
+			OperationName:    NewSingleplayerRoundOperation,
+			OperationSummary: "Create singleplayer game round",
+			OperationID:      "newSingleplayerRound",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -4557,8 +7775,8 @@ func (s *Server) handleNewMultiplayerRoundRequest(args [1]string, argsEscaped bo
 
 		type (
 			Request  = struct{}
-			Params   = NewMultiplayerRoundParams
-			Response = NewMultiplayerRoundRes
+			Params   = NewSingleplayerRoundParams
+			Response = NewSingleplayerRoundRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4567,14 +7785,14 @@ func (s *Server) handleNewMultiplayerRoundRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackNewMultiplayerRoundParams,
+			unpackNewSingleplayerRoundParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.NewMultiplayerRound(ctx, params)
+				response, err = s.h.NewSingleplayerRound(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.NewMultiplayerRound(ctx, params)
+		response, err = s.h.NewSingleplayerRound(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4582,7 +7800,7 @@ func (s *Server) handleNewMultiplayerRoundRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeNewMultiplayerRoundResponse(response, w, span); err != nil {
+	if err := encodeNewSingleplayerRoundResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4591,22 +7809,22 @@ func (s *Server) handleNewMultiplayerRoundRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleNewSingleplayerGameRequest handles newSingleplayerGame operation.
+// handleNewStreakGameRequest handles newStreakGame operation.
 //
-// Create new singleplayer game.
+// Create new country streak game, that continues until the first wrongly guessed country.
 //
-// POST /v1/singleplayer
-func (s *Server) handleNewSingleplayerGameRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/streak
+func (s *Server) handleNewStreakGameRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("newSingleplayerGame"),
+		otelogen.OperationID("newStreakGame"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v1/singleplayer"),
+		semconv.HTTPRouteKey.String("/v1/streak"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), NewSingleplayerGameOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), NewStreakGameOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4661,15 +7879,15 @@ func (s *Server) handleNewSingleplayerGameRequest(args [0]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: NewSingleplayerGameOperation,
-			ID:   "newSingleplayerGame",
+			Name: NewStreakGameOperation,
+			ID:   "newStreakGame",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, NewSingleplayerGameOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, NewStreakGameOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4709,7 +7927,7 @@ func (s *Server) handleNewSingleplayerGameRequest(args [0]string, argsEscaped bo
 			return
 		}
 	}
-	request, close, err := s.decodeNewSingleplayerGameRequest(r)
+	request, close, err := s.decodeNewStreakGameRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -4725,22 +7943,22 @@ func (s *Server) handleNewSingleplayerGameRequest(args [0]string, argsEscaped bo
 		}
 	}()
 
-	var response NewSingleplayerGameRes
+	var response NewStreakGameRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    NewSingleplayerGameOperation,
-			OperationSummary: "Create new singleplayer game",
-			OperationID:      "newSingleplayerGame",
+			OperationName:    NewStreakGameOperation,
+			OperationSummary: "Create new country streak game",
+			OperationID:      "newStreakGame",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *NewSingleplayerGameRequest
+			Request  = *NewStreakGameRequest
 			Params   = struct{}
-			Response = NewSingleplayerGameRes
+			Response = NewStreakGameRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4751,12 +7969,12 @@ func (s *Server) handleNewSingleplayerGameRequest(args [0]string, argsEscaped bo
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.NewSingleplayerGame(ctx, request)
+				response, err = s.h.NewStreakGame(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.NewSingleplayerGame(ctx, request)
+		response, err = s.h.NewStreakGame(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4764,7 +7982,7 @@ func (s *Server) handleNewSingleplayerGameRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeNewSingleplayerGameResponse(response, w, span); err != nil {
+	if err := encodeNewStreakGameResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4773,22 +7991,22 @@ func (s *Server) handleNewSingleplayerGameRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleNewSingleplayerRoundRequest handles newSingleplayerRound operation.
+// handleNewStreakRoundRequest handles newStreakRound operation.
 //
-// Create singleplayer game round.
+// Create next round of country streak game, current round is returned if it's not finished.
 //
-// POST /v1/singleplayer/{id}/round
-func (s *Server) handleNewSingleplayerRoundRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/streak/{id}/round
+func (s *Server) handleNewStreakRoundRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("newSingleplayerRound"),
+		otelogen.OperationID("newStreakRound"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v1/singleplayer/{id}/round"),
+		semconv.HTTPRouteKey.String("/v1/streak/{id}/round"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), NewSingleplayerRoundOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), NewStreakRoundOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4843,15 +8061,15 @@ func (s *Server) handleNewSingleplayerRoundRequest(args [1]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: NewSingleplayerRoundOperation,
-			ID:   "newSingleplayerRound",
+			Name: NewStreakRoundOperation,
+			ID:   "newStreakRound",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearer(ctx, NewSingleplayerRoundOperation, r)
+			sctx, ok, err := s.securityBearer(ctx, NewStreakRoundOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4891,7 +8109,7 @@ func (s *Server) handleNewSingleplayerRoundRequest(args [1]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeNewSingleplayerRoundParams(args, argsEscaped, r)
+	params, err := decodeNewStreakRoundParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4902,13 +8120,13 @@ func (s *Server) handleNewSingleplayerRoundRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	var response NewSingleplayerRoundRes
+	var response NewStreakRoundRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    NewSingleplayerRoundOperation,
-			OperationSummary: "Create singleplayer game round",
-			OperationID:      "newSingleplayerRound",
+			OperationName:    NewStreakRoundOperation,
+			OperationSummary: "Create country streak game round",
+			OperationID:      "newStreakRound",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -4921,8 +8139,8 @@ func (s *Server) handleNewSingleplayerRoundRequest(args [1]string, argsEscaped b
 
 		type (
 			Request  = struct{}
-			Params   = NewSingleplayerRoundParams
-			Response = NewSingleplayerRoundRes
+			Params   = NewStreakRoundParams
+			Response = NewStreakRoundRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4931,14 +8149,14 @@ func (s *Server) handleNewSingleplayerRoundRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackNewSingleplayerRoundParams,
+			unpackNewStreakRoundParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.NewSingleplayerRound(ctx, params)
+				response, err = s.h.NewStreakRound(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.NewSingleplayerRound(ctx, params)
+		response, err = s.h.NewStreakRound(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4946,7 +8164,7 @@ func (s *Server) handleNewSingleplayerRoundRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeNewSingleplayerRoundResponse(response, w, span); err != nil {
+	if err := encodeNewStreakRoundResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5559,6 +8777,203 @@ func (s *Server) handleRegisterRequest(args [0]string, argsEscaped bool, w http.
 	}
 }
 
+// handleUpdateMapRequest handles updateMap operation.
+//
+// Update name, description and visibility of a map created by the authenticated user.
+//
+// PATCH /v1/maps/{id}
+func (s *Server) handleUpdateMapRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("updateMap"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/v1/maps/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateMapOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UpdateMapOperation,
+			ID:   "updateMap",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearer(ctx, UpdateMapOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "Bearer",
+					Err:              err,
+				}
+				defer recordError("Security:Bearer", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeUpdateMapParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUpdateMapRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response UpdateMapRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UpdateMapOperation,
+			OperationSummary: "Update map",
+			OperationID:      "updateMap",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *UpdateMapRequest
+			Params   = UpdateMapParams
+			Response = UpdateMapRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUpdateMapParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UpdateMap(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UpdateMap(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeUpdateMapResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
 // handleUpdateUserRequest handles updateUser operation.
 //
 // Update authenticated user's profile information.
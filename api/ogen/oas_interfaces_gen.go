@@ -1,6 +1,14 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type AcceptSingleplayerChallengeRes interface {
+	acceptSingleplayerChallengeRes()
+}
+
+type AddMapLocationsRes interface {
+	addMapLocationsRes()
+}
+
 type DeleteDiscordRes interface {
 	deleteDiscordRes()
 }
@@ -25,6 +33,18 @@ type EndSingleplayerRoundRes interface {
 	endSingleplayerRoundRes()
 }
 
+type EndStreakRoundRes interface {
+	endStreakRoundRes()
+}
+
+type GetBestStreakRes interface {
+	getBestStreakRes()
+}
+
+type GetDailyChallengeLeaderboardRes interface {
+	getDailyChallengeLeaderboardRes()
+}
+
 type GetLobbiesRes interface {
 	getLobbiesRes()
 }
@@ -33,6 +53,14 @@ type GetLobbyRes interface {
 	getLobbyRes()
 }
 
+type GetMapRes interface {
+	getMapRes()
+}
+
+type GetMapsRes interface {
+	getMapsRes()
+}
+
 type GetMultiplayerGameGuessesRes interface {
 	getMultiplayerGameGuessesRes()
 }
@@ -49,6 +77,14 @@ type GetOAuthProvidersRes interface {
 	getOAuthProvidersRes()
 }
 
+type GetPanoramaProvidersRes interface {
+	getPanoramaProvidersRes()
+}
+
+type GetPanoramaRes interface {
+	getPanoramaRes()
+}
+
 type GetPrivateProfileRes interface {
 	getPrivateProfileRes()
 }
@@ -57,6 +93,10 @@ type GetPublicProfileRes interface {
 	getPublicProfileRes()
 }
 
+type GetSingleplayerChallengeRes interface {
+	getSingleplayerChallengeRes()
+}
+
 type GetSingleplayerGameRes interface {
 	getSingleplayerGameRes()
 }
@@ -73,10 +113,26 @@ type GetSingleplayerRoundRes interface {
 	getSingleplayerRoundRes()
 }
 
+type GetStreakGameRes interface {
+	getStreakGameRes()
+}
+
+type GetStreakLeaderboardRes interface {
+	getStreakLeaderboardRes()
+}
+
+type GetStreakRoundRes interface {
+	getStreakRoundRes()
+}
+
 type GetUserSessionsRes interface {
 	getUserSessionsRes()
 }
 
+type ImportMapLocationsRes interface {
+	importMapLocationsRes()
+}
+
 type LoginRes interface {
 	loginRes()
 }
@@ -93,10 +149,18 @@ type NewLobbyRes interface {
 	newLobbyRes()
 }
 
+type NewMapRes interface {
+	newMapRes()
+}
+
 type NewMultiplayerRoundRes interface {
 	newMultiplayerRoundRes()
 }
 
+type NewSingleplayerChallengeRes interface {
+	newSingleplayerChallengeRes()
+}
+
 type NewSingleplayerGameRes interface {
 	newSingleplayerGameRes()
 }
@@ -105,6 +169,14 @@ type NewSingleplayerRoundRes interface {
 	newSingleplayerRoundRes()
 }
 
+type NewStreakGameRes interface {
+	newStreakGameRes()
+}
+
+type NewStreakRoundRes interface {
+	newStreakRoundRes()
+}
+
 type NewYandexCallbackRes interface {
 	newYandexCallbackRes()
 }
@@ -121,6 +193,10 @@ type RegisterRes interface {
 	registerRes()
 }
 
+type UpdateMapRes interface {
+	updateMapRes()
+}
+
 type UpdateUserAvatarRes interface {
 	updateUserAvatarRes()
 }
@@ -5,6 +5,7 @@ package api
 import (
 	"math/bits"
 	"strconv"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/go-faster/jx"
@@ -13,74 +14,133 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
+// Encode encodes AcceptSingleplayerChallengeBadRequest as json.
+func (s *AcceptSingleplayerChallengeBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes AcceptSingleplayerChallengeBadRequest from json.
+func (s *AcceptSingleplayerChallengeBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode AcceptSingleplayerChallengeBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = AcceptSingleplayerChallengeBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *AcceptSingleplayerChallengeBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *AcceptSingleplayerChallengeBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes AcceptSingleplayerChallengeConflict as json.
+func (s *AcceptSingleplayerChallengeConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes AcceptSingleplayerChallengeConflict from json.
+func (s *AcceptSingleplayerChallengeConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode AcceptSingleplayerChallengeConflict to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = AcceptSingleplayerChallengeConflict(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *AcceptSingleplayerChallengeConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *AcceptSingleplayerChallengeConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
-func (s *AuthProvider) Encode(e *jx.Encoder) {
+func (s *AcceptSingleplayerChallengeCreated) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *AuthProvider) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("provider")
-		e.Str(s.Provider)
-	}
+func (s *AcceptSingleplayerChallengeCreated) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("createdAt")
-		json.EncodeDateTime(e, s.CreatedAt)
+		e.FieldStart("id")
+		e.Int(s.ID)
 	}
 }
 
-var jsonFieldsNameOfAuthProvider = [2]string{
-	0: "provider",
-	1: "createdAt",
+var jsonFieldsNameOfAcceptSingleplayerChallengeCreated = [1]string{
+	0: "id",
 }
 
-// Decode decodes AuthProvider from json.
-func (s *AuthProvider) Decode(d *jx.Decoder) error {
+// Decode decodes AcceptSingleplayerChallengeCreated from json.
+func (s *AcceptSingleplayerChallengeCreated) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode AuthProvider to nil")
+		return errors.New("invalid: unable to decode AcceptSingleplayerChallengeCreated to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "provider":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Provider = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provider\"")
-			}
-		case "createdAt":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
+				v, err := d.Int()
+				s.ID = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"createdAt\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode AuthProvider")
+		return errors.Wrap(err, "decode AcceptSingleplayerChallengeCreated")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -92,8 +152,8 @@ func (s *AuthProvider) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfAuthProvider) {
-					name = jsonFieldsNameOfAuthProvider[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAcceptSingleplayerChallengeCreated) {
+					name = jsonFieldsNameOfAcceptSingleplayerChallengeCreated[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -114,29 +174,29 @@ func (s *AuthProvider) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *AuthProvider) MarshalJSON() ([]byte, error) {
+func (s *AcceptSingleplayerChallengeCreated) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *AuthProvider) UnmarshalJSON(data []byte) error {
+func (s *AcceptSingleplayerChallengeCreated) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DeleteDiscordInternalServerError as json.
-func (s *DeleteDiscordInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes AcceptSingleplayerChallengeInternalServerError as json.
+func (s *AcceptSingleplayerChallengeInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes DeleteDiscordInternalServerError from json.
-func (s *DeleteDiscordInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes AcceptSingleplayerChallengeInternalServerError from json.
+func (s *AcceptSingleplayerChallengeInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DeleteDiscordInternalServerError to nil")
+		return errors.New("invalid: unable to decode AcceptSingleplayerChallengeInternalServerError to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -147,34 +207,34 @@ func (s *DeleteDiscordInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = DeleteDiscordInternalServerError(unwrapped)
+	*s = AcceptSingleplayerChallengeInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DeleteDiscordInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *AcceptSingleplayerChallengeInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeleteDiscordInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *AcceptSingleplayerChallengeInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DeleteDiscordUnauthorized as json.
-func (s *DeleteDiscordUnauthorized) Encode(e *jx.Encoder) {
+// Encode encodes AcceptSingleplayerChallengeNotFound as json.
+func (s *AcceptSingleplayerChallengeNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes DeleteDiscordUnauthorized from json.
-func (s *DeleteDiscordUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes AcceptSingleplayerChallengeNotFound from json.
+func (s *AcceptSingleplayerChallengeNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DeleteDiscordUnauthorized to nil")
+		return errors.New("invalid: unable to decode AcceptSingleplayerChallengeNotFound to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -185,34 +245,34 @@ func (s *DeleteDiscordUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = DeleteDiscordUnauthorized(unwrapped)
+	*s = AcceptSingleplayerChallengeNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DeleteDiscordUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *AcceptSingleplayerChallengeNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeleteDiscordUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *AcceptSingleplayerChallengeNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DeleteYandexInternalServerError as json.
-func (s *DeleteYandexInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes AcceptSingleplayerChallengeUnauthorized as json.
+func (s *AcceptSingleplayerChallengeUnauthorized) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes DeleteYandexInternalServerError from json.
-func (s *DeleteYandexInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes AcceptSingleplayerChallengeUnauthorized from json.
+func (s *AcceptSingleplayerChallengeUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DeleteYandexInternalServerError to nil")
+		return errors.New("invalid: unable to decode AcceptSingleplayerChallengeUnauthorized to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -223,34 +283,34 @@ func (s *DeleteYandexInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = DeleteYandexInternalServerError(unwrapped)
+	*s = AcceptSingleplayerChallengeUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DeleteYandexInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *AcceptSingleplayerChallengeUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeleteYandexInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *AcceptSingleplayerChallengeUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DeleteYandexUnauthorized as json.
-func (s *DeleteYandexUnauthorized) Encode(e *jx.Encoder) {
+// Encode encodes AddMapLocationsBadRequest as json.
+func (s *AddMapLocationsBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes DeleteYandexUnauthorized from json.
-func (s *DeleteYandexUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes AddMapLocationsBadRequest from json.
+func (s *AddMapLocationsBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DeleteYandexUnauthorized to nil")
+		return errors.New("invalid: unable to decode AddMapLocationsBadRequest to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -261,34 +321,34 @@ func (s *DeleteYandexUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = DeleteYandexUnauthorized(unwrapped)
+	*s = AddMapLocationsBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DeleteYandexUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *AddMapLocationsBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeleteYandexUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *AddMapLocationsBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DiscordLoginCallbackBadRequest as json.
-func (s *DiscordLoginCallbackBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes AddMapLocationsForbidden as json.
+func (s *AddMapLocationsForbidden) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes DiscordLoginCallbackBadRequest from json.
-func (s *DiscordLoginCallbackBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes AddMapLocationsForbidden from json.
+func (s *AddMapLocationsForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DiscordLoginCallbackBadRequest to nil")
+		return errors.New("invalid: unable to decode AddMapLocationsForbidden to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -299,34 +359,34 @@ func (s *DiscordLoginCallbackBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = DiscordLoginCallbackBadRequest(unwrapped)
+	*s = AddMapLocationsForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DiscordLoginCallbackBadRequest) MarshalJSON() ([]byte, error) {
+func (s *AddMapLocationsForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DiscordLoginCallbackBadRequest) UnmarshalJSON(data []byte) error {
+func (s *AddMapLocationsForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DiscordLoginCallbackInternalServerError as json.
-func (s *DiscordLoginCallbackInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes AddMapLocationsInternalServerError as json.
+func (s *AddMapLocationsInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes DiscordLoginCallbackInternalServerError from json.
-func (s *DiscordLoginCallbackInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes AddMapLocationsInternalServerError from json.
+func (s *AddMapLocationsInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DiscordLoginCallbackInternalServerError to nil")
+		return errors.New("invalid: unable to decode AddMapLocationsInternalServerError to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -337,34 +397,34 @@ func (s *DiscordLoginCallbackInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = DiscordLoginCallbackInternalServerError(unwrapped)
+	*s = AddMapLocationsInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DiscordLoginCallbackInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *AddMapLocationsInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DiscordLoginCallbackInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *AddMapLocationsInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DiscordLoginCallbackNotFound as json.
-func (s *DiscordLoginCallbackNotFound) Encode(e *jx.Encoder) {
+// Encode encodes AddMapLocationsNotFound as json.
+func (s *AddMapLocationsNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes DiscordLoginCallbackNotFound from json.
-func (s *DiscordLoginCallbackNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes AddMapLocationsNotFound from json.
+func (s *AddMapLocationsNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DiscordLoginCallbackNotFound to nil")
+		return errors.New("invalid: unable to decode AddMapLocationsNotFound to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -375,72 +435,142 @@ func (s *DiscordLoginCallbackNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = DiscordLoginCallbackNotFound(unwrapped)
+	*s = AddMapLocationsNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DiscordLoginCallbackNotFound) MarshalJSON() ([]byte, error) {
+func (s *AddMapLocationsNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DiscordLoginCallbackNotFound) UnmarshalJSON(data []byte) error {
+func (s *AddMapLocationsNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EndSingleplayerGameBadRequest as json.
-func (s *EndSingleplayerGameBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
+// Encode implements json.Marshaler.
+func (s *AddMapLocationsRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *AddMapLocationsRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("locationIDs")
+		e.ArrStart()
+		for _, elem := range s.LocationIDs {
+			e.Int(elem)
+		}
+		e.ArrEnd()
+	}
 }
 
-// Decode decodes EndSingleplayerGameBadRequest from json.
-func (s *EndSingleplayerGameBadRequest) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfAddMapLocationsRequest = [1]string{
+	0: "locationIDs",
+}
+
+// Decode decodes AddMapLocationsRequest from json.
+func (s *AddMapLocationsRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EndSingleplayerGameBadRequest to nil")
+		return errors.New("invalid: unable to decode AddMapLocationsRequest to nil")
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "locationIDs":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.LocationIDs = make([]int, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem int
+					v, err := d.Int()
+					elem = int(v)
+					if err != nil {
+						return err
+					}
+					s.LocationIDs = append(s.LocationIDs, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"locationIDs\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode AddMapLocationsRequest")
 	}
-	*s = EndSingleplayerGameBadRequest(unwrapped)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfAddMapLocationsRequest) {
+					name = jsonFieldsNameOfAddMapLocationsRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EndSingleplayerGameBadRequest) MarshalJSON() ([]byte, error) {
+func (s *AddMapLocationsRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EndSingleplayerGameBadRequest) UnmarshalJSON(data []byte) error {
+func (s *AddMapLocationsRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EndSingleplayerGameForbidden as json.
-func (s *EndSingleplayerGameForbidden) Encode(e *jx.Encoder) {
+// Encode encodes AddMapLocationsUnauthorized as json.
+func (s *AddMapLocationsUnauthorized) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes EndSingleplayerGameForbidden from json.
-func (s *EndSingleplayerGameForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes AddMapLocationsUnauthorized from json.
+func (s *AddMapLocationsUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EndSingleplayerGameForbidden to nil")
+		return errors.New("invalid: unable to decode AddMapLocationsUnauthorized to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -451,357 +581,582 @@ func (s *EndSingleplayerGameForbidden) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = EndSingleplayerGameForbidden(unwrapped)
+	*s = AddMapLocationsUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EndSingleplayerGameForbidden) MarshalJSON() ([]byte, error) {
+func (s *AddMapLocationsUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EndSingleplayerGameForbidden) UnmarshalJSON(data []byte) error {
+func (s *AddMapLocationsUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EndSingleplayerGameInternalServerError as json.
-func (s *EndSingleplayerGameInternalServerError) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
+// Encode implements json.Marshaler.
+func (s *AuthProvider) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *AuthProvider) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("provider")
+		e.Str(s.Provider)
+	}
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
 }
 
-// Decode decodes EndSingleplayerGameInternalServerError from json.
-func (s *EndSingleplayerGameInternalServerError) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfAuthProvider = [2]string{
+	0: "provider",
+	1: "createdAt",
+}
+
+// Decode decodes AuthProvider from json.
+func (s *AuthProvider) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EndSingleplayerGameInternalServerError to nil")
+		return errors.New("invalid: unable to decode AuthProvider to nil")
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "provider":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Provider = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "createdAt":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode AuthProvider")
 	}
-	*s = EndSingleplayerGameInternalServerError(unwrapped)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfAuthProvider) {
+					name = jsonFieldsNameOfAuthProvider[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EndSingleplayerGameInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *AuthProvider) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EndSingleplayerGameInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *AuthProvider) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EndSingleplayerGameNotFound as json.
-func (s *EndSingleplayerGameNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *BestStreak) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes EndSingleplayerGameNotFound from json.
-func (s *EndSingleplayerGameNotFound) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode EndSingleplayerGameNotFound to nil")
+// encodeFields encodes fields.
+func (s *BestStreak) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("streak")
+		e.Int(s.Streak)
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		if s.GameID.Set {
+			e.FieldStart("gameID")
+			s.GameID.Encode(e)
+		}
+	}
+	{
+		if s.EndedAt.Set {
+			e.FieldStart("endedAt")
+			s.EndedAt.Encode(e, json.EncodeDateTime)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = EndSingleplayerGameNotFound(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *EndSingleplayerGameNotFound) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EndSingleplayerGameNotFound) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes EndSingleplayerGameUnauthorized as json.
-func (s *EndSingleplayerGameUnauthorized) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
+var jsonFieldsNameOfBestStreak = [3]string{
+	0: "streak",
+	1: "gameID",
+	2: "endedAt",
 }
 
-// Decode decodes EndSingleplayerGameUnauthorized from json.
-func (s *EndSingleplayerGameUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes BestStreak from json.
+func (s *BestStreak) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EndSingleplayerGameUnauthorized to nil")
+		return errors.New("invalid: unable to decode BestStreak to nil")
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "streak":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Streak = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"streak\"")
+			}
+		case "gameID":
+			if err := func() error {
+				s.GameID.Reset()
+				if err := s.GameID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"gameID\"")
+			}
+		case "endedAt":
+			if err := func() error {
+				s.EndedAt.Reset()
+				if err := s.EndedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"endedAt\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode BestStreak")
 	}
-	*s = EndSingleplayerGameUnauthorized(unwrapped)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfBestStreak) {
+					name = jsonFieldsNameOfBestStreak[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EndSingleplayerGameUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *BestStreak) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EndSingleplayerGameUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *BestStreak) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EndSingleplayerRoundBadRequest as json.
-func (s *EndSingleplayerRoundBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
+// Encode encodes CountryCode as json.
+func (s CountryCode) Encode(e *jx.Encoder) {
+	unwrapped := string(s)
 
-	unwrapped.Encode(e)
+	e.Str(unwrapped)
 }
 
-// Decode decodes EndSingleplayerRoundBadRequest from json.
-func (s *EndSingleplayerRoundBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes CountryCode from json.
+func (s *CountryCode) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EndSingleplayerRoundBadRequest to nil")
+		return errors.New("invalid: unable to decode CountryCode to nil")
 	}
-	var unwrapped Error
+	var unwrapped string
 	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
+		v, err := d.Str()
+		unwrapped = string(v)
+		if err != nil {
 			return err
 		}
 		return nil
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = EndSingleplayerRoundBadRequest(unwrapped)
+	*s = CountryCode(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EndSingleplayerRoundBadRequest) MarshalJSON() ([]byte, error) {
+func (s CountryCode) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EndSingleplayerRoundBadRequest) UnmarshalJSON(data []byte) error {
+func (s *CountryCode) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EndSingleplayerRoundForbidden as json.
-func (s *EndSingleplayerRoundForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *DailyChallengeLeaderboard) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes EndSingleplayerRoundForbidden from json.
-func (s *EndSingleplayerRoundForbidden) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode EndSingleplayerRoundForbidden to nil")
+// encodeFields encodes fields.
+func (s *DailyChallengeLeaderboard) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("total")
+		e.Int(s.Total)
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		e.FieldStart("results")
+		e.ArrStart()
+		for _, elem := range s.Results {
+			elem.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+		e.ArrEnd()
 	}
-	*s = EndSingleplayerRoundForbidden(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *EndSingleplayerRoundForbidden) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EndSingleplayerRoundForbidden) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes EndSingleplayerRoundInternalServerError as json.
-func (s *EndSingleplayerRoundInternalServerError) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
+var jsonFieldsNameOfDailyChallengeLeaderboard = [2]string{
+	0: "total",
+	1: "results",
 }
 
-// Decode decodes EndSingleplayerRoundInternalServerError from json.
-func (s *EndSingleplayerRoundInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes DailyChallengeLeaderboard from json.
+func (s *DailyChallengeLeaderboard) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EndSingleplayerRoundInternalServerError to nil")
+		return errors.New("invalid: unable to decode DailyChallengeLeaderboard to nil")
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "total":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Total = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"total\"")
+			}
+		case "results":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.Results = make([]DailyChallengeResult, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem DailyChallengeResult
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Results = append(s.Results, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"results\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = EndSingleplayerRoundInternalServerError(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *EndSingleplayerRoundInternalServerError) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EndSingleplayerRoundInternalServerError) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes EndSingleplayerRoundNotFound as json.
-func (s *EndSingleplayerRoundNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes EndSingleplayerRoundNotFound from json.
-func (s *EndSingleplayerRoundNotFound) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode EndSingleplayerRoundNotFound to nil")
+	}); err != nil {
+		return errors.Wrap(err, "decode DailyChallengeLeaderboard")
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfDailyChallengeLeaderboard) {
+					name = jsonFieldsNameOfDailyChallengeLeaderboard[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = EndSingleplayerRoundNotFound(unwrapped)
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EndSingleplayerRoundNotFound) MarshalJSON() ([]byte, error) {
+func (s *DailyChallengeLeaderboard) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EndSingleplayerRoundNotFound) UnmarshalJSON(data []byte) error {
+func (s *DailyChallengeLeaderboard) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *EndSingleplayerRoundResponse) Encode(e *jx.Encoder) {
+func (s *DailyChallengeResult) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *EndSingleplayerRoundResponse) encodeFields(e *jx.Encoder) {
+func (s *DailyChallengeResult) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("place")
+		e.Int(s.Place)
+	}
+	{
+		e.FieldStart("userID")
+		e.Int(s.UserID)
+	}
+	{
+		e.FieldStart("username")
+		e.Str(s.Username)
+	}
+	{
+		e.FieldStart("avatarHash")
+		e.Str(s.AvatarHash)
+	}
+	{
+		e.FieldStart("gameID")
+		e.Int(s.GameID)
+	}
 	{
 		e.FieldStart("score")
 		e.Int(s.Score)
 	}
 	{
-		e.FieldStart("distance")
-		e.Int(s.Distance)
+		e.FieldStart("endedAt")
+		json.EncodeDateTime(e, s.EndedAt)
 	}
 }
 
-var jsonFieldsNameOfEndSingleplayerRoundResponse = [2]string{
-	0: "score",
-	1: "distance",
+var jsonFieldsNameOfDailyChallengeResult = [7]string{
+	0: "place",
+	1: "userID",
+	2: "username",
+	3: "avatarHash",
+	4: "gameID",
+	5: "score",
+	6: "endedAt",
 }
 
-// Decode decodes EndSingleplayerRoundResponse from json.
-func (s *EndSingleplayerRoundResponse) Decode(d *jx.Decoder) error {
+// Decode decodes DailyChallengeResult from json.
+func (s *DailyChallengeResult) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EndSingleplayerRoundResponse to nil")
+		return errors.New("invalid: unable to decode DailyChallengeResult to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "score":
+		case "place":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Int()
-				s.Score = int(v)
+				s.Place = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"score\"")
+				return errors.Wrap(err, "decode field \"place\"")
 			}
-		case "distance":
+		case "userID":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Int()
-				s.Distance = int(v)
+				s.UserID = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"distance\"")
+				return errors.Wrap(err, "decode field \"userID\"")
+			}
+		case "username":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Username = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"username\"")
+			}
+		case "avatarHash":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.AvatarHash = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"avatarHash\"")
+			}
+		case "gameID":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.GameID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"gameID\"")
+			}
+		case "score":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int()
+				s.Score = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
+			}
+		case "endedAt":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.EndedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"endedAt\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode EndSingleplayerRoundResponse")
+		return errors.Wrap(err, "decode DailyChallengeResult")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b01111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -813,8 +1168,8 @@ func (s *EndSingleplayerRoundResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfEndSingleplayerRoundResponse) {
-					name = jsonFieldsNameOfEndSingleplayerRoundResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfDailyChallengeResult) {
+					name = jsonFieldsNameOfDailyChallengeResult[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -835,29 +1190,29 @@ func (s *EndSingleplayerRoundResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EndSingleplayerRoundResponse) MarshalJSON() ([]byte, error) {
+func (s *DailyChallengeResult) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EndSingleplayerRoundResponse) UnmarshalJSON(data []byte) error {
+func (s *DailyChallengeResult) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EndSingleplayerRoundUnauthorized as json.
-func (s *EndSingleplayerRoundUnauthorized) Encode(e *jx.Encoder) {
+// Encode encodes DeleteDiscordInternalServerError as json.
+func (s *DeleteDiscordInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes EndSingleplayerRoundUnauthorized from json.
-func (s *EndSingleplayerRoundUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes DeleteDiscordInternalServerError from json.
+func (s *DeleteDiscordInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EndSingleplayerRoundUnauthorized to nil")
+		return errors.New("invalid: unable to decode DeleteDiscordInternalServerError to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -868,260 +1223,110 @@ func (s *EndSingleplayerRoundUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = EndSingleplayerRoundUnauthorized(unwrapped)
+	*s = DeleteDiscordInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EndSingleplayerRoundUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *DeleteDiscordInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EndSingleplayerRoundUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *DeleteDiscordInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *Error) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *Error) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("title")
-		e.Str(s.Title)
-	}
-	{
-		e.FieldStart("status")
-		e.Int(s.Status)
-	}
-	{
-		e.FieldStart("detail")
-		e.Str(s.Detail)
-	}
-}
+// Encode encodes DeleteDiscordUnauthorized as json.
+func (s *DeleteDiscordUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
 
-var jsonFieldsNameOfError = [3]string{
-	0: "title",
-	1: "status",
-	2: "detail",
+	unwrapped.Encode(e)
 }
 
-// Decode decodes Error from json.
-func (s *Error) Decode(d *jx.Decoder) error {
+// Decode decodes DeleteDiscordUnauthorized from json.
+func (s *DeleteDiscordUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Error to nil")
+		return errors.New("invalid: unable to decode DeleteDiscordUnauthorized to nil")
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "title":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.Title = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"title\"")
-			}
-		case "status":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int()
-				s.Status = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"status\"")
-			}
-		case "detail":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Str()
-				s.Detail = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"detail\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode Error")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfError) {
-					name = jsonFieldsNameOfError[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = DeleteDiscordUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Error) MarshalJSON() ([]byte, error) {
+func (s *DeleteDiscordUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Error) UnmarshalJSON(data []byte) error {
+func (s *DeleteDiscordUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *GetHealthOK) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *GetHealthOK) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("status")
-		e.Str(s.Status)
-	}
-}
+// Encode encodes DeleteYandexInternalServerError as json.
+func (s *DeleteYandexInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
 
-var jsonFieldsNameOfGetHealthOK = [1]string{
-	0: "status",
+	unwrapped.Encode(e)
 }
 
-// Decode decodes GetHealthOK from json.
-func (s *GetHealthOK) Decode(d *jx.Decoder) error {
+// Decode decodes DeleteYandexInternalServerError from json.
+func (s *DeleteYandexInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetHealthOK to nil")
+		return errors.New("invalid: unable to decode DeleteYandexInternalServerError to nil")
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "status":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.Status = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"status\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode GetHealthOK")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfGetHealthOK) {
-					name = jsonFieldsNameOfGetHealthOK[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = DeleteYandexInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetHealthOK) MarshalJSON() ([]byte, error) {
+func (s *DeleteYandexInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetHealthOK) UnmarshalJSON(data []byte) error {
+func (s *DeleteYandexInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetLobbyInternalServerError as json.
-func (s *GetLobbyInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes DeleteYandexUnauthorized as json.
+func (s *DeleteYandexUnauthorized) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetLobbyInternalServerError from json.
-func (s *GetLobbyInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes DeleteYandexUnauthorized from json.
+func (s *DeleteYandexUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetLobbyInternalServerError to nil")
+		return errors.New("invalid: unable to decode DeleteYandexUnauthorized to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -1132,34 +1337,34 @@ func (s *GetLobbyInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetLobbyInternalServerError(unwrapped)
+	*s = DeleteYandexUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetLobbyInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *DeleteYandexUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetLobbyInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *DeleteYandexUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetLobbyNotFound as json.
-func (s *GetLobbyNotFound) Encode(e *jx.Encoder) {
+// Encode encodes DiscordLoginCallbackBadRequest as json.
+func (s *DiscordLoginCallbackBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetLobbyNotFound from json.
-func (s *GetLobbyNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes DiscordLoginCallbackBadRequest from json.
+func (s *DiscordLoginCallbackBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetLobbyNotFound to nil")
+		return errors.New("invalid: unable to decode DiscordLoginCallbackBadRequest to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -1170,34 +1375,34 @@ func (s *GetLobbyNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetLobbyNotFound(unwrapped)
+	*s = DiscordLoginCallbackBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetLobbyNotFound) MarshalJSON() ([]byte, error) {
+func (s *DiscordLoginCallbackBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetLobbyNotFound) UnmarshalJSON(data []byte) error {
+func (s *DiscordLoginCallbackBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetMultiplayerGameGuessesBadRequest as json.
-func (s *GetMultiplayerGameGuessesBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes DiscordLoginCallbackInternalServerError as json.
+func (s *DiscordLoginCallbackInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetMultiplayerGameGuessesBadRequest from json.
-func (s *GetMultiplayerGameGuessesBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes DiscordLoginCallbackInternalServerError from json.
+func (s *DiscordLoginCallbackInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetMultiplayerGameGuessesBadRequest to nil")
+		return errors.New("invalid: unable to decode DiscordLoginCallbackInternalServerError to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -1208,34 +1413,34 @@ func (s *GetMultiplayerGameGuessesBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetMultiplayerGameGuessesBadRequest(unwrapped)
+	*s = DiscordLoginCallbackInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetMultiplayerGameGuessesBadRequest) MarshalJSON() ([]byte, error) {
+func (s *DiscordLoginCallbackInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetMultiplayerGameGuessesBadRequest) UnmarshalJSON(data []byte) error {
+func (s *DiscordLoginCallbackInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetMultiplayerGameGuessesInternalServerError as json.
-func (s *GetMultiplayerGameGuessesInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes DiscordLoginCallbackNotFound as json.
+func (s *DiscordLoginCallbackNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetMultiplayerGameGuessesInternalServerError from json.
-func (s *GetMultiplayerGameGuessesInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes DiscordLoginCallbackNotFound from json.
+func (s *DiscordLoginCallbackNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetMultiplayerGameGuessesInternalServerError to nil")
+		return errors.New("invalid: unable to decode DiscordLoginCallbackNotFound to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -1246,84 +1451,72 @@ func (s *GetMultiplayerGameGuessesInternalServerError) Decode(d *jx.Decoder) err
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetMultiplayerGameGuessesInternalServerError(unwrapped)
+	*s = DiscordLoginCallbackNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetMultiplayerGameGuessesInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *DiscordLoginCallbackNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetMultiplayerGameGuessesInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *DiscordLoginCallbackNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetMultiplayerGameGuessesOKApplicationJSON as json.
-func (s GetMultiplayerGameGuessesOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []MultiplayerGuess(s)
+// Encode encodes EndSingleplayerGameBadRequest as json.
+func (s *EndSingleplayerGameBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
 
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
+	unwrapped.Encode(e)
 }
 
-// Decode decodes GetMultiplayerGameGuessesOKApplicationJSON from json.
-func (s *GetMultiplayerGameGuessesOKApplicationJSON) Decode(d *jx.Decoder) error {
+// Decode decodes EndSingleplayerGameBadRequest from json.
+func (s *EndSingleplayerGameBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetMultiplayerGameGuessesOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode EndSingleplayerGameBadRequest to nil")
 	}
-	var unwrapped []MultiplayerGuess
+	var unwrapped Error
 	if err := func() error {
-		unwrapped = make([]MultiplayerGuess, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem MultiplayerGuess
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
+		if err := unwrapped.Decode(d); err != nil {
 			return err
 		}
 		return nil
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetMultiplayerGameGuessesOKApplicationJSON(unwrapped)
+	*s = EndSingleplayerGameBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s GetMultiplayerGameGuessesOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s *EndSingleplayerGameBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetMultiplayerGameGuessesOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *EndSingleplayerGameBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetMultiplayerGameInternalServerError as json.
-func (s *GetMultiplayerGameInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes EndSingleplayerGameForbidden as json.
+func (s *EndSingleplayerGameForbidden) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetMultiplayerGameInternalServerError from json.
-func (s *GetMultiplayerGameInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes EndSingleplayerGameForbidden from json.
+func (s *EndSingleplayerGameForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetMultiplayerGameInternalServerError to nil")
+		return errors.New("invalid: unable to decode EndSingleplayerGameForbidden to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -1334,34 +1527,34 @@ func (s *GetMultiplayerGameInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetMultiplayerGameInternalServerError(unwrapped)
+	*s = EndSingleplayerGameForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetMultiplayerGameInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *EndSingleplayerGameForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetMultiplayerGameInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *EndSingleplayerGameForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetMultiplayerGameNotFound as json.
-func (s *GetMultiplayerGameNotFound) Encode(e *jx.Encoder) {
+// Encode encodes EndSingleplayerGameInternalServerError as json.
+func (s *EndSingleplayerGameInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetMultiplayerGameNotFound from json.
-func (s *GetMultiplayerGameNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes EndSingleplayerGameInternalServerError from json.
+func (s *EndSingleplayerGameInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetMultiplayerGameNotFound to nil")
+		return errors.New("invalid: unable to decode EndSingleplayerGameInternalServerError to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -1372,34 +1565,34 @@ func (s *GetMultiplayerGameNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetMultiplayerGameNotFound(unwrapped)
+	*s = EndSingleplayerGameInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetMultiplayerGameNotFound) MarshalJSON() ([]byte, error) {
+func (s *EndSingleplayerGameInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetMultiplayerGameNotFound) UnmarshalJSON(data []byte) error {
+func (s *EndSingleplayerGameInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetMultiplayerGameUnauthorized as json.
-func (s *GetMultiplayerGameUnauthorized) Encode(e *jx.Encoder) {
+// Encode encodes EndSingleplayerGameNotFound as json.
+func (s *EndSingleplayerGameNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetMultiplayerGameUnauthorized from json.
-func (s *GetMultiplayerGameUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes EndSingleplayerGameNotFound from json.
+func (s *EndSingleplayerGameNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetMultiplayerGameUnauthorized to nil")
+		return errors.New("invalid: unable to decode EndSingleplayerGameNotFound to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -1410,34 +1603,34 @@ func (s *GetMultiplayerGameUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetMultiplayerGameUnauthorized(unwrapped)
+	*s = EndSingleplayerGameNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetMultiplayerGameUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *EndSingleplayerGameNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetMultiplayerGameUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *EndSingleplayerGameNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetMultiplayerRoundInternalServerError as json.
-func (s *GetMultiplayerRoundInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes EndSingleplayerGameUnauthorized as json.
+func (s *EndSingleplayerGameUnauthorized) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetMultiplayerRoundInternalServerError from json.
-func (s *GetMultiplayerRoundInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes EndSingleplayerGameUnauthorized from json.
+func (s *EndSingleplayerGameUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetMultiplayerRoundInternalServerError to nil")
+		return errors.New("invalid: unable to decode EndSingleplayerGameUnauthorized to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -1448,34 +1641,34 @@ func (s *GetMultiplayerRoundInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetMultiplayerRoundInternalServerError(unwrapped)
+	*s = EndSingleplayerGameUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetMultiplayerRoundInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *EndSingleplayerGameUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetMultiplayerRoundInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *EndSingleplayerGameUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetMultiplayerRoundNotFound as json.
-func (s *GetMultiplayerRoundNotFound) Encode(e *jx.Encoder) {
+// Encode encodes EndSingleplayerRoundBadRequest as json.
+func (s *EndSingleplayerRoundBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetMultiplayerRoundNotFound from json.
-func (s *GetMultiplayerRoundNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes EndSingleplayerRoundBadRequest from json.
+func (s *EndSingleplayerRoundBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetMultiplayerRoundNotFound to nil")
+		return errors.New("invalid: unable to decode EndSingleplayerRoundBadRequest to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -1486,34 +1679,34 @@ func (s *GetMultiplayerRoundNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetMultiplayerRoundNotFound(unwrapped)
+	*s = EndSingleplayerRoundBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetMultiplayerRoundNotFound) MarshalJSON() ([]byte, error) {
+func (s *EndSingleplayerRoundBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetMultiplayerRoundNotFound) UnmarshalJSON(data []byte) error {
+func (s *EndSingleplayerRoundBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetMultiplayerRoundUnauthorized as json.
-func (s *GetMultiplayerRoundUnauthorized) Encode(e *jx.Encoder) {
+// Encode encodes EndSingleplayerRoundForbidden as json.
+func (s *EndSingleplayerRoundForbidden) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetMultiplayerRoundUnauthorized from json.
-func (s *GetMultiplayerRoundUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes EndSingleplayerRoundForbidden from json.
+func (s *EndSingleplayerRoundForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetMultiplayerRoundUnauthorized to nil")
+		return errors.New("invalid: unable to decode EndSingleplayerRoundForbidden to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -1524,34 +1717,34 @@ func (s *GetMultiplayerRoundUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetMultiplayerRoundUnauthorized(unwrapped)
+	*s = EndSingleplayerRoundForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetMultiplayerRoundUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *EndSingleplayerRoundForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetMultiplayerRoundUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *EndSingleplayerRoundForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetOAuthProvidersInternalServerError as json.
-func (s *GetOAuthProvidersInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes EndSingleplayerRoundInternalServerError as json.
+func (s *EndSingleplayerRoundInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetOAuthProvidersInternalServerError from json.
-func (s *GetOAuthProvidersInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes EndSingleplayerRoundInternalServerError from json.
+func (s *EndSingleplayerRoundInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetOAuthProvidersInternalServerError to nil")
+		return errors.New("invalid: unable to decode EndSingleplayerRoundInternalServerError to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -1562,198 +1755,215 @@ func (s *GetOAuthProvidersInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetOAuthProvidersInternalServerError(unwrapped)
+	*s = EndSingleplayerRoundInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetOAuthProvidersInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *EndSingleplayerRoundInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetOAuthProvidersInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *EndSingleplayerRoundInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetOAuthProvidersOKApplicationJSON as json.
-func (s GetOAuthProvidersOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []AuthProvider(s)
+// Encode encodes EndSingleplayerRoundNotFound as json.
+func (s *EndSingleplayerRoundNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
 
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
+	unwrapped.Encode(e)
 }
 
-// Decode decodes GetOAuthProvidersOKApplicationJSON from json.
-func (s *GetOAuthProvidersOKApplicationJSON) Decode(d *jx.Decoder) error {
+// Decode decodes EndSingleplayerRoundNotFound from json.
+func (s *EndSingleplayerRoundNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetOAuthProvidersOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode EndSingleplayerRoundNotFound to nil")
 	}
-	var unwrapped []AuthProvider
+	var unwrapped Error
 	if err := func() error {
-		unwrapped = make([]AuthProvider, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem AuthProvider
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
+		if err := unwrapped.Decode(d); err != nil {
 			return err
 		}
 		return nil
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetOAuthProvidersOKApplicationJSON(unwrapped)
+	*s = EndSingleplayerRoundNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s GetOAuthProvidersOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s *EndSingleplayerRoundNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetOAuthProvidersOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *EndSingleplayerRoundNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetOAuthProvidersUnauthorized as json.
-func (s *GetOAuthProvidersUnauthorized) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *EndSingleplayerRoundResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes GetOAuthProvidersUnauthorized from json.
-func (s *GetOAuthProvidersUnauthorized) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode GetOAuthProvidersUnauthorized to nil")
+// encodeFields encodes fields.
+func (s *EndSingleplayerRoundResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("score")
+		e.Int(s.Score)
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	{
+		e.FieldStart("scoreBreakdown")
+		s.ScoreBreakdown.Encode(e)
+	}
+	{
+		e.FieldStart("distance")
+		e.Int(s.Distance)
+	}
+	{
+		e.FieldStart("location")
+		s.Location.Encode(e)
 	}
-	*s = GetOAuthProvidersUnauthorized(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *GetOAuthProvidersUnauthorized) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetOAuthProvidersUnauthorized) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfEndSingleplayerRoundResponse = [4]string{
+	0: "score",
+	1: "scoreBreakdown",
+	2: "distance",
+	3: "location",
 }
 
-// Encode encodes GetPrivateProfileInternalServerError as json.
-func (s *GetPrivateProfileInternalServerError) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes GetPrivateProfileInternalServerError from json.
-func (s *GetPrivateProfileInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes EndSingleplayerRoundResponse from json.
+func (s *EndSingleplayerRoundResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetPrivateProfileInternalServerError to nil")
+		return errors.New("invalid: unable to decode EndSingleplayerRoundResponse to nil")
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "score":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Score = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
+			}
+		case "scoreBreakdown":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.ScoreBreakdown.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scoreBreakdown\"")
+			}
+		case "distance":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Distance = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"distance\"")
+			}
+		case "location":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Location.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"location\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = GetPrivateProfileInternalServerError(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *GetPrivateProfileInternalServerError) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetPrivateProfileInternalServerError) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes GetPrivateProfileUnauthorized as json.
-func (s *GetPrivateProfileUnauthorized) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes GetPrivateProfileUnauthorized from json.
-func (s *GetPrivateProfileUnauthorized) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode GetPrivateProfileUnauthorized to nil")
+	}); err != nil {
+		return errors.Wrap(err, "decode EndSingleplayerRoundResponse")
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEndSingleplayerRoundResponse) {
+					name = jsonFieldsNameOfEndSingleplayerRoundResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = GetPrivateProfileUnauthorized(unwrapped)
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetPrivateProfileUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *EndSingleplayerRoundResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetPrivateProfileUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *EndSingleplayerRoundResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetPublicProfileInternalServerError as json.
-func (s *GetPublicProfileInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes EndSingleplayerRoundUnauthorized as json.
+func (s *EndSingleplayerRoundUnauthorized) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetPublicProfileInternalServerError from json.
-func (s *GetPublicProfileInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes EndSingleplayerRoundUnauthorized from json.
+func (s *EndSingleplayerRoundUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetPublicProfileInternalServerError to nil")
+		return errors.New("invalid: unable to decode EndSingleplayerRoundUnauthorized to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -1764,34 +1974,34 @@ func (s *GetPublicProfileInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetPublicProfileInternalServerError(unwrapped)
+	*s = EndSingleplayerRoundUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetPublicProfileInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *EndSingleplayerRoundUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetPublicProfileInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *EndSingleplayerRoundUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetPublicProfileNotFound as json.
-func (s *GetPublicProfileNotFound) Encode(e *jx.Encoder) {
+// Encode encodes EndStreakRoundBadRequest as json.
+func (s *EndStreakRoundBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetPublicProfileNotFound from json.
-func (s *GetPublicProfileNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes EndStreakRoundBadRequest from json.
+func (s *EndStreakRoundBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetPublicProfileNotFound to nil")
+		return errors.New("invalid: unable to decode EndStreakRoundBadRequest to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -1802,34 +2012,34 @@ func (s *GetPublicProfileNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetPublicProfileNotFound(unwrapped)
+	*s = EndStreakRoundBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetPublicProfileNotFound) MarshalJSON() ([]byte, error) {
+func (s *EndStreakRoundBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetPublicProfileNotFound) UnmarshalJSON(data []byte) error {
+func (s *EndStreakRoundBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetSingleplayerGameForbidden as json.
-func (s *GetSingleplayerGameForbidden) Encode(e *jx.Encoder) {
+// Encode encodes EndStreakRoundForbidden as json.
+func (s *EndStreakRoundForbidden) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetSingleplayerGameForbidden from json.
-func (s *GetSingleplayerGameForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes EndStreakRoundForbidden from json.
+func (s *EndStreakRoundForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetSingleplayerGameForbidden to nil")
+		return errors.New("invalid: unable to decode EndStreakRoundForbidden to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -1840,34 +2050,34 @@ func (s *GetSingleplayerGameForbidden) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetSingleplayerGameForbidden(unwrapped)
+	*s = EndStreakRoundForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetSingleplayerGameForbidden) MarshalJSON() ([]byte, error) {
+func (s *EndStreakRoundForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetSingleplayerGameForbidden) UnmarshalJSON(data []byte) error {
+func (s *EndStreakRoundForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetSingleplayerGameInternalServerError as json.
-func (s *GetSingleplayerGameInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes EndStreakRoundInternalServerError as json.
+func (s *EndStreakRoundInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetSingleplayerGameInternalServerError from json.
-func (s *GetSingleplayerGameInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes EndStreakRoundInternalServerError from json.
+func (s *EndStreakRoundInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetSingleplayerGameInternalServerError to nil")
+		return errors.New("invalid: unable to decode EndStreakRoundInternalServerError to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -1878,34 +2088,34 @@ func (s *GetSingleplayerGameInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetSingleplayerGameInternalServerError(unwrapped)
+	*s = EndStreakRoundInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetSingleplayerGameInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *EndStreakRoundInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetSingleplayerGameInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *EndStreakRoundInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetSingleplayerGameNotFound as json.
-func (s *GetSingleplayerGameNotFound) Encode(e *jx.Encoder) {
+// Encode encodes EndStreakRoundNotFound as json.
+func (s *EndStreakRoundNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetSingleplayerGameNotFound from json.
-func (s *GetSingleplayerGameNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes EndStreakRoundNotFound from json.
+func (s *EndStreakRoundNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetSingleplayerGameNotFound to nil")
+		return errors.New("invalid: unable to decode EndStreakRoundNotFound to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -1916,110 +2126,211 @@ func (s *GetSingleplayerGameNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetSingleplayerGameNotFound(unwrapped)
+	*s = EndStreakRoundNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetSingleplayerGameNotFound) MarshalJSON() ([]byte, error) {
+func (s *EndStreakRoundNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetSingleplayerGameNotFound) UnmarshalJSON(data []byte) error {
+func (s *EndStreakRoundNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetSingleplayerGameRoundsBadRequest as json.
-func (s *GetSingleplayerGameRoundsBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *EndStreakRoundResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes GetSingleplayerGameRoundsBadRequest from json.
-func (s *GetSingleplayerGameRoundsBadRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode GetSingleplayerGameRoundsBadRequest to nil")
+// encodeFields encodes fields.
+func (s *EndStreakRoundResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("correct")
+		e.Bool(s.Correct)
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		e.FieldStart("country")
+		s.Country.Encode(e)
+	}
+	{
+		if s.GuessCountry.Set {
+			e.FieldStart("guessCountry")
+			s.GuessCountry.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = GetSingleplayerGameRoundsBadRequest(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *GetSingleplayerGameRoundsBadRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetSingleplayerGameRoundsBadRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+	{
+		e.FieldStart("streak")
+		e.Int(s.Streak)
+	}
+	{
+		e.FieldStart("finished")
+		e.Bool(s.Finished)
+	}
+	{
+		e.FieldStart("location")
+		s.Location.Encode(e)
+	}
 }
 
-// Encode encodes GetSingleplayerGameRoundsForbidden as json.
-func (s *GetSingleplayerGameRoundsForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
+var jsonFieldsNameOfEndStreakRoundResponse = [6]string{
+	0: "correct",
+	1: "country",
+	2: "guessCountry",
+	3: "streak",
+	4: "finished",
+	5: "location",
 }
 
-// Decode decodes GetSingleplayerGameRoundsForbidden from json.
-func (s *GetSingleplayerGameRoundsForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes EndStreakRoundResponse from json.
+func (s *EndStreakRoundResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetSingleplayerGameRoundsForbidden to nil")
+		return errors.New("invalid: unable to decode EndStreakRoundResponse to nil")
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = GetSingleplayerGameRoundsForbidden(unwrapped)
-	return nil
-}
+	var requiredBitSet [1]uint8
 
-// MarshalJSON implements stdjson.Marshaler.
-func (s *GetSingleplayerGameRoundsForbidden) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "correct":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Bool()
+				s.Correct = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"correct\"")
+			}
+		case "country":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Country.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"country\"")
+			}
+		case "guessCountry":
+			if err := func() error {
+				s.GuessCountry.Reset()
+				if err := s.GuessCountry.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"guessCountry\"")
+			}
+		case "streak":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.Streak = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"streak\"")
+			}
+		case "finished":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Bool()
+				s.Finished = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"finished\"")
+			}
+		case "location":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				if err := s.Location.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"location\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EndStreakRoundResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00111011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEndStreakRoundResponse) {
+					name = jsonFieldsNameOfEndStreakRoundResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *EndStreakRoundResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetSingleplayerGameRoundsForbidden) UnmarshalJSON(data []byte) error {
+func (s *EndStreakRoundResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetSingleplayerGameRoundsInternalServerError as json.
-func (s *GetSingleplayerGameRoundsInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes EndStreakRoundUnauthorized as json.
+func (s *EndStreakRoundUnauthorized) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetSingleplayerGameRoundsInternalServerError from json.
-func (s *GetSingleplayerGameRoundsInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes EndStreakRoundUnauthorized from json.
+func (s *EndStreakRoundUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetSingleplayerGameRoundsInternalServerError to nil")
+		return errors.New("invalid: unable to decode EndStreakRoundUnauthorized to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -2030,122 +2341,204 @@ func (s *GetSingleplayerGameRoundsInternalServerError) Decode(d *jx.Decoder) err
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetSingleplayerGameRoundsInternalServerError(unwrapped)
+	*s = EndStreakRoundUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetSingleplayerGameRoundsInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *EndStreakRoundUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetSingleplayerGameRoundsInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *EndStreakRoundUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetSingleplayerGameRoundsNotFound as json.
-func (s *GetSingleplayerGameRoundsNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
+// Encode implements json.Marshaler.
+func (s *Error) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *Error) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("title")
+		e.Str(s.Title)
+	}
+	{
+		e.FieldStart("status")
+		e.Int(s.Status)
+	}
+	{
+		e.FieldStart("detail")
+		e.Str(s.Detail)
+	}
 }
 
-// Decode decodes GetSingleplayerGameRoundsNotFound from json.
-func (s *GetSingleplayerGameRoundsNotFound) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfError = [3]string{
+	0: "title",
+	1: "status",
+	2: "detail",
+}
+
+// Decode decodes Error from json.
+func (s *Error) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetSingleplayerGameRoundsNotFound to nil")
+		return errors.New("invalid: unable to decode Error to nil")
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "title":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Title = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"title\"")
+			}
+		case "status":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Status = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "detail":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Detail = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"detail\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode Error")
 	}
-	*s = GetSingleplayerGameRoundsNotFound(unwrapped)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfError) {
+					name = jsonFieldsNameOfError[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetSingleplayerGameRoundsNotFound) MarshalJSON() ([]byte, error) {
+func (s *Error) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetSingleplayerGameRoundsNotFound) UnmarshalJSON(data []byte) error {
+func (s *Error) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetSingleplayerGameRoundsOKApplicationJSON as json.
-func (s GetSingleplayerGameRoundsOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []SingleplayerRoundsWithGuess(s)
+// Encode encodes GameProvider as json.
+func (s GameProvider) Encode(e *jx.Encoder) {
+	unwrapped := string(s)
 
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
+	e.Str(unwrapped)
 }
 
-// Decode decodes GetSingleplayerGameRoundsOKApplicationJSON from json.
-func (s *GetSingleplayerGameRoundsOKApplicationJSON) Decode(d *jx.Decoder) error {
+// Decode decodes GameProvider from json.
+func (s *GameProvider) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetSingleplayerGameRoundsOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode GameProvider to nil")
 	}
-	var unwrapped []SingleplayerRoundsWithGuess
+	var unwrapped string
 	if err := func() error {
-		unwrapped = make([]SingleplayerRoundsWithGuess, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem SingleplayerRoundsWithGuess
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
+		v, err := d.Str()
+		unwrapped = string(v)
+		if err != nil {
 			return err
 		}
 		return nil
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetSingleplayerGameRoundsOKApplicationJSON(unwrapped)
+	*s = GameProvider(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s GetSingleplayerGameRoundsOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s GameProvider) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetSingleplayerGameRoundsOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *GameProvider) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetSingleplayerGameRoundsUnauthorized as json.
-func (s *GetSingleplayerGameRoundsUnauthorized) Encode(e *jx.Encoder) {
+// Encode encodes GetBestStreakInternalServerError as json.
+func (s *GetBestStreakInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetSingleplayerGameRoundsUnauthorized from json.
-func (s *GetSingleplayerGameRoundsUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes GetBestStreakInternalServerError from json.
+func (s *GetBestStreakInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetSingleplayerGameRoundsUnauthorized to nil")
+		return errors.New("invalid: unable to decode GetBestStreakInternalServerError to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -2156,34 +2549,34 @@ func (s *GetSingleplayerGameRoundsUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetSingleplayerGameRoundsUnauthorized(unwrapped)
+	*s = GetBestStreakInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetSingleplayerGameRoundsUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *GetBestStreakInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetSingleplayerGameRoundsUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *GetBestStreakInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetSingleplayerGameUnauthorized as json.
-func (s *GetSingleplayerGameUnauthorized) Encode(e *jx.Encoder) {
+// Encode encodes GetBestStreakUnauthorized as json.
+func (s *GetBestStreakUnauthorized) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetSingleplayerGameUnauthorized from json.
-func (s *GetSingleplayerGameUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes GetBestStreakUnauthorized from json.
+func (s *GetBestStreakUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetSingleplayerGameUnauthorized to nil")
+		return errors.New("invalid: unable to decode GetBestStreakUnauthorized to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -2194,34 +2587,34 @@ func (s *GetSingleplayerGameUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetSingleplayerGameUnauthorized(unwrapped)
+	*s = GetBestStreakUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetSingleplayerGameUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *GetBestStreakUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetSingleplayerGameUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *GetBestStreakUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetSingleplayerGamesBadRequest as json.
-func (s *GetSingleplayerGamesBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes GetDailyChallengeLeaderboardBadRequest as json.
+func (s *GetDailyChallengeLeaderboardBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetSingleplayerGamesBadRequest from json.
-func (s *GetSingleplayerGamesBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes GetDailyChallengeLeaderboardBadRequest from json.
+func (s *GetDailyChallengeLeaderboardBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetSingleplayerGamesBadRequest to nil")
+		return errors.New("invalid: unable to decode GetDailyChallengeLeaderboardBadRequest to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -2232,34 +2625,34 @@ func (s *GetSingleplayerGamesBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetSingleplayerGamesBadRequest(unwrapped)
+	*s = GetDailyChallengeLeaderboardBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetSingleplayerGamesBadRequest) MarshalJSON() ([]byte, error) {
+func (s *GetDailyChallengeLeaderboardBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetSingleplayerGamesBadRequest) UnmarshalJSON(data []byte) error {
+func (s *GetDailyChallengeLeaderboardBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetSingleplayerGamesInternalServerError as json.
-func (s *GetSingleplayerGamesInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes GetDailyChallengeLeaderboardInternalServerError as json.
+func (s *GetDailyChallengeLeaderboardInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetSingleplayerGamesInternalServerError from json.
-func (s *GetSingleplayerGamesInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes GetDailyChallengeLeaderboardInternalServerError from json.
+func (s *GetDailyChallengeLeaderboardInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetSingleplayerGamesInternalServerError to nil")
+		return errors.New("invalid: unable to decode GetDailyChallengeLeaderboardInternalServerError to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -2270,34 +2663,130 @@ func (s *GetSingleplayerGamesInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetSingleplayerGamesInternalServerError(unwrapped)
+	*s = GetDailyChallengeLeaderboardInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetSingleplayerGamesInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *GetDailyChallengeLeaderboardInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetSingleplayerGamesInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *GetDailyChallengeLeaderboardInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetSingleplayerGamesUnauthorized as json.
-func (s *GetSingleplayerGamesUnauthorized) Encode(e *jx.Encoder) {
+// Encode implements json.Marshaler.
+func (s *GetHealthOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *GetHealthOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("status")
+		e.Str(s.Status)
+	}
+}
+
+var jsonFieldsNameOfGetHealthOK = [1]string{
+	0: "status",
+}
+
+// Decode decodes GetHealthOK from json.
+func (s *GetHealthOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetHealthOK to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "status":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Status = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode GetHealthOK")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfGetHealthOK) {
+					name = jsonFieldsNameOfGetHealthOK[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetHealthOK) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetHealthOK) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetLobbyInternalServerError as json.
+func (s *GetLobbyInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetSingleplayerGamesUnauthorized from json.
-func (s *GetSingleplayerGamesUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes GetLobbyInternalServerError from json.
+func (s *GetLobbyInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetSingleplayerGamesUnauthorized to nil")
+		return errors.New("invalid: unable to decode GetLobbyInternalServerError to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -2308,34 +2797,34 @@ func (s *GetSingleplayerGamesUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetSingleplayerGamesUnauthorized(unwrapped)
+	*s = GetLobbyInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetSingleplayerGamesUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *GetLobbyInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetSingleplayerGamesUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *GetLobbyInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetSingleplayerRoundBadRequest as json.
-func (s *GetSingleplayerRoundBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes GetLobbyNotFound as json.
+func (s *GetLobbyNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetSingleplayerRoundBadRequest from json.
-func (s *GetSingleplayerRoundBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes GetLobbyNotFound from json.
+func (s *GetLobbyNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetSingleplayerRoundBadRequest to nil")
+		return errors.New("invalid: unable to decode GetLobbyNotFound to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -2346,34 +2835,34 @@ func (s *GetSingleplayerRoundBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetSingleplayerRoundBadRequest(unwrapped)
+	*s = GetLobbyNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetSingleplayerRoundBadRequest) MarshalJSON() ([]byte, error) {
+func (s *GetLobbyNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetSingleplayerRoundBadRequest) UnmarshalJSON(data []byte) error {
+func (s *GetLobbyNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetSingleplayerRoundForbidden as json.
-func (s *GetSingleplayerRoundForbidden) Encode(e *jx.Encoder) {
+// Encode encodes GetMapInternalServerError as json.
+func (s *GetMapInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetSingleplayerRoundForbidden from json.
-func (s *GetSingleplayerRoundForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes GetMapInternalServerError from json.
+func (s *GetMapInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetSingleplayerRoundForbidden to nil")
+		return errors.New("invalid: unable to decode GetMapInternalServerError to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -2384,34 +2873,34 @@ func (s *GetSingleplayerRoundForbidden) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetSingleplayerRoundForbidden(unwrapped)
+	*s = GetMapInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetSingleplayerRoundForbidden) MarshalJSON() ([]byte, error) {
+func (s *GetMapInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetSingleplayerRoundForbidden) UnmarshalJSON(data []byte) error {
+func (s *GetMapInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetSingleplayerRoundInternalServerError as json.
-func (s *GetSingleplayerRoundInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes GetMapNotFound as json.
+func (s *GetMapNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetSingleplayerRoundInternalServerError from json.
-func (s *GetSingleplayerRoundInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes GetMapNotFound from json.
+func (s *GetMapNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetSingleplayerRoundInternalServerError to nil")
+		return errors.New("invalid: unable to decode GetMapNotFound to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -2422,34 +2911,34 @@ func (s *GetSingleplayerRoundInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetSingleplayerRoundInternalServerError(unwrapped)
+	*s = GetMapNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetSingleplayerRoundInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *GetMapNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetSingleplayerRoundInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *GetMapNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetSingleplayerRoundNotFound as json.
-func (s *GetSingleplayerRoundNotFound) Encode(e *jx.Encoder) {
+// Encode encodes GetMapUnauthorized as json.
+func (s *GetMapUnauthorized) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetSingleplayerRoundNotFound from json.
-func (s *GetSingleplayerRoundNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes GetMapUnauthorized from json.
+func (s *GetMapUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetSingleplayerRoundNotFound to nil")
+		return errors.New("invalid: unable to decode GetMapUnauthorized to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -2460,34 +2949,34 @@ func (s *GetSingleplayerRoundNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetSingleplayerRoundNotFound(unwrapped)
+	*s = GetMapUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetSingleplayerRoundNotFound) MarshalJSON() ([]byte, error) {
+func (s *GetMapUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetSingleplayerRoundNotFound) UnmarshalJSON(data []byte) error {
+func (s *GetMapUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetSingleplayerRoundUnauthorized as json.
-func (s *GetSingleplayerRoundUnauthorized) Encode(e *jx.Encoder) {
+// Encode encodes GetMapsInternalServerError as json.
+func (s *GetMapsInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetSingleplayerRoundUnauthorized from json.
-func (s *GetSingleplayerRoundUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes GetMapsInternalServerError from json.
+func (s *GetMapsInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetSingleplayerRoundUnauthorized to nil")
+		return errors.New("invalid: unable to decode GetMapsInternalServerError to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -2498,715 +2987,388 @@ func (s *GetSingleplayerRoundUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetSingleplayerRoundUnauthorized(unwrapped)
+	*s = GetMapsInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetSingleplayerRoundUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *GetMapsInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetSingleplayerRoundUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *GetMapsInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetUserSessionsOKApplicationJSON as json.
-func (s GetUserSessionsOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []GetUserSessionsOKItem(s)
+// Encode encodes GetMapsUnauthorized as json.
+func (s *GetMapsUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
 
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
+	unwrapped.Encode(e)
 }
 
-// Decode decodes GetUserSessionsOKApplicationJSON from json.
-func (s *GetUserSessionsOKApplicationJSON) Decode(d *jx.Decoder) error {
+// Decode decodes GetMapsUnauthorized from json.
+func (s *GetMapsUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetUserSessionsOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode GetMapsUnauthorized to nil")
 	}
-	var unwrapped []GetUserSessionsOKItem
+	var unwrapped Error
 	if err := func() error {
-		unwrapped = make([]GetUserSessionsOKItem, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem GetUserSessionsOKItem
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
+		if err := unwrapped.Decode(d); err != nil {
 			return err
 		}
 		return nil
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetUserSessionsOKApplicationJSON(unwrapped)
+	*s = GetMapsUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s GetUserSessionsOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s *GetMapsUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetUserSessionsOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *GetMapsUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *GetUserSessionsOKItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetMultiplayerGameGuessesBadRequest as json.
+func (s *GetMultiplayerGameGuessesBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *GetUserSessionsOKItem) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("sessionID")
-		e.Str(s.SessionID)
-	}
-	{
-		e.FieldStart("userID")
-		e.Int(s.UserID)
-	}
-	{
-		e.FieldStart("refreshToken")
-		e.Str(s.RefreshToken)
-	}
-	{
-		e.FieldStart("ua")
-		e.Str(s.Ua)
+// Decode decodes GetMultiplayerGameGuessesBadRequest from json.
+func (s *GetMultiplayerGameGuessesBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetMultiplayerGameGuessesBadRequest to nil")
 	}
-	{
-		e.FieldStart("lastActive")
-		json.EncodeDateTime(e, s.LastActive)
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetMultiplayerGameGuessesBadRequest(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfGetUserSessionsOKItem = [5]string{
-	0: "sessionID",
-	1: "userID",
-	2: "refreshToken",
-	3: "ua",
-	4: "lastActive",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetMultiplayerGameGuessesBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes GetUserSessionsOKItem from json.
-func (s *GetUserSessionsOKItem) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetMultiplayerGameGuessesBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetMultiplayerGameGuessesForbidden as json.
+func (s *GetMultiplayerGameGuessesForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetMultiplayerGameGuessesForbidden from json.
+func (s *GetMultiplayerGameGuessesForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetUserSessionsOKItem to nil")
+		return errors.New("invalid: unable to decode GetMultiplayerGameGuessesForbidden to nil")
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "sessionID":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.SessionID = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"sessionID\"")
-			}
-		case "userID":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int()
-				s.UserID = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"userID\"")
-			}
-		case "refreshToken":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Str()
-				s.RefreshToken = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"refreshToken\"")
-			}
-		case "ua":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Str()
-				s.Ua = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ua\"")
-			}
-		case "lastActive":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.LastActive = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"lastActive\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode GetUserSessionsOKItem")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00011111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfGetUserSessionsOKItem) {
-					name = jsonFieldsNameOfGetUserSessionsOKItem[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetMultiplayerGameGuessesForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetUserSessionsOKItem) MarshalJSON() ([]byte, error) {
+func (s *GetMultiplayerGameGuessesForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetUserSessionsOKItem) UnmarshalJSON(data []byte) error {
+func (s *GetMultiplayerGameGuessesForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *LatLng) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *LatLng) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("lat")
-		e.Float64(s.Lat)
-	}
-	{
-		e.FieldStart("lng")
-		e.Float64(s.Lng)
-	}
-}
+// Encode encodes GetMultiplayerGameGuessesInternalServerError as json.
+func (s *GetMultiplayerGameGuessesInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
 
-var jsonFieldsNameOfLatLng = [2]string{
-	0: "lat",
-	1: "lng",
+	unwrapped.Encode(e)
 }
 
-// Decode decodes LatLng from json.
-func (s *LatLng) Decode(d *jx.Decoder) error {
+// Decode decodes GetMultiplayerGameGuessesInternalServerError from json.
+func (s *GetMultiplayerGameGuessesInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode LatLng to nil")
+		return errors.New("invalid: unable to decode GetMultiplayerGameGuessesInternalServerError to nil")
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "lat":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Float64()
-				s.Lat = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"lat\"")
-			}
-		case "lng":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Float64()
-				s.Lng = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"lng\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode LatLng")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfLatLng) {
-					name = jsonFieldsNameOfLatLng[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetMultiplayerGameGuessesInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *LatLng) MarshalJSON() ([]byte, error) {
+func (s *GetMultiplayerGameGuessesInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *LatLng) UnmarshalJSON(data []byte) error {
+func (s *GetMultiplayerGameGuessesInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *LobbiesResponse) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetMultiplayerGameGuessesNotFound as json.
+func (s *GetMultiplayerGameGuessesNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *LobbiesResponse) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("total")
-		e.Int(s.Total)
+// Decode decodes GetMultiplayerGameGuessesNotFound from json.
+func (s *GetMultiplayerGameGuessesNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetMultiplayerGameGuessesNotFound to nil")
 	}
-	{
-		e.FieldStart("lobbies")
-		e.ArrStart()
-		for _, elem := range s.Lobbies {
-			elem.Encode(e)
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
-		e.ArrEnd()
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetMultiplayerGameGuessesNotFound(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfLobbiesResponse = [2]string{
-	0: "total",
-	1: "lobbies",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetMultiplayerGameGuessesNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes LobbiesResponse from json.
-func (s *LobbiesResponse) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode LobbiesResponse to nil")
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetMultiplayerGameGuessesNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetMultiplayerGameGuessesOKApplicationJSON as json.
+func (s GetMultiplayerGameGuessesOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []MultiplayerGuess(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
 	}
-	var requiredBitSet [1]uint8
+	e.ArrEnd()
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "total":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int()
-				s.Total = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"total\"")
-			}
-		case "lobbies":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				s.Lobbies = make([]Lobby, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Lobby
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Lobbies = append(s.Lobbies, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"lobbies\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode LobbiesResponse")
+// Decode decodes GetMultiplayerGameGuessesOKApplicationJSON from json.
+func (s *GetMultiplayerGameGuessesOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetMultiplayerGameGuessesOKApplicationJSON to nil")
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfLobbiesResponse) {
-					name = jsonFieldsNameOfLobbiesResponse[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+	var unwrapped []MultiplayerGuess
+	if err := func() error {
+		unwrapped = make([]MultiplayerGuess, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem MultiplayerGuess
+			if err := elem.Decode(d); err != nil {
+				return err
 			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
+	*s = GetMultiplayerGameGuessesOKApplicationJSON(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *LobbiesResponse) MarshalJSON() ([]byte, error) {
+func (s GetMultiplayerGameGuessesOKApplicationJSON) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *LobbiesResponse) UnmarshalJSON(data []byte) error {
+func (s *GetMultiplayerGameGuessesOKApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *Lobby) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetMultiplayerGameGuessesUnauthorized as json.
+func (s *GetMultiplayerGameGuessesUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *Lobby) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Str(s.ID)
-	}
-	{
-		e.FieldStart("creatorID")
-		e.Int(s.CreatorID)
-	}
-	{
-		e.FieldStart("createdAt")
-		json.EncodeDateTime(e, s.CreatedAt)
-	}
-	{
-		e.FieldStart("rounds")
-		e.Int(s.Rounds)
-	}
-	{
-		e.FieldStart("provider")
-		s.Provider.Encode(e)
-	}
-	{
-		e.FieldStart("movementAllowed")
-		e.Bool(s.MovementAllowed)
+// Decode decodes GetMultiplayerGameGuessesUnauthorized from json.
+func (s *GetMultiplayerGameGuessesUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetMultiplayerGameGuessesUnauthorized to nil")
 	}
-	{
-		e.FieldStart("timerSeconds")
-		e.Int(s.TimerSeconds)
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	{
-		e.FieldStart("currentPlayers")
-		e.Int(s.CurrentPlayers)
+	*s = GetMultiplayerGameGuessesUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetMultiplayerGameGuessesUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetMultiplayerGameGuessesUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetMultiplayerGameInternalServerError as json.
+func (s *GetMultiplayerGameInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetMultiplayerGameInternalServerError from json.
+func (s *GetMultiplayerGameInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetMultiplayerGameInternalServerError to nil")
 	}
-	{
-		e.FieldStart("maxPlayers")
-		e.Int(s.MaxPlayers)
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetMultiplayerGameInternalServerError(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfLobby = [9]string{
-	0: "id",
-	1: "creatorID",
-	2: "createdAt",
-	3: "rounds",
-	4: "provider",
-	5: "movementAllowed",
-	6: "timerSeconds",
-	7: "currentPlayers",
-	8: "maxPlayers",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetMultiplayerGameInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes Lobby from json.
-func (s *Lobby) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetMultiplayerGameInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetMultiplayerGameNotFound as json.
+func (s *GetMultiplayerGameNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetMultiplayerGameNotFound from json.
+func (s *GetMultiplayerGameNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Lobby to nil")
+		return errors.New("invalid: unable to decode GetMultiplayerGameNotFound to nil")
 	}
-	var requiredBitSet [2]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.ID = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "creatorID":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int()
-				s.CreatorID = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"creatorID\"")
-			}
-		case "createdAt":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"createdAt\"")
-			}
-		case "rounds":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Int()
-				s.Rounds = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"rounds\"")
-			}
-		case "provider":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				if err := s.Provider.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provider\"")
-			}
-		case "movementAllowed":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.Bool()
-				s.MovementAllowed = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"movementAllowed\"")
-			}
-		case "timerSeconds":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				v, err := d.Int()
-				s.TimerSeconds = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"timerSeconds\"")
-			}
-		case "currentPlayers":
-			requiredBitSet[0] |= 1 << 7
-			if err := func() error {
-				v, err := d.Int()
-				s.CurrentPlayers = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"currentPlayers\"")
-			}
-		case "maxPlayers":
-			requiredBitSet[1] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int()
-				s.MaxPlayers = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"maxPlayers\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode Lobby")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b11111111,
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfLobby) {
-					name = jsonFieldsNameOfLobby[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetMultiplayerGameNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Lobby) MarshalJSON() ([]byte, error) {
+func (s *GetMultiplayerGameNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Lobby) UnmarshalJSON(data []byte) error {
+func (s *GetMultiplayerGameNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes LoginBadRequest as json.
-func (s *LoginBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes GetMultiplayerGameUnauthorized as json.
+func (s *GetMultiplayerGameUnauthorized) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes LoginBadRequest from json.
-func (s *LoginBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes GetMultiplayerGameUnauthorized from json.
+func (s *GetMultiplayerGameUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode LoginBadRequest to nil")
+		return errors.New("invalid: unable to decode GetMultiplayerGameUnauthorized to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -3217,34 +3379,34 @@ func (s *LoginBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = LoginBadRequest(unwrapped)
+	*s = GetMultiplayerGameUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *LoginBadRequest) MarshalJSON() ([]byte, error) {
+func (s *GetMultiplayerGameUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *LoginBadRequest) UnmarshalJSON(data []byte) error {
+func (s *GetMultiplayerGameUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes LoginInternalServerError as json.
-func (s *LoginInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes GetMultiplayerRoundInternalServerError as json.
+func (s *GetMultiplayerRoundInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes LoginInternalServerError from json.
-func (s *LoginInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes GetMultiplayerRoundInternalServerError from json.
+func (s *GetMultiplayerRoundInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode LoginInternalServerError to nil")
+		return errors.New("invalid: unable to decode GetMultiplayerRoundInternalServerError to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -3255,147 +3417,198 @@ func (s *LoginInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = LoginInternalServerError(unwrapped)
+	*s = GetMultiplayerRoundInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *LoginInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *GetMultiplayerRoundInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *LoginInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *GetMultiplayerRoundInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *LoginRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetMultiplayerRoundNotFound as json.
+func (s *GetMultiplayerRoundNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *LoginRequest) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("username")
-		e.Str(s.Username)
+// Decode decodes GetMultiplayerRoundNotFound from json.
+func (s *GetMultiplayerRoundNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetMultiplayerRoundNotFound to nil")
 	}
-	{
-		e.FieldStart("password")
-		e.Str(s.Password)
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetMultiplayerRoundNotFound(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfLoginRequest = [2]string{
-	0: "username",
-	1: "password",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetMultiplayerRoundNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes LoginRequest from json.
-func (s *LoginRequest) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetMultiplayerRoundNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetMultiplayerRoundUnauthorized as json.
+func (s *GetMultiplayerRoundUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetMultiplayerRoundUnauthorized from json.
+func (s *GetMultiplayerRoundUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode LoginRequest to nil")
+		return errors.New("invalid: unable to decode GetMultiplayerRoundUnauthorized to nil")
 	}
-	var requiredBitSet [1]uint8
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetMultiplayerRoundUnauthorized(unwrapped)
+	return nil
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "username":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.Username = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"username\"")
-			}
-		case "password":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Password = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
-			}
-		default:
-			return d.Skip()
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetMultiplayerRoundUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetMultiplayerRoundUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetOAuthProvidersInternalServerError as json.
+func (s *GetOAuthProvidersInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetOAuthProvidersInternalServerError from json.
+func (s *GetOAuthProvidersInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetOAuthProvidersInternalServerError to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode LoginRequest")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfLoginRequest) {
-					name = jsonFieldsNameOfLoginRequest[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+	*s = GetOAuthProvidersInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetOAuthProvidersInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetOAuthProvidersInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetOAuthProvidersOKApplicationJSON as json.
+func (s GetOAuthProvidersOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []AuthProvider(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes GetOAuthProvidersOKApplicationJSON from json.
+func (s *GetOAuthProvidersOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetOAuthProvidersOKApplicationJSON to nil")
+	}
+	var unwrapped []AuthProvider
+	if err := func() error {
+		unwrapped = make([]AuthProvider, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem AuthProvider
+			if err := elem.Decode(d); err != nil {
+				return err
 			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
+	*s = GetOAuthProvidersOKApplicationJSON(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *LoginRequest) MarshalJSON() ([]byte, error) {
+func (s GetOAuthProvidersOKApplicationJSON) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *LoginRequest) UnmarshalJSON(data []byte) error {
+func (s *GetOAuthProvidersOKApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes LoginUnauthorized as json.
-func (s *LoginUnauthorized) Encode(e *jx.Encoder) {
+// Encode encodes GetOAuthProvidersUnauthorized as json.
+func (s *GetOAuthProvidersUnauthorized) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes LoginUnauthorized from json.
-func (s *LoginUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes GetOAuthProvidersUnauthorized from json.
+func (s *GetOAuthProvidersUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode LoginUnauthorized to nil")
+		return errors.New("invalid: unable to decode GetOAuthProvidersUnauthorized to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -3406,781 +3619,312 @@ func (s *LoginUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = LoginUnauthorized(unwrapped)
+	*s = GetOAuthProvidersUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *LoginUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *GetOAuthProvidersUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *LoginUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *GetOAuthProvidersUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MultiplayerGame) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetPanoramaBadRequest as json.
+func (s *GetPanoramaBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *MultiplayerGame) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int(s.ID)
-	}
-	{
-		e.FieldStart("creatorID")
-		e.Int(s.CreatorID)
-	}
-	{
-		e.FieldStart("rounds")
-		e.Int(s.Rounds)
-	}
-	{
-		e.FieldStart("roundCurrent")
-		e.Int(s.RoundCurrent)
-	}
-	{
-		e.FieldStart("timerSeconds")
-		e.Int(s.TimerSeconds)
-	}
-	{
-		e.FieldStart("movementAllowed")
-		e.Bool(s.MovementAllowed)
-	}
-	{
-		e.FieldStart("players")
-		e.Int(s.Players)
-	}
-	{
-		e.FieldStart("provider")
-		s.Provider.Encode(e)
-	}
-	{
-		e.FieldStart("finished")
-		e.Bool(s.Finished)
+// Decode decodes GetPanoramaBadRequest from json.
+func (s *GetPanoramaBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetPanoramaBadRequest to nil")
 	}
-	{
-		e.FieldStart("createdAt")
-		json.EncodeDateTime(e, s.CreatedAt)
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetPanoramaBadRequest(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfMultiplayerGame = [10]string{
-	0: "id",
-	1: "creatorID",
-	2: "rounds",
-	3: "roundCurrent",
-	4: "timerSeconds",
-	5: "movementAllowed",
-	6: "players",
-	7: "provider",
-	8: "finished",
-	9: "createdAt",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetPanoramaBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes MultiplayerGame from json.
-func (s *MultiplayerGame) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetPanoramaBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetPanoramaInternalServerError as json.
+func (s *GetPanoramaInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetPanoramaInternalServerError from json.
+func (s *GetPanoramaInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode MultiplayerGame to nil")
+		return errors.New("invalid: unable to decode GetPanoramaInternalServerError to nil")
 	}
-	var requiredBitSet [2]uint8
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetPanoramaInternalServerError(unwrapped)
+	return nil
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int()
-				s.ID = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "creatorID":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int()
-				s.CreatorID = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"creatorID\"")
-			}
-		case "rounds":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Int()
-				s.Rounds = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"rounds\"")
-			}
-		case "roundCurrent":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Int()
-				s.RoundCurrent = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"roundCurrent\"")
-			}
-		case "timerSeconds":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Int()
-				s.TimerSeconds = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"timerSeconds\"")
-			}
-		case "movementAllowed":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.Bool()
-				s.MovementAllowed = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"movementAllowed\"")
-			}
-		case "players":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				v, err := d.Int()
-				s.Players = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"players\"")
-			}
-		case "provider":
-			requiredBitSet[0] |= 1 << 7
-			if err := func() error {
-				if err := s.Provider.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provider\"")
-			}
-		case "finished":
-			requiredBitSet[1] |= 1 << 0
-			if err := func() error {
-				v, err := d.Bool()
-				s.Finished = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"finished\"")
-			}
-		case "createdAt":
-			requiredBitSet[1] |= 1 << 1
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"createdAt\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MultiplayerGame")
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetPanoramaInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetPanoramaInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetPanoramaProvidersOKApplicationJSON as json.
+func (s GetPanoramaProvidersOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []Provider(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b11111111,
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfMultiplayerGame) {
-					name = jsonFieldsNameOfMultiplayerGame[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+	e.ArrEnd()
+}
+
+// Decode decodes GetPanoramaProvidersOKApplicationJSON from json.
+func (s *GetPanoramaProvidersOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetPanoramaProvidersOKApplicationJSON to nil")
+	}
+	var unwrapped []Provider
+	if err := func() error {
+		unwrapped = make([]Provider, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem Provider
+			if err := elem.Decode(d); err != nil {
+				return err
 			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
+	*s = GetPanoramaProvidersOKApplicationJSON(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MultiplayerGame) MarshalJSON() ([]byte, error) {
+func (s GetPanoramaProvidersOKApplicationJSON) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MultiplayerGame) UnmarshalJSON(data []byte) error {
+func (s *GetPanoramaProvidersOKApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MultiplayerGuess) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetPanoramaUnauthorized as json.
+func (s *GetPanoramaUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *MultiplayerGuess) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("username")
-		e.Str(s.Username)
-	}
-	{
-		e.FieldStart("avatarHash")
-		e.Str(s.AvatarHash)
-	}
-	{
-		e.FieldStart("roundNum")
-		e.Int(s.RoundNum)
-	}
-	{
-		e.FieldStart("roundLat")
-		e.Float64(s.RoundLat)
-	}
-	{
-		e.FieldStart("roundLng")
-		e.Float64(s.RoundLng)
-	}
-	{
-		e.FieldStart("lat")
-		e.Float64(s.Lat)
-	}
-	{
-		e.FieldStart("lng")
-		e.Float64(s.Lng)
+// Decode decodes GetPanoramaUnauthorized from json.
+func (s *GetPanoramaUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetPanoramaUnauthorized to nil")
 	}
-	{
-		e.FieldStart("score")
-		e.Int(s.Score)
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetPanoramaUnauthorized(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfMultiplayerGuess = [8]string{
-	0: "username",
-	1: "avatarHash",
-	2: "roundNum",
-	3: "roundLat",
-	4: "roundLng",
-	5: "lat",
-	6: "lng",
-	7: "score",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetPanoramaUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes MultiplayerGuess from json.
-func (s *MultiplayerGuess) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetPanoramaUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetPrivateProfileInternalServerError as json.
+func (s *GetPrivateProfileInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetPrivateProfileInternalServerError from json.
+func (s *GetPrivateProfileInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode MultiplayerGuess to nil")
+		return errors.New("invalid: unable to decode GetPrivateProfileInternalServerError to nil")
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "username":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.Username = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"username\"")
-			}
-		case "avatarHash":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.AvatarHash = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"avatarHash\"")
-			}
-		case "roundNum":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Int()
-				s.RoundNum = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"roundNum\"")
-			}
-		case "roundLat":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Float64()
-				s.RoundLat = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"roundLat\"")
-			}
-		case "roundLng":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Float64()
-				s.RoundLng = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"roundLng\"")
-			}
-		case "lat":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.Float64()
-				s.Lat = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"lat\"")
-			}
-		case "lng":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				v, err := d.Float64()
-				s.Lng = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"lng\"")
-			}
-		case "score":
-			requiredBitSet[0] |= 1 << 7
-			if err := func() error {
-				v, err := d.Int()
-				s.Score = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"score\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MultiplayerGuess")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b11111111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfMultiplayerGuess) {
-					name = jsonFieldsNameOfMultiplayerGuess[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetPrivateProfileInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MultiplayerGuess) MarshalJSON() ([]byte, error) {
+func (s *GetPrivateProfileInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MultiplayerGuess) UnmarshalJSON(data []byte) error {
+func (s *GetPrivateProfileInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *MultiplayerRound) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetPrivateProfileUnauthorized as json.
+func (s *GetPrivateProfileUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *MultiplayerRound) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int(s.ID)
-	}
-	{
-		e.FieldStart("gameID")
-		e.Int(s.GameID)
-	}
-	{
-		e.FieldStart("streetviewID")
-		e.Str(s.StreetviewID)
-	}
-	{
-		e.FieldStart("roundNum")
-		e.Int(s.RoundNum)
-	}
-	{
-		e.FieldStart("lat")
-		e.Float64(s.Lat)
-	}
-	{
-		e.FieldStart("lng")
-		e.Float64(s.Lng)
-	}
-	{
-		e.FieldStart("panoramaURL")
-		e.Str(s.PanoramaURL)
-	}
-	{
-		e.FieldStart("guessesCount")
-		e.Int(s.GuessesCount)
-	}
-	{
-		e.FieldStart("finished")
-		e.Bool(s.Finished)
-	}
-	{
-		e.FieldStart("createdAt")
-		json.EncodeDateTime(e, s.CreatedAt)
-	}
-	{
-		e.FieldStart("startedAt")
-		json.EncodeDateTime(e, s.StartedAt)
+// Decode decodes GetPrivateProfileUnauthorized from json.
+func (s *GetPrivateProfileUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetPrivateProfileUnauthorized to nil")
 	}
-	{
-		e.FieldStart("endedAt")
-		json.EncodeDateTime(e, s.EndedAt)
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetPrivateProfileUnauthorized(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfMultiplayerRound = [12]string{
-	0:  "id",
-	1:  "gameID",
-	2:  "streetviewID",
-	3:  "roundNum",
-	4:  "lat",
-	5:  "lng",
-	6:  "panoramaURL",
-	7:  "guessesCount",
-	8:  "finished",
-	9:  "createdAt",
-	10: "startedAt",
-	11: "endedAt",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetPrivateProfileUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes MultiplayerRound from json.
-func (s *MultiplayerRound) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetPrivateProfileUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetPublicProfileInternalServerError as json.
+func (s *GetPublicProfileInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetPublicProfileInternalServerError from json.
+func (s *GetPublicProfileInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode MultiplayerRound to nil")
+		return errors.New("invalid: unable to decode GetPublicProfileInternalServerError to nil")
 	}
-	var requiredBitSet [2]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int()
-				s.ID = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "gameID":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int()
-				s.GameID = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"gameID\"")
-			}
-		case "streetviewID":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Str()
-				s.StreetviewID = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"streetviewID\"")
-			}
-		case "roundNum":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Int()
-				s.RoundNum = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"roundNum\"")
-			}
-		case "lat":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Float64()
-				s.Lat = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"lat\"")
-			}
-		case "lng":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.Float64()
-				s.Lng = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"lng\"")
-			}
-		case "panoramaURL":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				v, err := d.Str()
-				s.PanoramaURL = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"panoramaURL\"")
-			}
-		case "guessesCount":
-			requiredBitSet[0] |= 1 << 7
-			if err := func() error {
-				v, err := d.Int()
-				s.GuessesCount = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"guessesCount\"")
-			}
-		case "finished":
-			requiredBitSet[1] |= 1 << 0
-			if err := func() error {
-				v, err := d.Bool()
-				s.Finished = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"finished\"")
-			}
-		case "createdAt":
-			requiredBitSet[1] |= 1 << 1
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"createdAt\"")
-			}
-		case "startedAt":
-			requiredBitSet[1] |= 1 << 2
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.StartedAt = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"startedAt\"")
-			}
-		case "endedAt":
-			requiredBitSet[1] |= 1 << 3
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.EndedAt = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"endedAt\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MultiplayerRound")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b11111111,
-		0b00001111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfMultiplayerRound) {
-					name = jsonFieldsNameOfMultiplayerRound[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetPublicProfileInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MultiplayerRound) MarshalJSON() ([]byte, error) {
+func (s *GetPublicProfileInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MultiplayerRound) UnmarshalJSON(data []byte) error {
+func (s *GetPublicProfileInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewDiscordCallbackBadRequest as json.
-func (s *NewDiscordCallbackBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes GetPublicProfileNotFound as json.
+func (s *GetPublicProfileNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes NewDiscordCallbackBadRequest from json.
-func (s *NewDiscordCallbackBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes GetPublicProfileNotFound from json.
+func (s *GetPublicProfileNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewDiscordCallbackBadRequest to nil")
+		return errors.New("invalid: unable to decode GetPublicProfileNotFound to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -4191,34 +3935,34 @@ func (s *NewDiscordCallbackBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = NewDiscordCallbackBadRequest(unwrapped)
+	*s = GetPublicProfileNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewDiscordCallbackBadRequest) MarshalJSON() ([]byte, error) {
+func (s *GetPublicProfileNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewDiscordCallbackBadRequest) UnmarshalJSON(data []byte) error {
+func (s *GetPublicProfileNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewDiscordCallbackInternalServerError as json.
-func (s *NewDiscordCallbackInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes GetSingleplayerChallengeBadRequest as json.
+func (s *GetSingleplayerChallengeBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes NewDiscordCallbackInternalServerError from json.
-func (s *NewDiscordCallbackInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes GetSingleplayerChallengeBadRequest from json.
+func (s *GetSingleplayerChallengeBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewDiscordCallbackInternalServerError to nil")
+		return errors.New("invalid: unable to decode GetSingleplayerChallengeBadRequest to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -4229,34 +3973,34 @@ func (s *NewDiscordCallbackInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = NewDiscordCallbackInternalServerError(unwrapped)
+	*s = GetSingleplayerChallengeBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewDiscordCallbackInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *GetSingleplayerChallengeBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewDiscordCallbackInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerChallengeBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewDiscordCallbackUnauthorized as json.
-func (s *NewDiscordCallbackUnauthorized) Encode(e *jx.Encoder) {
+// Encode encodes GetSingleplayerChallengeInternalServerError as json.
+func (s *GetSingleplayerChallengeInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes NewDiscordCallbackUnauthorized from json.
-func (s *NewDiscordCallbackUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes GetSingleplayerChallengeInternalServerError from json.
+func (s *GetSingleplayerChallengeInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewDiscordCallbackUnauthorized to nil")
+		return errors.New("invalid: unable to decode GetSingleplayerChallengeInternalServerError to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -4267,213 +4011,72 @@ func (s *NewDiscordCallbackUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = NewDiscordCallbackUnauthorized(unwrapped)
+	*s = GetSingleplayerChallengeInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewDiscordCallbackUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *GetSingleplayerChallengeInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewDiscordCallbackUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerChallengeInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *NewLobby) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetSingleplayerChallengeNotFound as json.
+func (s *GetSingleplayerChallengeNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *NewLobby) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("creatorID")
-		e.Int(s.CreatorID)
-	}
-	{
-		e.FieldStart("maxPlayers")
-		e.Int(s.MaxPlayers)
-	}
-	{
-		e.FieldStart("rounds")
-		e.Int(s.Rounds)
-	}
-	{
-		e.FieldStart("provider")
-		s.Provider.Encode(e)
+// Decode decodes GetSingleplayerChallengeNotFound from json.
+func (s *GetSingleplayerChallengeNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetSingleplayerChallengeNotFound to nil")
 	}
-	{
-		if s.TimerSeconds.Set {
-			e.FieldStart("timerSeconds")
-			s.TimerSeconds.Encode(e)
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	{
-		e.FieldStart("movementAllowed")
-		e.Bool(s.MovementAllowed)
-	}
-}
-
-var jsonFieldsNameOfNewLobby = [6]string{
-	0: "creatorID",
-	1: "maxPlayers",
-	2: "rounds",
-	3: "provider",
-	4: "timerSeconds",
-	5: "movementAllowed",
-}
-
-// Decode decodes NewLobby from json.
-func (s *NewLobby) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode NewLobby to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "creatorID":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int()
-				s.CreatorID = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"creatorID\"")
-			}
-		case "maxPlayers":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int()
-				s.MaxPlayers = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"maxPlayers\"")
-			}
-		case "rounds":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Int()
-				s.Rounds = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"rounds\"")
-			}
-		case "provider":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				if err := s.Provider.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provider\"")
-			}
-		case "timerSeconds":
-			if err := func() error {
-				s.TimerSeconds.Reset()
-				if err := s.TimerSeconds.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"timerSeconds\"")
-			}
-		case "movementAllowed":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.Bool()
-				s.MovementAllowed = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"movementAllowed\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode NewLobby")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00101111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfNewLobby) {
-					name = jsonFieldsNameOfNewLobby[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
+	*s = GetSingleplayerChallengeNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewLobby) MarshalJSON() ([]byte, error) {
+func (s *GetSingleplayerChallengeNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewLobby) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerChallengeNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewLobbyBadRequest as json.
-func (s *NewLobbyBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes GetSingleplayerChallengeUnauthorized as json.
+func (s *GetSingleplayerChallengeUnauthorized) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes NewLobbyBadRequest from json.
-func (s *NewLobbyBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes GetSingleplayerChallengeUnauthorized from json.
+func (s *GetSingleplayerChallengeUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewLobbyBadRequest to nil")
+		return errors.New("invalid: unable to decode GetSingleplayerChallengeUnauthorized to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -4484,130 +4087,72 @@ func (s *NewLobbyBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = NewLobbyBadRequest(unwrapped)
+	*s = GetSingleplayerChallengeUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewLobbyBadRequest) MarshalJSON() ([]byte, error) {
+func (s *GetSingleplayerChallengeUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewLobbyBadRequest) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerChallengeUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *NewLobbyCreated) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *NewLobbyCreated) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Str(s.ID)
-	}
-}
+// Encode encodes GetSingleplayerGameForbidden as json.
+func (s *GetSingleplayerGameForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
 
-var jsonFieldsNameOfNewLobbyCreated = [1]string{
-	0: "id",
+	unwrapped.Encode(e)
 }
 
-// Decode decodes NewLobbyCreated from json.
-func (s *NewLobbyCreated) Decode(d *jx.Decoder) error {
+// Decode decodes GetSingleplayerGameForbidden from json.
+func (s *GetSingleplayerGameForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewLobbyCreated to nil")
+		return errors.New("invalid: unable to decode GetSingleplayerGameForbidden to nil")
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.ID = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode NewLobbyCreated")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfNewLobbyCreated) {
-					name = jsonFieldsNameOfNewLobbyCreated[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetSingleplayerGameForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewLobbyCreated) MarshalJSON() ([]byte, error) {
+func (s *GetSingleplayerGameForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewLobbyCreated) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerGameForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewLobbyInternalServerError as json.
-func (s *NewLobbyInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes GetSingleplayerGameInternalServerError as json.
+func (s *GetSingleplayerGameInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes NewLobbyInternalServerError from json.
-func (s *NewLobbyInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes GetSingleplayerGameInternalServerError from json.
+func (s *GetSingleplayerGameInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewLobbyInternalServerError to nil")
+		return errors.New("invalid: unable to decode GetSingleplayerGameInternalServerError to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -4618,34 +4163,34 @@ func (s *NewLobbyInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = NewLobbyInternalServerError(unwrapped)
+	*s = GetSingleplayerGameInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewLobbyInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *GetSingleplayerGameInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewLobbyInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerGameInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewMultiplayerRoundInternalServerError as json.
-func (s *NewMultiplayerRoundInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes GetSingleplayerGameNotFound as json.
+func (s *GetSingleplayerGameNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes NewMultiplayerRoundInternalServerError from json.
-func (s *NewMultiplayerRoundInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes GetSingleplayerGameNotFound from json.
+func (s *GetSingleplayerGameNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewMultiplayerRoundInternalServerError to nil")
+		return errors.New("invalid: unable to decode GetSingleplayerGameNotFound to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -4656,34 +4201,34 @@ func (s *NewMultiplayerRoundInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = NewMultiplayerRoundInternalServerError(unwrapped)
+	*s = GetSingleplayerGameNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewMultiplayerRoundInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *GetSingleplayerGameNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewMultiplayerRoundInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerGameNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewMultiplayerRoundNotFound as json.
-func (s *NewMultiplayerRoundNotFound) Encode(e *jx.Encoder) {
+// Encode encodes GetSingleplayerGameRoundsBadRequest as json.
+func (s *GetSingleplayerGameRoundsBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes NewMultiplayerRoundNotFound from json.
-func (s *NewMultiplayerRoundNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes GetSingleplayerGameRoundsBadRequest from json.
+func (s *GetSingleplayerGameRoundsBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewMultiplayerRoundNotFound to nil")
+		return errors.New("invalid: unable to decode GetSingleplayerGameRoundsBadRequest to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -4694,34 +4239,34 @@ func (s *NewMultiplayerRoundNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = NewMultiplayerRoundNotFound(unwrapped)
+	*s = GetSingleplayerGameRoundsBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewMultiplayerRoundNotFound) MarshalJSON() ([]byte, error) {
+func (s *GetSingleplayerGameRoundsBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewMultiplayerRoundNotFound) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerGameRoundsBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewMultiplayerRoundUnauthorized as json.
-func (s *NewMultiplayerRoundUnauthorized) Encode(e *jx.Encoder) {
+// Encode encodes GetSingleplayerGameRoundsForbidden as json.
+func (s *GetSingleplayerGameRoundsForbidden) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes NewMultiplayerRoundUnauthorized from json.
-func (s *NewMultiplayerRoundUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes GetSingleplayerGameRoundsForbidden from json.
+func (s *GetSingleplayerGameRoundsForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewMultiplayerRoundUnauthorized to nil")
+		return errors.New("invalid: unable to decode GetSingleplayerGameRoundsForbidden to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -4732,34 +4277,34 @@ func (s *NewMultiplayerRoundUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = NewMultiplayerRoundUnauthorized(unwrapped)
+	*s = GetSingleplayerGameRoundsForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewMultiplayerRoundUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *GetSingleplayerGameRoundsForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewMultiplayerRoundUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerGameRoundsForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewSingleplayerGameBadRequest as json.
-func (s *NewSingleplayerGameBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes GetSingleplayerGameRoundsInternalServerError as json.
+func (s *GetSingleplayerGameRoundsInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes NewSingleplayerGameBadRequest from json.
-func (s *NewSingleplayerGameBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes GetSingleplayerGameRoundsInternalServerError from json.
+func (s *GetSingleplayerGameRoundsInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewSingleplayerGameBadRequest to nil")
+		return errors.New("invalid: unable to decode GetSingleplayerGameRoundsInternalServerError to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -4770,130 +4315,122 @@ func (s *NewSingleplayerGameBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = NewSingleplayerGameBadRequest(unwrapped)
+	*s = GetSingleplayerGameRoundsInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewSingleplayerGameBadRequest) MarshalJSON() ([]byte, error) {
+func (s *GetSingleplayerGameRoundsInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewSingleplayerGameBadRequest) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerGameRoundsInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *NewSingleplayerGameCreated) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetSingleplayerGameRoundsNotFound as json.
+func (s *GetSingleplayerGameRoundsNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *NewSingleplayerGameCreated) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int(s.ID)
+// Decode decodes GetSingleplayerGameRoundsNotFound from json.
+func (s *GetSingleplayerGameRoundsNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetSingleplayerGameRoundsNotFound to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetSingleplayerGameRoundsNotFound(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfNewSingleplayerGameCreated = [1]string{
-	0: "id",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetSingleplayerGameRoundsNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes NewSingleplayerGameCreated from json.
-func (s *NewSingleplayerGameCreated) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode NewSingleplayerGameCreated to nil")
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetSingleplayerGameRoundsNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetSingleplayerGameRoundsOKApplicationJSON as json.
+func (s GetSingleplayerGameRoundsOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []SingleplayerRoundsWithGuess(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
 	}
-	var requiredBitSet [1]uint8
+	e.ArrEnd()
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int()
-				s.ID = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode NewSingleplayerGameCreated")
+// Decode decodes GetSingleplayerGameRoundsOKApplicationJSON from json.
+func (s *GetSingleplayerGameRoundsOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetSingleplayerGameRoundsOKApplicationJSON to nil")
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfNewSingleplayerGameCreated) {
-					name = jsonFieldsNameOfNewSingleplayerGameCreated[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+	var unwrapped []SingleplayerRoundsWithGuess
+	if err := func() error {
+		unwrapped = make([]SingleplayerRoundsWithGuess, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem SingleplayerRoundsWithGuess
+			if err := elem.Decode(d); err != nil {
+				return err
 			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
+	*s = GetSingleplayerGameRoundsOKApplicationJSON(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewSingleplayerGameCreated) MarshalJSON() ([]byte, error) {
+func (s GetSingleplayerGameRoundsOKApplicationJSON) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewSingleplayerGameCreated) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerGameRoundsOKApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewSingleplayerGameInternalServerError as json.
-func (s *NewSingleplayerGameInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes GetSingleplayerGameRoundsUnauthorized as json.
+func (s *GetSingleplayerGameRoundsUnauthorized) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes NewSingleplayerGameInternalServerError from json.
-func (s *NewSingleplayerGameInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes GetSingleplayerGameRoundsUnauthorized from json.
+func (s *GetSingleplayerGameRoundsUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewSingleplayerGameInternalServerError to nil")
+		return errors.New("invalid: unable to decode GetSingleplayerGameRoundsUnauthorized to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -4904,179 +4441,148 @@ func (s *NewSingleplayerGameInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = NewSingleplayerGameInternalServerError(unwrapped)
+	*s = GetSingleplayerGameRoundsUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewSingleplayerGameInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *GetSingleplayerGameRoundsUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewSingleplayerGameInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerGameRoundsUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *NewSingleplayerGameRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetSingleplayerGameUnauthorized as json.
+func (s *GetSingleplayerGameUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *NewSingleplayerGameRequest) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("rounds")
-		e.Int(s.Rounds)
+// Decode decodes GetSingleplayerGameUnauthorized from json.
+func (s *GetSingleplayerGameUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetSingleplayerGameUnauthorized to nil")
 	}
-	{
-		if s.TimerSeconds.Set {
-			e.FieldStart("timerSeconds")
-			s.TimerSeconds.Encode(e)
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	{
-		e.FieldStart("movementAllowed")
-		e.Bool(s.MovementAllowed)
-	}
-	{
-		e.FieldStart("provider")
-		s.Provider.Encode(e)
-	}
+	*s = GetSingleplayerGameUnauthorized(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfNewSingleplayerGameRequest = [4]string{
-	0: "rounds",
-	1: "timerSeconds",
-	2: "movementAllowed",
-	3: "provider",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetSingleplayerGameUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes NewSingleplayerGameRequest from json.
-func (s *NewSingleplayerGameRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode NewSingleplayerGameRequest to nil")
-	}
-	var requiredBitSet [1]uint8
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetSingleplayerGameUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "rounds":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int()
-				s.Rounds = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"rounds\"")
-			}
-		case "timerSeconds":
-			if err := func() error {
-				s.TimerSeconds.Reset()
-				if err := s.TimerSeconds.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"timerSeconds\"")
-			}
-		case "movementAllowed":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Bool()
-				s.MovementAllowed = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"movementAllowed\"")
-			}
-		case "provider":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				if err := s.Provider.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provider\"")
-			}
-		default:
-			return d.Skip()
+// Encode encodes GetSingleplayerGamesBadRequest as json.
+func (s *GetSingleplayerGamesBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetSingleplayerGamesBadRequest from json.
+func (s *GetSingleplayerGamesBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetSingleplayerGamesBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode NewSingleplayerGameRequest")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00001101,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfNewSingleplayerGameRequest) {
-					name = jsonFieldsNameOfNewSingleplayerGameRequest[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+	*s = GetSingleplayerGamesBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetSingleplayerGamesBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetSingleplayerGamesBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetSingleplayerGamesInternalServerError as json.
+func (s *GetSingleplayerGamesInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetSingleplayerGamesInternalServerError from json.
+func (s *GetSingleplayerGamesInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetSingleplayerGamesInternalServerError to nil")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetSingleplayerGamesInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewSingleplayerGameRequest) MarshalJSON() ([]byte, error) {
+func (s *GetSingleplayerGamesInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewSingleplayerGameRequest) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerGamesInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewSingleplayerGameUnauthorized as json.
-func (s *NewSingleplayerGameUnauthorized) Encode(e *jx.Encoder) {
+// Encode encodes GetSingleplayerGamesUnauthorized as json.
+func (s *GetSingleplayerGamesUnauthorized) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes NewSingleplayerGameUnauthorized from json.
-func (s *NewSingleplayerGameUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes GetSingleplayerGamesUnauthorized from json.
+func (s *GetSingleplayerGamesUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewSingleplayerGameUnauthorized to nil")
+		return errors.New("invalid: unable to decode GetSingleplayerGamesUnauthorized to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -5087,34 +4593,34 @@ func (s *NewSingleplayerGameUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = NewSingleplayerGameUnauthorized(unwrapped)
+	*s = GetSingleplayerGamesUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewSingleplayerGameUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *GetSingleplayerGamesUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewSingleplayerGameUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerGamesUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewSingleplayerRoundBadRequest as json.
-func (s *NewSingleplayerRoundBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes GetSingleplayerRoundBadRequest as json.
+func (s *GetSingleplayerRoundBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes NewSingleplayerRoundBadRequest from json.
-func (s *NewSingleplayerRoundBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes GetSingleplayerRoundBadRequest from json.
+func (s *GetSingleplayerRoundBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewSingleplayerRoundBadRequest to nil")
+		return errors.New("invalid: unable to decode GetSingleplayerRoundBadRequest to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -5125,34 +4631,34 @@ func (s *NewSingleplayerRoundBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = NewSingleplayerRoundBadRequest(unwrapped)
+	*s = GetSingleplayerRoundBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewSingleplayerRoundBadRequest) MarshalJSON() ([]byte, error) {
+func (s *GetSingleplayerRoundBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewSingleplayerRoundBadRequest) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerRoundBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewSingleplayerRoundForbidden as json.
-func (s *NewSingleplayerRoundForbidden) Encode(e *jx.Encoder) {
+// Encode encodes GetSingleplayerRoundForbidden as json.
+func (s *GetSingleplayerRoundForbidden) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes NewSingleplayerRoundForbidden from json.
-func (s *NewSingleplayerRoundForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes GetSingleplayerRoundForbidden from json.
+func (s *GetSingleplayerRoundForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewSingleplayerRoundForbidden to nil")
+		return errors.New("invalid: unable to decode GetSingleplayerRoundForbidden to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -5163,34 +4669,34 @@ func (s *NewSingleplayerRoundForbidden) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = NewSingleplayerRoundForbidden(unwrapped)
+	*s = GetSingleplayerRoundForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewSingleplayerRoundForbidden) MarshalJSON() ([]byte, error) {
+func (s *GetSingleplayerRoundForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewSingleplayerRoundForbidden) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerRoundForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewSingleplayerRoundInternalServerError as json.
-func (s *NewSingleplayerRoundInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes GetSingleplayerRoundInternalServerError as json.
+func (s *GetSingleplayerRoundInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes NewSingleplayerRoundInternalServerError from json.
-func (s *NewSingleplayerRoundInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes GetSingleplayerRoundInternalServerError from json.
+func (s *GetSingleplayerRoundInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewSingleplayerRoundInternalServerError to nil")
+		return errors.New("invalid: unable to decode GetSingleplayerRoundInternalServerError to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -5201,34 +4707,34 @@ func (s *NewSingleplayerRoundInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = NewSingleplayerRoundInternalServerError(unwrapped)
+	*s = GetSingleplayerRoundInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewSingleplayerRoundInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *GetSingleplayerRoundInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewSingleplayerRoundInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerRoundInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewSingleplayerRoundNotFound as json.
-func (s *NewSingleplayerRoundNotFound) Encode(e *jx.Encoder) {
+// Encode encodes GetSingleplayerRoundNotFound as json.
+func (s *GetSingleplayerRoundNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes NewSingleplayerRoundNotFound from json.
-func (s *NewSingleplayerRoundNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes GetSingleplayerRoundNotFound from json.
+func (s *GetSingleplayerRoundNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewSingleplayerRoundNotFound to nil")
+		return errors.New("invalid: unable to decode GetSingleplayerRoundNotFound to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -5239,34 +4745,34 @@ func (s *NewSingleplayerRoundNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = NewSingleplayerRoundNotFound(unwrapped)
+	*s = GetSingleplayerRoundNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewSingleplayerRoundNotFound) MarshalJSON() ([]byte, error) {
+func (s *GetSingleplayerRoundNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewSingleplayerRoundNotFound) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerRoundNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewSingleplayerRoundUnauthorized as json.
-func (s *NewSingleplayerRoundUnauthorized) Encode(e *jx.Encoder) {
+// Encode encodes GetSingleplayerRoundUnauthorized as json.
+func (s *GetSingleplayerRoundUnauthorized) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes NewSingleplayerRoundUnauthorized from json.
-func (s *NewSingleplayerRoundUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes GetSingleplayerRoundUnauthorized from json.
+func (s *GetSingleplayerRoundUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewSingleplayerRoundUnauthorized to nil")
+		return errors.New("invalid: unable to decode GetSingleplayerRoundUnauthorized to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -5277,34 +4783,34 @@ func (s *NewSingleplayerRoundUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = NewSingleplayerRoundUnauthorized(unwrapped)
+	*s = GetSingleplayerRoundUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewSingleplayerRoundUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *GetSingleplayerRoundUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewSingleplayerRoundUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *GetSingleplayerRoundUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewYandexCallbackBadRequest as json.
-func (s *NewYandexCallbackBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes GetStreakGameForbidden as json.
+func (s *GetStreakGameForbidden) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes NewYandexCallbackBadRequest from json.
-func (s *NewYandexCallbackBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes GetStreakGameForbidden from json.
+func (s *GetStreakGameForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewYandexCallbackBadRequest to nil")
+		return errors.New("invalid: unable to decode GetStreakGameForbidden to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
@@ -5315,335 +4821,8752 @@ func (s *NewYandexCallbackBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = NewYandexCallbackBadRequest(unwrapped)
+	*s = GetStreakGameForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewYandexCallbackBadRequest) MarshalJSON() ([]byte, error) {
+func (s *GetStreakGameForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewYandexCallbackBadRequest) UnmarshalJSON(data []byte) error {
+func (s *GetStreakGameForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewYandexCallbackInternalServerError as json.
-func (s *NewYandexCallbackInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes GetStreakGameInternalServerError as json.
+func (s *GetStreakGameInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*Error)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes NewYandexCallbackInternalServerError from json.
-func (s *NewYandexCallbackInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes GetStreakGameInternalServerError from json.
+func (s *GetStreakGameInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NewYandexCallbackInternalServerError to nil")
+		return errors.New("invalid: unable to decode GetStreakGameInternalServerError to nil")
 	}
 	var unwrapped Error
 	if err := func() error {
 		if err := unwrapped.Decode(d); err != nil {
 			return err
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetStreakGameInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetStreakGameInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetStreakGameInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetStreakGameNotFound as json.
+func (s *GetStreakGameNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetStreakGameNotFound from json.
+func (s *GetStreakGameNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetStreakGameNotFound to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetStreakGameNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetStreakGameNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetStreakGameNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetStreakGameUnauthorized as json.
+func (s *GetStreakGameUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetStreakGameUnauthorized from json.
+func (s *GetStreakGameUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetStreakGameUnauthorized to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetStreakGameUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetStreakGameUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetStreakGameUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetStreakLeaderboardBadRequest as json.
+func (s *GetStreakLeaderboardBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetStreakLeaderboardBadRequest from json.
+func (s *GetStreakLeaderboardBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetStreakLeaderboardBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetStreakLeaderboardBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetStreakLeaderboardBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetStreakLeaderboardBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetStreakLeaderboardInternalServerError as json.
+func (s *GetStreakLeaderboardInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetStreakLeaderboardInternalServerError from json.
+func (s *GetStreakLeaderboardInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetStreakLeaderboardInternalServerError to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetStreakLeaderboardInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetStreakLeaderboardInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetStreakLeaderboardInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetStreakRoundForbidden as json.
+func (s *GetStreakRoundForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetStreakRoundForbidden from json.
+func (s *GetStreakRoundForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetStreakRoundForbidden to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetStreakRoundForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetStreakRoundForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetStreakRoundForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetStreakRoundInternalServerError as json.
+func (s *GetStreakRoundInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetStreakRoundInternalServerError from json.
+func (s *GetStreakRoundInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetStreakRoundInternalServerError to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetStreakRoundInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetStreakRoundInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetStreakRoundInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetStreakRoundNotFound as json.
+func (s *GetStreakRoundNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetStreakRoundNotFound from json.
+func (s *GetStreakRoundNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetStreakRoundNotFound to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetStreakRoundNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetStreakRoundNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetStreakRoundNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetStreakRoundUnauthorized as json.
+func (s *GetStreakRoundUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetStreakRoundUnauthorized from json.
+func (s *GetStreakRoundUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetStreakRoundUnauthorized to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetStreakRoundUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetStreakRoundUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetStreakRoundUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetUserSessionsOKApplicationJSON as json.
+func (s GetUserSessionsOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []GetUserSessionsOKItem(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes GetUserSessionsOKApplicationJSON from json.
+func (s *GetUserSessionsOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetUserSessionsOKApplicationJSON to nil")
+	}
+	var unwrapped []GetUserSessionsOKItem
+	if err := func() error {
+		unwrapped = make([]GetUserSessionsOKItem, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem GetUserSessionsOKItem
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetUserSessionsOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s GetUserSessionsOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetUserSessionsOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *GetUserSessionsOKItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *GetUserSessionsOKItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("sessionID")
+		e.Str(s.SessionID)
+	}
+	{
+		e.FieldStart("userID")
+		e.Int(s.UserID)
+	}
+	{
+		e.FieldStart("refreshToken")
+		e.Str(s.RefreshToken)
+	}
+	{
+		e.FieldStart("ua")
+		e.Str(s.Ua)
+	}
+	{
+		e.FieldStart("lastActive")
+		json.EncodeDateTime(e, s.LastActive)
+	}
+}
+
+var jsonFieldsNameOfGetUserSessionsOKItem = [5]string{
+	0: "sessionID",
+	1: "userID",
+	2: "refreshToken",
+	3: "ua",
+	4: "lastActive",
+}
+
+// Decode decodes GetUserSessionsOKItem from json.
+func (s *GetUserSessionsOKItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetUserSessionsOKItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "sessionID":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.SessionID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sessionID\"")
+			}
+		case "userID":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.UserID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"userID\"")
+			}
+		case "refreshToken":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.RefreshToken = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"refreshToken\"")
+			}
+		case "ua":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Ua = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ua\"")
+			}
+		case "lastActive":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.LastActive = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"lastActive\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode GetUserSessionsOKItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfGetUserSessionsOKItem) {
+					name = jsonFieldsNameOfGetUserSessionsOKItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetUserSessionsOKItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetUserSessionsOKItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ImportMapLocationsBadRequest as json.
+func (s *ImportMapLocationsBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes ImportMapLocationsBadRequest from json.
+func (s *ImportMapLocationsBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ImportMapLocationsBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ImportMapLocationsBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ImportMapLocationsBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ImportMapLocationsBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ImportMapLocationsForbidden as json.
+func (s *ImportMapLocationsForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes ImportMapLocationsForbidden from json.
+func (s *ImportMapLocationsForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ImportMapLocationsForbidden to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ImportMapLocationsForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ImportMapLocationsForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ImportMapLocationsForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ImportMapLocationsInternalServerError as json.
+func (s *ImportMapLocationsInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes ImportMapLocationsInternalServerError from json.
+func (s *ImportMapLocationsInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ImportMapLocationsInternalServerError to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ImportMapLocationsInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ImportMapLocationsInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ImportMapLocationsInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ImportMapLocationsNotFound as json.
+func (s *ImportMapLocationsNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes ImportMapLocationsNotFound from json.
+func (s *ImportMapLocationsNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ImportMapLocationsNotFound to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ImportMapLocationsNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ImportMapLocationsNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ImportMapLocationsNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ImportMapLocationsRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ImportMapLocationsRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("format")
+		s.Format.Encode(e)
+	}
+	{
+		e.FieldStart("data")
+		e.Str(s.Data)
+	}
+}
+
+var jsonFieldsNameOfImportMapLocationsRequest = [2]string{
+	0: "format",
+	1: "data",
+}
+
+// Decode decodes ImportMapLocationsRequest from json.
+func (s *ImportMapLocationsRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ImportMapLocationsRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "format":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Format.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"format\"")
+			}
+		case "data":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Data = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"data\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ImportMapLocationsRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfImportMapLocationsRequest) {
+					name = jsonFieldsNameOfImportMapLocationsRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ImportMapLocationsRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ImportMapLocationsRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ImportMapLocationsRequestFormat as json.
+func (s ImportMapLocationsRequestFormat) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ImportMapLocationsRequestFormat from json.
+func (s *ImportMapLocationsRequestFormat) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ImportMapLocationsRequestFormat to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ImportMapLocationsRequestFormat(v) {
+	case ImportMapLocationsRequestFormatCsv:
+		*s = ImportMapLocationsRequestFormatCsv
+	case ImportMapLocationsRequestFormatGeojson:
+		*s = ImportMapLocationsRequestFormatGeojson
+	default:
+		*s = ImportMapLocationsRequestFormat(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ImportMapLocationsRequestFormat) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ImportMapLocationsRequestFormat) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ImportMapLocationsUnauthorized as json.
+func (s *ImportMapLocationsUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes ImportMapLocationsUnauthorized from json.
+func (s *ImportMapLocationsUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ImportMapLocationsUnauthorized to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ImportMapLocationsUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ImportMapLocationsUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ImportMapLocationsUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *LatLng) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *LatLng) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("lat")
+		e.Float64(s.Lat)
+	}
+	{
+		e.FieldStart("lng")
+		e.Float64(s.Lng)
+	}
+}
+
+var jsonFieldsNameOfLatLng = [2]string{
+	0: "lat",
+	1: "lng",
+}
+
+// Decode decodes LatLng from json.
+func (s *LatLng) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LatLng to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "lat":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Float64()
+				s.Lat = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"lat\"")
+			}
+		case "lng":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Float64()
+				s.Lng = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"lng\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode LatLng")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfLatLng) {
+					name = jsonFieldsNameOfLatLng[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LatLng) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LatLng) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *LobbiesResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *LobbiesResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("total")
+		e.Int(s.Total)
+	}
+	{
+		e.FieldStart("lobbies")
+		e.ArrStart()
+		for _, elem := range s.Lobbies {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfLobbiesResponse = [2]string{
+	0: "total",
+	1: "lobbies",
+}
+
+// Decode decodes LobbiesResponse from json.
+func (s *LobbiesResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LobbiesResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "total":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Total = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"total\"")
+			}
+		case "lobbies":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.Lobbies = make([]Lobby, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Lobby
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Lobbies = append(s.Lobbies, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"lobbies\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode LobbiesResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfLobbiesResponse) {
+					name = jsonFieldsNameOfLobbiesResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LobbiesResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LobbiesResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Lobby) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Lobby) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Str(s.ID)
+	}
+	{
+		e.FieldStart("creatorID")
+		e.Int(s.CreatorID)
+	}
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("rounds")
+		e.Int(s.Rounds)
+	}
+	{
+		e.FieldStart("provider")
+		s.Provider.Encode(e)
+	}
+	{
+		if s.Providers != nil {
+			e.FieldStart("providers")
+			s.Providers.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("movementAllowed")
+		e.Bool(s.MovementAllowed)
+	}
+	{
+		e.FieldStart("timerSeconds")
+		e.Int(s.TimerSeconds)
+	}
+	{
+		e.FieldStart("currentPlayers")
+		e.Int(s.CurrentPlayers)
+	}
+	{
+		e.FieldStart("maxPlayers")
+		e.Int(s.MaxPlayers)
+	}
+	{
+		if s.MapID.Set {
+			e.FieldStart("mapID")
+			s.MapID.Encode(e)
+		}
+	}
+	{
+		if s.ScoreDistance.Set {
+			e.FieldStart("scoreDistance")
+			s.ScoreDistance.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("scoring")
+		s.Scoring.Encode(e)
+	}
+	{
+		e.FieldStart("mode")
+		s.Mode.Encode(e)
+	}
+	{
+		if s.Teams.Set {
+			e.FieldStart("teams")
+			s.Teams.Encode(e)
+		}
+	}
+	{
+		if s.TeamScoring.Set {
+			e.FieldStart("teamScoring")
+			s.TeamScoring.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("spectators")
+		e.Bool(s.Spectators)
+	}
+	{
+		if s.SpectatorDelaySeconds.Set {
+			e.FieldStart("spectatorDelaySeconds")
+			s.SpectatorDelaySeconds.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("visibility")
+		s.Visibility.Encode(e)
+	}
+	{
+		e.FieldStart("requireReady")
+		e.Bool(s.RequireReady)
+	}
+}
+
+var jsonFieldsNameOfLobby = [20]string{
+	0:  "id",
+	1:  "creatorID",
+	2:  "createdAt",
+	3:  "rounds",
+	4:  "provider",
+	5:  "providers",
+	6:  "movementAllowed",
+	7:  "timerSeconds",
+	8:  "currentPlayers",
+	9:  "maxPlayers",
+	10: "mapID",
+	11: "scoreDistance",
+	12: "scoring",
+	13: "mode",
+	14: "teams",
+	15: "teamScoring",
+	16: "spectators",
+	17: "spectatorDelaySeconds",
+	18: "visibility",
+	19: "requireReady",
+}
+
+// Decode decodes Lobby from json.
+func (s *Lobby) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Lobby to nil")
+	}
+	var requiredBitSet [3]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.ID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "creatorID":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.CreatorID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"creatorID\"")
+			}
+		case "createdAt":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
+			}
+		case "rounds":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.Rounds = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rounds\"")
+			}
+		case "provider":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				if err := s.Provider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "providers":
+			if err := func() error {
+				if err := s.Providers.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"providers\"")
+			}
+		case "movementAllowed":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Bool()
+				s.MovementAllowed = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"movementAllowed\"")
+			}
+		case "timerSeconds":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Int()
+				s.TimerSeconds = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"timerSeconds\"")
+			}
+		case "currentPlayers":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.CurrentPlayers = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"currentPlayers\"")
+			}
+		case "maxPlayers":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.MaxPlayers = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"maxPlayers\"")
+			}
+		case "mapID":
+			if err := func() error {
+				s.MapID.Reset()
+				if err := s.MapID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mapID\"")
+			}
+		case "scoreDistance":
+			if err := func() error {
+				s.ScoreDistance.Reset()
+				if err := s.ScoreDistance.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scoreDistance\"")
+			}
+		case "scoring":
+			requiredBitSet[1] |= 1 << 4
+			if err := func() error {
+				if err := s.Scoring.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scoring\"")
+			}
+		case "mode":
+			requiredBitSet[1] |= 1 << 5
+			if err := func() error {
+				if err := s.Mode.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mode\"")
+			}
+		case "teams":
+			if err := func() error {
+				s.Teams.Reset()
+				if err := s.Teams.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"teams\"")
+			}
+		case "teamScoring":
+			if err := func() error {
+				s.TeamScoring.Reset()
+				if err := s.TeamScoring.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"teamScoring\"")
+			}
+		case "spectators":
+			requiredBitSet[2] |= 1 << 0
+			if err := func() error {
+				v, err := d.Bool()
+				s.Spectators = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spectators\"")
+			}
+		case "spectatorDelaySeconds":
+			if err := func() error {
+				s.SpectatorDelaySeconds.Reset()
+				if err := s.SpectatorDelaySeconds.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spectatorDelaySeconds\"")
+			}
+		case "visibility":
+			requiredBitSet[2] |= 1 << 2
+			if err := func() error {
+				if err := s.Visibility.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visibility\"")
+			}
+		case "requireReady":
+			requiredBitSet[2] |= 1 << 3
+			if err := func() error {
+				v, err := d.Bool()
+				s.RequireReady = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"requireReady\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Lobby")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [3]uint8{
+		0b11011111,
+		0b00110011,
+		0b00001101,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfLobby) {
+					name = jsonFieldsNameOfLobby[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Lobby) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Lobby) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes LobbyVisibility as json.
+func (s LobbyVisibility) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes LobbyVisibility from json.
+func (s *LobbyVisibility) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LobbyVisibility to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch LobbyVisibility(v) {
+	case LobbyVisibilityPublic:
+		*s = LobbyVisibilityPublic
+	case LobbyVisibilityUnlisted:
+		*s = LobbyVisibilityUnlisted
+	case LobbyVisibilityPassword:
+		*s = LobbyVisibilityPassword
+	case LobbyVisibilityInviteOnly:
+		*s = LobbyVisibilityInviteOnly
+	default:
+		*s = LobbyVisibility(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s LobbyVisibility) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LobbyVisibility) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes LoginBadRequest as json.
+func (s *LoginBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes LoginBadRequest from json.
+func (s *LoginBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LoginBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = LoginBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LoginBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LoginBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes LoginInternalServerError as json.
+func (s *LoginInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes LoginInternalServerError from json.
+func (s *LoginInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LoginInternalServerError to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = LoginInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LoginInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LoginInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *LoginRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *LoginRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("username")
+		e.Str(s.Username)
+	}
+	{
+		e.FieldStart("password")
+		e.Str(s.Password)
+	}
+}
+
+var jsonFieldsNameOfLoginRequest = [2]string{
+	0: "username",
+	1: "password",
+}
+
+// Decode decodes LoginRequest from json.
+func (s *LoginRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LoginRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "username":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Username = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"username\"")
+			}
+		case "password":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Password = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"password\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode LoginRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfLoginRequest) {
+					name = jsonFieldsNameOfLoginRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LoginRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LoginRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes LoginUnauthorized as json.
+func (s *LoginUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes LoginUnauthorized from json.
+func (s *LoginUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LoginUnauthorized to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = LoginUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LoginUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LoginUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Map) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Map) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int(s.ID)
+	}
+	{
+		e.FieldStart("userID")
+		e.Int(s.UserID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("description")
+		e.Str(s.Description)
+	}
+	{
+		e.FieldStart("provider")
+		s.Provider.Encode(e)
+	}
+	{
+		e.FieldStart("visibility")
+		s.Visibility.Encode(e)
+	}
+	{
+		e.FieldStart("locationsCount")
+		e.Int(s.LocationsCount)
+	}
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("updatedAt")
+		json.EncodeDateTime(e, s.UpdatedAt)
+	}
+}
+
+var jsonFieldsNameOfMap = [9]string{
+	0: "id",
+	1: "userID",
+	2: "name",
+	3: "description",
+	4: "provider",
+	5: "visibility",
+	6: "locationsCount",
+	7: "createdAt",
+	8: "updatedAt",
+}
+
+// Decode decodes Map from json.
+func (s *Map) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Map to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.ID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "userID":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.UserID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"userID\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "description":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Description = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		case "provider":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				if err := s.Provider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "visibility":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				if err := s.Visibility.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visibility\"")
+			}
+		case "locationsCount":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Int()
+				s.LocationsCount = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"locationsCount\"")
+			}
+		case "createdAt":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
+			}
+		case "updatedAt":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.UpdatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updatedAt\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Map")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b11111111,
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfMap) {
+					name = jsonFieldsNameOfMap[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Map) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Map) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *MapLocationsAdded) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *MapLocationsAdded) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("added")
+		e.Int(s.Added)
+	}
+}
+
+var jsonFieldsNameOfMapLocationsAdded = [1]string{
+	0: "added",
+}
+
+// Decode decodes MapLocationsAdded from json.
+func (s *MapLocationsAdded) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MapLocationsAdded to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "added":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Added = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"added\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MapLocationsAdded")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfMapLocationsAdded) {
+					name = jsonFieldsNameOfMapLocationsAdded[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MapLocationsAdded) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MapLocationsAdded) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MapVisibility as json.
+func (s MapVisibility) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes MapVisibility from json.
+func (s *MapVisibility) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MapVisibility to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch MapVisibility(v) {
+	case MapVisibilityPublic:
+		*s = MapVisibilityPublic
+	case MapVisibilityUnlisted:
+		*s = MapVisibilityUnlisted
+	case MapVisibilityPrivate:
+		*s = MapVisibilityPrivate
+	default:
+		*s = MapVisibility(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s MapVisibility) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MapVisibility) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Maps) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Maps) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("total")
+		e.Int(s.Total)
+	}
+	{
+		e.FieldStart("maps")
+		e.ArrStart()
+		for _, elem := range s.Maps {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfMaps = [2]string{
+	0: "total",
+	1: "maps",
+}
+
+// Decode decodes Maps from json.
+func (s *Maps) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Maps to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "total":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Total = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"total\"")
+			}
+		case "maps":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.Maps = make([]Map, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Map
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Maps = append(s.Maps, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"maps\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Maps")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfMaps) {
+					name = jsonFieldsNameOfMaps[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Maps) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Maps) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *MultiplayerGame) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *MultiplayerGame) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int(s.ID)
+	}
+	{
+		e.FieldStart("creatorID")
+		e.Int(s.CreatorID)
+	}
+	{
+		e.FieldStart("rounds")
+		e.Int(s.Rounds)
+	}
+	{
+		e.FieldStart("roundCurrent")
+		e.Int(s.RoundCurrent)
+	}
+	{
+		e.FieldStart("timerSeconds")
+		e.Int(s.TimerSeconds)
+	}
+	{
+		e.FieldStart("movementAllowed")
+		e.Bool(s.MovementAllowed)
+	}
+	{
+		e.FieldStart("players")
+		e.Int(s.Players)
+	}
+	{
+		e.FieldStart("provider")
+		s.Provider.Encode(e)
+	}
+	{
+		if s.Providers != nil {
+			e.FieldStart("providers")
+			s.Providers.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("finished")
+		e.Bool(s.Finished)
+	}
+	{
+		if s.MapID.Set {
+			e.FieldStart("mapID")
+			s.MapID.Encode(e)
+		}
+	}
+	{
+		if s.ScoreDistance.Set {
+			e.FieldStart("scoreDistance")
+			s.ScoreDistance.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("scoring")
+		s.Scoring.Encode(e)
+	}
+	{
+		e.FieldStart("mode")
+		s.Mode.Encode(e)
+	}
+	{
+		if s.Health.Set {
+			e.FieldStart("health")
+			s.Health.Encode(e)
+		}
+	}
+	{
+		if s.TeamScoring.Set {
+			e.FieldStart("teamScoring")
+			s.TeamScoring.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("spectators")
+		e.Bool(s.Spectators)
+	}
+	{
+		if s.SpectatorDelaySeconds.Set {
+			e.FieldStart("spectatorDelaySeconds")
+			s.SpectatorDelaySeconds.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+}
+
+var jsonFieldsNameOfMultiplayerGame = [19]string{
+	0:  "id",
+	1:  "creatorID",
+	2:  "rounds",
+	3:  "roundCurrent",
+	4:  "timerSeconds",
+	5:  "movementAllowed",
+	6:  "players",
+	7:  "provider",
+	8:  "providers",
+	9:  "finished",
+	10: "mapID",
+	11: "scoreDistance",
+	12: "scoring",
+	13: "mode",
+	14: "health",
+	15: "teamScoring",
+	16: "spectators",
+	17: "spectatorDelaySeconds",
+	18: "createdAt",
+}
+
+// Decode decodes MultiplayerGame from json.
+func (s *MultiplayerGame) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MultiplayerGame to nil")
+	}
+	var requiredBitSet [3]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.ID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "creatorID":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.CreatorID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"creatorID\"")
+			}
+		case "rounds":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Rounds = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rounds\"")
+			}
+		case "roundCurrent":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.RoundCurrent = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"roundCurrent\"")
+			}
+		case "timerSeconds":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.TimerSeconds = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"timerSeconds\"")
+			}
+		case "movementAllowed":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Bool()
+				s.MovementAllowed = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"movementAllowed\"")
+			}
+		case "players":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Int()
+				s.Players = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"players\"")
+			}
+		case "provider":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				if err := s.Provider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "providers":
+			if err := func() error {
+				if err := s.Providers.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"providers\"")
+			}
+		case "finished":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				v, err := d.Bool()
+				s.Finished = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"finished\"")
+			}
+		case "mapID":
+			if err := func() error {
+				s.MapID.Reset()
+				if err := s.MapID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mapID\"")
+			}
+		case "scoreDistance":
+			if err := func() error {
+				s.ScoreDistance.Reset()
+				if err := s.ScoreDistance.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scoreDistance\"")
+			}
+		case "scoring":
+			requiredBitSet[1] |= 1 << 4
+			if err := func() error {
+				if err := s.Scoring.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scoring\"")
+			}
+		case "mode":
+			requiredBitSet[1] |= 1 << 5
+			if err := func() error {
+				if err := s.Mode.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mode\"")
+			}
+		case "health":
+			if err := func() error {
+				s.Health.Reset()
+				if err := s.Health.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"health\"")
+			}
+		case "teamScoring":
+			if err := func() error {
+				s.TeamScoring.Reset()
+				if err := s.TeamScoring.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"teamScoring\"")
+			}
+		case "spectators":
+			requiredBitSet[2] |= 1 << 0
+			if err := func() error {
+				v, err := d.Bool()
+				s.Spectators = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spectators\"")
+			}
+		case "spectatorDelaySeconds":
+			if err := func() error {
+				s.SpectatorDelaySeconds.Reset()
+				if err := s.SpectatorDelaySeconds.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spectatorDelaySeconds\"")
+			}
+		case "createdAt":
+			requiredBitSet[2] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MultiplayerGame")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [3]uint8{
+		0b11111111,
+		0b00110010,
+		0b00000101,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfMultiplayerGame) {
+					name = jsonFieldsNameOfMultiplayerGame[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MultiplayerGame) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MultiplayerGame) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *MultiplayerGuess) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *MultiplayerGuess) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("username")
+		e.Str(s.Username)
+	}
+	{
+		e.FieldStart("avatarHash")
+		e.Str(s.AvatarHash)
+	}
+	{
+		if s.Team.Set {
+			e.FieldStart("team")
+			s.Team.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("roundNum")
+		e.Int(s.RoundNum)
+	}
+	{
+		e.FieldStart("roundLat")
+		e.Float64(s.RoundLat)
+	}
+	{
+		e.FieldStart("roundLng")
+		e.Float64(s.RoundLng)
+	}
+	{
+		e.FieldStart("lat")
+		e.Float64(s.Lat)
+	}
+	{
+		e.FieldStart("lng")
+		e.Float64(s.Lng)
+	}
+	{
+		e.FieldStart("score")
+		e.Int(s.Score)
+	}
+	{
+		e.FieldStart("scoreBreakdown")
+		s.ScoreBreakdown.Encode(e)
+	}
+	{
+		e.FieldStart("missDistance")
+		e.Int(s.MissDistance)
+	}
+	{
+		if s.RoundCountry.Set {
+			e.FieldStart("roundCountry")
+			s.RoundCountry.Encode(e)
+		}
+	}
+	{
+		if s.GuessCountry.Set {
+			e.FieldStart("guessCountry")
+			s.GuessCountry.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("correctCountry")
+		e.Bool(s.CorrectCountry)
+	}
+}
+
+var jsonFieldsNameOfMultiplayerGuess = [14]string{
+	0:  "username",
+	1:  "avatarHash",
+	2:  "team",
+	3:  "roundNum",
+	4:  "roundLat",
+	5:  "roundLng",
+	6:  "lat",
+	7:  "lng",
+	8:  "score",
+	9:  "scoreBreakdown",
+	10: "missDistance",
+	11: "roundCountry",
+	12: "guessCountry",
+	13: "correctCountry",
+}
+
+// Decode decodes MultiplayerGuess from json.
+func (s *MultiplayerGuess) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MultiplayerGuess to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "username":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Username = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"username\"")
+			}
+		case "avatarHash":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.AvatarHash = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"avatarHash\"")
+			}
+		case "team":
+			if err := func() error {
+				s.Team.Reset()
+				if err := s.Team.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"team\"")
+			}
+		case "roundNum":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.RoundNum = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"roundNum\"")
+			}
+		case "roundLat":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Float64()
+				s.RoundLat = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"roundLat\"")
+			}
+		case "roundLng":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Float64()
+				s.RoundLng = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"roundLng\"")
+			}
+		case "lat":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Float64()
+				s.Lat = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"lat\"")
+			}
+		case "lng":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Float64()
+				s.Lng = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"lng\"")
+			}
+		case "score":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Score = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
+			}
+		case "scoreBreakdown":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				if err := s.ScoreBreakdown.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scoreBreakdown\"")
+			}
+		case "missDistance":
+			requiredBitSet[1] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.MissDistance = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"missDistance\"")
+			}
+		case "roundCountry":
+			if err := func() error {
+				s.RoundCountry.Reset()
+				if err := s.RoundCountry.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"roundCountry\"")
+			}
+		case "guessCountry":
+			if err := func() error {
+				s.GuessCountry.Reset()
+				if err := s.GuessCountry.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"guessCountry\"")
+			}
+		case "correctCountry":
+			requiredBitSet[1] |= 1 << 5
+			if err := func() error {
+				v, err := d.Bool()
+				s.CorrectCountry = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"correctCountry\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MultiplayerGuess")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b11111011,
+		0b00100111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfMultiplayerGuess) {
+					name = jsonFieldsNameOfMultiplayerGuess[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MultiplayerGuess) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MultiplayerGuess) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MultiplayerMode as json.
+func (s MultiplayerMode) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes MultiplayerMode from json.
+func (s *MultiplayerMode) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MultiplayerMode to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch MultiplayerMode(v) {
+	case MultiplayerModeClassic:
+		*s = MultiplayerModeClassic
+	case MultiplayerModeBattleRoyale:
+		*s = MultiplayerModeBattleRoyale
+	case MultiplayerModeDuel:
+		*s = MultiplayerModeDuel
+	default:
+		*s = MultiplayerMode(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s MultiplayerMode) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MultiplayerMode) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *MultiplayerRound) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *MultiplayerRound) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int(s.ID)
+	}
+	{
+		e.FieldStart("gameID")
+		e.Int(s.GameID)
+	}
+	{
+		if s.StreetviewID.Set {
+			e.FieldStart("streetviewID")
+			s.StreetviewID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("provider")
+		s.Provider.Encode(e)
+	}
+	{
+		e.FieldStart("roundNum")
+		e.Int(s.RoundNum)
+	}
+	{
+		e.FieldStart("panoramaToken")
+		e.Str(s.PanoramaToken)
+	}
+	{
+		if s.Location.Set {
+			e.FieldStart("location")
+			s.Location.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("panoramaURL")
+		e.Str(s.PanoramaURL)
+	}
+	{
+		e.FieldStart("guessesCount")
+		e.Int(s.GuessesCount)
+	}
+	{
+		e.FieldStart("finished")
+		e.Bool(s.Finished)
+	}
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("startedAt")
+		json.EncodeDateTime(e, s.StartedAt)
+	}
+	{
+		e.FieldStart("endedAt")
+		json.EncodeDateTime(e, s.EndedAt)
+	}
+}
+
+var jsonFieldsNameOfMultiplayerRound = [13]string{
+	0:  "id",
+	1:  "gameID",
+	2:  "streetviewID",
+	3:  "provider",
+	4:  "roundNum",
+	5:  "panoramaToken",
+	6:  "location",
+	7:  "panoramaURL",
+	8:  "guessesCount",
+	9:  "finished",
+	10: "createdAt",
+	11: "startedAt",
+	12: "endedAt",
+}
+
+// Decode decodes MultiplayerRound from json.
+func (s *MultiplayerRound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MultiplayerRound to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.ID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "gameID":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.GameID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"gameID\"")
+			}
+		case "streetviewID":
+			if err := func() error {
+				s.StreetviewID.Reset()
+				if err := s.StreetviewID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"streetviewID\"")
+			}
+		case "provider":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Provider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "roundNum":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.RoundNum = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"roundNum\"")
+			}
+		case "panoramaToken":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Str()
+				s.PanoramaToken = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"panoramaToken\"")
+			}
+		case "location":
+			if err := func() error {
+				s.Location.Reset()
+				if err := s.Location.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"location\"")
+			}
+		case "panoramaURL":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Str()
+				s.PanoramaURL = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"panoramaURL\"")
+			}
+		case "guessesCount":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.GuessesCount = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"guessesCount\"")
+			}
+		case "finished":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				v, err := d.Bool()
+				s.Finished = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"finished\"")
+			}
+		case "createdAt":
+			requiredBitSet[1] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
+			}
+		case "startedAt":
+			requiredBitSet[1] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.StartedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"startedAt\"")
+			}
+		case "endedAt":
+			requiredBitSet[1] |= 1 << 4
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.EndedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"endedAt\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MultiplayerRound")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b10111011,
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfMultiplayerRound) {
+					name = jsonFieldsNameOfMultiplayerRound[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MultiplayerRound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MultiplayerRound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewDiscordCallbackBadRequest as json.
+func (s *NewDiscordCallbackBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewDiscordCallbackBadRequest from json.
+func (s *NewDiscordCallbackBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewDiscordCallbackBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewDiscordCallbackBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewDiscordCallbackBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewDiscordCallbackBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewDiscordCallbackInternalServerError as json.
+func (s *NewDiscordCallbackInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewDiscordCallbackInternalServerError from json.
+func (s *NewDiscordCallbackInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewDiscordCallbackInternalServerError to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewDiscordCallbackInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewDiscordCallbackInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewDiscordCallbackInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewDiscordCallbackUnauthorized as json.
+func (s *NewDiscordCallbackUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewDiscordCallbackUnauthorized from json.
+func (s *NewDiscordCallbackUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewDiscordCallbackUnauthorized to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewDiscordCallbackUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewDiscordCallbackUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewDiscordCallbackUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NewLobby) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NewLobby) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("creatorID")
+		e.Int(s.CreatorID)
+	}
+	{
+		e.FieldStart("maxPlayers")
+		e.Int(s.MaxPlayers)
+	}
+	{
+		e.FieldStart("rounds")
+		e.Int(s.Rounds)
+	}
+	{
+		e.FieldStart("provider")
+		s.Provider.Encode(e)
+	}
+	{
+		if s.Providers != nil {
+			e.FieldStart("providers")
+			s.Providers.Encode(e)
+		}
+	}
+	{
+		if s.TimerSeconds.Set {
+			e.FieldStart("timerSeconds")
+			s.TimerSeconds.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("movementAllowed")
+		e.Bool(s.MovementAllowed)
+	}
+	{
+		if s.MapID.Set {
+			e.FieldStart("mapID")
+			s.MapID.Encode(e)
+		}
+	}
+	{
+		if s.ScoreDistance.Set {
+			e.FieldStart("scoreDistance")
+			s.ScoreDistance.Encode(e)
+		}
+	}
+	{
+		if s.Scoring.Set {
+			e.FieldStart("scoring")
+			s.Scoring.Encode(e)
+		}
+	}
+	{
+		if s.Mode.Set {
+			e.FieldStart("mode")
+			s.Mode.Encode(e)
+		}
+	}
+	{
+		if s.Teams.Set {
+			e.FieldStart("teams")
+			s.Teams.Encode(e)
+		}
+	}
+	{
+		if s.TeamScoring.Set {
+			e.FieldStart("teamScoring")
+			s.TeamScoring.Encode(e)
+		}
+	}
+	{
+		if s.Spectators.Set {
+			e.FieldStart("spectators")
+			s.Spectators.Encode(e)
+		}
+	}
+	{
+		if s.SpectatorDelaySeconds.Set {
+			e.FieldStart("spectatorDelaySeconds")
+			s.SpectatorDelaySeconds.Encode(e)
+		}
+	}
+	{
+		if s.Visibility.Set {
+			e.FieldStart("visibility")
+			s.Visibility.Encode(e)
+		}
+	}
+	{
+		if s.Password.Set {
+			e.FieldStart("password")
+			s.Password.Encode(e)
+		}
+	}
+	{
+		if s.InvitedUserIDs != nil {
+			e.FieldStart("invitedUserIDs")
+			e.ArrStart()
+			for _, elem := range s.InvitedUserIDs {
+				e.Int(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.RequireReady.Set {
+			e.FieldStart("requireReady")
+			s.RequireReady.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfNewLobby = [19]string{
+	0:  "creatorID",
+	1:  "maxPlayers",
+	2:  "rounds",
+	3:  "provider",
+	4:  "providers",
+	5:  "timerSeconds",
+	6:  "movementAllowed",
+	7:  "mapID",
+	8:  "scoreDistance",
+	9:  "scoring",
+	10: "mode",
+	11: "teams",
+	12: "teamScoring",
+	13: "spectators",
+	14: "spectatorDelaySeconds",
+	15: "visibility",
+	16: "password",
+	17: "invitedUserIDs",
+	18: "requireReady",
+}
+
+// Decode decodes NewLobby from json.
+func (s *NewLobby) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewLobby to nil")
+	}
+	var requiredBitSet [3]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "creatorID":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.CreatorID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"creatorID\"")
+			}
+		case "maxPlayers":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.MaxPlayers = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"maxPlayers\"")
+			}
+		case "rounds":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Rounds = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rounds\"")
+			}
+		case "provider":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Provider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "providers":
+			if err := func() error {
+				if err := s.Providers.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"providers\"")
+			}
+		case "timerSeconds":
+			if err := func() error {
+				s.TimerSeconds.Reset()
+				if err := s.TimerSeconds.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"timerSeconds\"")
+			}
+		case "movementAllowed":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Bool()
+				s.MovementAllowed = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"movementAllowed\"")
+			}
+		case "mapID":
+			if err := func() error {
+				s.MapID.Reset()
+				if err := s.MapID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mapID\"")
+			}
+		case "scoreDistance":
+			if err := func() error {
+				s.ScoreDistance.Reset()
+				if err := s.ScoreDistance.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scoreDistance\"")
+			}
+		case "scoring":
+			if err := func() error {
+				s.Scoring.Reset()
+				if err := s.Scoring.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scoring\"")
+			}
+		case "mode":
+			if err := func() error {
+				s.Mode.Reset()
+				if err := s.Mode.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mode\"")
+			}
+		case "teams":
+			if err := func() error {
+				s.Teams.Reset()
+				if err := s.Teams.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"teams\"")
+			}
+		case "teamScoring":
+			if err := func() error {
+				s.TeamScoring.Reset()
+				if err := s.TeamScoring.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"teamScoring\"")
+			}
+		case "spectators":
+			if err := func() error {
+				s.Spectators.Reset()
+				if err := s.Spectators.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spectators\"")
+			}
+		case "spectatorDelaySeconds":
+			if err := func() error {
+				s.SpectatorDelaySeconds.Reset()
+				if err := s.SpectatorDelaySeconds.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spectatorDelaySeconds\"")
+			}
+		case "visibility":
+			if err := func() error {
+				s.Visibility.Reset()
+				if err := s.Visibility.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visibility\"")
+			}
+		case "password":
+			if err := func() error {
+				s.Password.Reset()
+				if err := s.Password.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"password\"")
+			}
+		case "invitedUserIDs":
+			if err := func() error {
+				s.InvitedUserIDs = make([]int, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem int
+					v, err := d.Int()
+					elem = int(v)
+					if err != nil {
+						return err
+					}
+					s.InvitedUserIDs = append(s.InvitedUserIDs, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"invitedUserIDs\"")
+			}
+		case "requireReady":
+			if err := func() error {
+				s.RequireReady.Reset()
+				if err := s.RequireReady.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"requireReady\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NewLobby")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [3]uint8{
+		0b01001111,
+		0b00000000,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNewLobby) {
+					name = jsonFieldsNameOfNewLobby[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewLobby) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewLobby) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewLobbyBadRequest as json.
+func (s *NewLobbyBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewLobbyBadRequest from json.
+func (s *NewLobbyBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewLobbyBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewLobbyBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewLobbyBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewLobbyBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NewLobbyCreated) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NewLobbyCreated) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Str(s.ID)
+	}
+}
+
+var jsonFieldsNameOfNewLobbyCreated = [1]string{
+	0: "id",
+}
+
+// Decode decodes NewLobbyCreated from json.
+func (s *NewLobbyCreated) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewLobbyCreated to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.ID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NewLobbyCreated")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNewLobbyCreated) {
+					name = jsonFieldsNameOfNewLobbyCreated[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewLobbyCreated) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewLobbyCreated) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewLobbyInternalServerError as json.
+func (s *NewLobbyInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewLobbyInternalServerError from json.
+func (s *NewLobbyInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewLobbyInternalServerError to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewLobbyInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewLobbyInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewLobbyInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewLobbyNotFound as json.
+func (s *NewLobbyNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewLobbyNotFound from json.
+func (s *NewLobbyNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewLobbyNotFound to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewLobbyNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewLobbyNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewLobbyNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewMapBadRequest as json.
+func (s *NewMapBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewMapBadRequest from json.
+func (s *NewMapBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewMapBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewMapBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewMapBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewMapBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NewMapCreated) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NewMapCreated) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int(s.ID)
+	}
+}
+
+var jsonFieldsNameOfNewMapCreated = [1]string{
+	0: "id",
+}
+
+// Decode decodes NewMapCreated from json.
+func (s *NewMapCreated) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewMapCreated to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.ID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NewMapCreated")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNewMapCreated) {
+					name = jsonFieldsNameOfNewMapCreated[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewMapCreated) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewMapCreated) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewMapInternalServerError as json.
+func (s *NewMapInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewMapInternalServerError from json.
+func (s *NewMapInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewMapInternalServerError to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewMapInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewMapInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewMapInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NewMapRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NewMapRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("provider")
+		s.Provider.Encode(e)
+	}
+	{
+		e.FieldStart("visibility")
+		s.Visibility.Encode(e)
+	}
+	{
+		if s.LocationIDs != nil {
+			e.FieldStart("locationIDs")
+			e.ArrStart()
+			for _, elem := range s.LocationIDs {
+				e.Int(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfNewMapRequest = [5]string{
+	0: "name",
+	1: "description",
+	2: "provider",
+	3: "visibility",
+	4: "locationIDs",
+}
+
+// Decode decodes NewMapRequest from json.
+func (s *NewMapRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewMapRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		case "provider":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Provider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "visibility":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Visibility.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visibility\"")
+			}
+		case "locationIDs":
+			if err := func() error {
+				s.LocationIDs = make([]int, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem int
+					v, err := d.Int()
+					elem = int(v)
+					if err != nil {
+						return err
+					}
+					s.LocationIDs = append(s.LocationIDs, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"locationIDs\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NewMapRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001101,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNewMapRequest) {
+					name = jsonFieldsNameOfNewMapRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewMapRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewMapRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewMapUnauthorized as json.
+func (s *NewMapUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewMapUnauthorized from json.
+func (s *NewMapUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewMapUnauthorized to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewMapUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewMapUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewMapUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewMultiplayerRoundInternalServerError as json.
+func (s *NewMultiplayerRoundInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewMultiplayerRoundInternalServerError from json.
+func (s *NewMultiplayerRoundInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewMultiplayerRoundInternalServerError to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewMultiplayerRoundInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewMultiplayerRoundInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewMultiplayerRoundInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewMultiplayerRoundNotFound as json.
+func (s *NewMultiplayerRoundNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewMultiplayerRoundNotFound from json.
+func (s *NewMultiplayerRoundNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewMultiplayerRoundNotFound to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewMultiplayerRoundNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewMultiplayerRoundNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewMultiplayerRoundNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewMultiplayerRoundUnauthorized as json.
+func (s *NewMultiplayerRoundUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewMultiplayerRoundUnauthorized from json.
+func (s *NewMultiplayerRoundUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewMultiplayerRoundUnauthorized to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewMultiplayerRoundUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewMultiplayerRoundUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewMultiplayerRoundUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewSingleplayerChallengeBadRequest as json.
+func (s *NewSingleplayerChallengeBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewSingleplayerChallengeBadRequest from json.
+func (s *NewSingleplayerChallengeBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewSingleplayerChallengeBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewSingleplayerChallengeBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewSingleplayerChallengeBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewSingleplayerChallengeBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NewSingleplayerChallengeCreated) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NewSingleplayerChallengeCreated) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("token")
+		e.Str(s.Token)
+	}
+}
+
+var jsonFieldsNameOfNewSingleplayerChallengeCreated = [1]string{
+	0: "token",
+}
+
+// Decode decodes NewSingleplayerChallengeCreated from json.
+func (s *NewSingleplayerChallengeCreated) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewSingleplayerChallengeCreated to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "token":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Token = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"token\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NewSingleplayerChallengeCreated")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNewSingleplayerChallengeCreated) {
+					name = jsonFieldsNameOfNewSingleplayerChallengeCreated[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewSingleplayerChallengeCreated) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewSingleplayerChallengeCreated) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewSingleplayerChallengeForbidden as json.
+func (s *NewSingleplayerChallengeForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewSingleplayerChallengeForbidden from json.
+func (s *NewSingleplayerChallengeForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewSingleplayerChallengeForbidden to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewSingleplayerChallengeForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewSingleplayerChallengeForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewSingleplayerChallengeForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewSingleplayerChallengeInternalServerError as json.
+func (s *NewSingleplayerChallengeInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewSingleplayerChallengeInternalServerError from json.
+func (s *NewSingleplayerChallengeInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewSingleplayerChallengeInternalServerError to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewSingleplayerChallengeInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewSingleplayerChallengeInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewSingleplayerChallengeInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewSingleplayerChallengeNotFound as json.
+func (s *NewSingleplayerChallengeNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewSingleplayerChallengeNotFound from json.
+func (s *NewSingleplayerChallengeNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewSingleplayerChallengeNotFound to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewSingleplayerChallengeNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewSingleplayerChallengeNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewSingleplayerChallengeNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewSingleplayerChallengeUnauthorized as json.
+func (s *NewSingleplayerChallengeUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewSingleplayerChallengeUnauthorized from json.
+func (s *NewSingleplayerChallengeUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewSingleplayerChallengeUnauthorized to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewSingleplayerChallengeUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewSingleplayerChallengeUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewSingleplayerChallengeUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewSingleplayerGameBadRequest as json.
+func (s *NewSingleplayerGameBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewSingleplayerGameBadRequest from json.
+func (s *NewSingleplayerGameBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewSingleplayerGameBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewSingleplayerGameBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewSingleplayerGameBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewSingleplayerGameBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewSingleplayerGameConflict as json.
+func (s *NewSingleplayerGameConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewSingleplayerGameConflict from json.
+func (s *NewSingleplayerGameConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewSingleplayerGameConflict to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewSingleplayerGameConflict(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewSingleplayerGameConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewSingleplayerGameConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NewSingleplayerGameCreated) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NewSingleplayerGameCreated) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int(s.ID)
+	}
+}
+
+var jsonFieldsNameOfNewSingleplayerGameCreated = [1]string{
+	0: "id",
+}
+
+// Decode decodes NewSingleplayerGameCreated from json.
+func (s *NewSingleplayerGameCreated) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewSingleplayerGameCreated to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.ID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NewSingleplayerGameCreated")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNewSingleplayerGameCreated) {
+					name = jsonFieldsNameOfNewSingleplayerGameCreated[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewSingleplayerGameCreated) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewSingleplayerGameCreated) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewSingleplayerGameInternalServerError as json.
+func (s *NewSingleplayerGameInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewSingleplayerGameInternalServerError from json.
+func (s *NewSingleplayerGameInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewSingleplayerGameInternalServerError to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewSingleplayerGameInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewSingleplayerGameInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewSingleplayerGameInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewSingleplayerGameNotFound as json.
+func (s *NewSingleplayerGameNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewSingleplayerGameNotFound from json.
+func (s *NewSingleplayerGameNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewSingleplayerGameNotFound to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewSingleplayerGameNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewSingleplayerGameNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewSingleplayerGameNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NewSingleplayerGameRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NewSingleplayerGameRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("rounds")
+		e.Int(s.Rounds)
+	}
+	{
+		if s.TimerSeconds.Set {
+			e.FieldStart("timerSeconds")
+			s.TimerSeconds.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("movementAllowed")
+		e.Bool(s.MovementAllowed)
+	}
+	{
+		e.FieldStart("provider")
+		s.Provider.Encode(e)
+	}
+	{
+		if s.Providers != nil {
+			e.FieldStart("providers")
+			s.Providers.Encode(e)
+		}
+	}
+	{
+		if s.Daily.Set {
+			e.FieldStart("daily")
+			s.Daily.Encode(e)
+		}
+	}
+	{
+		if s.MapID.Set {
+			e.FieldStart("mapID")
+			s.MapID.Encode(e)
+		}
+	}
+	{
+		if s.ScoreDistance.Set {
+			e.FieldStart("scoreDistance")
+			s.ScoreDistance.Encode(e)
+		}
+	}
+	{
+		if s.Scoring.Set {
+			e.FieldStart("scoring")
+			s.Scoring.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfNewSingleplayerGameRequest = [9]string{
+	0: "rounds",
+	1: "timerSeconds",
+	2: "movementAllowed",
+	3: "provider",
+	4: "providers",
+	5: "daily",
+	6: "mapID",
+	7: "scoreDistance",
+	8: "scoring",
+}
+
+// Decode decodes NewSingleplayerGameRequest from json.
+func (s *NewSingleplayerGameRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewSingleplayerGameRequest to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "rounds":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Rounds = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rounds\"")
+			}
+		case "timerSeconds":
+			if err := func() error {
+				s.TimerSeconds.Reset()
+				if err := s.TimerSeconds.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"timerSeconds\"")
+			}
+		case "movementAllowed":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Bool()
+				s.MovementAllowed = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"movementAllowed\"")
+			}
+		case "provider":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Provider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "providers":
+			if err := func() error {
+				if err := s.Providers.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"providers\"")
+			}
+		case "daily":
+			if err := func() error {
+				s.Daily.Reset()
+				if err := s.Daily.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"daily\"")
+			}
+		case "mapID":
+			if err := func() error {
+				s.MapID.Reset()
+				if err := s.MapID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mapID\"")
+			}
+		case "scoreDistance":
+			if err := func() error {
+				s.ScoreDistance.Reset()
+				if err := s.ScoreDistance.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scoreDistance\"")
+			}
+		case "scoring":
+			if err := func() error {
+				s.Scoring.Reset()
+				if err := s.Scoring.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scoring\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NewSingleplayerGameRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00001101,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNewSingleplayerGameRequest) {
+					name = jsonFieldsNameOfNewSingleplayerGameRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewSingleplayerGameRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewSingleplayerGameRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewSingleplayerGameUnauthorized as json.
+func (s *NewSingleplayerGameUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewSingleplayerGameUnauthorized from json.
+func (s *NewSingleplayerGameUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewSingleplayerGameUnauthorized to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewSingleplayerGameUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewSingleplayerGameUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewSingleplayerGameUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewSingleplayerRoundBadRequest as json.
+func (s *NewSingleplayerRoundBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewSingleplayerRoundBadRequest from json.
+func (s *NewSingleplayerRoundBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewSingleplayerRoundBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewSingleplayerRoundBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewSingleplayerRoundBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewSingleplayerRoundBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewSingleplayerRoundForbidden as json.
+func (s *NewSingleplayerRoundForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewSingleplayerRoundForbidden from json.
+func (s *NewSingleplayerRoundForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewSingleplayerRoundForbidden to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewSingleplayerRoundForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewSingleplayerRoundForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewSingleplayerRoundForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewSingleplayerRoundInternalServerError as json.
+func (s *NewSingleplayerRoundInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewSingleplayerRoundInternalServerError from json.
+func (s *NewSingleplayerRoundInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewSingleplayerRoundInternalServerError to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewSingleplayerRoundInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewSingleplayerRoundInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewSingleplayerRoundInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewSingleplayerRoundNotFound as json.
+func (s *NewSingleplayerRoundNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewSingleplayerRoundNotFound from json.
+func (s *NewSingleplayerRoundNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewSingleplayerRoundNotFound to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewSingleplayerRoundNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewSingleplayerRoundNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewSingleplayerRoundNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewSingleplayerRoundUnauthorized as json.
+func (s *NewSingleplayerRoundUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewSingleplayerRoundUnauthorized from json.
+func (s *NewSingleplayerRoundUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewSingleplayerRoundUnauthorized to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewSingleplayerRoundUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewSingleplayerRoundUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewSingleplayerRoundUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewStreakGameBadRequest as json.
+func (s *NewStreakGameBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewStreakGameBadRequest from json.
+func (s *NewStreakGameBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewStreakGameBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewStreakGameBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewStreakGameBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewStreakGameBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NewStreakGameCreated) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NewStreakGameCreated) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int(s.ID)
+	}
+}
+
+var jsonFieldsNameOfNewStreakGameCreated = [1]string{
+	0: "id",
+}
+
+// Decode decodes NewStreakGameCreated from json.
+func (s *NewStreakGameCreated) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewStreakGameCreated to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.ID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NewStreakGameCreated")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNewStreakGameCreated) {
+					name = jsonFieldsNameOfNewStreakGameCreated[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewStreakGameCreated) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewStreakGameCreated) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewStreakGameInternalServerError as json.
+func (s *NewStreakGameInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewStreakGameInternalServerError from json.
+func (s *NewStreakGameInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewStreakGameInternalServerError to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewStreakGameInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewStreakGameInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewStreakGameInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NewStreakGameRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NewStreakGameRequest) encodeFields(e *jx.Encoder) {
+	{
+		if s.TimerSeconds.Set {
+			e.FieldStart("timerSeconds")
+			s.TimerSeconds.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("movementAllowed")
+		e.Bool(s.MovementAllowed)
+	}
+	{
+		e.FieldStart("provider")
+		s.Provider.Encode(e)
+	}
+	{
+		if s.Providers != nil {
+			e.FieldStart("providers")
+			s.Providers.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfNewStreakGameRequest = [4]string{
+	0: "timerSeconds",
+	1: "movementAllowed",
+	2: "provider",
+	3: "providers",
+}
+
+// Decode decodes NewStreakGameRequest from json.
+func (s *NewStreakGameRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewStreakGameRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "timerSeconds":
+			if err := func() error {
+				s.TimerSeconds.Reset()
+				if err := s.TimerSeconds.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"timerSeconds\"")
+			}
+		case "movementAllowed":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Bool()
+				s.MovementAllowed = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"movementAllowed\"")
+			}
+		case "provider":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Provider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "providers":
+			if err := func() error {
+				if err := s.Providers.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"providers\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NewStreakGameRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000110,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNewStreakGameRequest) {
+					name = jsonFieldsNameOfNewStreakGameRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewStreakGameRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewStreakGameRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewStreakGameUnauthorized as json.
+func (s *NewStreakGameUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewStreakGameUnauthorized from json.
+func (s *NewStreakGameUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewStreakGameUnauthorized to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewStreakGameUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewStreakGameUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewStreakGameUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewStreakRoundBadRequest as json.
+func (s *NewStreakRoundBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewStreakRoundBadRequest from json.
+func (s *NewStreakRoundBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewStreakRoundBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewStreakRoundBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewStreakRoundBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewStreakRoundBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewStreakRoundForbidden as json.
+func (s *NewStreakRoundForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewStreakRoundForbidden from json.
+func (s *NewStreakRoundForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewStreakRoundForbidden to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewStreakRoundForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewStreakRoundForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewStreakRoundForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewStreakRoundInternalServerError as json.
+func (s *NewStreakRoundInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewStreakRoundInternalServerError from json.
+func (s *NewStreakRoundInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewStreakRoundInternalServerError to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewStreakRoundInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewStreakRoundInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewStreakRoundInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewStreakRoundNotFound as json.
+func (s *NewStreakRoundNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewStreakRoundNotFound from json.
+func (s *NewStreakRoundNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewStreakRoundNotFound to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewStreakRoundNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewStreakRoundNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewStreakRoundNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewStreakRoundUnauthorized as json.
+func (s *NewStreakRoundUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewStreakRoundUnauthorized from json.
+func (s *NewStreakRoundUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewStreakRoundUnauthorized to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewStreakRoundUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewStreakRoundUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewStreakRoundUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewYandexCallbackBadRequest as json.
+func (s *NewYandexCallbackBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewYandexCallbackBadRequest from json.
+func (s *NewYandexCallbackBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewYandexCallbackBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewYandexCallbackBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewYandexCallbackBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewYandexCallbackBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewYandexCallbackInternalServerError as json.
+func (s *NewYandexCallbackInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewYandexCallbackInternalServerError from json.
+func (s *NewYandexCallbackInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewYandexCallbackInternalServerError to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewYandexCallbackInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewYandexCallbackInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewYandexCallbackInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NewYandexCallbackUnauthorized as json.
+func (s *NewYandexCallbackUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes NewYandexCallbackUnauthorized from json.
+func (s *NewYandexCallbackUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NewYandexCallbackUnauthorized to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = NewYandexCallbackUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NewYandexCallbackUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NewYandexCallbackUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes bool as json.
+func (o OptBool) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Bool(bool(o.Value))
+}
+
+// Decode decodes bool from json.
+func (o *OptBool) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBool to nil")
+	}
+	o.Set = true
+	v, err := d.Bool()
+	if err != nil {
+		return err
+	}
+	o.Value = bool(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBool) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBool) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CountryCode as json.
+func (o OptCountryCode) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes CountryCode from json.
+func (o *OptCountryCode) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCountryCode to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCountryCode) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCountryCode) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes time.Time as json.
+func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+	if !o.Set {
+		return
+	}
+	format(e, o.Value)
+}
+
+// Decode decodes time.Time from json.
+func (o *OptDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDateTime to nil")
+	}
+	o.Set = true
+	v, err := format(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDateTime) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e, json.EncodeDateTime)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDateTime) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d, json.DecodeDateTime)
+}
+
+// Encode encodes GameProvider as json.
+func (o OptGameProvider) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes GameProvider from json.
+func (o *OptGameProvider) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptGameProvider to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptGameProvider) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptGameProvider) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int as json.
+func (o OptInt) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes int from json.
+func (o *OptInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt to nil")
+	}
+	o.Set = true
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	o.Value = int(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes LatLng as json.
+func (o OptLatLng) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes LatLng from json.
+func (o *OptLatLng) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptLatLng to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptLatLng) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptLatLng) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes LobbyVisibility as json.
+func (o OptLobbyVisibility) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes LobbyVisibility from json.
+func (o *OptLobbyVisibility) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptLobbyVisibility to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptLobbyVisibility) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptLobbyVisibility) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MultiplayerMode as json.
+func (o OptMultiplayerMode) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes MultiplayerMode from json.
+func (o *OptMultiplayerMode) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptMultiplayerMode to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptMultiplayerMode) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptMultiplayerMode) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ScoreDistance as json.
+func (o OptScoreDistance) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ScoreDistance from json.
+func (o *OptScoreDistance) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptScoreDistance to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptScoreDistance) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptScoreDistance) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ScoringMode as json.
+func (o OptScoringMode) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes ScoringMode from json.
+func (o *OptScoringMode) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptScoringMode to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptScoringMode) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptScoringMode) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes string as json.
+func (o OptString) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes string from json.
+func (o *OptString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptString to nil")
+	}
+	o.Set = true
+	v, err := d.Str()
+	if err != nil {
+		return err
+	}
+	o.Value = string(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptString) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptString) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TeamScoring as json.
+func (o OptTeamScoring) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes TeamScoring from json.
+func (o *OptTeamScoring) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptTeamScoring to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptTeamScoring) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptTeamScoring) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PanoramaLocation) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PanoramaLocation) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("streetviewID")
+		e.Str(s.StreetviewID)
+	}
+}
+
+var jsonFieldsNameOfPanoramaLocation = [1]string{
+	0: "streetviewID",
+}
+
+// Decode decodes PanoramaLocation from json.
+func (s *PanoramaLocation) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PanoramaLocation to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "streetviewID":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.StreetviewID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"streetviewID\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PanoramaLocation")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPanoramaLocation) {
+					name = jsonFieldsNameOfPanoramaLocation[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PanoramaLocation) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PanoramaLocation) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes Provider as json.
+func (s Provider) Encode(e *jx.Encoder) {
+	unwrapped := string(s)
+
+	e.Str(unwrapped)
+}
+
+// Decode decodes Provider from json.
+func (s *Provider) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Provider to nil")
+	}
+	var unwrapped string
+	if err := func() error {
+		v, err := d.Str()
+		unwrapped = string(v)
+		if err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = Provider(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s Provider) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Provider) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes Providers as json.
+func (s Providers) Encode(e *jx.Encoder) {
+	unwrapped := []Provider(s)
+	if unwrapped == nil {
+		e.ArrEmpty()
+		return
+	}
+	if unwrapped != nil {
+		e.ArrStart()
+		for _, elem := range unwrapped {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+// Decode decodes Providers from json.
+func (s *Providers) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Providers to nil")
+	}
+	var unwrapped []Provider
+	if err := func() error {
+		unwrapped = make([]Provider, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem Provider
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = Providers(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s Providers) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Providers) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RefreshTokensBadRequest as json.
+func (s *RefreshTokensBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes RefreshTokensBadRequest from json.
+func (s *RefreshTokensBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RefreshTokensBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = RefreshTokensBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RefreshTokensBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RefreshTokensBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RefreshTokensInternalServerError as json.
+func (s *RefreshTokensInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes RefreshTokensInternalServerError from json.
+func (s *RefreshTokensInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RefreshTokensInternalServerError to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = RefreshTokensInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RefreshTokensInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RefreshTokensInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RefreshTokensReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RefreshTokensReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("refreshToken")
+		e.Str(s.RefreshToken)
+	}
+}
+
+var jsonFieldsNameOfRefreshTokensReq = [1]string{
+	0: "refreshToken",
+}
+
+// Decode decodes RefreshTokensReq from json.
+func (s *RefreshTokensReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RefreshTokensReq to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "refreshToken":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.RefreshToken = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"refreshToken\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RefreshTokensReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfRefreshTokensReq) {
+					name = jsonFieldsNameOfRefreshTokensReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RefreshTokensReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RefreshTokensReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RegisterBadRequest as json.
+func (s *RegisterBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes RegisterBadRequest from json.
+func (s *RegisterBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RegisterBadRequest to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = RegisterBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RegisterBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RegisterBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RegisterConflict as json.
+func (s *RegisterConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes RegisterConflict from json.
+func (s *RegisterConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RegisterConflict to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = RegisterConflict(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RegisterConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RegisterConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RegisterInternalServerError as json.
+func (s *RegisterInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes RegisterInternalServerError from json.
+func (s *RegisterInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RegisterInternalServerError to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = RegisterInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RegisterInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RegisterInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RegisterRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RegisterRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("username")
+		e.Str(s.Username)
+	}
+	{
+		e.FieldStart("password")
+		e.Str(s.Password)
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRegisterRequest = [3]string{
+	0: "username",
+	1: "password",
+	2: "name",
+}
+
+// Decode decodes RegisterRequest from json.
+func (s *RegisterRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RegisterRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "username":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Username = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"username\"")
+			}
+		case "password":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Password = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"password\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RegisterRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfRegisterRequest) {
+					name = jsonFieldsNameOfRegisterRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RegisterRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RegisterRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ScoreBreakdown) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ScoreBreakdown) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("base")
+		e.Int(s.Base)
+	}
+	{
+		e.FieldStart("bonus")
+		e.Int(s.Bonus)
+	}
+	{
+		e.FieldStart("penalty")
+		e.Int(s.Penalty)
+	}
+}
+
+var jsonFieldsNameOfScoreBreakdown = [3]string{
+	0: "base",
+	1: "bonus",
+	2: "penalty",
+}
+
+// Decode decodes ScoreBreakdown from json.
+func (s *ScoreBreakdown) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ScoreBreakdown to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "base":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Base = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"base\"")
+			}
+		case "bonus":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Bonus = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bonus\"")
+			}
+		case "penalty":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Penalty = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"penalty\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ScoreBreakdown")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfScoreBreakdown) {
+					name = jsonFieldsNameOfScoreBreakdown[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ScoreBreakdown) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ScoreBreakdown) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ScoreDistance as json.
+func (s ScoreDistance) Encode(e *jx.Encoder) {
+	unwrapped := float64(s)
+
+	e.Float64(unwrapped)
+}
+
+// Decode decodes ScoreDistance from json.
+func (s *ScoreDistance) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ScoreDistance to nil")
+	}
+	var unwrapped float64
+	if err := func() error {
+		v, err := d.Float64()
+		unwrapped = float64(v)
+		if err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ScoreDistance(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ScoreDistance) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ScoreDistance) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ScoringMode as json.
+func (s ScoringMode) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ScoringMode from json.
+func (s *ScoringMode) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ScoringMode to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ScoringMode(v) {
+	case ScoringModeClassic:
+		*s = ScoringModeClassic
+	case ScoringModeTimed:
+		*s = ScoringModeTimed
+	case ScoringModeHard:
+		*s = ScoringModeHard
+	case ScoringModeCountry:
+		*s = ScoringModeCountry
+	default:
+		*s = ScoringMode(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ScoringMode) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ScoringMode) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SingleplayerChallenge) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SingleplayerChallenge) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("token")
+		e.Str(s.Token)
+	}
+	{
+		e.FieldStart("gameID")
+		e.Int(s.GameID)
+	}
+	{
+		e.FieldStart("userID")
+		e.Int(s.UserID)
+	}
+	{
+		e.FieldStart("rounds")
+		e.Int(s.Rounds)
+	}
+	{
+		e.FieldStart("timerSeconds")
+		e.Int(s.TimerSeconds)
+	}
+	{
+		e.FieldStart("movementAllowed")
+		e.Bool(s.MovementAllowed)
+	}
+	{
+		e.FieldStart("provider")
+		s.Provider.Encode(e)
+	}
+	{
+		if s.Providers != nil {
+			e.FieldStart("providers")
+			s.Providers.Encode(e)
+		}
+	}
+	{
+		if s.ScoreDistance.Set {
+			e.FieldStart("scoreDistance")
+			s.ScoreDistance.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("scoring")
+		s.Scoring.Encode(e)
+	}
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("results")
+		e.ArrStart()
+		for _, elem := range s.Results {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfSingleplayerChallenge = [12]string{
+	0:  "token",
+	1:  "gameID",
+	2:  "userID",
+	3:  "rounds",
+	4:  "timerSeconds",
+	5:  "movementAllowed",
+	6:  "provider",
+	7:  "providers",
+	8:  "scoreDistance",
+	9:  "scoring",
+	10: "createdAt",
+	11: "results",
+}
+
+// Decode decodes SingleplayerChallenge from json.
+func (s *SingleplayerChallenge) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SingleplayerChallenge to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "token":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Token = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"token\"")
+			}
+		case "gameID":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.GameID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"gameID\"")
+			}
+		case "userID":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.UserID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"userID\"")
+			}
+		case "rounds":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.Rounds = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rounds\"")
+			}
+		case "timerSeconds":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.TimerSeconds = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"timerSeconds\"")
+			}
+		case "movementAllowed":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Bool()
+				s.MovementAllowed = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"movementAllowed\"")
+			}
+		case "provider":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				if err := s.Provider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "providers":
+			if err := func() error {
+				if err := s.Providers.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"providers\"")
+			}
+		case "scoreDistance":
+			if err := func() error {
+				s.ScoreDistance.Reset()
+				if err := s.ScoreDistance.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scoreDistance\"")
+			}
+		case "scoring":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				if err := s.Scoring.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scoring\"")
+			}
+		case "createdAt":
+			requiredBitSet[1] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
+			}
+		case "results":
+			requiredBitSet[1] |= 1 << 3
+			if err := func() error {
+				s.Results = make([]SingleplayerChallengeResult, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem SingleplayerChallengeResult
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Results = append(s.Results, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"results\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SingleplayerChallenge")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b01111111,
+		0b00001110,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSingleplayerChallenge) {
+					name = jsonFieldsNameOfSingleplayerChallenge[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SingleplayerChallenge) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SingleplayerChallenge) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SingleplayerChallengeResult) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SingleplayerChallengeResult) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("gameID")
+		e.Int(s.GameID)
+	}
+	{
+		e.FieldStart("userID")
+		e.Int(s.UserID)
+	}
+	{
+		e.FieldStart("username")
+		e.Str(s.Username)
+	}
+	{
+		e.FieldStart("avatarHash")
+		e.Str(s.AvatarHash)
+	}
+	{
+		e.FieldStart("score")
+		e.Int(s.Score)
+	}
+	{
+		e.FieldStart("finished")
+		e.Bool(s.Finished)
+	}
+	{
+		e.FieldStart("guesses")
+		e.ArrStart()
+		for _, elem := range s.Guesses {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfSingleplayerChallengeResult = [7]string{
+	0: "gameID",
+	1: "userID",
+	2: "username",
+	3: "avatarHash",
+	4: "score",
+	5: "finished",
+	6: "guesses",
+}
+
+// Decode decodes SingleplayerChallengeResult from json.
+func (s *SingleplayerChallengeResult) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SingleplayerChallengeResult to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "gameID":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.GameID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"gameID\"")
+			}
+		case "userID":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.UserID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"userID\"")
+			}
+		case "username":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Username = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"username\"")
+			}
+		case "avatarHash":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.AvatarHash = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"avatarHash\"")
+			}
+		case "score":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.Score = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
+			}
+		case "finished":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Bool()
+				s.Finished = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"finished\"")
+			}
+		case "guesses":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				s.Guesses = make([]SingleplayerRoundsWithGuess, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem SingleplayerRoundsWithGuess
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Guesses = append(s.Guesses, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"guesses\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SingleplayerChallengeResult")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b01111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSingleplayerChallengeResult) {
+					name = jsonFieldsNameOfSingleplayerChallengeResult[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SingleplayerChallengeResult) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SingleplayerChallengeResult) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SingleplayerGame) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SingleplayerGame) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int(s.ID)
+	}
+	{
+		e.FieldStart("userID")
+		e.Int(s.UserID)
+	}
+	{
+		e.FieldStart("rounds")
+		e.Int(s.Rounds)
+	}
+	{
+		e.FieldStart("roundCurrent")
+		e.Int(s.RoundCurrent)
+	}
+	{
+		e.FieldStart("timerSeconds")
+		e.Int(s.TimerSeconds)
+	}
+	{
+		e.FieldStart("movementAllowed")
+		e.Bool(s.MovementAllowed)
+	}
+	{
+		e.FieldStart("provider")
+		s.Provider.Encode(e)
+	}
+	{
+		if s.Providers != nil {
+			e.FieldStart("providers")
+			s.Providers.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("score")
+		e.Int(s.Score)
+	}
+	{
+		e.FieldStart("finished")
+		e.Bool(s.Finished)
+	}
+	{
+		e.FieldStart("daily")
+		e.Bool(s.Daily)
+	}
+	{
+		if s.MapID.Set {
+			e.FieldStart("mapID")
+			s.MapID.Encode(e)
+		}
+	}
+	{
+		if s.ScoreDistance.Set {
+			e.FieldStart("scoreDistance")
+			s.ScoreDistance.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("scoring")
+		s.Scoring.Encode(e)
+	}
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+}
+
+var jsonFieldsNameOfSingleplayerGame = [15]string{
+	0:  "id",
+	1:  "userID",
+	2:  "rounds",
+	3:  "roundCurrent",
+	4:  "timerSeconds",
+	5:  "movementAllowed",
+	6:  "provider",
+	7:  "providers",
+	8:  "score",
+	9:  "finished",
+	10: "daily",
+	11: "mapID",
+	12: "scoreDistance",
+	13: "scoring",
+	14: "createdAt",
+}
+
+// Decode decodes SingleplayerGame from json.
+func (s *SingleplayerGame) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SingleplayerGame to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.ID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "userID":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.UserID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"userID\"")
+			}
+		case "rounds":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Rounds = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rounds\"")
+			}
+		case "roundCurrent":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.RoundCurrent = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"roundCurrent\"")
+			}
+		case "timerSeconds":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.TimerSeconds = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"timerSeconds\"")
+			}
+		case "movementAllowed":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Bool()
+				s.MovementAllowed = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"movementAllowed\"")
+			}
+		case "provider":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				if err := s.Provider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "providers":
+			if err := func() error {
+				if err := s.Providers.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"providers\"")
+			}
+		case "score":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Score = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
+			}
+		case "finished":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				v, err := d.Bool()
+				s.Finished = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"finished\"")
+			}
+		case "daily":
+			requiredBitSet[1] |= 1 << 2
+			if err := func() error {
+				v, err := d.Bool()
+				s.Daily = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"daily\"")
+			}
+		case "mapID":
+			if err := func() error {
+				s.MapID.Reset()
+				if err := s.MapID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mapID\"")
+			}
+		case "scoreDistance":
+			if err := func() error {
+				s.ScoreDistance.Reset()
+				if err := s.ScoreDistance.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scoreDistance\"")
+			}
+		case "scoring":
+			requiredBitSet[1] |= 1 << 5
+			if err := func() error {
+				if err := s.Scoring.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scoring\"")
+			}
+		case "createdAt":
+			requiredBitSet[1] |= 1 << 6
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SingleplayerGame")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b01111111,
+		0b01100111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSingleplayerGame) {
+					name = jsonFieldsNameOfSingleplayerGame[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	*s = NewYandexCallbackInternalServerError(unwrapped)
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NewYandexCallbackInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *SingleplayerGame) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewYandexCallbackInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *SingleplayerGame) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NewYandexCallbackUnauthorized as json.
-func (s *NewYandexCallbackUnauthorized) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *SingleplayerGames) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes NewYandexCallbackUnauthorized from json.
-func (s *NewYandexCallbackUnauthorized) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode NewYandexCallbackUnauthorized to nil")
+// encodeFields encodes fields.
+func (s *SingleplayerGames) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("total")
+		e.Int(s.Total)
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		e.FieldStart("games")
+		e.ArrStart()
+		for _, elem := range s.Games {
+			elem.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+		e.ArrEnd()
 	}
-	*s = NewYandexCallbackUnauthorized(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *NewYandexCallbackUnauthorized) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NewYandexCallbackUnauthorized) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfSingleplayerGames = [2]string{
+	0: "total",
+	1: "games",
 }
 
-// Encode encodes int as json.
-func (o OptInt) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes SingleplayerGames from json.
+func (s *SingleplayerGames) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SingleplayerGames to nil")
 	}
-	e.Int(int(o.Value))
-}
+	var requiredBitSet [1]uint8
 
-// Decode decodes int from json.
-func (o *OptInt) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptInt to nil")
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "total":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Total = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"total\"")
+			}
+		case "games":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.Games = make([]SingleplayerGame, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem SingleplayerGame
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Games = append(s.Games, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"games\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SingleplayerGames")
 	}
-	o.Set = true
-	v, err := d.Int()
-	if err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSingleplayerGames) {
+					name = jsonFieldsNameOfSingleplayerGames[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	o.Value = int(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptInt) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptInt) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes string as json.
-func (o OptString) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
-	e.Str(string(o.Value))
-}
 
-// Decode decodes string from json.
-func (o *OptString) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptString to nil")
-	}
-	o.Set = true
-	v, err := d.Str()
-	if err != nil {
-		return err
-	}
-	o.Value = string(v)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptString) MarshalJSON() ([]byte, error) {
+func (s *SingleplayerGames) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptString) UnmarshalJSON(data []byte) error {
+func (s *SingleplayerGames) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes Provider as json.
-func (s Provider) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode implements json.Marshaler.
+func (s *SingleplayerRound) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes Provider from json.
-func (s *Provider) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode Provider to nil")
+// encodeFields encodes fields.
+func (s *SingleplayerRound) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int(s.ID)
 	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+	{
+		e.FieldStart("gameID")
+		e.Int(s.GameID)
 	}
-	// Try to use constant string.
-	switch Provider(v) {
-	case ProviderGoogle:
-		*s = ProviderGoogle
-	case ProviderYandex:
-		*s = ProviderYandex
-	case ProviderYandexAir:
-		*s = ProviderYandexAir
-	case ProviderSeznam:
-		*s = ProviderSeznam
-	default:
-		*s = Provider(v)
+	{
+		if s.StreetviewID.Set {
+			e.FieldStart("streetviewID")
+			s.StreetviewID.Encode(e)
+		}
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s Provider) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Provider) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes RefreshTokensBadRequest as json.
-func (s *RefreshTokensBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes RefreshTokensBadRequest from json.
-func (s *RefreshTokensBadRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RefreshTokensBadRequest to nil")
+	{
+		e.FieldStart("provider")
+		s.Provider.Encode(e)
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		e.FieldStart("roundNum")
+		e.Int(s.RoundNum)
+	}
+	{
+		e.FieldStart("panoramaToken")
+		e.Str(s.PanoramaToken)
+	}
+	{
+		if s.Location.Set {
+			e.FieldStart("location")
+			s.Location.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = RefreshTokensBadRequest(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *RefreshTokensBadRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RefreshTokensBadRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+	{
+		e.FieldStart("panoramaURL")
+		e.Str(s.PanoramaURL)
+	}
+	{
+		e.FieldStart("finished")
+		e.Bool(s.Finished)
+	}
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("startedAt")
+		json.EncodeDateTime(e, s.StartedAt)
+	}
 }
 
-// Encode encodes RefreshTokensInternalServerError as json.
-func (s *RefreshTokensInternalServerError) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
+var jsonFieldsNameOfSingleplayerRound = [11]string{
+	0:  "id",
+	1:  "gameID",
+	2:  "streetviewID",
+	3:  "provider",
+	4:  "roundNum",
+	5:  "panoramaToken",
+	6:  "location",
+	7:  "panoramaURL",
+	8:  "finished",
+	9:  "createdAt",
+	10: "startedAt",
 }
 
-// Decode decodes RefreshTokensInternalServerError from json.
-func (s *RefreshTokensInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes SingleplayerRound from json.
+func (s *SingleplayerRound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RefreshTokensInternalServerError to nil")
+		return errors.New("invalid: unable to decode SingleplayerRound to nil")
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.ID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "gameID":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.GameID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"gameID\"")
+			}
+		case "streetviewID":
+			if err := func() error {
+				s.StreetviewID.Reset()
+				if err := s.StreetviewID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"streetviewID\"")
+			}
+		case "provider":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Provider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "roundNum":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.RoundNum = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"roundNum\"")
+			}
+		case "panoramaToken":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Str()
+				s.PanoramaToken = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"panoramaToken\"")
+			}
+		case "location":
+			if err := func() error {
+				s.Location.Reset()
+				if err := s.Location.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"location\"")
+			}
+		case "panoramaURL":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Str()
+				s.PanoramaURL = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"panoramaURL\"")
+			}
+		case "finished":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Bool()
+				s.Finished = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"finished\"")
+			}
+		case "createdAt":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
+			}
+		case "startedAt":
+			requiredBitSet[1] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.StartedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"startedAt\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode SingleplayerRound")
 	}
-	*s = RefreshTokensInternalServerError(unwrapped)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b10111011,
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSingleplayerRound) {
+					name = jsonFieldsNameOfSingleplayerRound[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RefreshTokensInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *SingleplayerRound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RefreshTokensInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *SingleplayerRound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RefreshTokensReq) Encode(e *jx.Encoder) {
+func (s *SingleplayerRoundGuess) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RefreshTokensReq) encodeFields(e *jx.Encoder) {
+func (s *SingleplayerRoundGuess) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("refreshToken")
-		e.Str(s.RefreshToken)
+		e.FieldStart("guess")
+		s.Guess.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfRefreshTokensReq = [1]string{
-	0: "refreshToken",
+var jsonFieldsNameOfSingleplayerRoundGuess = [1]string{
+	0: "guess",
 }
 
-// Decode decodes RefreshTokensReq from json.
-func (s *RefreshTokensReq) Decode(d *jx.Decoder) error {
+// Decode decodes SingleplayerRoundGuess from json.
+func (s *SingleplayerRoundGuess) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RefreshTokensReq to nil")
+		return errors.New("invalid: unable to decode SingleplayerRoundGuess to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "refreshToken":
+		case "guess":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.RefreshToken = string(v)
-				if err != nil {
+				if err := s.Guess.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"refreshToken\"")
+				return errors.Wrap(err, "decode field \"guess\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RefreshTokensReq")
+		return errors.Wrap(err, "decode SingleplayerRoundGuess")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -5660,8 +13583,8 @@ func (s *RefreshTokensReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfRefreshTokensReq) {
-					name = jsonFieldsNameOfRefreshTokensReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfSingleplayerRoundGuess) {
+					name = jsonFieldsNameOfSingleplayerRoundGuess[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5682,217 +13605,238 @@ func (s *RefreshTokensReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RefreshTokensReq) MarshalJSON() ([]byte, error) {
+func (s *SingleplayerRoundGuess) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RefreshTokensReq) UnmarshalJSON(data []byte) error {
+func (s *SingleplayerRoundGuess) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes RegisterBadRequest as json.
-func (s *RegisterBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *SingleplayerRoundsWithGuess) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes RegisterBadRequest from json.
-func (s *RegisterBadRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RegisterBadRequest to nil")
+// encodeFields encodes fields.
+func (s *SingleplayerRoundsWithGuess) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("roundNum")
+		e.Int(s.RoundNum)
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	{
+		e.FieldStart("roundLat")
+		e.Float64(s.RoundLat)
 	}
-	*s = RegisterBadRequest(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *RegisterBadRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RegisterBadRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes RegisterConflict as json.
-func (s *RegisterConflict) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes RegisterConflict from json.
-func (s *RegisterConflict) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RegisterConflict to nil")
+	{
+		e.FieldStart("roundLng")
+		e.Float64(s.RoundLng)
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	{
+		e.FieldStart("guessLat")
+		e.Float64(s.GuessLat)
 	}
-	*s = RegisterConflict(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *RegisterConflict) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RegisterConflict) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes RegisterInternalServerError as json.
-func (s *RegisterInternalServerError) Encode(e *jx.Encoder) {
-	unwrapped := (*Error)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes RegisterInternalServerError from json.
-func (s *RegisterInternalServerError) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RegisterInternalServerError to nil")
+	{
+		e.FieldStart("guessLng")
+		e.Float64(s.GuessLng)
 	}
-	var unwrapped Error
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	{
+		e.FieldStart("score")
+		e.Int(s.Score)
 	}
-	*s = RegisterInternalServerError(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *RegisterInternalServerError) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RegisterInternalServerError) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *RegisterRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *RegisterRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("username")
-		e.Str(s.Username)
+		e.FieldStart("scoreBreakdown")
+		s.ScoreBreakdown.Encode(e)
 	}
 	{
-		e.FieldStart("password")
-		e.Str(s.Password)
+		e.FieldStart("missDistance")
+		e.Int(s.MissDistance)
 	}
 	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
+		if s.RoundCountry.Set {
+			e.FieldStart("roundCountry")
+			s.RoundCountry.Encode(e)
+		}
+	}
+	{
+		if s.GuessCountry.Set {
+			e.FieldStart("guessCountry")
+			s.GuessCountry.Encode(e)
 		}
 	}
+	{
+		e.FieldStart("correctCountry")
+		e.Bool(s.CorrectCountry)
+	}
 }
 
-var jsonFieldsNameOfRegisterRequest = [3]string{
-	0: "username",
-	1: "password",
-	2: "name",
+var jsonFieldsNameOfSingleplayerRoundsWithGuess = [11]string{
+	0:  "roundNum",
+	1:  "roundLat",
+	2:  "roundLng",
+	3:  "guessLat",
+	4:  "guessLng",
+	5:  "score",
+	6:  "scoreBreakdown",
+	7:  "missDistance",
+	8:  "roundCountry",
+	9:  "guessCountry",
+	10: "correctCountry",
 }
 
-// Decode decodes RegisterRequest from json.
-func (s *RegisterRequest) Decode(d *jx.Decoder) error {
+// Decode decodes SingleplayerRoundsWithGuess from json.
+func (s *SingleplayerRoundsWithGuess) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RegisterRequest to nil")
+		return errors.New("invalid: unable to decode SingleplayerRoundsWithGuess to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "username":
+		case "roundNum":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Username = string(v)
+				v, err := d.Int()
+				s.RoundNum = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"roundNum\"")
+			}
+		case "roundLat":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Float64()
+				s.RoundLat = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"roundLat\"")
+			}
+		case "roundLng":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float64()
+				s.RoundLng = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"roundLng\"")
+			}
+		case "guessLat":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float64()
+				s.GuessLat = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"guessLat\"")
+			}
+		case "guessLng":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Float64()
+				s.GuessLng = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"guessLng\"")
+			}
+		case "score":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int()
+				s.Score = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
+			}
+		case "scoreBreakdown":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				if err := s.ScoreBreakdown.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scoreBreakdown\"")
+			}
+		case "missDistance":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Int()
+				s.MissDistance = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"username\"")
+				return errors.Wrap(err, "decode field \"missDistance\"")
 			}
-		case "password":
-			requiredBitSet[0] |= 1 << 1
+		case "roundCountry":
 			if err := func() error {
-				v, err := d.Str()
-				s.Password = string(v)
-				if err != nil {
+				s.RoundCountry.Reset()
+				if err := s.RoundCountry.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
+				return errors.Wrap(err, "decode field \"roundCountry\"")
 			}
-		case "name":
+		case "guessCountry":
 			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
+				s.GuessCountry.Reset()
+				if err := s.GuessCountry.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"guessCountry\"")
+			}
+		case "correctCountry":
+			requiredBitSet[1] |= 1 << 2
+			if err := func() error {
+				v, err := d.Bool()
+				s.CorrectCountry = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"correctCountry\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RegisterRequest")
+		return errors.Wrap(err, "decode SingleplayerRoundsWithGuess")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
+	for i, mask := range [2]uint8{
+		0b11111111,
+		0b00000100,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5904,8 +13848,8 @@ func (s *RegisterRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfRegisterRequest) {
-					name = jsonFieldsNameOfRegisterRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfSingleplayerRoundsWithGuess) {
+					name = jsonFieldsNameOfSingleplayerRoundsWithGuess[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5926,27 +13870,27 @@ func (s *RegisterRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RegisterRequest) MarshalJSON() ([]byte, error) {
+func (s *SingleplayerRoundsWithGuess) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RegisterRequest) UnmarshalJSON(data []byte) error {
+func (s *SingleplayerRoundsWithGuess) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *SingleplayerGame) Encode(e *jx.Encoder) {
+func (s *StreakGame) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *SingleplayerGame) encodeFields(e *jx.Encoder) {
+func (s *StreakGame) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("id")
 		e.Int(s.ID)
@@ -5955,10 +13899,6 @@ func (s *SingleplayerGame) encodeFields(e *jx.Encoder) {
 		e.FieldStart("userID")
 		e.Int(s.UserID)
 	}
-	{
-		e.FieldStart("rounds")
-		e.Int(s.Rounds)
-	}
 	{
 		e.FieldStart("roundCurrent")
 		e.Int(s.RoundCurrent)
@@ -5976,8 +13916,14 @@ func (s *SingleplayerGame) encodeFields(e *jx.Encoder) {
 		s.Provider.Encode(e)
 	}
 	{
-		e.FieldStart("score")
-		e.Int(s.Score)
+		if s.Providers != nil {
+			e.FieldStart("providers")
+			s.Providers.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("streak")
+		e.Int(s.Streak)
 	}
 	{
 		e.FieldStart("finished")
@@ -5987,25 +13933,32 @@ func (s *SingleplayerGame) encodeFields(e *jx.Encoder) {
 		e.FieldStart("createdAt")
 		json.EncodeDateTime(e, s.CreatedAt)
 	}
+	{
+		if s.EndedAt.Set {
+			e.FieldStart("endedAt")
+			s.EndedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
 }
 
-var jsonFieldsNameOfSingleplayerGame = [10]string{
-	0: "id",
-	1: "userID",
-	2: "rounds",
-	3: "roundCurrent",
-	4: "timerSeconds",
-	5: "movementAllowed",
-	6: "provider",
-	7: "score",
-	8: "finished",
-	9: "createdAt",
+var jsonFieldsNameOfStreakGame = [11]string{
+	0:  "id",
+	1:  "userID",
+	2:  "roundCurrent",
+	3:  "timerSeconds",
+	4:  "movementAllowed",
+	5:  "provider",
+	6:  "providers",
+	7:  "streak",
+	8:  "finished",
+	9:  "createdAt",
+	10: "endedAt",
 }
 
-// Decode decodes SingleplayerGame from json.
-func (s *SingleplayerGame) Decode(d *jx.Decoder) error {
+// Decode decodes StreakGame from json.
+func (s *StreakGame) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SingleplayerGame to nil")
+		return errors.New("invalid: unable to decode StreakGame to nil")
 	}
 	var requiredBitSet [2]uint8
 
@@ -6035,20 +13988,8 @@ func (s *SingleplayerGame) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"userID\"")
 			}
-		case "rounds":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Int()
-				s.Rounds = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"rounds\"")
-			}
 		case "roundCurrent":
-			requiredBitSet[0] |= 1 << 3
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
 				v, err := d.Int()
 				s.RoundCurrent = int(v)
@@ -6060,7 +14001,7 @@ func (s *SingleplayerGame) Decode(d *jx.Decoder) error {
 				return errors.Wrap(err, "decode field \"roundCurrent\"")
 			}
 		case "timerSeconds":
-			requiredBitSet[0] |= 1 << 4
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
 				v, err := d.Int()
 				s.TimerSeconds = int(v)
@@ -6072,7 +14013,7 @@ func (s *SingleplayerGame) Decode(d *jx.Decoder) error {
 				return errors.Wrap(err, "decode field \"timerSeconds\"")
 			}
 		case "movementAllowed":
-			requiredBitSet[0] |= 1 << 5
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
 				v, err := d.Bool()
 				s.MovementAllowed = bool(v)
@@ -6084,7 +14025,7 @@ func (s *SingleplayerGame) Decode(d *jx.Decoder) error {
 				return errors.Wrap(err, "decode field \"movementAllowed\"")
 			}
 		case "provider":
-			requiredBitSet[0] |= 1 << 6
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
 				if err := s.Provider.Decode(d); err != nil {
 					return err
@@ -6093,17 +14034,26 @@ func (s *SingleplayerGame) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"provider\"")
 			}
-		case "score":
+		case "providers":
+			if err := func() error {
+				if err := s.Providers.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"providers\"")
+			}
+		case "streak":
 			requiredBitSet[0] |= 1 << 7
 			if err := func() error {
 				v, err := d.Int()
-				s.Score = int(v)
+				s.Streak = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"score\"")
+				return errors.Wrap(err, "decode field \"streak\"")
 			}
 		case "finished":
 			requiredBitSet[1] |= 1 << 0
@@ -6129,17 +14079,27 @@ func (s *SingleplayerGame) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"createdAt\"")
 			}
+		case "endedAt":
+			if err := func() error {
+				s.EndedAt.Reset()
+				if err := s.EndedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"endedAt\"")
+			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SingleplayerGame")
+		return errors.Wrap(err, "decode StreakGame")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [2]uint8{
-		0b11111111,
+		0b10111111,
 		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
@@ -6152,8 +14112,8 @@ func (s *SingleplayerGame) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfSingleplayerGame) {
-					name = jsonFieldsNameOfSingleplayerGame[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfStreakGame) {
+					name = jsonFieldsNameOfStreakGame[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6174,50 +14134,50 @@ func (s *SingleplayerGame) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SingleplayerGame) MarshalJSON() ([]byte, error) {
+func (s *StreakGame) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SingleplayerGame) UnmarshalJSON(data []byte) error {
+func (s *StreakGame) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *SingleplayerGames) Encode(e *jx.Encoder) {
+func (s *StreakLeaderboard) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *SingleplayerGames) encodeFields(e *jx.Encoder) {
+func (s *StreakLeaderboard) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("total")
 		e.Int(s.Total)
 	}
 	{
-		e.FieldStart("games")
+		e.FieldStart("results")
 		e.ArrStart()
-		for _, elem := range s.Games {
+		for _, elem := range s.Results {
 			elem.Encode(e)
 		}
 		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfSingleplayerGames = [2]string{
+var jsonFieldsNameOfStreakLeaderboard = [2]string{
 	0: "total",
-	1: "games",
+	1: "results",
 }
 
-// Decode decodes SingleplayerGames from json.
-func (s *SingleplayerGames) Decode(d *jx.Decoder) error {
+// Decode decodes StreakLeaderboard from json.
+func (s *StreakLeaderboard) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SingleplayerGames to nil")
+		return errors.New("invalid: unable to decode StreakLeaderboard to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -6227,43 +14187,241 @@ func (s *SingleplayerGames) Decode(d *jx.Decoder) error {
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Int()
-				s.Total = int(v)
+				s.Total = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"total\"")
+			}
+		case "results":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.Results = make([]StreakLeaderboardResult, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem StreakLeaderboardResult
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Results = append(s.Results, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"results\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StreakLeaderboard")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStreakLeaderboard) {
+					name = jsonFieldsNameOfStreakLeaderboard[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StreakLeaderboard) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StreakLeaderboard) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StreakLeaderboardResult) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StreakLeaderboardResult) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("place")
+		e.Int(s.Place)
+	}
+	{
+		e.FieldStart("userID")
+		e.Int(s.UserID)
+	}
+	{
+		e.FieldStart("username")
+		e.Str(s.Username)
+	}
+	{
+		e.FieldStart("avatarHash")
+		e.Str(s.AvatarHash)
+	}
+	{
+		e.FieldStart("gameID")
+		e.Int(s.GameID)
+	}
+	{
+		e.FieldStart("streak")
+		e.Int(s.Streak)
+	}
+	{
+		e.FieldStart("endedAt")
+		json.EncodeDateTime(e, s.EndedAt)
+	}
+}
+
+var jsonFieldsNameOfStreakLeaderboardResult = [7]string{
+	0: "place",
+	1: "userID",
+	2: "username",
+	3: "avatarHash",
+	4: "gameID",
+	5: "streak",
+	6: "endedAt",
+}
+
+// Decode decodes StreakLeaderboardResult from json.
+func (s *StreakLeaderboardResult) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StreakLeaderboardResult to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "place":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Place = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"place\"")
+			}
+		case "userID":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.UserID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"userID\"")
+			}
+		case "username":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Username = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"username\"")
+			}
+		case "avatarHash":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.AvatarHash = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"avatarHash\"")
+			}
+		case "gameID":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.GameID = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"total\"")
+				return errors.Wrap(err, "decode field \"gameID\"")
 			}
-		case "games":
-			requiredBitSet[0] |= 1 << 1
+		case "streak":
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				s.Games = make([]SingleplayerGame, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem SingleplayerGame
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Games = append(s.Games, elem)
-					return nil
-				}); err != nil {
+				v, err := d.Int()
+				s.Streak = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"games\"")
+				return errors.Wrap(err, "decode field \"streak\"")
+			}
+		case "endedAt":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.EndedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"endedAt\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SingleplayerGames")
+		return errors.Wrap(err, "decode StreakLeaderboardResult")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b01111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6275,8 +14433,8 @@ func (s *SingleplayerGames) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfSingleplayerGames) {
-					name = jsonFieldsNameOfSingleplayerGames[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfStreakLeaderboardResult) {
+					name = jsonFieldsNameOfStreakLeaderboardResult[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6297,27 +14455,27 @@ func (s *SingleplayerGames) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SingleplayerGames) MarshalJSON() ([]byte, error) {
+func (s *StreakLeaderboardResult) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SingleplayerGames) UnmarshalJSON(data []byte) error {
+func (s *StreakLeaderboardResult) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *SingleplayerRound) Encode(e *jx.Encoder) {
+func (s *StreakRound) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *SingleplayerRound) encodeFields(e *jx.Encoder) {
+func (s *StreakRound) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("id")
 		e.Int(s.ID)
@@ -6327,24 +14485,44 @@ func (s *SingleplayerRound) encodeFields(e *jx.Encoder) {
 		e.Int(s.GameID)
 	}
 	{
-		e.FieldStart("streetviewID")
-		e.Str(s.StreetviewID)
+		if s.StreetviewID.Set {
+			e.FieldStart("streetviewID")
+			s.StreetviewID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("provider")
+		s.Provider.Encode(e)
 	}
 	{
 		e.FieldStart("roundNum")
 		e.Int(s.RoundNum)
 	}
 	{
-		e.FieldStart("lat")
-		e.Float64(s.Lat)
+		e.FieldStart("panoramaToken")
+		e.Str(s.PanoramaToken)
 	}
 	{
-		e.FieldStart("lng")
-		e.Float64(s.Lng)
+		if s.Location.Set {
+			e.FieldStart("location")
+			s.Location.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("panoramaURL")
-		e.Str(s.PanoramaURL)
+		if s.Country.Set {
+			e.FieldStart("country")
+			s.Country.Encode(e)
+		}
+	}
+	{
+		if s.GuessCountry.Set {
+			e.FieldStart("guessCountry")
+			s.GuessCountry.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("correct")
+		e.Bool(s.Correct)
 	}
 	{
 		e.FieldStart("finished")
@@ -6360,23 +14538,26 @@ func (s *SingleplayerRound) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfSingleplayerRound = [10]string{
-	0: "id",
-	1: "gameID",
-	2: "streetviewID",
-	3: "roundNum",
-	4: "lat",
-	5: "lng",
-	6: "panoramaURL",
-	7: "finished",
-	8: "createdAt",
-	9: "startedAt",
-}
-
-// Decode decodes SingleplayerRound from json.
-func (s *SingleplayerRound) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfStreakRound = [13]string{
+	0:  "id",
+	1:  "gameID",
+	2:  "streetviewID",
+	3:  "provider",
+	4:  "roundNum",
+	5:  "panoramaToken",
+	6:  "location",
+	7:  "country",
+	8:  "guessCountry",
+	9:  "correct",
+	10: "finished",
+	11: "createdAt",
+	12: "startedAt",
+}
+
+// Decode decodes StreakRound from json.
+func (s *StreakRound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SingleplayerRound to nil")
+		return errors.New("invalid: unable to decode StreakRound to nil")
 	}
 	var requiredBitSet [2]uint8
 
@@ -6407,19 +14588,27 @@ func (s *SingleplayerRound) Decode(d *jx.Decoder) error {
 				return errors.Wrap(err, "decode field \"gameID\"")
 			}
 		case "streetviewID":
-			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Str()
-				s.StreetviewID = string(v)
-				if err != nil {
+				s.StreetviewID.Reset()
+				if err := s.StreetviewID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"streetviewID\"")
 			}
-		case "roundNum":
+		case "provider":
 			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Provider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "roundNum":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
 				v, err := d.Int()
 				s.RoundNum = int(v)
@@ -6430,44 +14619,62 @@ func (s *SingleplayerRound) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"roundNum\"")
 			}
-		case "lat":
-			requiredBitSet[0] |= 1 << 4
+		case "panoramaToken":
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				v, err := d.Float64()
-				s.Lat = float64(v)
+				v, err := d.Str()
+				s.PanoramaToken = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"lat\"")
+				return errors.Wrap(err, "decode field \"panoramaToken\"")
 			}
-		case "lng":
-			requiredBitSet[0] |= 1 << 5
+		case "location":
 			if err := func() error {
-				v, err := d.Float64()
-				s.Lng = float64(v)
-				if err != nil {
+				s.Location.Reset()
+				if err := s.Location.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"lng\"")
+				return errors.Wrap(err, "decode field \"location\"")
 			}
-		case "panoramaURL":
-			requiredBitSet[0] |= 1 << 6
+		case "country":
 			if err := func() error {
-				v, err := d.Str()
-				s.PanoramaURL = string(v)
+				s.Country.Reset()
+				if err := s.Country.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"country\"")
+			}
+		case "guessCountry":
+			if err := func() error {
+				s.GuessCountry.Reset()
+				if err := s.GuessCountry.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"guessCountry\"")
+			}
+		case "correct":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				v, err := d.Bool()
+				s.Correct = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"panoramaURL\"")
+				return errors.Wrap(err, "decode field \"correct\"")
 			}
 		case "finished":
-			requiredBitSet[0] |= 1 << 7
+			requiredBitSet[1] |= 1 << 2
 			if err := func() error {
 				v, err := d.Bool()
 				s.Finished = bool(v)
@@ -6479,7 +14686,7 @@ func (s *SingleplayerRound) Decode(d *jx.Decoder) error {
 				return errors.Wrap(err, "decode field \"finished\"")
 			}
 		case "createdAt":
-			requiredBitSet[1] |= 1 << 0
+			requiredBitSet[1] |= 1 << 3
 			if err := func() error {
 				v, err := json.DecodeDateTime(d)
 				s.CreatedAt = v
@@ -6491,7 +14698,7 @@ func (s *SingleplayerRound) Decode(d *jx.Decoder) error {
 				return errors.Wrap(err, "decode field \"createdAt\"")
 			}
 		case "startedAt":
-			requiredBitSet[1] |= 1 << 1
+			requiredBitSet[1] |= 1 << 4
 			if err := func() error {
 				v, err := json.DecodeDateTime(d)
 				s.StartedAt = v
@@ -6507,13 +14714,13 @@ func (s *SingleplayerRound) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SingleplayerRound")
+		return errors.Wrap(err, "decode StreakRound")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [2]uint8{
-		0b11111111,
-		0b00000011,
+		0b00111011,
+		0b00011110,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6525,8 +14732,8 @@ func (s *SingleplayerRound) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfSingleplayerRound) {
-					name = jsonFieldsNameOfSingleplayerRound[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfStreakRound) {
+					name = jsonFieldsNameOfStreakRound[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6539,273 +14746,343 @@ func (s *SingleplayerRound) Decode(d *jx.Decoder) error {
 			}
 		}
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StreakRound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StreakRound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StreakRoundGuess) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StreakRoundGuess) encodeFields(e *jx.Encoder) {
+	{
+		if s.Country.Set {
+			e.FieldStart("country")
+			s.Country.Encode(e)
+		}
+	}
+	{
+		if s.Guess.Set {
+			e.FieldStart("guess")
+			s.Guess.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfStreakRoundGuess = [2]string{
+	0: "country",
+	1: "guess",
+}
+
+// Decode decodes StreakRoundGuess from json.
+func (s *StreakRoundGuess) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StreakRoundGuess to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "country":
+			if err := func() error {
+				s.Country.Reset()
+				if err := s.Country.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"country\"")
+			}
+		case "guess":
+			if err := func() error {
+				s.Guess.Reset()
+				if err := s.Guess.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"guess\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StreakRoundGuess")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StreakRoundGuess) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StreakRoundGuess) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TeamScoring as json.
+func (s TeamScoring) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes TeamScoring from json.
+func (s *TeamScoring) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TeamScoring to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch TeamScoring(v) {
+	case TeamScoringBest:
+		*s = TeamScoringBest
+	case TeamScoringAverage:
+		*s = TeamScoringAverage
+	default:
+		*s = TeamScoring(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s TeamScoring) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TeamScoring) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UpdateMapForbidden as json.
+func (s *UpdateMapForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UpdateMapForbidden from json.
+func (s *UpdateMapForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateMapForbidden to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = UpdateMapForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SingleplayerRound) MarshalJSON() ([]byte, error) {
+func (s *UpdateMapForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SingleplayerRound) UnmarshalJSON(data []byte) error {
+func (s *UpdateMapForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *SingleplayerRoundGuess) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes UpdateMapInternalServerError as json.
+func (s *UpdateMapInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *SingleplayerRoundGuess) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("guess")
-		s.Guess.Encode(e)
+// Decode decodes UpdateMapInternalServerError from json.
+func (s *UpdateMapInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateMapInternalServerError to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = UpdateMapInternalServerError(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfSingleplayerRoundGuess = [1]string{
-	0: "guess",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdateMapInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes SingleplayerRoundGuess from json.
-func (s *SingleplayerRoundGuess) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdateMapInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UpdateMapNotFound as json.
+func (s *UpdateMapNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UpdateMapNotFound from json.
+func (s *UpdateMapNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SingleplayerRoundGuess to nil")
+		return errors.New("invalid: unable to decode UpdateMapNotFound to nil")
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "guess":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				if err := s.Guess.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"guess\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode SingleplayerRoundGuess")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfSingleplayerRoundGuess) {
-					name = jsonFieldsNameOfSingleplayerRoundGuess[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = UpdateMapNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SingleplayerRoundGuess) MarshalJSON() ([]byte, error) {
+func (s *UpdateMapNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SingleplayerRoundGuess) UnmarshalJSON(data []byte) error {
+func (s *UpdateMapNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *SingleplayerRoundsWithGuess) Encode(e *jx.Encoder) {
+func (s *UpdateMapRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *SingleplayerRoundsWithGuess) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("roundNum")
-		e.Int(s.RoundNum)
-	}
+func (s *UpdateMapRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("roundLat")
-		e.Float64(s.RoundLat)
-	}
-	{
-		e.FieldStart("roundLng")
-		e.Float64(s.RoundLng)
-	}
-	{
-		e.FieldStart("guessLat")
-		e.Float64(s.GuessLat)
-	}
-	{
-		e.FieldStart("guessLng")
-		e.Float64(s.GuessLng)
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("score")
-		e.Int(s.Score)
+		e.FieldStart("description")
+		e.Str(s.Description)
 	}
 	{
-		e.FieldStart("missDistance")
-		e.Int(s.MissDistance)
+		e.FieldStart("visibility")
+		s.Visibility.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfSingleplayerRoundsWithGuess = [7]string{
-	0: "roundNum",
-	1: "roundLat",
-	2: "roundLng",
-	3: "guessLat",
-	4: "guessLng",
-	5: "score",
-	6: "missDistance",
+var jsonFieldsNameOfUpdateMapRequest = [3]string{
+	0: "name",
+	1: "description",
+	2: "visibility",
 }
 
-// Decode decodes SingleplayerRoundsWithGuess from json.
-func (s *SingleplayerRoundsWithGuess) Decode(d *jx.Decoder) error {
+// Decode decodes UpdateMapRequest from json.
+func (s *UpdateMapRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SingleplayerRoundsWithGuess to nil")
+		return errors.New("invalid: unable to decode UpdateMapRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "roundNum":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int()
-				s.RoundNum = int(v)
+				v, err := d.Str()
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"roundNum\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "roundLat":
+		case "description":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Float64()
-				s.RoundLat = float64(v)
+				v, err := d.Str()
+				s.Description = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"roundLat\"")
+				return errors.Wrap(err, "decode field \"description\"")
 			}
-		case "roundLng":
+		case "visibility":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Float64()
-				s.RoundLng = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"roundLng\"")
-			}
-		case "guessLat":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Float64()
-				s.GuessLat = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"guessLat\"")
-			}
-		case "guessLng":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Float64()
-				s.GuessLng = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"guessLng\"")
-			}
-		case "score":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.Int()
-				s.Score = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"score\"")
-			}
-		case "missDistance":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				v, err := d.Int()
-				s.MissDistance = int(v)
-				if err != nil {
+				if err := s.Visibility.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"missDistance\"")
+				return errors.Wrap(err, "decode field \"visibility\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SingleplayerRoundsWithGuess")
+		return errors.Wrap(err, "decode UpdateMapRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b01111111,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6817,8 +15094,8 @@ func (s *SingleplayerRoundsWithGuess) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfSingleplayerRoundsWithGuess) {
-					name = jsonFieldsNameOfSingleplayerRoundsWithGuess[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUpdateMapRequest) {
+					name = jsonFieldsNameOfUpdateMapRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6839,14 +15116,52 @@ func (s *SingleplayerRoundsWithGuess) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SingleplayerRoundsWithGuess) MarshalJSON() ([]byte, error) {
+func (s *UpdateMapRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SingleplayerRoundsWithGuess) UnmarshalJSON(data []byte) error {
+func (s *UpdateMapRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UpdateMapUnauthorized as json.
+func (s *UpdateMapUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*Error)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UpdateMapUnauthorized from json.
+func (s *UpdateMapUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateMapUnauthorized to nil")
+	}
+	var unwrapped Error
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UpdateMapUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdateMapUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdateMapUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -6,41 +6,60 @@ package api
 type OperationName = string
 
 const (
-	DeleteDiscordOperation             OperationName = "DeleteDiscord"
-	DeleteUserSessionOperation         OperationName = "DeleteUserSession"
-	DeleteYandexOperation              OperationName = "DeleteYandex"
-	DiscordLoginOperation              OperationName = "DiscordLogin"
-	DiscordLoginCallbackOperation      OperationName = "DiscordLoginCallback"
-	EndSingleplayerGameOperation       OperationName = "EndSingleplayerGame"
-	EndSingleplayerRoundOperation      OperationName = "EndSingleplayerRound"
-	GetHealthOperation                 OperationName = "GetHealth"
-	GetLobbiesOperation                OperationName = "GetLobbies"
-	GetLobbyOperation                  OperationName = "GetLobby"
-	GetMultiplayerGameOperation        OperationName = "GetMultiplayerGame"
-	GetMultiplayerGameGuessesOperation OperationName = "GetMultiplayerGameGuesses"
-	GetMultiplayerRoundOperation       OperationName = "GetMultiplayerRound"
-	GetOAuthProvidersOperation         OperationName = "GetOAuthProviders"
-	GetPrivateProfileOperation         OperationName = "GetPrivateProfile"
-	GetPublicProfileOperation          OperationName = "GetPublicProfile"
-	GetRootOperation                   OperationName = "GetRoot"
-	GetSingleplayerGameOperation       OperationName = "GetSingleplayerGame"
-	GetSingleplayerGameRoundsOperation OperationName = "GetSingleplayerGameRounds"
-	GetSingleplayerGamesOperation      OperationName = "GetSingleplayerGames"
-	GetSingleplayerRoundOperation      OperationName = "GetSingleplayerRound"
-	GetUserSessionsOperation           OperationName = "GetUserSessions"
-	LoginOperation                     OperationName = "Login"
-	NewDiscordOperation                OperationName = "NewDiscord"
-	NewDiscordCallbackOperation        OperationName = "NewDiscordCallback"
-	NewLobbyOperation                  OperationName = "NewLobby"
-	NewMultiplayerRoundOperation       OperationName = "NewMultiplayerRound"
-	NewSingleplayerGameOperation       OperationName = "NewSingleplayerGame"
-	NewSingleplayerRoundOperation      OperationName = "NewSingleplayerRound"
-	NewYandexOperation                 OperationName = "NewYandex"
-	NewYandexCallbackOperation         OperationName = "NewYandexCallback"
-	RefreshTokensOperation             OperationName = "RefreshTokens"
-	RegisterOperation                  OperationName = "Register"
-	UpdateUserOperation                OperationName = "UpdateUser"
-	UpdateUserAvatarOperation          OperationName = "UpdateUserAvatar"
-	YandexLoginOperation               OperationName = "YandexLogin"
-	YandexLoginCallbackOperation       OperationName = "YandexLoginCallback"
+	AcceptSingleplayerChallengeOperation  OperationName = "AcceptSingleplayerChallenge"
+	AddMapLocationsOperation              OperationName = "AddMapLocations"
+	DeleteDiscordOperation                OperationName = "DeleteDiscord"
+	DeleteUserSessionOperation            OperationName = "DeleteUserSession"
+	DeleteYandexOperation                 OperationName = "DeleteYandex"
+	DiscordLoginOperation                 OperationName = "DiscordLogin"
+	DiscordLoginCallbackOperation         OperationName = "DiscordLoginCallback"
+	EndSingleplayerGameOperation          OperationName = "EndSingleplayerGame"
+	EndSingleplayerRoundOperation         OperationName = "EndSingleplayerRound"
+	EndStreakRoundOperation               OperationName = "EndStreakRound"
+	GetBestStreakOperation                OperationName = "GetBestStreak"
+	GetDailyChallengeLeaderboardOperation OperationName = "GetDailyChallengeLeaderboard"
+	GetHealthOperation                    OperationName = "GetHealth"
+	GetLobbiesOperation                   OperationName = "GetLobbies"
+	GetLobbyOperation                     OperationName = "GetLobby"
+	GetMapOperation                       OperationName = "GetMap"
+	GetMapsOperation                      OperationName = "GetMaps"
+	GetMultiplayerGameOperation           OperationName = "GetMultiplayerGame"
+	GetMultiplayerGameGuessesOperation    OperationName = "GetMultiplayerGameGuesses"
+	GetMultiplayerRoundOperation          OperationName = "GetMultiplayerRound"
+	GetOAuthProvidersOperation            OperationName = "GetOAuthProviders"
+	GetPanoramaOperation                  OperationName = "GetPanorama"
+	GetPanoramaProvidersOperation         OperationName = "GetPanoramaProviders"
+	GetPrivateProfileOperation            OperationName = "GetPrivateProfile"
+	GetPublicProfileOperation             OperationName = "GetPublicProfile"
+	GetRootOperation                      OperationName = "GetRoot"
+	GetSingleplayerChallengeOperation     OperationName = "GetSingleplayerChallenge"
+	GetSingleplayerGameOperation          OperationName = "GetSingleplayerGame"
+	GetSingleplayerGameRoundsOperation    OperationName = "GetSingleplayerGameRounds"
+	GetSingleplayerGamesOperation         OperationName = "GetSingleplayerGames"
+	GetSingleplayerRoundOperation         OperationName = "GetSingleplayerRound"
+	GetStreakGameOperation                OperationName = "GetStreakGame"
+	GetStreakLeaderboardOperation         OperationName = "GetStreakLeaderboard"
+	GetStreakRoundOperation               OperationName = "GetStreakRound"
+	GetUserSessionsOperation              OperationName = "GetUserSessions"
+	ImportMapLocationsOperation           OperationName = "ImportMapLocations"
+	LoginOperation                        OperationName = "Login"
+	NewDiscordOperation                   OperationName = "NewDiscord"
+	NewDiscordCallbackOperation           OperationName = "NewDiscordCallback"
+	NewLobbyOperation                     OperationName = "NewLobby"
+	NewMapOperation                       OperationName = "NewMap"
+	NewMultiplayerRoundOperation          OperationName = "NewMultiplayerRound"
+	NewSingleplayerChallengeOperation     OperationName = "NewSingleplayerChallenge"
+	NewSingleplayerGameOperation          OperationName = "NewSingleplayerGame"
+	NewSingleplayerRoundOperation         OperationName = "NewSingleplayerRound"
+	NewStreakGameOperation                OperationName = "NewStreakGame"
+	NewStreakRoundOperation               OperationName = "NewStreakRound"
+	NewYandexOperation                    OperationName = "NewYandex"
+	NewYandexCallbackOperation            OperationName = "NewYandexCallback"
+	RefreshTokensOperation                OperationName = "RefreshTokens"
+	RegisterOperation                     OperationName = "Register"
+	UpdateMapOperation                    OperationName = "UpdateMap"
+	UpdateUserOperation                   OperationName = "UpdateUser"
+	UpdateUserAvatarOperation             OperationName = "UpdateUserAvatar"
+	YandexLoginOperation                  OperationName = "YandexLogin"
+	YandexLoginCallbackOperation          OperationName = "YandexLoginCallback"
 )
@@ -5,6 +5,7 @@ package api
 import (
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/go-faster/errors"
 
@@ -15,6 +16,138 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
+// AcceptSingleplayerChallengeParams is parameters of acceptSingleplayerChallenge operation.
+type AcceptSingleplayerChallengeParams struct {
+	// String ID of the resource in path.
+	ID string
+}
+
+func unpackAcceptSingleplayerChallengeParams(packed middleware.Parameters) (params AcceptSingleplayerChallengeParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeAcceptSingleplayerChallengeParams(args [1]string, argsEscaped bool, r *http.Request) (params AcceptSingleplayerChallengeParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// AddMapLocationsParams is parameters of addMapLocations operation.
+type AddMapLocationsParams struct {
+	// Numeric ID of the resource in path.
+	ID int
+}
+
+func unpackAddMapLocationsParams(packed middleware.Parameters) (params AddMapLocationsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeAddMapLocationsParams(args [1]string, argsEscaped bool, r *http.Request) (params AddMapLocationsParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // DeleteUserSessionParams is parameters of deleteUserSession operation.
 type DeleteUserSessionParams struct {
 	// String ID of the resource in path.
@@ -360,15 +493,101 @@ func decodeEndSingleplayerRoundParams(args [1]string, argsEscaped bool, r *http.
 	return params, nil
 }
 
-// GetLobbiesParams is parameters of getLobbies operation.
-type GetLobbiesParams struct {
+// EndStreakRoundParams is parameters of endStreakRound operation.
+type EndStreakRoundParams struct {
+	// Numeric ID of the resource in path.
+	ID int
+}
+
+func unpackEndStreakRoundParams(packed middleware.Parameters) (params EndStreakRoundParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeEndStreakRoundParams(args [1]string, argsEscaped bool, r *http.Request) (params EndStreakRoundParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetDailyChallengeLeaderboardParams is parameters of getDailyChallengeLeaderboard operation.
+type GetDailyChallengeLeaderboardParams struct {
+	// Panorama provider of the daily challenge.
+	Provider Provider
+	// UTC date of the daily challenge, today if not set.
+	Date OptDate
 	// Page number in the query.
 	Page int
 	// Page size in the query.
 	PageSize int
 }
 
-func unpackGetLobbiesParams(packed middleware.Parameters) (params GetLobbiesParams) {
+func unpackGetDailyChallengeLeaderboardParams(packed middleware.Parameters) (params GetDailyChallengeLeaderboardParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "provider",
+			In:   "query",
+		}
+		params.Provider = packed[key].(Provider)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "date",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Date = v.(OptDate)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "page",
@@ -386,45 +605,43 @@ func unpackGetLobbiesParams(packed middleware.Parameters) (params GetLobbiesPara
 	return params
 }
 
-func decodeGetLobbiesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetLobbiesParams, _ error) {
+func decodeGetDailyChallengeLeaderboardParams(args [0]string, argsEscaped bool, r *http.Request) (params GetDailyChallengeLeaderboardParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: page.
+	// Decode query: provider.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "page",
+			Name:    "provider",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotProviderVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToInt(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotProviderVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Page = c
+				params.Provider = Provider(paramsDotProviderVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if err := (validate.Int{
-					MinSet:        true,
-					Min:           1,
-					MaxSet:        false,
-					Max:           0,
-					MinExclusive:  false,
-					MaxExclusive:  false,
-					MultipleOfSet: false,
-					MultipleOf:    0,
-				}).Validate(int64(params.Page)); err != nil {
-					return errors.Wrap(err, "int")
+				if err := params.Provider.Validate(); err != nil {
+					return err
 				}
 				return nil
 			}(); err != nil {
@@ -436,86 +653,1384 @@ func decodeGetLobbiesParams(args [0]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "page",
+			Name: "provider",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: page-size.
+	// Decode query: date.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "page-size",
+			Name:    "date",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotDateVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToInt(val)
-				if err != nil {
+					c, err := conv.ToDate(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDateVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
+				params.Date.SetTo(paramsDotDateVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "date",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.Page = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           1,
+					MaxSet:        false,
+					Max:           0,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+				}).Validate(int64(params.Page)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: page-size.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page-size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.PageSize = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           1,
+					MaxSet:        true,
+					Max:           50,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+				}).Validate(int64(params.PageSize)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page-size",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetLobbiesParams is parameters of getLobbies operation.
+type GetLobbiesParams struct {
+	// Panorama provider of lobbies.
+	Provider OptGameProvider
+	// Minimum amount of rounds.
+	RoundsMin OptInt
+	// Maximum amount of rounds.
+	RoundsMax OptInt
+	// Round timer of lobbies, 0 for lobbies with rounds without timer.
+	TimerSeconds OptInt
+	// Whether movement is allowed in lobbies.
+	MovementAllowed OptBool
+	// Return only lobbies that are not full.
+	FreeSlots OptBool
+	// Beginning of the username of the lobby creator (case-insensitive).
+	Creator OptString
+	// Order of lobbies, newest lobbies are returned first if not set.
+	Sort OptLobbySort
+	// Page number in the query.
+	Page int
+	// Page size in the query.
+	PageSize int
+}
+
+func unpackGetLobbiesParams(packed middleware.Parameters) (params GetLobbiesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "provider",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Provider = v.(OptGameProvider)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "rounds-min",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.RoundsMin = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "rounds-max",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.RoundsMax = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "timer-seconds",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.TimerSeconds = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "movement-allowed",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MovementAllowed = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "free-slots",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.FreeSlots = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "creator",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Creator = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptLobbySort)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		params.Page = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page-size",
+			In:   "query",
+		}
+		params.PageSize = packed[key].(int)
+	}
+	return params
+}
+
+func decodeGetLobbiesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetLobbiesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: provider.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "provider",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotProviderVal GameProvider
+				if err := func() error {
+					var paramsDotProviderValVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotProviderValVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					paramsDotProviderVal = GameProvider(paramsDotProviderValVal)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Provider.SetTo(paramsDotProviderVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Provider.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "provider",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: rounds-min.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "rounds-min",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotRoundsMinVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotRoundsMinVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.RoundsMin.SetTo(paramsDotRoundsMinVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.RoundsMin.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           10,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "rounds-min",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: rounds-max.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "rounds-max",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotRoundsMaxVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotRoundsMaxVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.RoundsMax.SetTo(paramsDotRoundsMaxVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.RoundsMax.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           10,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "rounds-max",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: timer-seconds.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "timer-seconds",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTimerSecondsVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTimerSecondsVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.TimerSeconds.SetTo(paramsDotTimerSecondsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.TimerSeconds.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        true,
+							Max:           600,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "timer-seconds",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: movement-allowed.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "movement-allowed",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMovementAllowedVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMovementAllowedVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.MovementAllowed.SetTo(paramsDotMovementAllowedVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "movement-allowed",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: free-slots.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "free-slots",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFreeSlotsVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFreeSlotsVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.FreeSlots.SetTo(paramsDotFreeSlotsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "free-slots",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: creator.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "creator",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCreatorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCreatorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Creator.SetTo(paramsDotCreatorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Creator.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:    1,
+							MinLengthSet: true,
+							MaxLength:    20,
+							MaxLengthSet: true,
+							Email:        false,
+							Hostname:     false,
+							Regex:        nil,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "creator",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal LobbySort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortVal = LobbySort(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sort.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.Page = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           1,
+					MaxSet:        false,
+					Max:           0,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+				}).Validate(int64(params.Page)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: page-size.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page-size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.PageSize = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           1,
+					MaxSet:        true,
+					Max:           50,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+				}).Validate(int64(params.PageSize)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page-size",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetLobbyParams is parameters of getLobby operation.
+type GetLobbyParams struct {
+	// String ID of the resource in path.
+	ID string
+}
+
+func unpackGetLobbyParams(packed middleware.Parameters) (params GetLobbyParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetLobbyParams(args [1]string, argsEscaped bool, r *http.Request) (params GetLobbyParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetMapParams is parameters of getMap operation.
+type GetMapParams struct {
+	// Numeric ID of the resource in path.
+	ID int
+}
+
+func unpackGetMapParams(packed middleware.Parameters) (params GetMapParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeGetMapParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMapParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetMapsParams is parameters of getMaps operation.
+type GetMapsParams struct {
+	// Return only maps created by the authenticated user, including unlisted and private ones.
+	Mine OptBool
+	// Page number in the query.
+	Page int
+	// Page size in the query.
+	PageSize int
+}
+
+func unpackGetMapsParams(packed middleware.Parameters) (params GetMapsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "mine",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Mine = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		params.Page = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page-size",
+			In:   "query",
+		}
+		params.PageSize = packed[key].(int)
+	}
+	return params
+}
+
+func decodeGetMapsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetMapsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: mine.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "mine",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMineVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMineVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Mine.SetTo(paramsDotMineVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "mine",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.Page = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           1,
+					MaxSet:        false,
+					Max:           0,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+				}).Validate(int64(params.Page)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: page-size.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page-size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.PageSize = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           1,
+					MaxSet:        true,
+					Max:           50,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+				}).Validate(int64(params.PageSize)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page-size",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetMultiplayerGameParams is parameters of getMultiplayerGame operation.
+type GetMultiplayerGameParams struct {
+	// Numeric ID of the resource in path.
+	ID int
+}
+
+func unpackGetMultiplayerGameParams(packed middleware.Parameters) (params GetMultiplayerGameParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeGetMultiplayerGameParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMultiplayerGameParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetMultiplayerGameGuessesParams is parameters of getMultiplayerGameGuesses operation.
+type GetMultiplayerGameGuessesParams struct {
+	// Numeric ID of the resource in path.
+	ID int
+}
+
+func unpackGetMultiplayerGameGuessesParams(packed middleware.Parameters) (params GetMultiplayerGameGuessesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeGetMultiplayerGameGuessesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMultiplayerGameGuessesParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetMultiplayerRoundParams is parameters of getMultiplayerRound operation.
+type GetMultiplayerRoundParams struct {
+	// Numeric ID of the resource in path.
+	ID int
+}
+
+func unpackGetMultiplayerRoundParams(packed middleware.Parameters) (params GetMultiplayerRoundParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
 
-				params.PageSize = c
-				return nil
-			}); err != nil {
-				return err
+func decodeGetMultiplayerRoundParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMultiplayerRoundParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
 			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				if err := (validate.Int{
-					MinSet:        true,
-					Min:           1,
-					MaxSet:        true,
-					Max:           50,
-					MinExclusive:  false,
-					MaxExclusive:  false,
-					MultipleOfSet: false,
-					MultipleOf:    0,
-				}).Validate(int64(params.PageSize)); err != nil {
-					return errors.Wrap(err, "int")
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "page-size",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetLobbyParams is parameters of getLobby operation.
-type GetLobbyParams struct {
-	// String ID of the resource in path.
-	ID string
+// GetPanoramaParams is parameters of getPanorama operation.
+type GetPanoramaParams struct {
+	// Opaque panorama token from the round response.
+	Token string
 }
 
-func unpackGetLobbyParams(packed middleware.Parameters) (params GetLobbyParams) {
+func unpackGetPanoramaParams(packed middleware.Parameters) (params GetPanoramaParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "id",
+			Name: "token",
 			In:   "path",
 		}
-		params.ID = packed[key].(string)
+		params.Token = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetLobbyParams(args [1]string, argsEscaped bool, r *http.Request) (params GetLobbyParams, _ error) {
-	// Decode path: id.
+func decodeGetPanoramaParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPanoramaParams, _ error) {
+	// Decode path: token.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -527,7 +2042,7 @@ func decodeGetLobbyParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "token",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -544,7 +2059,7 @@ func decodeGetLobbyParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 					return err
 				}
 
-				params.ID = c
+				params.Token = c
 				return nil
 			}(); err != nil {
 				return err
@@ -555,7 +2070,7 @@ func decodeGetLobbyParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "token",
 			In:   "path",
 			Err:  err,
 		}
@@ -563,13 +2078,13 @@ func decodeGetLobbyParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 	return params, nil
 }
 
-// GetMultiplayerGameParams is parameters of getMultiplayerGame operation.
-type GetMultiplayerGameParams struct {
+// GetPublicProfileParams is parameters of getPublicProfile operation.
+type GetPublicProfileParams struct {
 	// Numeric ID of the resource in path.
 	ID int
 }
 
-func unpackGetMultiplayerGameParams(packed middleware.Parameters) (params GetMultiplayerGameParams) {
+func unpackGetPublicProfileParams(packed middleware.Parameters) (params GetPublicProfileParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -580,7 +2095,7 @@ func unpackGetMultiplayerGameParams(packed middleware.Parameters) (params GetMul
 	return params
 }
 
-func decodeGetMultiplayerGameParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMultiplayerGameParams, _ error) {
+func decodeGetPublicProfileParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPublicProfileParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -629,24 +2144,24 @@ func decodeGetMultiplayerGameParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// GetMultiplayerGameGuessesParams is parameters of getMultiplayerGameGuesses operation.
-type GetMultiplayerGameGuessesParams struct {
-	// Numeric ID of the resource in path.
-	ID int
+// GetSingleplayerChallengeParams is parameters of getSingleplayerChallenge operation.
+type GetSingleplayerChallengeParams struct {
+	// String ID of the resource in path.
+	ID string
 }
 
-func unpackGetMultiplayerGameGuessesParams(packed middleware.Parameters) (params GetMultiplayerGameGuessesParams) {
+func unpackGetSingleplayerChallengeParams(packed middleware.Parameters) (params GetSingleplayerChallengeParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(int)
+		params.ID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetMultiplayerGameGuessesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMultiplayerGameGuessesParams, _ error) {
+func decodeGetSingleplayerChallengeParams(args [1]string, argsEscaped bool, r *http.Request) (params GetSingleplayerChallengeParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -671,7 +2186,7 @@ func decodeGetMultiplayerGameGuessesParams(args [1]string, argsEscaped bool, r *
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
@@ -695,13 +2210,13 @@ func decodeGetMultiplayerGameGuessesParams(args [1]string, argsEscaped bool, r *
 	return params, nil
 }
 
-// GetMultiplayerRoundParams is parameters of getMultiplayerRound operation.
-type GetMultiplayerRoundParams struct {
+// GetSingleplayerGameParams is parameters of getSingleplayerGame operation.
+type GetSingleplayerGameParams struct {
 	// Numeric ID of the resource in path.
 	ID int
 }
 
-func unpackGetMultiplayerRoundParams(packed middleware.Parameters) (params GetMultiplayerRoundParams) {
+func unpackGetSingleplayerGameParams(packed middleware.Parameters) (params GetSingleplayerGameParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -712,7 +2227,7 @@ func unpackGetMultiplayerRoundParams(packed middleware.Parameters) (params GetMu
 	return params
 }
 
-func decodeGetMultiplayerRoundParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMultiplayerRoundParams, _ error) {
+func decodeGetSingleplayerGameParams(args [1]string, argsEscaped bool, r *http.Request) (params GetSingleplayerGameParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -761,13 +2276,13 @@ func decodeGetMultiplayerRoundParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// GetPublicProfileParams is parameters of getPublicProfile operation.
-type GetPublicProfileParams struct {
+// GetSingleplayerGameRoundsParams is parameters of getSingleplayerGameRounds operation.
+type GetSingleplayerGameRoundsParams struct {
 	// Numeric ID of the resource in path.
 	ID int
 }
 
-func unpackGetPublicProfileParams(packed middleware.Parameters) (params GetPublicProfileParams) {
+func unpackGetSingleplayerGameRoundsParams(packed middleware.Parameters) (params GetSingleplayerGameRoundsParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -778,7 +2293,7 @@ func unpackGetPublicProfileParams(packed middleware.Parameters) (params GetPubli
 	return params
 }
 
-func decodeGetPublicProfileParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPublicProfileParams, _ error) {
+func decodeGetSingleplayerGameRoundsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetSingleplayerGameRoundsParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -827,13 +2342,150 @@ func decodeGetPublicProfileParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// GetSingleplayerGameParams is parameters of getSingleplayerGame operation.
-type GetSingleplayerGameParams struct {
+// GetSingleplayerGamesParams is parameters of getSingleplayerGames operation.
+type GetSingleplayerGamesParams struct {
+	// Page number in the query.
+	Page int
+	// Page size in the query.
+	PageSize int
+}
+
+func unpackGetSingleplayerGamesParams(packed middleware.Parameters) (params GetSingleplayerGamesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		params.Page = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page-size",
+			In:   "query",
+		}
+		params.PageSize = packed[key].(int)
+	}
+	return params
+}
+
+func decodeGetSingleplayerGamesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetSingleplayerGamesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.Page = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           1,
+					MaxSet:        false,
+					Max:           0,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+				}).Validate(int64(params.Page)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: page-size.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page-size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.PageSize = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           1,
+					MaxSet:        true,
+					Max:           50,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+				}).Validate(int64(params.PageSize)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page-size",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetSingleplayerRoundParams is parameters of getSingleplayerRound operation.
+type GetSingleplayerRoundParams struct {
 	// Numeric ID of the resource in path.
 	ID int
 }
 
-func unpackGetSingleplayerGameParams(packed middleware.Parameters) (params GetSingleplayerGameParams) {
+func unpackGetSingleplayerRoundParams(packed middleware.Parameters) (params GetSingleplayerRoundParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -844,7 +2496,7 @@ func unpackGetSingleplayerGameParams(packed middleware.Parameters) (params GetSi
 	return params
 }
 
-func decodeGetSingleplayerGameParams(args [1]string, argsEscaped bool, r *http.Request) (params GetSingleplayerGameParams, _ error) {
+func decodeGetSingleplayerRoundParams(args [1]string, argsEscaped bool, r *http.Request) (params GetSingleplayerRoundParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -893,13 +2545,13 @@ func decodeGetSingleplayerGameParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// GetSingleplayerGameRoundsParams is parameters of getSingleplayerGameRounds operation.
-type GetSingleplayerGameRoundsParams struct {
+// GetStreakGameParams is parameters of getStreakGame operation.
+type GetStreakGameParams struct {
 	// Numeric ID of the resource in path.
 	ID int
 }
 
-func unpackGetSingleplayerGameRoundsParams(packed middleware.Parameters) (params GetSingleplayerGameRoundsParams) {
+func unpackGetStreakGameParams(packed middleware.Parameters) (params GetStreakGameParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -910,7 +2562,7 @@ func unpackGetSingleplayerGameRoundsParams(packed middleware.Parameters) (params
 	return params
 }
 
-func decodeGetSingleplayerGameRoundsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetSingleplayerGameRoundsParams, _ error) {
+func decodeGetStreakGameParams(args [1]string, argsEscaped bool, r *http.Request) (params GetStreakGameParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -959,15 +2611,15 @@ func decodeGetSingleplayerGameRoundsParams(args [1]string, argsEscaped bool, r *
 	return params, nil
 }
 
-// GetSingleplayerGamesParams is parameters of getSingleplayerGames operation.
-type GetSingleplayerGamesParams struct {
+// GetStreakLeaderboardParams is parameters of getStreakLeaderboard operation.
+type GetStreakLeaderboardParams struct {
 	// Page number in the query.
 	Page int
 	// Page size in the query.
 	PageSize int
 }
 
-func unpackGetSingleplayerGamesParams(packed middleware.Parameters) (params GetSingleplayerGamesParams) {
+func unpackGetStreakLeaderboardParams(packed middleware.Parameters) (params GetStreakLeaderboardParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "page",
@@ -985,7 +2637,7 @@ func unpackGetSingleplayerGamesParams(packed middleware.Parameters) (params GetS
 	return params
 }
 
-func decodeGetSingleplayerGamesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetSingleplayerGamesParams, _ error) {
+func decodeGetStreakLeaderboardParams(args [0]string, argsEscaped bool, r *http.Request) (params GetStreakLeaderboardParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode query: page.
 	if err := func() error {
@@ -1096,13 +2748,13 @@ func decodeGetSingleplayerGamesParams(args [0]string, argsEscaped bool, r *http.
 	return params, nil
 }
 
-// GetSingleplayerRoundParams is parameters of getSingleplayerRound operation.
-type GetSingleplayerRoundParams struct {
+// GetStreakRoundParams is parameters of getStreakRound operation.
+type GetStreakRoundParams struct {
 	// Numeric ID of the resource in path.
 	ID int
 }
 
-func unpackGetSingleplayerRoundParams(packed middleware.Parameters) (params GetSingleplayerRoundParams) {
+func unpackGetStreakRoundParams(packed middleware.Parameters) (params GetStreakRoundParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -1113,7 +2765,73 @@ func unpackGetSingleplayerRoundParams(packed middleware.Parameters) (params GetS
 	return params
 }
 
-func decodeGetSingleplayerRoundParams(args [1]string, argsEscaped bool, r *http.Request) (params GetSingleplayerRoundParams, _ error) {
+func decodeGetStreakRoundParams(args [1]string, argsEscaped bool, r *http.Request) (params GetStreakRoundParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ImportMapLocationsParams is parameters of importMapLocations operation.
+type ImportMapLocationsParams struct {
+	// Numeric ID of the resource in path.
+	ID int
+}
+
+func unpackImportMapLocationsParams(packed middleware.Parameters) (params ImportMapLocationsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeImportMapLocationsParams(args [1]string, argsEscaped bool, r *http.Request) (params ImportMapLocationsParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1481,6 +3199,72 @@ func decodeNewMultiplayerRoundParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
+// NewSingleplayerChallengeParams is parameters of newSingleplayerChallenge operation.
+type NewSingleplayerChallengeParams struct {
+	// Numeric ID of the resource in path.
+	ID int
+}
+
+func unpackNewSingleplayerChallengeParams(packed middleware.Parameters) (params NewSingleplayerChallengeParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeNewSingleplayerChallengeParams(args [1]string, argsEscaped bool, r *http.Request) (params NewSingleplayerChallengeParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // NewSingleplayerRoundParams is parameters of newSingleplayerRound operation.
 type NewSingleplayerRoundParams struct {
 	// Numeric ID of the resource in path.
@@ -1547,6 +3331,72 @@ func decodeNewSingleplayerRoundParams(args [1]string, argsEscaped bool, r *http.
 	return params, nil
 }
 
+// NewStreakRoundParams is parameters of newStreakRound operation.
+type NewStreakRoundParams struct {
+	// Numeric ID of the resource in path.
+	ID int
+}
+
+func unpackNewStreakRoundParams(packed middleware.Parameters) (params NewStreakRoundParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeNewStreakRoundParams(args [1]string, argsEscaped bool, r *http.Request) (params NewStreakRoundParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // NewYandexCallbackParams is parameters of newYandexCallback operation.
 type NewYandexCallbackParams struct {
 	// OAuth state cookie (oauthState).
@@ -1757,6 +3607,72 @@ func decodeRegisterParams(args [0]string, argsEscaped bool, r *http.Request) (pa
 	return params, nil
 }
 
+// UpdateMapParams is parameters of updateMap operation.
+type UpdateMapParams struct {
+	// Numeric ID of the resource in path.
+	ID int
+}
+
+func unpackUpdateMapParams(packed middleware.Parameters) (params UpdateMapParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeUpdateMapParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateMapParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // YandexLoginCallbackParams is parameters of yandexLoginCallback operation.
 type YandexLoginCallbackParams struct {
 	// OAuth state cookie (oauthState).
@@ -15,6 +15,20 @@ import (
 	"github.com/ogen-go/ogen/uri"
 )
 
+func encodeAddMapLocationsRequest(
+	req *AddMapLocationsRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeEndSingleplayerRoundRequest(
 	req *SingleplayerRoundGuess,
 	r *http.Request,
@@ -29,6 +43,34 @@ func encodeEndSingleplayerRoundRequest(
 	return nil
 }
 
+func encodeEndStreakRoundRequest(
+	req *StreakRoundGuess,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeImportMapLocationsRequest(
+	req *ImportMapLocationsRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeLoginRequest(
 	req *LoginRequest,
 	r *http.Request,
@@ -57,6 +99,20 @@ func encodeNewLobbyRequest(
 	return nil
 }
 
+func encodeNewMapRequest(
+	req *NewMapRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeNewSingleplayerGameRequest(
 	req *NewSingleplayerGameRequest,
 	r *http.Request,
@@ -71,6 +127,20 @@ func encodeNewSingleplayerGameRequest(
 	return nil
 }
 
+func encodeNewStreakGameRequest(
+	req *NewStreakGameRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeRefreshTokensRequest(
 	req *RefreshTokensReq,
 	r *http.Request,
@@ -99,6 +169,20 @@ func encodeRegisterRequest(
 	return nil
 }
 
+func encodeUpdateMapRequest(
+	req *UpdateMapRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateUserRequest(
 	req *UserUpdateRequest,
 	r *http.Request,
@@ -14,6 +14,176 @@ import (
 	"github.com/ogen-go/ogen/uri"
 )
 
+func encodeAcceptSingleplayerChallengeResponse(response AcceptSingleplayerChallengeRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *AcceptSingleplayerChallengeCreated:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(201)
+		span.SetStatus(codes.Ok, http.StatusText(201))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *AcceptSingleplayerChallengeBadRequest:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *AcceptSingleplayerChallengeUnauthorized:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *AcceptSingleplayerChallengeNotFound:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *AcceptSingleplayerChallengeConflict:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(409)
+		span.SetStatus(codes.Error, http.StatusText(409))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *AcceptSingleplayerChallengeInternalServerError:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeAddMapLocationsResponse(response AddMapLocationsRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *MapLocationsAdded:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *AddMapLocationsBadRequest:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *AddMapLocationsUnauthorized:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *AddMapLocationsForbidden:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *AddMapLocationsNotFound:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *AddMapLocationsInternalServerError:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
 func encodeDeleteDiscordResponse(response DeleteDiscordRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
 	case *DeleteDiscordNoContent:
@@ -396,23 +566,9 @@ func encodeEndSingleplayerRoundResponse(response EndSingleplayerRoundRes, w http
 	}
 }
 
-func encodeGetHealthResponse(response *GetHealthOK, w http.ResponseWriter, span trace.Span) error {
-	w.Header().Set("Content-Type", "application/vnd.health+json")
-	w.WriteHeader(200)
-	span.SetStatus(codes.Ok, http.StatusText(200))
-
-	e := new(jx.Encoder)
-	response.Encode(e)
-	if _, err := e.WriteTo(w); err != nil {
-		return errors.Wrap(err, "write")
-	}
-
-	return nil
-}
-
-func encodeGetLobbiesResponse(response GetLobbiesRes, w http.ResponseWriter, span trace.Span) error {
+func encodeEndStreakRoundResponse(response EndStreakRoundRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *LobbiesResponse:
+	case *EndStreakRoundResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -425,10 +581,10 @@ func encodeGetLobbiesResponse(response GetLobbiesRes, w http.ResponseWriter, spa
 
 		return nil
 
-	case *Error:
+	case *EndStreakRoundBadRequest:
 		w.Header().Set("Content-Type", "application/problem+json")
-		w.WriteHeader(500)
-		span.SetStatus(codes.Error, http.StatusText(500))
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -438,17 +594,23 @@ func encodeGetLobbiesResponse(response GetLobbiesRes, w http.ResponseWriter, spa
 
 		return nil
 
-	default:
-		return errors.Errorf("unexpected response type: %T", response)
-	}
-}
+	case *EndStreakRoundUnauthorized:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
 
-func encodeGetLobbyResponse(response GetLobbyRes, w http.ResponseWriter, span trace.Span) error {
-	switch response := response.(type) {
-	case *Lobby:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *EndStreakRoundForbidden:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -458,7 +620,7 @@ func encodeGetLobbyResponse(response GetLobbyRes, w http.ResponseWriter, span tr
 
 		return nil
 
-	case *GetLobbyNotFound:
+	case *EndStreakRoundNotFound:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -471,7 +633,7 @@ func encodeGetLobbyResponse(response GetLobbyRes, w http.ResponseWriter, span tr
 
 		return nil
 
-	case *GetLobbyInternalServerError:
+	case *EndStreakRoundInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(500)
 		span.SetStatus(codes.Error, http.StatusText(500))
@@ -489,9 +651,9 @@ func encodeGetLobbyResponse(response GetLobbyRes, w http.ResponseWriter, span tr
 	}
 }
 
-func encodeGetMultiplayerGameResponse(response GetMultiplayerGameRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBestStreakResponse(response GetBestStreakRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *MultiplayerGame:
+	case *BestStreak:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -504,7 +666,7 @@ func encodeGetMultiplayerGameResponse(response GetMultiplayerGameRes, w http.Res
 
 		return nil
 
-	case *GetMultiplayerGameUnauthorized:
+	case *GetBestStreakUnauthorized:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(401)
 		span.SetStatus(codes.Error, http.StatusText(401))
@@ -517,20 +679,7 @@ func encodeGetMultiplayerGameResponse(response GetMultiplayerGameRes, w http.Res
 
 		return nil
 
-	case *GetMultiplayerGameNotFound:
-		w.Header().Set("Content-Type", "application/problem+json")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
-
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
-			return errors.Wrap(err, "write")
-		}
-
-		return nil
-
-	case *GetMultiplayerGameInternalServerError:
+	case *GetBestStreakInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(500)
 		span.SetStatus(codes.Error, http.StatusText(500))
@@ -548,9 +697,9 @@ func encodeGetMultiplayerGameResponse(response GetMultiplayerGameRes, w http.Res
 	}
 }
 
-func encodeGetMultiplayerGameGuessesResponse(response GetMultiplayerGameGuessesRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetDailyChallengeLeaderboardResponse(response GetDailyChallengeLeaderboardRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetMultiplayerGameGuessesOKApplicationJSON:
+	case *DailyChallengeLeaderboard:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -563,7 +712,7 @@ func encodeGetMultiplayerGameGuessesResponse(response GetMultiplayerGameGuessesR
 
 		return nil
 
-	case *GetMultiplayerGameGuessesBadRequest:
+	case *GetDailyChallengeLeaderboardBadRequest:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(400)
 		span.SetStatus(codes.Error, http.StatusText(400))
@@ -576,7 +725,7 @@ func encodeGetMultiplayerGameGuessesResponse(response GetMultiplayerGameGuessesR
 
 		return nil
 
-	case *GetMultiplayerGameGuessesInternalServerError:
+	case *GetDailyChallengeLeaderboardInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(500)
 		span.SetStatus(codes.Error, http.StatusText(500))
@@ -594,9 +743,23 @@ func encodeGetMultiplayerGameGuessesResponse(response GetMultiplayerGameGuessesR
 	}
 }
 
-func encodeGetMultiplayerRoundResponse(response GetMultiplayerRoundRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetHealthResponse(response *GetHealthOK, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/vnd.health+json")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetLobbiesResponse(response GetLobbiesRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *MultiplayerRound:
+	case *LobbiesResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -609,10 +772,10 @@ func encodeGetMultiplayerRoundResponse(response GetMultiplayerRoundRes, w http.R
 
 		return nil
 
-	case *GetMultiplayerRoundUnauthorized:
+	case *Error:
 		w.Header().Set("Content-Type", "application/problem+json")
-		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -622,10 +785,17 @@ func encodeGetMultiplayerRoundResponse(response GetMultiplayerRoundRes, w http.R
 
 		return nil
 
-	case *GetMultiplayerRoundNotFound:
-		w.Header().Set("Content-Type", "application/problem+json")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetLobbyResponse(response GetLobbyRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *Lobby:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -635,7 +805,20 @@ func encodeGetMultiplayerRoundResponse(response GetMultiplayerRoundRes, w http.R
 
 		return nil
 
-	case *GetMultiplayerRoundInternalServerError:
+	case *GetLobbyNotFound:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetLobbyInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(500)
 		span.SetStatus(codes.Error, http.StatusText(500))
@@ -653,9 +836,9 @@ func encodeGetMultiplayerRoundResponse(response GetMultiplayerRoundRes, w http.R
 	}
 }
 
-func encodeGetOAuthProvidersResponse(response GetOAuthProvidersRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetMapResponse(response GetMapRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetOAuthProvidersOKApplicationJSON:
+	case *Map:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -668,7 +851,7 @@ func encodeGetOAuthProvidersResponse(response GetOAuthProvidersRes, w http.Respo
 
 		return nil
 
-	case *GetOAuthProvidersUnauthorized:
+	case *GetMapUnauthorized:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(401)
 		span.SetStatus(codes.Error, http.StatusText(401))
@@ -681,7 +864,20 @@ func encodeGetOAuthProvidersResponse(response GetOAuthProvidersRes, w http.Respo
 
 		return nil
 
-	case *GetOAuthProvidersInternalServerError:
+	case *GetMapNotFound:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetMapInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(500)
 		span.SetStatus(codes.Error, http.StatusText(500))
@@ -699,9 +895,9 @@ func encodeGetOAuthProvidersResponse(response GetOAuthProvidersRes, w http.Respo
 	}
 }
 
-func encodeGetPrivateProfileResponse(response GetPrivateProfileRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetMapsResponse(response GetMapsRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *UserPrivateProfile:
+	case *Maps:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -714,7 +910,7 @@ func encodeGetPrivateProfileResponse(response GetPrivateProfileRes, w http.Respo
 
 		return nil
 
-	case *GetPrivateProfileUnauthorized:
+	case *GetMapsUnauthorized:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(401)
 		span.SetStatus(codes.Error, http.StatusText(401))
@@ -727,7 +923,7 @@ func encodeGetPrivateProfileResponse(response GetPrivateProfileRes, w http.Respo
 
 		return nil
 
-	case *GetPrivateProfileInternalServerError:
+	case *GetMapsInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(500)
 		span.SetStatus(codes.Error, http.StatusText(500))
@@ -745,9 +941,9 @@ func encodeGetPrivateProfileResponse(response GetPrivateProfileRes, w http.Respo
 	}
 }
 
-func encodeGetPublicProfileResponse(response GetPublicProfileRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetMultiplayerGameResponse(response GetMultiplayerGameRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *UserPublicProfile:
+	case *MultiplayerGame:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -760,7 +956,20 @@ func encodeGetPublicProfileResponse(response GetPublicProfileRes, w http.Respons
 
 		return nil
 
-	case *GetPublicProfileNotFound:
+	case *GetMultiplayerGameUnauthorized:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetMultiplayerGameNotFound:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -773,7 +982,7 @@ func encodeGetPublicProfileResponse(response GetPublicProfileRes, w http.Respons
 
 		return nil
 
-	case *GetPublicProfileInternalServerError:
+	case *GetMultiplayerGameInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(500)
 		span.SetStatus(codes.Error, http.StatusText(500))
@@ -791,32 +1000,9 @@ func encodeGetPublicProfileResponse(response GetPublicProfileRes, w http.Respons
 	}
 }
 
-func encodeGetRootResponse(response *GetRootFound, w http.ResponseWriter, span trace.Span) error {
-	// Encoding response headers.
-	{
-		h := uri.NewHeaderEncoder(w.Header())
-		// Encode "Location" header.
-		{
-			cfg := uri.HeaderParameterEncodingConfig{
-				Name:    "Location",
-				Explode: false,
-			}
-			if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
-				return e.EncodeValue(conv.StringToString(response.Location))
-			}); err != nil {
-				return errors.Wrap(err, "encode Location header")
-			}
-		}
-	}
-	w.WriteHeader(302)
-	span.SetStatus(codes.Ok, http.StatusText(302))
-
-	return nil
-}
-
-func encodeGetSingleplayerGameResponse(response GetSingleplayerGameRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetMultiplayerGameGuessesResponse(response GetMultiplayerGameGuessesRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *SingleplayerGame:
+	case *GetMultiplayerGameGuessesOKApplicationJSON:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -829,7 +1015,20 @@ func encodeGetSingleplayerGameResponse(response GetSingleplayerGameRes, w http.R
 
 		return nil
 
-	case *GetSingleplayerGameUnauthorized:
+	case *GetMultiplayerGameGuessesBadRequest:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetMultiplayerGameGuessesUnauthorized:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(401)
 		span.SetStatus(codes.Error, http.StatusText(401))
@@ -842,7 +1041,7 @@ func encodeGetSingleplayerGameResponse(response GetSingleplayerGameRes, w http.R
 
 		return nil
 
-	case *GetSingleplayerGameForbidden:
+	case *GetMultiplayerGameGuessesForbidden:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -855,7 +1054,7 @@ func encodeGetSingleplayerGameResponse(response GetSingleplayerGameRes, w http.R
 
 		return nil
 
-	case *GetSingleplayerGameNotFound:
+	case *GetMultiplayerGameGuessesNotFound:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -868,7 +1067,7 @@ func encodeGetSingleplayerGameResponse(response GetSingleplayerGameRes, w http.R
 
 		return nil
 
-	case *GetSingleplayerGameInternalServerError:
+	case *GetMultiplayerGameGuessesInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(500)
 		span.SetStatus(codes.Error, http.StatusText(500))
@@ -886,9 +1085,9 @@ func encodeGetSingleplayerGameResponse(response GetSingleplayerGameRes, w http.R
 	}
 }
 
-func encodeGetSingleplayerGameRoundsResponse(response GetSingleplayerGameRoundsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetMultiplayerRoundResponse(response GetMultiplayerRoundRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetSingleplayerGameRoundsOKApplicationJSON:
+	case *MultiplayerRound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -901,10 +1100,10 @@ func encodeGetSingleplayerGameRoundsResponse(response GetSingleplayerGameRoundsR
 
 		return nil
 
-	case *GetSingleplayerGameRoundsBadRequest:
+	case *GetMultiplayerRoundUnauthorized:
 		w.Header().Set("Content-Type", "application/problem+json")
-		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -914,10 +1113,10 @@ func encodeGetSingleplayerGameRoundsResponse(response GetSingleplayerGameRoundsR
 
 		return nil
 
-	case *GetSingleplayerGameRoundsUnauthorized:
+	case *GetMultiplayerRoundNotFound:
 		w.Header().Set("Content-Type", "application/problem+json")
-		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -927,10 +1126,10 @@ func encodeGetSingleplayerGameRoundsResponse(response GetSingleplayerGameRoundsR
 
 		return nil
 
-	case *GetSingleplayerGameRoundsForbidden:
+	case *GetMultiplayerRoundInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -940,10 +1139,30 @@ func encodeGetSingleplayerGameRoundsResponse(response GetSingleplayerGameRoundsR
 
 		return nil
 
-	case *GetSingleplayerGameRoundsNotFound:
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetOAuthProvidersResponse(response GetOAuthProvidersRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *GetOAuthProvidersOKApplicationJSON:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetOAuthProvidersUnauthorized:
 		w.Header().Set("Content-Type", "application/problem+json")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -953,7 +1172,7 @@ func encodeGetSingleplayerGameRoundsResponse(response GetSingleplayerGameRoundsR
 
 		return nil
 
-	case *GetSingleplayerGameRoundsInternalServerError:
+	case *GetOAuthProvidersInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(500)
 		span.SetStatus(codes.Error, http.StatusText(500))
@@ -971,9 +1190,9 @@ func encodeGetSingleplayerGameRoundsResponse(response GetSingleplayerGameRoundsR
 	}
 }
 
-func encodeGetSingleplayerGamesResponse(response GetSingleplayerGamesRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetPanoramaResponse(response GetPanoramaRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *SingleplayerGames:
+	case *PanoramaLocation:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -986,7 +1205,7 @@ func encodeGetSingleplayerGamesResponse(response GetSingleplayerGamesRes, w http
 
 		return nil
 
-	case *GetSingleplayerGamesBadRequest:
+	case *GetPanoramaBadRequest:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(400)
 		span.SetStatus(codes.Error, http.StatusText(400))
@@ -999,7 +1218,7 @@ func encodeGetSingleplayerGamesResponse(response GetSingleplayerGamesRes, w http
 
 		return nil
 
-	case *GetSingleplayerGamesUnauthorized:
+	case *GetPanoramaUnauthorized:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(401)
 		span.SetStatus(codes.Error, http.StatusText(401))
@@ -1012,7 +1231,7 @@ func encodeGetSingleplayerGamesResponse(response GetSingleplayerGamesRes, w http
 
 		return nil
 
-	case *GetSingleplayerGamesInternalServerError:
+	case *GetPanoramaInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(500)
 		span.SetStatus(codes.Error, http.StatusText(500))
@@ -1030,9 +1249,9 @@ func encodeGetSingleplayerGamesResponse(response GetSingleplayerGamesRes, w http
 	}
 }
 
-func encodeGetSingleplayerRoundResponse(response GetSingleplayerRoundRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetPanoramaProvidersResponse(response GetPanoramaProvidersRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *SingleplayerRound:
+	case *GetPanoramaProvidersOKApplicationJSON:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1045,10 +1264,10 @@ func encodeGetSingleplayerRoundResponse(response GetSingleplayerRoundRes, w http
 
 		return nil
 
-	case *GetSingleplayerRoundBadRequest:
+	case *Error:
 		w.Header().Set("Content-Type", "application/problem+json")
-		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1058,7 +1277,27 @@ func encodeGetSingleplayerRoundResponse(response GetSingleplayerRoundRes, w http
 
 		return nil
 
-	case *GetSingleplayerRoundUnauthorized:
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetPrivateProfileResponse(response GetPrivateProfileRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *UserPrivateProfile:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetPrivateProfileUnauthorized:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(401)
 		span.SetStatus(codes.Error, http.StatusText(401))
@@ -1071,10 +1310,10 @@ func encodeGetSingleplayerRoundResponse(response GetSingleplayerRoundRes, w http
 
 		return nil
 
-	case *GetSingleplayerRoundForbidden:
+	case *GetPrivateProfileInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1084,7 +1323,27 @@ func encodeGetSingleplayerRoundResponse(response GetSingleplayerRoundRes, w http
 
 		return nil
 
-	case *GetSingleplayerRoundNotFound:
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetPublicProfileResponse(response GetPublicProfileRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *UserPublicProfile:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetPublicProfileNotFound:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -1097,7 +1356,7 @@ func encodeGetSingleplayerRoundResponse(response GetSingleplayerRoundRes, w http
 
 		return nil
 
-	case *GetSingleplayerRoundInternalServerError:
+	case *GetPublicProfileInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(500)
 		span.SetStatus(codes.Error, http.StatusText(500))
@@ -1115,9 +1374,32 @@ func encodeGetSingleplayerRoundResponse(response GetSingleplayerRoundRes, w http
 	}
 }
 
-func encodeGetUserSessionsResponse(response GetUserSessionsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetRootResponse(response *GetRootFound, w http.ResponseWriter, span trace.Span) error {
+	// Encoding response headers.
+	{
+		h := uri.NewHeaderEncoder(w.Header())
+		// Encode "Location" header.
+		{
+			cfg := uri.HeaderParameterEncodingConfig{
+				Name:    "Location",
+				Explode: false,
+			}
+			if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+				return e.EncodeValue(conv.StringToString(response.Location))
+			}); err != nil {
+				return errors.Wrap(err, "encode Location header")
+			}
+		}
+	}
+	w.WriteHeader(302)
+	span.SetStatus(codes.Ok, http.StatusText(302))
+
+	return nil
+}
+
+func encodeGetSingleplayerChallengeResponse(response GetSingleplayerChallengeRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetUserSessionsOKApplicationJSON:
+	case *SingleplayerChallenge:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1130,7 +1412,1022 @@ func encodeGetUserSessionsResponse(response GetUserSessionsRes, w http.ResponseW
 
 		return nil
 
-	case *Error:
+	case *GetSingleplayerChallengeBadRequest:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerChallengeUnauthorized:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerChallengeNotFound:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerChallengeInternalServerError:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetSingleplayerGameResponse(response GetSingleplayerGameRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *SingleplayerGame:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerGameUnauthorized:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerGameForbidden:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerGameNotFound:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerGameInternalServerError:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetSingleplayerGameRoundsResponse(response GetSingleplayerGameRoundsRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *GetSingleplayerGameRoundsOKApplicationJSON:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerGameRoundsBadRequest:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerGameRoundsUnauthorized:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerGameRoundsForbidden:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerGameRoundsNotFound:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerGameRoundsInternalServerError:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetSingleplayerGamesResponse(response GetSingleplayerGamesRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *SingleplayerGames:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerGamesBadRequest:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerGamesUnauthorized:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerGamesInternalServerError:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetSingleplayerRoundResponse(response GetSingleplayerRoundRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *SingleplayerRound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerRoundBadRequest:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerRoundUnauthorized:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerRoundForbidden:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerRoundNotFound:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetSingleplayerRoundInternalServerError:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetStreakGameResponse(response GetStreakGameRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *StreakGame:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetStreakGameUnauthorized:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetStreakGameForbidden:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetStreakGameNotFound:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetStreakGameInternalServerError:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetStreakLeaderboardResponse(response GetStreakLeaderboardRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *StreakLeaderboard:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetStreakLeaderboardBadRequest:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetStreakLeaderboardInternalServerError:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetStreakRoundResponse(response GetStreakRoundRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *StreakRound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetStreakRoundUnauthorized:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetStreakRoundForbidden:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetStreakRoundNotFound:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetStreakRoundInternalServerError:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetUserSessionsResponse(response GetUserSessionsRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *GetUserSessionsOKApplicationJSON:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Error:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeImportMapLocationsResponse(response ImportMapLocationsRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *MapLocationsAdded:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ImportMapLocationsBadRequest:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ImportMapLocationsUnauthorized:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ImportMapLocationsForbidden:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ImportMapLocationsNotFound:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ImportMapLocationsInternalServerError:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeLoginResponse(response LoginRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *LoginNoContent:
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Set-Cookie" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Set-Cookie",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					return e.EncodeValue(conv.StringToString(response.SetCookie))
+				}); err != nil {
+					return errors.Wrap(err, "encode Set-Cookie header")
+				}
+			}
+		}
+		w.WriteHeader(204)
+		span.SetStatus(codes.Ok, http.StatusText(204))
+
+		return nil
+
+	case *LoginBadRequest:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *LoginUnauthorized:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *LoginInternalServerError:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeNewDiscordResponse(response NewDiscordRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *NewDiscordTemporaryRedirect:
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Location" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Location",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					return e.EncodeValue(conv.StringToString(response.Location))
+				}); err != nil {
+					return errors.Wrap(err, "encode Location header")
+				}
+			}
+			// Encode "Set-Cookie" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Set-Cookie",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					return e.EncodeValue(conv.StringToString(response.SetCookie))
+				}); err != nil {
+					return errors.Wrap(err, "encode Set-Cookie header")
+				}
+			}
+		}
+		w.WriteHeader(307)
+		span.SetStatus(codes.Ok, http.StatusText(307))
+
+		return nil
+
+	case *Error:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeNewDiscordCallbackResponse(response NewDiscordCallbackRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *NewDiscordCallbackTemporaryRedirect:
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Location" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Location",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					return e.EncodeValue(conv.StringToString(response.Location))
+				}); err != nil {
+					return errors.Wrap(err, "encode Location header")
+				}
+			}
+		}
+		w.WriteHeader(307)
+		span.SetStatus(codes.Ok, http.StatusText(307))
+
+		return nil
+
+	case *NewDiscordCallbackBadRequest:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *NewDiscordCallbackUnauthorized:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *NewDiscordCallbackInternalServerError:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeNewLobbyResponse(response NewLobbyRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *NewLobbyCreated:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(201)
+		span.SetStatus(codes.Ok, http.StatusText(201))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *NewLobbyBadRequest:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *NewLobbyNotFound:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *NewLobbyInternalServerError:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeNewMapResponse(response NewMapRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *NewMapCreated:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(201)
+		span.SetStatus(codes.Ok, http.StatusText(201))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *NewMapBadRequest:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *NewMapUnauthorized:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *NewMapInternalServerError:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeNewMultiplayerRoundResponse(response NewMultiplayerRoundRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *MultiplayerRound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *NewMultiplayerRoundUnauthorized:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *NewMultiplayerRoundNotFound:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *NewMultiplayerRoundInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(500)
 		span.SetStatus(codes.Error, http.StatusText(500))
@@ -1148,31 +2445,22 @@ func encodeGetUserSessionsResponse(response GetUserSessionsRes, w http.ResponseW
 	}
 }
 
-func encodeLoginResponse(response LoginRes, w http.ResponseWriter, span trace.Span) error {
+func encodeNewSingleplayerChallengeResponse(response NewSingleplayerChallengeRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *LoginNoContent:
-		// Encoding response headers.
-		{
-			h := uri.NewHeaderEncoder(w.Header())
-			// Encode "Set-Cookie" header.
-			{
-				cfg := uri.HeaderParameterEncodingConfig{
-					Name:    "Set-Cookie",
-					Explode: false,
-				}
-				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
-					return e.EncodeValue(conv.StringToString(response.SetCookie))
-				}); err != nil {
-					return errors.Wrap(err, "encode Set-Cookie header")
-				}
-			}
+	case *NewSingleplayerChallengeCreated:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(201)
+		span.SetStatus(codes.Ok, http.StatusText(201))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
 		}
-		w.WriteHeader(204)
-		span.SetStatus(codes.Ok, http.StatusText(204))
 
 		return nil
 
-	case *LoginBadRequest:
+	case *NewSingleplayerChallengeBadRequest:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(400)
 		span.SetStatus(codes.Error, http.StatusText(400))
@@ -1185,7 +2473,7 @@ func encodeLoginResponse(response LoginRes, w http.ResponseWriter, span trace.Sp
 
 		return nil
 
-	case *LoginUnauthorized:
+	case *NewSingleplayerChallengeUnauthorized:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(401)
 		span.SetStatus(codes.Error, http.StatusText(401))
@@ -1198,10 +2486,10 @@ func encodeLoginResponse(response LoginRes, w http.ResponseWriter, span trace.Sp
 
 		return nil
 
-	case *LoginInternalServerError:
+	case *NewSingleplayerChallengeForbidden:
 		w.Header().Set("Content-Type", "application/problem+json")
-		w.WriteHeader(500)
-		span.SetStatus(codes.Error, http.StatusText(500))
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1211,48 +2499,20 @@ func encodeLoginResponse(response LoginRes, w http.ResponseWriter, span trace.Sp
 
 		return nil
 
-	default:
-		return errors.Errorf("unexpected response type: %T", response)
-	}
-}
+	case *NewSingleplayerChallengeNotFound:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
-func encodeNewDiscordResponse(response NewDiscordRes, w http.ResponseWriter, span trace.Span) error {
-	switch response := response.(type) {
-	case *NewDiscordTemporaryRedirect:
-		// Encoding response headers.
-		{
-			h := uri.NewHeaderEncoder(w.Header())
-			// Encode "Location" header.
-			{
-				cfg := uri.HeaderParameterEncodingConfig{
-					Name:    "Location",
-					Explode: false,
-				}
-				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
-					return e.EncodeValue(conv.StringToString(response.Location))
-				}); err != nil {
-					return errors.Wrap(err, "encode Location header")
-				}
-			}
-			// Encode "Set-Cookie" header.
-			{
-				cfg := uri.HeaderParameterEncodingConfig{
-					Name:    "Set-Cookie",
-					Explode: false,
-				}
-				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
-					return e.EncodeValue(conv.StringToString(response.SetCookie))
-				}); err != nil {
-					return errors.Wrap(err, "encode Set-Cookie header")
-				}
-			}
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
 		}
-		w.WriteHeader(307)
-		span.SetStatus(codes.Ok, http.StatusText(307))
 
 		return nil
 
-	case *Error:
+	case *NewSingleplayerChallengeInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(500)
 		span.SetStatus(codes.Error, http.StatusText(500))
@@ -1270,34 +2530,12 @@ func encodeNewDiscordResponse(response NewDiscordRes, w http.ResponseWriter, spa
 	}
 }
 
-func encodeNewDiscordCallbackResponse(response NewDiscordCallbackRes, w http.ResponseWriter, span trace.Span) error {
+func encodeNewSingleplayerGameResponse(response NewSingleplayerGameRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *NewDiscordCallbackTemporaryRedirect:
-		// Encoding response headers.
-		{
-			h := uri.NewHeaderEncoder(w.Header())
-			// Encode "Location" header.
-			{
-				cfg := uri.HeaderParameterEncodingConfig{
-					Name:    "Location",
-					Explode: false,
-				}
-				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
-					return e.EncodeValue(conv.StringToString(response.Location))
-				}); err != nil {
-					return errors.Wrap(err, "encode Location header")
-				}
-			}
-		}
-		w.WriteHeader(307)
-		span.SetStatus(codes.Ok, http.StatusText(307))
-
-		return nil
-
-	case *NewDiscordCallbackBadRequest:
-		w.Header().Set("Content-Type", "application/problem+json")
-		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
+	case *NewSingleplayerGameCreated:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(201)
+		span.SetStatus(codes.Ok, http.StatusText(201))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1307,10 +2545,10 @@ func encodeNewDiscordCallbackResponse(response NewDiscordCallbackRes, w http.Res
 
 		return nil
 
-	case *NewDiscordCallbackUnauthorized:
+	case *NewSingleplayerGameBadRequest:
 		w.Header().Set("Content-Type", "application/problem+json")
-		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1320,10 +2558,10 @@ func encodeNewDiscordCallbackResponse(response NewDiscordCallbackRes, w http.Res
 
 		return nil
 
-	case *NewDiscordCallbackInternalServerError:
+	case *NewSingleplayerGameUnauthorized:
 		w.Header().Set("Content-Type", "application/problem+json")
-		w.WriteHeader(500)
-		span.SetStatus(codes.Error, http.StatusText(500))
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1333,17 +2571,10 @@ func encodeNewDiscordCallbackResponse(response NewDiscordCallbackRes, w http.Res
 
 		return nil
 
-	default:
-		return errors.Errorf("unexpected response type: %T", response)
-	}
-}
-
-func encodeNewLobbyResponse(response NewLobbyRes, w http.ResponseWriter, span trace.Span) error {
-	switch response := response.(type) {
-	case *NewLobbyCreated:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(201)
-		span.SetStatus(codes.Ok, http.StatusText(201))
+	case *NewSingleplayerGameNotFound:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1353,10 +2584,10 @@ func encodeNewLobbyResponse(response NewLobbyRes, w http.ResponseWriter, span tr
 
 		return nil
 
-	case *NewLobbyBadRequest:
+	case *NewSingleplayerGameConflict:
 		w.Header().Set("Content-Type", "application/problem+json")
-		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
+		w.WriteHeader(409)
+		span.SetStatus(codes.Error, http.StatusText(409))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1366,7 +2597,7 @@ func encodeNewLobbyResponse(response NewLobbyRes, w http.ResponseWriter, span tr
 
 		return nil
 
-	case *NewLobbyInternalServerError:
+	case *NewSingleplayerGameInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(500)
 		span.SetStatus(codes.Error, http.StatusText(500))
@@ -1384,9 +2615,9 @@ func encodeNewLobbyResponse(response NewLobbyRes, w http.ResponseWriter, span tr
 	}
 }
 
-func encodeNewMultiplayerRoundResponse(response NewMultiplayerRoundRes, w http.ResponseWriter, span trace.Span) error {
+func encodeNewSingleplayerRoundResponse(response NewSingleplayerRoundRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *MultiplayerRound:
+	case *SingleplayerRound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1399,7 +2630,20 @@ func encodeNewMultiplayerRoundResponse(response NewMultiplayerRoundRes, w http.R
 
 		return nil
 
-	case *NewMultiplayerRoundUnauthorized:
+	case *NewSingleplayerRoundBadRequest:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *NewSingleplayerRoundUnauthorized:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(401)
 		span.SetStatus(codes.Error, http.StatusText(401))
@@ -1412,7 +2656,20 @@ func encodeNewMultiplayerRoundResponse(response NewMultiplayerRoundRes, w http.R
 
 		return nil
 
-	case *NewMultiplayerRoundNotFound:
+	case *NewSingleplayerRoundForbidden:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *NewSingleplayerRoundNotFound:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -1425,7 +2682,7 @@ func encodeNewMultiplayerRoundResponse(response NewMultiplayerRoundRes, w http.R
 
 		return nil
 
-	case *NewMultiplayerRoundInternalServerError:
+	case *NewSingleplayerRoundInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(500)
 		span.SetStatus(codes.Error, http.StatusText(500))
@@ -1443,9 +2700,9 @@ func encodeNewMultiplayerRoundResponse(response NewMultiplayerRoundRes, w http.R
 	}
 }
 
-func encodeNewSingleplayerGameResponse(response NewSingleplayerGameRes, w http.ResponseWriter, span trace.Span) error {
+func encodeNewStreakGameResponse(response NewStreakGameRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *NewSingleplayerGameCreated:
+	case *NewStreakGameCreated:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(201)
 		span.SetStatus(codes.Ok, http.StatusText(201))
@@ -1458,7 +2715,7 @@ func encodeNewSingleplayerGameResponse(response NewSingleplayerGameRes, w http.R
 
 		return nil
 
-	case *NewSingleplayerGameBadRequest:
+	case *NewStreakGameBadRequest:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(400)
 		span.SetStatus(codes.Error, http.StatusText(400))
@@ -1471,7 +2728,7 @@ func encodeNewSingleplayerGameResponse(response NewSingleplayerGameRes, w http.R
 
 		return nil
 
-	case *NewSingleplayerGameUnauthorized:
+	case *NewStreakGameUnauthorized:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(401)
 		span.SetStatus(codes.Error, http.StatusText(401))
@@ -1484,7 +2741,7 @@ func encodeNewSingleplayerGameResponse(response NewSingleplayerGameRes, w http.R
 
 		return nil
 
-	case *NewSingleplayerGameInternalServerError:
+	case *NewStreakGameInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(500)
 		span.SetStatus(codes.Error, http.StatusText(500))
@@ -1502,9 +2759,9 @@ func encodeNewSingleplayerGameResponse(response NewSingleplayerGameRes, w http.R
 	}
 }
 
-func encodeNewSingleplayerRoundResponse(response NewSingleplayerRoundRes, w http.ResponseWriter, span trace.Span) error {
+func encodeNewStreakRoundResponse(response NewStreakRoundRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *SingleplayerRound:
+	case *StreakRound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1517,7 +2774,7 @@ func encodeNewSingleplayerRoundResponse(response NewSingleplayerRoundRes, w http
 
 		return nil
 
-	case *NewSingleplayerRoundBadRequest:
+	case *NewStreakRoundBadRequest:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(400)
 		span.SetStatus(codes.Error, http.StatusText(400))
@@ -1530,7 +2787,7 @@ func encodeNewSingleplayerRoundResponse(response NewSingleplayerRoundRes, w http
 
 		return nil
 
-	case *NewSingleplayerRoundUnauthorized:
+	case *NewStreakRoundUnauthorized:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(401)
 		span.SetStatus(codes.Error, http.StatusText(401))
@@ -1543,7 +2800,7 @@ func encodeNewSingleplayerRoundResponse(response NewSingleplayerRoundRes, w http
 
 		return nil
 
-	case *NewSingleplayerRoundForbidden:
+	case *NewStreakRoundForbidden:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -1556,7 +2813,7 @@ func encodeNewSingleplayerRoundResponse(response NewSingleplayerRoundRes, w http
 
 		return nil
 
-	case *NewSingleplayerRoundNotFound:
+	case *NewStreakRoundNotFound:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -1569,7 +2826,7 @@ func encodeNewSingleplayerRoundResponse(response NewSingleplayerRoundRes, w http
 
 		return nil
 
-	case *NewSingleplayerRoundInternalServerError:
+	case *NewStreakRoundInternalServerError:
 		w.Header().Set("Content-Type", "application/problem+json")
 		w.WriteHeader(500)
 		span.SetStatus(codes.Error, http.StatusText(500))
@@ -1816,6 +3073,71 @@ func encodeRegisterResponse(response RegisterRes, w http.ResponseWriter, span tr
 	}
 }
 
+func encodeUpdateMapResponse(response UpdateMapRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *UpdateMapNoContent:
+		w.WriteHeader(204)
+		span.SetStatus(codes.Ok, http.StatusText(204))
+
+		return nil
+
+	case *UpdateMapUnauthorized:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UpdateMapForbidden:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UpdateMapNotFound:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UpdateMapInternalServerError:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(500)
+		span.SetStatus(codes.Error, http.StatusText(500))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
 func encodeUpdateUserResponse(response UpdateUserRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
 	case *UpdateUserNoContent:
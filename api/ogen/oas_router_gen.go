@@ -480,6 +480,80 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					}
 
+				case 'c': // Prefix: "challenges/"
+
+					if l := len("challenges/"); len(elem) >= l && elem[0:l] == "challenges/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetSingleplayerChallengeRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/accept"
+
+						if l := len("/accept"); len(elem) >= l && elem[0:l] == "/accept" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleAcceptSingleplayerChallengeRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					}
+
+				case 'd': // Prefix: "daily-challenge/leaderboard"
+
+					if l := len("daily-challenge/leaderboard"); len(elem) >= l && elem[0:l] == "daily-challenge/leaderboard" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetDailyChallengeLeaderboardRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
 				case 'l': // Prefix: "lobbies"
 
 					if l := len("lobbies"); len(elem) >= l && elem[0:l] == "lobbies" {
@@ -534,124 +608,138 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					}
 
-				case 'm': // Prefix: "multiplayer/"
+				case 'm': // Prefix: "m"
 
-					if l := len("multiplayer/"); len(elem) >= l && elem[0:l] == "multiplayer/" {
+					if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					// Param: "id"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
-
 					if len(elem) == 0 {
-						switch r.Method {
-						case "GET":
-							s.handleGetMultiplayerGameRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
-						}
-
-						return
+						break
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/"
+					case 'a': // Prefix: "aps"
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("aps"); len(elem) >= l && elem[0:l] == "aps" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							break
+							switch r.Method {
+							case "GET":
+								s.handleGetMapsRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleNewMapRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
 						}
 						switch elem[0] {
-						case 'g': // Prefix: "guesses"
+						case '/': // Prefix: "/"
 
-							if l := len("guesses"); len(elem) >= l && elem[0:l] == "guesses" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetMultiplayerGameGuessesRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
-								}
-
-								return
-							}
-
-						case 'r': // Prefix: "round"
-
-							if l := len("round"); len(elem) >= l && elem[0:l] == "round" {
-								elem = elem[l:]
-							} else {
-								break
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
 							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
 
 							if len(elem) == 0 {
-								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleGetMultiplayerRoundRequest([1]string{
+									s.handleGetMapRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
-								case "POST":
-									s.handleNewMultiplayerRoundRequest([1]string{
+								case "PATCH":
+									s.handleUpdateMapRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "GET,POST")
+									s.notAllowed(w, r, "GET,PATCH")
 								}
 
 								return
 							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
 
-						}
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-					}
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'i': // Prefix: "import"
+
+									if l := len("import"); len(elem) >= l && elem[0:l] == "import" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-				case 's': // Prefix: "singleplayer"
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleImportMapLocationsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
 
-					if l := len("singleplayer"); len(elem) >= l && elem[0:l] == "singleplayer" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+										return
+									}
+
+								case 'l': // Prefix: "locations"
+
+									if l := len("locations"); len(elem) >= l && elem[0:l] == "locations" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleAddMapLocationsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							}
 
-					if len(elem) == 0 {
-						switch r.Method {
-						case "GET":
-							s.handleGetSingleplayerGamesRequest([0]string{}, elemIsEscaped, w, r)
-						case "POST":
-							s.handleNewSingleplayerGameRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET,POST")
 						}
 
-						return
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
+					case 'u': // Prefix: "ultiplayer/"
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("ultiplayer/"); len(elem) >= l && elem[0:l] == "ultiplayer/" {
 							elem = elem[l:]
 						} else {
 							break
@@ -669,7 +757,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						if len(elem) == 0 {
 							switch r.Method {
 							case "GET":
-								s.handleGetSingleplayerGameRequest([1]string{
+								s.handleGetMultiplayerGameRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
 							default:
@@ -691,9 +779,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 							switch elem[0] {
-							case 'e': // Prefix: "end"
+							case 'g': // Prefix: "guesses"
 
-								if l := len("end"); len(elem) >= l && elem[0:l] == "end" {
+								if l := len("guesses"); len(elem) >= l && elem[0:l] == "guesses" {
 									elem = elem[l:]
 								} else {
 									break
@@ -702,12 +790,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								if len(elem) == 0 {
 									// Leaf node.
 									switch r.Method {
-									case "POST":
-										s.handleEndSingleplayerGameRequest([1]string{
+									case "GET":
+										s.handleGetMultiplayerGameGuessesRequest([1]string{
 											args[0],
 										}, elemIsEscaped, w, r)
 									default:
-										s.notAllowed(w, r, "POST")
+										s.notAllowed(w, r, "GET")
 									}
 
 									return
@@ -722,13 +810,14 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								}
 
 								if len(elem) == 0 {
+									// Leaf node.
 									switch r.Method {
 									case "GET":
-										s.handleGetSingleplayerRoundRequest([1]string{
+										s.handleGetMultiplayerRoundRequest([1]string{
 											args[0],
 										}, elemIsEscaped, w, r)
 									case "POST":
-										s.handleNewSingleplayerRoundRequest([1]string{
+										s.handleNewMultiplayerRoundRequest([1]string{
 											args[0],
 										}, elemIsEscaped, w, r)
 									default:
@@ -737,52 +826,6 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 									return
 								}
-								switch elem[0] {
-								case '/': // Prefix: "/end"
-
-									if l := len("/end"); len(elem) >= l && elem[0:l] == "/end" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
-										case "POST":
-											s.handleEndSingleplayerRoundRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "POST")
-										}
-
-										return
-									}
-
-								case 's': // Prefix: "s"
-
-									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
-										case "GET":
-											s.handleGetSingleplayerGameRoundsRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "GET")
-										}
-
-										return
-									}
-
-								}
 
 							}
 
@@ -790,9 +833,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					}
 
-				case 'u': // Prefix: "users/"
+				case 'p': // Prefix: "panorama/"
 
-					if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
+					if l := len("panorama/"); len(elem) >= l && elem[0:l] == "panorama/" {
 						elem = elem[l:]
 					} else {
 						break
@@ -802,9 +845,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
-					case 'a': // Prefix: "avatar"
+					case 'p': // Prefix: "providers"
 						origElem := elem
-						if l := len("avatar"); len(elem) >= l && elem[0:l] == "avatar" {
+						if l := len("providers"); len(elem) >= l && elem[0:l] == "providers" {
 							elem = elem[l:]
 						} else {
 							break
@@ -813,54 +856,31 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						if len(elem) == 0 {
 							// Leaf node.
 							switch r.Method {
-							case "PUT":
-								s.handleUpdateUserAvatarRequest([0]string{}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetPanoramaProvidersRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "PUT")
+								s.notAllowed(w, r, "GET")
 							}
 
 							return
 						}
 
 						elem = origElem
-					case 'm': // Prefix: "me"
-						origElem := elem
-						if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleGetPrivateProfileRequest([0]string{}, elemIsEscaped, w, r)
-							case "PATCH":
-								s.handleUpdateUserRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET,PATCH")
-							}
-
-							return
-						}
-
-						elem = origElem
-					}
-					// Param: "id"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
-					}
-					args[0] = elem
-					elem = ""
+					}
+					// Param: "token"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
 
 					if len(elem) == 0 {
 						// Leaf node.
 						switch r.Method {
 						case "GET":
-							s.handleGetPublicProfileRequest([1]string{
+							s.handleGetPanoramaRequest([1]string{
 								args[0],
 							}, elemIsEscaped, w, r)
 						default:
@@ -870,164 +890,882 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						return
 					}
 
-				}
+				case 's': // Prefix: "s"
 
-			}
+					if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-		}
-	}
-	s.notFound(w, r)
-}
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'i': // Prefix: "ingleplayer"
 
-// Route is route object.
-type Route struct {
-	name        string
-	summary     string
-	operationID string
-	pathPattern string
-	count       int
-	args        [1]string
-}
+						if l := len("ingleplayer"); len(elem) >= l && elem[0:l] == "ingleplayer" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-// Name returns ogen operation name.
-//
-// It is guaranteed to be unique and not empty.
-func (r Route) Name() string {
-	return r.name
-}
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleGetSingleplayerGamesRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleNewSingleplayerGameRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
 
-// Summary returns OpenAPI summary.
-func (r Route) Summary() string {
-	return r.summary
-}
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
 
-// OperationID returns OpenAPI operationId.
-func (r Route) OperationID() string {
-	return r.operationID
-}
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-// PathPattern returns OpenAPI path.
-func (r Route) PathPattern() string {
-	return r.pathPattern
-}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
 
-// Args returns parsed arguments.
-func (r Route) Args() []string {
-	return r.args[:r.count]
-}
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleGetSingleplayerGameRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
 
-// FindRoute finds Route for given method and path.
-//
-// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
-func (s *Server) FindRoute(method, path string) (Route, bool) {
-	return s.FindPath(method, &url.URL{Path: path})
-}
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
 
-// FindPath finds Route for given method and URL.
-func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
-	var (
-		elem = u.Path
-		args = r.args
-	)
-	if rawPath := u.RawPath; rawPath != "" {
-		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
-			elem = normalized
-		}
-		defer func() {
-			for i, arg := range r.args[:r.count] {
-				if unescaped, err := url.PathUnescape(arg); err == nil {
-					r.args[i] = unescaped
-				}
-			}
-		}()
-	}
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-	elem, ok := s.cutPrefix(elem)
-	if !ok {
-		return r, false
-	}
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "challenge"
 
-	// Static code generated router with unwrapped path search.
-	switch {
-	default:
-		if len(elem) == 0 {
-			break
-		}
-		switch elem[0] {
-		case '/': // Prefix: "/"
+									if l := len("challenge"); len(elem) >= l && elem[0:l] == "challenge" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-				elem = elem[l:]
-			} else {
-				break
-			}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleNewSingleplayerChallengeRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 'e': // Prefix: "end"
+
+									if l := len("end"); len(elem) >= l && elem[0:l] == "end" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleEndSingleplayerGameRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 'r': // Prefix: "round"
+
+									if l := len("round"); len(elem) >= l && elem[0:l] == "round" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleGetSingleplayerRoundRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleNewSingleplayerRoundRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET,POST")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/end"
+
+										if l := len("/end"); len(elem) >= l && elem[0:l] == "/end" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleEndSingleplayerRoundRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									case 's': // Prefix: "s"
+
+										if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetSingleplayerGameRoundsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+							}
+
+						}
+
+					case 't': // Prefix: "treak"
+
+						if l := len("treak"); len(elem) >= l && elem[0:l] == "treak" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "POST":
+								s.handleNewStreakGameRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'b': // Prefix: "best"
+								origElem := elem
+								if l := len("best"); len(elem) >= l && elem[0:l] == "best" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetBestStreakRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 'l': // Prefix: "leaderboard"
+								origElem := elem
+								if l := len("leaderboard"); len(elem) >= l && elem[0:l] == "leaderboard" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetStreakLeaderboardRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleGetStreakGameRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/round"
+
+								if l := len("/round"); len(elem) >= l && elem[0:l] == "/round" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleGetStreakRoundRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleNewStreakRoundRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/end"
+
+									if l := len("/end"); len(elem) >= l && elem[0:l] == "/end" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleEndStreakRoundRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'u': // Prefix: "users/"
+
+					if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "avatar"
+						origElem := elem
+						if l := len("avatar"); len(elem) >= l && elem[0:l] == "avatar" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "PUT":
+								s.handleUpdateUserAvatarRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "PUT")
+							}
+
+							return
+						}
+
+						elem = origElem
+					case 'm': // Prefix: "me"
+						origElem := elem
+						if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetPrivateProfileRequest([0]string{}, elemIsEscaped, w, r)
+							case "PATCH":
+								s.handleUpdateUserRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,PATCH")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+					// Param: "id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetPublicProfileRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				}
+
+			}
+
+		}
+	}
+	s.notFound(w, r)
+}
+
+// Route is route object.
+type Route struct {
+	name        string
+	summary     string
+	operationID string
+	pathPattern string
+	count       int
+	args        [1]string
+}
+
+// Name returns ogen operation name.
+//
+// It is guaranteed to be unique and not empty.
+func (r Route) Name() string {
+	return r.name
+}
+
+// Summary returns OpenAPI summary.
+func (r Route) Summary() string {
+	return r.summary
+}
+
+// OperationID returns OpenAPI operationId.
+func (r Route) OperationID() string {
+	return r.operationID
+}
+
+// PathPattern returns OpenAPI path.
+func (r Route) PathPattern() string {
+	return r.pathPattern
+}
+
+// Args returns parsed arguments.
+func (r Route) Args() []string {
+	return r.args[:r.count]
+}
+
+// FindRoute finds Route for given method and path.
+//
+// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
+func (s *Server) FindRoute(method, path string) (Route, bool) {
+	return s.FindPath(method, &url.URL{Path: path})
+}
+
+// FindPath finds Route for given method and URL.
+func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
+	var (
+		elem = u.Path
+		args = r.args
+	)
+	if rawPath := u.RawPath; rawPath != "" {
+		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
+			elem = normalized
+		}
+		defer func() {
+			for i, arg := range r.args[:r.count] {
+				if unescaped, err := url.PathUnescape(arg); err == nil {
+					r.args[i] = unescaped
+				}
+			}
+		}()
+	}
+
+	elem, ok := s.cutPrefix(elem)
+	if !ok {
+		return r, false
+	}
+
+	// Static code generated router with unwrapped path search.
+	switch {
+	default:
+		if len(elem) == 0 {
+			break
+		}
+		switch elem[0] {
+		case '/': // Prefix: "/"
+
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				elem = elem[l:]
+			} else {
+				break
+			}
+
+			if len(elem) == 0 {
+				switch method {
+				case "GET":
+					r.name = GetRootOperation
+					r.summary = "Redirect to documentation"
+					r.operationID = "getRoot"
+					r.pathPattern = "/"
+					r.args = args
+					r.count = 0
+					return r, true
+				default:
+					return
+				}
+			}
+			switch elem[0] {
+			case 'h': // Prefix: "health"
+
+				if l := len("health"); len(elem) >= l && elem[0:l] == "health" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "GET":
+						r.name = GetHealthOperation
+						r.summary = "Check API health"
+						r.operationID = "getHealth"
+						r.pathPattern = "/health"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+			case 'v': // Prefix: "v1/"
+
+				if l := len("v1/"); len(elem) >= l && elem[0:l] == "v1/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "auth/"
+
+					if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'd': // Prefix: "discord"
+
+						if l := len("discord"); len(elem) >= l && elem[0:l] == "discord" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = DeleteDiscordOperation
+								r.summary = "Delete Discord auth"
+								r.operationID = "deleteDiscord"
+								r.pathPattern = "/v1/auth/discord"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'l': // Prefix: "login"
+
+								if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = DiscordLoginOperation
+										r.summary = "Discord login"
+										r.operationID = "discordLogin"
+										r.pathPattern = "/v1/auth/discord/login"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/callback"
+
+									if l := len("/callback"); len(elem) >= l && elem[0:l] == "/callback" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = DiscordLoginCallbackOperation
+											r.summary = "Discord login callback"
+											r.operationID = "discordLoginCallback"
+											r.pathPattern = "/v1/auth/discord/login/callback"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'n': // Prefix: "new"
+
+								if l := len("new"); len(elem) >= l && elem[0:l] == "new" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = NewDiscordOperation
+										r.summary = "New Discord auth"
+										r.operationID = "newDiscord"
+										r.pathPattern = "/v1/auth/discord/new"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/callback"
+
+									if l := len("/callback"); len(elem) >= l && elem[0:l] == "/callback" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = NewDiscordCallbackOperation
+											r.summary = "Discord new auth callback"
+											r.operationID = "newDiscordCallback"
+											r.pathPattern = "/v1/auth/discord/new/callback"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					case 'l': // Prefix: "login"
+
+						if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = LoginOperation
+								r.summary = "Login user"
+								r.operationID = "login"
+								r.pathPattern = "/v1/auth/login"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'p': // Prefix: "providers"
+
+						if l := len("providers"); len(elem) >= l && elem[0:l] == "providers" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetOAuthProvidersOperation
+								r.summary = "Get all connected OAuth providers"
+								r.operationID = "getOAuthProviders"
+								r.pathPattern = "/v1/auth/providers"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'r': // Prefix: "register"
+
+						if l := len("register"); len(elem) >= l && elem[0:l] == "register" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = RegisterOperation
+								r.summary = "Register new user"
+								r.operationID = "register"
+								r.pathPattern = "/v1/auth/register"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 's': // Prefix: "sessions"
+
+						if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = GetUserSessionsOperation
+								r.summary = "User sessions"
+								r.operationID = "getUserSessions"
+								r.pathPattern = "/v1/auth/sessions"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
 
-			if len(elem) == 0 {
-				switch method {
-				case "GET":
-					r.name = GetRootOperation
-					r.summary = "Redirect to documentation"
-					r.operationID = "getRoot"
-					r.pathPattern = "/"
-					r.args = args
-					r.count = 0
-					return r, true
-				default:
-					return
-				}
-			}
-			switch elem[0] {
-			case 'h': // Prefix: "health"
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-				if l := len("health"); len(elem) >= l && elem[0:l] == "health" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch method {
-					case "GET":
-						r.name = GetHealthOperation
-						r.summary = "Check API health"
-						r.operationID = "getHealth"
-						r.pathPattern = "/health"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
-				}
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = DeleteUserSessionOperation
+									r.summary = "Delete user session"
+									r.operationID = "deleteUserSession"
+									r.pathPattern = "/v1/auth/sessions/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
 
-			case 'v': // Prefix: "v1/"
+						}
 
-				if l := len("v1/"); len(elem) >= l && elem[0:l] == "v1/" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+					case 't': // Prefix: "tokens/refresh"
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'a': // Prefix: "auth/"
+						if l := len("tokens/refresh"); len(elem) >= l && elem[0:l] == "tokens/refresh" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-					if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = RefreshTokensOperation
+								r.summary = "Get new refresh and access tokens"
+								r.operationID = "refreshTokens"
+								r.pathPattern = "/v1/auth/tokens/refresh"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'd': // Prefix: "discord"
+					case 'y': // Prefix: "yandex"
 
-						if l := len("discord"); len(elem) >= l && elem[0:l] == "discord" {
+						if l := len("yandex"); len(elem) >= l && elem[0:l] == "yandex" {
 							elem = elem[l:]
 						} else {
 							break
@@ -1036,10 +1774,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							switch method {
 							case "DELETE":
-								r.name = DeleteDiscordOperation
-								r.summary = "Delete Discord auth"
-								r.operationID = "deleteDiscord"
-								r.pathPattern = "/v1/auth/discord"
+								r.name = DeleteYandexOperation
+								r.summary = "Delete Yandex auth"
+								r.operationID = "deleteYandex"
+								r.pathPattern = "/v1/auth/yandex"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -1071,10 +1809,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								if len(elem) == 0 {
 									switch method {
 									case "GET":
-										r.name = DiscordLoginOperation
-										r.summary = "Discord login"
-										r.operationID = "discordLogin"
-										r.pathPattern = "/v1/auth/discord/login"
+										r.name = YandexLoginOperation
+										r.summary = "Yandex login"
+										r.operationID = "yandexLogin"
+										r.pathPattern = "/v1/auth/yandex/login"
 										r.args = args
 										r.count = 0
 										return r, true
@@ -1095,10 +1833,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										// Leaf node.
 										switch method {
 										case "GET":
-											r.name = DiscordLoginCallbackOperation
-											r.summary = "Discord login callback"
-											r.operationID = "discordLoginCallback"
-											r.pathPattern = "/v1/auth/discord/login/callback"
+											r.name = YandexLoginCallbackOperation
+											r.summary = "Yandex login callback"
+											r.operationID = "yandexLoginCallback"
+											r.pathPattern = "/v1/auth/yandex/login/callback"
 											r.args = args
 											r.count = 0
 											return r, true
@@ -1120,10 +1858,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								if len(elem) == 0 {
 									switch method {
 									case "GET":
-										r.name = NewDiscordOperation
-										r.summary = "New Discord auth"
-										r.operationID = "newDiscord"
-										r.pathPattern = "/v1/auth/discord/new"
+										r.name = NewYandexOperation
+										r.summary = "New Yandex auth"
+										r.operationID = "newYandex"
+										r.pathPattern = "/v1/auth/yandex/new"
 										r.args = args
 										r.count = 0
 										return r, true
@@ -1144,10 +1882,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										// Leaf node.
 										switch method {
 										case "GET":
-											r.name = NewDiscordCallbackOperation
-											r.summary = "Discord new auth callback"
-											r.operationID = "newDiscordCallback"
-											r.pathPattern = "/v1/auth/discord/new/callback"
+											r.name = NewYandexCallbackOperation
+											r.summary = "New Yandex auth callback"
+											r.operationID = "newYandexCallback"
+											r.pathPattern = "/v1/auth/yandex/new/callback"
 											r.args = args
 											r.count = 0
 											return r, true
@@ -1162,9 +1900,43 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 						}
 
-					case 'l': // Prefix: "login"
+					}
 
-						if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+				case 'c': // Prefix: "challenges/"
+
+					if l := len("challenges/"); len(elem) >= l && elem[0:l] == "challenges/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GetSingleplayerChallengeOperation
+							r.summary = "Get challenge by token"
+							r.operationID = "getSingleplayerChallenge"
+							r.pathPattern = "/v1/challenges/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/accept"
+
+						if l := len("/accept"); len(elem) >= l && elem[0:l] == "/accept" {
 							elem = elem[l:]
 						} else {
 							break
@@ -1174,69 +1946,125 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							// Leaf node.
 							switch method {
 							case "POST":
-								r.name = LoginOperation
-								r.summary = "Login user"
-								r.operationID = "login"
-								r.pathPattern = "/v1/auth/login"
+								r.name = AcceptSingleplayerChallengeOperation
+								r.summary = "Accept challenge"
+								r.operationID = "acceptSingleplayerChallenge"
+								r.pathPattern = "/v1/challenges/{id}/accept"
 								r.args = args
-								r.count = 0
+								r.count = 1
 								return r, true
 							default:
 								return
 							}
 						}
 
-					case 'p': // Prefix: "providers"
+					}
 
-						if l := len("providers"); len(elem) >= l && elem[0:l] == "providers" {
-							elem = elem[l:]
-						} else {
-							break
+				case 'd': // Prefix: "daily-challenge/leaderboard"
+
+					if l := len("daily-challenge/leaderboard"); len(elem) >= l && elem[0:l] == "daily-challenge/leaderboard" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = GetDailyChallengeLeaderboardOperation
+							r.summary = "Get daily challenge leaderboard"
+							r.operationID = "getDailyChallengeLeaderboard"
+							r.pathPattern = "/v1/daily-challenge/leaderboard"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
 						}
+					}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = GetOAuthProvidersOperation
-								r.summary = "Get all connected OAuth providers"
-								r.operationID = "getOAuthProviders"
-								r.pathPattern = "/v1/auth/providers"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
+				case 'l': // Prefix: "lobbies"
+
+					if l := len("lobbies"); len(elem) >= l && elem[0:l] == "lobbies" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GetLobbiesOperation
+							r.summary = "Get available lobbies"
+							r.operationID = "getLobbies"
+							r.pathPattern = "/v1/lobbies"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = NewLobbyOperation
+							r.summary = "Create new lobby"
+							r.operationID = "newLobby"
+							r.pathPattern = "/v1/lobbies"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
 						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
 
-					case 'r': // Prefix: "register"
-
-						if l := len("register"); len(elem) >= l && elem[0:l] == "register" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
 						if len(elem) == 0 {
 							// Leaf node.
 							switch method {
-							case "POST":
-								r.name = RegisterOperation
-								r.summary = "Register new user"
-								r.operationID = "register"
-								r.pathPattern = "/v1/auth/register"
+							case "GET":
+								r.name = GetLobbyOperation
+								r.summary = "Get lobby by ID"
+								r.operationID = "getLobby"
+								r.pathPattern = "/v1/lobbies/{id}"
 								r.args = args
-								r.count = 0
+								r.count = 1
 								return r, true
 							default:
 								return
 							}
 						}
 
-					case 's': // Prefix: "sessions"
+					}
 
-						if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+				case 'm': // Prefix: "m"
+
+					if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "aps"
+
+						if l := len("aps"); len(elem) >= l && elem[0:l] == "aps" {
 							elem = elem[l:]
 						} else {
 							break
@@ -1245,10 +2073,18 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							switch method {
 							case "GET":
-								r.name = GetUserSessionsOperation
-								r.summary = "User sessions"
-								r.operationID = "getUserSessions"
-								r.pathPattern = "/v1/auth/sessions"
+								r.name = GetMapsOperation
+								r.summary = "Get maps"
+								r.operationID = "getMaps"
+								r.pathPattern = "/v1/maps"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = NewMapOperation
+								r.summary = "Create new map"
+								r.operationID = "newMap"
+								r.pathPattern = "/v1/maps"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -1266,22 +2102,29 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 
 							// Param: "id"
-							// Leaf parameter, slashes are prohibited
+							// Match until "/"
 							idx := strings.IndexByte(elem, '/')
-							if idx >= 0 {
-								break
+							if idx < 0 {
+								idx = len(elem)
 							}
-							args[0] = elem
-							elem = ""
+							args[0] = elem[:idx]
+							elem = elem[idx:]
 
 							if len(elem) == 0 {
-								// Leaf node.
 								switch method {
-								case "DELETE":
-									r.name = DeleteUserSessionOperation
-									r.summary = "Delete user session"
-									r.operationID = "deleteUserSession"
-									r.pathPattern = "/v1/auth/sessions/{id}"
+								case "GET":
+									r.name = GetMapOperation
+									r.summary = "Get map by ID"
+									r.operationID = "getMap"
+									r.pathPattern = "/v1/maps/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PATCH":
+									r.name = UpdateMapOperation
+									r.summary = "Update map"
+									r.operationID = "updateMap"
+									r.pathPattern = "/v1/maps/{id}"
 									r.args = args
 									r.count = 1
 									return r, true
@@ -1289,50 +2132,99 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									return
 								}
 							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
 
-						}
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-					case 't': // Prefix: "tokens/refresh"
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'i': // Prefix: "import"
 
-						if l := len("tokens/refresh"); len(elem) >= l && elem[0:l] == "tokens/refresh" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+									if l := len("import"); len(elem) >= l && elem[0:l] == "import" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = ImportMapLocationsOperation
+											r.summary = "Import locations to map"
+											r.operationID = "importMapLocations"
+											r.pathPattern = "/v1/maps/{id}/import"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'l': // Prefix: "locations"
+
+									if l := len("locations"); len(elem) >= l && elem[0:l] == "locations" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = AddMapLocationsOperation
+											r.summary = "Add existing locations to map"
+											r.operationID = "addMapLocations"
+											r.pathPattern = "/v1/maps/{id}/locations"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "POST":
-								r.name = RefreshTokensOperation
-								r.summary = "Get new refresh and access tokens"
-								r.operationID = "refreshTokens"
-								r.pathPattern = "/v1/auth/tokens/refresh"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
 							}
+
 						}
 
-					case 'y': // Prefix: "yandex"
+					case 'u': // Prefix: "ultiplayer/"
 
-						if l := len("yandex"); len(elem) >= l && elem[0:l] == "yandex" {
+						if l := len("ultiplayer/"); len(elem) >= l && elem[0:l] == "ultiplayer/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
 						if len(elem) == 0 {
 							switch method {
-							case "DELETE":
-								r.name = DeleteYandexOperation
-								r.summary = "Delete Yandex auth"
-								r.operationID = "deleteYandex"
-								r.pathPattern = "/v1/auth/yandex"
+							case "GET":
+								r.name = GetMultiplayerGameOperation
+								r.summary = "Get multiplayer game by ID"
+								r.operationID = "getMultiplayerGame"
+								r.pathPattern = "/v1/multiplayer/{id}"
 								r.args = args
-								r.count = 0
+								r.count = 1
 								return r, true
 							default:
 								return
@@ -1351,103 +2243,61 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 							switch elem[0] {
-							case 'l': // Prefix: "login"
+							case 'g': // Prefix: "guesses"
 
-								if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+								if l := len("guesses"); len(elem) >= l && elem[0:l] == "guesses" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
+									// Leaf node.
 									switch method {
 									case "GET":
-										r.name = YandexLoginOperation
-										r.summary = "Yandex login"
-										r.operationID = "yandexLogin"
-										r.pathPattern = "/v1/auth/yandex/login"
+										r.name = GetMultiplayerGameGuessesOperation
+										r.summary = "Get multiplayer game guesses"
+										r.operationID = "getMultiplayerGameGuesses"
+										r.pathPattern = "/v1/multiplayer/{id}/guesses"
 										r.args = args
-										r.count = 0
+										r.count = 1
 										return r, true
 									default:
 										return
 									}
 								}
-								switch elem[0] {
-								case '/': // Prefix: "/callback"
-
-									if l := len("/callback"); len(elem) >= l && elem[0:l] == "/callback" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = YandexLoginCallbackOperation
-											r.summary = "Yandex login callback"
-											r.operationID = "yandexLoginCallback"
-											r.pathPattern = "/v1/auth/yandex/login/callback"
-											r.args = args
-											r.count = 0
-											return r, true
-										default:
-											return
-										}
-									}
-
-								}
 
-							case 'n': // Prefix: "new"
+							case 'r': // Prefix: "round"
 
-								if l := len("new"); len(elem) >= l && elem[0:l] == "new" {
+								if l := len("round"); len(elem) >= l && elem[0:l] == "round" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
+									// Leaf node.
 									switch method {
 									case "GET":
-										r.name = NewYandexOperation
-										r.summary = "New Yandex auth"
-										r.operationID = "newYandex"
-										r.pathPattern = "/v1/auth/yandex/new"
+										r.name = GetMultiplayerRoundOperation
+										r.summary = "Get multiplayer game round"
+										r.operationID = "getMultiplayerRound"
+										r.pathPattern = "/v1/multiplayer/{id}/round"
 										r.args = args
-										r.count = 0
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = NewMultiplayerRoundOperation
+										r.summary = "Get or generate multiplayer game round"
+										r.operationID = "newMultiplayerRound"
+										r.pathPattern = "/v1/multiplayer/{id}/round"
+										r.args = args
+										r.count = 1
 										return r, true
 									default:
 										return
 									}
 								}
-								switch elem[0] {
-								case '/': // Prefix: "/callback"
-
-									if l := len("/callback"); len(elem) >= l && elem[0:l] == "/callback" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = NewYandexCallbackOperation
-											r.summary = "New Yandex auth callback"
-											r.operationID = "newYandexCallback"
-											r.pathPattern = "/v1/auth/yandex/new/callback"
-											r.args = args
-											r.count = 0
-											return r, true
-										default:
-											return
-										}
-									}
-
-								}
 
 							}
 
@@ -1455,96 +2305,61 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 					}
 
-				case 'l': // Prefix: "lobbies"
+				case 'p': // Prefix: "panorama/"
 
-					if l := len("lobbies"); len(elem) >= l && elem[0:l] == "lobbies" {
+					if l := len("panorama/"); len(elem) >= l && elem[0:l] == "panorama/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							r.name = GetLobbiesOperation
-							r.summary = "Get available lobbies"
-							r.operationID = "getLobbies"
-							r.pathPattern = "/v1/lobbies"
-							r.args = args
-							r.count = 0
-							return r, true
-						case "POST":
-							r.name = NewLobbyOperation
-							r.summary = "Create new lobby"
-							r.operationID = "newLobby"
-							r.pathPattern = "/v1/lobbies"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
+						break
 					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
-
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					switch elem[0] {
+					case 'p': // Prefix: "providers"
+						origElem := elem
+						if l := len("providers"); len(elem) >= l && elem[0:l] == "providers" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "id"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
-						}
-						args[0] = elem
-						elem = ""
-
 						if len(elem) == 0 {
 							// Leaf node.
 							switch method {
 							case "GET":
-								r.name = GetLobbyOperation
-								r.summary = "Get lobby by ID"
-								r.operationID = "getLobby"
-								r.pathPattern = "/v1/lobbies/{id}"
+								r.name = GetPanoramaProvidersOperation
+								r.summary = "Get panorama providers"
+								r.operationID = "getPanoramaProviders"
+								r.pathPattern = "/v1/panorama/providers"
 								r.args = args
-								r.count = 1
+								r.count = 0
 								return r, true
 							default:
 								return
 							}
 						}
 
+						elem = origElem
 					}
-
-				case 'm': // Prefix: "multiplayer/"
-
-					if l := len("multiplayer/"); len(elem) >= l && elem[0:l] == "multiplayer/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					// Param: "id"
-					// Match until "/"
+					// Param: "token"
+					// Leaf parameter, slashes are prohibited
 					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
+					if idx >= 0 {
+						break
 					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
+					args[0] = elem
+					elem = ""
 
 					if len(elem) == 0 {
+						// Leaf node.
 						switch method {
 						case "GET":
-							r.name = GetMultiplayerGameOperation
-							r.summary = "Get multiplayer game by ID"
-							r.operationID = "getMultiplayerGame"
-							r.pathPattern = "/v1/multiplayer/{id}"
+							r.name = GetPanoramaOperation
+							r.summary = "Resolve panorama token"
+							r.operationID = "getPanorama"
+							r.pathPattern = "/v1/panorama/{token}"
 							r.args = args
 							r.count = 1
 							return r, true
@@ -1552,35 +2367,74 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
+
+				case 's': // Prefix: "s"
+
+					if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
 					switch elem[0] {
-					case '/': // Prefix: "/"
+					case 'i': // Prefix: "ingleplayer"
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("ingleplayer"); len(elem) >= l && elem[0:l] == "ingleplayer" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							break
+							switch method {
+							case "GET":
+								r.name = GetSingleplayerGamesOperation
+								r.summary = "Get all singleplayer user games"
+								r.operationID = "getSingleplayerGames"
+								r.pathPattern = "/v1/singleplayer"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = NewSingleplayerGameOperation
+								r.summary = "Create new singleplayer game"
+								r.operationID = "newSingleplayerGame"
+								r.pathPattern = "/v1/singleplayer"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
 						}
 						switch elem[0] {
-						case 'g': // Prefix: "guesses"
+						case '/': // Prefix: "/"
 
-							if l := len("guesses"); len(elem) >= l && elem[0:l] == "guesses" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
 							if len(elem) == 0 {
-								// Leaf node.
 								switch method {
 								case "GET":
-									r.name = GetMultiplayerGameGuessesOperation
-									r.summary = "Get multiplayer game guesses"
-									r.operationID = "getMultiplayerGameGuesses"
-									r.pathPattern = "/v1/multiplayer/{id}/guesses"
+									r.name = GetSingleplayerGameOperation
+									r.summary = "Get singleplayer game by ID"
+									r.operationID = "getSingleplayerGame"
+									r.pathPattern = "/v1/singleplayer/{id}"
 									r.args = args
 									r.count = 1
 									return r, true
@@ -1588,100 +2442,171 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									return
 								}
 							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
 
-						case 'r': // Prefix: "round"
-
-							if l := len("round"); len(elem) >= l && elem[0:l] == "round" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = GetMultiplayerRoundOperation
-									r.summary = "Get multiplayer game round"
-									r.operationID = "getMultiplayerRound"
-									r.pathPattern = "/v1/multiplayer/{id}/round"
-									r.args = args
-									r.count = 1
-									return r, true
-								case "POST":
-									r.name = NewMultiplayerRoundOperation
-									r.summary = "Get or generate multiplayer game round"
-									r.operationID = "newMultiplayerRound"
-									r.pathPattern = "/v1/multiplayer/{id}/round"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
+								if len(elem) == 0 {
+									break
 								}
-							}
+								switch elem[0] {
+								case 'c': // Prefix: "challenge"
 
-						}
+									if l := len("challenge"); len(elem) >= l && elem[0:l] == "challenge" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-					}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = NewSingleplayerChallengeOperation
+											r.summary = "Create challenge from singleplayer game"
+											r.operationID = "newSingleplayerChallenge"
+											r.pathPattern = "/v1/singleplayer/{id}/challenge"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
 
-				case 's': // Prefix: "singleplayer"
+								case 'e': // Prefix: "end"
 
-					if l := len("singleplayer"); len(elem) >= l && elem[0:l] == "singleplayer" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+									if l := len("end"); len(elem) >= l && elem[0:l] == "end" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = EndSingleplayerGameOperation
+											r.summary = "End singleplayer game"
+											r.operationID = "endSingleplayerGame"
+											r.pathPattern = "/v1/singleplayer/{id}/end"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'r': // Prefix: "round"
+
+									if l := len("round"); len(elem) >= l && elem[0:l] == "round" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = GetSingleplayerRoundOperation
+											r.summary = "Get singleplayer game round"
+											r.operationID = "getSingleplayerRound"
+											r.pathPattern = "/v1/singleplayer/{id}/round"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "POST":
+											r.name = NewSingleplayerRoundOperation
+											r.summary = "Create singleplayer game round"
+											r.operationID = "newSingleplayerRound"
+											r.pathPattern = "/v1/singleplayer/{id}/round"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/end"
+
+										if l := len("/end"); len(elem) >= l && elem[0:l] == "/end" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = EndSingleplayerRoundOperation
+												r.summary = "End singleplayer game round"
+												r.operationID = "endSingleplayerRound"
+												r.pathPattern = "/v1/singleplayer/{id}/round/end"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 's': // Prefix: "s"
+
+										if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetSingleplayerGameRoundsOperation
+												r.summary = "Get singleplayer game rounds"
+												r.operationID = "getSingleplayerGameRounds"
+												r.pathPattern = "/v1/singleplayer/{id}/rounds"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							}
 
-					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							r.name = GetSingleplayerGamesOperation
-							r.summary = "Get all singleplayer user games"
-							r.operationID = "getSingleplayerGames"
-							r.pathPattern = "/v1/singleplayer"
-							r.args = args
-							r.count = 0
-							return r, true
-						case "POST":
-							r.name = NewSingleplayerGameOperation
-							r.summary = "Create new singleplayer game"
-							r.operationID = "newSingleplayerGame"
-							r.pathPattern = "/v1/singleplayer"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
 						}
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					case 't': // Prefix: "treak"
+
+						if l := len("treak"); len(elem) >= l && elem[0:l] == "treak" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "id"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
-
 						if len(elem) == 0 {
 							switch method {
-							case "GET":
-								r.name = GetSingleplayerGameOperation
-								r.summary = "Get singleplayer game by ID"
-								r.operationID = "getSingleplayerGame"
-								r.pathPattern = "/v1/singleplayer/{id}"
+							case "POST":
+								r.name = NewStreakGameOperation
+								r.summary = "Create new country streak game"
+								r.operationID = "newStreakGame"
+								r.pathPattern = "/v1/streak"
 								r.args = args
-								r.count = 1
+								r.count = 0
 								return r, true
 							default:
 								return
@@ -1700,9 +2625,34 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 							switch elem[0] {
-							case 'e': // Prefix: "end"
+							case 'b': // Prefix: "best"
+								origElem := elem
+								if l := len("best"); len(elem) >= l && elem[0:l] == "best" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetBestStreakOperation
+										r.summary = "Get best country streak of user"
+										r.operationID = "getBestStreak"
+										r.pathPattern = "/v1/streak/best"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
 
-								if l := len("end"); len(elem) >= l && elem[0:l] == "end" {
+								elem = origElem
+							case 'l': // Prefix: "leaderboard"
+								origElem := elem
+								if l := len("leaderboard"); len(elem) >= l && elem[0:l] == "leaderboard" {
 									elem = elem[l:]
 								} else {
 									break
@@ -1711,22 +2661,48 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								if len(elem) == 0 {
 									// Leaf node.
 									switch method {
-									case "POST":
-										r.name = EndSingleplayerGameOperation
-										r.summary = "End singleplayer game"
-										r.operationID = "endSingleplayerGame"
-										r.pathPattern = "/v1/singleplayer/{id}/end"
+									case "GET":
+										r.name = GetStreakLeaderboardOperation
+										r.summary = "Get country streak leaderboard"
+										r.operationID = "getStreakLeaderboard"
+										r.pathPattern = "/v1/streak/leaderboard"
 										r.args = args
-										r.count = 1
+										r.count = 0
 										return r, true
 									default:
 										return
 									}
 								}
 
-							case 'r': // Prefix: "round"
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
 
-								if l := len("round"); len(elem) >= l && elem[0:l] == "round" {
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = GetStreakGameOperation
+									r.summary = "Get country streak game by ID"
+									r.operationID = "getStreakGame"
+									r.pathPattern = "/v1/streak/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/round"
+
+								if l := len("/round"); len(elem) >= l && elem[0:l] == "/round" {
 									elem = elem[l:]
 								} else {
 									break
@@ -1735,18 +2711,18 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								if len(elem) == 0 {
 									switch method {
 									case "GET":
-										r.name = GetSingleplayerRoundOperation
-										r.summary = "Get singleplayer game round"
-										r.operationID = "getSingleplayerRound"
-										r.pathPattern = "/v1/singleplayer/{id}/round"
+										r.name = GetStreakRoundOperation
+										r.summary = "Get country streak game round"
+										r.operationID = "getStreakRound"
+										r.pathPattern = "/v1/streak/{id}/round"
 										r.args = args
 										r.count = 1
 										return r, true
 									case "POST":
-										r.name = NewSingleplayerRoundOperation
-										r.summary = "Create singleplayer game round"
-										r.operationID = "newSingleplayerRound"
-										r.pathPattern = "/v1/singleplayer/{id}/round"
+										r.name = NewStreakRoundOperation
+										r.summary = "Create country streak game round"
+										r.operationID = "newStreakRound"
+										r.pathPattern = "/v1/streak/{id}/round"
 										r.args = args
 										r.count = 1
 										return r, true
@@ -1767,34 +2743,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										// Leaf node.
 										switch method {
 										case "POST":
-											r.name = EndSingleplayerRoundOperation
-											r.summary = "End singleplayer game round"
-											r.operationID = "endSingleplayerRound"
-											r.pathPattern = "/v1/singleplayer/{id}/round/end"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
-										}
-									}
-
-								case 's': // Prefix: "s"
-
-									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = GetSingleplayerGameRoundsOperation
-											r.summary = "Get singleplayer game rounds"
-											r.operationID = "getSingleplayerGameRounds"
-											r.pathPattern = "/v1/singleplayer/{id}/rounds"
+											r.name = EndStreakRoundOperation
+											r.summary = "End country streak game round"
+											r.operationID = "endStreakRound"
+											r.pathPattern = "/v1/streak/{id}/round/end"
 											r.args = args
 											r.count = 1
 											return r, true
@@ -10,6 +10,78 @@ import (
 	ht "github.com/ogen-go/ogen/http"
 )
 
+type AcceptSingleplayerChallengeBadRequest Error
+
+func (*AcceptSingleplayerChallengeBadRequest) acceptSingleplayerChallengeRes() {}
+
+type AcceptSingleplayerChallengeConflict Error
+
+func (*AcceptSingleplayerChallengeConflict) acceptSingleplayerChallengeRes() {}
+
+type AcceptSingleplayerChallengeCreated struct {
+	ID int `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *AcceptSingleplayerChallengeCreated) GetID() int {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *AcceptSingleplayerChallengeCreated) SetID(val int) {
+	s.ID = val
+}
+
+func (*AcceptSingleplayerChallengeCreated) acceptSingleplayerChallengeRes() {}
+
+type AcceptSingleplayerChallengeInternalServerError Error
+
+func (*AcceptSingleplayerChallengeInternalServerError) acceptSingleplayerChallengeRes() {}
+
+type AcceptSingleplayerChallengeNotFound Error
+
+func (*AcceptSingleplayerChallengeNotFound) acceptSingleplayerChallengeRes() {}
+
+type AcceptSingleplayerChallengeUnauthorized Error
+
+func (*AcceptSingleplayerChallengeUnauthorized) acceptSingleplayerChallengeRes() {}
+
+type AddMapLocationsBadRequest Error
+
+func (*AddMapLocationsBadRequest) addMapLocationsRes() {}
+
+type AddMapLocationsForbidden Error
+
+func (*AddMapLocationsForbidden) addMapLocationsRes() {}
+
+type AddMapLocationsInternalServerError Error
+
+func (*AddMapLocationsInternalServerError) addMapLocationsRes() {}
+
+type AddMapLocationsNotFound Error
+
+func (*AddMapLocationsNotFound) addMapLocationsRes() {}
+
+// Ref: #/AddMapLocationsRequest
+type AddMapLocationsRequest struct {
+	// IDs of existing panorama locations of the map provider.
+	LocationIDs []int `json:"locationIDs"`
+}
+
+// GetLocationIDs returns the value of LocationIDs.
+func (s *AddMapLocationsRequest) GetLocationIDs() []int {
+	return s.LocationIDs
+}
+
+// SetLocationIDs sets the value of LocationIDs.
+func (s *AddMapLocationsRequest) SetLocationIDs(val []int) {
+	s.LocationIDs = val
+}
+
+type AddMapLocationsUnauthorized Error
+
+func (*AddMapLocationsUnauthorized) addMapLocationsRes() {}
+
 // Ref: #/AuthProvider
 type AuthProvider struct {
 	Provider  string    `json:"provider"`
@@ -50,6 +122,157 @@ func (s *Bearer) SetToken(val string) {
 	s.Token = val
 }
 
+// Ref: #/BestStreak
+type BestStreak struct {
+	// The longest streak among finished games of the user, 0 if there are none.
+	Streak  int         `json:"streak"`
+	GameID  OptInt      `json:"gameID"`
+	EndedAt OptDateTime `json:"endedAt"`
+}
+
+// GetStreak returns the value of Streak.
+func (s *BestStreak) GetStreak() int {
+	return s.Streak
+}
+
+// GetGameID returns the value of GameID.
+func (s *BestStreak) GetGameID() OptInt {
+	return s.GameID
+}
+
+// GetEndedAt returns the value of EndedAt.
+func (s *BestStreak) GetEndedAt() OptDateTime {
+	return s.EndedAt
+}
+
+// SetStreak sets the value of Streak.
+func (s *BestStreak) SetStreak(val int) {
+	s.Streak = val
+}
+
+// SetGameID sets the value of GameID.
+func (s *BestStreak) SetGameID(val OptInt) {
+	s.GameID = val
+}
+
+// SetEndedAt sets the value of EndedAt.
+func (s *BestStreak) SetEndedAt(val OptDateTime) {
+	s.EndedAt = val
+}
+
+func (*BestStreak) getBestStreakRes() {}
+
+type CountryCode string
+
+// Ref: #/DailyChallengeLeaderboard
+type DailyChallengeLeaderboard struct {
+	Total   int                    `json:"total"`
+	Results []DailyChallengeResult `json:"results"`
+}
+
+// GetTotal returns the value of Total.
+func (s *DailyChallengeLeaderboard) GetTotal() int {
+	return s.Total
+}
+
+// GetResults returns the value of Results.
+func (s *DailyChallengeLeaderboard) GetResults() []DailyChallengeResult {
+	return s.Results
+}
+
+// SetTotal sets the value of Total.
+func (s *DailyChallengeLeaderboard) SetTotal(val int) {
+	s.Total = val
+}
+
+// SetResults sets the value of Results.
+func (s *DailyChallengeLeaderboard) SetResults(val []DailyChallengeResult) {
+	s.Results = val
+}
+
+func (*DailyChallengeLeaderboard) getDailyChallengeLeaderboardRes() {}
+
+// Ref: #/DailyChallengeResult
+type DailyChallengeResult struct {
+	Place      int       `json:"place"`
+	UserID     int       `json:"userID"`
+	Username   string    `json:"username"`
+	AvatarHash string    `json:"avatarHash"`
+	GameID     int       `json:"gameID"`
+	Score      int       `json:"score"`
+	EndedAt    time.Time `json:"endedAt"`
+}
+
+// GetPlace returns the value of Place.
+func (s *DailyChallengeResult) GetPlace() int {
+	return s.Place
+}
+
+// GetUserID returns the value of UserID.
+func (s *DailyChallengeResult) GetUserID() int {
+	return s.UserID
+}
+
+// GetUsername returns the value of Username.
+func (s *DailyChallengeResult) GetUsername() string {
+	return s.Username
+}
+
+// GetAvatarHash returns the value of AvatarHash.
+func (s *DailyChallengeResult) GetAvatarHash() string {
+	return s.AvatarHash
+}
+
+// GetGameID returns the value of GameID.
+func (s *DailyChallengeResult) GetGameID() int {
+	return s.GameID
+}
+
+// GetScore returns the value of Score.
+func (s *DailyChallengeResult) GetScore() int {
+	return s.Score
+}
+
+// GetEndedAt returns the value of EndedAt.
+func (s *DailyChallengeResult) GetEndedAt() time.Time {
+	return s.EndedAt
+}
+
+// SetPlace sets the value of Place.
+func (s *DailyChallengeResult) SetPlace(val int) {
+	s.Place = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *DailyChallengeResult) SetUserID(val int) {
+	s.UserID = val
+}
+
+// SetUsername sets the value of Username.
+func (s *DailyChallengeResult) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetAvatarHash sets the value of AvatarHash.
+func (s *DailyChallengeResult) SetAvatarHash(val string) {
+	s.AvatarHash = val
+}
+
+// SetGameID sets the value of GameID.
+func (s *DailyChallengeResult) SetGameID(val int) {
+	s.GameID = val
+}
+
+// SetScore sets the value of Score.
+func (s *DailyChallengeResult) SetScore(val int) {
+	s.Score = val
+}
+
+// SetEndedAt sets the value of EndedAt.
+func (s *DailyChallengeResult) SetEndedAt(val time.Time) {
+	s.EndedAt = val
+}
+
 type DeleteDiscordInternalServerError Error
 
 func (*DeleteDiscordInternalServerError) deleteDiscordRes() {}
@@ -190,8 +413,10 @@ func (*EndSingleplayerRoundNotFound) endSingleplayerRoundRes() {}
 
 // Ref: #/EndSingleplayerRoundResponse
 type EndSingleplayerRoundResponse struct {
-	Score    int `json:"score"`
-	Distance int `json:"distance"`
+	Score          int            `json:"score"`
+	ScoreBreakdown ScoreBreakdown `json:"scoreBreakdown"`
+	Distance       int            `json:"distance"`
+	Location       LatLng         `json:"location"`
 }
 
 // GetScore returns the value of Score.
@@ -199,27 +424,142 @@ func (s *EndSingleplayerRoundResponse) GetScore() int {
 	return s.Score
 }
 
+// GetScoreBreakdown returns the value of ScoreBreakdown.
+func (s *EndSingleplayerRoundResponse) GetScoreBreakdown() ScoreBreakdown {
+	return s.ScoreBreakdown
+}
+
 // GetDistance returns the value of Distance.
 func (s *EndSingleplayerRoundResponse) GetDistance() int {
 	return s.Distance
 }
 
+// GetLocation returns the value of Location.
+func (s *EndSingleplayerRoundResponse) GetLocation() LatLng {
+	return s.Location
+}
+
 // SetScore sets the value of Score.
 func (s *EndSingleplayerRoundResponse) SetScore(val int) {
 	s.Score = val
 }
 
+// SetScoreBreakdown sets the value of ScoreBreakdown.
+func (s *EndSingleplayerRoundResponse) SetScoreBreakdown(val ScoreBreakdown) {
+	s.ScoreBreakdown = val
+}
+
 // SetDistance sets the value of Distance.
 func (s *EndSingleplayerRoundResponse) SetDistance(val int) {
 	s.Distance = val
 }
 
+// SetLocation sets the value of Location.
+func (s *EndSingleplayerRoundResponse) SetLocation(val LatLng) {
+	s.Location = val
+}
+
 func (*EndSingleplayerRoundResponse) endSingleplayerRoundRes() {}
 
 type EndSingleplayerRoundUnauthorized Error
 
 func (*EndSingleplayerRoundUnauthorized) endSingleplayerRoundRes() {}
 
+type EndStreakRoundBadRequest Error
+
+func (*EndStreakRoundBadRequest) endStreakRoundRes() {}
+
+type EndStreakRoundForbidden Error
+
+func (*EndStreakRoundForbidden) endStreakRoundRes() {}
+
+type EndStreakRoundInternalServerError Error
+
+func (*EndStreakRoundInternalServerError) endStreakRoundRes() {}
+
+type EndStreakRoundNotFound Error
+
+func (*EndStreakRoundNotFound) endStreakRoundRes() {}
+
+// Ref: #/EndStreakRoundResponse
+type EndStreakRoundResponse struct {
+	// Whether the guessed country is the country of the round location.
+	Correct      bool           `json:"correct"`
+	Country      CountryCode    `json:"country"`
+	GuessCountry OptCountryCode `json:"guessCountry"`
+	// Streak of the game after the round.
+	Streak int `json:"streak"`
+	// Whether the game has ended with this round.
+	Finished bool   `json:"finished"`
+	Location LatLng `json:"location"`
+}
+
+// GetCorrect returns the value of Correct.
+func (s *EndStreakRoundResponse) GetCorrect() bool {
+	return s.Correct
+}
+
+// GetCountry returns the value of Country.
+func (s *EndStreakRoundResponse) GetCountry() CountryCode {
+	return s.Country
+}
+
+// GetGuessCountry returns the value of GuessCountry.
+func (s *EndStreakRoundResponse) GetGuessCountry() OptCountryCode {
+	return s.GuessCountry
+}
+
+// GetStreak returns the value of Streak.
+func (s *EndStreakRoundResponse) GetStreak() int {
+	return s.Streak
+}
+
+// GetFinished returns the value of Finished.
+func (s *EndStreakRoundResponse) GetFinished() bool {
+	return s.Finished
+}
+
+// GetLocation returns the value of Location.
+func (s *EndStreakRoundResponse) GetLocation() LatLng {
+	return s.Location
+}
+
+// SetCorrect sets the value of Correct.
+func (s *EndStreakRoundResponse) SetCorrect(val bool) {
+	s.Correct = val
+}
+
+// SetCountry sets the value of Country.
+func (s *EndStreakRoundResponse) SetCountry(val CountryCode) {
+	s.Country = val
+}
+
+// SetGuessCountry sets the value of GuessCountry.
+func (s *EndStreakRoundResponse) SetGuessCountry(val OptCountryCode) {
+	s.GuessCountry = val
+}
+
+// SetStreak sets the value of Streak.
+func (s *EndStreakRoundResponse) SetStreak(val int) {
+	s.Streak = val
+}
+
+// SetFinished sets the value of Finished.
+func (s *EndStreakRoundResponse) SetFinished(val bool) {
+	s.Finished = val
+}
+
+// SetLocation sets the value of Location.
+func (s *EndStreakRoundResponse) SetLocation(val LatLng) {
+	s.Location = val
+}
+
+func (*EndStreakRoundResponse) endStreakRoundRes() {}
+
+type EndStreakRoundUnauthorized Error
+
+func (*EndStreakRoundUnauthorized) endStreakRoundRes() {}
+
 // An RFC 7807/RFC 9457 application/problem+json object.
 // Ref: #/Error
 type Error struct {
@@ -261,11 +601,30 @@ func (s *Error) SetDetail(val string) {
 	s.Detail = val
 }
 
-func (*Error) deleteUserSessionRes() {}
-func (*Error) getLobbiesRes()        {}
-func (*Error) getUserSessionsRes()   {}
-func (*Error) newDiscordRes()        {}
-func (*Error) newYandexRes()         {}
+func (*Error) deleteUserSessionRes()    {}
+func (*Error) getLobbiesRes()           {}
+func (*Error) getPanoramaProvidersRes() {}
+func (*Error) getUserSessionsRes()      {}
+func (*Error) newDiscordRes()           {}
+func (*Error) newYandexRes()            {}
+
+type GameProvider string
+
+type GetBestStreakInternalServerError Error
+
+func (*GetBestStreakInternalServerError) getBestStreakRes() {}
+
+type GetBestStreakUnauthorized Error
+
+func (*GetBestStreakUnauthorized) getBestStreakRes() {}
+
+type GetDailyChallengeLeaderboardBadRequest Error
+
+func (*GetDailyChallengeLeaderboardBadRequest) getDailyChallengeLeaderboardRes() {}
+
+type GetDailyChallengeLeaderboardInternalServerError Error
+
+func (*GetDailyChallengeLeaderboardInternalServerError) getDailyChallengeLeaderboardRes() {}
 
 type GetHealthOK struct {
 	Status string `json:"status"`
@@ -289,18 +648,50 @@ type GetLobbyNotFound Error
 
 func (*GetLobbyNotFound) getLobbyRes() {}
 
+type GetMapInternalServerError Error
+
+func (*GetMapInternalServerError) getMapRes() {}
+
+type GetMapNotFound Error
+
+func (*GetMapNotFound) getMapRes() {}
+
+type GetMapUnauthorized Error
+
+func (*GetMapUnauthorized) getMapRes() {}
+
+type GetMapsInternalServerError Error
+
+func (*GetMapsInternalServerError) getMapsRes() {}
+
+type GetMapsUnauthorized Error
+
+func (*GetMapsUnauthorized) getMapsRes() {}
+
 type GetMultiplayerGameGuessesBadRequest Error
 
 func (*GetMultiplayerGameGuessesBadRequest) getMultiplayerGameGuessesRes() {}
 
+type GetMultiplayerGameGuessesForbidden Error
+
+func (*GetMultiplayerGameGuessesForbidden) getMultiplayerGameGuessesRes() {}
+
 type GetMultiplayerGameGuessesInternalServerError Error
 
 func (*GetMultiplayerGameGuessesInternalServerError) getMultiplayerGameGuessesRes() {}
 
+type GetMultiplayerGameGuessesNotFound Error
+
+func (*GetMultiplayerGameGuessesNotFound) getMultiplayerGameGuessesRes() {}
+
 type GetMultiplayerGameGuessesOKApplicationJSON []MultiplayerGuess
 
 func (*GetMultiplayerGameGuessesOKApplicationJSON) getMultiplayerGameGuessesRes() {}
 
+type GetMultiplayerGameGuessesUnauthorized Error
+
+func (*GetMultiplayerGameGuessesUnauthorized) getMultiplayerGameGuessesRes() {}
+
 type GetMultiplayerGameInternalServerError Error
 
 func (*GetMultiplayerGameInternalServerError) getMultiplayerGameRes() {}
@@ -337,6 +728,22 @@ type GetOAuthProvidersUnauthorized Error
 
 func (*GetOAuthProvidersUnauthorized) getOAuthProvidersRes() {}
 
+type GetPanoramaBadRequest Error
+
+func (*GetPanoramaBadRequest) getPanoramaRes() {}
+
+type GetPanoramaInternalServerError Error
+
+func (*GetPanoramaInternalServerError) getPanoramaRes() {}
+
+type GetPanoramaProvidersOKApplicationJSON []Provider
+
+func (*GetPanoramaProvidersOKApplicationJSON) getPanoramaProvidersRes() {}
+
+type GetPanoramaUnauthorized Error
+
+func (*GetPanoramaUnauthorized) getPanoramaRes() {}
+
 type GetPrivateProfileInternalServerError Error
 
 func (*GetPrivateProfileInternalServerError) getPrivateProfileRes() {}
@@ -368,6 +775,22 @@ func (s *GetRootFound) SetLocation(val string) {
 	s.Location = val
 }
 
+type GetSingleplayerChallengeBadRequest Error
+
+func (*GetSingleplayerChallengeBadRequest) getSingleplayerChallengeRes() {}
+
+type GetSingleplayerChallengeInternalServerError Error
+
+func (*GetSingleplayerChallengeInternalServerError) getSingleplayerChallengeRes() {}
+
+type GetSingleplayerChallengeNotFound Error
+
+func (*GetSingleplayerChallengeNotFound) getSingleplayerChallengeRes() {}
+
+type GetSingleplayerChallengeUnauthorized Error
+
+func (*GetSingleplayerChallengeUnauthorized) getSingleplayerChallengeRes() {}
+
 type GetSingleplayerGameForbidden Error
 
 func (*GetSingleplayerGameForbidden) getSingleplayerGameRes() {}
@@ -440,6 +863,46 @@ type GetSingleplayerRoundUnauthorized Error
 
 func (*GetSingleplayerRoundUnauthorized) getSingleplayerRoundRes() {}
 
+type GetStreakGameForbidden Error
+
+func (*GetStreakGameForbidden) getStreakGameRes() {}
+
+type GetStreakGameInternalServerError Error
+
+func (*GetStreakGameInternalServerError) getStreakGameRes() {}
+
+type GetStreakGameNotFound Error
+
+func (*GetStreakGameNotFound) getStreakGameRes() {}
+
+type GetStreakGameUnauthorized Error
+
+func (*GetStreakGameUnauthorized) getStreakGameRes() {}
+
+type GetStreakLeaderboardBadRequest Error
+
+func (*GetStreakLeaderboardBadRequest) getStreakLeaderboardRes() {}
+
+type GetStreakLeaderboardInternalServerError Error
+
+func (*GetStreakLeaderboardInternalServerError) getStreakLeaderboardRes() {}
+
+type GetStreakRoundForbidden Error
+
+func (*GetStreakRoundForbidden) getStreakRoundRes() {}
+
+type GetStreakRoundInternalServerError Error
+
+func (*GetStreakRoundInternalServerError) getStreakRoundRes() {}
+
+type GetStreakRoundNotFound Error
+
+func (*GetStreakRoundNotFound) getStreakRoundRes() {}
+
+type GetStreakRoundUnauthorized Error
+
+func (*GetStreakRoundUnauthorized) getStreakRoundRes() {}
+
 type GetUserSessionsOKApplicationJSON []GetUserSessionsOKItem
 
 func (*GetUserSessionsOKApplicationJSON) getUserSessionsRes() {}
@@ -502,14 +965,107 @@ func (s *GetUserSessionsOKItem) SetLastActive(val time.Time) {
 	s.LastActive = val
 }
 
-// Ref: #/LatLng
-type LatLng struct {
-	Lat float64 `json:"lat"`
-	Lng float64 `json:"lng"`
-}
+type ImportMapLocationsBadRequest Error
 
-// GetLat returns the value of Lat.
-func (s *LatLng) GetLat() float64 {
+func (*ImportMapLocationsBadRequest) importMapLocationsRes() {}
+
+type ImportMapLocationsForbidden Error
+
+func (*ImportMapLocationsForbidden) importMapLocationsRes() {}
+
+type ImportMapLocationsInternalServerError Error
+
+func (*ImportMapLocationsInternalServerError) importMapLocationsRes() {}
+
+type ImportMapLocationsNotFound Error
+
+func (*ImportMapLocationsNotFound) importMapLocationsRes() {}
+
+// Ref: #/ImportMapLocationsRequest
+type ImportMapLocationsRequest struct {
+	// Format of the data. CSV rows are "lat,lng" or "lat,lng,streetviewID", optionally with a header.
+	// GeoJSON must be a FeatureCollection of Point features, streetview ID is read from "streetviewID"
+	// property.
+	Format ImportMapLocationsRequestFormat `json:"format"`
+	Data   string                          `json:"data"`
+}
+
+// GetFormat returns the value of Format.
+func (s *ImportMapLocationsRequest) GetFormat() ImportMapLocationsRequestFormat {
+	return s.Format
+}
+
+// GetData returns the value of Data.
+func (s *ImportMapLocationsRequest) GetData() string {
+	return s.Data
+}
+
+// SetFormat sets the value of Format.
+func (s *ImportMapLocationsRequest) SetFormat(val ImportMapLocationsRequestFormat) {
+	s.Format = val
+}
+
+// SetData sets the value of Data.
+func (s *ImportMapLocationsRequest) SetData(val string) {
+	s.Data = val
+}
+
+// Format of the data. CSV rows are "lat,lng" or "lat,lng,streetviewID", optionally with a header.
+// GeoJSON must be a FeatureCollection of Point features, streetview ID is read from "streetviewID"
+// property.
+type ImportMapLocationsRequestFormat string
+
+const (
+	ImportMapLocationsRequestFormatCsv     ImportMapLocationsRequestFormat = "csv"
+	ImportMapLocationsRequestFormatGeojson ImportMapLocationsRequestFormat = "geojson"
+)
+
+// AllValues returns all ImportMapLocationsRequestFormat values.
+func (ImportMapLocationsRequestFormat) AllValues() []ImportMapLocationsRequestFormat {
+	return []ImportMapLocationsRequestFormat{
+		ImportMapLocationsRequestFormatCsv,
+		ImportMapLocationsRequestFormatGeojson,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ImportMapLocationsRequestFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case ImportMapLocationsRequestFormatCsv:
+		return []byte(s), nil
+	case ImportMapLocationsRequestFormatGeojson:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ImportMapLocationsRequestFormat) UnmarshalText(data []byte) error {
+	switch ImportMapLocationsRequestFormat(data) {
+	case ImportMapLocationsRequestFormatCsv:
+		*s = ImportMapLocationsRequestFormatCsv
+		return nil
+	case ImportMapLocationsRequestFormatGeojson:
+		*s = ImportMapLocationsRequestFormatGeojson
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ImportMapLocationsUnauthorized Error
+
+func (*ImportMapLocationsUnauthorized) importMapLocationsRes() {}
+
+// Ref: #/LatLng
+type LatLng struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// GetLat returns the value of Lat.
+func (s *LatLng) GetLat() float64 {
 	return s.Lat
 }
 
@@ -558,15 +1114,31 @@ func (*LobbiesResponse) getLobbiesRes() {}
 
 // Ref: #/Lobby
 type Lobby struct {
-	ID              string    `json:"id"`
-	CreatorID       int       `json:"creatorID"`
-	CreatedAt       time.Time `json:"createdAt"`
-	Rounds          int       `json:"rounds"`
-	Provider        Provider  `json:"provider"`
-	MovementAllowed bool      `json:"movementAllowed"`
-	TimerSeconds    int       `json:"timerSeconds"`
-	CurrentPlayers  int       `json:"currentPlayers"`
-	MaxPlayers      int       `json:"maxPlayers"`
+	ID              string       `json:"id"`
+	CreatorID       int          `json:"creatorID"`
+	CreatedAt       time.Time    `json:"createdAt"`
+	Rounds          int          `json:"rounds"`
+	Provider        GameProvider `json:"provider"`
+	Providers       Providers    `json:"providers"`
+	MovementAllowed bool         `json:"movementAllowed"`
+	TimerSeconds    int          `json:"timerSeconds"`
+	CurrentPlayers  int          `json:"currentPlayers"`
+	MaxPlayers      int          `json:"maxPlayers"`
+	// ID of the map games of the lobby are played on, not set for games on all provider locations.
+	MapID         OptInt           `json:"mapID"`
+	ScoreDistance OptScoreDistance `json:"scoreDistance"`
+	Scoring       ScoringMode      `json:"scoring"`
+	Mode          MultiplayerMode  `json:"mode"`
+	// Amount of teams players are split into, not set if players play individually.
+	Teams       OptInt         `json:"teams"`
+	TeamScoring OptTeamScoring `json:"teamScoring"`
+	// Whether users outside of games started from the lobby can watch them.
+	Spectators bool `json:"spectators"`
+	// Delay of round results sent to spectators, not set if results are sent without delay.
+	SpectatorDelaySeconds OptInt          `json:"spectatorDelaySeconds"`
+	Visibility            LobbyVisibility `json:"visibility"`
+	// Whether every connected player must be ready before the game can be started.
+	RequireReady bool `json:"requireReady"`
 }
 
 // GetID returns the value of ID.
@@ -590,10 +1162,15 @@ func (s *Lobby) GetRounds() int {
 }
 
 // GetProvider returns the value of Provider.
-func (s *Lobby) GetProvider() Provider {
+func (s *Lobby) GetProvider() GameProvider {
 	return s.Provider
 }
 
+// GetProviders returns the value of Providers.
+func (s *Lobby) GetProviders() Providers {
+	return s.Providers
+}
+
 // GetMovementAllowed returns the value of MovementAllowed.
 func (s *Lobby) GetMovementAllowed() bool {
 	return s.MovementAllowed
@@ -614,6 +1191,56 @@ func (s *Lobby) GetMaxPlayers() int {
 	return s.MaxPlayers
 }
 
+// GetMapID returns the value of MapID.
+func (s *Lobby) GetMapID() OptInt {
+	return s.MapID
+}
+
+// GetScoreDistance returns the value of ScoreDistance.
+func (s *Lobby) GetScoreDistance() OptScoreDistance {
+	return s.ScoreDistance
+}
+
+// GetScoring returns the value of Scoring.
+func (s *Lobby) GetScoring() ScoringMode {
+	return s.Scoring
+}
+
+// GetMode returns the value of Mode.
+func (s *Lobby) GetMode() MultiplayerMode {
+	return s.Mode
+}
+
+// GetTeams returns the value of Teams.
+func (s *Lobby) GetTeams() OptInt {
+	return s.Teams
+}
+
+// GetTeamScoring returns the value of TeamScoring.
+func (s *Lobby) GetTeamScoring() OptTeamScoring {
+	return s.TeamScoring
+}
+
+// GetSpectators returns the value of Spectators.
+func (s *Lobby) GetSpectators() bool {
+	return s.Spectators
+}
+
+// GetSpectatorDelaySeconds returns the value of SpectatorDelaySeconds.
+func (s *Lobby) GetSpectatorDelaySeconds() OptInt {
+	return s.SpectatorDelaySeconds
+}
+
+// GetVisibility returns the value of Visibility.
+func (s *Lobby) GetVisibility() LobbyVisibility {
+	return s.Visibility
+}
+
+// GetRequireReady returns the value of RequireReady.
+func (s *Lobby) GetRequireReady() bool {
+	return s.RequireReady
+}
+
 // SetID sets the value of ID.
 func (s *Lobby) SetID(val string) {
 	s.ID = val
@@ -635,10 +1262,15 @@ func (s *Lobby) SetRounds(val int) {
 }
 
 // SetProvider sets the value of Provider.
-func (s *Lobby) SetProvider(val Provider) {
+func (s *Lobby) SetProvider(val GameProvider) {
 	s.Provider = val
 }
 
+// SetProviders sets the value of Providers.
+func (s *Lobby) SetProviders(val Providers) {
+	s.Providers = val
+}
+
 // SetMovementAllowed sets the value of MovementAllowed.
 func (s *Lobby) SetMovementAllowed(val bool) {
 	s.MovementAllowed = val
@@ -659,8 +1291,176 @@ func (s *Lobby) SetMaxPlayers(val int) {
 	s.MaxPlayers = val
 }
 
+// SetMapID sets the value of MapID.
+func (s *Lobby) SetMapID(val OptInt) {
+	s.MapID = val
+}
+
+// SetScoreDistance sets the value of ScoreDistance.
+func (s *Lobby) SetScoreDistance(val OptScoreDistance) {
+	s.ScoreDistance = val
+}
+
+// SetScoring sets the value of Scoring.
+func (s *Lobby) SetScoring(val ScoringMode) {
+	s.Scoring = val
+}
+
+// SetMode sets the value of Mode.
+func (s *Lobby) SetMode(val MultiplayerMode) {
+	s.Mode = val
+}
+
+// SetTeams sets the value of Teams.
+func (s *Lobby) SetTeams(val OptInt) {
+	s.Teams = val
+}
+
+// SetTeamScoring sets the value of TeamScoring.
+func (s *Lobby) SetTeamScoring(val OptTeamScoring) {
+	s.TeamScoring = val
+}
+
+// SetSpectators sets the value of Spectators.
+func (s *Lobby) SetSpectators(val bool) {
+	s.Spectators = val
+}
+
+// SetSpectatorDelaySeconds sets the value of SpectatorDelaySeconds.
+func (s *Lobby) SetSpectatorDelaySeconds(val OptInt) {
+	s.SpectatorDelaySeconds = val
+}
+
+// SetVisibility sets the value of Visibility.
+func (s *Lobby) SetVisibility(val LobbyVisibility) {
+	s.Visibility = val
+}
+
+// SetRequireReady sets the value of RequireReady.
+func (s *Lobby) SetRequireReady(val bool) {
+	s.RequireReady = val
+}
+
 func (*Lobby) getLobbyRes() {}
 
+// Order of lobbies in the list - by creation time ("newest", "oldest") or by amount of connected
+// players.
+// Ref: #/LobbySort
+type LobbySort string
+
+const (
+	LobbySortNewest        LobbySort = "newest"
+	LobbySortOldest        LobbySort = "oldest"
+	LobbySortMostPlayers   LobbySort = "most_players"
+	LobbySortFewestPlayers LobbySort = "fewest_players"
+)
+
+// AllValues returns all LobbySort values.
+func (LobbySort) AllValues() []LobbySort {
+	return []LobbySort{
+		LobbySortNewest,
+		LobbySortOldest,
+		LobbySortMostPlayers,
+		LobbySortFewestPlayers,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s LobbySort) MarshalText() ([]byte, error) {
+	switch s {
+	case LobbySortNewest:
+		return []byte(s), nil
+	case LobbySortOldest:
+		return []byte(s), nil
+	case LobbySortMostPlayers:
+		return []byte(s), nil
+	case LobbySortFewestPlayers:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *LobbySort) UnmarshalText(data []byte) error {
+	switch LobbySort(data) {
+	case LobbySortNewest:
+		*s = LobbySortNewest
+		return nil
+	case LobbySortOldest:
+		*s = LobbySortOldest
+		return nil
+	case LobbySortMostPlayers:
+		*s = LobbySortMostPlayers
+		return nil
+	case LobbySortFewestPlayers:
+		*s = LobbySortFewestPlayers
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Who can find and join a lobby: "public" lobbies are listed and can be joined by anyone,
+// "unlisted" lobbies can only be joined by link, "password" lobbies are listed, but require a
+// password to join,
+// "invite_only" lobbies are not listed and can only be joined by invited users.
+// Ref: #/LobbyVisibility
+type LobbyVisibility string
+
+const (
+	LobbyVisibilityPublic     LobbyVisibility = "public"
+	LobbyVisibilityUnlisted   LobbyVisibility = "unlisted"
+	LobbyVisibilityPassword   LobbyVisibility = "password"
+	LobbyVisibilityInviteOnly LobbyVisibility = "invite_only"
+)
+
+// AllValues returns all LobbyVisibility values.
+func (LobbyVisibility) AllValues() []LobbyVisibility {
+	return []LobbyVisibility{
+		LobbyVisibilityPublic,
+		LobbyVisibilityUnlisted,
+		LobbyVisibilityPassword,
+		LobbyVisibilityInviteOnly,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s LobbyVisibility) MarshalText() ([]byte, error) {
+	switch s {
+	case LobbyVisibilityPublic:
+		return []byte(s), nil
+	case LobbyVisibilityUnlisted:
+		return []byte(s), nil
+	case LobbyVisibilityPassword:
+		return []byte(s), nil
+	case LobbyVisibilityInviteOnly:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *LobbyVisibility) UnmarshalText(data []byte) error {
+	switch LobbyVisibility(data) {
+	case LobbyVisibilityPublic:
+		*s = LobbyVisibilityPublic
+		return nil
+	case LobbyVisibilityUnlisted:
+		*s = LobbyVisibilityUnlisted
+		return nil
+	case LobbyVisibilityPassword:
+		*s = LobbyVisibilityPassword
+		return nil
+	case LobbyVisibilityInviteOnly:
+		*s = LobbyVisibilityInviteOnly
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 type LoginBadRequest Error
 
 func (*LoginBadRequest) loginRes() {}
@@ -716,1267 +1516,3632 @@ type LoginUnauthorized Error
 
 func (*LoginUnauthorized) loginRes() {}
 
-// Ref: #/MultiplayerGame
-type MultiplayerGame struct {
-	ID              int       `json:"id"`
-	CreatorID       int       `json:"creatorID"`
-	Rounds          int       `json:"rounds"`
-	RoundCurrent    int       `json:"roundCurrent"`
-	TimerSeconds    int       `json:"timerSeconds"`
-	MovementAllowed bool      `json:"movementAllowed"`
-	Players         int       `json:"players"`
-	Provider        Provider  `json:"provider"`
-	Finished        bool      `json:"finished"`
-	CreatedAt       time.Time `json:"createdAt"`
+// Ref: #/Map
+type Map struct {
+	ID             int           `json:"id"`
+	UserID         int           `json:"userID"`
+	Name           string        `json:"name"`
+	Description    string        `json:"description"`
+	Provider       Provider      `json:"provider"`
+	Visibility     MapVisibility `json:"visibility"`
+	LocationsCount int           `json:"locationsCount"`
+	CreatedAt      time.Time     `json:"createdAt"`
+	UpdatedAt      time.Time     `json:"updatedAt"`
 }
 
 // GetID returns the value of ID.
-func (s *MultiplayerGame) GetID() int {
+func (s *Map) GetID() int {
 	return s.ID
 }
 
-// GetCreatorID returns the value of CreatorID.
-func (s *MultiplayerGame) GetCreatorID() int {
-	return s.CreatorID
-}
-
-// GetRounds returns the value of Rounds.
-func (s *MultiplayerGame) GetRounds() int {
-	return s.Rounds
-}
-
-// GetRoundCurrent returns the value of RoundCurrent.
-func (s *MultiplayerGame) GetRoundCurrent() int {
-	return s.RoundCurrent
-}
-
-// GetTimerSeconds returns the value of TimerSeconds.
-func (s *MultiplayerGame) GetTimerSeconds() int {
-	return s.TimerSeconds
+// GetUserID returns the value of UserID.
+func (s *Map) GetUserID() int {
+	return s.UserID
 }
 
-// GetMovementAllowed returns the value of MovementAllowed.
-func (s *MultiplayerGame) GetMovementAllowed() bool {
-	return s.MovementAllowed
+// GetName returns the value of Name.
+func (s *Map) GetName() string {
+	return s.Name
 }
 
-// GetPlayers returns the value of Players.
-func (s *MultiplayerGame) GetPlayers() int {
-	return s.Players
+// GetDescription returns the value of Description.
+func (s *Map) GetDescription() string {
+	return s.Description
 }
 
 // GetProvider returns the value of Provider.
-func (s *MultiplayerGame) GetProvider() Provider {
+func (s *Map) GetProvider() Provider {
 	return s.Provider
 }
 
-// GetFinished returns the value of Finished.
-func (s *MultiplayerGame) GetFinished() bool {
-	return s.Finished
-}
-
-// GetCreatedAt returns the value of CreatedAt.
-func (s *MultiplayerGame) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// GetVisibility returns the value of Visibility.
+func (s *Map) GetVisibility() MapVisibility {
+	return s.Visibility
 }
 
-// SetID sets the value of ID.
-func (s *MultiplayerGame) SetID(val int) {
-	s.ID = val
+// GetLocationsCount returns the value of LocationsCount.
+func (s *Map) GetLocationsCount() int {
+	return s.LocationsCount
 }
 
-// SetCreatorID sets the value of CreatorID.
-func (s *MultiplayerGame) SetCreatorID(val int) {
-	s.CreatorID = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Map) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// SetRounds sets the value of Rounds.
-func (s *MultiplayerGame) SetRounds(val int) {
-	s.Rounds = val
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Map) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
 }
 
-// SetRoundCurrent sets the value of RoundCurrent.
-func (s *MultiplayerGame) SetRoundCurrent(val int) {
-	s.RoundCurrent = val
+// SetID sets the value of ID.
+func (s *Map) SetID(val int) {
+	s.ID = val
 }
 
-// SetTimerSeconds sets the value of TimerSeconds.
-func (s *MultiplayerGame) SetTimerSeconds(val int) {
-	s.TimerSeconds = val
+// SetUserID sets the value of UserID.
+func (s *Map) SetUserID(val int) {
+	s.UserID = val
 }
 
-// SetMovementAllowed sets the value of MovementAllowed.
-func (s *MultiplayerGame) SetMovementAllowed(val bool) {
-	s.MovementAllowed = val
+// SetName sets the value of Name.
+func (s *Map) SetName(val string) {
+	s.Name = val
 }
 
-// SetPlayers sets the value of Players.
-func (s *MultiplayerGame) SetPlayers(val int) {
-	s.Players = val
+// SetDescription sets the value of Description.
+func (s *Map) SetDescription(val string) {
+	s.Description = val
 }
 
 // SetProvider sets the value of Provider.
-func (s *MultiplayerGame) SetProvider(val Provider) {
+func (s *Map) SetProvider(val Provider) {
 	s.Provider = val
 }
 
-// SetFinished sets the value of Finished.
-func (s *MultiplayerGame) SetFinished(val bool) {
-	s.Finished = val
+// SetVisibility sets the value of Visibility.
+func (s *Map) SetVisibility(val MapVisibility) {
+	s.Visibility = val
+}
+
+// SetLocationsCount sets the value of LocationsCount.
+func (s *Map) SetLocationsCount(val int) {
+	s.LocationsCount = val
 }
 
 // SetCreatedAt sets the value of CreatedAt.
-func (s *MultiplayerGame) SetCreatedAt(val time.Time) {
+func (s *Map) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
 }
 
-func (*MultiplayerGame) getMultiplayerGameRes() {}
-
-// Ref: #/MultiplayerGuess
-type MultiplayerGuess struct {
-	Username   string  `json:"username"`
-	AvatarHash string  `json:"avatarHash"`
-	RoundNum   int     `json:"roundNum"`
-	RoundLat   float64 `json:"roundLat"`
-	RoundLng   float64 `json:"roundLng"`
-	Lat        float64 `json:"lat"`
-	Lng        float64 `json:"lng"`
-	Score      int     `json:"score"`
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Map) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
 }
 
-// GetUsername returns the value of Username.
-func (s *MultiplayerGuess) GetUsername() string {
-	return s.Username
-}
+func (*Map) getMapRes() {}
 
-// GetAvatarHash returns the value of AvatarHash.
-func (s *MultiplayerGuess) GetAvatarHash() string {
-	return s.AvatarHash
+// Ref: #/MapLocationsAdded
+type MapLocationsAdded struct {
+	// Amount of locations added to the map.
+	Added int `json:"added"`
 }
 
-// GetRoundNum returns the value of RoundNum.
-func (s *MultiplayerGuess) GetRoundNum() int {
-	return s.RoundNum
+// GetAdded returns the value of Added.
+func (s *MapLocationsAdded) GetAdded() int {
+	return s.Added
 }
 
-// GetRoundLat returns the value of RoundLat.
-func (s *MultiplayerGuess) GetRoundLat() float64 {
-	return s.RoundLat
+// SetAdded sets the value of Added.
+func (s *MapLocationsAdded) SetAdded(val int) {
+	s.Added = val
 }
 
-// GetRoundLng returns the value of RoundLng.
-func (s *MultiplayerGuess) GetRoundLng() float64 {
-	return s.RoundLng
-}
+func (*MapLocationsAdded) addMapLocationsRes()    {}
+func (*MapLocationsAdded) importMapLocationsRes() {}
 
-// GetLat returns the value of Lat.
-func (s *MultiplayerGuess) GetLat() float64 {
-	return s.Lat
-}
+// Who can see and play the map. Public maps are listed for everyone, unlisted maps can be played by
+// anyone with the ID, private maps are available only to the creator.
+// Ref: #/MapVisibility
+type MapVisibility string
 
-// GetLng returns the value of Lng.
-func (s *MultiplayerGuess) GetLng() float64 {
-	return s.Lng
-}
+const (
+	MapVisibilityPublic   MapVisibility = "public"
+	MapVisibilityUnlisted MapVisibility = "unlisted"
+	MapVisibilityPrivate  MapVisibility = "private"
+)
 
-// GetScore returns the value of Score.
-func (s *MultiplayerGuess) GetScore() int {
-	return s.Score
+// AllValues returns all MapVisibility values.
+func (MapVisibility) AllValues() []MapVisibility {
+	return []MapVisibility{
+		MapVisibilityPublic,
+		MapVisibilityUnlisted,
+		MapVisibilityPrivate,
+	}
 }
 
-// SetUsername sets the value of Username.
-func (s *MultiplayerGuess) SetUsername(val string) {
-	s.Username = val
+// MarshalText implements encoding.TextMarshaler.
+func (s MapVisibility) MarshalText() ([]byte, error) {
+	switch s {
+	case MapVisibilityPublic:
+		return []byte(s), nil
+	case MapVisibilityUnlisted:
+		return []byte(s), nil
+	case MapVisibilityPrivate:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetAvatarHash sets the value of AvatarHash.
-func (s *MultiplayerGuess) SetAvatarHash(val string) {
-	s.AvatarHash = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *MapVisibility) UnmarshalText(data []byte) error {
+	switch MapVisibility(data) {
+	case MapVisibilityPublic:
+		*s = MapVisibilityPublic
+		return nil
+	case MapVisibilityUnlisted:
+		*s = MapVisibilityUnlisted
+		return nil
+	case MapVisibilityPrivate:
+		*s = MapVisibilityPrivate
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetRoundNum sets the value of RoundNum.
-func (s *MultiplayerGuess) SetRoundNum(val int) {
-	s.RoundNum = val
+// Ref: #/Maps
+type Maps struct {
+	Total int   `json:"total"`
+	Maps  []Map `json:"maps"`
 }
 
-// SetRoundLat sets the value of RoundLat.
-func (s *MultiplayerGuess) SetRoundLat(val float64) {
-	s.RoundLat = val
+// GetTotal returns the value of Total.
+func (s *Maps) GetTotal() int {
+	return s.Total
 }
 
-// SetRoundLng sets the value of RoundLng.
-func (s *MultiplayerGuess) SetRoundLng(val float64) {
-	s.RoundLng = val
+// GetMaps returns the value of Maps.
+func (s *Maps) GetMaps() []Map {
+	return s.Maps
 }
 
-// SetLat sets the value of Lat.
-func (s *MultiplayerGuess) SetLat(val float64) {
-	s.Lat = val
+// SetTotal sets the value of Total.
+func (s *Maps) SetTotal(val int) {
+	s.Total = val
 }
 
-// SetLng sets the value of Lng.
-func (s *MultiplayerGuess) SetLng(val float64) {
-	s.Lng = val
+// SetMaps sets the value of Maps.
+func (s *Maps) SetMaps(val []Map) {
+	s.Maps = val
 }
 
-// SetScore sets the value of Score.
-func (s *MultiplayerGuess) SetScore(val int) {
-	s.Score = val
-}
+func (*Maps) getMapsRes() {}
 
-// Ref: #/MultiplayerRound
-type MultiplayerRound struct {
-	ID           int       `json:"id"`
-	GameID       int       `json:"gameID"`
-	StreetviewID string    `json:"streetviewID"`
-	RoundNum     int       `json:"roundNum"`
-	Lat          float64   `json:"lat"`
-	Lng          float64   `json:"lng"`
-	PanoramaURL  string    `json:"panoramaURL"`
-	GuessesCount int       `json:"guessesCount"`
-	Finished     bool      `json:"finished"`
-	CreatedAt    time.Time `json:"createdAt"`
-	StartedAt    time.Time `json:"startedAt"`
-	EndedAt      time.Time `json:"endedAt"`
+// Ref: #/MultiplayerGame
+type MultiplayerGame struct {
+	ID              int          `json:"id"`
+	CreatorID       int          `json:"creatorID"`
+	Rounds          int          `json:"rounds"`
+	RoundCurrent    int          `json:"roundCurrent"`
+	TimerSeconds    int          `json:"timerSeconds"`
+	MovementAllowed bool         `json:"movementAllowed"`
+	Players         int          `json:"players"`
+	Provider        GameProvider `json:"provider"`
+	Providers       Providers    `json:"providers"`
+	Finished        bool         `json:"finished"`
+	// ID of the map the game is played on, not set for games on all provider locations.
+	MapID         OptInt           `json:"mapID"`
+	ScoreDistance OptScoreDistance `json:"scoreDistance"`
+	Scoring       ScoringMode      `json:"scoring"`
+	Mode          MultiplayerMode  `json:"mode"`
+	// Health players of duel games start with, not set for games of other modes.
+	Health OptInt `json:"health"`
+	// Team scoring of the game, not set for games where players play individually.
+	TeamScoring OptTeamScoring `json:"teamScoring"`
+	// Whether users outside of the game can watch it.
+	Spectators bool `json:"spectators"`
+	// Delay of round results sent to spectators, not set if results are sent without delay.
+	SpectatorDelaySeconds OptInt    `json:"spectatorDelaySeconds"`
+	CreatedAt             time.Time `json:"createdAt"`
 }
 
 // GetID returns the value of ID.
-func (s *MultiplayerRound) GetID() int {
+func (s *MultiplayerGame) GetID() int {
 	return s.ID
 }
 
-// GetGameID returns the value of GameID.
-func (s *MultiplayerRound) GetGameID() int {
-	return s.GameID
+// GetCreatorID returns the value of CreatorID.
+func (s *MultiplayerGame) GetCreatorID() int {
+	return s.CreatorID
 }
 
-// GetStreetviewID returns the value of StreetviewID.
-func (s *MultiplayerRound) GetStreetviewID() string {
-	return s.StreetviewID
+// GetRounds returns the value of Rounds.
+func (s *MultiplayerGame) GetRounds() int {
+	return s.Rounds
 }
 
-// GetRoundNum returns the value of RoundNum.
-func (s *MultiplayerRound) GetRoundNum() int {
-	return s.RoundNum
+// GetRoundCurrent returns the value of RoundCurrent.
+func (s *MultiplayerGame) GetRoundCurrent() int {
+	return s.RoundCurrent
 }
 
-// GetLat returns the value of Lat.
-func (s *MultiplayerRound) GetLat() float64 {
-	return s.Lat
+// GetTimerSeconds returns the value of TimerSeconds.
+func (s *MultiplayerGame) GetTimerSeconds() int {
+	return s.TimerSeconds
 }
 
-// GetLng returns the value of Lng.
-func (s *MultiplayerRound) GetLng() float64 {
-	return s.Lng
+// GetMovementAllowed returns the value of MovementAllowed.
+func (s *MultiplayerGame) GetMovementAllowed() bool {
+	return s.MovementAllowed
 }
 
-// GetPanoramaURL returns the value of PanoramaURL.
-func (s *MultiplayerRound) GetPanoramaURL() string {
-	return s.PanoramaURL
+// GetPlayers returns the value of Players.
+func (s *MultiplayerGame) GetPlayers() int {
+	return s.Players
 }
 
-// GetGuessesCount returns the value of GuessesCount.
-func (s *MultiplayerRound) GetGuessesCount() int {
-	return s.GuessesCount
+// GetProvider returns the value of Provider.
+func (s *MultiplayerGame) GetProvider() GameProvider {
+	return s.Provider
+}
+
+// GetProviders returns the value of Providers.
+func (s *MultiplayerGame) GetProviders() Providers {
+	return s.Providers
 }
 
 // GetFinished returns the value of Finished.
-func (s *MultiplayerRound) GetFinished() bool {
+func (s *MultiplayerGame) GetFinished() bool {
 	return s.Finished
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *MultiplayerRound) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// GetMapID returns the value of MapID.
+func (s *MultiplayerGame) GetMapID() OptInt {
+	return s.MapID
 }
 
-// GetStartedAt returns the value of StartedAt.
-func (s *MultiplayerRound) GetStartedAt() time.Time {
-	return s.StartedAt
+// GetScoreDistance returns the value of ScoreDistance.
+func (s *MultiplayerGame) GetScoreDistance() OptScoreDistance {
+	return s.ScoreDistance
 }
 
-// GetEndedAt returns the value of EndedAt.
-func (s *MultiplayerRound) GetEndedAt() time.Time {
-	return s.EndedAt
+// GetScoring returns the value of Scoring.
+func (s *MultiplayerGame) GetScoring() ScoringMode {
+	return s.Scoring
 }
 
-// SetID sets the value of ID.
-func (s *MultiplayerRound) SetID(val int) {
-	s.ID = val
+// GetMode returns the value of Mode.
+func (s *MultiplayerGame) GetMode() MultiplayerMode {
+	return s.Mode
 }
 
-// SetGameID sets the value of GameID.
-func (s *MultiplayerRound) SetGameID(val int) {
-	s.GameID = val
+// GetHealth returns the value of Health.
+func (s *MultiplayerGame) GetHealth() OptInt {
+	return s.Health
 }
 
-// SetStreetviewID sets the value of StreetviewID.
-func (s *MultiplayerRound) SetStreetviewID(val string) {
-	s.StreetviewID = val
+// GetTeamScoring returns the value of TeamScoring.
+func (s *MultiplayerGame) GetTeamScoring() OptTeamScoring {
+	return s.TeamScoring
 }
 
-// SetRoundNum sets the value of RoundNum.
-func (s *MultiplayerRound) SetRoundNum(val int) {
-	s.RoundNum = val
+// GetSpectators returns the value of Spectators.
+func (s *MultiplayerGame) GetSpectators() bool {
+	return s.Spectators
 }
 
-// SetLat sets the value of Lat.
-func (s *MultiplayerRound) SetLat(val float64) {
-	s.Lat = val
+// GetSpectatorDelaySeconds returns the value of SpectatorDelaySeconds.
+func (s *MultiplayerGame) GetSpectatorDelaySeconds() OptInt {
+	return s.SpectatorDelaySeconds
 }
 
-// SetLng sets the value of Lng.
-func (s *MultiplayerRound) SetLng(val float64) {
-	s.Lng = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *MultiplayerGame) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// SetPanoramaURL sets the value of PanoramaURL.
-func (s *MultiplayerRound) SetPanoramaURL(val string) {
-	s.PanoramaURL = val
+// SetID sets the value of ID.
+func (s *MultiplayerGame) SetID(val int) {
+	s.ID = val
 }
 
-// SetGuessesCount sets the value of GuessesCount.
-func (s *MultiplayerRound) SetGuessesCount(val int) {
-	s.GuessesCount = val
+// SetCreatorID sets the value of CreatorID.
+func (s *MultiplayerGame) SetCreatorID(val int) {
+	s.CreatorID = val
 }
 
-// SetFinished sets the value of Finished.
-func (s *MultiplayerRound) SetFinished(val bool) {
-	s.Finished = val
+// SetRounds sets the value of Rounds.
+func (s *MultiplayerGame) SetRounds(val int) {
+	s.Rounds = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *MultiplayerRound) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// SetRoundCurrent sets the value of RoundCurrent.
+func (s *MultiplayerGame) SetRoundCurrent(val int) {
+	s.RoundCurrent = val
 }
 
-// SetStartedAt sets the value of StartedAt.
-func (s *MultiplayerRound) SetStartedAt(val time.Time) {
-	s.StartedAt = val
+// SetTimerSeconds sets the value of TimerSeconds.
+func (s *MultiplayerGame) SetTimerSeconds(val int) {
+	s.TimerSeconds = val
 }
 
-// SetEndedAt sets the value of EndedAt.
-func (s *MultiplayerRound) SetEndedAt(val time.Time) {
-	s.EndedAt = val
+// SetMovementAllowed sets the value of MovementAllowed.
+func (s *MultiplayerGame) SetMovementAllowed(val bool) {
+	s.MovementAllowed = val
 }
 
-func (*MultiplayerRound) getMultiplayerRoundRes() {}
-func (*MultiplayerRound) newMultiplayerRoundRes() {}
-
-type NewDiscordCallbackBadRequest Error
-
-func (*NewDiscordCallbackBadRequest) newDiscordCallbackRes() {}
-
-type NewDiscordCallbackInternalServerError Error
+// SetPlayers sets the value of Players.
+func (s *MultiplayerGame) SetPlayers(val int) {
+	s.Players = val
+}
 
-func (*NewDiscordCallbackInternalServerError) newDiscordCallbackRes() {}
+// SetProvider sets the value of Provider.
+func (s *MultiplayerGame) SetProvider(val GameProvider) {
+	s.Provider = val
+}
 
-// NewDiscordCallbackTemporaryRedirect is response for NewDiscordCallback operation.
-type NewDiscordCallbackTemporaryRedirect struct {
-	Location string
+// SetProviders sets the value of Providers.
+func (s *MultiplayerGame) SetProviders(val Providers) {
+	s.Providers = val
 }
 
-// GetLocation returns the value of Location.
-func (s *NewDiscordCallbackTemporaryRedirect) GetLocation() string {
-	return s.Location
+// SetFinished sets the value of Finished.
+func (s *MultiplayerGame) SetFinished(val bool) {
+	s.Finished = val
 }
 
-// SetLocation sets the value of Location.
-func (s *NewDiscordCallbackTemporaryRedirect) SetLocation(val string) {
-	s.Location = val
+// SetMapID sets the value of MapID.
+func (s *MultiplayerGame) SetMapID(val OptInt) {
+	s.MapID = val
 }
 
-func (*NewDiscordCallbackTemporaryRedirect) newDiscordCallbackRes() {}
+// SetScoreDistance sets the value of ScoreDistance.
+func (s *MultiplayerGame) SetScoreDistance(val OptScoreDistance) {
+	s.ScoreDistance = val
+}
 
-type NewDiscordCallbackUnauthorized Error
+// SetScoring sets the value of Scoring.
+func (s *MultiplayerGame) SetScoring(val ScoringMode) {
+	s.Scoring = val
+}
 
-func (*NewDiscordCallbackUnauthorized) newDiscordCallbackRes() {}
+// SetMode sets the value of Mode.
+func (s *MultiplayerGame) SetMode(val MultiplayerMode) {
+	s.Mode = val
+}
 
-// NewDiscordTemporaryRedirect is response for NewDiscord operation.
-type NewDiscordTemporaryRedirect struct {
-	Location  string
-	SetCookie string
+// SetHealth sets the value of Health.
+func (s *MultiplayerGame) SetHealth(val OptInt) {
+	s.Health = val
 }
 
-// GetLocation returns the value of Location.
-func (s *NewDiscordTemporaryRedirect) GetLocation() string {
-	return s.Location
+// SetTeamScoring sets the value of TeamScoring.
+func (s *MultiplayerGame) SetTeamScoring(val OptTeamScoring) {
+	s.TeamScoring = val
 }
 
-// GetSetCookie returns the value of SetCookie.
-func (s *NewDiscordTemporaryRedirect) GetSetCookie() string {
-	return s.SetCookie
+// SetSpectators sets the value of Spectators.
+func (s *MultiplayerGame) SetSpectators(val bool) {
+	s.Spectators = val
 }
 
-// SetLocation sets the value of Location.
-func (s *NewDiscordTemporaryRedirect) SetLocation(val string) {
-	s.Location = val
+// SetSpectatorDelaySeconds sets the value of SpectatorDelaySeconds.
+func (s *MultiplayerGame) SetSpectatorDelaySeconds(val OptInt) {
+	s.SpectatorDelaySeconds = val
 }
 
-// SetSetCookie sets the value of SetCookie.
-func (s *NewDiscordTemporaryRedirect) SetSetCookie(val string) {
-	s.SetCookie = val
+// SetCreatedAt sets the value of CreatedAt.
+func (s *MultiplayerGame) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-func (*NewDiscordTemporaryRedirect) newDiscordRes() {}
+func (*MultiplayerGame) getMultiplayerGameRes() {}
 
-// Ref: #/NewLobby
-type NewLobby struct {
-	CreatorID       int      `json:"creatorID"`
-	MaxPlayers      int      `json:"maxPlayers"`
-	Rounds          int      `json:"rounds"`
-	Provider        Provider `json:"provider"`
-	TimerSeconds    OptInt   `json:"timerSeconds"`
-	MovementAllowed bool     `json:"movementAllowed"`
+// Ref: #/MultiplayerGuess
+type MultiplayerGuess struct {
+	Username   string `json:"username"`
+	AvatarHash string `json:"avatarHash"`
+	// Team of the player, not set for games without teams.
+	Team           OptInt         `json:"team"`
+	RoundNum       int            `json:"roundNum"`
+	RoundLat       float64        `json:"roundLat"`
+	RoundLng       float64        `json:"roundLng"`
+	Lat            float64        `json:"lat"`
+	Lng            float64        `json:"lng"`
+	Score          int            `json:"score"`
+	ScoreBreakdown ScoreBreakdown `json:"scoreBreakdown"`
+	MissDistance   int            `json:"missDistance"`
+	RoundCountry   OptCountryCode `json:"roundCountry"`
+	GuessCountry   OptCountryCode `json:"guessCountry"`
+	// Whether the guess is in the country of the round location.
+	CorrectCountry bool `json:"correctCountry"`
 }
 
-// GetCreatorID returns the value of CreatorID.
-func (s *NewLobby) GetCreatorID() int {
-	return s.CreatorID
+// GetUsername returns the value of Username.
+func (s *MultiplayerGuess) GetUsername() string {
+	return s.Username
 }
 
-// GetMaxPlayers returns the value of MaxPlayers.
-func (s *NewLobby) GetMaxPlayers() int {
-	return s.MaxPlayers
+// GetAvatarHash returns the value of AvatarHash.
+func (s *MultiplayerGuess) GetAvatarHash() string {
+	return s.AvatarHash
 }
 
-// GetRounds returns the value of Rounds.
-func (s *NewLobby) GetRounds() int {
-	return s.Rounds
+// GetTeam returns the value of Team.
+func (s *MultiplayerGuess) GetTeam() OptInt {
+	return s.Team
 }
 
-// GetProvider returns the value of Provider.
-func (s *NewLobby) GetProvider() Provider {
-	return s.Provider
+// GetRoundNum returns the value of RoundNum.
+func (s *MultiplayerGuess) GetRoundNum() int {
+	return s.RoundNum
 }
 
-// GetTimerSeconds returns the value of TimerSeconds.
-func (s *NewLobby) GetTimerSeconds() OptInt {
-	return s.TimerSeconds
+// GetRoundLat returns the value of RoundLat.
+func (s *MultiplayerGuess) GetRoundLat() float64 {
+	return s.RoundLat
 }
 
-// GetMovementAllowed returns the value of MovementAllowed.
-func (s *NewLobby) GetMovementAllowed() bool {
-	return s.MovementAllowed
-}
-
-// SetCreatorID sets the value of CreatorID.
-func (s *NewLobby) SetCreatorID(val int) {
-	s.CreatorID = val
+// GetRoundLng returns the value of RoundLng.
+func (s *MultiplayerGuess) GetRoundLng() float64 {
+	return s.RoundLng
 }
 
-// SetMaxPlayers sets the value of MaxPlayers.
-func (s *NewLobby) SetMaxPlayers(val int) {
-	s.MaxPlayers = val
+// GetLat returns the value of Lat.
+func (s *MultiplayerGuess) GetLat() float64 {
+	return s.Lat
 }
 
-// SetRounds sets the value of Rounds.
-func (s *NewLobby) SetRounds(val int) {
-	s.Rounds = val
+// GetLng returns the value of Lng.
+func (s *MultiplayerGuess) GetLng() float64 {
+	return s.Lng
 }
 
-// SetProvider sets the value of Provider.
-func (s *NewLobby) SetProvider(val Provider) {
-	s.Provider = val
+// GetScore returns the value of Score.
+func (s *MultiplayerGuess) GetScore() int {
+	return s.Score
 }
 
-// SetTimerSeconds sets the value of TimerSeconds.
-func (s *NewLobby) SetTimerSeconds(val OptInt) {
-	s.TimerSeconds = val
+// GetScoreBreakdown returns the value of ScoreBreakdown.
+func (s *MultiplayerGuess) GetScoreBreakdown() ScoreBreakdown {
+	return s.ScoreBreakdown
 }
 
-// SetMovementAllowed sets the value of MovementAllowed.
-func (s *NewLobby) SetMovementAllowed(val bool) {
-	s.MovementAllowed = val
+// GetMissDistance returns the value of MissDistance.
+func (s *MultiplayerGuess) GetMissDistance() int {
+	return s.MissDistance
 }
 
-type NewLobbyBadRequest Error
-
-func (*NewLobbyBadRequest) newLobbyRes() {}
-
-type NewLobbyCreated struct {
-	ID string `json:"id"`
+// GetRoundCountry returns the value of RoundCountry.
+func (s *MultiplayerGuess) GetRoundCountry() OptCountryCode {
+	return s.RoundCountry
 }
 
-// GetID returns the value of ID.
-func (s *NewLobbyCreated) GetID() string {
-	return s.ID
+// GetGuessCountry returns the value of GuessCountry.
+func (s *MultiplayerGuess) GetGuessCountry() OptCountryCode {
+	return s.GuessCountry
 }
 
-// SetID sets the value of ID.
-func (s *NewLobbyCreated) SetID(val string) {
-	s.ID = val
+// GetCorrectCountry returns the value of CorrectCountry.
+func (s *MultiplayerGuess) GetCorrectCountry() bool {
+	return s.CorrectCountry
 }
 
-func (*NewLobbyCreated) newLobbyRes() {}
-
-type NewLobbyInternalServerError Error
+// SetUsername sets the value of Username.
+func (s *MultiplayerGuess) SetUsername(val string) {
+	s.Username = val
+}
 
-func (*NewLobbyInternalServerError) newLobbyRes() {}
+// SetAvatarHash sets the value of AvatarHash.
+func (s *MultiplayerGuess) SetAvatarHash(val string) {
+	s.AvatarHash = val
+}
 
-type NewMultiplayerRoundInternalServerError Error
+// SetTeam sets the value of Team.
+func (s *MultiplayerGuess) SetTeam(val OptInt) {
+	s.Team = val
+}
 
-func (*NewMultiplayerRoundInternalServerError) newMultiplayerRoundRes() {}
+// SetRoundNum sets the value of RoundNum.
+func (s *MultiplayerGuess) SetRoundNum(val int) {
+	s.RoundNum = val
+}
 
-type NewMultiplayerRoundNotFound Error
+// SetRoundLat sets the value of RoundLat.
+func (s *MultiplayerGuess) SetRoundLat(val float64) {
+	s.RoundLat = val
+}
 
-func (*NewMultiplayerRoundNotFound) newMultiplayerRoundRes() {}
+// SetRoundLng sets the value of RoundLng.
+func (s *MultiplayerGuess) SetRoundLng(val float64) {
+	s.RoundLng = val
+}
 
-type NewMultiplayerRoundUnauthorized Error
+// SetLat sets the value of Lat.
+func (s *MultiplayerGuess) SetLat(val float64) {
+	s.Lat = val
+}
 
-func (*NewMultiplayerRoundUnauthorized) newMultiplayerRoundRes() {}
+// SetLng sets the value of Lng.
+func (s *MultiplayerGuess) SetLng(val float64) {
+	s.Lng = val
+}
 
-type NewSingleplayerGameBadRequest Error
+// SetScore sets the value of Score.
+func (s *MultiplayerGuess) SetScore(val int) {
+	s.Score = val
+}
 
-func (*NewSingleplayerGameBadRequest) newSingleplayerGameRes() {}
+// SetScoreBreakdown sets the value of ScoreBreakdown.
+func (s *MultiplayerGuess) SetScoreBreakdown(val ScoreBreakdown) {
+	s.ScoreBreakdown = val
+}
 
-type NewSingleplayerGameCreated struct {
-	ID int `json:"id"`
+// SetMissDistance sets the value of MissDistance.
+func (s *MultiplayerGuess) SetMissDistance(val int) {
+	s.MissDistance = val
 }
 
-// GetID returns the value of ID.
-func (s *NewSingleplayerGameCreated) GetID() int {
-	return s.ID
+// SetRoundCountry sets the value of RoundCountry.
+func (s *MultiplayerGuess) SetRoundCountry(val OptCountryCode) {
+	s.RoundCountry = val
 }
 
-// SetID sets the value of ID.
-func (s *NewSingleplayerGameCreated) SetID(val int) {
-	s.ID = val
+// SetGuessCountry sets the value of GuessCountry.
+func (s *MultiplayerGuess) SetGuessCountry(val OptCountryCode) {
+	s.GuessCountry = val
 }
 
-func (*NewSingleplayerGameCreated) newSingleplayerGameRes() {}
+// SetCorrectCountry sets the value of CorrectCountry.
+func (s *MultiplayerGuess) SetCorrectCountry(val bool) {
+	s.CorrectCountry = val
+}
 
-type NewSingleplayerGameInternalServerError Error
+// Mode of a multiplayer game: "classic" sums scores across a fixed amount of rounds,
+// "battle_royale" eliminates the lowest-scoring players of each round until one player remains,
+// "duel" makes players lose health by the score difference with the best guess of each round
+// until someone runs out of health. Amount of rounds is only limited in classic games.
+// Ref: #/MultiplayerMode
+type MultiplayerMode string
 
-func (*NewSingleplayerGameInternalServerError) newSingleplayerGameRes() {}
+const (
+	MultiplayerModeClassic      MultiplayerMode = "classic"
+	MultiplayerModeBattleRoyale MultiplayerMode = "battle_royale"
+	MultiplayerModeDuel         MultiplayerMode = "duel"
+)
 
-// Ref: #/NewSingleplayerGameRequest
-type NewSingleplayerGameRequest struct {
-	Rounds          int      `json:"rounds"`
-	TimerSeconds    OptInt   `json:"timerSeconds"`
-	MovementAllowed bool     `json:"movementAllowed"`
-	Provider        Provider `json:"provider"`
+// AllValues returns all MultiplayerMode values.
+func (MultiplayerMode) AllValues() []MultiplayerMode {
+	return []MultiplayerMode{
+		MultiplayerModeClassic,
+		MultiplayerModeBattleRoyale,
+		MultiplayerModeDuel,
+	}
 }
 
-// GetRounds returns the value of Rounds.
-func (s *NewSingleplayerGameRequest) GetRounds() int {
-	return s.Rounds
+// MarshalText implements encoding.TextMarshaler.
+func (s MultiplayerMode) MarshalText() ([]byte, error) {
+	switch s {
+	case MultiplayerModeClassic:
+		return []byte(s), nil
+	case MultiplayerModeBattleRoyale:
+		return []byte(s), nil
+	case MultiplayerModeDuel:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetTimerSeconds returns the value of TimerSeconds.
-func (s *NewSingleplayerGameRequest) GetTimerSeconds() OptInt {
-	return s.TimerSeconds
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *MultiplayerMode) UnmarshalText(data []byte) error {
+	switch MultiplayerMode(data) {
+	case MultiplayerModeClassic:
+		*s = MultiplayerModeClassic
+		return nil
+	case MultiplayerModeBattleRoyale:
+		*s = MultiplayerModeBattleRoyale
+		return nil
+	case MultiplayerModeDuel:
+		*s = MultiplayerModeDuel
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetMovementAllowed returns the value of MovementAllowed.
-func (s *NewSingleplayerGameRequest) GetMovementAllowed() bool {
-	return s.MovementAllowed
+// Ref: #/MultiplayerRound
+type MultiplayerRound struct {
+	ID     int `json:"id"`
+	GameID int `json:"gameID"`
+	// Streetview ID of the panorama (only present after the round has finished, live rounds are loaded
+	// by panorama token).
+	StreetviewID OptString `json:"streetviewID"`
+	Provider     Provider  `json:"provider"`
+	RoundNum     int       `json:"roundNum"`
+	// Opaque short-lived token, that can be resolved to panorama location.
+	PanoramaToken string `json:"panoramaToken"`
+	// Real location of the round (only present after the round has finished).
+	Location     OptLatLng `json:"location"`
+	PanoramaURL  string    `json:"panoramaURL"`
+	GuessesCount int       `json:"guessesCount"`
+	Finished     bool      `json:"finished"`
+	CreatedAt    time.Time `json:"createdAt"`
+	StartedAt    time.Time `json:"startedAt"`
+	EndedAt      time.Time `json:"endedAt"`
 }
 
-// GetProvider returns the value of Provider.
-func (s *NewSingleplayerGameRequest) GetProvider() Provider {
-	return s.Provider
+// GetID returns the value of ID.
+func (s *MultiplayerRound) GetID() int {
+	return s.ID
 }
 
-// SetRounds sets the value of Rounds.
-func (s *NewSingleplayerGameRequest) SetRounds(val int) {
-	s.Rounds = val
+// GetGameID returns the value of GameID.
+func (s *MultiplayerRound) GetGameID() int {
+	return s.GameID
 }
 
-// SetTimerSeconds sets the value of TimerSeconds.
-func (s *NewSingleplayerGameRequest) SetTimerSeconds(val OptInt) {
-	s.TimerSeconds = val
+// GetStreetviewID returns the value of StreetviewID.
+func (s *MultiplayerRound) GetStreetviewID() OptString {
+	return s.StreetviewID
 }
 
-// SetMovementAllowed sets the value of MovementAllowed.
-func (s *NewSingleplayerGameRequest) SetMovementAllowed(val bool) {
-	s.MovementAllowed = val
+// GetProvider returns the value of Provider.
+func (s *MultiplayerRound) GetProvider() Provider {
+	return s.Provider
 }
 
-// SetProvider sets the value of Provider.
-func (s *NewSingleplayerGameRequest) SetProvider(val Provider) {
-	s.Provider = val
+// GetRoundNum returns the value of RoundNum.
+func (s *MultiplayerRound) GetRoundNum() int {
+	return s.RoundNum
 }
 
-type NewSingleplayerGameUnauthorized Error
-
-func (*NewSingleplayerGameUnauthorized) newSingleplayerGameRes() {}
-
-type NewSingleplayerRoundBadRequest Error
-
-func (*NewSingleplayerRoundBadRequest) newSingleplayerRoundRes() {}
-
-type NewSingleplayerRoundForbidden Error
+// GetPanoramaToken returns the value of PanoramaToken.
+func (s *MultiplayerRound) GetPanoramaToken() string {
+	return s.PanoramaToken
+}
 
-func (*NewSingleplayerRoundForbidden) newSingleplayerRoundRes() {}
+// GetLocation returns the value of Location.
+func (s *MultiplayerRound) GetLocation() OptLatLng {
+	return s.Location
+}
 
-type NewSingleplayerRoundInternalServerError Error
+// GetPanoramaURL returns the value of PanoramaURL.
+func (s *MultiplayerRound) GetPanoramaURL() string {
+	return s.PanoramaURL
+}
 
-func (*NewSingleplayerRoundInternalServerError) newSingleplayerRoundRes() {}
+// GetGuessesCount returns the value of GuessesCount.
+func (s *MultiplayerRound) GetGuessesCount() int {
+	return s.GuessesCount
+}
 
-type NewSingleplayerRoundNotFound Error
+// GetFinished returns the value of Finished.
+func (s *MultiplayerRound) GetFinished() bool {
+	return s.Finished
+}
 
-func (*NewSingleplayerRoundNotFound) newSingleplayerRoundRes() {}
+// GetCreatedAt returns the value of CreatedAt.
+func (s *MultiplayerRound) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
 
-type NewSingleplayerRoundUnauthorized Error
+// GetStartedAt returns the value of StartedAt.
+func (s *MultiplayerRound) GetStartedAt() time.Time {
+	return s.StartedAt
+}
 
-func (*NewSingleplayerRoundUnauthorized) newSingleplayerRoundRes() {}
+// GetEndedAt returns the value of EndedAt.
+func (s *MultiplayerRound) GetEndedAt() time.Time {
+	return s.EndedAt
+}
 
-type NewYandexCallbackBadRequest Error
+// SetID sets the value of ID.
+func (s *MultiplayerRound) SetID(val int) {
+	s.ID = val
+}
 
-func (*NewYandexCallbackBadRequest) newYandexCallbackRes() {}
+// SetGameID sets the value of GameID.
+func (s *MultiplayerRound) SetGameID(val int) {
+	s.GameID = val
+}
 
-type NewYandexCallbackInternalServerError Error
+// SetStreetviewID sets the value of StreetviewID.
+func (s *MultiplayerRound) SetStreetviewID(val OptString) {
+	s.StreetviewID = val
+}
 
-func (*NewYandexCallbackInternalServerError) newYandexCallbackRes() {}
+// SetProvider sets the value of Provider.
+func (s *MultiplayerRound) SetProvider(val Provider) {
+	s.Provider = val
+}
 
-// NewYandexCallbackTemporaryRedirect is response for NewYandexCallback operation.
-type NewYandexCallbackTemporaryRedirect struct {
-	Location string
+// SetRoundNum sets the value of RoundNum.
+func (s *MultiplayerRound) SetRoundNum(val int) {
+	s.RoundNum = val
 }
 
-// GetLocation returns the value of Location.
-func (s *NewYandexCallbackTemporaryRedirect) GetLocation() string {
-	return s.Location
+// SetPanoramaToken sets the value of PanoramaToken.
+func (s *MultiplayerRound) SetPanoramaToken(val string) {
+	s.PanoramaToken = val
 }
 
 // SetLocation sets the value of Location.
-func (s *NewYandexCallbackTemporaryRedirect) SetLocation(val string) {
+func (s *MultiplayerRound) SetLocation(val OptLatLng) {
 	s.Location = val
 }
 
-func (*NewYandexCallbackTemporaryRedirect) newYandexCallbackRes() {}
-
-type NewYandexCallbackUnauthorized Error
-
-func (*NewYandexCallbackUnauthorized) newYandexCallbackRes() {}
+// SetPanoramaURL sets the value of PanoramaURL.
+func (s *MultiplayerRound) SetPanoramaURL(val string) {
+	s.PanoramaURL = val
+}
 
-// NewYandexTemporaryRedirect is response for NewYandex operation.
-type NewYandexTemporaryRedirect struct {
-	Location  string
-	SetCookie string
+// SetGuessesCount sets the value of GuessesCount.
+func (s *MultiplayerRound) SetGuessesCount(val int) {
+	s.GuessesCount = val
+}
+
+// SetFinished sets the value of Finished.
+func (s *MultiplayerRound) SetFinished(val bool) {
+	s.Finished = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *MultiplayerRound) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetStartedAt sets the value of StartedAt.
+func (s *MultiplayerRound) SetStartedAt(val time.Time) {
+	s.StartedAt = val
+}
+
+// SetEndedAt sets the value of EndedAt.
+func (s *MultiplayerRound) SetEndedAt(val time.Time) {
+	s.EndedAt = val
+}
+
+func (*MultiplayerRound) getMultiplayerRoundRes() {}
+func (*MultiplayerRound) newMultiplayerRoundRes() {}
+
+type NewDiscordCallbackBadRequest Error
+
+func (*NewDiscordCallbackBadRequest) newDiscordCallbackRes() {}
+
+type NewDiscordCallbackInternalServerError Error
+
+func (*NewDiscordCallbackInternalServerError) newDiscordCallbackRes() {}
+
+// NewDiscordCallbackTemporaryRedirect is response for NewDiscordCallback operation.
+type NewDiscordCallbackTemporaryRedirect struct {
+	Location string
 }
 
 // GetLocation returns the value of Location.
-func (s *NewYandexTemporaryRedirect) GetLocation() string {
+func (s *NewDiscordCallbackTemporaryRedirect) GetLocation() string {
+	return s.Location
+}
+
+// SetLocation sets the value of Location.
+func (s *NewDiscordCallbackTemporaryRedirect) SetLocation(val string) {
+	s.Location = val
+}
+
+func (*NewDiscordCallbackTemporaryRedirect) newDiscordCallbackRes() {}
+
+type NewDiscordCallbackUnauthorized Error
+
+func (*NewDiscordCallbackUnauthorized) newDiscordCallbackRes() {}
+
+// NewDiscordTemporaryRedirect is response for NewDiscord operation.
+type NewDiscordTemporaryRedirect struct {
+	Location  string
+	SetCookie string
+}
+
+// GetLocation returns the value of Location.
+func (s *NewDiscordTemporaryRedirect) GetLocation() string {
 	return s.Location
 }
 
 // GetSetCookie returns the value of SetCookie.
-func (s *NewYandexTemporaryRedirect) GetSetCookie() string {
+func (s *NewDiscordTemporaryRedirect) GetSetCookie() string {
 	return s.SetCookie
 }
 
 // SetLocation sets the value of Location.
-func (s *NewYandexTemporaryRedirect) SetLocation(val string) {
+func (s *NewDiscordTemporaryRedirect) SetLocation(val string) {
 	s.Location = val
 }
 
 // SetSetCookie sets the value of SetCookie.
-func (s *NewYandexTemporaryRedirect) SetSetCookie(val string) {
+func (s *NewDiscordTemporaryRedirect) SetSetCookie(val string) {
 	s.SetCookie = val
 }
 
-func (*NewYandexTemporaryRedirect) newYandexRes() {}
+func (*NewDiscordTemporaryRedirect) newDiscordRes() {}
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
-		Value: v,
-		Set:   true,
-	}
+// Ref: #/NewLobby
+type NewLobby struct {
+	CreatorID       int          `json:"creatorID"`
+	MaxPlayers      int          `json:"maxPlayers"`
+	Rounds          int          `json:"rounds"`
+	Provider        GameProvider `json:"provider"`
+	Providers       Providers    `json:"providers"`
+	TimerSeconds    OptInt       `json:"timerSeconds"`
+	MovementAllowed bool         `json:"movementAllowed"`
+	// ID of a map to play, provider is taken from the map then.
+	MapID         OptInt             `json:"mapID"`
+	ScoreDistance OptScoreDistance   `json:"scoreDistance"`
+	Scoring       OptScoringMode     `json:"scoring"`
+	Mode          OptMultiplayerMode `json:"mode"`
+	// Amount of teams players are split into, players play individually if not set.
+	Teams       OptInt         `json:"teams"`
+	TeamScoring OptTeamScoring `json:"teamScoring"`
+	// Whether users outside of games started from the lobby can watch them.
+	Spectators OptBool `json:"spectators"`
+	// Delay of round results sent to spectators (to prevent ghosting).
+	SpectatorDelaySeconds OptInt             `json:"spectatorDelaySeconds"`
+	Visibility            OptLobbyVisibility `json:"visibility"`
+	// Password required to join the lobby, only used for password-protected lobbies.
+	Password OptString `json:"password"`
+	// IDs of users allowed to join the lobby, only used for invite-only lobbies.
+	InvitedUserIDs []int `json:"invitedUserIDs"`
+	// Whether every connected player must be ready before the game can be started.
+	RequireReady OptBool `json:"requireReady"`
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
-	Set   bool
+// GetCreatorID returns the value of CreatorID.
+func (s *NewLobby) GetCreatorID() int {
+	return s.CreatorID
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
+// GetMaxPlayers returns the value of MaxPlayers.
+func (s *NewLobby) GetMaxPlayers() int {
+	return s.MaxPlayers
 }
 
-// SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
-	o.Set = true
-	o.Value = v
+// GetRounds returns the value of Rounds.
+func (s *NewLobby) GetRounds() int {
+	return s.Rounds
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetProvider returns the value of Provider.
+func (s *NewLobby) GetProvider() GameProvider {
+	return s.Provider
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetProviders returns the value of Providers.
+func (s *NewLobby) GetProviders() Providers {
+	return s.Providers
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// GetTimerSeconds returns the value of TimerSeconds.
+func (s *NewLobby) GetTimerSeconds() OptInt {
+	return s.TimerSeconds
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// GetMovementAllowed returns the value of MovementAllowed.
+func (s *NewLobby) GetMovementAllowed() bool {
+	return s.MovementAllowed
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// GetMapID returns the value of MapID.
+func (s *NewLobby) GetMapID() OptInt {
+	return s.MapID
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// GetScoreDistance returns the value of ScoreDistance.
+func (s *NewLobby) GetScoreDistance() OptScoreDistance {
+	return s.ScoreDistance
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetScoring returns the value of Scoring.
+func (s *NewLobby) GetScoring() OptScoringMode {
+	return s.Scoring
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetMode returns the value of Mode.
+func (s *NewLobby) GetMode() OptMultiplayerMode {
+	return s.Mode
 }
 
-// Ref: #/Provider
-type Provider string
+// GetTeams returns the value of Teams.
+func (s *NewLobby) GetTeams() OptInt {
+	return s.Teams
+}
 
-const (
-	ProviderGoogle    Provider = "google"
-	ProviderYandex    Provider = "yandex"
-	ProviderYandexAir Provider = "yandex_air"
-	ProviderSeznam    Provider = "seznam"
-)
+// GetTeamScoring returns the value of TeamScoring.
+func (s *NewLobby) GetTeamScoring() OptTeamScoring {
+	return s.TeamScoring
+}
 
-// AllValues returns all Provider values.
-func (Provider) AllValues() []Provider {
-	return []Provider{
-		ProviderGoogle,
-		ProviderYandex,
-		ProviderYandexAir,
-		ProviderSeznam,
-	}
+// GetSpectators returns the value of Spectators.
+func (s *NewLobby) GetSpectators() OptBool {
+	return s.Spectators
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s Provider) MarshalText() ([]byte, error) {
-	switch s {
-	case ProviderGoogle:
-		return []byte(s), nil
-	case ProviderYandex:
-		return []byte(s), nil
-	case ProviderYandexAir:
-		return []byte(s), nil
-	case ProviderSeznam:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetSpectatorDelaySeconds returns the value of SpectatorDelaySeconds.
+func (s *NewLobby) GetSpectatorDelaySeconds() OptInt {
+	return s.SpectatorDelaySeconds
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *Provider) UnmarshalText(data []byte) error {
-	switch Provider(data) {
-	case ProviderGoogle:
-		*s = ProviderGoogle
-		return nil
-	case ProviderYandex:
-		*s = ProviderYandex
-		return nil
-	case ProviderYandexAir:
-		*s = ProviderYandexAir
-		return nil
-	case ProviderSeznam:
-		*s = ProviderSeznam
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetVisibility returns the value of Visibility.
+func (s *NewLobby) GetVisibility() OptLobbyVisibility {
+	return s.Visibility
 }
 
-type RefreshTokensBadRequest Error
+// GetPassword returns the value of Password.
+func (s *NewLobby) GetPassword() OptString {
+	return s.Password
+}
 
-func (*RefreshTokensBadRequest) refreshTokensRes() {}
+// GetInvitedUserIDs returns the value of InvitedUserIDs.
+func (s *NewLobby) GetInvitedUserIDs() []int {
+	return s.InvitedUserIDs
+}
 
-type RefreshTokensInternalServerError Error
+// GetRequireReady returns the value of RequireReady.
+func (s *NewLobby) GetRequireReady() OptBool {
+	return s.RequireReady
+}
 
-func (*RefreshTokensInternalServerError) refreshTokensRes() {}
+// SetCreatorID sets the value of CreatorID.
+func (s *NewLobby) SetCreatorID(val int) {
+	s.CreatorID = val
+}
 
-// RefreshTokensNoContent is response for RefreshTokens operation.
-type RefreshTokensNoContent struct {
-	SetCookie string
+// SetMaxPlayers sets the value of MaxPlayers.
+func (s *NewLobby) SetMaxPlayers(val int) {
+	s.MaxPlayers = val
 }
 
-// GetSetCookie returns the value of SetCookie.
-func (s *RefreshTokensNoContent) GetSetCookie() string {
-	return s.SetCookie
+// SetRounds sets the value of Rounds.
+func (s *NewLobby) SetRounds(val int) {
+	s.Rounds = val
 }
 
-// SetSetCookie sets the value of SetCookie.
-func (s *RefreshTokensNoContent) SetSetCookie(val string) {
-	s.SetCookie = val
+// SetProvider sets the value of Provider.
+func (s *NewLobby) SetProvider(val GameProvider) {
+	s.Provider = val
 }
 
-func (*RefreshTokensNoContent) refreshTokensRes() {}
+// SetProviders sets the value of Providers.
+func (s *NewLobby) SetProviders(val Providers) {
+	s.Providers = val
+}
 
-type RefreshTokensReq struct {
-	RefreshToken string `json:"refreshToken"`
+// SetTimerSeconds sets the value of TimerSeconds.
+func (s *NewLobby) SetTimerSeconds(val OptInt) {
+	s.TimerSeconds = val
 }
 
-// GetRefreshToken returns the value of RefreshToken.
-func (s *RefreshTokensReq) GetRefreshToken() string {
-	return s.RefreshToken
+// SetMovementAllowed sets the value of MovementAllowed.
+func (s *NewLobby) SetMovementAllowed(val bool) {
+	s.MovementAllowed = val
 }
 
-// SetRefreshToken sets the value of RefreshToken.
-func (s *RefreshTokensReq) SetRefreshToken(val string) {
-	s.RefreshToken = val
+// SetMapID sets the value of MapID.
+func (s *NewLobby) SetMapID(val OptInt) {
+	s.MapID = val
 }
 
-type RegisterBadRequest Error
+// SetScoreDistance sets the value of ScoreDistance.
+func (s *NewLobby) SetScoreDistance(val OptScoreDistance) {
+	s.ScoreDistance = val
+}
 
-func (*RegisterBadRequest) registerRes() {}
+// SetScoring sets the value of Scoring.
+func (s *NewLobby) SetScoring(val OptScoringMode) {
+	s.Scoring = val
+}
 
-type RegisterConflict Error
+// SetMode sets the value of Mode.
+func (s *NewLobby) SetMode(val OptMultiplayerMode) {
+	s.Mode = val
+}
 
-func (*RegisterConflict) registerRes() {}
+// SetTeams sets the value of Teams.
+func (s *NewLobby) SetTeams(val OptInt) {
+	s.Teams = val
+}
 
-// RegisterCreated is response for Register operation.
-type RegisterCreated struct{}
+// SetTeamScoring sets the value of TeamScoring.
+func (s *NewLobby) SetTeamScoring(val OptTeamScoring) {
+	s.TeamScoring = val
+}
 
-func (*RegisterCreated) registerRes() {}
+// SetSpectators sets the value of Spectators.
+func (s *NewLobby) SetSpectators(val OptBool) {
+	s.Spectators = val
+}
 
-type RegisterInternalServerError Error
+// SetSpectatorDelaySeconds sets the value of SpectatorDelaySeconds.
+func (s *NewLobby) SetSpectatorDelaySeconds(val OptInt) {
+	s.SpectatorDelaySeconds = val
+}
 
-func (*RegisterInternalServerError) registerRes() {}
+// SetVisibility sets the value of Visibility.
+func (s *NewLobby) SetVisibility(val OptLobbyVisibility) {
+	s.Visibility = val
+}
 
-// Ref: #/RegisterRequest
-type RegisterRequest struct {
-	Username string    `json:"username"`
-	Password string    `json:"password"`
-	Name     OptString `json:"name"`
+// SetPassword sets the value of Password.
+func (s *NewLobby) SetPassword(val OptString) {
+	s.Password = val
 }
 
-// GetUsername returns the value of Username.
-func (s *RegisterRequest) GetUsername() string {
-	return s.Username
+// SetInvitedUserIDs sets the value of InvitedUserIDs.
+func (s *NewLobby) SetInvitedUserIDs(val []int) {
+	s.InvitedUserIDs = val
 }
 
-// GetPassword returns the value of Password.
-func (s *RegisterRequest) GetPassword() string {
-	return s.Password
+// SetRequireReady sets the value of RequireReady.
+func (s *NewLobby) SetRequireReady(val OptBool) {
+	s.RequireReady = val
 }
 
-// GetName returns the value of Name.
-func (s *RegisterRequest) GetName() OptString {
+type NewLobbyBadRequest Error
+
+func (*NewLobbyBadRequest) newLobbyRes() {}
+
+type NewLobbyCreated struct {
+	ID string `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *NewLobbyCreated) GetID() string {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *NewLobbyCreated) SetID(val string) {
+	s.ID = val
+}
+
+func (*NewLobbyCreated) newLobbyRes() {}
+
+type NewLobbyInternalServerError Error
+
+func (*NewLobbyInternalServerError) newLobbyRes() {}
+
+type NewLobbyNotFound Error
+
+func (*NewLobbyNotFound) newLobbyRes() {}
+
+type NewMapBadRequest Error
+
+func (*NewMapBadRequest) newMapRes() {}
+
+type NewMapCreated struct {
+	ID int `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *NewMapCreated) GetID() int {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *NewMapCreated) SetID(val int) {
+	s.ID = val
+}
+
+func (*NewMapCreated) newMapRes() {}
+
+type NewMapInternalServerError Error
+
+func (*NewMapInternalServerError) newMapRes() {}
+
+// Ref: #/NewMapRequest
+type NewMapRequest struct {
+	Name        string        `json:"name"`
+	Description OptString     `json:"description"`
+	Provider    Provider      `json:"provider"`
+	Visibility  MapVisibility `json:"visibility"`
+	// IDs of existing panorama locations of the provider to add to the map.
+	LocationIDs []int `json:"locationIDs"`
+}
+
+// GetName returns the value of Name.
+func (s *NewMapRequest) GetName() string {
 	return s.Name
 }
 
-// SetUsername sets the value of Username.
-func (s *RegisterRequest) SetUsername(val string) {
-	s.Username = val
+// GetDescription returns the value of Description.
+func (s *NewMapRequest) GetDescription() OptString {
+	return s.Description
+}
+
+// GetProvider returns the value of Provider.
+func (s *NewMapRequest) GetProvider() Provider {
+	return s.Provider
+}
+
+// GetVisibility returns the value of Visibility.
+func (s *NewMapRequest) GetVisibility() MapVisibility {
+	return s.Visibility
+}
+
+// GetLocationIDs returns the value of LocationIDs.
+func (s *NewMapRequest) GetLocationIDs() []int {
+	return s.LocationIDs
+}
+
+// SetName sets the value of Name.
+func (s *NewMapRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *NewMapRequest) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetProvider sets the value of Provider.
+func (s *NewMapRequest) SetProvider(val Provider) {
+	s.Provider = val
+}
+
+// SetVisibility sets the value of Visibility.
+func (s *NewMapRequest) SetVisibility(val MapVisibility) {
+	s.Visibility = val
+}
+
+// SetLocationIDs sets the value of LocationIDs.
+func (s *NewMapRequest) SetLocationIDs(val []int) {
+	s.LocationIDs = val
+}
+
+type NewMapUnauthorized Error
+
+func (*NewMapUnauthorized) newMapRes() {}
+
+type NewMultiplayerRoundInternalServerError Error
+
+func (*NewMultiplayerRoundInternalServerError) newMultiplayerRoundRes() {}
+
+type NewMultiplayerRoundNotFound Error
+
+func (*NewMultiplayerRoundNotFound) newMultiplayerRoundRes() {}
+
+type NewMultiplayerRoundUnauthorized Error
+
+func (*NewMultiplayerRoundUnauthorized) newMultiplayerRoundRes() {}
+
+type NewSingleplayerChallengeBadRequest Error
+
+func (*NewSingleplayerChallengeBadRequest) newSingleplayerChallengeRes() {}
+
+type NewSingleplayerChallengeCreated struct {
+	// Challenge token to share with other players.
+	Token string `json:"token"`
+}
+
+// GetToken returns the value of Token.
+func (s *NewSingleplayerChallengeCreated) GetToken() string {
+	return s.Token
+}
+
+// SetToken sets the value of Token.
+func (s *NewSingleplayerChallengeCreated) SetToken(val string) {
+	s.Token = val
+}
+
+func (*NewSingleplayerChallengeCreated) newSingleplayerChallengeRes() {}
+
+type NewSingleplayerChallengeForbidden Error
+
+func (*NewSingleplayerChallengeForbidden) newSingleplayerChallengeRes() {}
+
+type NewSingleplayerChallengeInternalServerError Error
+
+func (*NewSingleplayerChallengeInternalServerError) newSingleplayerChallengeRes() {}
+
+type NewSingleplayerChallengeNotFound Error
+
+func (*NewSingleplayerChallengeNotFound) newSingleplayerChallengeRes() {}
+
+type NewSingleplayerChallengeUnauthorized Error
+
+func (*NewSingleplayerChallengeUnauthorized) newSingleplayerChallengeRes() {}
+
+type NewSingleplayerGameBadRequest Error
+
+func (*NewSingleplayerGameBadRequest) newSingleplayerGameRes() {}
+
+type NewSingleplayerGameConflict Error
+
+func (*NewSingleplayerGameConflict) newSingleplayerGameRes() {}
+
+type NewSingleplayerGameCreated struct {
+	ID int `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *NewSingleplayerGameCreated) GetID() int {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *NewSingleplayerGameCreated) SetID(val int) {
+	s.ID = val
+}
+
+func (*NewSingleplayerGameCreated) newSingleplayerGameRes() {}
+
+type NewSingleplayerGameInternalServerError Error
+
+func (*NewSingleplayerGameInternalServerError) newSingleplayerGameRes() {}
+
+type NewSingleplayerGameNotFound Error
+
+func (*NewSingleplayerGameNotFound) newSingleplayerGameRes() {}
+
+// Ref: #/NewSingleplayerGameRequest
+type NewSingleplayerGameRequest struct {
+	Rounds          int          `json:"rounds"`
+	TimerSeconds    OptInt       `json:"timerSeconds"`
+	MovementAllowed bool         `json:"movementAllowed"`
+	Provider        GameProvider `json:"provider"`
+	Providers       Providers    `json:"providers"`
+	// Play today's daily challenge of the provider (not available for mixed provider).
+	// Rounds, timer and movement settings are ignored then.
+	Daily OptBool `json:"daily"`
+	// ID of a map to play, provider is taken from the map then.
+	MapID         OptInt           `json:"mapID"`
+	ScoreDistance OptScoreDistance `json:"scoreDistance"`
+	Scoring       OptScoringMode   `json:"scoring"`
+}
+
+// GetRounds returns the value of Rounds.
+func (s *NewSingleplayerGameRequest) GetRounds() int {
+	return s.Rounds
+}
+
+// GetTimerSeconds returns the value of TimerSeconds.
+func (s *NewSingleplayerGameRequest) GetTimerSeconds() OptInt {
+	return s.TimerSeconds
+}
+
+// GetMovementAllowed returns the value of MovementAllowed.
+func (s *NewSingleplayerGameRequest) GetMovementAllowed() bool {
+	return s.MovementAllowed
+}
+
+// GetProvider returns the value of Provider.
+func (s *NewSingleplayerGameRequest) GetProvider() GameProvider {
+	return s.Provider
+}
+
+// GetProviders returns the value of Providers.
+func (s *NewSingleplayerGameRequest) GetProviders() Providers {
+	return s.Providers
+}
+
+// GetDaily returns the value of Daily.
+func (s *NewSingleplayerGameRequest) GetDaily() OptBool {
+	return s.Daily
+}
+
+// GetMapID returns the value of MapID.
+func (s *NewSingleplayerGameRequest) GetMapID() OptInt {
+	return s.MapID
+}
+
+// GetScoreDistance returns the value of ScoreDistance.
+func (s *NewSingleplayerGameRequest) GetScoreDistance() OptScoreDistance {
+	return s.ScoreDistance
+}
+
+// GetScoring returns the value of Scoring.
+func (s *NewSingleplayerGameRequest) GetScoring() OptScoringMode {
+	return s.Scoring
+}
+
+// SetRounds sets the value of Rounds.
+func (s *NewSingleplayerGameRequest) SetRounds(val int) {
+	s.Rounds = val
+}
+
+// SetTimerSeconds sets the value of TimerSeconds.
+func (s *NewSingleplayerGameRequest) SetTimerSeconds(val OptInt) {
+	s.TimerSeconds = val
+}
+
+// SetMovementAllowed sets the value of MovementAllowed.
+func (s *NewSingleplayerGameRequest) SetMovementAllowed(val bool) {
+	s.MovementAllowed = val
+}
+
+// SetProvider sets the value of Provider.
+func (s *NewSingleplayerGameRequest) SetProvider(val GameProvider) {
+	s.Provider = val
+}
+
+// SetProviders sets the value of Providers.
+func (s *NewSingleplayerGameRequest) SetProviders(val Providers) {
+	s.Providers = val
+}
+
+// SetDaily sets the value of Daily.
+func (s *NewSingleplayerGameRequest) SetDaily(val OptBool) {
+	s.Daily = val
+}
+
+// SetMapID sets the value of MapID.
+func (s *NewSingleplayerGameRequest) SetMapID(val OptInt) {
+	s.MapID = val
+}
+
+// SetScoreDistance sets the value of ScoreDistance.
+func (s *NewSingleplayerGameRequest) SetScoreDistance(val OptScoreDistance) {
+	s.ScoreDistance = val
+}
+
+// SetScoring sets the value of Scoring.
+func (s *NewSingleplayerGameRequest) SetScoring(val OptScoringMode) {
+	s.Scoring = val
+}
+
+type NewSingleplayerGameUnauthorized Error
+
+func (*NewSingleplayerGameUnauthorized) newSingleplayerGameRes() {}
+
+type NewSingleplayerRoundBadRequest Error
+
+func (*NewSingleplayerRoundBadRequest) newSingleplayerRoundRes() {}
+
+type NewSingleplayerRoundForbidden Error
+
+func (*NewSingleplayerRoundForbidden) newSingleplayerRoundRes() {}
+
+type NewSingleplayerRoundInternalServerError Error
+
+func (*NewSingleplayerRoundInternalServerError) newSingleplayerRoundRes() {}
+
+type NewSingleplayerRoundNotFound Error
+
+func (*NewSingleplayerRoundNotFound) newSingleplayerRoundRes() {}
+
+type NewSingleplayerRoundUnauthorized Error
+
+func (*NewSingleplayerRoundUnauthorized) newSingleplayerRoundRes() {}
+
+type NewStreakGameBadRequest Error
+
+func (*NewStreakGameBadRequest) newStreakGameRes() {}
+
+type NewStreakGameCreated struct {
+	ID int `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *NewStreakGameCreated) GetID() int {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *NewStreakGameCreated) SetID(val int) {
+	s.ID = val
+}
+
+func (*NewStreakGameCreated) newStreakGameRes() {}
+
+type NewStreakGameInternalServerError Error
+
+func (*NewStreakGameInternalServerError) newStreakGameRes() {}
+
+// Ref: #/NewStreakGameRequest
+type NewStreakGameRequest struct {
+	TimerSeconds    OptInt       `json:"timerSeconds"`
+	MovementAllowed bool         `json:"movementAllowed"`
+	Provider        GameProvider `json:"provider"`
+	Providers       Providers    `json:"providers"`
+}
+
+// GetTimerSeconds returns the value of TimerSeconds.
+func (s *NewStreakGameRequest) GetTimerSeconds() OptInt {
+	return s.TimerSeconds
+}
+
+// GetMovementAllowed returns the value of MovementAllowed.
+func (s *NewStreakGameRequest) GetMovementAllowed() bool {
+	return s.MovementAllowed
+}
+
+// GetProvider returns the value of Provider.
+func (s *NewStreakGameRequest) GetProvider() GameProvider {
+	return s.Provider
+}
+
+// GetProviders returns the value of Providers.
+func (s *NewStreakGameRequest) GetProviders() Providers {
+	return s.Providers
+}
+
+// SetTimerSeconds sets the value of TimerSeconds.
+func (s *NewStreakGameRequest) SetTimerSeconds(val OptInt) {
+	s.TimerSeconds = val
+}
+
+// SetMovementAllowed sets the value of MovementAllowed.
+func (s *NewStreakGameRequest) SetMovementAllowed(val bool) {
+	s.MovementAllowed = val
+}
+
+// SetProvider sets the value of Provider.
+func (s *NewStreakGameRequest) SetProvider(val GameProvider) {
+	s.Provider = val
+}
+
+// SetProviders sets the value of Providers.
+func (s *NewStreakGameRequest) SetProviders(val Providers) {
+	s.Providers = val
+}
+
+type NewStreakGameUnauthorized Error
+
+func (*NewStreakGameUnauthorized) newStreakGameRes() {}
+
+type NewStreakRoundBadRequest Error
+
+func (*NewStreakRoundBadRequest) newStreakRoundRes() {}
+
+type NewStreakRoundForbidden Error
+
+func (*NewStreakRoundForbidden) newStreakRoundRes() {}
+
+type NewStreakRoundInternalServerError Error
+
+func (*NewStreakRoundInternalServerError) newStreakRoundRes() {}
+
+type NewStreakRoundNotFound Error
+
+func (*NewStreakRoundNotFound) newStreakRoundRes() {}
+
+type NewStreakRoundUnauthorized Error
+
+func (*NewStreakRoundUnauthorized) newStreakRoundRes() {}
+
+type NewYandexCallbackBadRequest Error
+
+func (*NewYandexCallbackBadRequest) newYandexCallbackRes() {}
+
+type NewYandexCallbackInternalServerError Error
+
+func (*NewYandexCallbackInternalServerError) newYandexCallbackRes() {}
+
+// NewYandexCallbackTemporaryRedirect is response for NewYandexCallback operation.
+type NewYandexCallbackTemporaryRedirect struct {
+	Location string
+}
+
+// GetLocation returns the value of Location.
+func (s *NewYandexCallbackTemporaryRedirect) GetLocation() string {
+	return s.Location
+}
+
+// SetLocation sets the value of Location.
+func (s *NewYandexCallbackTemporaryRedirect) SetLocation(val string) {
+	s.Location = val
+}
+
+func (*NewYandexCallbackTemporaryRedirect) newYandexCallbackRes() {}
+
+type NewYandexCallbackUnauthorized Error
+
+func (*NewYandexCallbackUnauthorized) newYandexCallbackRes() {}
+
+// NewYandexTemporaryRedirect is response for NewYandex operation.
+type NewYandexTemporaryRedirect struct {
+	Location  string
+	SetCookie string
+}
+
+// GetLocation returns the value of Location.
+func (s *NewYandexTemporaryRedirect) GetLocation() string {
+	return s.Location
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *NewYandexTemporaryRedirect) GetSetCookie() string {
+	return s.SetCookie
+}
+
+// SetLocation sets the value of Location.
+func (s *NewYandexTemporaryRedirect) SetLocation(val string) {
+	s.Location = val
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *NewYandexTemporaryRedirect) SetSetCookie(val string) {
+	s.SetCookie = val
+}
+
+func (*NewYandexTemporaryRedirect) newYandexRes() {}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCountryCode returns new OptCountryCode with value set to v.
+func NewOptCountryCode(v CountryCode) OptCountryCode {
+	return OptCountryCode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCountryCode is optional CountryCode.
+type OptCountryCode struct {
+	Value CountryCode
+	Set   bool
+}
+
+// IsSet returns true if OptCountryCode was set.
+func (o OptCountryCode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCountryCode) Reset() {
+	var v CountryCode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCountryCode) SetTo(v CountryCode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCountryCode) Get() (v CountryCode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCountryCode) Or(d CountryCode) CountryCode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDate returns new OptDate with value set to v.
+func NewOptDate(v time.Time) OptDate {
+	return OptDate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDate is optional time.Time.
+type OptDate struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDate was set.
+func (o OptDate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDate) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDate) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDate) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDate) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGameProvider returns new OptGameProvider with value set to v.
+func NewOptGameProvider(v GameProvider) OptGameProvider {
+	return OptGameProvider{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGameProvider is optional GameProvider.
+type OptGameProvider struct {
+	Value GameProvider
+	Set   bool
+}
+
+// IsSet returns true if OptGameProvider was set.
+func (o OptGameProvider) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGameProvider) Reset() {
+	var v GameProvider
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGameProvider) SetTo(v GameProvider) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGameProvider) Get() (v GameProvider, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGameProvider) Or(d GameProvider) GameProvider {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLatLng returns new OptLatLng with value set to v.
+func NewOptLatLng(v LatLng) OptLatLng {
+	return OptLatLng{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLatLng is optional LatLng.
+type OptLatLng struct {
+	Value LatLng
+	Set   bool
+}
+
+// IsSet returns true if OptLatLng was set.
+func (o OptLatLng) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLatLng) Reset() {
+	var v LatLng
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLatLng) SetTo(v LatLng) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLatLng) Get() (v LatLng, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLatLng) Or(d LatLng) LatLng {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLobbySort returns new OptLobbySort with value set to v.
+func NewOptLobbySort(v LobbySort) OptLobbySort {
+	return OptLobbySort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLobbySort is optional LobbySort.
+type OptLobbySort struct {
+	Value LobbySort
+	Set   bool
+}
+
+// IsSet returns true if OptLobbySort was set.
+func (o OptLobbySort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLobbySort) Reset() {
+	var v LobbySort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLobbySort) SetTo(v LobbySort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLobbySort) Get() (v LobbySort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLobbySort) Or(d LobbySort) LobbySort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLobbyVisibility returns new OptLobbyVisibility with value set to v.
+func NewOptLobbyVisibility(v LobbyVisibility) OptLobbyVisibility {
+	return OptLobbyVisibility{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLobbyVisibility is optional LobbyVisibility.
+type OptLobbyVisibility struct {
+	Value LobbyVisibility
+	Set   bool
+}
+
+// IsSet returns true if OptLobbyVisibility was set.
+func (o OptLobbyVisibility) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLobbyVisibility) Reset() {
+	var v LobbyVisibility
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLobbyVisibility) SetTo(v LobbyVisibility) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLobbyVisibility) Get() (v LobbyVisibility, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLobbyVisibility) Or(d LobbyVisibility) LobbyVisibility {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMultiplayerMode returns new OptMultiplayerMode with value set to v.
+func NewOptMultiplayerMode(v MultiplayerMode) OptMultiplayerMode {
+	return OptMultiplayerMode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMultiplayerMode is optional MultiplayerMode.
+type OptMultiplayerMode struct {
+	Value MultiplayerMode
+	Set   bool
+}
+
+// IsSet returns true if OptMultiplayerMode was set.
+func (o OptMultiplayerMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMultiplayerMode) Reset() {
+	var v MultiplayerMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMultiplayerMode) SetTo(v MultiplayerMode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMultiplayerMode) Get() (v MultiplayerMode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMultiplayerMode) Or(d MultiplayerMode) MultiplayerMode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptScoreDistance returns new OptScoreDistance with value set to v.
+func NewOptScoreDistance(v ScoreDistance) OptScoreDistance {
+	return OptScoreDistance{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptScoreDistance is optional ScoreDistance.
+type OptScoreDistance struct {
+	Value ScoreDistance
+	Set   bool
+}
+
+// IsSet returns true if OptScoreDistance was set.
+func (o OptScoreDistance) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptScoreDistance) Reset() {
+	var v ScoreDistance
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptScoreDistance) SetTo(v ScoreDistance) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptScoreDistance) Get() (v ScoreDistance, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptScoreDistance) Or(d ScoreDistance) ScoreDistance {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptScoringMode returns new OptScoringMode with value set to v.
+func NewOptScoringMode(v ScoringMode) OptScoringMode {
+	return OptScoringMode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptScoringMode is optional ScoringMode.
+type OptScoringMode struct {
+	Value ScoringMode
+	Set   bool
+}
+
+// IsSet returns true if OptScoringMode was set.
+func (o OptScoringMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptScoringMode) Reset() {
+	var v ScoringMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptScoringMode) SetTo(v ScoringMode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptScoringMode) Get() (v ScoringMode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptScoringMode) Or(d ScoringMode) ScoringMode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptTeamScoring returns new OptTeamScoring with value set to v.
+func NewOptTeamScoring(v TeamScoring) OptTeamScoring {
+	return OptTeamScoring{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTeamScoring is optional TeamScoring.
+type OptTeamScoring struct {
+	Value TeamScoring
+	Set   bool
+}
+
+// IsSet returns true if OptTeamScoring was set.
+func (o OptTeamScoring) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTeamScoring) Reset() {
+	var v TeamScoring
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTeamScoring) SetTo(v TeamScoring) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTeamScoring) Get() (v TeamScoring, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTeamScoring) Or(d TeamScoring) TeamScoring {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Panorama of the current round, its coordinates are revealed only after the round is finished.
+// Ref: #/PanoramaLocation
+type PanoramaLocation struct {
+	// ID of the provider panorama, panoramas of all providers are loaded by it.
+	StreetviewID string `json:"streetviewID"`
+}
+
+// GetStreetviewID returns the value of StreetviewID.
+func (s *PanoramaLocation) GetStreetviewID() string {
+	return s.StreetviewID
+}
+
+// SetStreetviewID sets the value of StreetviewID.
+func (s *PanoramaLocation) SetStreetviewID(val string) {
+	s.StreetviewID = val
+}
+
+func (*PanoramaLocation) getPanoramaRes() {}
+
+type Provider string
+
+type Providers []Provider
+
+type RefreshTokensBadRequest Error
+
+func (*RefreshTokensBadRequest) refreshTokensRes() {}
+
+type RefreshTokensInternalServerError Error
+
+func (*RefreshTokensInternalServerError) refreshTokensRes() {}
+
+// RefreshTokensNoContent is response for RefreshTokens operation.
+type RefreshTokensNoContent struct {
+	SetCookie string
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *RefreshTokensNoContent) GetSetCookie() string {
+	return s.SetCookie
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *RefreshTokensNoContent) SetSetCookie(val string) {
+	s.SetCookie = val
+}
+
+func (*RefreshTokensNoContent) refreshTokensRes() {}
+
+type RefreshTokensReq struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *RefreshTokensReq) GetRefreshToken() string {
+	return s.RefreshToken
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *RefreshTokensReq) SetRefreshToken(val string) {
+	s.RefreshToken = val
+}
+
+type RegisterBadRequest Error
+
+func (*RegisterBadRequest) registerRes() {}
+
+type RegisterConflict Error
+
+func (*RegisterConflict) registerRes() {}
+
+// RegisterCreated is response for Register operation.
+type RegisterCreated struct{}
+
+func (*RegisterCreated) registerRes() {}
+
+type RegisterInternalServerError Error
+
+func (*RegisterInternalServerError) registerRes() {}
+
+// Ref: #/RegisterRequest
+type RegisterRequest struct {
+	Username string    `json:"username"`
+	Password string    `json:"password"`
+	Name     OptString `json:"name"`
+}
+
+// GetUsername returns the value of Username.
+func (s *RegisterRequest) GetUsername() string {
+	return s.Username
+}
+
+// GetPassword returns the value of Password.
+func (s *RegisterRequest) GetPassword() string {
+	return s.Password
+}
+
+// GetName returns the value of Name.
+func (s *RegisterRequest) GetName() OptString {
+	return s.Name
+}
+
+// SetUsername sets the value of Username.
+func (s *RegisterRequest) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetPassword sets the value of Password.
+func (s *RegisterRequest) SetPassword(val string) {
+	s.Password = val
+}
+
+// SetName sets the value of Name.
+func (s *RegisterRequest) SetName(val OptString) {
+	s.Name = val
+}
+
+// Parts of a guess score, the score equals base + bonus - penalty (but not less than 0).
+// Ref: #/ScoreBreakdown
+type ScoreBreakdown struct {
+	Base    int `json:"base"`
+	Bonus   int `json:"bonus"`
+	Penalty int `json:"penalty"`
+}
+
+// GetBase returns the value of Base.
+func (s *ScoreBreakdown) GetBase() int {
+	return s.Base
+}
+
+// GetBonus returns the value of Bonus.
+func (s *ScoreBreakdown) GetBonus() int {
+	return s.Bonus
+}
+
+// GetPenalty returns the value of Penalty.
+func (s *ScoreBreakdown) GetPenalty() int {
+	return s.Penalty
+}
+
+// SetBase sets the value of Base.
+func (s *ScoreBreakdown) SetBase(val int) {
+	s.Base = val
+}
+
+// SetBonus sets the value of Bonus.
+func (s *ScoreBreakdown) SetBonus(val int) {
+	s.Bonus = val
+}
+
+// SetPenalty sets the value of Penalty.
+func (s *ScoreBreakdown) SetPenalty(val int) {
+	s.Penalty = val
+}
+
+type ScoreDistance float64
+
+// Scoring of a game rounds: "classic" by distance only, "timed" adds a bonus for fast guesses,
+// "hard" decreases the score faster with the distance,
+// "country" adds a bonus for guesses in the country of the location.
+// Ref: #/ScoringMode
+type ScoringMode string
+
+const (
+	ScoringModeClassic ScoringMode = "classic"
+	ScoringModeTimed   ScoringMode = "timed"
+	ScoringModeHard    ScoringMode = "hard"
+	ScoringModeCountry ScoringMode = "country"
+)
+
+// AllValues returns all ScoringMode values.
+func (ScoringMode) AllValues() []ScoringMode {
+	return []ScoringMode{
+		ScoringModeClassic,
+		ScoringModeTimed,
+		ScoringModeHard,
+		ScoringModeCountry,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ScoringMode) MarshalText() ([]byte, error) {
+	switch s {
+	case ScoringModeClassic:
+		return []byte(s), nil
+	case ScoringModeTimed:
+		return []byte(s), nil
+	case ScoringModeHard:
+		return []byte(s), nil
+	case ScoringModeCountry:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ScoringMode) UnmarshalText(data []byte) error {
+	switch ScoringMode(data) {
+	case ScoringModeClassic:
+		*s = ScoringModeClassic
+		return nil
+	case ScoringModeTimed:
+		*s = ScoringModeTimed
+		return nil
+	case ScoringModeHard:
+		*s = ScoringModeHard
+		return nil
+	case ScoringModeCountry:
+		*s = ScoringModeCountry
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/SingleplayerChallenge
+type SingleplayerChallenge struct {
+	Token string `json:"token"`
+	// ID of the game the challenge was created from.
+	GameID int `json:"gameID"`
+	// ID of the challenge creator.
+	UserID          int                           `json:"userID"`
+	Rounds          int                           `json:"rounds"`
+	TimerSeconds    int                           `json:"timerSeconds"`
+	MovementAllowed bool                          `json:"movementAllowed"`
+	Provider        GameProvider                  `json:"provider"`
+	Providers       Providers                     `json:"providers"`
+	ScoreDistance   OptScoreDistance              `json:"scoreDistance"`
+	Scoring         ScoringMode                   `json:"scoring"`
+	CreatedAt       time.Time                     `json:"createdAt"`
+	Results         []SingleplayerChallengeResult `json:"results"`
+}
+
+// GetToken returns the value of Token.
+func (s *SingleplayerChallenge) GetToken() string {
+	return s.Token
+}
+
+// GetGameID returns the value of GameID.
+func (s *SingleplayerChallenge) GetGameID() int {
+	return s.GameID
+}
+
+// GetUserID returns the value of UserID.
+func (s *SingleplayerChallenge) GetUserID() int {
+	return s.UserID
+}
+
+// GetRounds returns the value of Rounds.
+func (s *SingleplayerChallenge) GetRounds() int {
+	return s.Rounds
+}
+
+// GetTimerSeconds returns the value of TimerSeconds.
+func (s *SingleplayerChallenge) GetTimerSeconds() int {
+	return s.TimerSeconds
+}
+
+// GetMovementAllowed returns the value of MovementAllowed.
+func (s *SingleplayerChallenge) GetMovementAllowed() bool {
+	return s.MovementAllowed
+}
+
+// GetProvider returns the value of Provider.
+func (s *SingleplayerChallenge) GetProvider() GameProvider {
+	return s.Provider
+}
+
+// GetProviders returns the value of Providers.
+func (s *SingleplayerChallenge) GetProviders() Providers {
+	return s.Providers
+}
+
+// GetScoreDistance returns the value of ScoreDistance.
+func (s *SingleplayerChallenge) GetScoreDistance() OptScoreDistance {
+	return s.ScoreDistance
+}
+
+// GetScoring returns the value of Scoring.
+func (s *SingleplayerChallenge) GetScoring() ScoringMode {
+	return s.Scoring
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *SingleplayerChallenge) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetResults returns the value of Results.
+func (s *SingleplayerChallenge) GetResults() []SingleplayerChallengeResult {
+	return s.Results
+}
+
+// SetToken sets the value of Token.
+func (s *SingleplayerChallenge) SetToken(val string) {
+	s.Token = val
+}
+
+// SetGameID sets the value of GameID.
+func (s *SingleplayerChallenge) SetGameID(val int) {
+	s.GameID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *SingleplayerChallenge) SetUserID(val int) {
+	s.UserID = val
+}
+
+// SetRounds sets the value of Rounds.
+func (s *SingleplayerChallenge) SetRounds(val int) {
+	s.Rounds = val
+}
+
+// SetTimerSeconds sets the value of TimerSeconds.
+func (s *SingleplayerChallenge) SetTimerSeconds(val int) {
+	s.TimerSeconds = val
+}
+
+// SetMovementAllowed sets the value of MovementAllowed.
+func (s *SingleplayerChallenge) SetMovementAllowed(val bool) {
+	s.MovementAllowed = val
+}
+
+// SetProvider sets the value of Provider.
+func (s *SingleplayerChallenge) SetProvider(val GameProvider) {
+	s.Provider = val
+}
+
+// SetProviders sets the value of Providers.
+func (s *SingleplayerChallenge) SetProviders(val Providers) {
+	s.Providers = val
+}
+
+// SetScoreDistance sets the value of ScoreDistance.
+func (s *SingleplayerChallenge) SetScoreDistance(val OptScoreDistance) {
+	s.ScoreDistance = val
+}
+
+// SetScoring sets the value of Scoring.
+func (s *SingleplayerChallenge) SetScoring(val ScoringMode) {
+	s.Scoring = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *SingleplayerChallenge) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetResults sets the value of Results.
+func (s *SingleplayerChallenge) SetResults(val []SingleplayerChallengeResult) {
+	s.Results = val
+}
+
+func (*SingleplayerChallenge) getSingleplayerChallengeRes() {}
+
+// Ref: #/SingleplayerChallengeResult
+type SingleplayerChallengeResult struct {
+	GameID     int    `json:"gameID"`
+	UserID     int    `json:"userID"`
+	Username   string `json:"username"`
+	AvatarHash string `json:"avatarHash"`
+	Score      int    `json:"score"`
+	Finished   bool   `json:"finished"`
+	// Round by round results, empty until the user finished their own game of the challenge.
+	Guesses []SingleplayerRoundsWithGuess `json:"guesses"`
+}
+
+// GetGameID returns the value of GameID.
+func (s *SingleplayerChallengeResult) GetGameID() int {
+	return s.GameID
+}
+
+// GetUserID returns the value of UserID.
+func (s *SingleplayerChallengeResult) GetUserID() int {
+	return s.UserID
+}
+
+// GetUsername returns the value of Username.
+func (s *SingleplayerChallengeResult) GetUsername() string {
+	return s.Username
+}
+
+// GetAvatarHash returns the value of AvatarHash.
+func (s *SingleplayerChallengeResult) GetAvatarHash() string {
+	return s.AvatarHash
+}
+
+// GetScore returns the value of Score.
+func (s *SingleplayerChallengeResult) GetScore() int {
+	return s.Score
+}
+
+// GetFinished returns the value of Finished.
+func (s *SingleplayerChallengeResult) GetFinished() bool {
+	return s.Finished
+}
+
+// GetGuesses returns the value of Guesses.
+func (s *SingleplayerChallengeResult) GetGuesses() []SingleplayerRoundsWithGuess {
+	return s.Guesses
+}
+
+// SetGameID sets the value of GameID.
+func (s *SingleplayerChallengeResult) SetGameID(val int) {
+	s.GameID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *SingleplayerChallengeResult) SetUserID(val int) {
+	s.UserID = val
+}
+
+// SetUsername sets the value of Username.
+func (s *SingleplayerChallengeResult) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetAvatarHash sets the value of AvatarHash.
+func (s *SingleplayerChallengeResult) SetAvatarHash(val string) {
+	s.AvatarHash = val
+}
+
+// SetScore sets the value of Score.
+func (s *SingleplayerChallengeResult) SetScore(val int) {
+	s.Score = val
+}
+
+// SetFinished sets the value of Finished.
+func (s *SingleplayerChallengeResult) SetFinished(val bool) {
+	s.Finished = val
+}
+
+// SetGuesses sets the value of Guesses.
+func (s *SingleplayerChallengeResult) SetGuesses(val []SingleplayerRoundsWithGuess) {
+	s.Guesses = val
+}
+
+// Ref: #/SingleplayerGame
+type SingleplayerGame struct {
+	ID              int          `json:"id"`
+	UserID          int          `json:"userID"`
+	Rounds          int          `json:"rounds"`
+	RoundCurrent    int          `json:"roundCurrent"`
+	TimerSeconds    int          `json:"timerSeconds"`
+	MovementAllowed bool         `json:"movementAllowed"`
+	Provider        GameProvider `json:"provider"`
+	Providers       Providers    `json:"providers"`
+	Score           int          `json:"score"`
+	Finished        bool         `json:"finished"`
+	// Whether the game is played as a daily challenge.
+	Daily bool `json:"daily"`
+	// ID of the map the game is played on, not set for games on all provider locations.
+	MapID         OptInt           `json:"mapID"`
+	ScoreDistance OptScoreDistance `json:"scoreDistance"`
+	Scoring       ScoringMode      `json:"scoring"`
+	CreatedAt     time.Time        `json:"createdAt"`
+}
+
+// GetID returns the value of ID.
+func (s *SingleplayerGame) GetID() int {
+	return s.ID
+}
+
+// GetUserID returns the value of UserID.
+func (s *SingleplayerGame) GetUserID() int {
+	return s.UserID
+}
+
+// GetRounds returns the value of Rounds.
+func (s *SingleplayerGame) GetRounds() int {
+	return s.Rounds
+}
+
+// GetRoundCurrent returns the value of RoundCurrent.
+func (s *SingleplayerGame) GetRoundCurrent() int {
+	return s.RoundCurrent
+}
+
+// GetTimerSeconds returns the value of TimerSeconds.
+func (s *SingleplayerGame) GetTimerSeconds() int {
+	return s.TimerSeconds
+}
+
+// GetMovementAllowed returns the value of MovementAllowed.
+func (s *SingleplayerGame) GetMovementAllowed() bool {
+	return s.MovementAllowed
+}
+
+// GetProvider returns the value of Provider.
+func (s *SingleplayerGame) GetProvider() GameProvider {
+	return s.Provider
+}
+
+// GetProviders returns the value of Providers.
+func (s *SingleplayerGame) GetProviders() Providers {
+	return s.Providers
+}
+
+// GetScore returns the value of Score.
+func (s *SingleplayerGame) GetScore() int {
+	return s.Score
+}
+
+// GetFinished returns the value of Finished.
+func (s *SingleplayerGame) GetFinished() bool {
+	return s.Finished
+}
+
+// GetDaily returns the value of Daily.
+func (s *SingleplayerGame) GetDaily() bool {
+	return s.Daily
+}
+
+// GetMapID returns the value of MapID.
+func (s *SingleplayerGame) GetMapID() OptInt {
+	return s.MapID
+}
+
+// GetScoreDistance returns the value of ScoreDistance.
+func (s *SingleplayerGame) GetScoreDistance() OptScoreDistance {
+	return s.ScoreDistance
+}
+
+// GetScoring returns the value of Scoring.
+func (s *SingleplayerGame) GetScoring() ScoringMode {
+	return s.Scoring
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *SingleplayerGame) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *SingleplayerGame) SetID(val int) {
+	s.ID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *SingleplayerGame) SetUserID(val int) {
+	s.UserID = val
+}
+
+// SetRounds sets the value of Rounds.
+func (s *SingleplayerGame) SetRounds(val int) {
+	s.Rounds = val
+}
+
+// SetRoundCurrent sets the value of RoundCurrent.
+func (s *SingleplayerGame) SetRoundCurrent(val int) {
+	s.RoundCurrent = val
+}
+
+// SetTimerSeconds sets the value of TimerSeconds.
+func (s *SingleplayerGame) SetTimerSeconds(val int) {
+	s.TimerSeconds = val
+}
+
+// SetMovementAllowed sets the value of MovementAllowed.
+func (s *SingleplayerGame) SetMovementAllowed(val bool) {
+	s.MovementAllowed = val
+}
+
+// SetProvider sets the value of Provider.
+func (s *SingleplayerGame) SetProvider(val GameProvider) {
+	s.Provider = val
+}
+
+// SetProviders sets the value of Providers.
+func (s *SingleplayerGame) SetProviders(val Providers) {
+	s.Providers = val
+}
+
+// SetScore sets the value of Score.
+func (s *SingleplayerGame) SetScore(val int) {
+	s.Score = val
+}
+
+// SetFinished sets the value of Finished.
+func (s *SingleplayerGame) SetFinished(val bool) {
+	s.Finished = val
+}
+
+// SetDaily sets the value of Daily.
+func (s *SingleplayerGame) SetDaily(val bool) {
+	s.Daily = val
+}
+
+// SetMapID sets the value of MapID.
+func (s *SingleplayerGame) SetMapID(val OptInt) {
+	s.MapID = val
+}
+
+// SetScoreDistance sets the value of ScoreDistance.
+func (s *SingleplayerGame) SetScoreDistance(val OptScoreDistance) {
+	s.ScoreDistance = val
+}
+
+// SetScoring sets the value of Scoring.
+func (s *SingleplayerGame) SetScoring(val ScoringMode) {
+	s.Scoring = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *SingleplayerGame) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*SingleplayerGame) getSingleplayerGameRes() {}
+
+// Ref: #/SingleplayerGames
+type SingleplayerGames struct {
+	Total int                `json:"total"`
+	Games []SingleplayerGame `json:"games"`
+}
+
+// GetTotal returns the value of Total.
+func (s *SingleplayerGames) GetTotal() int {
+	return s.Total
+}
+
+// GetGames returns the value of Games.
+func (s *SingleplayerGames) GetGames() []SingleplayerGame {
+	return s.Games
+}
+
+// SetTotal sets the value of Total.
+func (s *SingleplayerGames) SetTotal(val int) {
+	s.Total = val
+}
+
+// SetGames sets the value of Games.
+func (s *SingleplayerGames) SetGames(val []SingleplayerGame) {
+	s.Games = val
+}
+
+func (*SingleplayerGames) getSingleplayerGamesRes() {}
+
+// Ref: #/SingleplayerRound
+type SingleplayerRound struct {
+	ID     int `json:"id"`
+	GameID int `json:"gameID"`
+	// Streetview ID of the panorama (only present after the round has finished, live rounds are loaded
+	// by panorama token).
+	StreetviewID OptString `json:"streetviewID"`
+	Provider     Provider  `json:"provider"`
+	RoundNum     int       `json:"roundNum"`
+	// Opaque short-lived token, that can be resolved to panorama location.
+	PanoramaToken string `json:"panoramaToken"`
+	// Real location of the round (only present after the round has finished).
+	Location    OptLatLng `json:"location"`
+	PanoramaURL string    `json:"panoramaURL"`
+	Finished    bool      `json:"finished"`
+	CreatedAt   time.Time `json:"createdAt"`
+	StartedAt   time.Time `json:"startedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *SingleplayerRound) GetID() int {
+	return s.ID
+}
+
+// GetGameID returns the value of GameID.
+func (s *SingleplayerRound) GetGameID() int {
+	return s.GameID
+}
+
+// GetStreetviewID returns the value of StreetviewID.
+func (s *SingleplayerRound) GetStreetviewID() OptString {
+	return s.StreetviewID
+}
+
+// GetProvider returns the value of Provider.
+func (s *SingleplayerRound) GetProvider() Provider {
+	return s.Provider
+}
+
+// GetRoundNum returns the value of RoundNum.
+func (s *SingleplayerRound) GetRoundNum() int {
+	return s.RoundNum
+}
+
+// GetPanoramaToken returns the value of PanoramaToken.
+func (s *SingleplayerRound) GetPanoramaToken() string {
+	return s.PanoramaToken
+}
+
+// GetLocation returns the value of Location.
+func (s *SingleplayerRound) GetLocation() OptLatLng {
+	return s.Location
+}
+
+// GetPanoramaURL returns the value of PanoramaURL.
+func (s *SingleplayerRound) GetPanoramaURL() string {
+	return s.PanoramaURL
+}
+
+// GetFinished returns the value of Finished.
+func (s *SingleplayerRound) GetFinished() bool {
+	return s.Finished
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *SingleplayerRound) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetStartedAt returns the value of StartedAt.
+func (s *SingleplayerRound) GetStartedAt() time.Time {
+	return s.StartedAt
+}
+
+// SetID sets the value of ID.
+func (s *SingleplayerRound) SetID(val int) {
+	s.ID = val
+}
+
+// SetGameID sets the value of GameID.
+func (s *SingleplayerRound) SetGameID(val int) {
+	s.GameID = val
+}
+
+// SetStreetviewID sets the value of StreetviewID.
+func (s *SingleplayerRound) SetStreetviewID(val OptString) {
+	s.StreetviewID = val
+}
+
+// SetProvider sets the value of Provider.
+func (s *SingleplayerRound) SetProvider(val Provider) {
+	s.Provider = val
+}
+
+// SetRoundNum sets the value of RoundNum.
+func (s *SingleplayerRound) SetRoundNum(val int) {
+	s.RoundNum = val
+}
+
+// SetPanoramaToken sets the value of PanoramaToken.
+func (s *SingleplayerRound) SetPanoramaToken(val string) {
+	s.PanoramaToken = val
+}
+
+// SetLocation sets the value of Location.
+func (s *SingleplayerRound) SetLocation(val OptLatLng) {
+	s.Location = val
+}
+
+// SetPanoramaURL sets the value of PanoramaURL.
+func (s *SingleplayerRound) SetPanoramaURL(val string) {
+	s.PanoramaURL = val
+}
+
+// SetFinished sets the value of Finished.
+func (s *SingleplayerRound) SetFinished(val bool) {
+	s.Finished = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *SingleplayerRound) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetStartedAt sets the value of StartedAt.
+func (s *SingleplayerRound) SetStartedAt(val time.Time) {
+	s.StartedAt = val
+}
+
+func (*SingleplayerRound) getSingleplayerRoundRes() {}
+func (*SingleplayerRound) newSingleplayerRoundRes() {}
+
+// Ref: #/SingleplayerRoundGuess
+type SingleplayerRoundGuess struct {
+	Guess LatLng `json:"guess"`
+}
+
+// GetGuess returns the value of Guess.
+func (s *SingleplayerRoundGuess) GetGuess() LatLng {
+	return s.Guess
+}
+
+// SetGuess sets the value of Guess.
+func (s *SingleplayerRoundGuess) SetGuess(val LatLng) {
+	s.Guess = val
+}
+
+// Ref: #/SingleplayerRoundsWithGuess
+type SingleplayerRoundsWithGuess struct {
+	RoundNum       int            `json:"roundNum"`
+	RoundLat       float64        `json:"roundLat"`
+	RoundLng       float64        `json:"roundLng"`
+	GuessLat       float64        `json:"guessLat"`
+	GuessLng       float64        `json:"guessLng"`
+	Score          int            `json:"score"`
+	ScoreBreakdown ScoreBreakdown `json:"scoreBreakdown"`
+	MissDistance   int            `json:"missDistance"`
+	RoundCountry   OptCountryCode `json:"roundCountry"`
+	GuessCountry   OptCountryCode `json:"guessCountry"`
+	// Whether the guess is in the country of the round location.
+	CorrectCountry bool `json:"correctCountry"`
+}
+
+// GetRoundNum returns the value of RoundNum.
+func (s *SingleplayerRoundsWithGuess) GetRoundNum() int {
+	return s.RoundNum
+}
+
+// GetRoundLat returns the value of RoundLat.
+func (s *SingleplayerRoundsWithGuess) GetRoundLat() float64 {
+	return s.RoundLat
+}
+
+// GetRoundLng returns the value of RoundLng.
+func (s *SingleplayerRoundsWithGuess) GetRoundLng() float64 {
+	return s.RoundLng
+}
+
+// GetGuessLat returns the value of GuessLat.
+func (s *SingleplayerRoundsWithGuess) GetGuessLat() float64 {
+	return s.GuessLat
+}
+
+// GetGuessLng returns the value of GuessLng.
+func (s *SingleplayerRoundsWithGuess) GetGuessLng() float64 {
+	return s.GuessLng
+}
+
+// GetScore returns the value of Score.
+func (s *SingleplayerRoundsWithGuess) GetScore() int {
+	return s.Score
+}
+
+// GetScoreBreakdown returns the value of ScoreBreakdown.
+func (s *SingleplayerRoundsWithGuess) GetScoreBreakdown() ScoreBreakdown {
+	return s.ScoreBreakdown
+}
+
+// GetMissDistance returns the value of MissDistance.
+func (s *SingleplayerRoundsWithGuess) GetMissDistance() int {
+	return s.MissDistance
+}
+
+// GetRoundCountry returns the value of RoundCountry.
+func (s *SingleplayerRoundsWithGuess) GetRoundCountry() OptCountryCode {
+	return s.RoundCountry
+}
+
+// GetGuessCountry returns the value of GuessCountry.
+func (s *SingleplayerRoundsWithGuess) GetGuessCountry() OptCountryCode {
+	return s.GuessCountry
+}
+
+// GetCorrectCountry returns the value of CorrectCountry.
+func (s *SingleplayerRoundsWithGuess) GetCorrectCountry() bool {
+	return s.CorrectCountry
+}
+
+// SetRoundNum sets the value of RoundNum.
+func (s *SingleplayerRoundsWithGuess) SetRoundNum(val int) {
+	s.RoundNum = val
+}
+
+// SetRoundLat sets the value of RoundLat.
+func (s *SingleplayerRoundsWithGuess) SetRoundLat(val float64) {
+	s.RoundLat = val
+}
+
+// SetRoundLng sets the value of RoundLng.
+func (s *SingleplayerRoundsWithGuess) SetRoundLng(val float64) {
+	s.RoundLng = val
+}
+
+// SetGuessLat sets the value of GuessLat.
+func (s *SingleplayerRoundsWithGuess) SetGuessLat(val float64) {
+	s.GuessLat = val
+}
+
+// SetGuessLng sets the value of GuessLng.
+func (s *SingleplayerRoundsWithGuess) SetGuessLng(val float64) {
+	s.GuessLng = val
+}
+
+// SetScore sets the value of Score.
+func (s *SingleplayerRoundsWithGuess) SetScore(val int) {
+	s.Score = val
+}
+
+// SetScoreBreakdown sets the value of ScoreBreakdown.
+func (s *SingleplayerRoundsWithGuess) SetScoreBreakdown(val ScoreBreakdown) {
+	s.ScoreBreakdown = val
+}
+
+// SetMissDistance sets the value of MissDistance.
+func (s *SingleplayerRoundsWithGuess) SetMissDistance(val int) {
+	s.MissDistance = val
 }
 
-// SetPassword sets the value of Password.
-func (s *RegisterRequest) SetPassword(val string) {
-	s.Password = val
+// SetRoundCountry sets the value of RoundCountry.
+func (s *SingleplayerRoundsWithGuess) SetRoundCountry(val OptCountryCode) {
+	s.RoundCountry = val
 }
 
-// SetName sets the value of Name.
-func (s *RegisterRequest) SetName(val OptString) {
-	s.Name = val
+// SetGuessCountry sets the value of GuessCountry.
+func (s *SingleplayerRoundsWithGuess) SetGuessCountry(val OptCountryCode) {
+	s.GuessCountry = val
 }
 
-// Ref: #/SingleplayerGame
-type SingleplayerGame struct {
-	ID              int       `json:"id"`
-	UserID          int       `json:"userID"`
-	Rounds          int       `json:"rounds"`
-	RoundCurrent    int       `json:"roundCurrent"`
-	TimerSeconds    int       `json:"timerSeconds"`
-	MovementAllowed bool      `json:"movementAllowed"`
-	Provider        Provider  `json:"provider"`
-	Score           int       `json:"score"`
-	Finished        bool      `json:"finished"`
-	CreatedAt       time.Time `json:"createdAt"`
+// SetCorrectCountry sets the value of CorrectCountry.
+func (s *SingleplayerRoundsWithGuess) SetCorrectCountry(val bool) {
+	s.CorrectCountry = val
+}
+
+// Ref: #/StreakGame
+type StreakGame struct {
+	ID              int          `json:"id"`
+	UserID          int          `json:"userID"`
+	RoundCurrent    int          `json:"roundCurrent"`
+	TimerSeconds    int          `json:"timerSeconds"`
+	MovementAllowed bool         `json:"movementAllowed"`
+	Provider        GameProvider `json:"provider"`
+	Providers       Providers    `json:"providers"`
+	// Amount of correctly guessed rounds in a row.
+	Streak int `json:"streak"`
+	// Whether the game has ended with a miss.
+	Finished  bool        `json:"finished"`
+	CreatedAt time.Time   `json:"createdAt"`
+	EndedAt   OptDateTime `json:"endedAt"`
 }
 
 // GetID returns the value of ID.
-func (s *SingleplayerGame) GetID() int {
+func (s *StreakGame) GetID() int {
 	return s.ID
 }
 
 // GetUserID returns the value of UserID.
-func (s *SingleplayerGame) GetUserID() int {
+func (s *StreakGame) GetUserID() int {
 	return s.UserID
 }
 
-// GetRounds returns the value of Rounds.
-func (s *SingleplayerGame) GetRounds() int {
-	return s.Rounds
-}
-
 // GetRoundCurrent returns the value of RoundCurrent.
-func (s *SingleplayerGame) GetRoundCurrent() int {
+func (s *StreakGame) GetRoundCurrent() int {
 	return s.RoundCurrent
 }
 
 // GetTimerSeconds returns the value of TimerSeconds.
-func (s *SingleplayerGame) GetTimerSeconds() int {
+func (s *StreakGame) GetTimerSeconds() int {
 	return s.TimerSeconds
 }
 
 // GetMovementAllowed returns the value of MovementAllowed.
-func (s *SingleplayerGame) GetMovementAllowed() bool {
+func (s *StreakGame) GetMovementAllowed() bool {
 	return s.MovementAllowed
 }
 
 // GetProvider returns the value of Provider.
-func (s *SingleplayerGame) GetProvider() Provider {
+func (s *StreakGame) GetProvider() GameProvider {
 	return s.Provider
 }
 
-// GetScore returns the value of Score.
-func (s *SingleplayerGame) GetScore() int {
-	return s.Score
+// GetProviders returns the value of Providers.
+func (s *StreakGame) GetProviders() Providers {
+	return s.Providers
+}
+
+// GetStreak returns the value of Streak.
+func (s *StreakGame) GetStreak() int {
+	return s.Streak
 }
 
 // GetFinished returns the value of Finished.
-func (s *SingleplayerGame) GetFinished() bool {
+func (s *StreakGame) GetFinished() bool {
 	return s.Finished
 }
 
 // GetCreatedAt returns the value of CreatedAt.
-func (s *SingleplayerGame) GetCreatedAt() time.Time {
+func (s *StreakGame) GetCreatedAt() time.Time {
 	return s.CreatedAt
 }
 
+// GetEndedAt returns the value of EndedAt.
+func (s *StreakGame) GetEndedAt() OptDateTime {
+	return s.EndedAt
+}
+
 // SetID sets the value of ID.
-func (s *SingleplayerGame) SetID(val int) {
+func (s *StreakGame) SetID(val int) {
 	s.ID = val
 }
 
 // SetUserID sets the value of UserID.
-func (s *SingleplayerGame) SetUserID(val int) {
+func (s *StreakGame) SetUserID(val int) {
 	s.UserID = val
 }
 
-// SetRounds sets the value of Rounds.
-func (s *SingleplayerGame) SetRounds(val int) {
-	s.Rounds = val
-}
-
 // SetRoundCurrent sets the value of RoundCurrent.
-func (s *SingleplayerGame) SetRoundCurrent(val int) {
+func (s *StreakGame) SetRoundCurrent(val int) {
 	s.RoundCurrent = val
 }
 
 // SetTimerSeconds sets the value of TimerSeconds.
-func (s *SingleplayerGame) SetTimerSeconds(val int) {
+func (s *StreakGame) SetTimerSeconds(val int) {
 	s.TimerSeconds = val
 }
 
 // SetMovementAllowed sets the value of MovementAllowed.
-func (s *SingleplayerGame) SetMovementAllowed(val bool) {
+func (s *StreakGame) SetMovementAllowed(val bool) {
 	s.MovementAllowed = val
 }
 
 // SetProvider sets the value of Provider.
-func (s *SingleplayerGame) SetProvider(val Provider) {
+func (s *StreakGame) SetProvider(val GameProvider) {
 	s.Provider = val
 }
 
-// SetScore sets the value of Score.
-func (s *SingleplayerGame) SetScore(val int) {
-	s.Score = val
+// SetProviders sets the value of Providers.
+func (s *StreakGame) SetProviders(val Providers) {
+	s.Providers = val
+}
+
+// SetStreak sets the value of Streak.
+func (s *StreakGame) SetStreak(val int) {
+	s.Streak = val
 }
 
 // SetFinished sets the value of Finished.
-func (s *SingleplayerGame) SetFinished(val bool) {
+func (s *StreakGame) SetFinished(val bool) {
 	s.Finished = val
 }
 
 // SetCreatedAt sets the value of CreatedAt.
-func (s *SingleplayerGame) SetCreatedAt(val time.Time) {
+func (s *StreakGame) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
 }
 
-func (*SingleplayerGame) getSingleplayerGameRes() {}
+// SetEndedAt sets the value of EndedAt.
+func (s *StreakGame) SetEndedAt(val OptDateTime) {
+	s.EndedAt = val
+}
 
-// Ref: #/SingleplayerGames
-type SingleplayerGames struct {
-	Total int                `json:"total"`
-	Games []SingleplayerGame `json:"games"`
+func (*StreakGame) getStreakGameRes() {}
+
+// Ref: #/StreakLeaderboard
+type StreakLeaderboard struct {
+	Total   int                       `json:"total"`
+	Results []StreakLeaderboardResult `json:"results"`
 }
 
 // GetTotal returns the value of Total.
-func (s *SingleplayerGames) GetTotal() int {
+func (s *StreakLeaderboard) GetTotal() int {
 	return s.Total
 }
 
-// GetGames returns the value of Games.
-func (s *SingleplayerGames) GetGames() []SingleplayerGame {
-	return s.Games
+// GetResults returns the value of Results.
+func (s *StreakLeaderboard) GetResults() []StreakLeaderboardResult {
+	return s.Results
 }
 
 // SetTotal sets the value of Total.
-func (s *SingleplayerGames) SetTotal(val int) {
+func (s *StreakLeaderboard) SetTotal(val int) {
 	s.Total = val
 }
 
-// SetGames sets the value of Games.
-func (s *SingleplayerGames) SetGames(val []SingleplayerGame) {
-	s.Games = val
+// SetResults sets the value of Results.
+func (s *StreakLeaderboard) SetResults(val []StreakLeaderboardResult) {
+	s.Results = val
 }
 
-func (*SingleplayerGames) getSingleplayerGamesRes() {}
+func (*StreakLeaderboard) getStreakLeaderboardRes() {}
 
-// Ref: #/SingleplayerRound
-type SingleplayerRound struct {
-	ID           int       `json:"id"`
-	GameID       int       `json:"gameID"`
-	StreetviewID string    `json:"streetviewID"`
+// Ref: #/StreakLeaderboardResult
+type StreakLeaderboardResult struct {
+	Place      int       `json:"place"`
+	UserID     int       `json:"userID"`
+	Username   string    `json:"username"`
+	AvatarHash string    `json:"avatarHash"`
+	GameID     int       `json:"gameID"`
+	Streak     int       `json:"streak"`
+	EndedAt    time.Time `json:"endedAt"`
+}
+
+// GetPlace returns the value of Place.
+func (s *StreakLeaderboardResult) GetPlace() int {
+	return s.Place
+}
+
+// GetUserID returns the value of UserID.
+func (s *StreakLeaderboardResult) GetUserID() int {
+	return s.UserID
+}
+
+// GetUsername returns the value of Username.
+func (s *StreakLeaderboardResult) GetUsername() string {
+	return s.Username
+}
+
+// GetAvatarHash returns the value of AvatarHash.
+func (s *StreakLeaderboardResult) GetAvatarHash() string {
+	return s.AvatarHash
+}
+
+// GetGameID returns the value of GameID.
+func (s *StreakLeaderboardResult) GetGameID() int {
+	return s.GameID
+}
+
+// GetStreak returns the value of Streak.
+func (s *StreakLeaderboardResult) GetStreak() int {
+	return s.Streak
+}
+
+// GetEndedAt returns the value of EndedAt.
+func (s *StreakLeaderboardResult) GetEndedAt() time.Time {
+	return s.EndedAt
+}
+
+// SetPlace sets the value of Place.
+func (s *StreakLeaderboardResult) SetPlace(val int) {
+	s.Place = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *StreakLeaderboardResult) SetUserID(val int) {
+	s.UserID = val
+}
+
+// SetUsername sets the value of Username.
+func (s *StreakLeaderboardResult) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetAvatarHash sets the value of AvatarHash.
+func (s *StreakLeaderboardResult) SetAvatarHash(val string) {
+	s.AvatarHash = val
+}
+
+// SetGameID sets the value of GameID.
+func (s *StreakLeaderboardResult) SetGameID(val int) {
+	s.GameID = val
+}
+
+// SetStreak sets the value of Streak.
+func (s *StreakLeaderboardResult) SetStreak(val int) {
+	s.Streak = val
+}
+
+// SetEndedAt sets the value of EndedAt.
+func (s *StreakLeaderboardResult) SetEndedAt(val time.Time) {
+	s.EndedAt = val
+}
+
+// Ref: #/StreakRound
+type StreakRound struct {
+	ID     int `json:"id"`
+	GameID int `json:"gameID"`
+	// Streetview ID of the panorama (only present after the round has finished, live rounds are loaded
+	// by panorama token).
+	StreetviewID OptString `json:"streetviewID"`
+	Provider     Provider  `json:"provider"`
 	RoundNum     int       `json:"roundNum"`
-	Lat          float64   `json:"lat"`
-	Lng          float64   `json:"lng"`
-	PanoramaURL  string    `json:"panoramaURL"`
-	Finished     bool      `json:"finished"`
-	CreatedAt    time.Time `json:"createdAt"`
-	StartedAt    time.Time `json:"startedAt"`
+	// Opaque short-lived token, that can be resolved to panorama location.
+	PanoramaToken string `json:"panoramaToken"`
+	// Real location of the round (only present after the round has finished).
+	Location OptLatLng `json:"location"`
+	// Country of the round location (only present after the round has finished).
+	Country      OptCountryCode `json:"country"`
+	GuessCountry OptCountryCode `json:"guessCountry"`
+	Correct      bool           `json:"correct"`
+	Finished     bool           `json:"finished"`
+	CreatedAt    time.Time      `json:"createdAt"`
+	StartedAt    time.Time      `json:"startedAt"`
 }
 
 // GetID returns the value of ID.
-func (s *SingleplayerRound) GetID() int {
+func (s *StreakRound) GetID() int {
 	return s.ID
 }
 
 // GetGameID returns the value of GameID.
-func (s *SingleplayerRound) GetGameID() int {
+func (s *StreakRound) GetGameID() int {
 	return s.GameID
 }
 
 // GetStreetviewID returns the value of StreetviewID.
-func (s *SingleplayerRound) GetStreetviewID() string {
+func (s *StreakRound) GetStreetviewID() OptString {
 	return s.StreetviewID
 }
 
+// GetProvider returns the value of Provider.
+func (s *StreakRound) GetProvider() Provider {
+	return s.Provider
+}
+
 // GetRoundNum returns the value of RoundNum.
-func (s *SingleplayerRound) GetRoundNum() int {
+func (s *StreakRound) GetRoundNum() int {
 	return s.RoundNum
 }
 
-// GetLat returns the value of Lat.
-func (s *SingleplayerRound) GetLat() float64 {
-	return s.Lat
+// GetPanoramaToken returns the value of PanoramaToken.
+func (s *StreakRound) GetPanoramaToken() string {
+	return s.PanoramaToken
 }
 
-// GetLng returns the value of Lng.
-func (s *SingleplayerRound) GetLng() float64 {
-	return s.Lng
+// GetLocation returns the value of Location.
+func (s *StreakRound) GetLocation() OptLatLng {
+	return s.Location
 }
 
-// GetPanoramaURL returns the value of PanoramaURL.
-func (s *SingleplayerRound) GetPanoramaURL() string {
-	return s.PanoramaURL
+// GetCountry returns the value of Country.
+func (s *StreakRound) GetCountry() OptCountryCode {
+	return s.Country
+}
+
+// GetGuessCountry returns the value of GuessCountry.
+func (s *StreakRound) GetGuessCountry() OptCountryCode {
+	return s.GuessCountry
+}
+
+// GetCorrect returns the value of Correct.
+func (s *StreakRound) GetCorrect() bool {
+	return s.Correct
 }
 
 // GetFinished returns the value of Finished.
-func (s *SingleplayerRound) GetFinished() bool {
+func (s *StreakRound) GetFinished() bool {
 	return s.Finished
 }
 
 // GetCreatedAt returns the value of CreatedAt.
-func (s *SingleplayerRound) GetCreatedAt() time.Time {
+func (s *StreakRound) GetCreatedAt() time.Time {
 	return s.CreatedAt
 }
 
 // GetStartedAt returns the value of StartedAt.
-func (s *SingleplayerRound) GetStartedAt() time.Time {
+func (s *StreakRound) GetStartedAt() time.Time {
 	return s.StartedAt
 }
 
 // SetID sets the value of ID.
-func (s *SingleplayerRound) SetID(val int) {
+func (s *StreakRound) SetID(val int) {
 	s.ID = val
 }
 
 // SetGameID sets the value of GameID.
-func (s *SingleplayerRound) SetGameID(val int) {
+func (s *StreakRound) SetGameID(val int) {
 	s.GameID = val
 }
 
 // SetStreetviewID sets the value of StreetviewID.
-func (s *SingleplayerRound) SetStreetviewID(val string) {
+func (s *StreakRound) SetStreetviewID(val OptString) {
 	s.StreetviewID = val
 }
 
+// SetProvider sets the value of Provider.
+func (s *StreakRound) SetProvider(val Provider) {
+	s.Provider = val
+}
+
 // SetRoundNum sets the value of RoundNum.
-func (s *SingleplayerRound) SetRoundNum(val int) {
+func (s *StreakRound) SetRoundNum(val int) {
 	s.RoundNum = val
 }
 
-// SetLat sets the value of Lat.
-func (s *SingleplayerRound) SetLat(val float64) {
-	s.Lat = val
+// SetPanoramaToken sets the value of PanoramaToken.
+func (s *StreakRound) SetPanoramaToken(val string) {
+	s.PanoramaToken = val
 }
 
-// SetLng sets the value of Lng.
-func (s *SingleplayerRound) SetLng(val float64) {
-	s.Lng = val
+// SetLocation sets the value of Location.
+func (s *StreakRound) SetLocation(val OptLatLng) {
+	s.Location = val
 }
 
-// SetPanoramaURL sets the value of PanoramaURL.
-func (s *SingleplayerRound) SetPanoramaURL(val string) {
-	s.PanoramaURL = val
+// SetCountry sets the value of Country.
+func (s *StreakRound) SetCountry(val OptCountryCode) {
+	s.Country = val
+}
+
+// SetGuessCountry sets the value of GuessCountry.
+func (s *StreakRound) SetGuessCountry(val OptCountryCode) {
+	s.GuessCountry = val
+}
+
+// SetCorrect sets the value of Correct.
+func (s *StreakRound) SetCorrect(val bool) {
+	s.Correct = val
 }
 
 // SetFinished sets the value of Finished.
-func (s *SingleplayerRound) SetFinished(val bool) {
+func (s *StreakRound) SetFinished(val bool) {
 	s.Finished = val
 }
 
 // SetCreatedAt sets the value of CreatedAt.
-func (s *SingleplayerRound) SetCreatedAt(val time.Time) {
+func (s *StreakRound) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
 }
 
 // SetStartedAt sets the value of StartedAt.
-func (s *SingleplayerRound) SetStartedAt(val time.Time) {
+func (s *StreakRound) SetStartedAt(val time.Time) {
 	s.StartedAt = val
 }
 
-func (*SingleplayerRound) getSingleplayerRoundRes() {}
-func (*SingleplayerRound) newSingleplayerRoundRes() {}
+func (*StreakRound) getStreakRoundRes() {}
+func (*StreakRound) newStreakRoundRes() {}
 
-// Ref: #/SingleplayerRoundGuess
-type SingleplayerRoundGuess struct {
-	Guess LatLng `json:"guess"`
+// Guess of a country, either named by its code or clicked on the map. Country code is used if both
+// are set.
+// Ref: #/StreakRoundGuess
+type StreakRoundGuess struct {
+	Country OptCountryCode `json:"country"`
+	Guess   OptLatLng      `json:"guess"`
+}
+
+// GetCountry returns the value of Country.
+func (s *StreakRoundGuess) GetCountry() OptCountryCode {
+	return s.Country
 }
 
 // GetGuess returns the value of Guess.
-func (s *SingleplayerRoundGuess) GetGuess() LatLng {
+func (s *StreakRoundGuess) GetGuess() OptLatLng {
 	return s.Guess
 }
 
+// SetCountry sets the value of Country.
+func (s *StreakRoundGuess) SetCountry(val OptCountryCode) {
+	s.Country = val
+}
+
 // SetGuess sets the value of Guess.
-func (s *SingleplayerRoundGuess) SetGuess(val LatLng) {
+func (s *StreakRoundGuess) SetGuess(val OptLatLng) {
 	s.Guess = val
 }
 
-// Ref: #/SingleplayerRoundsWithGuess
-type SingleplayerRoundsWithGuess struct {
-	RoundNum     int     `json:"roundNum"`
-	RoundLat     float64 `json:"roundLat"`
-	RoundLng     float64 `json:"roundLng"`
-	GuessLat     float64 `json:"guessLat"`
-	GuessLng     float64 `json:"guessLng"`
-	Score        int     `json:"score"`
-	MissDistance int     `json:"missDistance"`
-}
+// How scores of team members are combined into a team score of a round:
+// "best" counts only the best guess of the team, "average" counts the average score of all team
+// members.
+// Ref: #/TeamScoring
+type TeamScoring string
 
-// GetRoundNum returns the value of RoundNum.
-func (s *SingleplayerRoundsWithGuess) GetRoundNum() int {
-	return s.RoundNum
-}
+const (
+	TeamScoringBest    TeamScoring = "best"
+	TeamScoringAverage TeamScoring = "average"
+)
 
-// GetRoundLat returns the value of RoundLat.
-func (s *SingleplayerRoundsWithGuess) GetRoundLat() float64 {
-	return s.RoundLat
+// AllValues returns all TeamScoring values.
+func (TeamScoring) AllValues() []TeamScoring {
+	return []TeamScoring{
+		TeamScoringBest,
+		TeamScoringAverage,
+	}
 }
 
-// GetRoundLng returns the value of RoundLng.
-func (s *SingleplayerRoundsWithGuess) GetRoundLng() float64 {
-	return s.RoundLng
+// MarshalText implements encoding.TextMarshaler.
+func (s TeamScoring) MarshalText() ([]byte, error) {
+	switch s {
+	case TeamScoringBest:
+		return []byte(s), nil
+	case TeamScoringAverage:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetGuessLat returns the value of GuessLat.
-func (s *SingleplayerRoundsWithGuess) GetGuessLat() float64 {
-	return s.GuessLat
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TeamScoring) UnmarshalText(data []byte) error {
+	switch TeamScoring(data) {
+	case TeamScoringBest:
+		*s = TeamScoringBest
+		return nil
+	case TeamScoringAverage:
+		*s = TeamScoringAverage
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetGuessLng returns the value of GuessLng.
-func (s *SingleplayerRoundsWithGuess) GetGuessLng() float64 {
-	return s.GuessLng
-}
+type UpdateMapForbidden Error
 
-// GetScore returns the value of Score.
-func (s *SingleplayerRoundsWithGuess) GetScore() int {
-	return s.Score
-}
+func (*UpdateMapForbidden) updateMapRes() {}
 
-// GetMissDistance returns the value of MissDistance.
-func (s *SingleplayerRoundsWithGuess) GetMissDistance() int {
-	return s.MissDistance
-}
+type UpdateMapInternalServerError Error
 
-// SetRoundNum sets the value of RoundNum.
-func (s *SingleplayerRoundsWithGuess) SetRoundNum(val int) {
-	s.RoundNum = val
+func (*UpdateMapInternalServerError) updateMapRes() {}
+
+// UpdateMapNoContent is response for UpdateMap operation.
+type UpdateMapNoContent struct{}
+
+func (*UpdateMapNoContent) updateMapRes() {}
+
+type UpdateMapNotFound Error
+
+func (*UpdateMapNotFound) updateMapRes() {}
+
+// Ref: #/UpdateMapRequest
+type UpdateMapRequest struct {
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	Visibility  MapVisibility `json:"visibility"`
 }
 
-// SetRoundLat sets the value of RoundLat.
-func (s *SingleplayerRoundsWithGuess) SetRoundLat(val float64) {
-	s.RoundLat = val
+// GetName returns the value of Name.
+func (s *UpdateMapRequest) GetName() string {
+	return s.Name
 }
 
-// SetRoundLng sets the value of RoundLng.
-func (s *SingleplayerRoundsWithGuess) SetRoundLng(val float64) {
-	s.RoundLng = val
+// GetDescription returns the value of Description.
+func (s *UpdateMapRequest) GetDescription() string {
+	return s.Description
 }
 
-// SetGuessLat sets the value of GuessLat.
-func (s *SingleplayerRoundsWithGuess) SetGuessLat(val float64) {
-	s.GuessLat = val
+// GetVisibility returns the value of Visibility.
+func (s *UpdateMapRequest) GetVisibility() MapVisibility {
+	return s.Visibility
 }
 
-// SetGuessLng sets the value of GuessLng.
-func (s *SingleplayerRoundsWithGuess) SetGuessLng(val float64) {
-	s.GuessLng = val
+// SetName sets the value of Name.
+func (s *UpdateMapRequest) SetName(val string) {
+	s.Name = val
 }
 
-// SetScore sets the value of Score.
-func (s *SingleplayerRoundsWithGuess) SetScore(val int) {
-	s.Score = val
+// SetDescription sets the value of Description.
+func (s *UpdateMapRequest) SetDescription(val string) {
+	s.Description = val
 }
 
-// SetMissDistance sets the value of MissDistance.
-func (s *SingleplayerRoundsWithGuess) SetMissDistance(val int) {
-	s.MissDistance = val
+// SetVisibility sets the value of Visibility.
+func (s *UpdateMapRequest) SetVisibility(val MapVisibility) {
+	s.Visibility = val
 }
 
+type UpdateMapUnauthorized Error
+
+func (*UpdateMapUnauthorized) updateMapRes() {}
+
 type UpdateUserAvatarInternalServerError Error
 
 func (*UpdateUserAvatarInternalServerError) updateUserAvatarRes() {}
@@ -10,8 +10,11 @@ import (
 type Handler interface {
 	AuthHandler
 	LobbiesHandler
+	MapsHandler
 	MultiplayerHandler
+	PanoramaHandler
 	SingleplayerHandler
+	StreakHandler
 	UsersHandler
 	// GetHealth implements getHealth operation.
 	//
@@ -135,7 +138,8 @@ type AuthHandler interface {
 type LobbiesHandler interface {
 	// GetLobbies implements getLobbies operation.
 	//
-	// Get all available lobbies.
+	// Get available lobbies matching all of the set filters, unlisted and invite-only lobbies are not
+	// listed.
 	//
 	// GET /v1/lobbies
 	GetLobbies(ctx context.Context, params GetLobbiesParams) (GetLobbiesRes, error)
@@ -153,6 +157,48 @@ type LobbiesHandler interface {
 	NewLobby(ctx context.Context, req *NewLobby) (NewLobbyRes, error)
 }
 
+// MapsHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: Maps
+type MapsHandler interface {
+	// AddMapLocations implements addMapLocations operation.
+	//
+	// Add existing panorama locations of the map provider to a map created by the authenticated user.
+	//
+	// POST /v1/maps/{id}/locations
+	AddMapLocations(ctx context.Context, req *AddMapLocationsRequest, params AddMapLocationsParams) (AddMapLocationsRes, error)
+	// GetMap implements getMap operation.
+	//
+	// Get map information by ID. Private maps are available only to their creator.
+	//
+	// GET /v1/maps/{id}
+	GetMap(ctx context.Context, params GetMapParams) (GetMapRes, error)
+	// GetMaps implements getMaps operation.
+	//
+	// Get public maps, or all maps of the authenticated user if `mine` is set.
+	//
+	// GET /v1/maps
+	GetMaps(ctx context.Context, params GetMapsParams) (GetMapsRes, error)
+	// ImportMapLocations implements importMapLocations operation.
+	//
+	// Import locations from CSV or GeoJSON to a map created by the authenticated user.
+	//
+	// POST /v1/maps/{id}/import
+	ImportMapLocations(ctx context.Context, req *ImportMapLocationsRequest, params ImportMapLocationsParams) (ImportMapLocationsRes, error)
+	// NewMap implements newMap operation.
+	//
+	// Create new map, optionally with existing panorama locations of the provider.
+	//
+	// POST /v1/maps
+	NewMap(ctx context.Context, req *NewMapRequest) (NewMapRes, error)
+	// UpdateMap implements updateMap operation.
+	//
+	// Update name, description and visibility of a map created by the authenticated user.
+	//
+	// PATCH /v1/maps/{id}
+	UpdateMap(ctx context.Context, req *UpdateMapRequest, params UpdateMapParams) (UpdateMapRes, error)
+}
+
 // MultiplayerHandler handles operations described by OpenAPI v3 specification.
 //
 // x-ogen-operation-group: Multiplayer
@@ -165,7 +211,8 @@ type MultiplayerHandler interface {
 	GetMultiplayerGame(ctx context.Context, params GetMultiplayerGameParams) (GetMultiplayerGameRes, error)
 	// GetMultiplayerGameGuesses implements getMultiplayerGameGuesses operation.
 	//
-	// Get multiplayer game user guesses.
+	// Get multiplayer game user guesses of finished rounds.
+	// Spectators get guesses of a round only after the spectator delay of the game.
 	//
 	// GET /v1/multiplayer/{id}/guesses
 	GetMultiplayerGameGuesses(ctx context.Context, params GetMultiplayerGameGuessesParams) (GetMultiplayerGameGuessesRes, error)
@@ -183,10 +230,34 @@ type MultiplayerHandler interface {
 	NewMultiplayerRound(ctx context.Context, params NewMultiplayerRoundParams) (NewMultiplayerRoundRes, error)
 }
 
+// PanoramaHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: Panorama
+type PanoramaHandler interface {
+	// GetPanorama implements getPanorama operation.
+	//
+	// Resolve opaque panorama token of the current round to panorama ID (without coordinates).
+	//
+	// GET /v1/panorama/{token}
+	GetPanorama(ctx context.Context, params GetPanoramaParams) (GetPanoramaRes, error)
+	// GetPanoramaProviders implements getPanoramaProviders operation.
+	//
+	// Get names of panorama providers, that games can be played on.
+	//
+	// GET /v1/panorama/providers
+	GetPanoramaProviders(ctx context.Context) (GetPanoramaProvidersRes, error)
+}
+
 // SingleplayerHandler handles operations described by OpenAPI v3 specification.
 //
 // x-ogen-operation-group: Singleplayer
 type SingleplayerHandler interface {
+	// AcceptSingleplayerChallenge implements acceptSingleplayerChallenge operation.
+	//
+	// Create new singleplayer game with locations and settings of the challenge.
+	//
+	// POST /v1/challenges/{id}/accept
+	AcceptSingleplayerChallenge(ctx context.Context, params AcceptSingleplayerChallengeParams) (AcceptSingleplayerChallengeRes, error)
 	// EndSingleplayerGame implements endSingleplayerGame operation.
 	//
 	// End singleplayer game.
@@ -199,6 +270,20 @@ type SingleplayerHandler interface {
 	//
 	// POST /v1/singleplayer/{id}/round/end
 	EndSingleplayerRound(ctx context.Context, req *SingleplayerRoundGuess, params EndSingleplayerRoundParams) (EndSingleplayerRoundRes, error)
+	// GetDailyChallengeLeaderboard implements getDailyChallengeLeaderboard operation.
+	//
+	// Get finished daily challenge games of a date, ranked by score. Players with equal score share the
+	// same place.
+	//
+	// GET /v1/daily-challenge/leaderboard
+	GetDailyChallengeLeaderboard(ctx context.Context, params GetDailyChallengeLeaderboardParams) (GetDailyChallengeLeaderboardRes, error)
+	// GetSingleplayerChallenge implements getSingleplayerChallenge operation.
+	//
+	// Get challenge settings and results of its creator and challengers. Guesses of the games are only
+	// included after the user finished their own game of the challenge.
+	//
+	// GET /v1/challenges/{id}
+	GetSingleplayerChallenge(ctx context.Context, params GetSingleplayerChallengeParams) (GetSingleplayerChallengeRes, error)
 	// GetSingleplayerGame implements getSingleplayerGame operation.
 	//
 	// Get singleplayer game information by ID.
@@ -223,6 +308,14 @@ type SingleplayerHandler interface {
 	//
 	// GET /v1/singleplayer/{id}/round
 	GetSingleplayerRound(ctx context.Context, params GetSingleplayerRoundParams) (GetSingleplayerRoundRes, error)
+	// NewSingleplayerChallenge implements newSingleplayerChallenge operation.
+	//
+	// Create a shareable challenge, that lets other players replay locations of a finished game with the
+	// same settings. Returns the existing challenge if it was already created. Daily challenge games
+	// can't be shared.
+	//
+	// POST /v1/singleplayer/{id}/challenge
+	NewSingleplayerChallenge(ctx context.Context, params NewSingleplayerChallengeParams) (NewSingleplayerChallengeRes, error)
 	// NewSingleplayerGame implements newSingleplayerGame operation.
 	//
 	// Create new singleplayer game.
@@ -237,6 +330,55 @@ type SingleplayerHandler interface {
 	NewSingleplayerRound(ctx context.Context, params NewSingleplayerRoundParams) (NewSingleplayerRoundRes, error)
 }
 
+// StreakHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: Streak
+type StreakHandler interface {
+	// EndStreakRound implements endStreakRound operation.
+	//
+	// Guess the country of the current round. The game ends if the country is wrong or the round timer
+	// has ended.
+	//
+	// POST /v1/streak/{id}/round/end
+	EndStreakRound(ctx context.Context, req *StreakRoundGuess, params EndStreakRoundParams) (EndStreakRoundRes, error)
+	// GetBestStreak implements getBestStreak operation.
+	//
+	// Get the longest streak among finished country streak games of the current user.
+	//
+	// GET /v1/streak/best
+	GetBestStreak(ctx context.Context) (GetBestStreakRes, error)
+	// GetStreakGame implements getStreakGame operation.
+	//
+	// Get country streak game information by ID.
+	//
+	// GET /v1/streak/{id}
+	GetStreakGame(ctx context.Context, params GetStreakGameParams) (GetStreakGameRes, error)
+	// GetStreakLeaderboard implements getStreakLeaderboard operation.
+	//
+	// Get best streaks of users, ranked by streak. Players with equal streak share the same place.
+	//
+	// GET /v1/streak/leaderboard
+	GetStreakLeaderboard(ctx context.Context, params GetStreakLeaderboardParams) (GetStreakLeaderboardRes, error)
+	// GetStreakRound implements getStreakRound operation.
+	//
+	// Get current round of country streak game.
+	//
+	// GET /v1/streak/{id}/round
+	GetStreakRound(ctx context.Context, params GetStreakRoundParams) (GetStreakRoundRes, error)
+	// NewStreakGame implements newStreakGame operation.
+	//
+	// Create new country streak game, that continues until the first wrongly guessed country.
+	//
+	// POST /v1/streak
+	NewStreakGame(ctx context.Context, req *NewStreakGameRequest) (NewStreakGameRes, error)
+	// NewStreakRound implements newStreakRound operation.
+	//
+	// Create next round of country streak game, current round is returned if it's not finished.
+	//
+	// POST /v1/streak/{id}/round
+	NewStreakRound(ctx context.Context, params NewStreakRoundParams) (NewStreakRoundRes, error)
+}
+
 // UsersHandler handles operations described by OpenAPI v3 specification.
 //
 // x-ogen-operation-group: Users
@@ -13,6 +13,24 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AcceptSingleplayerChallenge implements acceptSingleplayerChallenge operation.
+//
+// Create new singleplayer game with locations and settings of the challenge.
+//
+// POST /v1/challenges/{id}/accept
+func (UnimplementedHandler) AcceptSingleplayerChallenge(ctx context.Context, params AcceptSingleplayerChallengeParams) (r AcceptSingleplayerChallengeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AddMapLocations implements addMapLocations operation.
+//
+// Add existing panorama locations of the map provider to a map created by the authenticated user.
+//
+// POST /v1/maps/{id}/locations
+func (UnimplementedHandler) AddMapLocations(ctx context.Context, req *AddMapLocationsRequest, params AddMapLocationsParams) (r AddMapLocationsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeleteDiscord implements deleteDiscord operation.
 //
 // Delete login with Discord OAuth.
@@ -76,6 +94,35 @@ func (UnimplementedHandler) EndSingleplayerRound(ctx context.Context, req *Singl
 	return r, ht.ErrNotImplemented
 }
 
+// EndStreakRound implements endStreakRound operation.
+//
+// Guess the country of the current round. The game ends if the country is wrong or the round timer
+// has ended.
+//
+// POST /v1/streak/{id}/round/end
+func (UnimplementedHandler) EndStreakRound(ctx context.Context, req *StreakRoundGuess, params EndStreakRoundParams) (r EndStreakRoundRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetBestStreak implements getBestStreak operation.
+//
+// Get the longest streak among finished country streak games of the current user.
+//
+// GET /v1/streak/best
+func (UnimplementedHandler) GetBestStreak(ctx context.Context) (r GetBestStreakRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetDailyChallengeLeaderboard implements getDailyChallengeLeaderboard operation.
+//
+// Get finished daily challenge games of a date, ranked by score. Players with equal score share the
+// same place.
+//
+// GET /v1/daily-challenge/leaderboard
+func (UnimplementedHandler) GetDailyChallengeLeaderboard(ctx context.Context, params GetDailyChallengeLeaderboardParams) (r GetDailyChallengeLeaderboardRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetHealth implements getHealth operation.
 //
 // Check API health status.
@@ -87,7 +134,8 @@ func (UnimplementedHandler) GetHealth(ctx context.Context) (r *GetHealthOK, _ er
 
 // GetLobbies implements getLobbies operation.
 //
-// Get all available lobbies.
+// Get available lobbies matching all of the set filters, unlisted and invite-only lobbies are not
+// listed.
 //
 // GET /v1/lobbies
 func (UnimplementedHandler) GetLobbies(ctx context.Context, params GetLobbiesParams) (r GetLobbiesRes, _ error) {
@@ -103,6 +151,24 @@ func (UnimplementedHandler) GetLobby(ctx context.Context, params GetLobbyParams)
 	return r, ht.ErrNotImplemented
 }
 
+// GetMap implements getMap operation.
+//
+// Get map information by ID. Private maps are available only to their creator.
+//
+// GET /v1/maps/{id}
+func (UnimplementedHandler) GetMap(ctx context.Context, params GetMapParams) (r GetMapRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMaps implements getMaps operation.
+//
+// Get public maps, or all maps of the authenticated user if `mine` is set.
+//
+// GET /v1/maps
+func (UnimplementedHandler) GetMaps(ctx context.Context, params GetMapsParams) (r GetMapsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetMultiplayerGame implements getMultiplayerGame operation.
 //
 // Get multiplayer game information by ID.
@@ -114,7 +180,8 @@ func (UnimplementedHandler) GetMultiplayerGame(ctx context.Context, params GetMu
 
 // GetMultiplayerGameGuesses implements getMultiplayerGameGuesses operation.
 //
-// Get multiplayer game user guesses.
+// Get multiplayer game user guesses of finished rounds.
+// Spectators get guesses of a round only after the spectator delay of the game.
 //
 // GET /v1/multiplayer/{id}/guesses
 func (UnimplementedHandler) GetMultiplayerGameGuesses(ctx context.Context, params GetMultiplayerGameGuessesParams) (r GetMultiplayerGameGuessesRes, _ error) {
@@ -139,6 +206,24 @@ func (UnimplementedHandler) GetOAuthProviders(ctx context.Context) (r GetOAuthPr
 	return r, ht.ErrNotImplemented
 }
 
+// GetPanorama implements getPanorama operation.
+//
+// Resolve opaque panorama token of the current round to panorama ID (without coordinates).
+//
+// GET /v1/panorama/{token}
+func (UnimplementedHandler) GetPanorama(ctx context.Context, params GetPanoramaParams) (r GetPanoramaRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetPanoramaProviders implements getPanoramaProviders operation.
+//
+// Get names of panorama providers, that games can be played on.
+//
+// GET /v1/panorama/providers
+func (UnimplementedHandler) GetPanoramaProviders(ctx context.Context) (r GetPanoramaProvidersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetPrivateProfile implements getPrivateProfile operation.
 //
 // Retrieve authenticated user profile details.
@@ -166,6 +251,16 @@ func (UnimplementedHandler) GetRoot(ctx context.Context) (r *GetRootFound, _ err
 	return r, ht.ErrNotImplemented
 }
 
+// GetSingleplayerChallenge implements getSingleplayerChallenge operation.
+//
+// Get challenge settings and results of its creator and challengers. Guesses of the games are only
+// included after the user finished their own game of the challenge.
+//
+// GET /v1/challenges/{id}
+func (UnimplementedHandler) GetSingleplayerChallenge(ctx context.Context, params GetSingleplayerChallengeParams) (r GetSingleplayerChallengeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetSingleplayerGame implements getSingleplayerGame operation.
 //
 // Get singleplayer game information by ID.
@@ -202,6 +297,33 @@ func (UnimplementedHandler) GetSingleplayerRound(ctx context.Context, params Get
 	return r, ht.ErrNotImplemented
 }
 
+// GetStreakGame implements getStreakGame operation.
+//
+// Get country streak game information by ID.
+//
+// GET /v1/streak/{id}
+func (UnimplementedHandler) GetStreakGame(ctx context.Context, params GetStreakGameParams) (r GetStreakGameRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetStreakLeaderboard implements getStreakLeaderboard operation.
+//
+// Get best streaks of users, ranked by streak. Players with equal streak share the same place.
+//
+// GET /v1/streak/leaderboard
+func (UnimplementedHandler) GetStreakLeaderboard(ctx context.Context, params GetStreakLeaderboardParams) (r GetStreakLeaderboardRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetStreakRound implements getStreakRound operation.
+//
+// Get current round of country streak game.
+//
+// GET /v1/streak/{id}/round
+func (UnimplementedHandler) GetStreakRound(ctx context.Context, params GetStreakRoundParams) (r GetStreakRoundRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetUserSessions implements getUserSessions operation.
 //
 // Get user sessions associated with the authenticated user.
@@ -211,6 +333,15 @@ func (UnimplementedHandler) GetUserSessions(ctx context.Context) (r GetUserSessi
 	return r, ht.ErrNotImplemented
 }
 
+// ImportMapLocations implements importMapLocations operation.
+//
+// Import locations from CSV or GeoJSON to a map created by the authenticated user.
+//
+// POST /v1/maps/{id}/import
+func (UnimplementedHandler) ImportMapLocations(ctx context.Context, req *ImportMapLocationsRequest, params ImportMapLocationsParams) (r ImportMapLocationsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // Login implements login operation.
 //
 // Log in using username and password.
@@ -247,6 +378,15 @@ func (UnimplementedHandler) NewLobby(ctx context.Context, req *NewLobby) (r NewL
 	return r, ht.ErrNotImplemented
 }
 
+// NewMap implements newMap operation.
+//
+// Create new map, optionally with existing panorama locations of the provider.
+//
+// POST /v1/maps
+func (UnimplementedHandler) NewMap(ctx context.Context, req *NewMapRequest) (r NewMapRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewMultiplayerRound implements newMultiplayerRound operation.
 //
 // Get or generate multiplayer game round.
@@ -256,6 +396,17 @@ func (UnimplementedHandler) NewMultiplayerRound(ctx context.Context, params NewM
 	return r, ht.ErrNotImplemented
 }
 
+// NewSingleplayerChallenge implements newSingleplayerChallenge operation.
+//
+// Create a shareable challenge, that lets other players replay locations of a finished game with the
+// same settings. Returns the existing challenge if it was already created. Daily challenge games
+// can't be shared.
+//
+// POST /v1/singleplayer/{id}/challenge
+func (UnimplementedHandler) NewSingleplayerChallenge(ctx context.Context, params NewSingleplayerChallengeParams) (r NewSingleplayerChallengeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewSingleplayerGame implements newSingleplayerGame operation.
 //
 // Create new singleplayer game.
@@ -274,6 +425,24 @@ func (UnimplementedHandler) NewSingleplayerRound(ctx context.Context, params New
 	return r, ht.ErrNotImplemented
 }
 
+// NewStreakGame implements newStreakGame operation.
+//
+// Create new country streak game, that continues until the first wrongly guessed country.
+//
+// POST /v1/streak
+func (UnimplementedHandler) NewStreakGame(ctx context.Context, req *NewStreakGameRequest) (r NewStreakGameRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NewStreakRound implements newStreakRound operation.
+//
+// Create next round of country streak game, current round is returned if it's not finished.
+//
+// POST /v1/streak/{id}/round
+func (UnimplementedHandler) NewStreakRound(ctx context.Context, params NewStreakRoundParams) (r NewStreakRoundRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewYandex implements newYandex operation.
 //
 // Create Yandex OAuth connection.
@@ -310,6 +479,15 @@ func (UnimplementedHandler) Register(ctx context.Context, req *RegisterRequest,
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateMap implements updateMap operation.
+//
+// Update name, description and visibility of a map created by the authenticated user.
+//
+// PATCH /v1/maps/{id}
+func (UnimplementedHandler) UpdateMap(ctx context.Context, req *UpdateMapRequest, params UpdateMapParams) (r UpdateMapRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateUser implements updateUser operation.
 //
 // Update authenticated user's profile information.
@@ -12,16 +12,23 @@ import (
 
 	"github.com/VasySS/segoya-backend/internal/config"
 	httpController "github.com/VasySS/segoya-backend/internal/controller/http"
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/geocoder"
+	panoramaProvider "github.com/VasySS/segoya-backend/internal/infrastructure/panorama"
+	_ "github.com/VasySS/segoya-backend/internal/infrastructure/panorama/providers"
 	"github.com/VasySS/segoya-backend/internal/infrastructure/repository/cloudflare"
 	"github.com/VasySS/segoya-backend/internal/infrastructure/repository/postgres"
 	valkeyRepo "github.com/VasySS/segoya-backend/internal/infrastructure/repository/valkey"
 	"github.com/VasySS/segoya-backend/internal/infrastructure/token"
 	"github.com/VasySS/segoya-backend/internal/infrastructure/transport/melody"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/transport/pubsub"
 	"github.com/VasySS/segoya-backend/internal/usecase/auth"
+	"github.com/VasySS/segoya-backend/internal/usecase/gamemap"
 	"github.com/VasySS/segoya-backend/internal/usecase/lobby"
 	"github.com/VasySS/segoya-backend/internal/usecase/multiplayer"
 	"github.com/VasySS/segoya-backend/internal/usecase/panorama"
 	"github.com/VasySS/segoya-backend/internal/usecase/singleplayer"
+	"github.com/VasySS/segoya-backend/internal/usecase/streak"
 	"github.com/VasySS/segoya-backend/internal/usecase/user"
 	"github.com/VasySS/segoya-backend/pkg/captcha"
 	"github.com/VasySS/segoya-backend/pkg/crypto"
@@ -42,11 +49,13 @@ func Run(ctx context.Context, conf config.Config) error {
 		return err
 	}
 
-	valkeyRepo, err := newValkeyRepo(ctx, closer, conf.ENV.ValkeyURL)
+	valkeyClient, err := newValkeyClient(ctx, closer, conf.ENV.ValkeyURL)
 	if err != nil {
 		return err
 	}
 
+	valkeyRepo := valkeyRepo.New(valkeyClient)
+
 	pgConnectionURL := fmt.Sprintf(
 		"postgres://%s:%s@%s/%s",
 		conf.ENV.PostgresUser,
@@ -75,21 +84,85 @@ func Run(ctx context.Context, conf config.Config) error {
 		conf.ENV.JWTSecretKey,
 		conf.Limits.AccessTokenTTL,
 		conf.Limits.RefreshTokenTTL,
+		conf.Limits.PanoramaTokenTTL,
 	)
 
-	lobbyWebSocketService := melody.NewWebSocketService()
+	lobbyWebSocketService := pubsub.NewWebSocketService(
+		valkeyClient,
+		melody.NewWebSocketService(dto.LobbyWebSocketProtocol),
+		"lobby",
+	)
 	closer.AddWithError(lobbyWebSocketService.Close)
 
-	multiplayerWebSocketService := melody.NewWebSocketService()
+	multiplayerWebSocketService := pubsub.NewWebSocketService(valkeyClient, melody.NewWebSocketService(), "multiplayer")
 	closer.AddWithError(multiplayerWebSocketService.Close)
 
 	authUsecase := auth.NewUsecase(auth.NewConfig(conf), cryptoService, tokenService, pgRepo, valkeyRepo)
 	userUsecase := user.NewUsecase(user.NewConfig(conf), pgRepo, cloudflareS3)
 
-	panoramaUsecase := panorama.NewUsecase(panorama.NewConfig(conf), pgRepo)
-	singleplayerUsecase := singleplayer.NewUsecase(singleplayer.NewConfig(conf), pgRepo, panoramaUsecase)
-	multiplayerUsecase := multiplayer.NewUsecase(multiplayer.NewConfig(conf), pgRepo, panoramaUsecase)
-	lobbyUsecase := lobby.NewUsecase(lobby.NewConfig(conf), cryptoService, pgRepo, valkeyRepo, multiplayerUsecase)
+	panoramaProviders, err := panorama.NewRegistry(panoramaProvider.New(conf, pgRepo)...)
+	if err != nil {
+		return fmt.Errorf("failed to register panorama providers: %w", err)
+	}
+
+	// the dataset is parsed on the first lookup, so that it doesn't slow down startup
+	countryGeocoder, err := geocoder.NewLazy(conf.ENV.GeocoderDatasetPath)
+	if err != nil {
+		return fmt.Errorf("failed to load geocoder: %w", err)
+	}
+
+	scorings, err := panorama.NewScoringRegistry(
+		panorama.NewClassicScoring(),
+		panorama.NewHardScoring(),
+		panorama.NewTimedScoring(conf.Limits.TimedScoreMaxBonus, conf.Limits.TimedScoreBonusTime),
+		panorama.NewCountryScoring(countryGeocoder, conf.Limits.CountryScoreBonus),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register scoring strategies: %w", err)
+	}
+
+	panoramaUsecase := panorama.NewUsecase(
+		panorama.NewConfig(conf),
+		pgRepo,
+		panoramaProviders,
+		scorings,
+		countryGeocoder,
+	)
+	mapUsecase := gamemap.NewUsecase(gamemap.NewConfig(conf), pgRepo, panoramaUsecase)
+	singleplayerUsecase := singleplayer.NewUsecase(
+		singleplayer.NewConfig(conf),
+		cryptoService,
+		pgRepo,
+		panoramaUsecase,
+		mapUsecase,
+	)
+	streakUsecase := streak.NewUsecase(streak.NewConfig(conf), pgRepo, panoramaUsecase)
+	multiplayerUsecase := multiplayer.NewUsecase(
+		multiplayer.NewConfig(conf),
+		pgRepo,
+		panoramaUsecase,
+		multiplayerWebSocketService,
+	)
+	closer.Add(multiplayerUsecase.Close)
+
+	// rounds which are not recovered on startup are finished later by the periodic recovery
+	if err := multiplayerUsecase.RecoverRounds(ctx); err != nil {
+		slog.Error("error recovering multiplayer rounds", slog.Any("error", err))
+	}
+
+	go multiplayerUsecase.RunRoundRecovery(ctx)
+
+	lobbyUsecase := lobby.NewUsecase(
+		lobby.NewConfig(conf),
+		cryptoService,
+		cryptoService,
+		pgRepo,
+		valkeyRepo,
+		multiplayerUsecase,
+		mapUsecase,
+		panoramaUsecase,
+	)
+	closer.Add(lobbyUsecase.Close)
 
 	r := httpController.NewRouter(
 		conf,
@@ -102,7 +175,10 @@ func Run(ctx context.Context, conf config.Config) error {
 		userUsecase,
 		lobbyUsecase,
 		singleplayerUsecase,
+		streakUsecase,
 		multiplayerUsecase,
+		mapUsecase,
+		panoramaUsecase,
 	)
 
 	go startHTTP(closer, r)
@@ -159,7 +235,7 @@ func newPgRepo(ctx context.Context, closer *Closer, connectionURL string) (*post
 	return pgRepo, nil
 }
 
-func newValkeyRepo(ctx context.Context, closer *Closer, valkeyURL string) (*valkeyRepo.Repository, error) {
+func newValkeyClient(ctx context.Context, closer *Closer, valkeyURL string) (valkey.Client, error) {
 	valkeyClient, err := valkey.NewClient(valkey.MustParseURL(valkeyURL))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create valkey client: %w", err)
@@ -173,7 +249,7 @@ func newValkeyRepo(ctx context.Context, closer *Closer, valkeyURL string) (*valk
 	slog.Info("valkey connected")
 	closer.Add(valkeyClient.Close)
 
-	return valkeyRepo.New(valkeyClient), nil
+	return valkeyClient, nil
 }
 
 func setGlobalTracer(ctx context.Context, jaegerURL string) error {
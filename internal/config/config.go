@@ -38,6 +38,11 @@ type YandexOAuth struct {
 	YandexSecretKey string `env:"YANDEX_SECRET_KEY"`
 }
 
+// PanoramaLocators contains credentials of services, which find panoramas of providers by coordinates.
+type PanoramaLocators struct {
+	GoogleMapsAPIKey string `env:"GOOGLE_MAPS_API_KEY"`
+}
+
 // Postgres contains Postgres connection credentials.
 type Postgres struct {
 	PostgresUser     string `env:"PG_USER" env-required:"true"`
@@ -54,6 +59,7 @@ type Config struct {
 		Cloudflare
 		DiscordOAuth
 		YandexOAuth
+		PanoramaLocators
 		BackendURL       url.URL `env:"BACKEND_URL"        env-required:"true"`
 		FrontendURL      url.URL `env:"FRONTEND_URL"       env-required:"true"`
 		ValkeyURL        string  `env:"VALKEY_URL"         env-required:"true"`
@@ -61,10 +67,14 @@ type Config struct {
 		CaptchaSecretKey string  `env:"CAPTCHA_SECRET_KEY" env-required:"true"`
 		JWTSecretKey     string  `env:"JWT_SECRET_KEY"     env-required:"true"`
 		Mode             string  `env:"ENV_MODE"           env-default:"production"`
+		// GeocoderDatasetPath is a path of the boundaries dataset for reverse geocoding,
+		// it is fetched with "make geocoder-dataset"
+		GeocoderDatasetPath string `env:"GEOCODER_DATASET_PATH" env-default:"./internal/infrastructure/geocoder/boundaries.geojson.gz"`
 	}
-	HTTPClient *http.Client
-	OAuth      OAuth
-	Limits     Limits
+	HTTPClient        *http.Client
+	OAuth             OAuth
+	Limits            Limits
+	PanoramaProviders []PanoramaProvider
 }
 
 // MustInit reads environment variables and returns a new global config.
@@ -81,6 +91,7 @@ func MustInit() Config {
 
 	conf.OAuth = newOAuthConfig(conf.ENV.BackendURL, conf)
 	conf.Limits = newLimits()
+	conf.PanoramaProviders = newPanoramaProviders()
 
 	proxyClient, err := httpPkg.NewClientWithProxy(
 		conf.ENV.Address,
@@ -6,23 +6,59 @@ import (
 
 // Limits contains various application limits - e.g. lobby expiration time.
 type Limits struct {
-	LobbyExpiration   time.Duration
-	LobbyIDLength     int
-	RoundStartDelay   time.Duration
-	RoundEndDelay     time.Duration
-	AvatarUpdateLimit time.Duration
-	AccessTokenTTL    time.Duration
-	RefreshTokenTTL   time.Duration
+	LobbyExpiration       time.Duration
+	LobbyIDLength         int
+	LobbyReadyCountdown   time.Duration
+	LobbyGameStartTimeout time.Duration
+	ChallengeTokenLength  int
+	RoundStartDelay       time.Duration
+	RoundEndDelay         time.Duration
+	DisconnectGracePeriod time.Duration
+	RoundRecoveryInterval time.Duration
+	DuelHealth            int
+	DuelGuessTimer        time.Duration
+	DuelMultiplierStep    float64
+	AvatarUpdateLimit     time.Duration
+	AccessTokenTTL        time.Duration
+	RefreshTokenTTL       time.Duration
+	PanoramaTokenTTL      time.Duration
+	MapImportMaxSize      int
+	RecentGamesExcluded   int
+	MinScoreDistance      float64
+	TimedScoreMaxBonus    int
+	TimedScoreBonusTime   time.Duration
+	CountryScoreBonus     int
+	DailyRounds           int
+	DailyTimer            time.Duration
+	DailyMovement         bool
 }
 
 func newLimits() Limits {
 	return Limits{
-		LobbyExpiration:   3 * time.Minute,
-		LobbyIDLength:     16,
-		RoundStartDelay:   5 * time.Second,
-		RoundEndDelay:     10 * time.Second,
-		AvatarUpdateLimit: 5 * time.Minute,
-		AccessTokenTTL:    1 * time.Hour,
-		RefreshTokenTTL:   31 * 24 * time.Hour,
+		LobbyExpiration:       3 * time.Minute,
+		LobbyIDLength:         16,
+		LobbyReadyCountdown:   5 * time.Second,
+		LobbyGameStartTimeout: 10 * time.Second,
+		ChallengeTokenLength:  16,
+		RoundStartDelay:       5 * time.Second,
+		RoundEndDelay:         10 * time.Second,
+		DisconnectGracePeriod: 30 * time.Second,
+		RoundRecoveryInterval: 30 * time.Second,
+		DuelHealth:            6000,
+		DuelGuessTimer:        15 * time.Second,
+		DuelMultiplierStep:    0.5,
+		AvatarUpdateLimit:     5 * time.Minute,
+		AccessTokenTTL:        1 * time.Hour,
+		RefreshTokenTTL:       31 * 24 * time.Hour,
+		PanoramaTokenTTL:      30 * time.Minute,
+		MapImportMaxSize:      10000,
+		RecentGamesExcluded:   10,
+		MinScoreDistance:      5,
+		TimedScoreMaxBonus:    1000,
+		TimedScoreBonusTime:   time.Minute,
+		CountryScoreBonus:     500,
+		DailyRounds:           5,
+		DailyTimer:            2 * time.Minute,
+		DailyMovement:         true,
 	}
 }
@@ -0,0 +1,21 @@
+package config
+
+import "github.com/VasySS/segoya-backend/internal/entity/game"
+
+// PanoramaProvider contains settings of a registered panorama provider.
+// Providers without settings use default ones.
+type PanoramaProvider struct {
+	// Name is a unique provider name, that is stored with games and locations.
+	Name game.PanoramaProvider
+	// MixedWeight is a relative chance of the provider to be picked for a round of mixed provider game.
+	MixedWeight int
+}
+
+func newPanoramaProviders() []PanoramaProvider {
+	return []PanoramaProvider{
+		{Name: game.GoogleProvider, MixedWeight: 1},
+		{Name: game.YandexProvider, MixedWeight: 1},
+		{Name: game.YandexAirProvider, MixedWeight: 1},
+		{Name: game.SeznamProvider, MixedWeight: 1},
+	}
+}
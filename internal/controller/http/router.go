@@ -11,9 +11,12 @@ import (
 	"github.com/VasySS/segoya-backend/internal/config"
 	"github.com/VasySS/segoya-backend/internal/controller/http/middleware"
 	"github.com/VasySS/segoya-backend/internal/controller/http/v1/auth"
+	"github.com/VasySS/segoya-backend/internal/controller/http/v1/gamemap"
 	"github.com/VasySS/segoya-backend/internal/controller/http/v1/lobby"
 	"github.com/VasySS/segoya-backend/internal/controller/http/v1/multiplayer"
+	"github.com/VasySS/segoya-backend/internal/controller/http/v1/panorama"
 	"github.com/VasySS/segoya-backend/internal/controller/http/v1/singleplayer"
+	"github.com/VasySS/segoya-backend/internal/controller/http/v1/streak"
 	"github.com/VasySS/segoya-backend/internal/controller/http/v1/user"
 	"github.com/VasySS/segoya-backend/internal/infrastructure/token"
 	"github.com/VasySS/segoya-backend/internal/infrastructure/transport"
@@ -29,7 +32,10 @@ type APIHandler struct {
 	api.AuthHandler
 	api.LobbiesHandler
 	api.SingleplayerHandler
+	api.StreakHandler
 	api.MultiplayerHandler
+	api.PanoramaHandler
+	api.MapsHandler
 }
 
 func newAPIHandler(
@@ -37,14 +43,20 @@ func newAPIHandler(
 	ah api.AuthHandler,
 	lh api.LobbiesHandler,
 	sh api.SingleplayerHandler,
+	th api.StreakHandler,
 	mh api.MultiplayerHandler,
+	ph api.PanoramaHandler,
+	gh api.MapsHandler,
 ) *APIHandler {
 	return &APIHandler{
 		UsersHandler:        uh,
 		AuthHandler:         ah,
 		LobbiesHandler:      lh,
 		SingleplayerHandler: sh,
+		StreakHandler:       th,
 		MultiplayerHandler:  mh,
+		PanoramaHandler:     ph,
+		MapsHandler:         gh,
 	}
 }
 
@@ -70,7 +82,10 @@ func NewRouter(
 	userUsecase user.Usecase,
 	lobbyUsecase lobby.Usecase,
 	singleplayerUsecase singleplayer.Usecase,
+	streakUsecase streak.Usecase,
 	multiplayerUsecase multiplayer.Usecase,
+	mapUsecase gamemap.Usecase,
+	panoramaUsecase panorama.Usecase,
 ) http.Handler {
 	mux := chi.NewMux()
 
@@ -88,12 +103,15 @@ func NewRouter(
 	ah := auth.NewHandler(auth.NewConfig(conf), authUsecase, randomService, tokenService, captchaService)
 	lh := lobby.NewHandler(lobby.NewConfig(conf), lobbyUsecase, tokenService, lobbyWSService)
 	sh := singleplayer.NewHandler(singleplayer.NewConfig(conf), singleplayerUsecase, tokenService)
+	th := streak.NewHandler(streak.NewConfig(conf), streakUsecase, tokenService)
 	mh := multiplayer.NewHandler(multiplayer.NewConfig(conf), multiplayerUsecase, tokenService, multiplayerWSService)
+	ph := panorama.NewHandler(panorama.NewConfig(conf), panoramaUsecase, tokenService)
+	gh := gamemap.NewHandler(gamemap.NewConfig(conf), mapUsecase, tokenService)
 
 	authMW := middleware.NewAuth(tokenService)
 
 	ogenServer, err := api.NewServer(
-		newAPIHandler(uh, ah, lh, sh, mh),
+		newAPIHandler(uh, ah, lh, sh, th, mh, ph, gh),
 		authMW,
 		api.WithErrorHandler(middleware.ErrorHandler),
 		api.WithMiddleware(middleware.OpenTelemetry{}.Middleware),
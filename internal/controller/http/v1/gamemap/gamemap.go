@@ -0,0 +1,282 @@
+package gamemap
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	api "github.com/VasySS/segoya-backend/api/ogen"
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/gamemap"
+)
+
+// NewMap creates a new map of the authenticated user.
+func (h Handler) NewMap(ctx context.Context, req *api.NewMapRequest) (api.NewMapRes, error) {
+	claims, ok := h.ts.FromContext(ctx)
+	if !ok {
+		return &api.NewMapUnauthorized{
+			Title:  "Error authorizing user",
+			Status: http.StatusUnauthorized,
+			Detail: "An error occurred while authorizing user",
+		}, nil
+	}
+
+	mapID, err := h.uc.NewMap(ctx, dto.NewMapRequest{
+		RequestTime: time.Now().UTC(),
+		UserID:      claims.UserID,
+		Name:        req.Name,
+		Description: req.Description.Or(""),
+		Provider:    game.PanoramaProvider(req.Provider),
+		Visibility:  gamemap.Visibility(req.Visibility),
+		LocationIDs: req.LocationIDs,
+	})
+	switch {
+	case errors.Is(err, gamemap.ErrLocationNotFound):
+		return &api.NewMapBadRequest{
+			Title:  "Location not found",
+			Status: http.StatusBadRequest,
+			Detail: "Some of the locations do not exist or belong to another provider",
+		}, nil
+	case errors.Is(err, game.ErrUnknownProvider):
+		return &api.NewMapBadRequest{
+			Title:  "Unknown provider",
+			Status: http.StatusBadRequest,
+			Detail: "Maps can't be created for the provider",
+		}, nil
+	case err != nil:
+		slog.Error("error creating map", slog.Any("error", err))
+
+		return &api.NewMapInternalServerError{
+			Title:  "Error creating map",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while creating map",
+		}, nil
+	}
+
+	return &api.NewMapCreated{ID: mapID}, nil
+}
+
+// GetMap returns a map by its ID.
+func (h Handler) GetMap(ctx context.Context, params api.GetMapParams) (api.GetMapRes, error) {
+	claims, ok := h.ts.FromContext(ctx)
+	if !ok {
+		return &api.GetMapUnauthorized{
+			Title:  "Error authorizing user",
+			Status: http.StatusUnauthorized,
+			Detail: "An error occurred while authorizing user",
+		}, nil
+	}
+
+	m, err := h.uc.GetMap(ctx, dto.GetMapRequest{
+		UserID: claims.UserID,
+		MapID:  params.ID,
+	})
+	if errors.Is(err, gamemap.ErrNotFound) {
+		return &api.GetMapNotFound{
+			Title:  "Map not found",
+			Status: http.StatusNotFound,
+			Detail: "The map you are trying to get does not exist",
+		}, nil
+	} else if err != nil {
+		slog.Error("error getting map", slog.Any("error", err))
+
+		return &api.GetMapInternalServerError{
+			Title:  "Error getting map",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while getting map",
+		}, nil
+	}
+
+	return dto.MapToAPI(m), nil
+}
+
+// GetMaps returns a page of public maps or maps of the authenticated user.
+func (h Handler) GetMaps(ctx context.Context, params api.GetMapsParams) (api.GetMapsRes, error) {
+	claims, ok := h.ts.FromContext(ctx)
+	if !ok {
+		return &api.GetMapsUnauthorized{
+			Title:  "Error authorizing user",
+			Status: http.StatusUnauthorized,
+			Detail: "An error occurred while authorizing user",
+		}, nil
+	}
+
+	maps, total, err := h.uc.GetMaps(ctx, dto.GetMapsRequest{
+		UserID:   claims.UserID,
+		Mine:     params.Mine.Or(false),
+		Page:     params.Page,
+		PageSize: params.PageSize,
+	})
+	if err != nil {
+		slog.Error("error getting maps", slog.Any("error", err))
+
+		return &api.GetMapsInternalServerError{
+			Title:  "Error getting maps",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while getting maps",
+		}, nil
+	}
+
+	return dto.MapsToAPI(maps, total), nil
+}
+
+// UpdateMap updates a map of the authenticated user.
+func (h Handler) UpdateMap(
+	ctx context.Context,
+	req *api.UpdateMapRequest,
+	params api.UpdateMapParams,
+) (api.UpdateMapRes, error) {
+	claims, ok := h.ts.FromContext(ctx)
+	if !ok {
+		return &api.UpdateMapUnauthorized{
+			Title:  "Error authorizing user",
+			Status: http.StatusUnauthorized,
+			Detail: "An error occurred while authorizing user",
+		}, nil
+	}
+
+	err := h.uc.UpdateMap(ctx, dto.UpdateMapRequest{
+		RequestTime: time.Now().UTC(),
+		UserID:      claims.UserID,
+		MapID:       params.ID,
+		Name:        req.Name,
+		Description: req.Description,
+		Visibility:  gamemap.Visibility(req.Visibility),
+	})
+
+	switch {
+	case errors.Is(err, gamemap.ErrNotFound):
+		return &api.UpdateMapNotFound{
+			Title:  "Map not found",
+			Status: http.StatusNotFound,
+			Detail: "The map you are trying to update does not exist",
+		}, nil
+	case errors.Is(err, gamemap.ErrWrongUserID):
+		return &api.UpdateMapForbidden{
+			Title:  "Forbidden",
+			Status: http.StatusForbidden,
+			Detail: "This map does not belong to you",
+		}, nil
+	case err != nil:
+		slog.Error("error updating map", slog.Any("error", err))
+
+		return &api.UpdateMapInternalServerError{
+			Title:  "Error updating map",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while updating map",
+		}, nil
+	}
+
+	return &api.UpdateMapNoContent{}, nil
+}
+
+// AddMapLocations adds existing panorama locations to a map of the authenticated user.
+func (h Handler) AddMapLocations(
+	ctx context.Context,
+	req *api.AddMapLocationsRequest,
+	params api.AddMapLocationsParams,
+) (api.AddMapLocationsRes, error) {
+	claims, ok := h.ts.FromContext(ctx)
+	if !ok {
+		return &api.AddMapLocationsUnauthorized{
+			Title:  "Error authorizing user",
+			Status: http.StatusUnauthorized,
+			Detail: "An error occurred while authorizing user",
+		}, nil
+	}
+
+	added, err := h.uc.AddMapLocations(ctx, dto.AddMapLocationsRequest{
+		UserID:      claims.UserID,
+		MapID:       params.ID,
+		LocationIDs: req.LocationIDs,
+	})
+
+	switch {
+	case errors.Is(err, gamemap.ErrNotFound):
+		return &api.AddMapLocationsNotFound{
+			Title:  "Map not found",
+			Status: http.StatusNotFound,
+			Detail: "The map you are trying to update does not exist",
+		}, nil
+	case errors.Is(err, gamemap.ErrWrongUserID):
+		return &api.AddMapLocationsForbidden{
+			Title:  "Forbidden",
+			Status: http.StatusForbidden,
+			Detail: "This map does not belong to you",
+		}, nil
+	case errors.Is(err, gamemap.ErrLocationNotFound):
+		return &api.AddMapLocationsBadRequest{
+			Title:  "Location not found",
+			Status: http.StatusBadRequest,
+			Detail: "Some of the locations do not exist or belong to another provider",
+		}, nil
+	case err != nil:
+		slog.Error("error adding map locations", slog.Any("error", err))
+
+		return &api.AddMapLocationsInternalServerError{
+			Title:  "Error adding locations",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while adding locations",
+		}, nil
+	}
+
+	return &api.MapLocationsAdded{Added: added}, nil
+}
+
+// ImportMapLocations imports locations from CSV or GeoJSON to a map of the authenticated user.
+func (h Handler) ImportMapLocations(
+	ctx context.Context,
+	req *api.ImportMapLocationsRequest,
+	params api.ImportMapLocationsParams,
+) (api.ImportMapLocationsRes, error) {
+	claims, ok := h.ts.FromContext(ctx)
+	if !ok {
+		return &api.ImportMapLocationsUnauthorized{
+			Title:  "Error authorizing user",
+			Status: http.StatusUnauthorized,
+			Detail: "An error occurred while authorizing user",
+		}, nil
+	}
+
+	imported, err := h.uc.ImportMapLocations(ctx, dto.ImportMapLocationsRequest{
+		UserID: claims.UserID,
+		MapID:  params.ID,
+		Format: gamemap.ImportFormat(req.Format),
+		Data:   req.Data,
+	})
+
+	switch {
+	case errors.Is(err, gamemap.ErrNotFound):
+		return &api.ImportMapLocationsNotFound{
+			Title:  "Map not found",
+			Status: http.StatusNotFound,
+			Detail: "The map you are trying to update does not exist",
+		}, nil
+	case errors.Is(err, gamemap.ErrWrongUserID):
+		return &api.ImportMapLocationsForbidden{
+			Title:  "Forbidden",
+			Status: http.StatusForbidden,
+			Detail: "This map does not belong to you",
+		}, nil
+	case errors.Is(err, gamemap.ErrInvalidImport):
+		// parsing errors are returned to help users fix their files
+		return &api.ImportMapLocationsBadRequest{
+			Title:  "Invalid locations",
+			Status: http.StatusBadRequest,
+			Detail: err.Error(),
+		}, nil
+	case err != nil:
+		slog.Error("error importing map locations", slog.Any("error", err))
+
+		return &api.ImportMapLocationsInternalServerError{
+			Title:  "Error importing locations",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while importing locations",
+		}, nil
+	}
+
+	return &api.MapLocationsAdded{Added: imported}, nil
+}
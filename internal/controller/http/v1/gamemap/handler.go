@@ -0,0 +1,50 @@
+// Package gamemap contains HTTP handlers for user-created maps operations.
+package gamemap
+
+import (
+	"context"
+
+	api "github.com/VasySS/segoya-backend/api/ogen"
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game/gamemap"
+	"github.com/VasySS/segoya-backend/internal/entity/user"
+)
+
+// TokenService defines the interface for handling user JWT token operations.
+type TokenService interface {
+	FromContext(ctx context.Context) (user.AccessTokenClaims, bool)
+}
+
+// Usecase defines methods for managing maps.
+type Usecase interface {
+	NewMap(ctx context.Context, req dto.NewMapRequest) (int, error)
+	GetMap(ctx context.Context, req dto.GetMapRequest) (gamemap.Map, error)
+	GetMaps(ctx context.Context, req dto.GetMapsRequest) ([]gamemap.Map, int, error)
+	UpdateMap(ctx context.Context, req dto.UpdateMapRequest) error
+	AddMapLocations(ctx context.Context, req dto.AddMapLocationsRequest) (int, error)
+	ImportMapLocations(ctx context.Context, req dto.ImportMapLocationsRequest) (int, error)
+}
+
+var _ api.MapsHandler = (*Handler)(nil)
+
+// Handler handles HTTP requests for maps operations and implements the api.MapsHandler interface.
+type Handler struct {
+	cfg Config
+	uc  Usecase
+	ts  TokenService
+}
+
+// NewHandler creates and returns a new Handler instance with the provided dependencies.
+//
+// cfg - Configuration settings for the Handler.
+//
+// usecase - Implementation of the Usecase interface for business logic.
+//
+// tokenService - Implementation of the TokenService interface for handling tokens.
+func NewHandler(cfg Config, usecase Usecase, tokenService TokenService) *Handler {
+	return &Handler{
+		cfg: cfg,
+		uc:  usecase,
+		ts:  tokenService,
+	}
+}
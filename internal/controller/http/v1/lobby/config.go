@@ -1,11 +1,20 @@
 package lobby
 
-import "github.com/VasySS/segoya-backend/internal/config"
+import (
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/config"
+)
 
 // Config contains configuration for lobby HTTP handlers.
-type Config struct{}
+type Config struct {
+	// ReadyCountdown is a time between every player getting ready and the game start.
+	ReadyCountdown time.Duration
+}
 
 // NewConfig creates and returns new local config from general config.
-func NewConfig(_ config.Config) Config {
-	return Config{}
+func NewConfig(conf config.Config) Config {
+	return Config{
+		ReadyCountdown: conf.Limits.LobbyReadyCountdown,
+	}
 }
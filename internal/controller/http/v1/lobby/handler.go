@@ -22,9 +22,20 @@ type Usecase interface {
 	GetLobby(ctx context.Context, id string) (lobby.Lobby, error)
 	DeleteLobby(ctx context.Context, id string) error
 	GetLobbies(ctx context.Context, req dto.GetLobbiesRequest) ([]lobby.Lobby, int, error)
-	ConnectLobbyUser(ctx context.Context, lobbyID string, userID int) (user.PublicProfile, error)
-	DisconnectLobbyUser(ctx context.Context, lobbyID string, userID int) error
+	ConnectLobbyUser(ctx context.Context, req dto.ConnectLobbyUserRequest) (user.PublicProfile, error)
+	CheckLobbyAccess(ctx context.Context, req dto.ConnectLobbyUserRequest) error
+	DisconnectLobbyUser(ctx context.Context, lobbyID string, userID int) (int, error)
 	StartLobbyGame(ctx context.Context, req dto.StartLobbyGameRequest) (int, error)
+	SwitchLobbyTeam(ctx context.Context, req dto.SwitchLobbyTeamRequest) error
+	GetLobbyTeams(ctx context.Context, lobbyID string) (map[int]int, error)
+	UpdateLobbySettings(ctx context.Context, req dto.UpdateLobbySettingsRequest) (dto.UpdateLobbySettingsResponse, error)
+	KickLobbyUser(ctx context.Context, req dto.KickLobbyUserRequest) error
+	TransferLobbyHost(ctx context.Context, req dto.TransferLobbyHostRequest) error
+	SetLobbyUserReady(ctx context.Context, req dto.SetLobbyUserReadyRequest) (bool, error)
+	LobbyReady(ctx context.Context, lobbyID string) (bool, error)
+	GetLobbyReadyUsers(ctx context.Context, lobbyID string) ([]int, error)
+	StartLobbyCountdown(ctx context.Context, lobbyID string, start func(ctx context.Context, token string)) (bool, error)
+	CancelLobbyCountdown(ctx context.Context, lobbyID, token string) (bool, error)
 }
 
 var _ api.LobbiesHandler = (*Handler)(nil)
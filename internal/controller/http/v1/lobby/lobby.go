@@ -9,6 +9,7 @@ import (
 
 	api "github.com/VasySS/segoya-backend/api/ogen"
 	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game/gamemap"
 	"github.com/VasySS/segoya-backend/internal/entity/lobby"
 )
 
@@ -19,16 +20,63 @@ func (h Handler) NewLobby(
 ) (api.NewLobbyRes, error) {
 	timerSeconds, _ := req.TimerSeconds.Get()
 
+	// team scoring only makes sense when players are split into teams
+	teams := req.Teams.Or(0)
+
+	var teamScoring string
+	if teams != 0 {
+		teamScoring = string(req.TeamScoring.Or(api.TeamScoringBest))
+	}
+
 	lobbyID, err := h.uc.NewLobby(ctx, dto.NewLobbyRequest{
-		RequestTime:     time.Now().UTC(),
-		CreatorID:       req.CreatorID,
-		MaxPlayers:      req.MaxPlayers,
-		Rounds:          req.Rounds,
-		Provider:        string(req.GetProvider()),
-		TimerSeconds:    timerSeconds,
-		MovementAllowed: req.MovementAllowed,
+		RequestTime:           time.Now().UTC(),
+		CreatorID:             req.CreatorID,
+		MaxPlayers:            req.MaxPlayers,
+		Rounds:                req.Rounds,
+		Provider:              string(req.GetProvider()),
+		Providers:             dto.ProvidersFromAPI[string](req.GetProviders()),
+		TimerSeconds:          timerSeconds,
+		MovementAllowed:       req.MovementAllowed,
+		MapID:                 req.MapID.Or(0),
+		ScoreDistance:         float64(req.ScoreDistance.Or(0)),
+		Scoring:               string(req.Scoring.Or(api.ScoringModeClassic)),
+		Mode:                  string(req.Mode.Or(api.MultiplayerModeClassic)),
+		Teams:                 teams,
+		TeamScoring:           teamScoring,
+		Spectators:            req.Spectators.Or(false),
+		SpectatorDelaySeconds: req.SpectatorDelaySeconds.Or(0),
+		Visibility:            lobby.Visibility(req.Visibility.Or(api.LobbyVisibilityPublic)),
+		Password:              req.Password.Or(""),
+		InvitedUserIDs:        req.InvitedUserIDs,
+		RequireReady:          req.RequireReady.Or(false),
 	})
-	if err != nil {
+
+	switch {
+	case errors.Is(err, lobby.ErrPasswordRequired):
+		return &api.NewLobbyBadRequest{
+			Title:  "Password is required",
+			Status: http.StatusBadRequest,
+			Detail: "Password-protected lobby must have a password",
+		}, nil
+	case errors.Is(err, lobby.ErrWrongSettings):
+		return &api.NewLobbyBadRequest{
+			Title:  "Unknown provider",
+			Status: http.StatusBadRequest,
+			Detail: "The game can't be played on the provider",
+		}, nil
+	case errors.Is(err, gamemap.ErrNotFound):
+		return &api.NewLobbyNotFound{
+			Title:  "Map not found",
+			Status: http.StatusNotFound,
+			Detail: "The map you are trying to play does not exist",
+		}, nil
+	case errors.Is(err, gamemap.ErrEmpty):
+		return &api.NewLobbyBadRequest{
+			Title:  "Map is empty",
+			Status: http.StatusBadRequest,
+			Detail: "The map you are trying to play has no locations",
+		}, nil
+	case err != nil:
 		slog.Error("error creating lobby", slog.Any("error", err))
 
 		return &api.NewLobbyInternalServerError{
@@ -66,14 +114,32 @@ func (h Handler) GetLobby(
 	return dto.LobbyToAPI(l), nil
 }
 
-// GetLobbies retrieves all active lobbies.
+// GetLobbies retrieves active lobbies matching filters of the request.
 func (h Handler) GetLobbies(
 	ctx context.Context,
 	params api.GetLobbiesParams,
 ) (api.GetLobbiesRes, error) {
+	var timerSeconds *int
+	if v, ok := params.TimerSeconds.Get(); ok {
+		timerSeconds = &v
+	}
+
+	var movementAllowed *bool
+	if v, ok := params.MovementAllowed.Get(); ok {
+		movementAllowed = &v
+	}
+
 	lobbies, total, err := h.uc.GetLobbies(ctx, dto.GetLobbiesRequest{
-		Page:     params.Page,
-		PageSize: params.PageSize,
+		Page:            params.Page,
+		PageSize:        params.PageSize,
+		Order:           lobby.Order(params.Sort.Or(api.LobbySortNewest)),
+		Provider:        string(params.Provider.Or("")),
+		RoundsMin:       params.RoundsMin.Or(0),
+		RoundsMax:       params.RoundsMax.Or(0),
+		TimerSeconds:    timerSeconds,
+		MovementAllowed: movementAllowed,
+		FreeSlots:       params.FreeSlots.Or(false),
+		CreatorUsername: params.Creator.Or(""),
 	})
 	if err != nil {
 		slog.Error("error getting lobbies", slog.Any("error", err))
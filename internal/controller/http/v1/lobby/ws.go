@@ -1,12 +1,20 @@
 package lobby
 
 import (
+	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
+	"github.com/VasySS/segoya-backend/internal/entity/lobby"
 	"github.com/VasySS/segoya-backend/internal/entity/user"
 	"github.com/VasySS/segoya-backend/internal/infrastructure/transport"
 	"github.com/go-chi/chi/v5"
@@ -27,36 +35,99 @@ func getUser(s transport.WebSocketSession) (user.PublicProfile, bool) {
 	return u, true
 }
 
-// getLobbyUsers returns all connected users in the lobby.
-func (h Handler) getLobbyUsers(lobbyID string) []user.PublicProfile {
-	sessions := h.ws.Sessions()
-	users := make([]user.PublicProfile, 0)
+// getLobbyUsers returns all connected users in the lobby (including those connected to other instances).
+func (h Handler) getLobbyUsers(lobbyID string) ([]user.PublicProfile, error) {
+	members, err := h.ws.Members(lobbyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lobby members: %w", err)
+	}
 
-	for _, s := range sessions {
-		sLobbyID, ok := s.GetBroadcastID()
-		if !ok || sLobbyID != lobbyID {
-			continue
-		}
+	users := make([]user.PublicProfile, 0, len(members))
 
-		u, ok := getUser(s)
-		if !ok {
-			continue
+	for _, m := range members {
+		var u user.PublicProfile
+		if err := json.Unmarshal(m, &u); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal lobby member: %w", err)
 		}
 
 		users = append(users, u)
 	}
 
-	return users
+	return users, nil
+}
+
+// lobbyPassword returns the password of a password-protected lobby from WebSocket subprotocols of the request
+// (the password is sent in the Sec-WebSocket-Protocol header, so that it isn't logged with the URL).
+func lobbyPassword(r *http.Request) string {
+	for _, header := range r.Header.Values("Sec-WebSocket-Protocol") {
+		for protocol := range strings.SplitSeq(header, ",") {
+			encoded, ok := strings.CutPrefix(strings.TrimSpace(protocol), dto.LobbyPasswordProtocolPrefix)
+			if !ok {
+				continue
+			}
+
+			password, err := base64.RawURLEncoding.DecodeString(encoded)
+			if err != nil {
+				return ""
+			}
+
+			return string(password)
+		}
+	}
+
+	return ""
 }
 
-// HandleWS upgrades http request to websocket.
+// HandleWS upgrades http request to websocket, if the user can join the lobby.
 func (h Handler) HandleWS(w http.ResponseWriter, r *http.Request) {
+	claims, ok := h.ts.FromContext(r.Context())
+	if !ok {
+		http.Error(w, "error authorizing user", http.StatusUnauthorized)
+		return
+	}
+
+	err := h.uc.CheckLobbyAccess(r.Context(), dto.ConnectLobbyUserRequest{
+		LobbyID:  chi.URLParam(r, "id"),
+		UserID:   claims.UserID,
+		Password: lobbyPassword(r),
+	})
+	if errors.Is(err, lobby.ErrNotFound) {
+		http.Error(w, "lobby not found", http.StatusNotFound)
+		return
+	}
+
+	if message, denied := accessErrorMessage(err); denied {
+		http.Error(w, message, http.StatusForbidden)
+		return
+	}
+
+	if err != nil {
+		slog.Error("error checking lobby access", slog.Any("error", err))
+		http.Error(w, "error connecting to lobby", http.StatusInternalServerError)
+
+		return
+	}
+
 	if err := h.ws.HandleRequest(w, r); err != nil {
 		slog.Error("error handling ws request", slog.Any("error", err))
 		return
 	}
 }
 
+// accessErrorMessage returns a message for errors of users who can't join the lobby.
+func accessErrorMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, lobby.ErrUserIsBanned):
+		return "you are banned from the lobby", true
+	case errors.Is(err, lobby.ErrWrongPassword):
+		return "wrong lobby password", true
+	case errors.Is(err, lobby.ErrNotInvited):
+		return "you are not invited to the lobby", true
+	default:
+		return "", false
+	}
+}
+
 // handleWSConnect handles a new websocket connection request.
 func (h Handler) handleWSConnect(session transport.WebSocketSession) {
 	req := session.Request()
@@ -69,7 +140,16 @@ func (h Handler) handleWSConnect(session transport.WebSocketSession) {
 		return
 	}
 
-	userProfile, err := h.uc.ConnectLobbyUser(ctx, lobbyID, claims.UserID)
+	userProfile, err := h.uc.ConnectLobbyUser(ctx, dto.ConnectLobbyUserRequest{
+		LobbyID:  lobbyID,
+		UserID:   claims.UserID,
+		Password: lobbyPassword(req),
+	})
+	if message, denied := accessErrorMessage(err); denied {
+		session.SendError(message)
+		return
+	}
+
 	if err != nil {
 		slog.Error("error connecting user to lobby", slog.Any("error", err))
 		session.SendError("error connecting to lobby")
@@ -80,11 +160,40 @@ func (h Handler) handleWSConnect(session transport.WebSocketSession) {
 	session.SetBroadcastID(lobbyID)
 	session.Set(dto.LobbyUserProfileKey, userProfile)
 
-	users := h.getLobbyUsers(lobbyID)
+	if err := h.ws.Join(session, userProfile); err != nil {
+		slog.Error("error joining lobby broadcast", slog.Any("error", err))
+		session.SendError("error connecting to lobby")
+
+		return
+	}
+
+	users, err := h.getLobbyUsers(lobbyID)
+	if err != nil {
+		slog.Error("error getting lobby users", slog.Any("error", err))
+		session.SendError("error connecting to lobby")
+
+		return
+	}
+
+	teams, err := h.uc.GetLobbyTeams(ctx, lobbyID)
+	if err != nil {
+		slog.Error("error getting lobby teams", slog.Any("error", err))
+		session.SendError("error connecting to lobby")
+
+		return
+	}
+
+	ready, err := h.uc.GetLobbyReadyUsers(ctx, lobbyID)
+	if err != nil {
+		slog.Error("error getting lobby ready users", slog.Any("error", err))
+		session.SendError("error connecting to lobby")
+
+		return
+	}
 
 	if err := session.SendMessage(
 		dto.LobbyMessageConnectedUsers,
-		map[string]any{"users": users},
+		map[string]any{"users": users, "teams": teams, "ready": ready},
 	); err != nil {
 		slog.Error("error sending connected users", slog.Any("error", err))
 		session.SendError("error connecting to lobby")
@@ -118,10 +227,42 @@ func (h Handler) handleWSMessage(
 		}
 
 		h.processChatMsg(session, lobbyID, chatInput.Message)
+	case dto.LobbyMessageTeamSwitch:
+		var teamSwitch dto.LobbyTeamSwitchMessage
+		if err := json.Unmarshal(message.Payload, &teamSwitch); err != nil {
+			session.SendError("error unmarshalling msg")
+			return
+		}
+
+		h.processTeamSwitch(session, lobbyID, teamSwitch.Team)
+	case dto.LobbyMessageKickUser, dto.LobbyMessageBanUser:
+		var moderation dto.LobbyModerationMessage
+		if err := json.Unmarshal(message.Payload, &moderation); err != nil {
+			session.SendError("error unmarshalling msg")
+			return
+		}
+
+		h.processKick(session, lobbyID, moderation.UserID, message.Type == dto.LobbyMessageBanUser)
+	case dto.LobbyMessageTransferHost:
+		var moderation dto.LobbyModerationMessage
+		if err := json.Unmarshal(message.Payload, &moderation); err != nil {
+			session.SendError("error unmarshalling msg")
+			return
+		}
+
+		h.processHostTransfer(session, lobbyID, moderation.UserID)
+	case dto.LobbyMessageReady, dto.LobbyMessageUnready:
+		h.processReady(session, lobbyID, message.Type == dto.LobbyMessageReady)
 	case dto.LobbyMessageGameStart:
 		h.processGameStart(session, lobbyID)
 	case dto.LobbyMessageSettingsChanged:
-	// h.processSettingsChanged(s, lobbyID)
+		var settingsChange dto.LobbySettingsChangedMessage
+		if err := json.Unmarshal(message.Payload, &settingsChange); err != nil {
+			session.SendError("error unmarshalling msg")
+			return
+		}
+
+		h.processSettingsChange(session, lobbyID, settingsChange.Settings)
 	default:
 		slog.Debug("got unknown message type", slog.Any("type", message.Type))
 	}
@@ -144,7 +285,8 @@ func (h Handler) handleWSDisconnect(session transport.WebSocketSession) {
 		return
 	}
 
-	if err := h.uc.DisconnectLobbyUser(ctx, lobbyID, userProfile.ID); err != nil {
+	newHostID, err := h.uc.DisconnectLobbyUser(ctx, lobbyID, userProfile.ID)
+	if err != nil {
 		slog.Debug("error disconnecting user from lobby", slog.Any("error", err))
 		return
 	}
@@ -153,6 +295,24 @@ func (h Handler) handleWSDisconnect(session transport.WebSocketSession) {
 		Type:    dto.LobbyMessageUserDisconnected,
 		Payload: map[string]any{"username": userProfile.Username},
 	})
+
+	if newHostID != 0 {
+		_ = h.ws.BroadcastOthers(lobbyID, session, transport.WebSocketMessageOutput{
+			Type:    dto.LobbyMessageHostChanged,
+			Payload: map[string]any{"userID": newHostID},
+		})
+	}
+
+	// everyone left in the lobby may be ready after the user who wasn't has left
+	ready, err := h.uc.LobbyReady(ctx, lobbyID)
+	if err != nil {
+		slog.Debug("error checking lobby readiness", slog.Any("error", err))
+		return
+	}
+
+	if ready {
+		h.startCountdown(ctx, lobbyID)
+	}
 }
 
 // processChatMsg handles incoming chat messages from users in the lobby.
@@ -167,13 +327,383 @@ func (h Handler) processChatMsg(
 	})
 }
 
+// processTeamSwitch moves the user to another team and notifies everyone in the lobby.
+func (h Handler) processTeamSwitch(
+	session transport.WebSocketSession,
+	lobbyID string,
+	team int,
+) {
+	ctx := session.Request().Context()
+
+	userProfile, ok := getUser(session)
+	if !ok {
+		session.SendError("error getting user profile")
+		return
+	}
+
+	err := h.uc.SwitchLobbyTeam(ctx, dto.SwitchLobbyTeamRequest{
+		LobbyID: lobbyID,
+		UserID:  userProfile.ID,
+		Team:    team,
+	})
+	if errors.Is(err, lobby.ErrWrongTeam) {
+		session.SendError("team doesn't exist in the lobby")
+		return
+	} else if err != nil {
+		slog.Error("error switching team", slog.Any("error", err))
+		session.SendError("error switching team")
+
+		return
+	}
+
+	_ = h.ws.Broadcast(lobbyID, transport.WebSocketMessageOutput{
+		Type:    dto.LobbyMessageTeamSwitched,
+		Payload: map[string]any{"userID": userProfile.ID, "team": team},
+	})
+}
+
+// processSettingsChange changes settings of the lobby and notifies everyone in the lobby about them
+// and about the cancelled countdown.
+func (h Handler) processSettingsChange(
+	session transport.WebSocketSession,
+	lobbyID string,
+	settings lobby.Settings,
+) {
+	ctx := session.Request().Context()
+
+	userProfile, ok := getUser(session)
+	if !ok {
+		session.SendError("error getting user profile")
+		return
+	}
+
+	resp, err := h.uc.UpdateLobbySettings(ctx, dto.UpdateLobbySettingsRequest{
+		LobbyID:  lobbyID,
+		UserID:   userProfile.ID,
+		Settings: settings,
+	})
+	if errors.Is(err, lobby.ErrOnlyCreatorCanChangeSettings) {
+		session.SendError("only creator can change settings")
+		return
+	} else if errors.Is(err, lobby.ErrWrongSettings) {
+		session.SendError("wrong lobby settings")
+		return
+	} else if err != nil {
+		slog.Error("error changing lobby settings", slog.Any("error", err))
+		session.SendError("error changing lobby settings")
+
+		return
+	}
+
+	// ready states of players are reset, as they got ready for the old settings
+	_ = h.ws.Broadcast(lobbyID, transport.WebSocketMessageOutput{
+		Type:    dto.LobbyMessageSettingsUpdated,
+		Payload: map[string]any{"lobby": resp.Lobby, "ready": []int{}},
+	})
+
+	if resp.CountdownCancelled {
+		h.notifyCountdownCancelled(lobbyID, "lobby settings changed")
+	}
+}
+
+// processKick removes a user from the lobby (and bans them if needed), closes their connections
+// and notifies everyone in the lobby.
+func (h Handler) processKick(
+	session transport.WebSocketSession,
+	lobbyID string,
+	userID int,
+	ban bool,
+) {
+	ctx := session.Request().Context()
+
+	creatorProfile, ok := getUser(session)
+	if !ok {
+		session.SendError("error getting user profile")
+		return
+	}
+
+	err := h.uc.KickLobbyUser(ctx, dto.KickLobbyUserRequest{
+		LobbyID:   lobbyID,
+		CreatorID: creatorProfile.ID,
+		UserID:    userID,
+		Ban:       ban,
+	})
+	if !h.handleModerationError(session, err, "error kicking user") {
+		return
+	}
+
+	_ = h.ws.Broadcast(lobbyID, transport.WebSocketMessageOutput{
+		Type:    dto.LobbyMessageUserKicked,
+		Payload: map[string]any{"userID": userID, "banned": ban},
+	})
+
+	if err := h.disconnectLobbyUser(lobbyID, userID); err != nil {
+		slog.Error("error disconnecting kicked user", slog.Any("error", err))
+	}
+}
+
+// processHostTransfer passes the host role to another user and notifies everyone in the lobby.
+func (h Handler) processHostTransfer(
+	session transport.WebSocketSession,
+	lobbyID string,
+	userID int,
+) {
+	ctx := session.Request().Context()
+
+	creatorProfile, ok := getUser(session)
+	if !ok {
+		session.SendError("error getting user profile")
+		return
+	}
+
+	err := h.uc.TransferLobbyHost(ctx, dto.TransferLobbyHostRequest{
+		LobbyID:   lobbyID,
+		CreatorID: creatorProfile.ID,
+		UserID:    userID,
+	})
+	if !h.handleModerationError(session, err, "error transferring host") {
+		return
+	}
+
+	_ = h.ws.Broadcast(lobbyID, transport.WebSocketMessageOutput{
+		Type:    dto.LobbyMessageHostChanged,
+		Payload: map[string]any{"userID": userID},
+	})
+}
+
+// handleModerationError sends an error of kicking, banning or passing the host role to the user,
+// it returns true if there was no error.
+func (h Handler) handleModerationError(session transport.WebSocketSession, err error, message string) bool {
+	switch {
+	case err == nil:
+		return true
+	case errors.Is(err, lobby.ErrOnlyCreatorCanModerate):
+		session.SendError("only creator can moderate the lobby")
+	case errors.Is(err, lobby.ErrWrongModerationTarget):
+		session.SendError("user is not connected to the lobby")
+	default:
+		slog.Error(message, slog.Any("error", err))
+		session.SendError(message)
+	}
+
+	return false
+}
+
+// disconnectLobbyUser closes connections of the user to the lobby (including those on other instances).
+func (h Handler) disconnectLobbyUser(lobbyID string, userID int) error {
+	members, err := h.ws.Members(lobbyID)
+	if err != nil {
+		return fmt.Errorf("failed to get lobby members: %w", err)
+	}
+
+	for _, m := range members {
+		var u user.PublicProfile
+		if err := json.Unmarshal(m, &u); err != nil {
+			return fmt.Errorf("failed to unmarshal lobby member: %w", err)
+		}
+
+		if u.ID != userID {
+			continue
+		}
+
+		if err := h.ws.Disconnect(lobbyID, m); err != nil {
+			return fmt.Errorf("failed to disconnect lobby member: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// processReady changes the ready state of the user and notifies everyone in the lobby,
+// the countdown to the game start begins when every player is ready and is cancelled when someone is not.
+func (h Handler) processReady(
+	session transport.WebSocketSession,
+	lobbyID string,
+	ready bool,
+) {
+	ctx := session.Request().Context()
+
+	userProfile, ok := getUser(session)
+	if !ok {
+		session.SendError("error getting user profile")
+		return
+	}
+
+	allReady, err := h.uc.SetLobbyUserReady(ctx, dto.SetLobbyUserReadyRequest{
+		LobbyID: lobbyID,
+		UserID:  userProfile.ID,
+		Ready:   ready,
+	})
+	if err != nil {
+		slog.Error("error changing ready state", slog.Any("error", err))
+		session.SendError("error changing ready state")
+
+		return
+	}
+
+	messageType := dto.LobbyMessagePlayerUnready
+	if ready {
+		messageType = dto.LobbyMessagePlayerReady
+	}
+
+	_ = h.ws.Broadcast(lobbyID, transport.WebSocketMessageOutput{
+		Type:    messageType,
+		Payload: map[string]any{"userID": userProfile.ID},
+	})
+
+	if allReady {
+		h.startCountdown(ctx, lobbyID)
+		return
+	}
+
+	if ready {
+		return
+	}
+
+	// the countdown may have been started on another instance, so it is cancelled by any player
+	cancelled, err := h.uc.CancelLobbyCountdown(ctx, lobbyID, "")
+	if err != nil {
+		slog.Error("error cancelling countdown", slog.Any("error", err))
+		return
+	}
+
+	if cancelled {
+		h.notifyCountdownCancelled(lobbyID, "not all players are ready")
+	}
+}
+
+// startCountdown notifies everyone in the lobby about the countdown and starts the game when it runs out.
+// The countdown is started only once on one of the instances, which starts the game.
+func (h Handler) startCountdown(ctx context.Context, lobbyID string) {
+	started, err := h.uc.StartLobbyCountdown(ctx, lobbyID, func(ctx context.Context, token string) {
+		h.startCountdownGame(ctx, lobbyID, token)
+	})
+	if err != nil {
+		slog.Error("error starting countdown", slog.Any("error", err))
+		return
+	}
+
+	if !started {
+		return
+	}
+
+	_ = h.ws.Broadcast(lobbyID, transport.WebSocketMessageOutput{
+		Type:    dto.LobbyMessageCountdownStarted,
+		Payload: map[string]any{"seconds": int(h.cfg.ReadyCountdown.Seconds())},
+	})
+}
+
+// cancelCountdown cancels the countdown with the given token and notifies everyone in the lobby about it,
+// unless it was already cancelled.
+func (h Handler) cancelCountdown(ctx context.Context, lobbyID, token, reason string) {
+	cancelled, err := h.uc.CancelLobbyCountdown(ctx, lobbyID, token)
+	if err != nil {
+		slog.Error("error cancelling countdown", slog.Any("error", err))
+		return
+	}
+
+	if cancelled {
+		h.notifyCountdownCancelled(lobbyID, reason)
+	}
+}
+
+// notifyCountdownCancelled notifies everyone in the lobby that the game won't be started by the countdown.
+func (h Handler) notifyCountdownCancelled(lobbyID, reason string) {
+	_ = h.ws.Broadcast(lobbyID, transport.WebSocketMessageOutput{
+		Type:    dto.LobbyMessageCountdownCancelled,
+		Payload: map[string]any{"reason": reason},
+	})
+}
+
+// startCountdownGame starts the game on behalf of the lobby creator after the countdown,
+// if every player is still ready (some may have changed their mind or joined on other instances).
+func (h Handler) startCountdownGame(ctx context.Context, lobbyID, token string) {
+	l, err := h.uc.GetLobby(ctx, lobbyID)
+	if err != nil {
+		slog.Error("error getting lobby after countdown", slog.Any("error", err))
+		h.cancelCountdown(ctx, lobbyID, token, "error starting game")
+
+		return
+	}
+
+	lobbyUsers, err := h.getLobbyUsers(lobbyID)
+	if err != nil {
+		slog.Error("error getting lobby users", slog.Any("error", err))
+		h.cancelCountdown(ctx, lobbyID, token, "error starting game")
+
+		return
+	}
+
+	creatorIdx := slices.IndexFunc(lobbyUsers, func(u user.PublicProfile) bool {
+		return u.ID == l.CreatorID
+	})
+	if creatorIdx == -1 {
+		h.cancelCountdown(ctx, lobbyID, token, "lobby creator is not connected")
+		return
+	}
+
+	gameID, err := h.uc.StartLobbyGame(ctx, dto.StartLobbyGameRequest{
+		RequestTime:      time.Now().UTC(),
+		LobbyID:          lobbyID,
+		Creator:          lobbyUsers[creatorIdx],
+		ConnectedPlayers: lobbyUsers,
+		CountdownToken:   token,
+	})
+
+	// everyone was notified by the player, who has cancelled the countdown
+	if errors.Is(err, lobby.ErrCountdownCancelled) {
+		return
+	}
+
+	if message, known := gameStartErrorMessage(err); known {
+		h.notifyCountdownCancelled(lobbyID, message)
+		return
+	}
+
+	if err != nil {
+		slog.Error("error starting game after countdown", slog.Any("error", err))
+		h.notifyCountdownCancelled(lobbyID, "error starting game")
+
+		return
+	}
+
+	_ = h.ws.Broadcast(lobbyID, transport.WebSocketMessageOutput{
+		Type:    dto.LobbyMessageGameRedirect,
+		Payload: map[string]any{"gameID": gameID},
+	})
+}
+
+// gameStartErrorMessage returns a message for errors of games that can't be started from the lobby
+// with its current players.
+func gameStartErrorMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, lobby.ErrPlayersNotReady):
+		return "not all players are ready", true
+	case errors.Is(err, lobby.ErrGameStarting):
+		return "game is already starting", true
+	case errors.Is(err, multiplayer.ErrWrongPlayersAmount):
+		return "duels require exactly two players or teams", true
+	case errors.Is(err, multiplayer.ErrWrongTeams):
+		return "players must be split into at least two teams", true
+	default:
+		return "", false
+	}
+}
+
 // processGameStart initiates a start of a new game within the lobby.
 func (h Handler) processGameStart(
 	session transport.WebSocketSession,
 	lobbyID string,
 ) {
 	ctx := session.Request().Context()
-	lobbyUsers := h.getLobbyUsers(lobbyID)
+
+	lobbyUsers, err := h.getLobbyUsers(lobbyID)
+	if err != nil {
+		slog.Error("error getting lobby users", slog.Any("error", err))
+		session.SendError("error starting game")
+
+		return
+	}
 
 	creatorProfile, ok := getUser(session)
 	if !ok {
@@ -187,6 +717,11 @@ func (h Handler) processGameStart(
 		Creator:          creatorProfile,
 		ConnectedPlayers: lobbyUsers,
 	})
+	if message, known := gameStartErrorMessage(err); known {
+		session.SendError(message)
+		return
+	}
+
 	if err != nil {
 		slog.Error("error starting game", slog.Any("error", err))
 		session.SendError("error starting game")
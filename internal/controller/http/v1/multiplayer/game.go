@@ -5,6 +5,7 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"time"
 
 	api "github.com/VasySS/segoya-backend/api/ogen"
 	"github.com/VasySS/segoya-backend/internal/dto"
@@ -45,13 +46,40 @@ func (h Handler) GetMultiplayerGame(
 	return dto.MultiplayerGameToAPI(game), nil
 }
 
-// GetMultiplayerGameGuesses retrieves all user guesses made in a specific multiplayer game.
+// GetMultiplayerGameGuesses retrieves user guesses made in finished rounds of a specific multiplayer game.
 func (h Handler) GetMultiplayerGameGuesses(
 	ctx context.Context,
 	params api.GetMultiplayerGameGuessesParams,
 ) (api.GetMultiplayerGameGuessesRes, error) {
-	guesses, err := h.uc.GetGameGuesses(ctx, params.ID)
-	if err != nil {
+	claims, ok := h.ts.FromContext(ctx)
+	if !ok {
+		return &api.GetMultiplayerGameGuessesUnauthorized{
+			Title:  "Error authorizing user",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while authorizing user",
+		}, nil
+	}
+
+	guesses, err := h.uc.GetGameGuesses(ctx, dto.GetMultiplayerGameGuessesRequest{
+		RequestTime: time.Now().UTC(),
+		GameID:      params.ID,
+		UserID:      claims.UserID,
+	})
+
+	switch {
+	case errors.Is(err, multiplayer.ErrGameNotFound):
+		return &api.GetMultiplayerGameGuessesNotFound{
+			Title:  "Game not found",
+			Status: http.StatusNotFound,
+			Detail: "The game you are trying to get guesses of does not exist",
+		}, nil
+	case errors.Is(err, multiplayer.ErrGameWrongUserID):
+		return &api.GetMultiplayerGameGuessesForbidden{
+			Title:  "Forbidden",
+			Status: http.StatusForbidden,
+			Detail: "You are not a player of this game and it can't be spectated",
+		}, nil
+	case err != nil:
 		slog.Error("error getting multiplayer game guesses", slog.Any("error", err))
 
 		return &api.GetMultiplayerGameGuessesInternalServerError{
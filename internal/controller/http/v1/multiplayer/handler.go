@@ -3,9 +3,11 @@ package multiplayer
 
 import (
 	"context"
+	"time"
 
 	api "github.com/VasySS/segoya-backend/api/ogen"
 	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
 	"github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
 	"github.com/VasySS/segoya-backend/internal/entity/user"
 	"github.com/VasySS/segoya-backend/internal/infrastructure/transport"
@@ -14,6 +16,7 @@ import (
 // TokenService defines the interface for handling user JWT token operations.
 type TokenService interface {
 	FromContext(ctx context.Context) (user.AccessTokenClaims, bool)
+	NewPanoramaToken(current time.Time, req game.PanoramaTokenClaims) (string, error)
 }
 
 // Usecase defines methods for managing multiplayer game operations.
@@ -24,8 +27,12 @@ type Usecase interface {
 	GetRound(ctx context.Context, req dto.GetMultiplayerRoundRequest) (multiplayer.Round, error)
 	EndRound(ctx context.Context, req dto.EndMultiplayerRoundRequest) ([]multiplayer.Guess, error)
 	NewRoundGuess(ctx context.Context, req dto.NewMultiplayerRoundGuessRequest) error
-	GetGameGuesses(ctx context.Context, gameID int) ([]multiplayer.Guess, error)
+	EndRoundIfGuessed(ctx context.Context, req dto.EndMultiplayerRoundIfGuessedRequest) error
+	DisconnectUser(ctx context.Context, req dto.DisconnectMultiplayerUserRequest) error
+	GetGameState(ctx context.Context, req dto.GetMultiplayerGameStateRequest) (multiplayer.GameState, error)
+	GetGameGuesses(ctx context.Context, req dto.GetMultiplayerGameGuessesRequest) ([]multiplayer.Guess, error)
 	GetGameUser(ctx context.Context, userID, gameID int) (user.MultiplayerUser, error)
+	ConnectSpectator(ctx context.Context, userID, gameID int) (user.PublicProfile, error)
 	GetGameUsers(ctx context.Context, gameID int) ([]user.MultiplayerUser, error)
 }
 
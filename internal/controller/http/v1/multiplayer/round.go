@@ -49,7 +49,18 @@ func (h Handler) NewMultiplayerRound(
 		}, nil
 	}
 
-	return dto.MultiplayerRoundToAPI(round), nil
+	panoramaToken, err := h.ts.NewPanoramaToken(time.Now().UTC(), round.PanoramaTokenClaims())
+	if err != nil {
+		slog.Error("error creating panorama token", slog.Any("error", err))
+
+		return &api.NewMultiplayerRoundInternalServerError{
+			Title:  "Error creating round",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while creating round",
+		}, nil
+	}
+
+	return dto.MultiplayerRoundToAPI(round, panoramaToken), nil
 }
 
 // GetMultiplayerRound returns current multiplayer round of a game by its id.
@@ -89,5 +100,16 @@ func (h Handler) GetMultiplayerRound(
 		}, nil
 	}
 
-	return dto.MultiplayerRoundToAPI(round), nil
+	panoramaToken, err := h.ts.NewPanoramaToken(time.Now().UTC(), round.PanoramaTokenClaims())
+	if err != nil {
+		slog.Error("error creating panorama token", slog.Any("error", err))
+
+		return &api.GetMultiplayerRoundInternalServerError{
+			Title:  "Error getting round",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while getting round",
+		}, nil
+	}
+
+	return dto.MultiplayerRoundToAPI(round, panoramaToken), nil
 }
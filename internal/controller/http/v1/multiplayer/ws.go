@@ -1,11 +1,13 @@
 package multiplayer
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strconv"
 	"time"
 
@@ -31,15 +33,20 @@ func getUser(s transport.WebSocketSession) (user.MultiplayerUser, bool) {
 	return u, true
 }
 
-// getGameUsers retrieves the list of users currently connected to the game.
-func (h Handler) getGameUsers(s transport.WebSocketSession) ([]user.MultiplayerUser, error) {
-	ctx := s.Request().Context()
-
-	gameID, ok := s.GetBroadcastID()
+// isSpectator returns true if the websocket session belongs to a spectator of the game.
+func isSpectator(s transport.WebSocketSession) bool {
+	spectator, ok := s.Get(dto.MultiplayerSpectatorKey)
 	if !ok {
-		return nil, errors.New("game id not found in session")
+		return false
 	}
 
+	v, ok := spectator.(bool)
+
+	return ok && v
+}
+
+// getGameUsers retrieves the list of users currently connected to the game.
+func (h Handler) getGameUsers(ctx context.Context, gameID string) ([]user.MultiplayerUser, error) {
 	gameIDInt, _ := strconv.Atoi(gameID)
 
 	users, err := h.uc.GetGameUsers(ctx, gameIDInt)
@@ -47,23 +54,37 @@ func (h Handler) getGameUsers(s transport.WebSocketSession) ([]user.MultiplayerU
 		return nil, fmt.Errorf("failed to get game users: %w", err)
 	}
 
-	sessions := h.ws.Sessions()
+	connectedUserIDs, err := h.getConnectedUserIDs(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, u := range users {
+		users[i].Connected = slices.Contains(connectedUserIDs, u.ID)
+	}
 
-	// check if user is connected
-	for _, session := range sessions {
-		if val, ok := session.GetBroadcastID(); !ok || val != gameID {
-			continue
-		}
+	return users, nil
+}
+
+// getConnectedUserIDs returns IDs of users connected to the game (including those connected to other instances).
+func (h Handler) getConnectedUserIDs(gameID string) ([]int, error) {
+	members, err := h.ws.Members(gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game members: %w", err)
+	}
 
-		for i, u := range users {
-			userInfo, ok := getUser(session)
-			if ok && userInfo.ID == u.ID {
-				users[i].Connected = true
-			}
+	userIDs := make([]int, 0, len(members))
+
+	for _, m := range members {
+		var u user.MultiplayerUser
+		if err := json.Unmarshal(m, &u); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal game member: %w", err)
 		}
+
+		userIDs = append(userIDs, u.ID)
 	}
 
-	return users, nil
+	return userIDs, nil
 }
 
 // HandleWS handles the WebSocket connection initiation and upgrades the HTTP request to WebSocket.
@@ -93,7 +114,10 @@ func (h Handler) handleWSConnect(session transport.WebSocketSession) {
 	}
 
 	userProfile, err := h.uc.GetGameUser(ctx, claims.UserID, gameIDInt)
-	if err != nil {
+	if errors.Is(err, multiplayer.ErrGameWrongUserID) {
+		h.connectSpectator(session, gameIDInt, claims.UserID)
+		return
+	} else if err != nil {
 		session.SendError("error connecting to game")
 		return
 	}
@@ -101,29 +125,117 @@ func (h Handler) handleWSConnect(session transport.WebSocketSession) {
 	session.SetBroadcastID(gameID)
 	session.Set(dto.MultiplayerUserProfileKey, userProfile)
 
-	gameUsers, err := h.getGameUsers(session)
-	if err != nil {
-		session.SendError("error getting game users")
+	if err := h.ws.Join(session, userProfile); err != nil {
+		slog.Error("error joining game broadcast", slog.Any("error", err))
+		session.SendError("error connecting to game")
+
 		return
 	}
 
-	err = session.SendMessage(dto.MultiplayerMessageConnectedUsers, map[string]any{"users": gameUsers})
-	if err != nil {
+	if err := h.sendConnectedUsers(session, gameID); err != nil {
 		session.SendError("error getting game users")
 		return
 	}
 
+	if err := h.sendGameState(session, gameIDInt, claims.UserID); err != nil {
+		slog.Error("error sending game state (ws)", slog.Any("error", err))
+		session.SendError("error getting game state")
+
+		return
+	}
+
 	_ = h.ws.BroadcastOthers(gameID, session, transport.WebSocketMessageOutput{
 		Type:    dto.MultiplayerMessageUserConnected,
 		Payload: map[string]any{"user": userProfile},
 	})
 }
 
+// connectSpectator connects a user, who is not a player of the game, as a spectator (if the game allows it).
+// Spectators join a separate broadcast, so that they are not counted as connected players.
+func (h Handler) connectSpectator(session transport.WebSocketSession, gameID, userID int) {
+	ctx := session.Request().Context()
+
+	profile, err := h.uc.ConnectSpectator(ctx, userID, gameID)
+	if errors.Is(err, multiplayer.ErrSpectatorsNotAllowed) {
+		session.SendError("game can't be spectated")
+		return
+	} else if err != nil {
+		slog.Error("error connecting spectator (ws)", slog.Any("error", err))
+		session.SendError("error connecting to game")
+
+		return
+	}
+
+	session.SetBroadcastID(dto.MultiplayerSpectatorsBroadcastID(gameID))
+	session.Set(dto.MultiplayerUserProfileKey, user.MultiplayerUser{PublicProfile: profile})
+	session.Set(dto.MultiplayerSpectatorKey, true)
+
+	if err := h.ws.Join(session, profile); err != nil {
+		slog.Error("error joining game spectators broadcast", slog.Any("error", err))
+		session.SendError("error connecting to game")
+
+		return
+	}
+
+	if err := h.sendConnectedUsers(session, strconv.Itoa(gameID)); err != nil {
+		session.SendError("error getting game users")
+		return
+	}
+
+	if err := h.sendGameState(session, gameID, userID); err != nil {
+		slog.Error("error sending game state (ws)", slog.Any("error", err))
+		session.SendError("error getting game state")
+	}
+}
+
+// sendConnectedUsers sends the list of game players with their connection status to the session.
+func (h Handler) sendConnectedUsers(session transport.WebSocketSession, gameID string) error {
+	gameUsers, err := h.getGameUsers(session.Request().Context(), gameID)
+	if err != nil {
+		return err
+	}
+
+	return session.SendMessage(dto.MultiplayerMessageConnectedUsers, map[string]any{"users": gameUsers})
+}
+
+// sendGameState sends a snapshot of the game to the session, so that reconnecting player
+// (or a spectator) can restore it.
+func (h Handler) sendGameState(session transport.WebSocketSession, gameID, userID int) error {
+	ctx := session.Request().Context()
+
+	state, err := h.uc.GetGameState(ctx, dto.GetMultiplayerGameStateRequest{
+		RequestTime: time.Now().UTC(),
+		GameID:      gameID,
+		UserID:      userID,
+		Spectator:   isSpectator(session),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get game state: %w", err)
+	}
+
+	panoramaToken, err := h.ts.NewPanoramaToken(time.Now().UTC(), state.Round.PanoramaTokenClaims())
+	if err != nil {
+		return fmt.Errorf("failed to create panorama token: %w", err)
+	}
+
+	err = session.SendMessage(dto.MultiplayerMessageGameState, dto.MultiplayerGameStateToMessage(state, panoramaToken))
+	if err != nil {
+		return fmt.Errorf("failed to send game state: %w", err)
+	}
+
+	return nil
+}
+
 // handleWSMessage processes all incoming websocket messages from connected users.
 func (h Handler) handleWSMessage(
 	session transport.WebSocketSession,
 	message transport.WebSocketMessageInput,
 ) {
+	if isSpectator(session) {
+		h.handleSpectatorMessage(session, message)
+		return
+	}
+
 	gameID, ok := session.GetBroadcastID()
 	if !ok {
 		session.SendError("game id not found")
@@ -141,13 +253,56 @@ func (h Handler) handleWSMessage(
 		h.processUserGuess(session, gameID, guess)
 	case dto.MultiplayerMessageRoundEnd:
 		h.processRoundEnd(session)
+	case dto.MultiplayerMessageGetGameState:
+		h.processGetGameState(session)
+	default:
+		session.SendError("unknown message type")
+	}
+}
+
+// handleSpectatorMessage processes incoming websocket messages from spectators, who can only watch the game.
+func (h Handler) handleSpectatorMessage(
+	session transport.WebSocketSession,
+	message transport.WebSocketMessageInput,
+) {
+	switch message.Type {
+	case dto.MultiplayerMessageUserGuess, dto.MultiplayerMessageRoundEnd:
+		session.SendError("spectators can't play")
+	case dto.MultiplayerMessageGetGameState:
+		h.processGetGameState(session)
 	default:
 		session.SendError("unknown message type")
 	}
 }
 
+// processGetGameState handles incoming game state request, e.g. from spectators after a new round has started.
+func (h Handler) processGetGameState(session transport.WebSocketSession) {
+	userProfile, ok := getUser(session)
+	if !ok {
+		session.SendError("error getting user profile")
+		return
+	}
+
+	// broadcast ID of spectators differs from the game ID, so it is taken from the URL
+	gameID, err := strconv.Atoi(chi.URLParam(session.Request(), "id"))
+	if err != nil {
+		session.SendError("error parsing game id")
+		return
+	}
+
+	if err := h.sendGameState(session, gameID, userProfile.ID); err != nil {
+		slog.Error("error sending game state (ws)", slog.Any("error", err))
+		session.SendError("error getting game state")
+	}
+}
+
 // handleWSDisconnect handles user disconnection from a game.
 func (h Handler) handleWSDisconnect(session transport.WebSocketSession) {
+	// spectators don't affect the game
+	if isSpectator(session) {
+		return
+	}
+
 	gameID, ok := session.GetBroadcastID()
 	if !ok {
 		slog.Debug("error in game disconnect: game id not found in session")
@@ -164,6 +319,19 @@ func (h Handler) handleWSDisconnect(session transport.WebSocketSession) {
 		Type:    dto.MultiplayerMessageUserDisconnected,
 		Payload: map[string]any{"username": userProfile.Username},
 	})
+
+	// remaining players may have already guessed, so there is no need to wait for the timer
+	h.endRoundIfGuessed(session, gameID)
+
+	gameIDInt, _ := strconv.Atoi(gameID)
+
+	err := h.uc.DisconnectUser(session.Request().Context(), dto.DisconnectMultiplayerUserRequest{
+		GameID: gameIDInt,
+		UserID: userProfile.ID,
+	})
+	if err != nil {
+		slog.Error("error handling user disconnect (ws)", slog.Any("error", err))
+	}
 }
 
 // processUserGuess handles incoming user guess message.
@@ -191,6 +359,9 @@ func (h Handler) processUserGuess(
 		session.SendError("round already finished")
 		slog.Debug("round already finished")
 
+		return
+	} else if errors.Is(err, multiplayer.ErrUserEliminated) {
+		session.SendError("eliminated players can't guess")
 		return
 	} else if err != nil {
 		session.SendError("error saving guess")
@@ -199,10 +370,36 @@ func (h Handler) processUserGuess(
 		return
 	}
 
-	_ = h.ws.Broadcast(gameID, transport.WebSocketMessageOutput{
+	guessed := transport.WebSocketMessageOutput{
 		Type:    dto.MultiplayerMessageUserGuessed,
 		Payload: map[string]any{"username": userProfile.Username},
+	}
+
+	_ = h.ws.Broadcast(gameID, guessed)
+	_ = h.ws.Broadcast(dto.MultiplayerSpectatorsBroadcastID(gameIDInt), guessed)
+
+	h.endRoundIfGuessed(session, gameID)
+}
+
+// endRoundIfGuessed ends current round early if all connected players have made their guesses.
+func (h Handler) endRoundIfGuessed(session transport.WebSocketSession, gameID string) {
+	ctx := session.Request().Context()
+	gameIDInt, _ := strconv.Atoi(gameID)
+
+	connectedUserIDs, err := h.getConnectedUserIDs(gameID)
+	if err != nil {
+		slog.Error("error getting connected users (ws)", slog.Any("error", err))
+		return
+	}
+
+	err = h.uc.EndRoundIfGuessed(ctx, dto.EndMultiplayerRoundIfGuessedRequest{
+		RequestTime:      time.Now().UTC(),
+		GameID:           gameIDInt,
+		ConnectedUserIDs: connectedUserIDs,
 	})
+	if err != nil {
+		slog.Error("error ending round after guesses (ws)", slog.Any("error", err))
+	}
 }
 
 // processRoundEnd handles incoming round end message.
@@ -225,7 +422,8 @@ func (h Handler) processRoundEnd(
 
 	gameIDInt, _ := strconv.Atoi(gameID)
 
-	guesses, err := h.uc.EndRound(ctx, dto.EndMultiplayerRoundRequest{
+	// round results are broadcasted to all players by the usecase
+	_, err := h.uc.EndRound(ctx, dto.EndMultiplayerRoundRequest{
 		RequestTime: time.Now().UTC(),
 		GameID:      gameIDInt,
 		UserID:      userProfile.ID,
@@ -235,8 +433,5 @@ func (h Handler) processRoundEnd(
 		return
 	} else if err != nil {
 		slog.Error("error ending round (ws)", slog.Any("error", err))
-		return
 	}
-
-	_ = session.SendMessage(dto.MultiplayerMessageRoundFinished, map[string]any{"guesses": guesses})
 }
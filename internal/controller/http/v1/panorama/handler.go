@@ -0,0 +1,49 @@
+// Package panorama contains HTTP handlers for panorama operations.
+package panorama
+
+import (
+	"context"
+
+	api "github.com/VasySS/segoya-backend/api/ogen"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/user"
+)
+
+// TokenService defines the interface for handling user JWT and panorama token operations.
+type TokenService interface {
+	FromContext(ctx context.Context) (user.AccessTokenClaims, bool)
+	ParsePanoramaToken(token string) (game.PanoramaTokenClaims, error)
+}
+
+// Usecase defines methods for accessing panorama providers.
+type Usecase interface {
+	Providers() []game.PanoramaProvider
+}
+
+var _ api.PanoramaHandler = (*Handler)(nil)
+
+// Handler implements the api.PanoramaHandler interface and handles HTTP requests for panorama operations.
+type Handler struct {
+	cfg Config
+	uc  Usecase
+	ts  TokenService
+}
+
+// NewHandler creates and returns a new Handler instance with the provided dependencies.
+//
+// cfg - Configuration settings for the Handler.
+//
+// uc - Implementation of the Usecase interface for accessing panorama providers.
+//
+// tokenService - Implementation of the TokenService interface for handling tokens.
+func NewHandler(
+	cfg Config,
+	uc Usecase,
+	tokenService TokenService,
+) *Handler {
+	return &Handler{
+		cfg: cfg,
+		uc:  uc,
+		ts:  tokenService,
+	}
+}
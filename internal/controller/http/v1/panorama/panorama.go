@@ -0,0 +1,42 @@
+package panorama
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	api "github.com/VasySS/segoya-backend/api/ogen"
+	"github.com/VasySS/segoya-backend/internal/dto"
+)
+
+// GetPanorama resolves panorama token of a round to panorama location.
+func (h *Handler) GetPanorama(
+	ctx context.Context,
+	params api.GetPanoramaParams,
+) (api.GetPanoramaRes, error) {
+	if _, ok := h.ts.FromContext(ctx); !ok {
+		return &api.GetPanoramaUnauthorized{
+			Title:  "Error authorizing user",
+			Status: http.StatusUnauthorized,
+			Detail: "An error occurred while authorizing user",
+		}, nil
+	}
+
+	claims, err := h.ts.ParsePanoramaToken(params.Token)
+	if err != nil {
+		slog.Debug("error parsing panorama token", slog.Any("error", err))
+
+		return &api.GetPanoramaBadRequest{
+			Title:  "Invalid panorama token",
+			Status: http.StatusBadRequest,
+			Detail: "The panorama token is invalid or has expired",
+		}, nil
+	}
+
+	return dto.PanoramaLocationToAPI(claims), nil
+}
+
+// GetPanoramaProviders returns names of panorama providers, that games can be played on.
+func (h *Handler) GetPanoramaProviders(_ context.Context) (api.GetPanoramaProvidersRes, error) {
+	return dto.PanoramaProvidersToAPI(h.uc.Providers()), nil
+}
@@ -0,0 +1,149 @@
+package panorama_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	api "github.com/VasySS/segoya-backend/api/ogen"
+	"github.com/VasySS/segoya-backend/internal/controller/http/v1/panorama"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/user"
+	panoramaProvider "github.com/VasySS/segoya-backend/internal/infrastructure/panorama"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/panorama/mocks"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/token"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_GetPanorama(t *testing.T) {
+	t.Parallel()
+
+	ts := token.NewService("jwt-secret", time.Hour, time.Hour, time.Hour)
+	h := panorama.NewHandler(panorama.Config{}, nil, ts)
+
+	location := game.PanoramaMetadata{
+		ID:     432432,
+		LatLng: game.LatLng{Lat: 55.7558, Lng: 37.6173},
+	}
+
+	tests := []struct {
+		name     string
+		provider game.PanoramaProvider
+		// streetviewID is stored with the location, locations without it are located by coordinates
+		streetviewID string
+		located      string
+	}{
+		{
+			name:     "google location loaded by coordinates",
+			provider: game.GoogleProvider,
+			located:  "google-pano",
+		},
+		{
+			name:     "yandex location loaded by coordinates",
+			provider: game.YandexProvider,
+			located:  "yandex-pano",
+		},
+		{
+			name:     "seznam location loaded by coordinates",
+			provider: game.SeznamProvider,
+			located:  "68231145",
+		},
+		{
+			name:         "yandex air location with streetview ID",
+			provider:     game.YandexAirProvider,
+			streetviewID: "yandex-air-pano",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			locator := mocks.NewLocator(t)
+
+			loc := location
+			loc.StreetviewID = tt.streetviewID
+
+			want := tt.streetviewID
+
+			repo.On("GetPanoramaLocation", mock.Anything, tt.provider, loc.ID).Return(loc, nil)
+
+			if tt.located != "" {
+				want = tt.located
+
+				locator.On("Locate", mock.Anything, loc.LatLng).Return(tt.located, nil)
+				repo.On("SetPanoramaStreetviewID", mock.Anything, loc.ID, tt.located).Return(nil)
+			}
+
+			// round panorama is got from the provider and hidden in the token, as it is done by game usecases
+			pano, err := panoramaProvider.NewLocations(tt.provider, repo, locator).Get(t.Context(), loc.ID)
+			require.NoError(t, err)
+
+			panoramaToken, err := ts.NewPanoramaToken(time.Now().UTC(), game.PanoramaTokenClaims{
+				StreetviewID: pano.StreetviewID,
+			})
+			require.NoError(t, err)
+
+			ctx := ts.NewContext(t.Context(), user.AccessTokenClaims{UserID: 1})
+
+			resp, err := h.GetPanorama(ctx, api.GetPanoramaParams{Token: panoramaToken})
+			require.NoError(t, err)
+
+			got, ok := resp.(*api.PanoramaLocation)
+			require.True(t, ok)
+
+			body, err := json.Marshal(got)
+			require.NoError(t, err)
+
+			// response contains only the panorama ID, which can be loaded without coordinates
+			var fields map[string]any
+			require.NoError(t, json.Unmarshal(body, &fields))
+			assert.NotEmpty(t, want)
+			assert.Equal(t, map[string]any{"streetviewID": want}, fields)
+		})
+	}
+
+	panoramaToken, err := ts.NewPanoramaToken(time.Now().UTC(), game.PanoramaTokenClaims{
+		StreetviewID: "streetview-123",
+	})
+	require.NoError(t, err)
+
+	t.Run("unauthorized user", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := h.GetPanorama(t.Context(), api.GetPanoramaParams{Token: panoramaToken})
+		require.NoError(t, err)
+		assert.IsType(t, &api.GetPanoramaUnauthorized{}, resp)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := ts.NewContext(t.Context(), user.AccessTokenClaims{UserID: 1})
+
+		resp, err := h.GetPanorama(ctx, api.GetPanoramaParams{Token: "invalid"})
+		require.NoError(t, err)
+		assert.IsType(t, &api.GetPanoramaBadRequest{}, resp)
+	})
+}
+
+// providersUsecase returns a fixed list of panorama providers.
+type providersUsecase []game.PanoramaProvider
+
+func (u providersUsecase) Providers() []game.PanoramaProvider {
+	return u
+}
+
+func TestHandler_GetPanoramaProviders(t *testing.T) {
+	t.Parallel()
+
+	uc := providersUsecase{game.GoogleProvider, game.SeznamProvider}
+	h := panorama.NewHandler(panorama.Config{}, uc, nil)
+
+	resp, err := h.GetPanoramaProviders(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, &api.GetPanoramaProvidersOKApplicationJSON{"google", "seznam"}, resp)
+}
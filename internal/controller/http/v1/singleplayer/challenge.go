@@ -0,0 +1,159 @@
+package singleplayer
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	api "github.com/VasySS/segoya-backend/api/ogen"
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game/singleplayer"
+)
+
+// NewSingleplayerChallenge creates a shareable challenge from a finished singleplayer game.
+func (h Handler) NewSingleplayerChallenge(
+	ctx context.Context,
+	params api.NewSingleplayerChallengeParams,
+) (api.NewSingleplayerChallengeRes, error) {
+	claims, ok := h.ts.FromContext(ctx)
+	if !ok {
+		return &api.NewSingleplayerChallengeUnauthorized{
+			Title:  "Error authorizing user",
+			Status: http.StatusUnauthorized,
+			Detail: "An error occurred while authorizing user",
+		}, nil
+	}
+
+	challenge, err := h.uc.NewChallenge(ctx, dto.NewSingleplayerChallengeRequest{
+		RequestTime: time.Now().UTC(),
+		GameID:      params.ID,
+		UserID:      claims.UserID,
+	})
+
+	switch {
+	case errors.Is(err, singleplayer.ErrGameNotFound):
+		return &api.NewSingleplayerChallengeNotFound{
+			Title:  "Game not found",
+			Status: http.StatusNotFound,
+			Detail: "The game with the provided ID does not exist",
+		}, nil
+	case errors.Is(err, singleplayer.ErrGameWrongUserID):
+		return &api.NewSingleplayerChallengeForbidden{
+			Title:  "Forbidden",
+			Status: http.StatusForbidden,
+			Detail: "This game does not belong to you",
+		}, nil
+	case errors.Is(err, singleplayer.ErrGameIsStillActive):
+		return &api.NewSingleplayerChallengeBadRequest{
+			Title:  "Game is in progress",
+			Status: http.StatusBadRequest,
+			Detail: "Challenge can only be created from a finished game",
+		}, nil
+	case errors.Is(err, singleplayer.ErrChallengeFromDailyGame):
+		return &api.NewSingleplayerChallengeBadRequest{
+			Title:  "Daily challenge game",
+			Status: http.StatusBadRequest,
+			Detail: "Challenge can't be created from a daily challenge game",
+		}, nil
+	case err != nil:
+		slog.Error("error creating singleplayer challenge", slog.Any("error", err))
+
+		return &api.NewSingleplayerChallengeInternalServerError{
+			Title:  "Error creating challenge",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while creating challenge",
+		}, nil
+	}
+
+	return &api.NewSingleplayerChallengeCreated{
+		Token: challenge.Token,
+	}, nil
+}
+
+// GetSingleplayerChallenge returns a challenge with results of its players.
+func (h Handler) GetSingleplayerChallenge(
+	ctx context.Context,
+	params api.GetSingleplayerChallengeParams,
+) (api.GetSingleplayerChallengeRes, error) {
+	claims, ok := h.ts.FromContext(ctx)
+	if !ok {
+		return &api.GetSingleplayerChallengeUnauthorized{
+			Title:  "Error authorizing user",
+			Status: http.StatusUnauthorized,
+			Detail: "An error occurred while authorizing user",
+		}, nil
+	}
+
+	comparison, err := h.uc.GetChallenge(ctx, dto.GetSingleplayerChallengeRequest{
+		RequestTime: time.Now().UTC(),
+		Token:       params.ID,
+		UserID:      claims.UserID,
+	})
+	if errors.Is(err, singleplayer.ErrChallengeNotFound) {
+		return &api.GetSingleplayerChallengeNotFound{
+			Title:  "Challenge not found",
+			Status: http.StatusNotFound,
+			Detail: "The challenge with the provided token does not exist",
+		}, nil
+	} else if err != nil {
+		slog.Error("error getting singleplayer challenge", slog.Any("error", err))
+
+		return &api.GetSingleplayerChallengeInternalServerError{
+			Title:  "Error getting challenge",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while getting challenge",
+		}, nil
+	}
+
+	return dto.SingleplayerChallengeToAPI(comparison), nil
+}
+
+// AcceptSingleplayerChallenge creates a new singleplayer game of the challenge.
+func (h Handler) AcceptSingleplayerChallenge(
+	ctx context.Context,
+	params api.AcceptSingleplayerChallengeParams,
+) (api.AcceptSingleplayerChallengeRes, error) {
+	claims, ok := h.ts.FromContext(ctx)
+	if !ok {
+		return &api.AcceptSingleplayerChallengeUnauthorized{
+			Title:  "Error authorizing user",
+			Status: http.StatusUnauthorized,
+			Detail: "An error occurred while authorizing user",
+		}, nil
+	}
+
+	gameID, err := h.uc.AcceptChallenge(ctx, dto.AcceptSingleplayerChallengeRequest{
+		RequestTime: time.Now().UTC(),
+		Token:       params.ID,
+		UserID:      claims.UserID,
+	})
+
+	switch {
+	case errors.Is(err, singleplayer.ErrChallengeNotFound):
+		return &api.AcceptSingleplayerChallengeNotFound{
+			Title:  "Challenge not found",
+			Status: http.StatusNotFound,
+			Detail: "The challenge with the provided token does not exist",
+		}, nil
+	case errors.Is(err, singleplayer.ErrChallengeAlreadyPlayed):
+		return &api.AcceptSingleplayerChallengeConflict{
+			Title:  "Challenge already played",
+			Status: http.StatusConflict,
+			Detail: "The challenge was already played by user",
+		}, nil
+	case err != nil:
+		slog.Error("error accepting singleplayer challenge", slog.Any("error", err))
+
+		return &api.AcceptSingleplayerChallengeInternalServerError{
+			Title:  "Error accepting challenge",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while accepting challenge",
+		}, nil
+	}
+
+	return &api.AcceptSingleplayerChallengeCreated{
+		ID: gameID,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package singleplayer
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	api "github.com/VasySS/segoya-backend/api/ogen"
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+)
+
+// GetDailyChallengeLeaderboard returns ranked results of a daily challenge.
+func (h Handler) GetDailyChallengeLeaderboard(
+	ctx context.Context,
+	params api.GetDailyChallengeLeaderboardParams,
+) (api.GetDailyChallengeLeaderboardRes, error) {
+	date, _ := params.Date.Get()
+
+	results, resultsTotal, err := h.uc.GetDailyChallengeLeaderboard(ctx, dto.GetDailyChallengeLeaderboardRequest{
+		RequestTime: time.Now().UTC(),
+		Date:        date,
+		Provider:    game.PanoramaProvider(params.Provider),
+		Page:        params.Page,
+		PageSize:    params.PageSize,
+	})
+	if err != nil {
+		slog.Error("error getting daily challenge leaderboard", slog.Any("error", err))
+
+		return &api.GetDailyChallengeLeaderboardInternalServerError{
+			Title:  "Error getting leaderboard",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while getting daily challenge leaderboard",
+		}, nil
+	}
+
+	return dto.DailyChallengeLeaderboardToAPI(results, resultsTotal), nil
+}
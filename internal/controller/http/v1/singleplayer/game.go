@@ -9,6 +9,8 @@ import (
 
 	api "github.com/VasySS/segoya-backend/api/ogen"
 	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/gamemap"
 	"github.com/VasySS/segoya-backend/internal/entity/game/singleplayer"
 )
 
@@ -34,9 +36,46 @@ func (h Handler) NewSingleplayerGame(
 		Rounds:          req.Rounds,
 		TimerSeconds:    timerSeconds,
 		Provider:        string(req.GetProvider()),
+		Providers:       dto.ProvidersFromAPI[game.PanoramaProvider](req.GetProviders()),
 		MovementAllowed: req.MovementAllowed,
+		Daily:           req.Daily.Or(false),
+		MapID:           req.MapID.Or(0),
+		ScoreDistance:   float64(req.ScoreDistance.Or(0)),
+		Scoring:         game.ScoringMode(req.Scoring.Or(api.ScoringModeClassic)),
 	})
-	if err != nil {
+
+	switch {
+	case errors.Is(err, singleplayer.ErrDailyChallengeAlreadyPlayed):
+		return &api.NewSingleplayerGameConflict{
+			Title:  "Daily challenge already played",
+			Status: http.StatusConflict,
+			Detail: "Today's daily challenge was already played by user",
+		}, nil
+	case errors.Is(err, game.ErrUnknownProvider):
+		return &api.NewSingleplayerGameBadRequest{
+			Title:  "Unknown provider",
+			Status: http.StatusBadRequest,
+			Detail: "The game can't be played on the provider",
+		}, nil
+	case errors.Is(err, singleplayer.ErrDailyChallengeMixedProvider):
+		return &api.NewSingleplayerGameBadRequest{
+			Title:  "Daily challenge is not available",
+			Status: http.StatusBadRequest,
+			Detail: "Daily challenge can't be played with mixed provider",
+		}, nil
+	case errors.Is(err, gamemap.ErrNotFound):
+		return &api.NewSingleplayerGameNotFound{
+			Title:  "Map not found",
+			Status: http.StatusNotFound,
+			Detail: "The map you are trying to play does not exist",
+		}, nil
+	case errors.Is(err, gamemap.ErrEmpty):
+		return &api.NewSingleplayerGameBadRequest{
+			Title:  "Map is empty",
+			Status: http.StatusBadRequest,
+			Detail: "The map you are trying to play has no locations",
+		}, nil
+	case err != nil:
 		slog.Error("error creating singleplayer game", slog.Any("error", err))
 
 		return &api.NewSingleplayerGameInternalServerError{
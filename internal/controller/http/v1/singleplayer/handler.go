@@ -3,9 +3,11 @@ package singleplayer
 
 import (
 	"context"
+	"time"
 
 	api "github.com/VasySS/segoya-backend/api/ogen"
 	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
 	"github.com/VasySS/segoya-backend/internal/entity/game/singleplayer"
 	"github.com/VasySS/segoya-backend/internal/entity/user"
 )
@@ -13,6 +15,7 @@ import (
 // TokenService defines the interface for handling user JWT token operations.
 type TokenService interface {
 	FromContext(ctx context.Context) (user.AccessTokenClaims, bool)
+	NewPanoramaToken(current time.Time, req game.PanoramaTokenClaims) (string, error)
 }
 
 // Usecase defines methods for managing singleplayer game operations.
@@ -25,6 +28,16 @@ type Usecase interface {
 	GetRound(ctx context.Context, req dto.GetSingleplayerRoundRequest) (singleplayer.Round, error)
 	GetGameRounds(ctx context.Context, req dto.GetSingleplayerGameRoundsRequest) ([]singleplayer.Guess, error)
 	EndRound(ctx context.Context, req dto.EndSingleplayerRoundRequest) (dto.EndCurrentRoundResponse, error)
+	GetDailyChallengeLeaderboard(
+		ctx context.Context,
+		req dto.GetDailyChallengeLeaderboardRequest,
+	) ([]singleplayer.DailyChallengeResult, int, error)
+	NewChallenge(ctx context.Context, req dto.NewSingleplayerChallengeRequest) (singleplayer.Challenge, error)
+	AcceptChallenge(ctx context.Context, req dto.AcceptSingleplayerChallengeRequest) (int, error)
+	GetChallenge(
+		ctx context.Context,
+		req dto.GetSingleplayerChallengeRequest,
+	) (singleplayer.ChallengeComparison, error)
 }
 
 var _ api.SingleplayerHandler = (*Handler)(nil)
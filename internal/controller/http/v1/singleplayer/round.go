@@ -62,7 +62,18 @@ func (h Handler) NewSingleplayerRound(
 		}, nil
 	}
 
-	return dto.SingleplayerRoundToAPI(resp), nil
+	panoramaToken, err := h.ts.NewPanoramaToken(time.Now().UTC(), resp.PanoramaTokenClaims())
+	if err != nil {
+		slog.Error("error creating panorama token", slog.Any("error", err))
+
+		return &api.NewSingleplayerRoundInternalServerError{
+			Title:  "Error creating round",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while creating round",
+		}, nil
+	}
+
+	return dto.SingleplayerRoundToAPI(resp, panoramaToken), nil
 }
 
 // GetSingleplayerRound returns current singleplayer round by game id.
@@ -110,7 +121,18 @@ func (h Handler) GetSingleplayerRound(
 		}, nil
 	}
 
-	return dto.SingleplayerRoundToAPI(resp), nil
+	panoramaToken, err := h.ts.NewPanoramaToken(time.Now().UTC(), resp.PanoramaTokenClaims())
+	if err != nil {
+		slog.Error("error creating panorama token", slog.Any("error", err))
+
+		return &api.GetSingleplayerRoundInternalServerError{
+			Title:  "Error getting round",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while getting round",
+		}, nil
+	}
+
+	return dto.SingleplayerRoundToAPI(resp, panoramaToken), nil
 }
 
 // EndSingleplayerRound ends singleplayer round.
@@ -0,0 +1,103 @@
+package streak
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	api "github.com/VasySS/segoya-backend/api/ogen"
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/streak"
+)
+
+// NewStreakGame creates new country streak game.
+func (h Handler) NewStreakGame(
+	ctx context.Context,
+	req *api.NewStreakGameRequest,
+) (api.NewStreakGameRes, error) {
+	claims, ok := h.ts.FromContext(ctx)
+	if !ok {
+		return &api.NewStreakGameUnauthorized{
+			Title:  "Error authorizing user",
+			Status: http.StatusUnauthorized,
+			Detail: "An error occurred while authorizing user",
+		}, nil
+	}
+
+	gameID, err := h.uc.NewGame(ctx, dto.NewStreakGameRequest{
+		RequestTime:     time.Now().UTC(),
+		UserID:          claims.UserID,
+		TimerSeconds:    req.TimerSeconds.Or(0),
+		MovementAllowed: req.MovementAllowed,
+		Provider:        game.PanoramaProvider(req.GetProvider()),
+		Providers:       dto.ProvidersFromAPI[game.PanoramaProvider](req.GetProviders()),
+	})
+	if errors.Is(err, game.ErrUnknownProvider) {
+		return &api.NewStreakGameBadRequest{
+			Title:  "Unknown provider",
+			Status: http.StatusBadRequest,
+			Detail: "The game can't be played on the provider",
+		}, nil
+	} else if err != nil {
+		slog.Error("error creating streak game", slog.Any("error", err))
+
+		return &api.NewStreakGameInternalServerError{
+			Title:  "Error creating game",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while creating game",
+		}, nil
+	}
+
+	return &api.NewStreakGameCreated{
+		ID: gameID,
+	}, nil
+}
+
+// GetStreakGame returns country streak game by id.
+func (h Handler) GetStreakGame(
+	ctx context.Context,
+	params api.GetStreakGameParams,
+) (api.GetStreakGameRes, error) {
+	claims, ok := h.ts.FromContext(ctx)
+	if !ok {
+		return &api.GetStreakGameUnauthorized{
+			Title:  "Error authorizing user",
+			Status: http.StatusUnauthorized,
+			Detail: "An error occurred while authorizing user",
+		}, nil
+	}
+
+	g, err := h.uc.GetGame(ctx, dto.GetStreakGameRequest{
+		RequestTime: time.Now().UTC(),
+		GameID:      params.ID,
+		UserID:      claims.UserID,
+	})
+
+	switch {
+	case errors.Is(err, streak.ErrGameNotFound):
+		return &api.GetStreakGameNotFound{
+			Title:  "Game not found",
+			Status: http.StatusNotFound,
+			Detail: "The game you are trying to get does not exist",
+		}, nil
+	case errors.Is(err, streak.ErrGameWrongUserID):
+		return &api.GetStreakGameForbidden{
+			Title:  "Forbidden",
+			Status: http.StatusForbidden,
+			Detail: "This game does not belong to you",
+		}, nil
+	case err != nil:
+		slog.Error("error getting streak game", slog.Any("error", err))
+
+		return &api.GetStreakGameInternalServerError{
+			Title:  "Error getting game",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while getting game",
+		}, nil
+	}
+
+	return dto.StreakGameToAPI(g), nil
+}
@@ -0,0 +1,57 @@
+// Package streak contains HTTP handlers for country streak game operations.
+package streak
+
+import (
+	"context"
+	"time"
+
+	api "github.com/VasySS/segoya-backend/api/ogen"
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/streak"
+	"github.com/VasySS/segoya-backend/internal/entity/user"
+)
+
+// TokenService defines the interface for handling user JWT token operations.
+type TokenService interface {
+	FromContext(ctx context.Context) (user.AccessTokenClaims, bool)
+	NewPanoramaToken(current time.Time, req game.PanoramaTokenClaims) (string, error)
+}
+
+// Usecase defines methods for managing country streak game operations.
+type Usecase interface {
+	NewGame(ctx context.Context, req dto.NewStreakGameRequest) (int, error)
+	GetGame(ctx context.Context, req dto.GetStreakGameRequest) (streak.Game, error)
+	NewRound(ctx context.Context, req dto.NewStreakRoundRequest) (streak.Round, error)
+	GetRound(ctx context.Context, req dto.GetStreakRoundRequest) (streak.Round, error)
+	EndRound(ctx context.Context, req dto.EndStreakRoundRequest) (dto.EndStreakRoundResponse, error)
+	GetBestStreak(ctx context.Context, userID int) (streak.BestStreak, error)
+	GetLeaderboard(
+		ctx context.Context,
+		req dto.GetStreakLeaderboardRequest,
+	) ([]streak.LeaderboardResult, int, error)
+}
+
+var _ api.StreakHandler = (*Handler)(nil)
+
+// Handler handles HTTP requests for country streak operations and implements the api.StreakHandler interface.
+type Handler struct {
+	cfg Config
+	uc  Usecase
+	ts  TokenService
+}
+
+// NewHandler creates and returns a new Handler instance with the provided dependencies.
+//
+// cfg - Configuration settings for the Handler.
+//
+// usecase - Implementation of the Usecase interface for business logic.
+//
+// tokenService - Implementation of the TokenService interface for handling tokens.
+func NewHandler(cfg Config, usecase Usecase, ts TokenService) *Handler {
+	return &Handler{
+		cfg: cfg,
+		uc:  usecase,
+		ts:  ts,
+	}
+}
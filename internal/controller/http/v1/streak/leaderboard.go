@@ -0,0 +1,57 @@
+package streak
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	api "github.com/VasySS/segoya-backend/api/ogen"
+	"github.com/VasySS/segoya-backend/internal/dto"
+)
+
+// GetBestStreak returns the best country streak of the current user.
+func (h Handler) GetBestStreak(ctx context.Context) (api.GetBestStreakRes, error) {
+	claims, ok := h.ts.FromContext(ctx)
+	if !ok {
+		return &api.GetBestStreakUnauthorized{
+			Title:  "Error authorizing user",
+			Status: http.StatusUnauthorized,
+			Detail: "An error occurred while authorizing user",
+		}, nil
+	}
+
+	best, err := h.uc.GetBestStreak(ctx, claims.UserID)
+	if err != nil {
+		slog.Error("error getting best streak", slog.Any("error", err))
+
+		return &api.GetBestStreakInternalServerError{
+			Title:  "Error getting best streak",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while getting best streak",
+		}, nil
+	}
+
+	return dto.BestStreakToAPI(best), nil
+}
+
+// GetStreakLeaderboard returns best country streaks of users.
+func (h Handler) GetStreakLeaderboard(
+	ctx context.Context,
+	params api.GetStreakLeaderboardParams,
+) (api.GetStreakLeaderboardRes, error) {
+	results, resultsTotal, err := h.uc.GetLeaderboard(ctx, dto.GetStreakLeaderboardRequest{
+		Page:     params.Page,
+		PageSize: params.PageSize,
+	})
+	if err != nil {
+		slog.Error("error getting streak leaderboard", slog.Any("error", err))
+
+		return &api.GetStreakLeaderboardInternalServerError{
+			Title:  "Error getting leaderboard",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while getting streak leaderboard",
+		}, nil
+	}
+
+	return dto.StreakLeaderboardToAPI(results, resultsTotal), nil
+}
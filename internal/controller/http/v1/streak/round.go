@@ -0,0 +1,201 @@
+package streak
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	api "github.com/VasySS/segoya-backend/api/ogen"
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/streak"
+)
+
+// NewStreakRound creates next country streak round.
+func (h Handler) NewStreakRound(
+	ctx context.Context,
+	params api.NewStreakRoundParams,
+) (api.NewStreakRoundRes, error) {
+	claims, ok := h.ts.FromContext(ctx)
+	if !ok {
+		return &api.NewStreakRoundUnauthorized{
+			Title:  "Error authorizing user",
+			Status: http.StatusUnauthorized,
+			Detail: "An error occurred while authorizing user",
+		}, nil
+	}
+
+	resp, err := h.uc.NewRound(ctx, dto.NewStreakRoundRequest{
+		RequestTime: time.Now().UTC(),
+		GameID:      params.ID,
+		UserID:      claims.UserID,
+	})
+
+	switch {
+	case errors.Is(err, streak.ErrGameNotFound):
+		return &api.NewStreakRoundNotFound{
+			Title:  "Game not found",
+			Status: http.StatusNotFound,
+			Detail: "The game with the provided ID does not exist",
+		}, nil
+	case errors.Is(err, streak.ErrGameWrongUserID):
+		return &api.NewStreakRoundForbidden{
+			Title:  "Forbidden",
+			Status: http.StatusForbidden,
+			Detail: "This game does not belong to you",
+		}, nil
+	case errors.Is(err, streak.ErrGameFinished):
+		return &api.NewStreakRoundBadRequest{
+			Title:  "Game finished",
+			Status: http.StatusBadRequest,
+			Detail: "The game has already ended with a miss",
+		}, nil
+	case err != nil:
+		slog.Error("error creating streak round", slog.Any("error", err))
+
+		return &api.NewStreakRoundInternalServerError{
+			Title:  "Error creating round",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while creating round",
+		}, nil
+	}
+
+	panoramaToken, err := h.ts.NewPanoramaToken(time.Now().UTC(), resp.PanoramaTokenClaims())
+	if err != nil {
+		slog.Error("error creating panorama token", slog.Any("error", err))
+
+		return &api.NewStreakRoundInternalServerError{
+			Title:  "Error creating round",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while creating round",
+		}, nil
+	}
+
+	return dto.StreakRoundToAPI(resp, panoramaToken), nil
+}
+
+// GetStreakRound returns current country streak round by game id.
+func (h Handler) GetStreakRound(
+	ctx context.Context,
+	params api.GetStreakRoundParams,
+) (api.GetStreakRoundRes, error) {
+	claims, ok := h.ts.FromContext(ctx)
+	if !ok {
+		return &api.GetStreakRoundUnauthorized{
+			Title:  "Error authorizing user",
+			Status: http.StatusUnauthorized,
+			Detail: "An error occurred while authorizing user",
+		}, nil
+	}
+
+	resp, err := h.uc.GetRound(ctx, dto.GetStreakRoundRequest{
+		RequestTime: time.Now().UTC(),
+		GameID:      params.ID,
+		UserID:      claims.UserID,
+	})
+
+	switch {
+	case errors.Is(err, streak.ErrGameNotFound) || errors.Is(err, streak.ErrRoundNotFound):
+		return &api.GetStreakRoundNotFound{
+			Title:  "Round not found",
+			Status: http.StatusNotFound,
+			Detail: "The game with the provided ID or its round does not exist",
+		}, nil
+	case errors.Is(err, streak.ErrGameWrongUserID):
+		return &api.GetStreakRoundForbidden{
+			Title:  "Forbidden",
+			Status: http.StatusForbidden,
+			Detail: "This game does not belong to you",
+		}, nil
+	case err != nil:
+		slog.Error("error getting streak round", slog.Any("error", err))
+
+		return &api.GetStreakRoundInternalServerError{
+			Title:  "Error getting round",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while getting round",
+		}, nil
+	}
+
+	panoramaToken, err := h.ts.NewPanoramaToken(time.Now().UTC(), resp.PanoramaTokenClaims())
+	if err != nil {
+		slog.Error("error creating panorama token", slog.Any("error", err))
+
+		return &api.GetStreakRoundInternalServerError{
+			Title:  "Error getting round",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while getting round",
+		}, nil
+	}
+
+	return dto.StreakRoundToAPI(resp, panoramaToken), nil
+}
+
+// EndStreakRound checks a guessed country of the current country streak round.
+func (h Handler) EndStreakRound(
+	ctx context.Context,
+	req *api.StreakRoundGuess,
+	params api.EndStreakRoundParams,
+) (api.EndStreakRoundRes, error) {
+	claims, ok := h.ts.FromContext(ctx)
+	if !ok {
+		return &api.EndStreakRoundUnauthorized{
+			Title:  "Error authorizing user",
+			Status: http.StatusUnauthorized,
+			Detail: "An error occurred while authorizing user",
+		}, nil
+	}
+
+	var guess *game.LatLng
+	if latlng, ok := req.Guess.Get(); ok {
+		location := dto.LatLngAPIToEntity(&latlng)
+		guess = &location
+	}
+
+	resp, err := h.uc.EndRound(ctx, dto.EndStreakRoundRequest{
+		RequestTime: time.Now().UTC(),
+		GameID:      params.ID,
+		UserID:      claims.UserID,
+		CountryCode: string(req.Country.Or("")),
+		Guess:       guess,
+	})
+
+	switch {
+	case errors.Is(err, streak.ErrEmptyGuess):
+		return &api.EndStreakRoundBadRequest{
+			Title:  "Empty guess",
+			Status: http.StatusBadRequest,
+			Detail: "Either a country or a location must be guessed",
+		}, nil
+	case errors.Is(err, streak.ErrRoundAlreadyFinished):
+		return &api.EndStreakRoundBadRequest{
+			Title:  "Round already finished",
+			Status: http.StatusBadRequest,
+			Detail: "The round you are trying to end has already finished",
+		}, nil
+	case errors.Is(err, streak.ErrGameWrongUserID):
+		return &api.EndStreakRoundForbidden{
+			Title:  "Forbidden",
+			Status: http.StatusForbidden,
+			Detail: "This game does not belong to you",
+		}, nil
+	case errors.Is(err, streak.ErrGameNotFound) || errors.Is(err, streak.ErrRoundNotFound):
+		return &api.EndStreakRoundNotFound{
+			Title:  "Game not found",
+			Status: http.StatusNotFound,
+			Detail: "The game with the provided ID or its round does not exist",
+		}, nil
+	case err != nil:
+		slog.Error("error ending streak round", slog.Any("error", err))
+
+		return &api.EndStreakRoundInternalServerError{
+			Title:  "Error ending round",
+			Status: http.StatusInternalServerError,
+			Detail: "An error occurred while ending round",
+		}, nil
+	}
+
+	return dto.StreakRoundResultToAPI(resp), nil
+}
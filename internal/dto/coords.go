@@ -12,3 +12,19 @@ func LatLngAPIToEntity(latlng *api.LatLng) game.LatLng {
 		Lng: latlng.Lng,
 	}
 }
+
+// LatLngToAPI converts an entity LatLng object to an API LatLng object.
+func LatLngToAPI(latlng game.LatLng) api.LatLng {
+	return api.LatLng{
+		Lat: latlng.Lat,
+		Lng: latlng.Lng,
+	}
+}
+
+// PanoramaLocationToAPI converts panorama token claims to the API model.
+// Coordinates of the panorama are not included, as they are the answer of the round.
+func PanoramaLocationToAPI(claims game.PanoramaTokenClaims) *api.PanoramaLocation {
+	return &api.PanoramaLocation{
+		StreetviewID: claims.StreetviewID,
+	}
+}
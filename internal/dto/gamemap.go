@@ -0,0 +1,102 @@
+package dto
+
+import (
+	"time"
+
+	api "github.com/VasySS/segoya-backend/api/ogen"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/gamemap"
+)
+
+// NewMapRequest is a request to create a new map.
+type NewMapRequest struct {
+	RequestTime time.Time
+	UserID      int
+	Name        string
+	Description string
+	Provider    game.PanoramaProvider
+	Visibility  gamemap.Visibility
+	LocationIDs []int
+}
+
+// GetMapRequest is a request to get a map.
+type GetMapRequest struct {
+	UserID int
+	MapID  int
+}
+
+// GetMapsRequest is a request to get a list of maps.
+type GetMapsRequest struct {
+	UserID   int
+	Mine     bool
+	Page     int
+	PageSize int
+}
+
+// UpdateMapRequest is a request to update map information.
+type UpdateMapRequest struct {
+	RequestTime time.Time
+	UserID      int
+	MapID       int
+	Name        string
+	Description string
+	Visibility  gamemap.Visibility
+}
+
+// AddMapLocationsRequest is a request to add existing panorama locations to a map.
+type AddMapLocationsRequest struct {
+	UserID      int
+	MapID       int
+	LocationIDs []int
+}
+
+// AddMapLocationsRequestDB is a request to add existing panorama locations to a map in the database.
+type AddMapLocationsRequestDB struct {
+	MapID       int
+	Provider    game.PanoramaProvider
+	LocationIDs []int
+}
+
+// ImportMapLocationsRequest is a request to import locations to a map.
+type ImportMapLocationsRequest struct {
+	UserID int
+	MapID  int
+	Format gamemap.ImportFormat
+	Data   string
+}
+
+// ImportMapLocationsRequestDB is a request to save imported locations of a map in the database.
+type ImportMapLocationsRequestDB struct {
+	MapID     int
+	Provider  game.PanoramaProvider
+	Locations []gamemap.Location
+}
+
+// MapToAPI converts a map entity to its API representation.
+func MapToAPI(m gamemap.Map) *api.Map {
+	return &api.Map{
+		ID:             m.ID,
+		UserID:         m.UserID,
+		Name:           m.Name,
+		Description:    m.Description,
+		Provider:       api.Provider(m.Provider),
+		Visibility:     api.MapVisibility(m.Visibility),
+		LocationsCount: m.LocationsCount,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+// MapsToAPI converts a slice of maps to their API representation.
+func MapsToAPI(maps []gamemap.Map, total int) *api.Maps {
+	resp := make([]api.Map, 0, len(maps))
+
+	for _, m := range maps {
+		resp = append(resp, *MapToAPI(m))
+	}
+
+	return &api.Maps{
+		Total: total,
+		Maps:  resp,
+	}
+}
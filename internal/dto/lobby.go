@@ -12,14 +12,30 @@ import (
 // LobbyUserProfileKey is the key for the user profile in the WebSocket session.
 const LobbyUserProfileKey string = "userProfile"
 
+// LobbyWebSocketProtocol is a WebSocket subprotocol selected by the server, when the client of a
+// password-protected lobby offers it together with the password protocol.
+const LobbyWebSocketProtocol = "lobby"
+
+// LobbyPasswordProtocolPrefix is a prefix of a WebSocket subprotocol with the base64url-encoded (without padding)
+// password of a password-protected lobby, so that the password is sent in a header instead of the URL.
+const LobbyPasswordProtocolPrefix = "password."
+
 // Message types for outgoing lobby messages.
 const (
-	LobbyMessageError            transport.WebSocketMessageOutputType = "error"
-	LobbyMessageGameRedirect     transport.WebSocketMessageOutputType = "gameRedirect"
-	LobbyMessageUserConnected    transport.WebSocketMessageOutputType = "userConnected"
-	LobbyMessageUserDisconnected transport.WebSocketMessageOutputType = "userDisconnected"
-	LobbyMessageConnectedUsers   transport.WebSocketMessageOutputType = "usersConnected"
-	LobbyMessageChatOutput       transport.WebSocketMessageOutputType = "chatMessage"
+	LobbyMessageError              transport.WebSocketMessageOutputType = "error"
+	LobbyMessageGameRedirect       transport.WebSocketMessageOutputType = "gameRedirect"
+	LobbyMessageUserConnected      transport.WebSocketMessageOutputType = "userConnected"
+	LobbyMessageUserDisconnected   transport.WebSocketMessageOutputType = "userDisconnected"
+	LobbyMessageConnectedUsers     transport.WebSocketMessageOutputType = "usersConnected"
+	LobbyMessageChatOutput         transport.WebSocketMessageOutputType = "chatMessage"
+	LobbyMessageTeamSwitched       transport.WebSocketMessageOutputType = "teamSwitched"
+	LobbyMessageSettingsUpdated    transport.WebSocketMessageOutputType = "settingsUpdated"
+	LobbyMessageUserKicked         transport.WebSocketMessageOutputType = "userKicked"
+	LobbyMessageHostChanged        transport.WebSocketMessageOutputType = "hostChanged"
+	LobbyMessagePlayerReady        transport.WebSocketMessageOutputType = "playerReady"
+	LobbyMessagePlayerUnready      transport.WebSocketMessageOutputType = "playerUnready"
+	LobbyMessageCountdownStarted   transport.WebSocketMessageOutputType = "countdownStarted"
+	LobbyMessageCountdownCancelled transport.WebSocketMessageOutputType = "countdownCancelled"
 )
 
 // Message types for incoming lobby messages.
@@ -27,6 +43,12 @@ const (
 	LobbyMessageChatInput       transport.WebSocketMessageInputType = "postChatMessage"
 	LobbyMessageGameStart       transport.WebSocketMessageInputType = "gameStart"
 	LobbyMessageSettingsChanged transport.WebSocketMessageInputType = "settingsChanged"
+	LobbyMessageTeamSwitch      transport.WebSocketMessageInputType = "switchTeam"
+	LobbyMessageKickUser        transport.WebSocketMessageInputType = "kickUser"
+	LobbyMessageBanUser         transport.WebSocketMessageInputType = "banUser"
+	LobbyMessageTransferHost    transport.WebSocketMessageInputType = "transferHost"
+	LobbyMessageReady           transport.WebSocketMessageInputType = "ready"
+	LobbyMessageUnready         transport.WebSocketMessageInputType = "unready"
 )
 
 // LobbyGameStartMessage is a message to initiate the start of the game in the lobby.
@@ -37,6 +59,22 @@ type LobbyChatInputMessage struct {
 	Message LobbyChatMessage `json:"message"`
 }
 
+// LobbyTeamSwitchMessage is an incoming message of a user picking a team.
+type LobbyTeamSwitchMessage struct {
+	Team int `json:"team"`
+}
+
+// LobbySettingsChangedMessage is an incoming message of the lobby creator changing lobby settings.
+type LobbySettingsChangedMessage struct {
+	Settings lobby.Settings `json:"settings"`
+}
+
+// LobbyModerationMessage is an incoming message of the lobby creator kicking, banning
+// or passing the host role to a user.
+type LobbyModerationMessage struct {
+	UserID int `json:"userID"`
+}
+
 // LobbyChatMessage is a content of a chat message.
 type LobbyChatMessage struct {
 	Username string `json:"username"`
@@ -46,15 +84,26 @@ type LobbyChatMessage struct {
 // LobbyToAPI converts a lobby entity to its API representation.
 func LobbyToAPI(l lobby.Lobby) *api.Lobby {
 	return &api.Lobby{
-		ID:              l.ID,
-		CreatorID:       l.CreatorID,
-		CreatedAt:       l.CreatedAt,
-		Rounds:          l.Rounds,
-		Provider:        api.Provider(l.Provider),
-		MovementAllowed: l.MovementAllowed,
-		TimerSeconds:    l.TimerSeconds,
-		CurrentPlayers:  l.CurrentPlayers,
-		MaxPlayers:      l.MaxPlayers,
+		ID:                    l.ID,
+		CreatorID:             l.CreatorID,
+		CreatedAt:             l.CreatedAt,
+		Rounds:                l.Rounds,
+		Provider:              api.GameProvider(l.Provider),
+		Providers:             ProvidersToAPI(l.Providers),
+		MovementAllowed:       l.MovementAllowed,
+		TimerSeconds:          l.TimerSeconds,
+		CurrentPlayers:        l.CurrentPlayers,
+		MaxPlayers:            l.MaxPlayers,
+		MapID:                 api.OptInt{Value: l.MapID, Set: l.MapID != 0},
+		ScoreDistance:         api.OptScoreDistance{Value: api.ScoreDistance(l.ScoreDistance), Set: l.ScoreDistance != 0},
+		Scoring:               api.ScoringMode(l.Scoring),
+		Mode:                  api.MultiplayerMode(l.Mode),
+		Teams:                 api.OptInt{Value: l.Teams, Set: l.Teams != 0},
+		TeamScoring:           api.OptTeamScoring{Value: api.TeamScoring(l.TeamScoring), Set: l.TeamScoring != ""},
+		Spectators:            l.Spectators,
+		SpectatorDelaySeconds: api.OptInt{Value: l.SpectatorDelaySeconds, Set: l.SpectatorDelaySeconds != 0},
+		Visibility:            api.LobbyVisibility(l.Visibility),
+		RequireReady:          l.RequireReady,
 	}
 }
 
@@ -79,32 +128,143 @@ type NewLobbyRequest struct {
 	CreatorID       int
 	Rounds          int
 	Provider        string
+	Providers       []string
 	TimerSeconds    int
 	MovementAllowed bool
+	MapID           int
+	ScoreDistance   float64
+	Scoring         string
+	Mode            string
+	// Teams is an amount of teams, 0 if players play individually.
+	Teams       int
+	TeamScoring string
+	// Spectators allows users outside of games to watch them with round results delayed by SpectatorDelaySeconds.
+	Spectators            bool
+	SpectatorDelaySeconds int
+	// Password is only used for password-protected lobbies, InvitedUserIDs - for invite-only lobbies.
+	Visibility     lobby.Visibility
+	Password       string
+	InvitedUserIDs []int
+	// RequireReady forbids starting games until every connected player is ready.
+	RequireReady bool
 }
 
 // NewLobbyRequestDB is a request to create a new lobby in the database.
 type NewLobbyRequestDB struct {
-	RequestTime     time.Time
-	ID              string
-	CreatorID       int
-	Rounds          int
-	Provider        string
-	TimerSeconds    int
-	MovementAllowed bool
-	MaxPlayers      int
+	RequestTime           time.Time
+	ID                    string
+	CreatorID             int
+	Rounds                int
+	Provider              string
+	Providers             []string
+	TimerSeconds          int
+	MovementAllowed       bool
+	MaxPlayers            int
+	MapID                 int
+	ScoreDistance         float64
+	Scoring               string
+	Mode                  string
+	Teams                 int
+	TeamScoring           string
+	Spectators            bool
+	SpectatorDelaySeconds int
+	Visibility            lobby.Visibility
+	PasswordHash          string
+	InvitedUserIDs        []int
+	RequireReady          bool
+	// CreatorUsername is only used to search lobbies by their creator.
+	CreatorUsername string
 }
 
-// GetLobbiesRequest is a request to get a list of lobbies.
+// GetLobbiesRequest is a request to get a list of lobbies, that match all of the set filters.
+// Filters with zero values are not applied, except TimerSeconds and MovementAllowed, which are set if not nil
+// (as 0 timer and disabled movement are valid filters). CreatorUsername is a beginning of the creator username.
 type GetLobbiesRequest struct {
-	Page     int
-	PageSize int
+	Page            int
+	PageSize        int
+	Order           lobby.Order
+	Provider        string
+	RoundsMin       int
+	RoundsMax       int
+	TimerSeconds    *int
+	MovementAllowed *bool
+	FreeSlots       bool
+	CreatorUsername string
+}
+
+// ConnectLobbyUserRequest is a request of a user to join a lobby,
+// password is only checked for password-protected lobbies.
+type ConnectLobbyUserRequest struct {
+	LobbyID  string
+	UserID   int
+	Password string
 }
 
 // StartLobbyGameRequest is a request to start a game from a lobby.
+// CountdownToken is set when the game is started by the countdown after everyone got ready, so every player
+// must still be ready and the countdown must not have been cancelled.
 type StartLobbyGameRequest struct {
 	RequestTime      time.Time
 	LobbyID          string
 	Creator          user.PublicProfile
 	ConnectedPlayers []user.PublicProfile
+	CountdownToken   string
+}
+
+// SetLobbyUserReadyRequest is a request of a lobby user to change their ready state.
+type SetLobbyUserReadyRequest struct {
+	LobbyID string
+	UserID  int
+	Ready   bool
+}
+
+// SwitchLobbyTeamRequest is a request to move a user to another team of the lobby.
+type SwitchLobbyTeamRequest struct {
+	LobbyID string
+	UserID  int
+	Team    int
+}
+
+// UpdateLobbySettingsRequest is a request to change settings of a lobby.
+type UpdateLobbySettingsRequest struct {
+	LobbyID  string
+	UserID   int
+	Settings lobby.Settings
+}
+
+// UpdateLobbySettingsResponse is a response to change settings of a lobby.
+type UpdateLobbySettingsResponse struct {
+	Lobby lobby.Lobby
+	// CountdownCancelled is true if the pending game start countdown was cancelled by the change.
+	CountdownCancelled bool
+}
+
+// UpdateLobbySettingsRequestDB is a request to change settings of a lobby in the database.
+type UpdateLobbySettingsRequestDB struct {
+	ID       string
+	Settings lobby.Settings
+}
+
+// KickLobbyUserRequest is a request to remove a user from the lobby, banned users can't join the lobby again.
+type KickLobbyUserRequest struct {
+	LobbyID   string
+	CreatorID int
+	UserID    int
+	Ban       bool
+}
+
+// SetLobbyCreatorRequestDB is a request to pass the host role of the lobby to another user in the database.
+type SetLobbyCreatorRequestDB struct {
+	ID string
+	// OldCreatorID is the creator the host role is passed from, it is passed only if the lobby still has them.
+	OldCreatorID    int
+	CreatorID       int
+	CreatorUsername string
+}
+
+// TransferLobbyHostRequest is a request to pass the host role of the lobby to another user.
+type TransferLobbyHostRequest struct {
+	LobbyID   string
+	CreatorID int
+	UserID    int
 }
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"strconv"
 	"time"
 
 	api "github.com/VasySS/segoya-backend/api/ogen"
@@ -13,6 +14,17 @@ import (
 // MultiplayerUserProfileKey is the key for the user profile in the WebSocket session.
 const MultiplayerUserProfileKey string = "userProfile"
 
+// MultiplayerSpectatorKey is the key for the spectator flag in the WebSocket session,
+// it is set for users watching a game they don't play in.
+const MultiplayerSpectatorKey string = "spectator"
+
+// MultiplayerSpectatorsBroadcastID returns the broadcast ID of spectators of a game.
+// Spectators are kept apart from players, so that they are not counted as players of the game
+// and can receive round results later than players.
+func MultiplayerSpectatorsBroadcastID(gameID int) string {
+	return strconv.Itoa(gameID) + ":spectators"
+}
+
 // Message types for outgoing multiplayer messages.
 const (
 	MultiplayerMessageError            transport.WebSocketMessageOutputType = "error"
@@ -22,12 +34,18 @@ const (
 	MultiplayerMessageUserGuessed      transport.WebSocketMessageOutputType = "userGuessed"
 	MultiplayerMessageGameFinished     transport.WebSocketMessageOutputType = "gameFinished"
 	MultiplayerMessageRoundFinished    transport.WebSocketMessageOutputType = "roundFinished"
+	MultiplayerMessageGameState        transport.WebSocketMessageOutputType = "gameState"
+	MultiplayerMessageUsersEliminated  transport.WebSocketMessageOutputType = "usersEliminated"
+	MultiplayerMessageUsersDamaged     transport.WebSocketMessageOutputType = "usersDamaged"
+	MultiplayerMessageRoundTimer       transport.WebSocketMessageOutputType = "roundTimerStarted"
+	MultiplayerMessageRoundStarted     transport.WebSocketMessageOutputType = "roundStarted"
 )
 
 // Message types for incoming multiplayer messages.
 const (
-	MultiplayerMessageUserGuess transport.WebSocketMessageInputType = "userGuess"
-	MultiplayerMessageRoundEnd  transport.WebSocketMessageInputType = "endRound"
+	MultiplayerMessageUserGuess    transport.WebSocketMessageInputType = "userGuess"
+	MultiplayerMessageRoundEnd     transport.WebSocketMessageInputType = "endRound"
+	MultiplayerMessageGetGameState transport.WebSocketMessageInputType = "getGameState"
 )
 
 // MultiplayerUserGuessMessage is an incoming message with a user guess.
@@ -38,34 +56,73 @@ type MultiplayerUserGuessMessage struct {
 // MultiplayerGameToAPI converts a multiplayer game entity to the API model.
 func MultiplayerGameToAPI(g multiplayer.Game) *api.MultiplayerGame {
 	return &api.MultiplayerGame{
-		ID:              g.ID,
-		CreatorID:       g.CreatorID,
-		Rounds:          g.Rounds,
-		RoundCurrent:    g.RoundCurrent,
-		MovementAllowed: g.MovementAllowed,
-		Provider:        api.Provider(g.Provider),
-		TimerSeconds:    g.TimerSeconds,
-		Players:         g.Players,
-		Finished:        g.Finished,
-		CreatedAt:       g.CreatedAt,
+		ID:                    g.ID,
+		CreatorID:             g.CreatorID,
+		Rounds:                g.Rounds,
+		RoundCurrent:          g.RoundCurrent,
+		MovementAllowed:       g.MovementAllowed,
+		Provider:              api.GameProvider(g.Provider),
+		Providers:             ProvidersToAPI(g.Providers),
+		TimerSeconds:          g.TimerSeconds,
+		Players:               g.Players,
+		Finished:              g.Finished,
+		MapID:                 api.OptInt{Value: g.MapID, Set: g.MapID != 0},
+		ScoreDistance:         api.OptScoreDistance{Value: api.ScoreDistance(g.ScoreDistance), Set: g.ScoreDistance != 0},
+		Scoring:               api.ScoringMode(g.Scoring),
+		Mode:                  api.MultiplayerMode(g.Mode),
+		Health:                api.OptInt{Value: g.Health, Set: g.Health != 0},
+		TeamScoring:           api.OptTeamScoring{Value: api.TeamScoring(g.TeamScoring), Set: g.TeamGame()},
+		Spectators:            g.Spectators,
+		SpectatorDelaySeconds: api.OptInt{Value: g.SpectatorDelaySeconds, Set: g.SpectatorDelaySeconds != 0},
+		CreatedAt:             g.CreatedAt,
 	}
 }
 
 // MultiplayerRoundToAPI converts a multiplayer round entity to the API model.
-func MultiplayerRoundToAPI(r multiplayer.Round) *api.MultiplayerRound {
-	return &api.MultiplayerRound{
-		ID:           r.ID,
-		GameID:       r.GameID,
-		StreetviewID: r.StreetviewID,
-		RoundNum:     r.RoundNum,
-		Lat:          r.Lat,
-		Lng:          r.Lng,
-		GuessesCount: r.GuessesCount,
-		CreatedAt:    r.CreatedAt,
-		StartedAt:    r.StartedAt,
-		Finished:     r.Finished,
-		EndedAt:      r.EndedAt,
+// Round location and streetview ID are only revealed after the round has finished,
+// live round panorama is loaded by the panorama token.
+func MultiplayerRoundToAPI(r multiplayer.Round, panoramaToken string) *api.MultiplayerRound {
+	resp := &api.MultiplayerRound{
+		ID:            r.ID,
+		GameID:        r.GameID,
+		Provider:      api.Provider(r.Provider),
+		RoundNum:      r.RoundNum,
+		PanoramaToken: panoramaToken,
+		GuessesCount:  r.GuessesCount,
+		CreatedAt:     r.CreatedAt,
+		StartedAt:     r.StartedAt,
+		Finished:      r.Finished,
+		EndedAt:       r.EndedAt,
+	}
+
+	if r.Finished {
+		resp.StreetviewID = api.NewOptString(r.StreetviewID)
+		resp.Location = api.NewOptLatLng(LatLngToAPI(r.Location()))
+	}
+
+	return resp
+}
+
+// MultiplayerGameStateToMessage converts a multiplayer game state to the websocket message payload.
+func MultiplayerGameStateToMessage(s multiplayer.GameState, panoramaToken string) map[string]any {
+	payload := map[string]any{
+		"game":                  MultiplayerGameToAPI(s.Game),
+		"round":                 MultiplayerRoundToAPI(s.Round, panoramaToken),
+		"ownGuess":              s.OwnGuess,
+		"guessedUserIDs":        s.GuessedUserIDs,
+		"timerRemainingSeconds": int(s.TimerRemaining.Seconds()),
+		"standings":             s.Standings,
+	}
+
+	if s.Game.TeamGame() {
+		payload["teamStandings"] = s.TeamStandings
 	}
+
+	if s.Round.Finished {
+		payload["guesses"] = s.RoundGuesses
+	}
+
+	return payload
 }
 
 // MultiplayerGameGuessesToAPI converts a slice of multiplayer guess entities to the API model.
@@ -74,14 +131,20 @@ func MultiplayerGameGuessesToAPI(guesses []multiplayer.Guess) *api.GetMultiplaye
 
 	for _, g := range guesses {
 		resp = append(resp, api.MultiplayerGuess{
-			Username:   g.Username,
-			AvatarHash: g.AvatarHash,
-			RoundNum:   g.RoundNum,
-			RoundLat:   g.RoundLat,
-			RoundLng:   g.RoundLng,
-			Lat:        g.Lat,
-			Lng:        g.Lng,
-			Score:      g.Score,
+			Username:       g.Username,
+			AvatarHash:     g.AvatarHash,
+			Team:           api.OptInt{Value: g.Team, Set: g.Team != 0},
+			RoundNum:       g.RoundNum,
+			RoundLat:       g.RoundLat,
+			RoundLng:       g.RoundLng,
+			Lat:            g.Lat,
+			Lng:            g.Lng,
+			Score:          g.Score,
+			ScoreBreakdown: ScoreBreakdownToAPI(g.Score, g.ScoreBonus, g.ScorePenalty),
+			MissDistance:   g.MissDistance,
+			RoundCountry:   api.OptCountryCode{Value: api.CountryCode(g.RoundCountry), Set: g.RoundCountry != ""},
+			GuessCountry:   api.OptCountryCode{Value: api.CountryCode(g.GuessCountry), Set: g.GuessCountry != ""},
+			CorrectCountry: g.CorrectCountry(),
 		})
 	}
 
@@ -97,6 +160,26 @@ type NewMultiplayerGameRequest struct {
 	TimerSeconds     int
 	MovementAllowed  bool
 	Provider         string
+	Providers        []game.PanoramaProvider
+	// MapID is set when the game is played on a user-created map.
+	MapID int
+	// ScoreDistance is a distance in km, at which a guess receives ~60% of score.
+	// If it is 0, it is derived from the size of the played area by usecase.
+	ScoreDistance float64
+	// Scoring is a scoring mode of the game, classic if empty.
+	Scoring game.ScoringMode
+	// Mode is a mode of the game, classic if empty.
+	Mode multiplayer.Mode
+	// Health is a health players of duel games start with, it is set by usecase.
+	Health int
+	// Teams are teams of connected players by their IDs, nil for games without teams.
+	Teams map[int]int
+	// TeamScoring decides how team scores are calculated, empty for games without teams.
+	TeamScoring multiplayer.TeamScoring
+	// Spectators allows users outside of the game to watch it,
+	// they receive round results after SpectatorDelaySeconds.
+	Spectators            bool
+	SpectatorDelaySeconds int
 }
 
 // EndMultiplayerGameRequestDB is a request to end a multiplayer game in the database.
@@ -121,7 +204,12 @@ type NewMultiplayerRoundGuessRequestDB struct {
 	Lat         float64
 	Lng         float64
 	Score       int
-	Distance    int
+	// ScoreBonus and ScorePenalty are parts of the score, see game.Score.
+	ScoreBonus   int
+	ScorePenalty int
+	Distance     int
+	// CountryCode is a country of the guess, empty if it is outside of known countries.
+	CountryCode string
 }
 
 // GetMultiplayerRoundRequest is a request to get a multiplayer round.
@@ -142,6 +230,7 @@ type NewMultiplayerRoundRequest struct {
 type NewMultiplayerRoundRequestDB struct {
 	GameID     int
 	LocationID int
+	Provider   game.PanoramaProvider
 	RoundNum   int
 	CreatedAt  time.Time
 	StartedAt  time.Time
@@ -166,3 +255,61 @@ type EndMultiplayerGameRequest struct {
 	GameID      int
 	UserID      int
 }
+
+// GetMultiplayerGameStateRequest is a request to get a snapshot of a multiplayer game for a player or a spectator.
+type GetMultiplayerGameStateRequest struct {
+	RequestTime time.Time
+	GameID      int
+	UserID      int
+	Spectator   bool
+}
+
+// GetMultiplayerGameGuessesRequest is a request to get guesses of a multiplayer game for a player or a spectator.
+type GetMultiplayerGameGuessesRequest struct {
+	RequestTime time.Time
+	GameID      int
+	UserID      int
+}
+
+// FinishMultiplayerRoundRequest is a request to finish a multiplayer round once its timer runs out.
+type FinishMultiplayerRoundRequest struct {
+	RequestTime time.Time
+	GameID      int
+	RoundNum    int
+}
+
+// EndMultiplayerRoundIfGuessedRequest is a request to end current multiplayer round
+// if all connected players have made their guesses.
+type EndMultiplayerRoundIfGuessedRequest struct {
+	RequestTime      time.Time
+	GameID           int
+	ConnectedUserIDs []int
+}
+
+// EliminateMultiplayerUsersRequestDB is a request to eliminate players of a battle royale game in the database.
+type EliminateMultiplayerUsersRequestDB struct {
+	RequestTime time.Time
+	GameID      int
+	RoundNum    int
+	UserIDs     []int
+}
+
+// DamageMultiplayerUsersRequestDB is a request to decrease health of duel players in the database.
+type DamageMultiplayerUsersRequestDB struct {
+	GameID int
+	Damage []multiplayer.Damage
+}
+
+// DisconnectMultiplayerUserRequest is a request to handle disconnection of a player from a multiplayer game.
+type DisconnectMultiplayerUserRequest struct {
+	GameID int
+	UserID int
+}
+
+// EliminateDisconnectedMultiplayerUserRequest is a request to eliminate a player of a battle royale game,
+// who did not reconnect during the grace period.
+type EliminateDisconnectedMultiplayerUserRequest struct {
+	RequestTime time.Time
+	GameID      int
+	UserID      int
+}
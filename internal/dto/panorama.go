@@ -0,0 +1,90 @@
+package dto
+
+import (
+	"time"
+
+	api "github.com/VasySS/segoya-backend/api/ogen"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+)
+
+// NewStreetviewRequest is a request to choose a random streetview for a new round.
+type NewStreetviewRequest struct {
+	Provider game.PanoramaProvider
+	// Providers are allowed providers of mixed provider game, empty means all providers.
+	Providers []game.PanoramaProvider
+	// MapID is an ID of the map to choose the streetview from, 0 - any location of the provider.
+	MapID int
+	// UserIDs are players of the game, whose recently played locations are not repeated.
+	UserIDs []int
+	// PlayedLocationIDs are locations already played in the game.
+	PlayedLocationIDs []int
+}
+
+// ScoreDistanceRequest is a request to derive score distance of a new game from the size of its played area.
+type ScoreDistanceRequest struct {
+	Provider game.PanoramaProvider
+	// Providers are allowed providers of mixed provider game, empty means all providers.
+	Providers []game.PanoramaProvider
+	// MapID is an ID of the map the game is played on, 0 - all locations of the provider.
+	MapID int
+}
+
+// ScoreRequest is a request to score a guess of a round.
+type ScoreRequest struct {
+	Provider      game.PanoramaProvider
+	Scoring       game.ScoringMode
+	ScoreDistance float64
+	Location      game.LatLng
+	Guess         game.LatLng
+	StartedAt     time.Time
+	GuessedAt     time.Time
+	TimerSeconds  int
+}
+
+// ScoreBreakdownToAPI converts parts of a guess score to the API model, base score is not stored,
+// because it can be restored from the final score.
+func ScoreBreakdownToAPI(score, bonus, penalty int) api.ScoreBreakdown {
+	return api.ScoreBreakdown{
+		Base:    score - bonus + penalty,
+		Bonus:   bonus,
+		Penalty: penalty,
+	}
+}
+
+// PanoramaProvidersToAPI converts names of registered panorama providers to the API model.
+func PanoramaProvidersToAPI(providers []game.PanoramaProvider) *api.GetPanoramaProvidersOKApplicationJSON {
+	resp := make(api.GetPanoramaProvidersOKApplicationJSON, 0, len(providers))
+	for _, p := range providers {
+		resp = append(resp, api.Provider(p))
+	}
+
+	return &resp
+}
+
+// ProvidersToAPI converts allowed providers of a mixed provider game or lobby to the API model.
+func ProvidersToAPI[T ~string](providers []T) api.Providers {
+	if len(providers) == 0 {
+		return nil
+	}
+
+	resp := make(api.Providers, 0, len(providers))
+	for _, p := range providers {
+		resp = append(resp, api.Provider(p))
+	}
+
+	return resp
+}
+
+// ProvidersFromAPI converts allowed providers of a mixed provider game or lobby from the API model.
+func ProvidersFromAPI[T ~string](providers api.Providers) []T {
+	if len(providers) == 0 {
+		return nil
+	}
+
+	resp := make([]T, 0, len(providers))
+	for _, p := range providers {
+		resp = append(resp, T(p))
+	}
+
+	return resp
+}
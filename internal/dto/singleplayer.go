@@ -17,33 +17,48 @@ func SingleplayerGameToAPI(g singleplayer.Game) *api.SingleplayerGame {
 		RoundCurrent:    g.RoundCurrent,
 		TimerSeconds:    g.TimerSeconds,
 		MovementAllowed: g.MovementAllowed,
-		Provider:        api.Provider(g.Provider),
+		Provider:        api.GameProvider(g.Provider),
+		Providers:       ProvidersToAPI(g.Providers),
 		Score:           g.Score,
 		Finished:        g.Finished,
+		Daily:           g.IsDaily(),
+		MapID:           api.OptInt{Value: g.MapID, Set: g.MapID != 0},
+		ScoreDistance:   api.OptScoreDistance{Value: api.ScoreDistance(g.ScoreDistance), Set: g.ScoreDistance != 0},
+		Scoring:         api.ScoringMode(g.Scoring),
 		CreatedAt:       g.CreatedAt,
 	}
 }
 
 // SingleplayerRoundToAPI converts a singleplayer round entity to the API model.
-func SingleplayerRoundToAPI(r singleplayer.Round) *api.SingleplayerRound {
-	return &api.SingleplayerRound{
-		ID:           r.ID,
-		GameID:       r.GameID,
-		StreetviewID: r.StreetviewID,
-		RoundNum:     r.RoundNum,
-		Lat:          r.Lat,
-		Lng:          r.Lng,
-		Finished:     r.Finished,
-		CreatedAt:    r.CreatedAt,
-		StartedAt:    r.StartedAt,
+// Round location and streetview ID are only revealed after the round has finished,
+// live round panorama is loaded by the panorama token.
+func SingleplayerRoundToAPI(r singleplayer.Round, panoramaToken string) *api.SingleplayerRound {
+	resp := &api.SingleplayerRound{
+		ID:            r.ID,
+		GameID:        r.GameID,
+		Provider:      api.Provider(r.Provider),
+		RoundNum:      r.RoundNum,
+		PanoramaToken: panoramaToken,
+		Finished:      r.Finished,
+		CreatedAt:     r.CreatedAt,
+		StartedAt:     r.StartedAt,
 	}
+
+	if r.Finished {
+		resp.StreetviewID = api.NewOptString(r.StreetviewID)
+		resp.Location = api.NewOptLatLng(LatLngToAPI(r.Location()))
+	}
+
+	return resp
 }
 
 // SingleplayerRoundResultToAPI converts a singleplayer round result entity to the API model.
 func SingleplayerRoundResultToAPI(r EndCurrentRoundResponse) *api.EndSingleplayerRoundResponse {
 	return &api.EndSingleplayerRoundResponse{
-		Score:    r.Score,
-		Distance: r.Distance,
+		Score:          r.Score,
+		ScoreBreakdown: ScoreBreakdownToAPI(r.Score, r.ScoreBonus, r.ScorePenalty),
+		Distance:       r.Distance,
+		Location:       LatLngToAPI(r.Location),
 	}
 }
 
@@ -53,13 +68,17 @@ func SingleplayerRoundsToAPI(rounds []singleplayer.Guess) *api.GetSingleplayerGa
 
 	for _, r := range rounds {
 		resp = append(resp, api.SingleplayerRoundsWithGuess{
-			RoundNum:     r.RoundNum,
-			RoundLat:     r.RoundLat,
-			RoundLng:     r.RoundLng,
-			GuessLat:     r.GuessLat,
-			GuessLng:     r.GuessLng,
-			Score:        r.Score,
-			MissDistance: r.MissDistance,
+			RoundNum:       r.RoundNum,
+			RoundLat:       r.RoundLat,
+			RoundLng:       r.RoundLng,
+			GuessLat:       r.GuessLat,
+			GuessLng:       r.GuessLng,
+			Score:          r.Score,
+			ScoreBreakdown: ScoreBreakdownToAPI(r.Score, r.ScoreBonus, r.ScorePenalty),
+			MissDistance:   r.MissDistance,
+			RoundCountry:   api.OptCountryCode{Value: api.CountryCode(r.RoundCountry), Set: r.RoundCountry != ""},
+			GuessCountry:   api.OptCountryCode{Value: api.CountryCode(r.GuessCountry), Set: r.GuessCountry != ""},
+			CorrectCountry: r.CorrectCountry(),
 		})
 	}
 
@@ -78,7 +97,10 @@ func SingleplyerGamesToAPI(g []singleplayer.Game, gamesTotal int) *api.Singlepla
 			RoundCurrent:    game.RoundCurrent,
 			TimerSeconds:    game.TimerSeconds,
 			MovementAllowed: game.MovementAllowed,
-			Provider:        api.Provider(game.Provider),
+			Provider:        api.GameProvider(game.Provider),
+			Providers:       ProvidersToAPI(game.Providers),
+			ScoreDistance:   api.OptScoreDistance{Value: api.ScoreDistance(game.ScoreDistance), Set: game.ScoreDistance != 0},
+			Scoring:         api.ScoringMode(game.Scoring),
 			Score:           game.Score,
 			Finished:        game.Finished,
 			CreatedAt:       game.CreatedAt,
@@ -91,6 +113,64 @@ func SingleplyerGamesToAPI(g []singleplayer.Game, gamesTotal int) *api.Singlepla
 	}
 }
 
+// DailyChallengeLeaderboardToAPI converts daily challenge results to the API model.
+func DailyChallengeLeaderboardToAPI(
+	results []singleplayer.DailyChallengeResult,
+	resultsTotal int,
+) *api.DailyChallengeLeaderboard {
+	apiResults := make([]api.DailyChallengeResult, 0, len(results))
+	for _, r := range results {
+		apiResults = append(apiResults, api.DailyChallengeResult{
+			Place:      r.Place,
+			UserID:     r.UserID,
+			Username:   r.Username,
+			AvatarHash: r.AvatarHash,
+			GameID:     r.GameID,
+			Score:      r.Score,
+			EndedAt:    r.EndedAt,
+		})
+	}
+
+	return &api.DailyChallengeLeaderboard{
+		Total:   resultsTotal,
+		Results: apiResults,
+	}
+}
+
+// SingleplayerChallengeToAPI converts a singleplayer challenge with its results to the API model.
+func SingleplayerChallengeToAPI(c singleplayer.ChallengeComparison) *api.SingleplayerChallenge {
+	results := make([]api.SingleplayerChallengeResult, 0, len(c.Results))
+	for _, r := range c.Results {
+		results = append(results, api.SingleplayerChallengeResult{
+			GameID:     r.GameID,
+			UserID:     r.UserID,
+			Username:   r.Username,
+			AvatarHash: r.AvatarHash,
+			Score:      r.Score,
+			Finished:   r.Finished,
+			Guesses:    *SingleplayerRoundsToAPI(r.Guesses),
+		})
+	}
+
+	return &api.SingleplayerChallenge{
+		Token:           c.Challenge.Token,
+		GameID:          c.Challenge.GameID,
+		UserID:          c.Challenge.UserID,
+		Rounds:          c.Challenge.Rounds,
+		TimerSeconds:    c.Challenge.TimerSeconds,
+		MovementAllowed: c.Challenge.MovementAllowed,
+		Provider:        api.GameProvider(c.Challenge.Provider),
+		Providers:       ProvidersToAPI(c.Challenge.Providers),
+		ScoreDistance: api.OptScoreDistance{
+			Value: api.ScoreDistance(c.Challenge.ScoreDistance),
+			Set:   c.Challenge.ScoreDistance != 0,
+		},
+		Scoring:   api.ScoringMode(c.Challenge.Scoring),
+		CreatedAt: c.Challenge.CreatedAt,
+		Results:   results,
+	}
+}
+
 // GetSingleplayerGamesRequest represents a request to get a list of singleplayer games.
 type GetSingleplayerGamesRequest struct {
 	UserID   int
@@ -104,6 +184,7 @@ type NewSingleplayerRoundDBRequest struct {
 	StartedAt  time.Time
 	GameID     int
 	LocationID int
+	Provider   game.PanoramaProvider
 	RoundNum   int
 }
 
@@ -114,7 +195,21 @@ type NewSingleplayerGameRequest struct {
 	Rounds          int
 	TimerSeconds    int
 	Provider        string
+	Providers       []game.PanoramaProvider
 	MovementAllowed bool
+	// Daily is true when the game is played as today's daily challenge, game settings are ignored then.
+	Daily bool
+	// DailyChallengeID is set by usecase for daily challenge games.
+	DailyChallengeID int
+	// ChallengeID is set by usecase for games of accepted challenges.
+	ChallengeID int
+	// MapID is set when the game is played on a user-created map, provider is taken from the map then.
+	MapID int
+	// ScoreDistance is a distance in km, at which a guess receives ~60% of score.
+	// If it is 0, it is derived from the size of the played area by usecase.
+	ScoreDistance float64
+	// Scoring is a scoring mode of the game, classic if empty.
+	Scoring game.ScoringMode
 }
 
 // EndSingleplayerGameRequestDB represents a request to end a singleplayer game.
@@ -138,13 +233,21 @@ type NewSingleplayerRoundGuessRequest struct {
 	GameID      int
 	Guess       game.LatLng
 	Score       int
-	Distance    int
+	// ScoreBonus and ScorePenalty are parts of the score, see game.Score.
+	ScoreBonus   int
+	ScorePenalty int
+	Distance     int
+	// CountryCode is a country of the guess, empty if it is outside of known countries.
+	CountryCode string
 }
 
 // EndCurrentRoundResponse represents a response to end a singleplayer round.
 type EndCurrentRoundResponse struct {
-	Score    int
-	Distance int
+	Score        int
+	ScoreBonus   int
+	ScorePenalty int
+	Distance     int
+	Location     game.LatLng
 }
 
 // GetSingleplayerGameRequest represents a request to get a singleplayer game.
@@ -181,3 +284,58 @@ type EndSingleplayerGameRequest struct {
 	GameID      int
 	UserID      int
 }
+
+// NewDailyChallengeRequest represents a request to save a daily challenge.
+type NewDailyChallengeRequest struct {
+	RequestTime time.Time
+	Date        time.Time
+	Provider    game.PanoramaProvider
+	LocationIDs []int
+}
+
+// GetDailyChallengeLeaderboardRequest represents a request to get a daily challenge leaderboard.
+type GetDailyChallengeLeaderboardRequest struct {
+	RequestTime time.Time
+	// Date of the challenge, today (by UTC) if zero.
+	Date     time.Time
+	Provider game.PanoramaProvider
+	Page     int
+	PageSize int
+}
+
+// GetDailyChallengeLeaderboardRequestDB represents a request to get finished games of a daily challenge.
+type GetDailyChallengeLeaderboardRequestDB struct {
+	ChallengeID int
+	Page        int
+	PageSize    int
+}
+
+// NewSingleplayerChallengeRequest represents a request to create a challenge from a finished game.
+type NewSingleplayerChallengeRequest struct {
+	RequestTime time.Time
+	GameID      int
+	UserID      int
+}
+
+// NewSingleplayerChallengeRequestDB represents a request to save a challenge.
+type NewSingleplayerChallengeRequestDB struct {
+	RequestTime time.Time
+	Token       string
+	GameID      int
+	UserID      int
+	LocationIDs []int
+}
+
+// AcceptSingleplayerChallengeRequest represents a request to start a game of a challenge.
+type AcceptSingleplayerChallengeRequest struct {
+	RequestTime time.Time
+	Token       string
+	UserID      int
+}
+
+// GetSingleplayerChallengeRequest represents a request to get a challenge with results of its players.
+type GetSingleplayerChallengeRequest struct {
+	RequestTime time.Time
+	Token       string
+	UserID      int
+}
@@ -0,0 +1,175 @@
+package dto
+
+import (
+	"time"
+
+	api "github.com/VasySS/segoya-backend/api/ogen"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/streak"
+)
+
+// StreakGameToAPI converts a country streak game entity to the API model.
+func StreakGameToAPI(g streak.Game) *api.StreakGame {
+	return &api.StreakGame{
+		ID:              g.ID,
+		UserID:          g.UserID,
+		RoundCurrent:    g.RoundCurrent,
+		TimerSeconds:    g.TimerSeconds,
+		MovementAllowed: g.MovementAllowed,
+		Provider:        api.GameProvider(g.Provider),
+		Providers:       ProvidersToAPI(g.Providers),
+		Streak:          g.Streak,
+		Finished:        g.Finished,
+		CreatedAt:       g.CreatedAt,
+		EndedAt:         api.OptDateTime{Value: g.EndedAt, Set: !g.EndedAt.IsZero()},
+	}
+}
+
+// StreakRoundToAPI converts a country streak round entity to the API model.
+// Round location, its country and streetview ID are only revealed after the round has finished,
+// live round panorama is loaded by the panorama token.
+func StreakRoundToAPI(r streak.Round, panoramaToken string) *api.StreakRound {
+	resp := &api.StreakRound{
+		ID:            r.ID,
+		GameID:        r.GameID,
+		Provider:      api.Provider(r.Provider),
+		RoundNum:      r.RoundNum,
+		PanoramaToken: panoramaToken,
+		Correct:       r.Correct,
+		Finished:      r.Finished,
+		CreatedAt:     r.CreatedAt,
+		StartedAt:     r.StartedAt,
+	}
+
+	if r.Finished {
+		resp.StreetviewID = api.NewOptString(r.StreetviewID)
+		resp.Location = api.NewOptLatLng(LatLngToAPI(r.Location()))
+		resp.Country = api.NewOptCountryCode(api.CountryCode(r.CountryCode))
+		resp.GuessCountry = api.OptCountryCode{Value: api.CountryCode(r.GuessCountry), Set: r.GuessCountry != ""}
+	}
+
+	return resp
+}
+
+// StreakRoundResultToAPI converts a country streak round result to the API model.
+func StreakRoundResultToAPI(r EndStreakRoundResponse) *api.EndStreakRoundResponse {
+	return &api.EndStreakRoundResponse{
+		Correct:      r.Correct,
+		Country:      api.CountryCode(r.Country),
+		GuessCountry: api.OptCountryCode{Value: api.CountryCode(r.GuessCountry), Set: r.GuessCountry != ""},
+		Streak:       r.Streak,
+		Finished:     r.Finished,
+		Location:     LatLngToAPI(r.Location),
+	}
+}
+
+// BestStreakToAPI converts the best streak of a user to the API model.
+func BestStreakToAPI(b streak.BestStreak) *api.BestStreak {
+	return &api.BestStreak{
+		Streak:  b.Streak,
+		GameID:  api.OptInt{Value: b.GameID, Set: b.GameID != 0},
+		EndedAt: api.OptDateTime{Value: b.EndedAt, Set: !b.EndedAt.IsZero()},
+	}
+}
+
+// StreakLeaderboardToAPI converts best streaks of users to the API model.
+func StreakLeaderboardToAPI(results []streak.LeaderboardResult, resultsTotal int) *api.StreakLeaderboard {
+	apiResults := make([]api.StreakLeaderboardResult, 0, len(results))
+	for _, r := range results {
+		apiResults = append(apiResults, api.StreakLeaderboardResult{
+			Place:      r.Place,
+			UserID:     r.UserID,
+			Username:   r.Username,
+			AvatarHash: r.AvatarHash,
+			GameID:     r.GameID,
+			Streak:     r.Streak,
+			EndedAt:    r.EndedAt,
+		})
+	}
+
+	return &api.StreakLeaderboard{
+		Total:   resultsTotal,
+		Results: apiResults,
+	}
+}
+
+// NewStreakGameRequest represents a request to create a new country streak game.
+type NewStreakGameRequest struct {
+	RequestTime     time.Time
+	UserID          int
+	TimerSeconds    int
+	MovementAllowed bool
+	Provider        game.PanoramaProvider
+	Providers       []game.PanoramaProvider
+}
+
+// GetStreakGameRequest represents a request to get a country streak game.
+type GetStreakGameRequest struct {
+	RequestTime time.Time
+	GameID      int
+	UserID      int
+}
+
+// NewStreakRoundRequest represents a request to create a new country streak round.
+type NewStreakRoundRequest struct {
+	RequestTime time.Time
+	GameID      int
+	UserID      int
+}
+
+// GetStreakRoundRequest represents a request to get a current country streak round.
+type GetStreakRoundRequest struct {
+	RequestTime time.Time
+	GameID      int
+	UserID      int
+}
+
+// NewStreakRoundDBRequest represents a request to save a new country streak round.
+type NewStreakRoundDBRequest struct {
+	CreatedAt  time.Time
+	StartedAt  time.Time
+	GameID     int
+	LocationID int
+	Provider   game.PanoramaProvider
+	RoundNum   int
+	// CountryCode is a country of the round location.
+	CountryCode string
+}
+
+// EndStreakRoundRequest represents a request to guess a country of the current country streak round.
+type EndStreakRoundRequest struct {
+	RequestTime time.Time
+	GameID      int
+	UserID      int
+	// CountryCode is a named country, it is used instead of the guess location if set.
+	CountryCode string
+	// Guess is a clicked location, which country is resolved by usecase.
+	Guess *game.LatLng
+}
+
+// NewStreakRoundGuessRequest represents a request to save a guess of a country streak round.
+// The game streak is increased for a correct guess, otherwise the game is finished.
+type NewStreakRoundGuessRequest struct {
+	RequestTime time.Time
+	RoundID     int
+	GameID      int
+	// CountryCode is a guessed country, empty if a clicked location is outside of known countries.
+	CountryCode string
+	Correct     bool
+}
+
+// EndStreakRoundResponse represents a response to end a country streak round.
+type EndStreakRoundResponse struct {
+	Correct      bool
+	Country      string
+	GuessCountry string
+	Streak       int
+	Finished     bool
+	Location     game.LatLng
+}
+
+// GetStreakLeaderboardRequest represents a request to get best streaks of users.
+type GetStreakLeaderboardRequest struct {
+	Page     int
+	PageSize int
+}
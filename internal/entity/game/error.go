@@ -0,0 +1,10 @@
+package game
+
+import "errors"
+
+var (
+	// ErrPanoramaNotFound is returned when there is no panorama location matching the selection criteria.
+	ErrPanoramaNotFound = errors.New("panorama not found")
+	// ErrUnknownProvider is returned when a game can't be played on the panorama provider.
+	ErrUnknownProvider = errors.New("unknown provider")
+)
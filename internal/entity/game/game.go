@@ -6,3 +6,20 @@ type LatLng struct {
 	Lat float64 `json:"lat"`
 	Lng float64 `json:"lng"`
 }
+
+// Bounds is a bounding box of a set of locations.
+type Bounds struct {
+	MinLat float64 `db:"min_lat" json:"minLat"`
+	MinLng float64 `db:"min_lng" json:"minLng"`
+	MaxLat float64 `db:"max_lat" json:"maxLat"`
+	MaxLng float64 `db:"max_lng" json:"maxLng"`
+}
+
+// Place is a country and a region (first-level subdivision), which contain a location.
+// Codes are empty for locations outside of known boundaries, e.g. in the ocean.
+type Place struct {
+	// CountryCode is an ISO 3166-1 alpha-2 code, e.g. "CZ".
+	CountryCode string `db:"country_code" json:"countryCode"`
+	// RegionCode is an ISO 3166-2 code, e.g. "CZ-10".
+	RegionCode string `db:"region_code" json:"regionCode"`
+}
@@ -0,0 +1,17 @@
+package gamemap
+
+import "errors"
+
+var (
+	// ErrNotFound is returned when the map is not found in the database.
+	ErrNotFound = errors.New("map not found")
+	// ErrWrongUserID is returned when the user tries to modify a map that does not belong to them.
+	ErrWrongUserID = errors.New("map belongs to another user")
+	// ErrEmpty is returned when the user tries to play a map without locations.
+	ErrEmpty = errors.New("map has no locations")
+	// ErrInvalidImport is returned when imported locations can not be parsed or are invalid.
+	ErrInvalidImport = errors.New("invalid imported locations")
+	// ErrLocationNotFound is returned when the user tries to add a location,
+	// that does not exist or belongs to another provider.
+	ErrLocationNotFound = errors.New("location not found")
+)
@@ -0,0 +1,68 @@
+// Package gamemap contains types for working with user-created maps.
+package gamemap
+
+import (
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+)
+
+// Visibility defines who can see and play a map.
+type Visibility string
+
+// Supported map visibility settings.
+const (
+	// VisibilityPublic maps are listed for everyone and can be played by anyone.
+	VisibilityPublic Visibility = "public"
+	// VisibilityUnlisted maps are not listed, but can be played by anyone who knows the ID.
+	VisibilityUnlisted Visibility = "unlisted"
+	// VisibilityPrivate maps can be seen and played only by their creator.
+	VisibilityPrivate Visibility = "private"
+)
+
+// Valid returns true if visibility is one of the supported values.
+func (v Visibility) Valid() bool {
+	switch v {
+	case VisibilityPublic, VisibilityUnlisted, VisibilityPrivate:
+		return true
+	default:
+		return false
+	}
+}
+
+// ImportFormat is a format of imported map locations.
+type ImportFormat string
+
+// Supported import formats.
+const (
+	// ImportFormatCSV is a CSV with "lat,lng[,streetview_id]" rows, optionally with a header.
+	ImportFormatCSV ImportFormat = "csv"
+	// ImportFormatGeoJSON is a GeoJSON FeatureCollection of Point features.
+	ImportFormatGeoJSON ImportFormat = "geojson"
+)
+
+// Map struct contains information about a user-created map.
+type Map struct {
+	ID             int                   `db:"id"              json:"id"`
+	UserID         int                   `db:"user_id"         json:"userID"`
+	Name           string                `db:"name"            json:"name"`
+	Description    string                `db:"description"     json:"description"`
+	Provider       game.PanoramaProvider `db:"provider"        json:"provider"`
+	Visibility     Visibility            `db:"visibility"      json:"visibility"`
+	LocationsCount int                   `db:"locations_count" json:"locationsCount"`
+	CreatedAt      time.Time             `db:"created_at"      json:"createdAt"`
+	UpdatedAt      time.Time             `db:"updated_at"      json:"updatedAt"`
+}
+
+// AccessibleBy returns true if the user can see and play the map.
+func (m Map) AccessibleBy(userID int) bool {
+	return m.Visibility != VisibilityPrivate || m.UserID == userID
+}
+
+// Location struct contains a single location of a map.
+type Location struct {
+	game.LatLng
+	StreetviewID string
+	// Place is resolved from coordinates on import.
+	Place game.Place
+}
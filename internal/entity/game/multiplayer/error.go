@@ -19,4 +19,13 @@ var (
 	ErrRoundMaxAmount = errors.New("round max amount")
 	// ErrRoundAlreadyFinished is returned when user tries to send guess after round has ended.
 	ErrRoundAlreadyFinished = errors.New("round already finished")
+	// ErrUserEliminated is returned when an eliminated player of a battle royale game tries to send a guess.
+	ErrUserEliminated = errors.New("user eliminated")
+	// ErrWrongPlayersAmount is returned when a game can't be played by the amount of connected players,
+	// e.g. duels are played by two players (or two teams).
+	ErrWrongPlayersAmount = errors.New("wrong players amount")
+	// ErrWrongTeams is returned when players of a team game are not split into at least two teams.
+	ErrWrongTeams = errors.New("wrong teams")
+	// ErrSpectatorsNotAllowed is returned when a user tries to spectate a game, that can't be watched by spectators.
+	ErrSpectatorsNotAllowed = errors.New("spectators not allowed")
 )
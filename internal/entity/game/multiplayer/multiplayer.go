@@ -2,49 +2,329 @@
 package multiplayer
 
 import (
+	"cmp"
+	"slices"
 	"time"
 
 	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/user"
+)
+
+// Mode is a multiplayer game mode, it decides how rounds are scored and when the game ends.
+type Mode string
+
+// Supported multiplayer game modes.
+const (
+	// ClassicMode sums players' scores across a fixed amount of rounds.
+	ClassicMode Mode = "classic"
+	// BattleRoyaleMode eliminates the lowest-scoring players of each round,
+	// rounds continue until one player remains.
+	BattleRoyaleMode Mode = "battle_royale"
+	// DuelMode makes players lose health by the score difference with the best guess of each round,
+	// rounds continue until someone runs out of health.
+	DuelMode Mode = "duel"
 )
 
 // Game struct contains multiplayer game information.
+// Health is a health players of duel games start with, it is 0 for games of other modes.
+// TeamScoring is empty for games where players play individually.
+// Spectators is true if users outside of the game can watch it, they receive round results
+// after SpectatorDelaySeconds (to prevent ghosting).
 type Game struct {
-	ID              int                   `db:"id"               json:"id"`
-	CreatorID       int                   `db:"creator_id"       json:"creatorID"`
-	Rounds          int                   `db:"rounds"           json:"rounds"`
-	RoundCurrent    int                   `db:"round_current"    json:"roundCurrent"`
-	MovementAllowed bool                  `db:"movement_allowed" json:"movementAllowed"`
-	Provider        game.PanoramaProvider `db:"provider"         json:"provider"`
-	TimerSeconds    int                   `db:"timer_seconds"    json:"timerSeconds"`
-	Players         int                   `db:"players"          json:"players"`
-	Finished        bool                  `db:"finished"         json:"finished"`
-	CreatedAt       time.Time             `db:"created_at"       json:"createdAt"`
-	EndedAt         time.Time             `db:"ended_at"         json:"endedAt"`
+	ID                    int                     `db:"id"                      json:"id"`
+	CreatorID             int                     `db:"creator_id"              json:"creatorID"`
+	Rounds                int                     `db:"rounds"                  json:"rounds"`
+	RoundCurrent          int                     `db:"round_current"           json:"roundCurrent"`
+	MovementAllowed       bool                    `db:"movement_allowed"        json:"movementAllowed"`
+	Provider              game.PanoramaProvider   `db:"provider"                json:"provider"`
+	Providers             []game.PanoramaProvider `db:"providers"               json:"providers"`
+	ScoreDistance         float64                 `db:"score_distance"          json:"scoreDistance"`
+	Scoring               game.ScoringMode        `db:"scoring"                 json:"scoring"`
+	Mode                  Mode                    `db:"mode"                    json:"mode"`
+	Health                int                     `db:"health"                  json:"health"`
+	TeamScoring           TeamScoring             `db:"team_scoring"            json:"teamScoring"`
+	Spectators            bool                    `db:"spectators"              json:"spectators"`
+	SpectatorDelaySeconds int                     `db:"spectator_delay_seconds" json:"spectatorDelaySeconds"`
+	TimerSeconds          int                     `db:"timer_seconds"           json:"timerSeconds"`
+	Players               int                     `db:"players"                 json:"players"`
+	Finished              bool                    `db:"finished"                json:"finished"`
+	MapID                 int                     `db:"map_id"                  json:"mapID"`
+	CreatedAt             time.Time               `db:"created_at"              json:"createdAt"`
+	EndedAt               time.Time               `db:"ended_at"                json:"endedAt"`
+}
+
+// RoundsLeft returns true if new rounds can still be started in the game.
+// Battle royale and duel games have no fixed amount of rounds and continue until the game is finished.
+func (g Game) RoundsLeft() bool {
+	switch g.Mode {
+	case BattleRoyaleMode, DuelMode:
+		return !g.Finished
+	default:
+		return g.RoundCurrent < g.Rounds
+	}
+}
+
+// TeamGame returns true if players of the game are split into teams.
+func (g Game) TeamGame() bool {
+	return g.TeamScoring != ""
+}
+
+// SpectatorDelay returns the delay of round results sent to spectators of the game.
+func (g Game) SpectatorDelay() time.Duration {
+	return time.Second * time.Duration(g.SpectatorDelaySeconds)
 }
 
 // Round struct contains multiplayer round information.
+// Round location is never serialized, so that it can't be leaked to clients while the round is active.
+// FirstGuessAt is zero if nobody has guessed in the round yet.
 type Round struct {
-	ID           int       `db:"id"            json:"id"`
-	GameID       int       `db:"game_id"       json:"gameID"`
-	RoundNum     int       `db:"round_num"     json:"roundNum"`
-	StreetviewID string    `db:"streetview_id" json:"streetviewID"`
-	Lat          float64   `db:"lat"           json:"lat"`
-	Lng          float64   `db:"lng"           json:"lng"`
-	GuessesCount int       `db:"guesses_count" json:"guessesCount"`
-	Finished     bool      `db:"finished"      json:"finished"`
-	CreatedAt    time.Time `db:"created_at"    json:"createdAt"`
-	StartedAt    time.Time `db:"started_at"    json:"startedAt"`
-	EndedAt      time.Time `db:"ended_at"      json:"endedAt"`
+	ID           int                   `db:"id"             json:"id"`
+	GameID       int                   `db:"game_id"        json:"gameID"`
+	RoundNum     int                   `db:"round_num"      json:"roundNum"`
+	StreetviewID string                `db:"streetview_id"  json:"streetviewID"`
+	Provider     game.PanoramaProvider `db:"provider"       json:"provider"`
+	Lat          float64               `db:"lat"            json:"-"`
+	Lng          float64               `db:"lng"            json:"-"`
+	GuessesCount int                   `db:"guesses_count"  json:"guessesCount"`
+	FirstGuessAt time.Time             `db:"first_guess_at" json:"firstGuessAt"`
+	Finished     bool                  `db:"finished"       json:"finished"`
+	CreatedAt    time.Time             `db:"created_at"     json:"createdAt"`
+	StartedAt    time.Time             `db:"started_at"     json:"startedAt"`
+	EndedAt      time.Time             `db:"ended_at"       json:"endedAt"`
+}
+
+// Location returns the real location of the round.
+func (r Round) Location() game.LatLng {
+	return game.LatLng{Lat: r.Lat, Lng: r.Lng}
+}
+
+// GameRound is a round together with the game it belongs to.
+type GameRound struct {
+	Game  Game  `db:"game"`
+	Round Round `db:"round"`
+}
+
+// PanoramaTokenClaims returns claims for a panorama token of the round.
+func (r Round) PanoramaTokenClaims() game.PanoramaTokenClaims {
+	return game.PanoramaTokenClaims{
+		StreetviewID: r.StreetviewID,
+	}
 }
 
 // Guess struct contains multiplayer user's guess information.
+// Team is 0 for games without teams.
 type Guess struct {
-	Username   string  `json:"username"`
-	AvatarHash string  `json:"avatarHash"`
-	RoundNum   int     `json:"roundNum"`
-	RoundLat   float64 `json:"roundLat"`
-	RoundLng   float64 `json:"roundLng"`
-	Lat        float64 `json:"lat"`
-	Lng        float64 `json:"lng"`
-	Score      int     `json:"score"`
+	UserID       int     `json:"userID"`
+	Team         int     `json:"team"`
+	Username     string  `json:"username"`
+	AvatarHash   string  `json:"avatarHash"`
+	RoundNum     int     `json:"roundNum"`
+	RoundLat     float64 `json:"roundLat"`
+	RoundLng     float64 `json:"roundLng"`
+	Lat          float64 `json:"lat"`
+	Lng          float64 `json:"lng"`
+	Score        int     `json:"score"`
+	ScoreBonus   int     `json:"scoreBonus"`
+	ScorePenalty int     `json:"scorePenalty"`
+	MissDistance int     `json:"missDistance"`
+	RoundCountry string  `json:"roundCountry"`
+	GuessCountry string  `json:"guessCountry"`
+	// RoundEndedAt is used to hide guesses of the round from spectators until the spectator delay passes.
+	RoundEndedAt time.Time `json:"-"`
+}
+
+// CorrectCountry returns true if the guess is in the country of the round location.
+// It is false if any of the countries is unknown.
+func (g Guess) CorrectCountry() bool {
+	return g.RoundCountry != "" && g.RoundCountry == g.GuessCountry
+}
+
+// GameState struct contains a snapshot of a multiplayer game for a single player.
+// It never contains location of an active round, because it's sent to players on connect.
+type GameState struct {
+	Game  Game
+	Round Round
+	// OwnGuess is nil if the player has not made a guess in current round yet.
+	OwnGuess       *game.LatLng
+	GuessedUserIDs []int
+	TimerRemaining time.Duration
+	Standings      []Standing
+	// TeamStandings are only set for games with teams.
+	TeamStandings []TeamStanding
+	// RoundGuesses are only set after current round has finished.
+	RoundGuesses []Guess
+}
+
+// Standing struct contains player's place in a game by total score.
+type Standing struct {
+	Place      int    `json:"place"`
+	UserID     int    `json:"userID"`
+	Username   string `json:"username"`
+	AvatarHash string `json:"avatarHash"`
+	Score      int    `json:"score"`
+	// EliminatedRound is a round in which the player was eliminated (battle royale), 0 if not eliminated.
+	EliminatedRound int `json:"eliminatedRound"`
+	// Health is a remaining health of a duel player.
+	Health int `json:"health"`
+}
+
+// compareSurvival orders players who survived longer first, players who are not eliminated go before everyone.
+func (s Standing) compareSurvival(other Standing) int {
+	switch {
+	case s.EliminatedRound == other.EliminatedRound:
+		return 0
+	case s.EliminatedRound == 0:
+		return -1
+	case other.EliminatedRound == 0:
+		return 1
+	default:
+		return cmp.Compare(other.EliminatedRound, s.EliminatedRound)
+	}
+}
+
+// NewStandings ranks game players by their total score, eliminated players are ranked
+// below the ones who survived longer and duel players with less health are ranked below the others.
+// Players with equal score share the same place.
+func NewStandings(users []user.MultiplayerUser) []Standing {
+	standings := make([]Standing, 0, len(users))
+
+	for _, u := range users {
+		standings = append(standings, Standing{
+			UserID:          u.ID,
+			Username:        u.Username,
+			AvatarHash:      u.AvatarHash,
+			Score:           u.Score,
+			EliminatedRound: u.EliminatedRound,
+			Health:          u.Health,
+		})
+	}
+
+	slices.SortStableFunc(standings, func(a, b Standing) int {
+		return cmp.Or(
+			a.compareSurvival(b),
+			cmp.Compare(b.Health, a.Health),
+			cmp.Compare(b.Score, a.Score),
+			cmp.Compare(a.UserID, b.UserID),
+		)
+	})
+
+	for i := range standings {
+		if i > 0 && standings[i].Score == standings[i-1].Score &&
+			standings[i].Health == standings[i-1].Health &&
+			standings[i].compareSurvival(standings[i-1]) == 0 {
+			standings[i].Place = standings[i-1].Place
+			continue
+		}
+
+		standings[i].Place = i + 1
+	}
+
+	return standings
+}
+
+// RoundLosers returns IDs of players to be eliminated after a battle royale round - alive players
+// with the lowest score of the round (players without a guess score 0).
+// Nobody is eliminated if all alive players share the lowest score.
+func RoundLosers(users []user.MultiplayerUser, guesses []Guess) []int {
+	scores := make(map[int]int, len(guesses))
+	for _, g := range guesses {
+		scores[g.UserID] = g.Score
+	}
+
+	var (
+		losers   []int
+		alive    int
+		minScore int
+	)
+
+	for _, u := range users {
+		if u.Eliminated() {
+			continue
+		}
+
+		alive++
+		score := scores[u.ID]
+
+		switch {
+		case len(losers) == 0 || score < minScore:
+			minScore = score
+			losers = []int{u.ID}
+		case score == minScore:
+			losers = append(losers, u.ID)
+		}
+	}
+
+	if len(losers) == alive {
+		return nil
+	}
+
+	return losers
+}
+
+// AlivePlayers returns the amount of players that are not eliminated.
+func AlivePlayers(users []user.MultiplayerUser) int {
+	alive := 0
+
+	for _, u := range users {
+		if !u.Eliminated() {
+			alive++
+		}
+	}
+
+	return alive
+}
+
+// Damage is health lost by a duel player after a round.
+type Damage struct {
+	UserID int `json:"userID"`
+	Damage int `json:"damage"`
+}
+
+// DuelDamage returns damage dealt to duel players after a round - every player loses the difference
+// between the best score of the round and their own score, multiplied by the round multiplier.
+// In team duels all members of a team lose the difference between the best team score and their team score.
+// Players without a guess score 0, players who don't lose health are omitted.
+func DuelDamage(
+	users []user.MultiplayerUser,
+	guesses []Guess,
+	scoring TeamScoring,
+	multiplier float64,
+) []Damage {
+	scores := sideScores(users, guesses, scoring)
+	bestScore := 0
+
+	for _, score := range scores {
+		bestScore = max(bestScore, score)
+	}
+
+	var damage []Damage
+
+	for _, u := range users {
+		if d := int(float64(bestScore-scores[u.ID]) * multiplier); d > 0 {
+			damage = append(damage, Damage{UserID: u.ID, Damage: d})
+		}
+	}
+
+	return damage
+}
+
+// sideScores returns score of the round for each player - score of their own guess,
+// or score of their team if the game has teams.
+func sideScores(users []user.MultiplayerUser, guesses []Guess, scoring TeamScoring) map[int]int {
+	scores := make(map[int]int, len(users))
+
+	if scoring == "" {
+		for _, g := range guesses {
+			scores[g.UserID] = g.Score
+		}
+
+		return scores
+	}
+
+	teamScores := TeamRoundScores(users, guesses, scoring)
+	for _, u := range users {
+		scores[u.ID] = teamScores[u.Team]
+	}
+
+	return scores
 }
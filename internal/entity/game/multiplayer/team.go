@@ -0,0 +1,132 @@
+package multiplayer
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/VasySS/segoya-backend/internal/entity/user"
+)
+
+// TeamScoring decides how scores of team members are combined into a team score of a round.
+type TeamScoring string
+
+// Supported team scoring modes.
+const (
+	// BestTeamScoring counts only the best guess of the team.
+	BestTeamScoring TeamScoring = "best"
+	// AverageTeamScoring counts the average score of all team members, members without a guess score 0.
+	AverageTeamScoring TeamScoring = "average"
+)
+
+// TeamStanding struct contains team's place in a game by total team score.
+// Health is a remaining health of the team in duels, it is shared by all team members.
+type TeamStanding struct {
+	Place   int   `json:"place"`
+	Team    int   `json:"team"`
+	UserIDs []int `json:"userIDs"`
+	Score   int   `json:"score"`
+	Health  int   `json:"health"`
+}
+
+// TeamRoundScores returns scores of all teams in a round by team number.
+func TeamRoundScores(users []user.MultiplayerUser, guesses []Guess, scoring TeamScoring) map[int]int {
+	teams := make(map[int]int, len(users))
+	members := make(map[int]int)
+
+	for _, u := range users {
+		if u.Team == 0 {
+			continue
+		}
+
+		teams[u.ID] = u.Team
+		members[u.Team]++
+	}
+
+	scores := make(map[int]int, len(members))
+	for team := range members {
+		scores[team] = 0
+	}
+
+	for _, g := range guesses {
+		team, ok := teams[g.UserID]
+		if !ok {
+			continue
+		}
+
+		switch scoring {
+		case AverageTeamScoring:
+			scores[team] += g.Score
+		default:
+			scores[team] = max(scores[team], g.Score)
+		}
+	}
+
+	if scoring == AverageTeamScoring {
+		for team, score := range scores {
+			scores[team] = score / members[team]
+		}
+	}
+
+	return scores
+}
+
+// NewTeamStandings ranks teams by the sum of their round scores, teams with less health are ranked
+// below the others in duels. Teams with equal score share the same place.
+// It returns nil for games without teams.
+func NewTeamStandings(users []user.MultiplayerUser, guesses []Guess, scoring TeamScoring) []TeamStanding {
+	if scoring == "" {
+		return nil
+	}
+
+	teams := make(map[int]*TeamStanding)
+
+	for _, u := range users {
+		if u.Team == 0 {
+			continue
+		}
+
+		s, ok := teams[u.Team]
+		if !ok {
+			s = &TeamStanding{Team: u.Team, Health: u.Health}
+			teams[u.Team] = s
+		}
+
+		s.UserIDs = append(s.UserIDs, u.ID)
+		s.Health = min(s.Health, u.Health)
+	}
+
+	rounds := make(map[int][]Guess)
+	for _, g := range guesses {
+		rounds[g.RoundNum] = append(rounds[g.RoundNum], g)
+	}
+
+	for _, roundGuesses := range rounds {
+		for team, score := range TeamRoundScores(users, roundGuesses, scoring) {
+			teams[team].Score += score
+		}
+	}
+
+	standings := make([]TeamStanding, 0, len(teams))
+	for _, s := range teams {
+		standings = append(standings, *s)
+	}
+
+	slices.SortFunc(standings, func(a, b TeamStanding) int {
+		return cmp.Or(
+			cmp.Compare(b.Health, a.Health),
+			cmp.Compare(b.Score, a.Score),
+			cmp.Compare(a.Team, b.Team),
+		)
+	})
+
+	for i := range standings {
+		if i > 0 && standings[i].Score == standings[i-1].Score && standings[i].Health == standings[i-1].Health {
+			standings[i].Place = standings[i-1].Place
+			continue
+		}
+
+		standings[i].Place = i + 1
+	}
+
+	return standings
+}
@@ -3,7 +3,7 @@ package game
 // PanoramaProvider is a type of panorama provider (who is hosting streetview images).
 type PanoramaProvider string
 
-// Supported panorama providers.
+// Names of the default panorama providers, providers which can be played on are defined by the configuration.
 const (
 	GoogleProvider    PanoramaProvider = "google"
 	YandexProvider    PanoramaProvider = "yandex"
@@ -11,38 +11,22 @@ const (
 	SeznamProvider    PanoramaProvider = "seznam"
 )
 
+// MixedProvider is a game provider, for which every round picks one of allowed panorama providers.
+const MixedProvider PanoramaProvider = "mixed"
+
 // PanoramaMetadata contains general streetview metadata.
 type PanoramaMetadata struct {
 	LatLng
 	ID           int
 	StreetviewID string
+	Provider     PanoramaProvider
+	// CountryCode of the location is saved with it, empty if it is outside of known countries
+	// (or was not resolved yet).
+	CountryCode string
 }
 
-// GoogleStreetview contains Google streetview metadata.
-type GoogleStreetview struct {
-	ID  int
-	Lat float64
-	Lng float64
-}
-
-// SeznamStreetview contains Seznam streetview metadata.
-type SeznamStreetview struct {
-	ID  int
-	Lat float64
-	Lng float64
-}
-
-// YandexAirview contains Yandex air view metadata.
-type YandexAirview struct {
-	ID           int
+// PanoramaTokenClaims contains panorama streetview ID, that is hidden from clients inside of a panorama token.
+// Coordinates are not put into the token, as they are not needed to load the panorama.
+type PanoramaTokenClaims struct {
 	StreetviewID string
-	Lat          float64
-	Lng          float64
-}
-
-// YandexStreetview contains Yandex streetview metadata.
-type YandexStreetview struct {
-	ID  int
-	Lat float64
-	Lng float64
 }
@@ -0,0 +1,31 @@
+package game
+
+// ScoringMode is a way of scoring guesses, it is selected for a game.
+type ScoringMode string
+
+// Supported scoring modes.
+const (
+	// ClassicScoring scores guesses only by distance to the location.
+	ClassicScoring ScoringMode = "classic"
+	// TimedScoring gives a bonus for fast guesses.
+	TimedScoring ScoringMode = "timed"
+	// HardScoring scores guesses by distance with a steeper falloff.
+	HardScoring ScoringMode = "hard"
+	// CountryScoring gives a bonus for guesses in the country of the location.
+	CountryScoring ScoringMode = "country"
+)
+
+// Score is a score of a guess with its breakdown.
+type Score struct {
+	// Base is a score for distance to the location (0 to 5000).
+	Base    int
+	Bonus   int
+	Penalty int
+	// Distance is a distance between the guess and the location in meters.
+	Distance int
+}
+
+// Total returns the final score of the guess, it is never negative.
+func (s Score) Total() int {
+	return max(s.Base+s.Bonus-s.Penalty, 0)
+}
@@ -17,4 +17,17 @@ var (
 	ErrRoundMaxAmount = errors.New("round max amount")
 	// ErrRoundAlreadyFinished is returned when user tries to end the round that is already finished.
 	ErrRoundAlreadyFinished = errors.New("round already finished")
+	// ErrDailyChallengeNotFound is returned when the daily challenge is not found in the database.
+	ErrDailyChallengeNotFound = errors.New("daily challenge not found")
+	// ErrDailyChallengeAlreadyPlayed is returned when user tries to play the same daily challenge twice.
+	ErrDailyChallengeAlreadyPlayed = errors.New("daily challenge already played")
+	// ErrDailyChallengeMixedProvider is returned when user tries to play daily challenge with mixed provider.
+	ErrDailyChallengeMixedProvider = errors.New("daily challenge mixed provider")
+	// ErrChallengeNotFound is returned when the challenge is not found in the database.
+	ErrChallengeNotFound = errors.New("challenge not found")
+	// ErrChallengeAlreadyPlayed is returned when user tries to accept a challenge they already played.
+	ErrChallengeAlreadyPlayed = errors.New("challenge already played")
+	// ErrChallengeFromDailyGame is returned when user tries to create a challenge from a daily challenge game,
+	// which would reveal locations of the daily challenge to other players.
+	ErrChallengeFromDailyGame = errors.New("challenge from daily game")
 )
@@ -9,31 +9,56 @@ import (
 
 // Game struct contains singleplayer game information.
 type Game struct {
-	ID              int                   `db:"id"               json:"id"`
-	UserID          int                   `db:"user_id"          json:"userID"`
-	Rounds          int                   `db:"rounds"           json:"rounds"`
-	RoundCurrent    int                   `db:"round_current"    json:"roundCurrent"`
-	TimerSeconds    int                   `db:"timer_seconds"    json:"timerSeconds"`
-	MovementAllowed bool                  `db:"movement_allowed" json:"movementAllowed"`
-	Provider        game.PanoramaProvider `db:"provider"         json:"provider"`
-	Score           int                   `db:"score"            json:"score"`
-	Finished        bool                  `db:"finished"         json:"finished"`
-	CreatedAt       time.Time             `db:"created_at"       json:"createdAt"`
-	EndedAt         time.Time             `db:"ended_at"         json:"endedAt"`
+	ID               int                     `db:"id"                 json:"id"`
+	UserID           int                     `db:"user_id"            json:"userID"`
+	Rounds           int                     `db:"rounds"             json:"rounds"`
+	RoundCurrent     int                     `db:"round_current"      json:"roundCurrent"`
+	TimerSeconds     int                     `db:"timer_seconds"      json:"timerSeconds"`
+	MovementAllowed  bool                    `db:"movement_allowed"   json:"movementAllowed"`
+	Provider         game.PanoramaProvider   `db:"provider"           json:"provider"`
+	Providers        []game.PanoramaProvider `db:"providers"          json:"providers"`
+	ScoreDistance    float64                 `db:"score_distance"     json:"scoreDistance"`
+	Scoring          game.ScoringMode        `db:"scoring"            json:"scoring"`
+	Score            int                     `db:"score"              json:"score"`
+	Finished         bool                    `db:"finished"           json:"finished"`
+	DailyChallengeID int                     `db:"daily_challenge_id" json:"dailyChallengeID"`
+	ChallengeID      int                     `db:"challenge_id"       json:"challengeID"`
+	MapID            int                     `db:"map_id"             json:"mapID"`
+	CreatedAt        time.Time               `db:"created_at"         json:"createdAt"`
+	EndedAt          time.Time               `db:"ended_at"           json:"endedAt"`
+}
+
+// IsDaily returns true if the game is played as a daily challenge (challenge ID is 0 for regular games).
+func (g Game) IsDaily() bool {
+	return g.DailyChallengeID != 0
 }
 
 // Round struct contains singleplayer round information.
+// Location is excluded from JSON to keep it hidden from the player until the round ends.
 type Round struct {
-	ID           int       `db:"id"            json:"id"`
-	GameID       int       `db:"game_id"       json:"gameID"`
-	StreetviewID string    `db:"streetview_id" json:"streetviewID"`
-	Lat          float64   `db:"lat"           json:"lat"`
-	Lng          float64   `db:"lng"           json:"lng"`
-	RoundNum     int       `db:"round_num"     json:"roundNum"`
-	Finished     bool      `db:"finished"      json:"finished"`
-	CreatedAt    time.Time `db:"created_at"    json:"createdAt"`
-	StartedAt    time.Time `db:"started_at"    json:"startedAt"`
-	EndedAt      time.Time `db:"ended_at"      json:"endedAt"`
+	ID           int                   `db:"id"            json:"id"`
+	GameID       int                   `db:"game_id"       json:"gameID"`
+	StreetviewID string                `db:"streetview_id" json:"streetviewID"`
+	Provider     game.PanoramaProvider `db:"provider"      json:"provider"`
+	Lat          float64               `db:"lat"           json:"-"`
+	Lng          float64               `db:"lng"           json:"-"`
+	RoundNum     int                   `db:"round_num"     json:"roundNum"`
+	Finished     bool                  `db:"finished"      json:"finished"`
+	CreatedAt    time.Time             `db:"created_at"    json:"createdAt"`
+	StartedAt    time.Time             `db:"started_at"    json:"startedAt"`
+	EndedAt      time.Time             `db:"ended_at"      json:"endedAt"`
+}
+
+// Location returns the real location of the round.
+func (r Round) Location() game.LatLng {
+	return game.LatLng{Lat: r.Lat, Lng: r.Lng}
+}
+
+// PanoramaTokenClaims returns claims for a panorama token of the round.
+func (r Round) PanoramaTokenClaims() game.PanoramaTokenClaims {
+	return game.PanoramaTokenClaims{
+		StreetviewID: r.StreetviewID,
+	}
 }
 
 // Guess struct contains singleplayer guess information.
@@ -44,5 +69,84 @@ type Guess struct {
 	GuessLat     float64 `json:"guessLat"`
 	GuessLng     float64 `json:"guessLng"`
 	Score        int     `json:"score"`
+	ScoreBonus   int     `json:"scoreBonus"`
+	ScorePenalty int     `json:"scorePenalty"`
 	MissDistance int     `json:"missDistance"`
+	RoundCountry string  `json:"roundCountry"`
+	GuessCountry string  `json:"guessCountry"`
+}
+
+// CorrectCountry returns true if the guess is in the country of the round location.
+// It is false if any of the countries is unknown.
+func (g Guess) CorrectCountry() bool {
+	return g.RoundCountry != "" && g.RoundCountry == g.GuessCountry
+}
+
+// IsChallenge returns true if the game is played as an accepted challenge of another game.
+func (g Game) IsChallenge() bool {
+	return g.ChallengeID != 0
+}
+
+// DailyChallenge struct contains the set of locations shared by all players for a date and provider.
+type DailyChallenge struct {
+	ID          int                   `db:"id"             json:"id"`
+	Date        time.Time             `db:"challenge_date" json:"date"`
+	Provider    game.PanoramaProvider `db:"provider"       json:"provider"`
+	LocationIDs []int                 `db:"location_ids"   json:"-"`
+	CreatedAt   time.Time             `db:"created_at"     json:"createdAt"`
+}
+
+// DailyChallengeResult struct contains a single finished game of the daily challenge leaderboard.
+type DailyChallengeResult struct {
+	Place      int       `db:"place"       json:"place"`
+	UserID     int       `db:"user_id"     json:"userID"`
+	Username   string    `db:"username"    json:"username"`
+	AvatarHash string    `db:"avatar_hash" json:"avatarHash"`
+	GameID     int       `db:"game_id"     json:"gameID"`
+	Score      int       `db:"score"       json:"score"`
+	EndedAt    time.Time `db:"ended_at"    json:"endedAt"`
+}
+
+// Challenge struct contains a shareable challenge to replay locations and settings of a finished game.
+type Challenge struct {
+	ID                int                     `db:"id"                 json:"id"`
+	Token             string                  `db:"token"              json:"token"`
+	GameID            int                     `db:"game_id"            json:"gameID"`
+	UserID            int                     `db:"user_id"            json:"userID"`
+	Rounds            int                     `db:"rounds"             json:"rounds"`
+	TimerSeconds      int                     `db:"timer_seconds"      json:"timerSeconds"`
+	MovementAllowed   bool                    `db:"movement_allowed"   json:"movementAllowed"`
+	Provider          game.PanoramaProvider   `db:"provider"           json:"provider"`
+	Providers         []game.PanoramaProvider `db:"providers"          json:"providers"`
+	ScoreDistance     float64                 `db:"score_distance"     json:"scoreDistance"`
+	Scoring           game.ScoringMode        `db:"scoring"            json:"scoring"`
+	LocationIDs       []int                   `db:"location_ids"       json:"-"`
+	LocationProviders []game.PanoramaProvider `db:"location_providers" json:"-"`
+	CreatedAt         time.Time               `db:"created_at"         json:"createdAt"`
+}
+
+// LocationProvider returns provider of the challenge location by its index.
+func (c Challenge) LocationProvider(i int) game.PanoramaProvider {
+	if i < len(c.LocationProviders) {
+		return c.LocationProviders[i]
+	}
+
+	return c.Provider
+}
+
+// ChallengeResult struct contains a game of the challenge creator or one of the challengers.
+type ChallengeResult struct {
+	GameID     int     `db:"game_id"     json:"gameID"`
+	UserID     int     `db:"user_id"     json:"userID"`
+	Username   string  `db:"username"    json:"username"`
+	AvatarHash string  `db:"avatar_hash" json:"avatarHash"`
+	Score      int     `db:"score"       json:"score"`
+	Finished   bool    `db:"finished"    json:"finished"`
+	Guesses    []Guess `db:"-"           json:"guesses"`
+}
+
+// ChallengeComparison struct contains a challenge with results of all its players.
+type ChallengeComparison struct {
+	Challenge Challenge         `json:"challenge"`
+	Results   []ChallengeResult `json:"results"`
 }
@@ -0,0 +1,20 @@
+package streak
+
+import "errors"
+
+var (
+	// ErrGameNotFound is returned when the game is not found in the database.
+	ErrGameNotFound = errors.New("game not found")
+	// ErrGameWrongUserID is returned when the user tries to interact with a game that does not belong to them.
+	ErrGameWrongUserID = errors.New("game wrong user id")
+	// ErrGameFinished is returned when user tries to play a game that has already ended with a miss.
+	ErrGameFinished = errors.New("game finished")
+	// ErrRoundNotFound is returned when the round is not found in the database.
+	ErrRoundNotFound = errors.New("round not found")
+	// ErrRoundAlreadyFinished is returned when user tries to end the round that is already finished.
+	ErrRoundAlreadyFinished = errors.New("round already finished")
+	// ErrEmptyGuess is returned when a guess has neither a country nor a location.
+	ErrEmptyGuess = errors.New("empty guess")
+	// ErrNoCountryLocation is returned when no location inside of a known country was found for a round.
+	ErrNoCountryLocation = errors.New("no location inside of a known country")
+)
@@ -0,0 +1,78 @@
+// Package streak contains types for working with country streak game data.
+package streak
+
+import (
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+)
+
+// Game struct contains country streak game information.
+// Timer and movement settings have the same meaning as in singleplayer games.
+type Game struct {
+	ID              int                     `db:"id"               json:"id"`
+	UserID          int                     `db:"user_id"          json:"userID"`
+	RoundCurrent    int                     `db:"round_current"    json:"roundCurrent"`
+	TimerSeconds    int                     `db:"timer_seconds"    json:"timerSeconds"`
+	MovementAllowed bool                    `db:"movement_allowed" json:"movementAllowed"`
+	Provider        game.PanoramaProvider   `db:"provider"         json:"provider"`
+	Providers       []game.PanoramaProvider `db:"providers"        json:"providers"`
+	Streak          int                     `db:"streak"           json:"streak"`
+	Finished        bool                    `db:"finished"         json:"finished"`
+	CreatedAt       time.Time               `db:"created_at"       json:"createdAt"`
+	EndedAt         time.Time               `db:"ended_at"         json:"endedAt"`
+}
+
+// Round struct contains country streak round information.
+// Location and its country are excluded from JSON to keep them hidden from the player until the round ends.
+type Round struct {
+	ID           int                   `db:"id"            json:"id"`
+	GameID       int                   `db:"game_id"       json:"gameID"`
+	StreetviewID string                `db:"streetview_id" json:"streetviewID"`
+	Provider     game.PanoramaProvider `db:"provider"      json:"provider"`
+	Lat          float64               `db:"lat"           json:"-"`
+	Lng          float64               `db:"lng"           json:"-"`
+	CountryCode  string                `db:"country_code"  json:"-"`
+	GuessCountry string                `db:"guess_country" json:"guessCountry"`
+	Correct      bool                  `db:"correct"       json:"correct"`
+	RoundNum     int                   `db:"round_num"     json:"roundNum"`
+	Finished     bool                  `db:"finished"      json:"finished"`
+	CreatedAt    time.Time             `db:"created_at"    json:"createdAt"`
+	StartedAt    time.Time             `db:"started_at"    json:"startedAt"`
+	EndedAt      time.Time             `db:"ended_at"      json:"endedAt"`
+}
+
+// Location returns the real location of the round.
+func (r Round) Location() game.LatLng {
+	return game.LatLng{Lat: r.Lat, Lng: r.Lng}
+}
+
+// PanoramaTokenClaims returns claims for a panorama token of the round.
+func (r Round) PanoramaTokenClaims() game.PanoramaTokenClaims {
+	return game.PanoramaTokenClaims{
+		StreetviewID: r.StreetviewID,
+	}
+}
+
+// IsCorrect returns true if the guessed country is the country of the round location.
+func (r Round) IsCorrect(guessCountry string) bool {
+	return r.CountryCode != "" && r.CountryCode == guessCountry
+}
+
+// BestStreak struct contains the longest streak of a user among their finished games.
+type BestStreak struct {
+	GameID  int       `db:"game_id"  json:"gameID"`
+	Streak  int       `db:"streak"   json:"streak"`
+	EndedAt time.Time `db:"ended_at" json:"endedAt"`
+}
+
+// LeaderboardResult struct contains the best streak of a single user of the streak leaderboard.
+type LeaderboardResult struct {
+	Place      int       `db:"place"       json:"place"`
+	UserID     int       `db:"user_id"     json:"userID"`
+	Username   string    `db:"username"    json:"username"`
+	AvatarHash string    `db:"avatar_hash" json:"avatarHash"`
+	GameID     int       `db:"game_id"     json:"gameID"`
+	Streak     int       `db:"streak"      json:"streak"`
+	EndedAt    time.Time `db:"ended_at"    json:"endedAt"`
+}
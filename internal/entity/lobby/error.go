@@ -10,4 +10,35 @@ var (
 	ErrOnlyCreatorCanStart = errors.New("only creator can start the game")
 	// ErrLobbyIsFull is returned when user tries to join a lobby that is full.
 	ErrLobbyIsFull = errors.New("lobby is full")
+	// ErrWrongTeam is returned when user tries to join a team that doesn't exist in the lobby.
+	ErrWrongTeam = errors.New("wrong team")
+	// ErrOnlyCreatorCanChangeSettings is returned when the user tries to change settings
+	// of a lobby that was not created by them.
+	ErrOnlyCreatorCanChangeSettings = errors.New("only creator can change settings")
+	// ErrWrongSettings is returned when new lobby settings are out of allowed bounds
+	// (e.g. max players is lower than the amount of players already in the lobby).
+	ErrWrongSettings = errors.New("wrong settings")
+	// ErrOnlyCreatorCanModerate is returned when the user tries to kick, ban or pass the host role
+	// in a lobby that was not created by them.
+	ErrOnlyCreatorCanModerate = errors.New("only creator can moderate the lobby")
+	// ErrWrongModerationTarget is returned when the creator tries to kick, ban or pass the host role
+	// to themselves or to a user who is not connected to the lobby.
+	ErrWrongModerationTarget = errors.New("wrong moderation target")
+	// ErrUserIsBanned is returned when a user banned from the lobby tries to join it.
+	ErrUserIsBanned = errors.New("user is banned from the lobby")
+	// ErrPasswordRequired is returned when a password-protected lobby is created without a password.
+	ErrPasswordRequired = errors.New("password is required")
+	// ErrWrongPassword is returned when a user tries to join a password-protected lobby with a wrong password.
+	ErrWrongPassword = errors.New("wrong lobby password")
+	// ErrNotInvited is returned when a user tries to join an invite-only lobby without an invitation.
+	ErrNotInvited = errors.New("user is not invited to the lobby")
+	// ErrPlayersNotReady is returned when the game is started from a lobby, that requires
+	// every player to be ready, while some of them are not.
+	ErrPlayersNotReady = errors.New("not all players are ready")
+	// ErrGameStarting is returned when the game is started from a lobby, which game is already being started
+	// (by the countdown or by another request of the creator).
+	ErrGameStarting = errors.New("game is already starting")
+	// ErrCountdownCancelled is returned when the countdown runs out, but it was cancelled
+	// or replaced by another countdown in the meantime.
+	ErrCountdownCancelled = errors.New("countdown was cancelled")
 )
@@ -2,18 +2,192 @@
 package lobby
 
 import (
+	"slices"
 	"time"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+)
+
+// Visibility decides who can find and join a lobby.
+type Visibility string
+
+const (
+	// PublicVisibility lobbies are listed and can be joined by anyone.
+	PublicVisibility Visibility = "public"
+	// UnlistedVisibility lobbies are not listed, but can be joined by anyone with a link.
+	UnlistedVisibility Visibility = "unlisted"
+	// PasswordVisibility lobbies are listed, but require a password to join.
+	PasswordVisibility Visibility = "password"
+	// InviteOnlyVisibility lobbies are not listed and can only be joined by invited users.
+	InviteOnlyVisibility Visibility = "invite_only"
+)
+
+// Listed returns true if lobbies with the visibility are shown in the list of lobbies.
+func (v Visibility) Listed() bool {
+	return v == PublicVisibility || v == PasswordVisibility
+}
+
+// Order is an order of lobbies in the list of lobbies.
+type Order string
+
+const (
+	// NewestOrder lists recently created lobbies first.
+	NewestOrder Order = "newest"
+	// OldestOrder lists lobbies in order of creation.
+	OldestOrder Order = "oldest"
+	// MostPlayersOrder lists lobbies with the most connected players first.
+	MostPlayersOrder Order = "most_players"
+	// FewestPlayersOrder lists lobbies with the fewest connected players first.
+	FewestPlayersOrder Order = "fewest_players"
 )
 
 // Lobby struct contains lobby information, including game details.
+// Teams is an amount of teams players are split into, it is 0 if players play individually.
+// Spectators allows users outside of games started from the lobby to watch them.
+// PasswordHash is only set for password-protected lobbies, InvitedUserIDs - for invite-only lobbies.
+// RequireReady forbids starting games until every connected player is ready.
 type Lobby struct {
-	ID              string    `json:"id"`
-	CreatorID       int       `json:"creatorID"`
-	CreatedAt       time.Time `json:"createdAt"`
-	Rounds          int       `json:"rounds"`
-	Provider        string    `json:"provider"`
-	MovementAllowed bool      `json:"movementAllowed"`
-	TimerSeconds    int       `json:"timerSeconds"`
-	CurrentPlayers  int       `json:"currentPlayers"`
-	MaxPlayers      int       `json:"maxPlayers"`
+	ID                    string     `json:"id"`
+	CreatorID             int        `json:"creatorID"`
+	CreatedAt             time.Time  `json:"createdAt"`
+	Rounds                int        `json:"rounds"`
+	Provider              string     `json:"provider"`
+	Providers             []string   `json:"providers"`
+	ScoreDistance         float64    `json:"scoreDistance"`
+	Scoring               string     `json:"scoring"`
+	Mode                  string     `json:"mode"`
+	Teams                 int        `json:"teams"`
+	TeamScoring           string     `json:"teamScoring"`
+	Spectators            bool       `json:"spectators"`
+	SpectatorDelaySeconds int        `json:"spectatorDelaySeconds"`
+	MovementAllowed       bool       `json:"movementAllowed"`
+	TimerSeconds          int        `json:"timerSeconds"`
+	CurrentPlayers        int        `json:"currentPlayers"`
+	MaxPlayers            int        `json:"maxPlayers"`
+	MapID                 int        `json:"mapID"`
+	Visibility            Visibility `json:"visibility"`
+	RequireReady          bool       `json:"requireReady"`
+	PasswordHash          string     `json:"-"`
+	InvitedUserIDs        []int      `json:"-"`
+}
+
+// Invited returns true if the user can join an invite-only lobby, its creator is always invited.
+func (l Lobby) Invited(userID int) bool {
+	return l.CreatorID == userID || slices.Contains(l.InvitedUserIDs, userID)
+}
+
+// ValidTeam returns true if players of the lobby can join the team.
+func (l Lobby) ValidTeam(team int) bool {
+	return team >= 1 && team <= l.Teams
+}
+
+// AssignTeams returns teams of players by their IDs for a game started from the lobby, nil if the lobby has no teams.
+// Players keep the teams they have picked, others are assigned one by one to the team with the fewest players.
+func (l Lobby) AssignTeams(userIDs []int, picked map[int]int) map[int]int {
+	if l.Teams == 0 {
+		return nil
+	}
+
+	teams := make(map[int]int, len(userIDs))
+	members := make([]int, l.Teams+1)
+
+	var unassigned []int
+
+	for _, id := range userIDs {
+		team, ok := picked[id]
+		if !ok || !l.ValidTeam(team) {
+			unassigned = append(unassigned, id)
+			continue
+		}
+
+		teams[id] = team
+		members[team]++
+	}
+
+	for _, id := range unassigned {
+		smallest := 1
+
+		for team := 2; team <= l.Teams; team++ {
+			if members[team] < members[smallest] {
+				smallest = team
+			}
+		}
+
+		teams[id] = smallest
+		members[smallest]++
+	}
+
+	return teams
+}
+
+// AllReady returns true if there are connected players and every one of them is ready.
+func AllReady(players, ready []int) bool {
+	if len(players) == 0 {
+		return false
+	}
+
+	for _, id := range players {
+		if !slices.Contains(ready, id) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Bounds of lobby settings.
+const (
+	minRounds       = 1
+	maxRounds       = 10
+	minTimerSeconds = 10
+	maxTimerSeconds = 600
+	minPlayers      = 2
+	maxPlayers      = 10
+)
+
+// Settings are settings of a lobby, that can be changed by its creator while waiting for players.
+// Providers are only kept for mixed provider, TimerSeconds is 0 for rounds without timer.
+type Settings struct {
+	Rounds          int      `json:"rounds"`
+	Provider        string   `json:"provider"`
+	Providers       []string `json:"providers"`
+	TimerSeconds    int      `json:"timerSeconds"`
+	MovementAllowed bool     `json:"movementAllowed"`
+	MaxPlayers      int      `json:"maxPlayers"`
+	RequireReady    bool     `json:"requireReady"`
+}
+
+// Valid returns true if the settings are within allowed bounds, validProvider reports
+// whether a game can be played on a provider.
+func (s Settings) Valid(validProvider func(game.PanoramaProvider) bool) bool {
+	timerValid := s.TimerSeconds == 0 || (s.TimerSeconds >= minTimerSeconds && s.TimerSeconds <= maxTimerSeconds)
+
+	return s.Rounds >= minRounds && s.Rounds <= maxRounds &&
+		s.MaxPlayers >= minPlayers && s.MaxPlayers <= maxPlayers &&
+		timerValid &&
+		s.ValidProviders(validProvider)
+}
+
+// ValidProviders returns true if the provider can be played on, mixed provider must have
+// at least one allowed provider.
+func (s Settings) ValidProviders(validProvider func(game.PanoramaProvider) bool) bool {
+	if !validProvider(game.PanoramaProvider(s.Provider)) {
+		return false
+	}
+
+	if s.Provider != string(game.MixedProvider) {
+		return true
+	}
+
+	if len(s.Providers) == 0 {
+		return false
+	}
+
+	for _, p := range s.Providers {
+		if p == string(game.MixedProvider) || !validProvider(game.PanoramaProvider(p)) {
+			return false
+		}
+	}
+
+	return true
 }
@@ -30,4 +30,15 @@ type MultiplayerUser struct {
 	// Guessed   bool `json:"guessed"` // TODO - implement
 	Connected bool `json:"connected"`
 	Score     int  `json:"score"`
+	// EliminatedRound is a round in which the player was eliminated (battle royale), 0 if not eliminated.
+	EliminatedRound int `json:"eliminatedRound"`
+	// Health is a remaining health of a duel player.
+	Health int `json:"health"`
+	// Team is a team of the player, 0 for games without teams.
+	Team int `json:"team"`
+}
+
+// Eliminated returns true if the player was eliminated from a battle royale game.
+func (u MultiplayerUser) Eliminated() bool {
+	return u.EliminatedRound != 0
 }
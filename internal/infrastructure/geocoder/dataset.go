@@ -0,0 +1,84 @@
+package geocoder
+
+import (
+	"compress/gzip"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+)
+
+// Open creates a Geocoder from a gzipped GeoJSON boundaries dataset file. The dataset has first-level
+// subdivisions of countries (and countries without them) from Natural Earth (public domain), it isn't committed
+// and is fetched with "make geocoder-dataset".
+func Open(path string) (*Geocoder, error) {
+	f, err := os.Open(path) //nolint:gosec // path is set by the application config
+	if err != nil {
+		return nil, fmt.Errorf("failed to open boundaries dataset: %w", err)
+	}
+
+	defer func() {
+		_ = f.Close()
+	}()
+
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDataset, err)
+	}
+
+	defer func() {
+		_ = r.Close()
+	}()
+
+	return New(r)
+}
+
+// Lazy is a Geocoder, which loads the boundaries dataset file on the first lookup instead of on startup.
+// If the dataset can't be loaded, all coordinates are outside of known countries. It is safe for concurrent use.
+type Lazy struct {
+	path     string
+	once     sync.Once
+	geocoder *Geocoder
+}
+
+// NewLazy creates a Lazy geocoder of the boundaries dataset file, it only checks that the file exists.
+func NewLazy(path string) (*Lazy, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("failed to find boundaries dataset: %w", err)
+	}
+
+	return &Lazy{path: path}, nil
+}
+
+// Place returns a country and a region, which contain the coordinates.
+// Returns empty place if the coordinates are outside of all boundaries.
+func (l *Lazy) Place(lat, lng float64) game.Place {
+	l.once.Do(l.load)
+
+	if l.geocoder == nil {
+		return game.Place{}
+	}
+
+	return l.geocoder.Place(lat, lng)
+}
+
+// CountryCode returns an ISO 3166-1 alpha-2 code of a country, which contains the coordinates.
+// Returns empty string if the coordinates are outside of all countries.
+func (l *Lazy) CountryCode(lat, lng float64) string {
+	return l.Place(lat, lng).CountryCode
+}
+
+func (l *Lazy) load() {
+	g, err := Open(l.path)
+	if err != nil {
+		slog.Error("error loading geocoder boundaries dataset",
+			slog.String("path", l.path),
+			slog.Any("error", err))
+
+		return
+	}
+
+	l.geocoder = g
+}
@@ -0,0 +1,275 @@
+// Package geocoder provides offline reverse geocoding of coordinates to countries and regions,
+// using boundaries from a GeoJSON dataset and a grid spatial index over them.
+package geocoder
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+)
+
+// cellSize is a size of a spatial index cell in degrees.
+const cellSize = 1.0
+
+var (
+	// ErrInvalidDataset is returned when boundaries dataset can't be parsed.
+	ErrInvalidDataset = errors.New("invalid boundaries dataset")
+	// ErrEmptyDataset is returned when boundaries dataset has no polygons with country codes.
+	ErrEmptyDataset = errors.New("boundaries dataset has no countries")
+)
+
+// Country code properties of dataset features in order of priority, Natural Earth datasets use
+// lower case names for subdivisions and upper case names for countries.
+//
+//nolint:gochecknoglobals
+var (
+	countryCodeProperties = []string{"iso_a2", "ISO_A2", "ISO_A2_EH"}
+	regionCodeProperties  = []string{"iso_3166_2"}
+)
+
+// Geocoder resolves countries and regions of coordinates. It is safe for concurrent use.
+type Geocoder struct {
+	polygons []polygon
+	// cells contains indexes of polygons, which bounding boxes intersect a cell of the grid.
+	cells   [][]int32
+	columns int
+	rows    int
+}
+
+// polygon is a boundary of a place, first ring is an outer ring and others are holes.
+type polygon struct {
+	place  game.Place
+	bounds game.Bounds
+	rings  [][]point
+}
+
+type point struct {
+	lng float64
+	lat float64
+}
+
+type featureCollection struct {
+	Features []feature `json:"features"`
+}
+
+type feature struct {
+	Properties map[string]any `json:"properties"`
+	Geometry   *geometry      `json:"geometry"`
+}
+
+type geometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// New creates a Geocoder from a GeoJSON feature collection of Polygon and MultiPolygon features.
+// Features without a valid country code are skipped.
+func New(r io.Reader) (*Geocoder, error) {
+	var fc featureCollection
+	if err := json.NewDecoder(r).Decode(&fc); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDataset, err)
+	}
+
+	g := &Geocoder{
+		columns: int(360 / cellSize),
+		rows:    int(180 / cellSize),
+	}
+	g.cells = make([][]int32, g.columns*g.rows)
+
+	for _, f := range fc.Features {
+		place := featurePlace(f.Properties)
+		if place.CountryCode == "" || f.Geometry == nil {
+			continue
+		}
+
+		polygons, err := parseGeometry(*f.Geometry)
+		if err != nil {
+			return nil, fmt.Errorf("%w: feature %s: %w", ErrInvalidDataset, place.CountryCode, err)
+		}
+
+		for _, rings := range polygons {
+			g.add(place, rings)
+		}
+	}
+
+	if len(g.polygons) == 0 {
+		return nil, ErrEmptyDataset
+	}
+
+	return g, nil
+}
+
+// Place returns a country and a region, which contain the coordinates.
+// Returns empty place if the coordinates are outside of all boundaries.
+func (g *Geocoder) Place(lat, lng float64) game.Place {
+	if lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		return game.Place{}
+	}
+
+	p := point{lng: lng, lat: lat}
+
+	for _, i := range g.cells[g.cell(lat, lng)] {
+		poly := g.polygons[i]
+		if poly.contains(p) {
+			return poly.place
+		}
+	}
+
+	return game.Place{}
+}
+
+// CountryCode returns an ISO 3166-1 alpha-2 code of a country, which contains the coordinates.
+// Returns empty string if the coordinates are outside of all countries.
+func (g *Geocoder) CountryCode(lat, lng float64) string {
+	return g.Place(lat, lng).CountryCode
+}
+
+// add adds a polygon to the geocoder and to all index cells, that its bounding box intersects.
+func (g *Geocoder) add(place game.Place, rings [][]point) {
+	if len(rings) == 0 || len(rings[0]) < 3 { //nolint:mnd
+		return
+	}
+
+	bounds := game.Bounds{MinLat: math.Inf(1), MinLng: math.Inf(1), MaxLat: math.Inf(-1), MaxLng: math.Inf(-1)}
+	for _, p := range rings[0] {
+		bounds.MinLat = min(bounds.MinLat, p.lat)
+		bounds.MinLng = min(bounds.MinLng, p.lng)
+		bounds.MaxLat = max(bounds.MaxLat, p.lat)
+		bounds.MaxLng = max(bounds.MaxLng, p.lng)
+	}
+
+	idx := int32(len(g.polygons)) //nolint:gosec
+	g.polygons = append(g.polygons, polygon{place: place, bounds: bounds, rings: rings})
+
+	minCol, minRow := g.cellPosition(bounds.MinLat, bounds.MinLng)
+	maxCol, maxRow := g.cellPosition(bounds.MaxLat, bounds.MaxLng)
+
+	for row := minRow; row <= maxRow; row++ {
+		for col := minCol; col <= maxCol; col++ {
+			cell := row*g.columns + col
+			g.cells[cell] = append(g.cells[cell], idx)
+		}
+	}
+}
+
+func (g *Geocoder) cell(lat, lng float64) int {
+	col, row := g.cellPosition(lat, lng)
+	return row*g.columns + col
+}
+
+// cellPosition returns a column and a row of the index cell, coordinates on the edges of the map
+// belong to the last cells.
+func (g *Geocoder) cellPosition(lat, lng float64) (int, int) {
+	col := min(max(int((lng+180)/cellSize), 0), g.columns-1)
+	row := min(max(int((lat+90)/cellSize), 0), g.rows-1)
+
+	return col, row
+}
+
+// contains reports whether the point is inside the outer ring and outside of all holes.
+func (p polygon) contains(pt point) bool {
+	if pt.lat < p.bounds.MinLat || pt.lat > p.bounds.MaxLat || pt.lng < p.bounds.MinLng || pt.lng > p.bounds.MaxLng {
+		return false
+	}
+
+	if !ringContains(p.rings[0], pt) {
+		return false
+	}
+
+	for _, hole := range p.rings[1:] {
+		if ringContains(hole, pt) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ringContains checks whether the point is inside the ring using ray casting, based on this:
+// https://wrfranklin.org/Research/Short_Notes/pnpoly.html
+func ringContains(ring []point, pt point) bool {
+	inside := false
+
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.lat > pt.lat) != (b.lat > pt.lat) &&
+			pt.lng < (b.lng-a.lng)*(pt.lat-a.lat)/(b.lat-a.lat)+a.lng {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+// featurePlace returns codes of a feature, invalid codes (e.g. "-99" for disputed areas) are ignored.
+func featurePlace(properties map[string]any) game.Place {
+	return game.Place{
+		CountryCode: firstCode(properties, countryCodeProperties, 2), //nolint:mnd
+		RegionCode:  firstCode(properties, regionCodeProperties, 0),
+	}
+}
+
+func firstCode(properties map[string]any, keys []string, length int) string {
+	for _, key := range keys {
+		code, _ := properties[key].(string)
+		if code == "" || code[0] == '-' || (length != 0 && len(code) != length) {
+			continue
+		}
+
+		return code
+	}
+
+	return ""
+}
+
+// parseGeometry returns polygons of Polygon or MultiPolygon geometry, other geometries have no area.
+func parseGeometry(geom geometry) ([][][]point, error) {
+	switch geom.Type {
+	case "Polygon":
+		var coords [][][]float64
+		if err := json.Unmarshal(geom.Coordinates, &coords); err != nil {
+			return nil, fmt.Errorf("failed to parse polygon: %w", err)
+		}
+
+		return [][][]point{toRings(coords)}, nil
+	case "MultiPolygon":
+		var coords [][][][]float64
+		if err := json.Unmarshal(geom.Coordinates, &coords); err != nil {
+			return nil, fmt.Errorf("failed to parse multipolygon: %w", err)
+		}
+
+		polygons := make([][][]point, 0, len(coords))
+		for _, c := range coords {
+			polygons = append(polygons, toRings(c))
+		}
+
+		return polygons, nil
+	default:
+		return nil, nil
+	}
+}
+
+// toRings converts GeoJSON positions ([lng, lat, optional altitude]) to rings of points.
+func toRings(coords [][][]float64) [][]point {
+	rings := make([][]point, 0, len(coords))
+
+	for _, c := range coords {
+		ring := make([]point, 0, len(c))
+
+		for _, pos := range c {
+			if len(pos) < 2 { //nolint:mnd
+				continue
+			}
+
+			ring = append(ring, point{lng: pos[0], lat: pos[1]})
+		}
+
+		rings = append(rings, ring)
+	}
+
+	return rings
+}
@@ -0,0 +1,225 @@
+package geocoder_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/geocoder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDataset has a square region with a lake (hole), an island country split into two polygons,
+// that cross index cells, and a disputed area without a country code.
+const testDataset = `{
+	"type": "FeatureCollection",
+	"features": [
+		{
+			"type": "Feature",
+			"properties": {"iso_a2": "AA", "iso_3166_2": "AA-01"},
+			"geometry": {
+				"type": "Polygon",
+				"coordinates": [
+					[[10, 10], [20, 10], [20, 20], [10, 20], [10, 10]],
+					[[14, 14], [16, 14], [16, 16], [14, 16], [14, 14]]
+				]
+			}
+		},
+		{
+			"type": "Feature",
+			"properties": {"ISO_A2": "BB"},
+			"geometry": {
+				"type": "MultiPolygon",
+				"coordinates": [
+					[[[-30.5, -5.5], [-25.5, -5.5], [-28, -1.5], [-30.5, -5.5]]],
+					[[[170, 60, 100], [180, 60, 100], [180, 70, 100], [170, 70, 100], [170, 60, 100]]]
+				]
+			}
+		},
+		{
+			"type": "Feature",
+			"properties": {"iso_a2": "-99", "ISO_A2": "-99"},
+			"geometry": {
+				"type": "Polygon",
+				"coordinates": [[[0, 0], [5, 0], [5, 5], [0, 5], [0, 0]]]
+			}
+		},
+		{
+			"type": "Feature",
+			"properties": {"iso_a2": "CC"},
+			"geometry": {
+				"type": "Point",
+				"coordinates": [50, 50]
+			}
+		}
+	]
+}`
+
+func TestGeocoder_Place(t *testing.T) {
+	t.Parallel()
+
+	g, err := geocoder.New(strings.NewReader(testDataset))
+	require.NoError(t, err)
+
+	type args struct {
+		lat float64
+		lng float64
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want game.Place
+	}{
+		{
+			name: "point inside region",
+			args: args{lat: 12.5, lng: 11.5},
+			want: game.Place{CountryCode: "AA", RegionCode: "AA-01"},
+		},
+		{
+			name: "point inside hole of region",
+			args: args{lat: 15, lng: 15},
+			want: game.Place{},
+		},
+		{
+			name: "point inside triangle in bounding box of another polygon",
+			args: args{lat: -4, lng: -28},
+			want: game.Place{CountryCode: "BB"},
+		},
+		{
+			name: "point outside triangle, but inside its bounding box",
+			args: args{lat: -2, lng: -30},
+			want: game.Place{},
+		},
+		{
+			name: "point near antimeridian",
+			args: args{lat: 65, lng: 179.9},
+			want: game.Place{CountryCode: "BB"},
+		},
+		{
+			name: "point inside area without country code",
+			args: args{lat: 2, lng: 2},
+			want: game.Place{},
+		},
+		{
+			name: "point in the ocean",
+			args: args{lat: -60, lng: 100},
+			want: game.Place{},
+		},
+		{
+			name: "invalid coordinates",
+			args: args{lat: 100, lng: 15},
+			want: game.Place{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, g.Place(tt.args.lat, tt.args.lng))
+			assert.Equal(t, tt.want.CountryCode, g.CountryCode(tt.args.lat, tt.args.lng))
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		dataset string
+		wantErr error
+	}{
+		{
+			name:    "invalid json",
+			dataset: `{"features": [`,
+			wantErr: geocoder.ErrInvalidDataset,
+		},
+		{
+			name:    "invalid polygon coordinates",
+			dataset: `{"features": [{"properties": {"iso_a2": "AA"}, "geometry": {"type": "Polygon", "coordinates": [1]}}]}`,
+			wantErr: geocoder.ErrInvalidDataset,
+		},
+		{
+			name:    "no countries",
+			dataset: `{"features": []}`,
+			wantErr: geocoder.ErrEmptyDataset,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := geocoder.New(strings.NewReader(tt.dataset))
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestLazy(t *testing.T) {
+	t.Parallel()
+
+	path := writeDataset(t, testDataset)
+
+	g, err := geocoder.NewLazy(path)
+	require.NoError(t, err)
+
+	// the dataset is loaded on the first lookup, not when the geocoder is created
+	require.NoError(t, os.Remove(path))
+
+	assert.Equal(t, game.Place{}, g.Place(12, 12))
+
+	path = writeDataset(t, testDataset)
+
+	g, err = geocoder.NewLazy(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, game.Place{CountryCode: "AA", RegionCode: "AA-01"}, g.Place(12, 12))
+	assert.Equal(t, "BB", g.CountryCode(65, 175))
+	assert.Empty(t, g.CountryCode(15, 15))
+}
+
+func TestNewLazy(t *testing.T) {
+	t.Parallel()
+
+	_, err := geocoder.NewLazy(filepath.Join(t.TempDir(), "missing.geojson.gz"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestOpen(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "boundaries.geojson")
+	require.NoError(t, os.WriteFile(path, []byte(testDataset), 0o600))
+
+	_, err := geocoder.Open(path)
+	require.ErrorIs(t, err, geocoder.ErrInvalidDataset)
+
+	g, err := geocoder.Open(writeDataset(t, testDataset))
+	require.NoError(t, err)
+	assert.Equal(t, "AA", g.CountryCode(12, 12))
+}
+
+// writeDataset writes the gzipped dataset to a temporary file and returns its path.
+func writeDataset(t *testing.T, dataset string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write([]byte(dataset))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	path := filepath.Join(t.TempDir(), "boundaries.geojson.gz")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+
+	return path
+}
@@ -0,0 +1,98 @@
+// Package google provides Google Street View panoramas.
+package google
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/VasySS/segoya-backend/internal/config"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/panorama"
+	panoramaUsecase "github.com/VasySS/segoya-backend/internal/usecase/panorama"
+)
+
+// URL is the URL of Street View Image Metadata API, requests to it are not billed.
+const URL = "https://maps.googleapis.com/maps/api/streetview/metadata"
+
+// scoreDistance is a distance in km, at which player will receive ~60% of score.
+const scoreDistance = 750
+
+func init() { //nolint:gochecknoinits
+	panorama.Register(game.GoogleProvider, func(cfg config.Config, repo panorama.Repository) panoramaUsecase.Provider {
+		return New(cfg.HTTPClient, URL, cfg.ENV.GoogleMapsAPIKey, repo)
+	})
+}
+
+// Provider provides Google Street View panoramas, which locations are stored in the database.
+// Locations without streetview ID are located by the metadata API.
+type Provider struct {
+	*panorama.Locations
+	httpClient *http.Client
+	url        string
+	apiKey     string
+}
+
+// New creates a new Provider, which sends requests to the metadata API at the url.
+func New(httpClient *http.Client, url, apiKey string, repo panorama.Repository) *Provider {
+	p := &Provider{
+		httpClient: httpClient,
+		url:        url,
+		apiKey:     apiKey,
+	}
+	p.Locations = panorama.NewLocations(game.GoogleProvider, repo, p)
+
+	return p
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() game.PanoramaProvider {
+	return game.GoogleProvider
+}
+
+// ScoreDistance returns distance in km, at which player will receive ~60% of score.
+func (p *Provider) ScoreDistance() float64 {
+	return scoreDistance
+}
+
+// StreetviewIDRequired returns false, as Street View panoramas can be found by coordinates.
+func (p *Provider) StreetviewIDRequired() bool {
+	return false
+}
+
+// Locate returns ID of the Street View panorama closest to the coordinates.
+func (p *Provider) Locate(ctx context.Context, latlng game.LatLng) (string, error) {
+	query := url.Values{
+		"location": {panorama.Pair(latlng.Lat, latlng.Lng)},
+		"radius":   {strconv.Itoa(panorama.SearchRadius)},
+		"source":   {"outdoor"},
+		"key":      {p.apiKey},
+	}
+
+	body, err := panorama.Get(ctx, p.httpClient, p.url, query.Encode())
+	if err != nil {
+		return "", fmt.Errorf("failed to get google panorama metadata: %w", err)
+	}
+
+	type metadataResponse struct {
+		Status string `json:"status"`
+		PanoID string `json:"pano_id"` //nolint:tagliatelle
+	}
+
+	var resp metadataResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return "", fmt.Errorf("error unmarshalling google panorama metadata: %w", err)
+	}
+
+	switch resp.Status {
+	case "OK":
+		return resp.PanoID, nil
+	case "ZERO_RESULTS", "NOT_FOUND":
+		return "", panorama.ErrPanoramaNotFound
+	default:
+		return "", fmt.Errorf("google panorama metadata status %s", resp.Status)
+	}
+}
@@ -0,0 +1,87 @@
+package google_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/panorama"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/panorama/google"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvider(t *testing.T) {
+	t.Parallel()
+
+	p := google.New(http.DefaultClient, google.URL, "api-key", nil)
+
+	assert.Equal(t, game.GoogleProvider, p.Name())
+	assert.InDelta(t, 750, p.ScoreDistance(), 0)
+	assert.False(t, p.StreetviewIDRequired())
+}
+
+func TestProvider_Locate(t *testing.T) {
+	t.Parallel()
+
+	latlng := game.LatLng{Lat: 50.0875, Lng: 14.4213}
+
+	tests := []struct {
+		name string
+		// check validates the request sent to the provider
+		check   func(t *testing.T, r *http.Request)
+		status  int
+		body    string
+		want    string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "panorama",
+			check: func(t *testing.T, r *http.Request) {
+				t.Helper()
+				assert.Equal(t, "50.0875,14.4213", r.URL.Query().Get("location"))
+				assert.Equal(t, "api-key", r.URL.Query().Get("key"))
+			},
+			status:  http.StatusOK,
+			body:    `{"status":"OK","pano_id":"google-pano","location":{"lat":50.0875,"lng":14.4213}}`,
+			want:    "google-pano",
+			wantErr: assert.NoError,
+		},
+		{
+			name:   "no panorama",
+			status: http.StatusOK,
+			body:   `{"status":"ZERO_RESULTS"}`,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, panorama.ErrPanoramaNotFound)
+			},
+		},
+		{
+			name:    "request denied",
+			status:  http.StatusOK,
+			body:    `{"status":"REQUEST_DENIED"}`,
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.check != nil {
+					tt.check(t, r)
+				}
+
+				w.WriteHeader(tt.status)
+				_, _ = io.Copy(w, strings.NewReader(tt.body))
+			}))
+			t.Cleanup(server.Close)
+
+			got, err := google.New(http.DefaultClient, server.URL, "api-key", nil).Locate(t.Context(), latlng)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
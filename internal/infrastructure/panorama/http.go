@@ -0,0 +1,55 @@
+package panorama
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// ErrPanoramaNotFound is returned by locators when provider has no panorama near the coordinates.
+var ErrPanoramaNotFound = errors.New("panorama is not found near the location")
+
+// SearchRadius is a distance in meters from the coordinates, in which locators search panoramas.
+const SearchRadius = 50
+
+// Do sends the request and returns the response body, if the response status is 200.
+func Do(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, ErrPanoramaNotFound
+	default:
+		return nil, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	return body, nil
+}
+
+// Get sends a GET request with the query and returns the response body.
+func Get(ctx context.Context, client *http.Client, url, query string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url+"?"+query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create http request: %w", err)
+	}
+
+	return Do(client, req)
+}
+
+// Pair formats a pair of coordinates separated by a comma.
+func Pair(a, b float64) string {
+	return strconv.FormatFloat(a, 'f', -1, 64) + "," + strconv.FormatFloat(b, 'f', -1, 64)
+}
@@ -0,0 +1,93 @@
+// Package panorama contains parts shared by panorama providers, which are implemented in subpackages
+// (one package per provider) and register themselves with Register.
+//
+// Clients load panoramas only by streetview ID, as coordinates of a panorama are the answer of the round,
+// so locations without it (e.g. loaded from coordinates-only datasets) are located by the provider.
+package panorama
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+)
+
+// Repository provides access to panorama locations.
+//
+//go:generate go tool mockery --name=Repository
+type Repository interface {
+	RandomPanoramaLocation(
+		ctx context.Context,
+		provider game.PanoramaProvider,
+		exclude []int,
+	) (game.PanoramaMetadata, error)
+	GetPanoramaLocation(ctx context.Context, provider game.PanoramaProvider, id int) (game.PanoramaMetadata, error)
+	SetPanoramaStreetviewID(ctx context.Context, id int, streetviewID string) error
+}
+
+// Locator finds panoramas of a provider by coordinates.
+//
+//go:generate go tool mockery --name=Locator
+type Locator interface {
+	// Locate returns streetview ID of the provider panorama closest to the coordinates.
+	Locate(ctx context.Context, latlng game.LatLng) (string, error)
+}
+
+// Locations provides panoramas of a provider from its locations stored in the database.
+// Providers embed it to implement random selection, lookup by ID and metadata of panoramas.
+type Locations struct {
+	name    game.PanoramaProvider
+	repo    Repository
+	locator Locator
+}
+
+// NewLocations creates and returns a new Locations instance for the provider.
+// Locator can be nil, if all locations of the provider have streetview IDs.
+func NewLocations(name game.PanoramaProvider, repo Repository, locator Locator) *Locations {
+	return &Locations{
+		name:    name,
+		repo:    repo,
+		locator: locator,
+	}
+}
+
+// Random gets a random panorama from the database, skipping excluded location IDs.
+func (l *Locations) Random(ctx context.Context, exclude []int) (game.PanoramaMetadata, error) {
+	loc, err := l.repo.RandomPanoramaLocation(ctx, l.name, exclude)
+	if err != nil {
+		return game.PanoramaMetadata{}, fmt.Errorf("failed to get random %s panorama: %w", l.name, err)
+	}
+
+	return l.Metadata(ctx, loc)
+}
+
+// Get gets a panorama by ID from the database.
+func (l *Locations) Get(ctx context.Context, id int) (game.PanoramaMetadata, error) {
+	loc, err := l.repo.GetPanoramaLocation(ctx, l.name, id)
+	if err != nil {
+		return game.PanoramaMetadata{}, fmt.Errorf("failed to get %s panorama: %w", l.name, err)
+	}
+
+	return l.Metadata(ctx, loc)
+}
+
+// Metadata finds streetview ID of the location by its coordinates, if the location has none.
+// The ID is saved, so that every location is located only once.
+func (l *Locations) Metadata(ctx context.Context, loc game.PanoramaMetadata) (game.PanoramaMetadata, error) {
+	if loc.StreetviewID != "" || l.locator == nil {
+		return loc, nil
+	}
+
+	streetviewID, err := l.locator.Locate(ctx, loc.LatLng)
+	if err != nil {
+		return game.PanoramaMetadata{}, fmt.Errorf("failed to locate %s panorama: %w", l.name, err)
+	}
+
+	if err := l.repo.SetPanoramaStreetviewID(ctx, loc.ID, streetviewID); err != nil {
+		return game.PanoramaMetadata{}, fmt.Errorf("failed to save %s streetview id: %w", l.name, err)
+	}
+
+	loc.StreetviewID = streetviewID
+
+	return loc, nil
+}
@@ -0,0 +1,157 @@
+package panorama_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/panorama"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/panorama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLocations(t *testing.T) {
+	t.Parallel()
+
+	location := game.PanoramaMetadata{
+		ID:           432432,
+		StreetviewID: "some_streetview_id",
+		LatLng:       game.LatLng{Lat: 1.1234, Lng: 2.3456},
+		CountryCode:  "CZ",
+	}
+
+	// location from coordinates-only dataset, its streetview ID is found by the locator
+	coordinatesLocation := location
+	coordinatesLocation.StreetviewID = ""
+
+	located := location
+	located.StreetviewID = "located_streetview_id"
+
+	repoErr := errors.New("some repository error")
+	locatorErr := errors.New("some locator error")
+
+	type fields struct {
+		repo    *mocks.Repository
+		locator *mocks.Locator
+	}
+
+	type args struct {
+		exclude []int
+		id      int
+	}
+
+	tests := []struct {
+		name       string
+		provider   game.PanoramaProvider
+		noLocator  bool
+		args       args
+		setup      func(game.PanoramaProvider, fields, args)
+		wantRandom game.PanoramaMetadata
+		wantGet    game.PanoramaMetadata
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "location with streetview ID",
+			provider: game.GoogleProvider,
+			args:     args{exclude: []int{1, 2}, id: location.ID},
+			setup: func(provider game.PanoramaProvider, f fields, a args) {
+				f.repo.On("RandomPanoramaLocation", mock.Anything, provider, a.exclude).Return(location, nil)
+				f.repo.On("GetPanoramaLocation", mock.Anything, provider, a.id).Return(location, nil)
+			},
+			wantRandom: location,
+			wantGet:    location,
+			wantErr:    assert.NoError,
+		},
+		{
+			name:     "location without streetview ID is located and saved",
+			provider: game.SeznamProvider,
+			args:     args{id: location.ID},
+			setup: func(provider game.PanoramaProvider, f fields, a args) {
+				f.repo.On("RandomPanoramaLocation", mock.Anything, provider, a.exclude).
+					Return(coordinatesLocation, nil)
+				f.repo.On("GetPanoramaLocation", mock.Anything, provider, a.id).
+					Return(coordinatesLocation, nil)
+				f.locator.On("Locate", mock.Anything, location.LatLng).
+					Return(located.StreetviewID, nil)
+				f.repo.On("SetPanoramaStreetviewID", mock.Anything, location.ID, located.StreetviewID).
+					Return(nil)
+			},
+			wantRandom: located,
+			wantGet:    located,
+			wantErr:    assert.NoError,
+		},
+		{
+			name:      "provider without locator",
+			provider:  game.YandexAirProvider,
+			noLocator: true,
+			args:      args{id: location.ID},
+			setup: func(provider game.PanoramaProvider, f fields, a args) {
+				f.repo.On("RandomPanoramaLocation", mock.Anything, provider, a.exclude).Return(location, nil)
+				f.repo.On("GetPanoramaLocation", mock.Anything, provider, a.id).Return(location, nil)
+			},
+			wantRandom: location,
+			wantGet:    location,
+			wantErr:    assert.NoError,
+		},
+		{
+			name:     "error while locating panorama",
+			provider: game.YandexProvider,
+			args:     args{id: location.ID},
+			setup: func(provider game.PanoramaProvider, f fields, a args) {
+				f.repo.On("RandomPanoramaLocation", mock.Anything, provider, a.exclude).
+					Return(coordinatesLocation, nil)
+				f.repo.On("GetPanoramaLocation", mock.Anything, provider, a.id).
+					Return(coordinatesLocation, nil)
+				f.locator.On("Locate", mock.Anything, location.LatLng).
+					Return("", locatorErr)
+			},
+			wantRandom: game.PanoramaMetadata{},
+			wantGet:    game.PanoramaMetadata{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, locatorErr)
+			},
+		},
+		{
+			name:     "error while getting panoramas from repository",
+			provider: game.GoogleProvider,
+			args:     args{id: location.ID},
+			setup: func(provider game.PanoramaProvider, f fields, a args) {
+				f.repo.On("RandomPanoramaLocation", mock.Anything, provider, a.exclude).
+					Return(game.PanoramaMetadata{}, repoErr)
+				f.repo.On("GetPanoramaLocation", mock.Anything, provider, a.id).
+					Return(game.PanoramaMetadata{}, repoErr)
+			},
+			wantRandom: game.PanoramaMetadata{},
+			wantGet:    game.PanoramaMetadata{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, repoErr)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			locator := mocks.NewLocator(t)
+			fs := fields{repo: repo, locator: locator}
+
+			p := panorama.NewLocations(tt.provider, repo, locator)
+			if tt.noLocator {
+				p = panorama.NewLocations(tt.provider, repo, nil)
+			}
+
+			tt.setup(tt.provider, fs, tt.args)
+
+			got, err := p.Random(t.Context(), tt.args.exclude)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantRandom, got)
+
+			got, err = p.Get(t.Context(), tt.args.id)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantGet, got)
+		})
+	}
+}
@@ -0,0 +1,11 @@
+// Package providers registers all panorama providers of the application,
+// a new provider is added by importing its package here.
+package providers
+
+import (
+	// provider packages register themselves in their init functions.
+	_ "github.com/VasySS/segoya-backend/internal/infrastructure/panorama/google"
+	_ "github.com/VasySS/segoya-backend/internal/infrastructure/panorama/seznam"
+	_ "github.com/VasySS/segoya-backend/internal/infrastructure/panorama/yandex"
+	_ "github.com/VasySS/segoya-backend/internal/infrastructure/panorama/yandexair"
+)
@@ -0,0 +1,45 @@
+package panorama
+
+import (
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/VasySS/segoya-backend/internal/config"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	panoramaUsecase "github.com/VasySS/segoya-backend/internal/usecase/panorama"
+)
+
+// Factory creates a provider from the application config and panorama locations repository.
+type Factory func(cfg config.Config, repo Repository) panoramaUsecase.Provider
+
+var ( //nolint:gochecknoglobals
+	factoriesMu sync.Mutex
+	factories   = make(map[game.PanoramaProvider]Factory)
+)
+
+// Register makes a provider available to the application by its name, it is called by provider packages
+// in their init functions. Register panics if a provider with the same name is already registered.
+func Register(name game.PanoramaProvider, factory Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+
+	if _, ok := factories[name]; ok {
+		panic("panorama: provider is registered twice: " + string(name))
+	}
+
+	factories[name] = factory
+}
+
+// New creates all registered providers, ordered by their names.
+func New(cfg config.Config, repo Repository) []panoramaUsecase.Provider {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+
+	providers := make([]panoramaUsecase.Provider, 0, len(factories))
+	for _, name := range slices.Sorted(maps.Keys(factories)) {
+		providers = append(providers, factories[name](cfg, repo))
+	}
+
+	return providers
+}
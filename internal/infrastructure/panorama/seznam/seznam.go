@@ -0,0 +1,180 @@
+// Package seznam provides Seznam (Mapy.cz) panoramas.
+package seznam
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/VasySS/segoya-backend/internal/config"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/panorama"
+	panoramaUsecase "github.com/VasySS/segoya-backend/internal/usecase/panorama"
+)
+
+// URL is the URL of panoramas service, which is used by Mapy.cz API to find panoramas.
+// The service speaks FastRPC, which also accepts XML-RPC requests.
+const URL = "https://pro.mapy.cz/panorpc"
+
+// scoreDistance is a distance in km, at which player will receive ~60% of score.
+const scoreDistance = 150
+
+// errNoStatus is returned when response of the panoramas service has no status.
+var errNoStatus = errors.New("seznam panorama response has no status")
+
+// statusNotFound is a status of the response, when there is no panorama near the location.
+const statusNotFound = 404
+
+func init() { //nolint:gochecknoinits
+	panorama.Register(game.SeznamProvider, func(cfg config.Config, repo panorama.Repository) panoramaUsecase.Provider {
+		return New(cfg.HTTPClient, URL, repo)
+	})
+}
+
+// Provider provides Seznam panoramas, which locations are stored in the database.
+// Locations without streetview ID are located by the panoramas service.
+type Provider struct {
+	*panorama.Locations
+	httpClient *http.Client
+	url        string
+}
+
+// New creates a new Provider, which sends requests to the panoramas service at the url.
+func New(httpClient *http.Client, url string, repo panorama.Repository) *Provider {
+	p := &Provider{
+		httpClient: httpClient,
+		url:        url,
+	}
+	p.Locations = panorama.NewLocations(game.SeznamProvider, repo, p)
+
+	return p
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() game.PanoramaProvider {
+	return game.SeznamProvider
+}
+
+// ScoreDistance returns distance in km, at which player will receive ~60% of score.
+func (p *Provider) ScoreDistance() float64 {
+	return scoreDistance
+}
+
+// StreetviewIDRequired returns false, as Seznam panoramas can be found by coordinates.
+func (p *Provider) StreetviewIDRequired() bool {
+	return false
+}
+
+type xmlrpcMember struct {
+	Name  string      `xml:"name"`
+	Value xmlrpcValue `xml:"value"`
+}
+
+type xmlrpcValue struct {
+	Int     string         `xml:"int"`
+	I4      string         `xml:"i4"`
+	Members []xmlrpcMember `xml:"struct>member"`
+}
+
+// member returns value of the struct member with the name.
+func (v xmlrpcValue) member(name string) (xmlrpcValue, bool) {
+	for _, m := range v.Members {
+		if m.Name == name {
+			return m.Value, true
+		}
+	}
+
+	return xmlrpcValue{}, false
+}
+
+// int returns value of the integer.
+func (v xmlrpcValue) int() (int, error) {
+	if v.I4 != "" {
+		return strconv.Atoi(v.I4)
+	}
+
+	return strconv.Atoi(v.Int)
+}
+
+// Locate returns ID of the Seznam panorama closest to the coordinates.
+func (p *Provider) Locate(ctx context.Context, latlng game.LatLng) (string, error) {
+	call := fmt.Sprintf(`<?xml version="1.0"?>
+<methodCall>
+	<methodName>getbest</methodName>
+	<params>
+		<param><value><double>%s</double></value></param>
+		<param><value><double>%s</double></value></param>
+		<param><value><double>%d</double></value></param>
+	</params>
+</methodCall>`,
+		strconv.FormatFloat(latlng.Lng, 'f', -1, 64),
+		strconv.FormatFloat(latlng.Lat, 'f', -1, 64),
+		panorama.SearchRadius,
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewBufferString(call))
+	if err != nil {
+		return "", fmt.Errorf("failed to create http request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "text/xml")
+	req.Header.Set("Accept", "text/xml")
+
+	body, err := panorama.Do(p.httpClient, req)
+	if err != nil {
+		return "", fmt.Errorf("failed to get seznam panorama: %w", err)
+	}
+
+	type methodResponse struct {
+		Value xmlrpcValue `xml:"params>param>value"`
+	}
+
+	var resp methodResponse
+	if err := xml.Unmarshal(body, &resp); err != nil {
+		return "", fmt.Errorf("error unmarshalling seznam panorama: %w", err)
+	}
+
+	return panoramaID(resp.Value)
+}
+
+// panoramaID returns panorama ID from the response of getbest method.
+func panoramaID(resp xmlrpcValue) (string, error) {
+	statusValue, ok := resp.member("status")
+	if !ok {
+		return "", errNoStatus
+	}
+
+	status, err := statusValue.int()
+	if err != nil {
+		return "", fmt.Errorf("failed to parse seznam panorama status: %w", err)
+	}
+
+	switch status {
+	case http.StatusOK:
+	case statusNotFound:
+		return "", panorama.ErrPanoramaNotFound
+	default:
+		return "", fmt.Errorf("seznam panorama status %d", status)
+	}
+
+	result, ok := resp.member("result")
+	if !ok {
+		return "", panorama.ErrPanoramaNotFound
+	}
+
+	pidValue, ok := result.member("pid")
+	if !ok {
+		return "", panorama.ErrPanoramaNotFound
+	}
+
+	pid, err := pidValue.int()
+	if err != nil {
+		return "", fmt.Errorf("failed to parse seznam panorama id: %w", err)
+	}
+
+	return strconv.Itoa(pid), nil
+}
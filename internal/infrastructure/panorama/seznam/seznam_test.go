@@ -0,0 +1,98 @@
+package seznam_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/panorama"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/panorama/seznam"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvider(t *testing.T) {
+	t.Parallel()
+
+	p := seznam.New(http.DefaultClient, seznam.URL, nil)
+
+	assert.Equal(t, game.SeznamProvider, p.Name())
+	assert.InDelta(t, 150, p.ScoreDistance(), 0)
+	assert.False(t, p.StreetviewIDRequired())
+}
+
+func TestProvider_Locate(t *testing.T) {
+	t.Parallel()
+
+	latlng := game.LatLng{Lat: 50.0875, Lng: 14.4213}
+
+	response := func(status, pid string) string {
+		return `<?xml version="1.0"?><methodResponse><params><param><value><struct>` +
+			`<member><name>status</name><value><int>` + status + `</int></value></member>` +
+			`<member><name>result</name><value><struct>` +
+			`<member><name>pid</name><value><int>` + pid + `</int></value></member>` +
+			`</struct></value></member>` +
+			`</struct></value></param></params></methodResponse>`
+	}
+
+	tests := []struct {
+		name string
+		// check validates the request sent to the provider
+		check   func(t *testing.T, r *http.Request)
+		status  int
+		body    string
+		want    string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "panorama",
+			check: func(t *testing.T, r *http.Request) {
+				t.Helper()
+
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Contains(t, string(body), "<methodName>getbest</methodName>")
+				assert.Contains(t, string(body), "<double>14.4213</double>")
+			},
+			status:  http.StatusOK,
+			body:    response("200", "68231145"),
+			want:    "68231145",
+			wantErr: assert.NoError,
+		},
+		{
+			name:   "no panorama",
+			status: http.StatusOK,
+			body:   response("404", "0"),
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, panorama.ErrPanoramaNotFound)
+			},
+		},
+		{
+			name:    "provider error",
+			status:  http.StatusInternalServerError,
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.check != nil {
+					tt.check(t, r)
+				}
+
+				w.WriteHeader(tt.status)
+				_, _ = io.Copy(w, strings.NewReader(tt.body))
+			}))
+			t.Cleanup(server.Close)
+
+			got, err := seznam.New(http.DefaultClient, server.URL, nil).Locate(t.Context(), latlng)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Package yandex provides Yandex panoramas.
+package yandex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/VasySS/segoya-backend/internal/config"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/panorama"
+	panoramaUsecase "github.com/VasySS/segoya-backend/internal/usecase/panorama"
+)
+
+// URL is the URL of panoramas service, which is used by Yandex Maps API to find panoramas.
+const URL = "https://api-maps.yandex.ru/services/panoramas/1.x/"
+
+// scoreDistance is a distance in km, at which player will receive ~60% of score.
+const scoreDistance = 500
+
+func init() { //nolint:gochecknoinits
+	panorama.Register(game.YandexProvider, func(cfg config.Config, repo panorama.Repository) panoramaUsecase.Provider {
+		return New(cfg.HTTPClient, URL, repo)
+	})
+}
+
+// Provider provides Yandex panoramas, which locations are stored in the database.
+// Locations without streetview ID are located by the panoramas service.
+type Provider struct {
+	*panorama.Locations
+	httpClient *http.Client
+	url        string
+}
+
+// New creates a new Provider, which sends requests to the panoramas service at the url.
+func New(httpClient *http.Client, url string, repo panorama.Repository) *Provider {
+	p := &Provider{
+		httpClient: httpClient,
+		url:        url,
+	}
+	p.Locations = panorama.NewLocations(game.YandexProvider, repo, p)
+
+	return p
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() game.PanoramaProvider {
+	return game.YandexProvider
+}
+
+// ScoreDistance returns distance in km, at which player will receive ~60% of score.
+func (p *Provider) ScoreDistance() float64 {
+	return scoreDistance
+}
+
+// StreetviewIDRequired returns false, as Yandex panoramas can be found by coordinates.
+func (p *Provider) StreetviewIDRequired() bool {
+	return false
+}
+
+// Locate returns ID of the Yandex panorama closest to the coordinates.
+func (p *Provider) Locate(ctx context.Context, latlng game.LatLng) (string, error) {
+	query := url.Values{
+		"l":        {"stv"},
+		"lang":     {"en_US"},
+		"origin":   {"userAction"},
+		"provider": {"streetview"},
+		"ll":       {panorama.Pair(latlng.Lng, latlng.Lat)},
+	}
+
+	body, err := panorama.Get(ctx, p.httpClient, p.url, query.Encode())
+	if err != nil {
+		return "", fmt.Errorf("failed to get yandex panorama: %w", err)
+	}
+
+	type panoramaResponse struct {
+		Data struct {
+			Data struct {
+				PanoramaID string `json:"panoramaId"` //nolint:tagliatelle
+			} `json:"Data"` //nolint:tagliatelle
+		} `json:"data"`
+	}
+
+	var resp panoramaResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return "", fmt.Errorf("error unmarshalling yandex panorama: %w", err)
+	}
+
+	if resp.Data.Data.PanoramaID == "" {
+		return "", panorama.ErrPanoramaNotFound
+	}
+
+	return resp.Data.Data.PanoramaID, nil
+}
@@ -0,0 +1,84 @@
+package yandex_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/panorama"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/panorama/yandex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvider(t *testing.T) {
+	t.Parallel()
+
+	p := yandex.New(http.DefaultClient, yandex.URL, nil)
+
+	assert.Equal(t, game.YandexProvider, p.Name())
+	assert.InDelta(t, 500, p.ScoreDistance(), 0)
+	assert.False(t, p.StreetviewIDRequired())
+}
+
+func TestProvider_Locate(t *testing.T) {
+	t.Parallel()
+
+	latlng := game.LatLng{Lat: 50.0875, Lng: 14.4213}
+
+	tests := []struct {
+		name string
+		// check validates the request sent to the provider
+		check   func(t *testing.T, r *http.Request)
+		status  int
+		body    string
+		want    string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "panorama",
+			check: func(t *testing.T, r *http.Request) {
+				t.Helper()
+				assert.Equal(t, "14.4213,50.0875", r.URL.Query().Get("ll"))
+			},
+			status:  http.StatusOK,
+			body:    `{"data":{"Data":{"panoramaId":"yandex-pano"}}}`,
+			want:    "yandex-pano",
+			wantErr: assert.NoError,
+		},
+		{
+			name:   "no panorama",
+			status: http.StatusNotFound,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, panorama.ErrPanoramaNotFound)
+			},
+		},
+		{
+			name:    "provider error",
+			status:  http.StatusInternalServerError,
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.check != nil {
+					tt.check(t, r)
+				}
+
+				w.WriteHeader(tt.status)
+				_, _ = io.Copy(w, strings.NewReader(tt.body))
+			}))
+			t.Cleanup(server.Close)
+
+			got, err := yandex.New(http.DefaultClient, server.URL, nil).Locate(t.Context(), latlng)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
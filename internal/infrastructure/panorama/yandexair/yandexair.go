@@ -0,0 +1,46 @@
+// Package yandexair provides Yandex aerial panoramas.
+package yandexair
+
+import (
+	"github.com/VasySS/segoya-backend/internal/config"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/panorama"
+	panoramaUsecase "github.com/VasySS/segoya-backend/internal/usecase/panorama"
+)
+
+// scoreDistance is a distance in km, at which player will receive ~60% of score.
+const scoreDistance = 750
+
+func init() { //nolint:gochecknoinits
+	panorama.Register(game.YandexAirProvider, func(_ config.Config, repo panorama.Repository) panoramaUsecase.Provider {
+		return New(repo)
+	})
+}
+
+// Provider provides Yandex aerial panoramas, which locations are stored in the database.
+// Aerial panoramas can't be found by coordinates, so all their locations have streetview IDs.
+type Provider struct {
+	*panorama.Locations
+}
+
+// New creates a new Provider.
+func New(repo panorama.Repository) *Provider {
+	return &Provider{
+		Locations: panorama.NewLocations(game.YandexAirProvider, repo, nil),
+	}
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() game.PanoramaProvider {
+	return game.YandexAirProvider
+}
+
+// ScoreDistance returns distance in km, at which player will receive ~60% of score.
+func (p *Provider) ScoreDistance() float64 {
+	return scoreDistance
+}
+
+// StreetviewIDRequired returns true, as aerial panoramas can't be found by coordinates.
+func (p *Provider) StreetviewIDRequired() bool {
+	return true
+}
@@ -0,0 +1,184 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game/singleplayer"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetSingleplayerGameLocationIDs returns location IDs of singleplayer game rounds, ordered by round number.
+func (r *Repository) GetSingleplayerGameLocationIDs(ctx context.Context, gameID int) ([]int, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "GetSingleplayerGameLocationIDs")
+	defer span.End()
+
+	query := `
+		SELECT location_id
+		FROM singleplayer_round
+		WHERE game_id = @game_id
+		ORDER BY round_num
+	`
+
+	var ids []int
+
+	err := pgxscan.Select(ctx, tx, &ids, query, pgx.NamedArgs{"game_id": gameID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get singleplayer game location ids: %w", err)
+	}
+
+	return ids, nil
+}
+
+// NewSingleplayerChallenge saves a challenge of a singleplayer game.
+func (r *Repository) NewSingleplayerChallenge(
+	ctx context.Context,
+	req dto.NewSingleplayerChallengeRequestDB,
+) (int, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "NewSingleplayerChallenge")
+	defer span.End()
+
+	query := `
+		INSERT INTO singleplayer_challenge
+		(token, game_id, user_id, location_ids, created_at)
+		VALUES (@token, @game_id, @user_id, @location_ids, @created_at)
+		RETURNING id
+	`
+
+	var challengeID int
+
+	err := tx.QueryRow(ctx, query, pgx.NamedArgs{
+		"token":        req.Token,
+		"game_id":      req.GameID,
+		"user_id":      req.UserID,
+		"location_ids": req.LocationIDs,
+		"created_at":   req.RequestTime,
+	}).Scan(&challengeID)
+	if err != nil {
+		return -1, fmt.Errorf("failed to create singleplayer challenge: %w", err)
+	}
+
+	return challengeID, nil
+}
+
+// GetSingleplayerChallenge returns a challenge by its token.
+func (r *Repository) GetSingleplayerChallenge(ctx context.Context, token string) (singleplayer.Challenge, error) {
+	ctx, span := r.tracer.Start(ctx, "GetSingleplayerChallenge")
+	defer span.End()
+
+	return r.getSingleplayerChallenge(ctx, "sc.token = @token", pgx.NamedArgs{"token": token})
+}
+
+// GetSingleplayerChallengeByID returns a challenge by its id.
+func (r *Repository) GetSingleplayerChallengeByID(ctx context.Context, id int) (singleplayer.Challenge, error) {
+	ctx, span := r.tracer.Start(ctx, "GetSingleplayerChallengeByID")
+	defer span.End()
+
+	return r.getSingleplayerChallenge(ctx, "sc.id = @id", pgx.NamedArgs{"id": id})
+}
+
+// GetSingleplayerChallengeByGameID returns a challenge created from the game.
+func (r *Repository) GetSingleplayerChallengeByGameID(
+	ctx context.Context,
+	gameID int,
+) (singleplayer.Challenge, error) {
+	ctx, span := r.tracer.Start(ctx, "GetSingleplayerChallengeByGameID")
+	defer span.End()
+
+	return r.getSingleplayerChallenge(ctx, "sc.game_id = @game_id", pgx.NamedArgs{"game_id": gameID})
+}
+
+// getSingleplayerChallenge returns a challenge, that matches the condition, with settings of its game
+// and providers of its locations.
+func (r *Repository) getSingleplayerChallenge(
+	ctx context.Context,
+	condition string,
+	args pgx.NamedArgs,
+) (singleplayer.Challenge, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	query := `
+		SELECT
+			sc.id,
+			sc.token,
+			sc.game_id,
+			sc.user_id,
+			sg.rounds,
+			sg.timer_seconds,
+			sg.movement_allowed,
+			sg.provider,
+			sg.providers,
+			COALESCE(sg.score_distance, 0) AS score_distance,
+			sg.scoring,
+			sc.location_ids,
+			ARRAY(
+				SELECT sr.provider
+				FROM singleplayer_round AS sr
+				WHERE sr.game_id = sc.game_id
+				ORDER BY sr.round_num
+			) AS location_providers,
+			sc.created_at
+		FROM singleplayer_challenge AS sc
+		JOIN singleplayer_game AS sg
+			ON sg.id = sc.game_id
+		WHERE ` + condition
+
+	var challenge singleplayer.Challenge
+
+	err := pgxscan.Get(ctx, tx, &challenge, query, args)
+	if pgxscan.NotFound(err) {
+		return singleplayer.Challenge{}, singleplayer.ErrChallengeNotFound
+	} else if err != nil {
+		return singleplayer.Challenge{}, fmt.Errorf("failed to get singleplayer challenge: %w", err)
+	}
+
+	return challenge, nil
+}
+
+// GetSingleplayerChallengeResults returns games of the challenge creator and all challengers,
+// ordered by score. Guesses of the games are not included.
+func (r *Repository) GetSingleplayerChallengeResults(
+	ctx context.Context,
+	challengeID int,
+) ([]singleplayer.ChallengeResult, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "GetSingleplayerChallengeResults")
+	defer span.End()
+
+	query := `
+		SELECT
+			sg.id AS game_id,
+			sg.user_id,
+			u.username,
+			COALESCE(u.avatar_hash, '') AS avatar_hash,
+			COALESCE(SUM(srg.score), 0) AS score,
+			sg.finished
+		FROM singleplayer_game AS sg
+		JOIN user_info AS u
+			ON u.id = sg.user_id
+		LEFT JOIN singleplayer_round AS sr
+			ON sr.game_id = sg.id
+		LEFT JOIN singleplayer_round_guess AS srg
+			ON srg.round_id = sr.id
+		WHERE sg.challenge_id = @challenge_id
+			OR sg.id = (SELECT game_id FROM singleplayer_challenge WHERE id = @challenge_id)
+		GROUP BY sg.id, u.id
+		ORDER BY score DESC, sg.id
+	`
+
+	var results []singleplayer.ChallengeResult
+
+	err := pgxscan.Select(ctx, tx, &results, query, pgx.NamedArgs{"challenge_id": challengeID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get singleplayer challenge results: %w", err)
+	}
+
+	return results, nil
+}
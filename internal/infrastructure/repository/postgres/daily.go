@@ -0,0 +1,178 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/singleplayer"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+)
+
+// NewDailyChallenge saves a daily challenge, if challenge for the same date and provider
+// already exists, it is left unchanged.
+func (r *Repository) NewDailyChallenge(ctx context.Context, req dto.NewDailyChallengeRequest) error {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "NewDailyChallenge")
+	defer span.End()
+
+	query := `
+		INSERT INTO daily_challenge
+		(challenge_date, provider, location_ids, created_at)
+		VALUES (@challenge_date, @provider, @location_ids, @created_at)
+		ON CONFLICT (challenge_date, provider) DO NOTHING
+	`
+
+	_, err := tx.Exec(ctx, query, pgx.NamedArgs{
+		"challenge_date": req.Date,
+		"provider":       req.Provider,
+		"location_ids":   req.LocationIDs,
+		"created_at":     req.RequestTime,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create daily challenge: %w", err)
+	}
+
+	return nil
+}
+
+// GetDailyChallenge returns a daily challenge by its date and provider.
+func (r *Repository) GetDailyChallenge(
+	ctx context.Context,
+	date time.Time,
+	provider game.PanoramaProvider,
+) (singleplayer.DailyChallenge, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "GetDailyChallenge")
+	defer span.End()
+
+	query := `
+		SELECT
+			id,
+			challenge_date,
+			provider,
+			location_ids,
+			created_at
+		FROM daily_challenge
+		WHERE challenge_date = @challenge_date AND provider = @provider
+	`
+
+	var challenge singleplayer.DailyChallenge
+
+	err := pgxscan.Get(ctx, tx, &challenge, query, pgx.NamedArgs{
+		"challenge_date": date,
+		"provider":       provider,
+	})
+	if pgxscan.NotFound(err) {
+		return singleplayer.DailyChallenge{}, singleplayer.ErrDailyChallengeNotFound
+	} else if err != nil {
+		return singleplayer.DailyChallenge{}, fmt.Errorf("failed to get daily challenge: %w", err)
+	}
+
+	return challenge, nil
+}
+
+// GetDailyChallengeByID returns a daily challenge by its id.
+func (r *Repository) GetDailyChallengeByID(ctx context.Context, id int) (singleplayer.DailyChallenge, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "GetDailyChallengeByID")
+	defer span.End()
+
+	query := `
+		SELECT
+			id,
+			challenge_date,
+			provider,
+			location_ids,
+			created_at
+		FROM daily_challenge
+		WHERE id = @id
+	`
+
+	var challenge singleplayer.DailyChallenge
+
+	err := pgxscan.Get(ctx, tx, &challenge, query, pgx.NamedArgs{"id": id})
+	if pgxscan.NotFound(err) {
+		return singleplayer.DailyChallenge{}, singleplayer.ErrDailyChallengeNotFound
+	} else if err != nil {
+		return singleplayer.DailyChallenge{}, fmt.Errorf("failed to get daily challenge: %w", err)
+	}
+
+	return challenge, nil
+}
+
+// GetDailyChallengeLeaderboard returns finished games of a daily challenge, ranked by score.
+func (r *Repository) GetDailyChallengeLeaderboard(
+	ctx context.Context,
+	req dto.GetDailyChallengeLeaderboardRequestDB,
+) ([]singleplayer.DailyChallengeResult, int, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "GetDailyChallengeLeaderboard")
+	defer span.End()
+
+	var totalResults int
+
+	countQuery := `
+		SELECT COUNT(*)
+		FROM singleplayer_game
+		WHERE daily_challenge_id = @challenge_id AND finished = true
+	`
+
+	err := pgxscan.Get(ctx, tx, &totalResults, countQuery, pgx.NamedArgs{
+		"challenge_id": req.ChallengeID,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get total daily challenge results count: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	query := `
+		WITH results AS (
+			SELECT
+				sg.id AS game_id,
+				sg.user_id,
+				COALESCE(SUM(srg.score), 0) AS score,
+				sg.ended_at
+			FROM singleplayer_game AS sg
+			LEFT JOIN singleplayer_round AS sr
+				ON sr.game_id = sg.id
+			LEFT JOIN singleplayer_round_guess AS srg
+				ON srg.round_id = sr.id
+			WHERE sg.daily_challenge_id = @challenge_id AND sg.finished = true
+			GROUP BY sg.id
+		)
+		SELECT
+			RANK() OVER (ORDER BY res.score DESC) AS place,
+			res.user_id,
+			u.username,
+			COALESCE(u.avatar_hash, '') AS avatar_hash,
+			res.game_id,
+			res.score,
+			res.ended_at
+		FROM results AS res
+		JOIN user_info AS u
+			ON u.id = res.user_id
+		ORDER BY res.score DESC, res.ended_at, res.game_id
+		LIMIT @limit OFFSET @offset
+	`
+
+	var results []singleplayer.DailyChallengeResult
+
+	err = pgxscan.Select(ctx, tx, &results, query, pgx.NamedArgs{
+		"challenge_id": req.ChallengeID,
+		"limit":        req.PageSize,
+		"offset":       offset,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get daily challenge leaderboard: %w", err)
+	}
+
+	return results, totalResults, nil
+}
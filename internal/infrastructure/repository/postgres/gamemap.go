@@ -0,0 +1,306 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game/gamemap"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+)
+
+// NewMap creates a new map without locations.
+func (r *Repository) NewMap(ctx context.Context, req dto.NewMapRequest) (int, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "NewMap")
+	defer span.End()
+
+	query := `
+		INSERT INTO game_map
+		(user_id, name, description, provider, visibility, created_at, updated_at)
+		VALUES (@user_id, @name, @description, @provider, @visibility, @created_at, @created_at)
+		RETURNING id
+	`
+
+	var mapID int
+
+	err := tx.QueryRow(ctx, query, pgx.NamedArgs{
+		"user_id":     req.UserID,
+		"name":        req.Name,
+		"description": req.Description,
+		"provider":    req.Provider,
+		"visibility":  req.Visibility,
+		"created_at":  req.RequestTime,
+	}).Scan(&mapID)
+	if err != nil {
+		return -1, fmt.Errorf("failed to create map: %w", err)
+	}
+
+	return mapID, nil
+}
+
+// GetMap returns a map by its id.
+func (r *Repository) GetMap(ctx context.Context, id int) (gamemap.Map, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "GetMap")
+	defer span.End()
+
+	query := `
+		SELECT
+			gm.id,
+			gm.user_id,
+			gm.name,
+			gm.description,
+			gm.provider,
+			gm.visibility,
+			(SELECT COUNT(*) FROM game_map_location WHERE map_id = gm.id) AS locations_count,
+			gm.created_at,
+			gm.updated_at
+		FROM game_map AS gm
+		WHERE gm.id = @id
+	`
+
+	var m gamemap.Map
+
+	err := pgxscan.Get(ctx, tx, &m, query, pgx.NamedArgs{"id": id})
+	if pgxscan.NotFound(err) {
+		return gamemap.Map{}, gamemap.ErrNotFound
+	} else if err != nil {
+		return gamemap.Map{}, fmt.Errorf("failed to get map: %w", err)
+	}
+
+	return m, nil
+}
+
+// GetMaps returns a page of public maps, or of all maps of the user if only own maps are requested.
+func (r *Repository) GetMaps(ctx context.Context, req dto.GetMapsRequest) ([]gamemap.Map, int, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "GetMaps")
+	defer span.End()
+
+	condition := "gm.visibility = 'public'"
+	if req.Mine {
+		condition = "gm.user_id = @user_id"
+	}
+
+	var totalMaps int
+
+	countQuery := `
+		SELECT COUNT(*)
+		FROM game_map AS gm
+		WHERE ` + condition
+
+	err := pgxscan.Get(ctx, tx, &totalMaps, countQuery, pgx.NamedArgs{
+		"user_id": req.UserID,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get total maps count: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	query := `
+		SELECT
+			gm.id,
+			gm.user_id,
+			gm.name,
+			gm.description,
+			gm.provider,
+			gm.visibility,
+			(SELECT COUNT(*) FROM game_map_location WHERE map_id = gm.id) AS locations_count,
+			gm.created_at,
+			gm.updated_at
+		FROM game_map AS gm
+		WHERE ` + condition + `
+		ORDER BY gm.created_at DESC, gm.id DESC
+		LIMIT @limit OFFSET @offset
+	`
+
+	var maps []gamemap.Map
+
+	err = pgxscan.Select(ctx, tx, &maps, query, pgx.NamedArgs{
+		"user_id": req.UserID,
+		"limit":   req.PageSize,
+		"offset":  offset,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get maps: %w", err)
+	}
+
+	return maps, totalMaps, nil
+}
+
+// UpdateMap updates name, description and visibility of a map.
+func (r *Repository) UpdateMap(ctx context.Context, req dto.UpdateMapRequest) error {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "UpdateMap")
+	defer span.End()
+
+	query := `
+		UPDATE game_map
+		SET
+			name = @name,
+			description = @description,
+			visibility = @visibility,
+			updated_at = @updated_at
+		WHERE id = @id
+	`
+
+	_, err := tx.Exec(ctx, query, pgx.NamedArgs{
+		"id":          req.MapID,
+		"name":        req.Name,
+		"description": req.Description,
+		"visibility":  req.Visibility,
+		"updated_at":  req.RequestTime,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update map: %w", err)
+	}
+
+	return nil
+}
+
+// AddMapLocations adds existing (not imported) panorama locations of the provider to a map.
+// Returns the amount of found locations and the amount of locations, that were not in the map before.
+func (r *Repository) AddMapLocations(
+	ctx context.Context,
+	req dto.AddMapLocationsRequestDB,
+) (int, int, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "AddMapLocations")
+	defer span.End()
+
+	query := `
+		WITH found AS (
+			SELECT id
+			FROM panorama_location
+			WHERE id = ANY(@location_ids) AND provider = @provider AND NOT imported
+		), added AS (
+			INSERT INTO game_map_location
+			(map_id, location_id)
+			SELECT @map_id, id
+			FROM found
+			ON CONFLICT DO NOTHING
+			RETURNING location_id
+		)
+		SELECT
+			(SELECT COUNT(*) FROM found),
+			(SELECT COUNT(*) FROM added)
+	`
+
+	var found, added int
+
+	err := tx.QueryRow(ctx, query, pgx.NamedArgs{
+		"map_id":       req.MapID,
+		"provider":     req.Provider,
+		"location_ids": req.LocationIDs,
+	}).Scan(&found, &added)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to add map locations: %w", err)
+	}
+
+	if added != 0 {
+		if err := r.refreshMapBounds(ctx, req.MapID); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return found, added, nil
+}
+
+// ImportMapLocations saves imported locations as panorama locations of the provider and adds them to a map.
+// Imported locations are used only in games on maps. Returns the amount of imported locations.
+func (r *Repository) ImportMapLocations(ctx context.Context, req dto.ImportMapLocationsRequestDB) (int, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "ImportMapLocations")
+	defer span.End()
+
+	lats := make([]float64, 0, len(req.Locations))
+	lngs := make([]float64, 0, len(req.Locations))
+	streetviewIDs := make([]string, 0, len(req.Locations))
+	countryCodes := make([]string, 0, len(req.Locations))
+	regionCodes := make([]string, 0, len(req.Locations))
+
+	for _, loc := range req.Locations {
+		lats = append(lats, loc.Lat)
+		lngs = append(lngs, loc.Lng)
+		streetviewIDs = append(streetviewIDs, loc.StreetviewID)
+		countryCodes = append(countryCodes, loc.Place.CountryCode)
+		regionCodes = append(regionCodes, loc.Place.RegionCode)
+	}
+
+	query := `
+		WITH imported AS (
+			INSERT INTO panorama_location
+			(streetview_id, provider, lat, lng, country_code, region_code, imported)
+			SELECT NULLIF(streetview_id, ''), @provider, lat, lng, NULLIF(country_code, ''), NULLIF(region_code, ''), TRUE
+			FROM UNNEST(
+				@streetview_ids::VARCHAR[], @lats::FLOAT[], @lngs::FLOAT[],
+				@country_codes::VARCHAR[], @region_codes::VARCHAR[]
+			) AS l(streetview_id, lat, lng, country_code, region_code)
+			RETURNING id
+		)
+		INSERT INTO game_map_location
+		(map_id, location_id)
+		SELECT @map_id, id
+		FROM imported
+	`
+
+	tag, err := tx.Exec(ctx, query, pgx.NamedArgs{
+		"map_id":         req.MapID,
+		"provider":       req.Provider,
+		"streetview_ids": streetviewIDs,
+		"lats":           lats,
+		"lngs":           lngs,
+		"country_codes":  countryCodes,
+		"region_codes":   regionCodes,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to import map locations: %w", err)
+	}
+
+	if err := r.refreshMapBounds(ctx, req.MapID); err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// refreshMapBounds recalculates the bounding box of map locations after they were added,
+// so that it doesn't have to be calculated for every new game on the map.
+func (r *Repository) refreshMapBounds(ctx context.Context, mapID int) error {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	query := `
+		UPDATE game_map AS gm
+		SET
+			min_lat = b.min_lat,
+			min_lng = b.min_lng,
+			max_lat = b.max_lat,
+			max_lng = b.max_lng
+		FROM (
+			SELECT
+				MIN(pl.lat) AS min_lat,
+				MIN(pl.lng) AS min_lng,
+				MAX(pl.lat) AS max_lat,
+				MAX(pl.lng) AS max_lng
+			FROM game_map_location AS gml
+			JOIN panorama_location AS pl
+				ON pl.id = gml.location_id
+			WHERE gml.map_id = @map_id
+		) AS b
+		WHERE gm.id = @map_id
+	`
+
+	if _, err := tx.Exec(ctx, query, pgx.NamedArgs{"map_id": mapID}); err != nil {
+		return fmt.Errorf("failed to refresh map bounds: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,313 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/gamemap"
+	"github.com/VasySS/segoya-backend/internal/entity/user"
+	postgresRepo "github.com/VasySS/segoya-backend/internal/infrastructure/repository/postgres"
+	"github.com/VasySS/segoya-backend/migrations/data"
+	"github.com/VasySS/segoya-backend/migrations/tables"
+	"github.com/VasySS/segoya-backend/tests/containers"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestGameMapTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(GameMapTestSuite))
+}
+
+type GameMapTestSuite struct {
+	suite.Suite
+	ctx               context.Context
+	postgresContainer *containers.PostgresContainer
+	postgresRepo      *postgresRepo.Repository
+}
+
+func (s *GameMapTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+
+	postgresContainer, err := containers.NewPostgresContainer(s.ctx)
+	s.Require().NoError(err)
+
+	s.postgresContainer = postgresContainer
+
+	migrationsPool, err := pgxpool.New(s.ctx, postgresContainer.ConnectionString)
+	s.Require().NoError(err)
+
+	err = tables.RunGooseMigrations(s.ctx, migrationsPool, "up")
+	s.Require().NoError(err)
+
+	err = data.MigrateAllCSVData(s.ctx, migrationsPool)
+	s.Require().NoError(err)
+
+	// testcontainers lib needs all connections to db to be closed
+	migrationsPool.Close()
+
+	// save db state with all migrations applied
+	err = s.postgresContainer.Snapshot(s.ctx)
+	s.Require().NoError(err)
+}
+
+func (s *GameMapTestSuite) TearDownSuite() {
+	err := s.postgresContainer.Terminate(s.ctx)
+	s.Require().NoError(err)
+}
+
+func (s *GameMapTestSuite) SetupTest() {
+	// restore db state with migrations only
+	err := s.postgresContainer.Restore(s.ctx)
+	s.Require().NoError(err)
+
+	pool, err := pgxpool.New(s.ctx, s.postgresContainer.ConnectionString)
+	s.Require().NoError(err)
+
+	txManger := postgresRepo.NewTxManager(pool)
+	repo := postgresRepo.New(txManger)
+
+	s.postgresRepo = repo
+}
+
+func (s *GameMapTestSuite) newTestUser() user.PrivateProfile {
+	newUserReq := dto.RegisterRequestDB{
+		RequestTime: time.Now().UTC(),
+		Username:    gofakeit.Username(),
+		Name:        gofakeit.Name(),
+		Password:    gofakeit.LetterN(60), // emulating bcrypt hash
+	}
+
+	err := s.postgresRepo.NewUser(s.ctx, newUserReq)
+	s.Require().NoError(err)
+
+	newUser, err := s.postgresRepo.GetUserByUsername(s.ctx, newUserReq.Username)
+	s.Require().NoError(err)
+
+	return newUser
+}
+
+func (s *GameMapTestSuite) newTestMap(userID int, visibility gamemap.Visibility) int {
+	mapID, err := s.postgresRepo.NewMap(s.ctx, dto.NewMapRequest{
+		RequestTime: time.Now().UTC(),
+		UserID:      userID,
+		Name:        gofakeit.City(),
+		Description: gofakeit.Sentence(5),
+		Provider:    game.GoogleProvider,
+		Visibility:  visibility,
+	})
+	s.Require().NoError(err)
+
+	return mapID
+}
+
+func (s *GameMapTestSuite) TestNewMap() {
+	testUser := s.newTestUser()
+	mapID := s.newTestMap(testUser.ID, gamemap.VisibilityPrivate)
+
+	m, err := s.postgresRepo.GetMap(s.ctx, mapID)
+	s.Require().NoError(err)
+
+	s.Equal(mapID, m.ID)
+	s.Equal(testUser.ID, m.UserID)
+	s.Equal(game.GoogleProvider, m.Provider)
+	s.Equal(gamemap.VisibilityPrivate, m.Visibility)
+	s.Zero(m.LocationsCount)
+}
+
+func (s *GameMapTestSuite) TestGetMapNotFound() {
+	_, err := s.postgresRepo.GetMap(s.ctx, 999999)
+	s.ErrorIs(err, gamemap.ErrNotFound)
+}
+
+func (s *GameMapTestSuite) TestGetMaps() {
+	testUser := s.newTestUser()
+	otherUser := s.newTestUser()
+
+	publicMapID := s.newTestMap(otherUser.ID, gamemap.VisibilityPublic)
+	s.newTestMap(otherUser.ID, gamemap.VisibilityPrivate)
+	ownMapID := s.newTestMap(testUser.ID, gamemap.VisibilityUnlisted)
+
+	publicMaps, total, err := s.postgresRepo.GetMaps(s.ctx, dto.GetMapsRequest{
+		UserID:   testUser.ID,
+		Page:     1,
+		PageSize: 10,
+	})
+	s.Require().NoError(err)
+	s.Equal(1, total)
+	s.Equal(publicMapID, publicMaps[0].ID)
+
+	ownMaps, total, err := s.postgresRepo.GetMaps(s.ctx, dto.GetMapsRequest{
+		UserID:   testUser.ID,
+		Mine:     true,
+		Page:     1,
+		PageSize: 10,
+	})
+	s.Require().NoError(err)
+	s.Equal(1, total)
+	s.Equal(ownMapID, ownMaps[0].ID)
+}
+
+func (s *GameMapTestSuite) TestUpdateMap() {
+	testUser := s.newTestUser()
+	mapID := s.newTestMap(testUser.ID, gamemap.VisibilityPrivate)
+
+	err := s.postgresRepo.UpdateMap(s.ctx, dto.UpdateMapRequest{
+		RequestTime: time.Now().UTC(),
+		MapID:       mapID,
+		Name:        "Updated",
+		Description: "Updated description",
+		Visibility:  gamemap.VisibilityPublic,
+	})
+	s.Require().NoError(err)
+
+	m, err := s.postgresRepo.GetMap(s.ctx, mapID)
+	s.Require().NoError(err)
+	s.Equal("Updated", m.Name)
+	s.Equal("Updated description", m.Description)
+	s.Equal(gamemap.VisibilityPublic, m.Visibility)
+}
+
+func (s *GameMapTestSuite) TestAddMapLocations() {
+	testUser := s.newTestUser()
+	mapID := s.newTestMap(testUser.ID, gamemap.VisibilityPublic)
+
+	stv, err := s.postgresRepo.RandomPanoramaLocation(s.ctx, game.GoogleProvider, nil)
+	s.Require().NoError(err)
+
+	found, added, err := s.postgresRepo.AddMapLocations(s.ctx, dto.AddMapLocationsRequestDB{
+		MapID:       mapID,
+		Provider:    game.GoogleProvider,
+		LocationIDs: []int{stv.ID, -1},
+	})
+	s.Require().NoError(err)
+	s.Equal(1, found)
+	s.Equal(1, added)
+
+	// locations already in the map are not added again
+	found, added, err = s.postgresRepo.AddMapLocations(s.ctx, dto.AddMapLocationsRequestDB{
+		MapID:       mapID,
+		Provider:    game.GoogleProvider,
+		LocationIDs: []int{stv.ID},
+	})
+	s.Require().NoError(err)
+	s.Equal(1, found)
+	s.Equal(0, added)
+
+	panorama, err := s.postgresRepo.RandomMapStreetview(s.ctx, mapID, nil)
+	s.Require().NoError(err)
+	s.Equal(stv.ID, panorama.ID)
+
+	_, err = s.postgresRepo.RandomMapStreetview(s.ctx, mapID, []int{stv.ID})
+	s.ErrorIs(err, game.ErrPanoramaNotFound)
+}
+
+func (s *GameMapTestSuite) TestImportMapLocations() {
+	testUser := s.newTestUser()
+	mapID := s.newTestMap(testUser.ID, gamemap.VisibilityPublic)
+
+	totalBefore, err := s.postgresRepo.CountPanoramaLocations(s.ctx, game.GoogleProvider)
+	s.Require().NoError(err)
+
+	imported, err := s.postgresRepo.ImportMapLocations(s.ctx, dto.ImportMapLocationsRequestDB{
+		MapID:    mapID,
+		Provider: game.GoogleProvider,
+		Locations: []gamemap.Location{
+			{LatLng: game.LatLng{Lat: 55.75, Lng: 37.61}},
+			{
+				LatLng:       game.LatLng{Lat: -33.86, Lng: 151.2},
+				StreetviewID: "some_id",
+				Place:        game.Place{CountryCode: "AU", RegionCode: "AU-NSW"},
+			},
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal(2, imported)
+
+	m, err := s.postgresRepo.GetMap(s.ctx, mapID)
+	s.Require().NoError(err)
+	s.Equal(2, m.LocationsCount)
+
+	// imported locations are used only in games on maps
+	totalAfter, err := s.postgresRepo.CountPanoramaLocations(s.ctx, game.GoogleProvider)
+	s.Require().NoError(err)
+	s.Equal(totalBefore, totalAfter)
+}
+
+func (s *GameMapTestSuite) TestRandomMapStreetviewCountry() {
+	testUser := s.newTestUser()
+	mapID := s.newTestMap(testUser.ID, gamemap.VisibilityPublic)
+
+	_, err := s.postgresRepo.ImportMapLocations(s.ctx, dto.ImportMapLocationsRequestDB{
+		MapID:    mapID,
+		Provider: game.GoogleProvider,
+		Locations: []gamemap.Location{
+			{
+				LatLng: game.LatLng{Lat: -33.86, Lng: 151.2},
+				Place:  game.Place{CountryCode: "AU", RegionCode: "AU-NSW"},
+			},
+		},
+	})
+	s.Require().NoError(err)
+
+	// country is read from the location, so it is not resolved again for every round
+	panorama, err := s.postgresRepo.RandomMapStreetview(s.ctx, mapID, nil)
+	s.Require().NoError(err)
+	s.Equal("AU", panorama.CountryCode)
+}
+
+func (s *GameMapTestSuite) TestRandomMapStreetviewEmpty() {
+	testUser := s.newTestUser()
+	mapID := s.newTestMap(testUser.ID, gamemap.VisibilityPublic)
+
+	_, err := s.postgresRepo.RandomMapStreetview(s.ctx, mapID, nil)
+	s.ErrorIs(err, game.ErrPanoramaNotFound)
+}
+
+func (s *GameMapTestSuite) TestGetMapLocationBounds() {
+	testUser := s.newTestUser()
+	mapID := s.newTestMap(testUser.ID, gamemap.VisibilityPublic)
+
+	_, err := s.postgresRepo.GetMapLocationBounds(s.ctx, mapID)
+	s.Require().ErrorIs(err, game.ErrPanoramaNotFound)
+
+	_, err = s.postgresRepo.ImportMapLocations(s.ctx, dto.ImportMapLocationsRequestDB{
+		MapID:    mapID,
+		Provider: game.GoogleProvider,
+		Locations: []gamemap.Location{
+			{LatLng: game.LatLng{Lat: 55.75, Lng: 37.61}},
+			{LatLng: game.LatLng{Lat: -33.86, Lng: 151.2}},
+		},
+	})
+	s.Require().NoError(err)
+
+	bounds, err := s.postgresRepo.GetMapLocationBounds(s.ctx, mapID)
+	s.Require().NoError(err)
+	s.InDelta(-33.86, bounds.MinLat, 0.01)
+	s.InDelta(37.61, bounds.MinLng, 0.01)
+	s.InDelta(55.75, bounds.MaxLat, 0.01)
+	s.InDelta(151.2, bounds.MaxLng, 0.01)
+
+	// bounds are refreshed when existing locations are added to the map
+	location, err := s.postgresRepo.RandomPanoramaLocation(s.ctx, game.GoogleProvider, nil)
+	s.Require().NoError(err)
+
+	_, _, err = s.postgresRepo.AddMapLocations(s.ctx, dto.AddMapLocationsRequestDB{
+		MapID:       mapID,
+		Provider:    game.GoogleProvider,
+		LocationIDs: []int{location.ID},
+	})
+	s.Require().NoError(err)
+
+	bounds, err = s.postgresRepo.GetMapLocationBounds(s.ctx, mapID)
+	s.Require().NoError(err)
+	s.InDelta(min(-33.86, location.Lat), bounds.MinLat, 0.01)
+	s.InDelta(min(37.61, location.Lng), bounds.MinLng, 0.01)
+	s.InDelta(max(55.75, location.Lat), bounds.MaxLat, 0.01)
+	s.InDelta(max(151.2, location.Lng), bounds.MaxLng, 0.01)
+}
@@ -43,35 +43,54 @@ func (r *Repository) NewMultiplayerGame(
         WITH 
 		new_game AS (
             INSERT INTO multiplayer_game
-                (created_at, creator_id, rounds, movement_allowed, provider, timer_seconds, players)
-            VALUES (@created_at, @creator_id, @rounds, @movement_allowed, @provider, @timer_seconds, @players)
+                (created_at, creator_id, rounds, movement_allowed, provider, providers, score_distance,
+                scoring, mode, health, team_scoring, spectators, spectator_delay_seconds,
+                timer_seconds, players, map_id)
+            VALUES (@created_at, @creator_id, @rounds, @movement_allowed, @provider,
+                COALESCE(@providers::VARCHAR[], '{}'), NULLIF(@score_distance::DOUBLE PRECISION, 0),
+                COALESCE(NULLIF(@scoring, ''), 'classic'), COALESCE(NULLIF(@mode, ''), 'classic'),
+                NULLIF(@health::BIGINT, 0), NULLIF(@team_scoring, ''), @spectators, @spectator_delay_seconds,
+                @timer_seconds, @players, NULLIF(@map_id, 0))
             RETURNING id
         ),
 		inserted_users AS (
-            INSERT INTO multiplayer_game_user (user_id, game_id, created_at)
-            SELECT user_id, new_game.id, @created_at
-            FROM new_game, unnest(@user_ids::bigint[]) AS user_id
+            INSERT INTO multiplayer_game_user (user_id, game_id, health, team, created_at)
+            SELECT p.user_id, new_game.id, NULLIF(@health::BIGINT, 0), NULLIF(p.team, 0), @created_at
+            FROM new_game, unnest(@user_ids::BIGINT[], @teams::BIGINT[]) AS p(user_id, team)
         )
 		
         SELECT id FROM new_game
     `
 
 	userIDs := make([]int64, 0, len(req.ConnectedPlayers))
+	teams := make([]int64, 0, len(req.ConnectedPlayers))
+
 	for _, player := range req.ConnectedPlayers {
 		userIDs = append(userIDs, int64(player.ID))
+		teams = append(teams, int64(req.Teams[player.ID]))
 	}
 
 	var gameID int
 
 	err := pgxscan.Get(ctx, tx, &gameID, query, pgx.NamedArgs{
-		"created_at":       req.RequestTime,
-		"creator_id":       req.CreatorID,
-		"rounds":           req.Rounds,
-		"movement_allowed": req.MovementAllowed,
-		"provider":         req.Provider,
-		"timer_seconds":    req.TimerSeconds,
-		"players":          len(req.ConnectedPlayers),
-		"user_ids":         userIDs,
+		"created_at":              req.RequestTime,
+		"creator_id":              req.CreatorID,
+		"rounds":                  req.Rounds,
+		"movement_allowed":        req.MovementAllowed,
+		"provider":                req.Provider,
+		"providers":               req.Providers,
+		"score_distance":          req.ScoreDistance,
+		"scoring":                 req.Scoring,
+		"mode":                    req.Mode,
+		"health":                  req.Health,
+		"team_scoring":            req.TeamScoring,
+		"spectators":              req.Spectators,
+		"spectator_delay_seconds": req.SpectatorDelaySeconds,
+		"timer_seconds":           req.TimerSeconds,
+		"players":                 len(req.ConnectedPlayers),
+		"map_id":                  req.MapID,
+		"user_ids":                userIDs,
+		"teams":                   teams,
 	})
 	if err != nil {
 		return -1, fmt.Errorf("failed to create multiplayer game: %w", err)
@@ -95,9 +114,18 @@ func (r *Repository) GetMultiplayerGame(ctx context.Context, gameID int) (multip
 			COUNT(mr.id) AS round_current,
 			mg.movement_allowed,
 			mg.provider,
+			mg.providers,
+			COALESCE(mg.score_distance, 0) AS score_distance,
+			mg.scoring,
+			mg.mode,
+			COALESCE(mg.health, 0) AS health,
+			COALESCE(mg.team_scoring, '') AS team_scoring,
+			mg.spectators,
+			mg.spectator_delay_seconds,
 			mg.timer_seconds,
 			mg.players,
 			mg.finished,
+			COALESCE(mg.map_id, 0) AS map_id,
 			mg.created_at,
 			COALESCE(mg.ended_at, '0001-01-01 00:00:00') AS ended_at
 		FROM multiplayer_game AS mg
@@ -159,14 +187,19 @@ func (r *Repository) GetMultiplayerGameUser(ctx context.Context, userID, gameID
 			u.username,
 			u.register_date,
 			COALESCE(u.avatar_hash, '') AS avatar_hash,
-			SUM(COALESCE(mru.score, 0)) AS score
+			SUM(COALESCE(mru.score, 0)) AS score,
+			COALESCE(mgu.eliminated_round, 0) AS eliminated_round,
+			COALESCE(mgu.health, 0) AS health,
+			COALESCE(mgu.team, 0) AS team
 		FROM user_info AS u 
+		JOIN multiplayer_game_user AS mgu
+			ON mgu.user_id = u.id AND mgu.game_id = @game_id
 		JOIN multiplayer_round AS mr
 			ON mr.game_id = @game_id
 		LEFT JOIN multiplayer_round_user AS mru 
-			ON mru.round_id = mr.id
+			ON mru.round_id = mr.id AND mru.user_id = u.id
 		WHERE u.id = @user_id
-		GROUP BY u.id
+		GROUP BY u.id, mgu.id
 	`
 
 	var u user.MultiplayerUser
@@ -175,7 +208,9 @@ func (r *Repository) GetMultiplayerGameUser(ctx context.Context, userID, gameID
 		"user_id": userID,
 		"game_id": gameID,
 	})
-	if err != nil {
+	if pgxscan.NotFound(err) {
+		return user.MultiplayerUser{}, multiplayer.ErrGameWrongUserID
+	} else if err != nil {
 		return user.MultiplayerUser{}, fmt.Errorf("failed to get user: %w", err)
 	}
 
@@ -196,14 +231,19 @@ func (r *Repository) GetMultiplayerGameUsers(ctx context.Context, gameID int) ([
 			u.username, 
 			u.register_date,
 			COALESCE(u.avatar_hash, '') AS avatar_hash,
-			SUM(COALESCE(mru.score, 0)) AS score
+			SUM(COALESCE(mru.score, 0)) AS score,
+			COALESCE(mgu.eliminated_round, 0) AS eliminated_round,
+			COALESCE(mgu.health, 0) AS health,
+			COALESCE(mgu.team, 0) AS team
 		FROM multiplayer_game_user AS mgu
 		JOIN user_info AS u
 			ON u.id = mgu.user_id
+		LEFT JOIN multiplayer_round AS mr
+			ON mr.game_id = mgu.game_id
 		LEFT JOIN multiplayer_round_user AS mru 
-			ON mru.user_id = mgu.user_id
+			ON mru.round_id = mr.id AND mru.user_id = mgu.user_id
 		WHERE mgu.game_id = @game_id
-		GROUP BY u.id
+		GROUP BY u.id, mgu.id
 	`
 
 	var users []user.MultiplayerUser
@@ -216,7 +256,108 @@ func (r *Repository) GetMultiplayerGameUsers(ctx context.Context, gameID int) ([
 	return users, nil
 }
 
-// GetMultiplayerGameGuesses returns a list of all multiplayer game guesses.
+// EliminateMultiplayerGameUsers marks players of a battle royale game as eliminated in the given round,
+// players who are already eliminated are not updated.
+func (r *Repository) EliminateMultiplayerGameUsers(
+	ctx context.Context,
+	req dto.EliminateMultiplayerUsersRequestDB,
+) error {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "EliminateMultiplayerGameUsers")
+	defer span.End()
+
+	query := `
+		UPDATE multiplayer_game_user
+		SET 
+			eliminated_round = @round_num,
+			eliminated_at = @eliminated_at
+		WHERE game_id = @game_id 
+			AND user_id = ANY(@user_ids::BIGINT[]) 
+			AND eliminated_round IS NULL
+	`
+
+	userIDs := make([]int64, 0, len(req.UserIDs))
+	for _, id := range req.UserIDs {
+		userIDs = append(userIDs, int64(id))
+	}
+
+	_, err := tx.Exec(ctx, query, pgx.NamedArgs{
+		"game_id":       req.GameID,
+		"round_num":     req.RoundNum,
+		"eliminated_at": req.RequestTime,
+		"user_ids":      userIDs,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to eliminate multiplayer game users: %w", err)
+	}
+
+	return nil
+}
+
+// DamageMultiplayerGameUsers decreases health of duel players, health never goes below 0.
+func (r *Repository) DamageMultiplayerGameUsers(
+	ctx context.Context,
+	req dto.DamageMultiplayerUsersRequestDB,
+) error {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "DamageMultiplayerGameUsers")
+	defer span.End()
+
+	query := `
+		UPDATE multiplayer_game_user AS mgu
+		SET health = GREATEST(mgu.health - d.damage, 0)
+		FROM unnest(@user_ids::BIGINT[], @damage::BIGINT[]) AS d(user_id, damage)
+		WHERE mgu.game_id = @game_id AND mgu.user_id = d.user_id
+	`
+
+	userIDs := make([]int64, 0, len(req.Damage))
+	damage := make([]int64, 0, len(req.Damage))
+
+	for _, d := range req.Damage {
+		userIDs = append(userIDs, int64(d.UserID))
+		damage = append(damage, int64(d.Damage))
+	}
+
+	_, err := tx.Exec(ctx, query, pgx.NamedArgs{
+		"game_id":  req.GameID,
+		"user_ids": userIDs,
+		"damage":   damage,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to damage multiplayer game users: %w", err)
+	}
+
+	return nil
+}
+
+// GetMultiplayerGameLocationIDs returns location IDs of multiplayer game rounds, ordered by round number.
+func (r *Repository) GetMultiplayerGameLocationIDs(ctx context.Context, gameID int) ([]int, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "GetMultiplayerGameLocationIDs")
+	defer span.End()
+
+	query := `
+		SELECT location_id
+		FROM multiplayer_round
+		WHERE game_id = @game_id
+		ORDER BY round_num
+	`
+
+	var ids []int
+
+	err := pgxscan.Select(ctx, tx, &ids, query, pgx.NamedArgs{"game_id": gameID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get multiplayer game location ids: %w", err)
+	}
+
+	return ids, nil
+}
+
+// GetMultiplayerGameGuesses returns a list of multiplayer game guesses of finished rounds,
+// so that locations of the current round are not revealed before it's over.
 func (r *Repository) GetMultiplayerGameGuesses(ctx context.Context, gameID int) ([]multiplayer.Guess, error) {
 	tx := r.txManager.GetQueryEngine(ctx)
 
@@ -228,19 +369,29 @@ func (r *Repository) GetMultiplayerGameGuesses(ctx context.Context, gameID int)
 			mr.round_num,
 			pl.lat AS round_lat,
 			pl.lng AS round_lng,
+			u.id AS user_id,
+			COALESCE(mgu.team, 0) AS team,
 			u.username,
 			COALESCE(u.avatar_hash, '') AS avatar_hash,
 			mru.lat, 
 			mru.lng, 
-			mru.score
+			mru.score,
+			mru.score_bonus,
+			mru.score_penalty,
+			mru.distance_miss_meters AS miss_distance,
+			COALESCE(pl.country_code, '') AS round_country,
+			COALESCE(mru.country_code, '') AS guess_country,
+			mr.ended_at AS round_ended_at
 		FROM multiplayer_round_user AS mru
 		JOIN multiplayer_round AS mr
 			ON mr.id = mru.round_id
 		JOIN panorama_location AS pl
 			ON pl.id = mr.location_id
+		JOIN multiplayer_game_user AS mgu
+			ON mgu.game_id = mr.game_id AND mgu.user_id = mru.user_id
 		JOIN user_info AS u 
 			ON u.id = mru.user_id
-		WHERE mr.game_id = @game_id
+		WHERE mr.game_id = @game_id AND mr.finished
 	`
 
 	var guesses []multiplayer.Guess
@@ -265,8 +416,8 @@ func (r *Repository) NewMultiplayerRound(
 
 	roundQuery := `
 		INSERT INTO multiplayer_round
-		(game_id, location_id, round_num, created_at, started_at)
-		VALUES (@game_id, @location_id, @round_num, @created_at, @started_at)
+		(game_id, location_id, provider, round_num, created_at, started_at)
+		VALUES (@game_id, @location_id, @provider, @round_num, @created_at, @started_at)
 		ON CONFLICT (game_id, round_num) DO NOTHING
 		RETURNING id
 	`
@@ -276,6 +427,7 @@ func (r *Repository) NewMultiplayerRound(
 	err := pgxscan.Get(ctx, tx, &roundID, roundQuery, pgx.NamedArgs{
 		"game_id":     req.GameID,
 		"location_id": req.LocationID,
+		"provider":    req.Provider,
 		"round_num":   req.RoundNum,
 		"created_at":  req.CreatedAt,
 		"started_at":  req.StartedAt,
@@ -304,9 +456,11 @@ func (r *Repository) GetMultiplayerRound(ctx context.Context, gameID, roundNum i
 			mr.id, 
 			mr.game_id, 
 			COALESCE(MAX(pl.streetview_id), '') AS streetview_id,
+			mr.provider,
 			MAX(pl.lat) AS lat,
     		MAX(pl.lng) AS lng, 
 			COUNT(mru.id) AS guesses_count,
+			COALESCE(MIN(mru.created_at), '0001-01-01 00:00:00') AS first_guess_at,
 			mr.round_num, 
 			mr.finished,
 			mr.created_at, 
@@ -336,6 +490,73 @@ func (r *Repository) GetMultiplayerRound(ctx context.Context, gameID, roundNum i
 	return round, nil
 }
 
+// GetUnfinishedMultiplayerRounds returns all rounds that are not finished yet in active multiplayer games,
+// together with their games.
+func (r *Repository) GetUnfinishedMultiplayerRounds(ctx context.Context) ([]multiplayer.GameRound, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "GetUnfinishedMultiplayerRounds")
+	defer span.End()
+
+	query := `
+		SELECT 
+			mg.id AS "game.id",
+			mg.creator_id AS "game.creator_id",
+			mg.rounds AS "game.rounds",
+			(
+				SELECT COUNT(*)
+				FROM multiplayer_round AS gr
+				WHERE gr.game_id = mg.id
+			) AS "game.round_current",
+			mg.movement_allowed AS "game.movement_allowed",
+			mg.provider AS "game.provider",
+			mg.providers AS "game.providers",
+			COALESCE(mg.score_distance, 0) AS "game.score_distance",
+			mg.scoring AS "game.scoring",
+			mg.mode AS "game.mode",
+			COALESCE(mg.health, 0) AS "game.health",
+			COALESCE(mg.team_scoring, '') AS "game.team_scoring",
+			mg.spectators AS "game.spectators",
+			mg.spectator_delay_seconds AS "game.spectator_delay_seconds",
+			mg.timer_seconds AS "game.timer_seconds",
+			mg.players AS "game.players",
+			mg.finished AS "game.finished",
+			COALESCE(mg.map_id, 0) AS "game.map_id",
+			mg.created_at AS "game.created_at",
+			COALESCE(mg.ended_at, '0001-01-01 00:00:00') AS "game.ended_at",
+			mr.id AS "round.id", 
+			mr.game_id AS "round.game_id", 
+			COALESCE(MAX(pl.streetview_id), '') AS "round.streetview_id",
+			mr.provider AS "round.provider",
+			MAX(pl.lat) AS "round.lat",
+			MAX(pl.lng) AS "round.lng", 
+			COUNT(mru.id) AS "round.guesses_count",
+			COALESCE(MIN(mru.created_at), '0001-01-01 00:00:00') AS "round.first_guess_at",
+			mr.round_num AS "round.round_num", 
+			mr.finished AS "round.finished",
+			mr.created_at AS "round.created_at", 
+			mr.started_at AS "round.started_at",
+			COALESCE(mr.ended_at, '0001-01-01 00:00:00') AS "round.ended_at"
+		FROM multiplayer_round AS mr
+		JOIN multiplayer_game AS mg
+			ON mg.id = mr.game_id
+		JOIN panorama_location AS pl
+			ON pl.id = mr.location_id
+		LEFT JOIN multiplayer_round_user AS mru
+			ON mru.round_id = mr.id
+		WHERE mr.finished = false AND mg.finished = false
+		GROUP BY mr.id, mg.id
+	`
+
+	var rounds []multiplayer.GameRound
+
+	if err := pgxscan.Select(ctx, tx, &rounds, query); err != nil {
+		return nil, fmt.Errorf("failed to get unfinished multiplayer rounds: %w", err)
+	}
+
+	return rounds, nil
+}
+
 // EndMultiplayerRound ends a multiplayer round.
 func (r *Repository) EndMultiplayerRound(ctx context.Context, req dto.EndMultiplayerRoundRequestDB) error {
 	tx := r.txManager.GetQueryEngine(ctx)
@@ -374,18 +595,24 @@ func (r *Repository) NewMultiplayerRoundGuess(
 
 	guessQuery := `
 		INSERT INTO multiplayer_round_user
-		(created_at, round_id, user_id, lat, lng, score, distance_miss_meters) 
-		VALUES (@created_at, @round_id, @user_id, @lat, @lng, @score, @distance)
+		(created_at, round_id, user_id, lat, lng, country_code, score, score_bonus, score_penalty, distance_miss_meters)
+		VALUES (
+			@created_at, @round_id, @user_id, @lat, @lng, NULLIF(@country_code, ''),
+			@score, @score_bonus, @score_penalty, @distance
+		)
 	`
 
 	_, err := tx.Exec(ctx, guessQuery, pgx.NamedArgs{
-		"created_at": req.RequestTime,
-		"round_id":   req.RoundID,
-		"user_id":    req.UserID,
-		"lat":        req.Lat,
-		"lng":        req.Lng,
-		"score":      req.Score,
-		"distance":   req.Distance,
+		"created_at":    req.RequestTime,
+		"round_id":      req.RoundID,
+		"user_id":       req.UserID,
+		"lat":           req.Lat,
+		"lng":           req.Lng,
+		"country_code":  req.CountryCode,
+		"score":         req.Score,
+		"score_bonus":   req.ScoreBonus,
+		"score_penalty": req.ScorePenalty,
+		"distance":      req.Distance,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to insert multiplayer user guess: %w", err)
@@ -406,18 +633,25 @@ func (r *Repository) GetMultiplayerRoundGuesses(ctx context.Context, roundID int
 			mr.round_num,
 			pl.lat AS round_lat,
 			pl.lng AS round_lng,
+			u.id AS user_id,
+			COALESCE(mgu.team, 0) AS team,
 			u.username,
 			COALESCE(u.avatar_hash, '') AS avatar_hash,
 			mru.lat,
 			mru.lng,
-			mru.score
+			mru.score,
+			mru.score_bonus,
+			mru.score_penalty,
+			mru.distance_miss_meters AS miss_distance,
+			COALESCE(pl.country_code, '') AS round_country,
+			COALESCE(mru.country_code, '') AS guess_country
 		FROM multiplayer_round AS mr
 		JOIN panorama_location AS pl
 			ON pl.id = mr.location_id
 		JOIN multiplayer_game_user AS mgu
 			ON mgu.game_id = mr.game_id
 		JOIN multiplayer_round_user AS mru
-			ON mru.user_id = mgu.user_id
+			ON mru.round_id = mr.id AND mru.user_id = mgu.user_id
 		JOIN user_info AS u 
 			ON u.id = mru.user_id
 		WHERE mr.id = @round_id
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
 	"github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
 	"github.com/VasySS/segoya-backend/internal/entity/user"
 	postgresRepo "github.com/VasySS/segoya-backend/internal/infrastructure/repository/postgres"
@@ -122,6 +123,7 @@ func (s *MultiplayerTestSuite) newTestRound(
 		StartedAt:  time.Now().UTC().Add(time.Second * 10),
 		GameID:     gameID,
 		LocationID: gofakeit.Number(1, 100),
+		Provider:   game.PanoramaProvider(gofakeit.RandomString([]string{"seznam", "yandex", "yandex_air", "google"})),
 		RoundNum:   roundNum,
 	}
 
@@ -135,24 +137,32 @@ func (s *MultiplayerTestSuite) newTestGuess(
 	user user.PrivateProfile, round multiplayer.Round,
 ) multiplayer.Guess {
 	req := dto.NewMultiplayerRoundGuessRequestDB{
-		RequestTime: time.Now().UTC(),
-		UserID:      user.ID,
-		RoundID:     round.RoundNum,
-		Lat:         gofakeit.Latitude(),
-		Lng:         gofakeit.Longitude(),
-		Score:       gofakeit.Number(0, 5000),
-		Distance:    gofakeit.Number(0, 5000),
+		RequestTime:  time.Now().UTC(),
+		UserID:       user.ID,
+		RoundID:      round.ID,
+		Lat:          gofakeit.Latitude(),
+		Lng:          gofakeit.Longitude(),
+		Score:        gofakeit.Number(0, 5000),
+		ScoreBonus:   gofakeit.Number(0, 1000),
+		ScorePenalty: gofakeit.Number(0, 1000),
+		Distance:     gofakeit.Number(0, 5000),
+		CountryCode:  gofakeit.CountryAbr(),
 	}
 
 	multiplayerGuess := multiplayer.Guess{
-		Username:   user.Username,
-		AvatarHash: user.AvatarHash,
-		RoundNum:   round.RoundNum,
-		RoundLat:   round.Lat,
-		RoundLng:   round.Lng,
-		Lat:        req.Lat,
-		Lng:        req.Lng,
-		Score:      req.Score,
+		UserID:       user.ID,
+		Username:     user.Username,
+		AvatarHash:   user.AvatarHash,
+		RoundNum:     round.RoundNum,
+		RoundLat:     round.Lat,
+		RoundLng:     round.Lng,
+		Lat:          req.Lat,
+		Lng:          req.Lng,
+		Score:        req.Score,
+		ScoreBonus:   req.ScoreBonus,
+		ScorePenalty: req.ScorePenalty,
+		MissDistance: req.Distance,
+		GuessCountry: req.CountryCode,
 	}
 
 	err := s.postgresRepo.NewMultiplayerRoundGuess(s.ctx, req)
@@ -161,6 +171,14 @@ func (s *MultiplayerTestSuite) newTestGuess(
 	return multiplayerGuess
 }
 
+func (s *MultiplayerTestSuite) endTestRound(round multiplayer.Round) {
+	err := s.postgresRepo.EndMultiplayerRound(s.ctx, dto.EndMultiplayerRoundRequestDB{
+		RequestTime: time.Now().UTC(),
+		RoundID:     round.ID,
+	})
+	s.Require().NoError(err)
+}
+
 func (s *MultiplayerTestSuite) TestNewMultiplayerGame() {
 	userCreator := s.newTestUser()
 	userFirstPlayer := s.newTestUser()
@@ -179,9 +197,35 @@ func (s *MultiplayerTestSuite) TestNewMultiplayerGame() {
 	s.EqualValues(gameReq.Provider, newGame.Provider)
 	s.Equal(gameReq.MovementAllowed, newGame.MovementAllowed)
 	s.Equal(len(gameReq.ConnectedPlayers), newGame.Players)
+	s.Equal(multiplayer.ClassicMode, newGame.Mode)
 	s.WithinDuration(gameReq.RequestTime, newGame.CreatedAt, 5*time.Millisecond)
 }
 
+func (s *MultiplayerTestSuite) TestNewMixedProviderMultiplayerGame() {
+	userCreator := s.newTestUser()
+
+	gameID, err := s.postgresRepo.NewMultiplayerGame(s.ctx, dto.NewMultiplayerGameRequest{
+		RequestTime:      time.Now().UTC(),
+		CreatorID:        userCreator.ID,
+		ConnectedPlayers: []user.PublicProfile{userCreator.PublicProfile},
+		Rounds:           5,
+		Provider:         string(game.MixedProvider),
+		Providers:        []game.PanoramaProvider{game.YandexProvider, game.YandexAirProvider},
+		ScoreDistance:    321.5,
+		Scoring:          game.HardScoring,
+		Mode:             multiplayer.BattleRoyaleMode,
+	})
+	s.Require().NoError(err)
+
+	mixedGame, err := s.postgresRepo.GetMultiplayerGame(s.ctx, gameID)
+	s.Require().NoError(err)
+	s.Equal(game.MixedProvider, mixedGame.Provider)
+	s.Equal([]game.PanoramaProvider{game.YandexProvider, game.YandexAirProvider}, mixedGame.Providers)
+	s.InDelta(321.5, mixedGame.ScoreDistance, 0.001)
+	s.Equal(game.HardScoring, mixedGame.Scoring)
+	s.Equal(multiplayer.BattleRoyaleMode, mixedGame.Mode)
+}
+
 func (s *MultiplayerTestSuite) TestMultiplayerGameByID() {
 	_, err := s.postgresRepo.GetMultiplayerGame(s.ctx, 1111)
 	s.Require().ErrorIs(err, multiplayer.ErrGameNotFound)
@@ -222,6 +266,7 @@ func (s *MultiplayerTestSuite) TestNewMultiplayerRound() {
 	s.Equal(0, newRound.GuessesCount)
 	s.Equal(roundReq.GameID, newRound.GameID)
 	s.Equal(roundReq.RoundNum, newRound.RoundNum)
+	s.Equal(roundReq.Provider, newRound.Provider)
 	s.WithinDuration(roundReq.CreatedAt, newRound.CreatedAt, 5*time.Millisecond)
 	s.WithinDuration(roundReq.StartedAt, newRound.StartedAt, 5*time.Millisecond)
 }
@@ -264,6 +309,108 @@ func (s *MultiplayerTestSuite) TestMultiplayerGameUser() {
 	firstUserMultplayer, err := s.postgresRepo.GetMultiplayerGameUser(s.ctx, userFirstPlayer.ID, newGame.ID)
 	s.Require().NoError(err)
 	s.Equal(userFirstPlayer.PublicProfile, firstUserMultplayer.PublicProfile)
+
+	otherUser := s.newTestUser()
+
+	_, err = s.postgresRepo.GetMultiplayerGameUser(s.ctx, otherUser.ID, newGame.ID)
+	s.Require().ErrorIs(err, multiplayer.ErrGameWrongUserID)
+}
+
+func (s *MultiplayerTestSuite) TestEliminateMultiplayerGameUsers() {
+	userCreator := s.newTestUser()
+	userFirstPlayer := s.newTestUser()
+	userSecondPlayer := s.newTestUser()
+
+	newGame, _ := s.newTestGame(userCreator.ID, []user.PublicProfile{
+		userCreator.PublicProfile,
+		userFirstPlayer.PublicProfile,
+		userSecondPlayer.PublicProfile,
+	})
+	_, _ = s.newTestRound(newGame.ID, 1)
+	_, _ = s.newTestRound(newGame.ID, 2)
+
+	eliminate := func(roundNum int, userIDs ...int) {
+		err := s.postgresRepo.EliminateMultiplayerGameUsers(s.ctx, dto.EliminateMultiplayerUsersRequestDB{
+			RequestTime: time.Now().UTC(),
+			GameID:      newGame.ID,
+			RoundNum:    roundNum,
+			UserIDs:     userIDs,
+		})
+		s.Require().NoError(err)
+	}
+
+	eliminate(1, userFirstPlayer.ID)
+	// already eliminated players keep their elimination round
+	eliminate(2, userFirstPlayer.ID, userSecondPlayer.ID)
+
+	users, err := s.postgresRepo.GetMultiplayerGameUsers(s.ctx, newGame.ID)
+	s.Require().NoError(err)
+
+	eliminatedRounds := make(map[int]int, len(users))
+	for _, u := range users {
+		eliminatedRounds[u.ID] = u.EliminatedRound
+	}
+
+	s.Equal(map[int]int{
+		userCreator.ID:      0,
+		userFirstPlayer.ID:  1,
+		userSecondPlayer.ID: 2,
+	}, eliminatedRounds)
+
+	secondUser, err := s.postgresRepo.GetMultiplayerGameUser(s.ctx, userSecondPlayer.ID, newGame.ID)
+	s.Require().NoError(err)
+	s.True(secondUser.Eliminated())
+}
+
+func (s *MultiplayerTestSuite) TestDamageMultiplayerGameUsers() {
+	userFirstPlayer := s.newTestUser()
+	userSecondPlayer := s.newTestUser()
+
+	gameID, err := s.postgresRepo.NewMultiplayerGame(s.ctx, dto.NewMultiplayerGameRequest{
+		RequestTime: time.Now().UTC(),
+		CreatorID:   userFirstPlayer.ID,
+		ConnectedPlayers: []user.PublicProfile{
+			userFirstPlayer.PublicProfile,
+			userSecondPlayer.PublicProfile,
+		},
+		Provider: "google",
+		Mode:     multiplayer.DuelMode,
+		Health:   6000,
+	})
+	s.Require().NoError(err)
+
+	newGame, err := s.postgresRepo.GetMultiplayerGame(s.ctx, gameID)
+	s.Require().NoError(err)
+	s.Equal(multiplayer.DuelMode, newGame.Mode)
+	s.Equal(6000, newGame.Health)
+
+	damage := func(d ...multiplayer.Damage) {
+		err := s.postgresRepo.DamageMultiplayerGameUsers(s.ctx, dto.DamageMultiplayerUsersRequestDB{
+			GameID: gameID,
+			Damage: d,
+		})
+		s.Require().NoError(err)
+	}
+
+	damage(multiplayer.Damage{UserID: userFirstPlayer.ID, Damage: 1500})
+	// health doesn't go below zero
+	damage(
+		multiplayer.Damage{UserID: userFirstPlayer.ID, Damage: 500},
+		multiplayer.Damage{UserID: userSecondPlayer.ID, Damage: 7000},
+	)
+
+	users, err := s.postgresRepo.GetMultiplayerGameUsers(s.ctx, gameID)
+	s.Require().NoError(err)
+
+	health := make(map[int]int, len(users))
+	for _, u := range users {
+		health[u.ID] = u.Health
+	}
+
+	s.Equal(map[int]int{
+		userFirstPlayer.ID:  4000,
+		userSecondPlayer.ID: 0,
+	}, health)
 }
 
 func (s *MultiplayerTestSuite) TestMultiplayerGameUsers() {
@@ -287,6 +434,52 @@ func (s *MultiplayerTestSuite) TestMultiplayerGameUsers() {
 	)
 }
 
+func (s *MultiplayerTestSuite) TestMultiplayerGameTeams() {
+	userFirstPlayer := s.newTestUser()
+	userSecondPlayer := s.newTestUser()
+
+	gameID, err := s.postgresRepo.NewMultiplayerGame(s.ctx, dto.NewMultiplayerGameRequest{
+		RequestTime: time.Now().UTC(),
+		CreatorID:   userFirstPlayer.ID,
+		ConnectedPlayers: []user.PublicProfile{
+			userFirstPlayer.PublicProfile,
+			userSecondPlayer.PublicProfile,
+		},
+		Rounds:                3,
+		Provider:              "google",
+		Teams:                 map[int]int{userFirstPlayer.ID: 1, userSecondPlayer.ID: 2},
+		TeamScoring:           multiplayer.AverageTeamScoring,
+		Spectators:            true,
+		SpectatorDelaySeconds: 30,
+	})
+	s.Require().NoError(err)
+
+	newGame, err := s.postgresRepo.GetMultiplayerGame(s.ctx, gameID)
+	s.Require().NoError(err)
+	s.Equal(multiplayer.AverageTeamScoring, newGame.TeamScoring)
+	s.True(newGame.Spectators)
+	s.Equal(30, newGame.SpectatorDelaySeconds)
+
+	users, err := s.postgresRepo.GetMultiplayerGameUsers(s.ctx, gameID)
+	s.Require().NoError(err)
+
+	teams := make(map[int]int, len(users))
+	for _, u := range users {
+		teams[u.ID] = u.Team
+	}
+
+	s.Equal(map[int]int{userFirstPlayer.ID: 1, userSecondPlayer.ID: 2}, teams)
+
+	newRound, _ := s.newTestRound(gameID, 1)
+	_ = s.newTestGuess(userSecondPlayer, newRound)
+	s.endTestRound(newRound)
+
+	guesses, err := s.postgresRepo.GetMultiplayerGameGuesses(s.ctx, gameID)
+	s.Require().NoError(err)
+	s.Require().Len(guesses, 1)
+	s.Equal(2, guesses[0].Team)
+}
+
 func (s *MultiplayerTestSuite) TestSetMultiplayerUserGuess() {
 	userCreator := s.newTestUser()
 	userFirstPlayer := s.newTestUser()
@@ -360,9 +553,22 @@ func (s *MultiplayerTestSuite) TestMultiplayerGameGuesses() {
 	creatorSecondRoundGuess := s.newTestGuess(userCreator, secondRound)
 	firstPlayerSecondRoundGuess := s.newTestGuess(userFirstPlayer, secondRound)
 
+	s.endTestRound(firstRound)
+
+	// guesses of the unfinished round are not returned
 	gameGuesses, err := s.postgresRepo.GetMultiplayerGameGuesses(s.ctx, newGame.ID)
 	s.Require().NoError(err)
 
+	s.ElementsMatch([]multiplayer.Guess{
+		creatorFirstRoundGuess,
+		firstPlayerFirstRoundGuess,
+	}, gameGuesses)
+
+	s.endTestRound(secondRound)
+
+	gameGuesses, err = s.postgresRepo.GetMultiplayerGameGuesses(s.ctx, newGame.ID)
+	s.Require().NoError(err)
+
 	s.ElementsMatch([]multiplayer.Guess{
 		creatorFirstRoundGuess,
 		firstPlayerFirstRoundGuess,
@@ -398,6 +604,41 @@ func (s *MultiplayerTestSuite) TestEndMultiplayerRound() {
 	s.WithinDuration(endRoundReq.RequestTime, updatedRound.EndedAt, 5*time.Millisecond)
 }
 
+func (s *MultiplayerTestSuite) TestGetUnfinishedMultiplayerRounds() {
+	userCreator := s.newTestUser()
+
+	newGame, _ := s.newTestGame(userCreator.ID, []user.PublicProfile{
+		userCreator.PublicProfile,
+	})
+
+	firstRound, _ := s.newTestRound(newGame.ID, 1)
+	secondRound, _ := s.newTestRound(newGame.ID, 2)
+
+	err := s.postgresRepo.EndMultiplayerRound(s.ctx, dto.EndMultiplayerRoundRequestDB{
+		RequestTime: time.Now().UTC(),
+		RoundID:     firstRound.ID,
+	})
+	s.Require().NoError(err)
+
+	rounds, err := s.postgresRepo.GetUnfinishedMultiplayerRounds(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(rounds, 1)
+	s.Equal(secondRound.ID, rounds[0].Round.ID)
+	s.False(rounds[0].Round.Finished)
+	s.Equal(newGame.ID, rounds[0].Game.ID)
+	s.Equal(2, rounds[0].Game.RoundCurrent)
+
+	err = s.postgresRepo.EndMultiplayerGame(s.ctx, dto.EndMultiplayerGameRequestDB{
+		RequestTime: time.Now().UTC(),
+		GameID:      newGame.ID,
+	})
+	s.Require().NoError(err)
+
+	rounds, err = s.postgresRepo.GetUnfinishedMultiplayerRounds(s.ctx)
+	s.Require().NoError(err)
+	s.Empty(rounds)
+}
+
 func (s *MultiplayerTestSuite) TestMultiplayerGameEnd() {
 	userCreator := s.newTestUser()
 	userFirstPlayer := s.newTestUser()
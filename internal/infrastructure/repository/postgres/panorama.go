@@ -9,220 +9,368 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
-// RandomGoogleStreetview gets a random Google streetview from the database.
-func (r *Repository) RandomGoogleStreetview(ctx context.Context) (game.GoogleStreetview, error) {
+// RandomPanoramaLocation gets a random location of a provider from the database, skipping excluded location IDs.
+//
+// Instead of sorting all provider locations, a random value is generated and the location with the next
+// random key is taken (wrapping around to the lowest key), so only a single index lookup is needed.
+func (r *Repository) RandomPanoramaLocation(
+	ctx context.Context,
+	provider game.PanoramaProvider,
+	exclude []int,
+) (game.PanoramaMetadata, error) {
 	tx := r.txManager.GetQueryEngine(ctx)
 
-	ctx, span := r.tracer.Start(ctx, "RandomGoogleStreetview")
+	ctx, span := r.tracer.Start(ctx, "RandomPanoramaLocation")
 	defer span.End()
 
 	query := `
-		SELECT
-			id,
-			lat, 
-			lng
-		FROM panorama_location
-		WHERE provider = 'google'
-		ORDER BY RANDOM() 
+		WITH sample AS (
+			SELECT random() AS random_key
+		)
+		(
+			SELECT
+				id,
+				COALESCE(streetview_id, '') AS streetview_id,
+				lat,
+				lng,
+				COALESCE(country_code, '') AS country_code
+			FROM panorama_location
+			WHERE provider = @provider AND NOT imported
+				AND random_key >= (SELECT random_key FROM sample)
+				AND id <> ALL(COALESCE(@exclude::BIGINT[], '{}'))
+			ORDER BY random_key
+			LIMIT 1
+		)
+		UNION ALL
+		(
+			SELECT
+				id,
+				COALESCE(streetview_id, '') AS streetview_id,
+				lat,
+				lng,
+				COALESCE(country_code, '') AS country_code
+			FROM panorama_location
+			WHERE provider = @provider AND NOT imported
+				AND random_key < (SELECT random_key FROM sample)
+				AND id <> ALL(COALESCE(@exclude::BIGINT[], '{}'))
+			ORDER BY random_key
+			LIMIT 1
+		)
 		LIMIT 1
 	`
 
-	var stv game.GoogleStreetview
+	var loc game.PanoramaMetadata
 
-	err := pgxscan.Get(ctx, tx, &stv, query)
-	if err != nil {
-		return game.GoogleStreetview{}, fmt.Errorf("failed to get random google streetview: %w", err)
+	err := pgxscan.Get(ctx, tx, &loc, query, pgx.NamedArgs{
+		"provider": provider,
+		"exclude":  exclude,
+	})
+	if pgxscan.NotFound(err) {
+		return game.PanoramaMetadata{}, game.ErrPanoramaNotFound
+	} else if err != nil {
+		return game.PanoramaMetadata{}, fmt.Errorf("failed to get random location: %w", err)
 	}
 
-	return stv, nil
+	return loc, nil
 }
 
-// GetGoogleStreetview gets a Google streetview by id from the database.
-func (r *Repository) GetGoogleStreetview(ctx context.Context, id int) (game.GoogleStreetview, error) {
+// GetPanoramaLocation gets a provider location by id from the database.
+func (r *Repository) GetPanoramaLocation(
+	ctx context.Context,
+	provider game.PanoramaProvider,
+	id int,
+) (game.PanoramaMetadata, error) {
 	tx := r.txManager.GetQueryEngine(ctx)
 
-	ctx, span := r.tracer.Start(ctx, "GetGoogleStreetview")
+	ctx, span := r.tracer.Start(ctx, "GetPanoramaLocation")
 	defer span.End()
 
 	query := `
-		SELECT 
+		SELECT
 			id,
-			lat, 
-			lng
+			COALESCE(streetview_id, '') AS streetview_id,
+			lat,
+			lng,
+			COALESCE(country_code, '') AS country_code
 		FROM panorama_location
-		WHERE provider = 'google' AND id = @location_id
+		WHERE provider = @provider AND id = @id
 	`
 
-	var stv game.GoogleStreetview
+	var loc game.PanoramaMetadata
 
-	err := pgxscan.Get(ctx, tx, &stv, query, pgx.NamedArgs{"location_id": id})
-	if err != nil {
-		return game.GoogleStreetview{}, fmt.Errorf("failed to get streetview by id: %w", err)
+	err := pgxscan.Get(ctx, tx, &loc, query, pgx.NamedArgs{
+		"provider": provider,
+		"id":       id,
+	})
+	if pgxscan.NotFound(err) {
+		return game.PanoramaMetadata{}, game.ErrPanoramaNotFound
+	} else if err != nil {
+		return game.PanoramaMetadata{}, fmt.Errorf("failed to get panorama location by id: %w", err)
 	}
 
-	return stv, nil
+	return loc, nil
 }
 
-// RandomYandexAirview gets a random Yandex air view from the database.
-func (r *Repository) RandomYandexAirview(ctx context.Context) (game.YandexAirview, error) {
+// SetPanoramaStreetviewID saves streetview ID of a panorama location, that was found by its coordinates.
+func (r *Repository) SetPanoramaStreetviewID(ctx context.Context, id int, streetviewID string) error {
 	tx := r.txManager.GetQueryEngine(ctx)
 
-	ctx, span := r.tracer.Start(ctx, "RandomYandexAirview")
+	ctx, span := r.tracer.Start(ctx, "SetPanoramaStreetviewID")
 	defer span.End()
 
 	query := `
-		SELECT 
-			id,
-			COALESCE(streetview_id, '') AS streetview_id,
-			lat, 
-			lng
-		FROM panorama_location
-		WHERE provider = 'yandex_air'
-		ORDER BY random() 
-		LIMIT 1
+		UPDATE panorama_location
+		SET streetview_id = @streetview_id
+		WHERE id = @id
 	`
 
-	var airv game.YandexAirview
-
-	err := pgxscan.Get(ctx, tx, &airv, query)
+	tag, err := tx.Exec(ctx, query, pgx.NamedArgs{
+		"id":            id,
+		"streetview_id": streetviewID,
+	})
 	if err != nil {
-		return game.YandexAirview{}, fmt.Errorf("failed to get random air view: %w", err)
+		return fmt.Errorf("failed to set panorama streetview id: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return game.ErrPanoramaNotFound
 	}
 
-	return airv, nil
+	return nil
 }
 
-// GetYandexAirview gets a Yandex air view by id from the database.
-func (r *Repository) GetYandexAirview(ctx context.Context, id int) (game.YandexAirview, error) {
+// CountPanoramaLocations returns the amount of panorama locations of a provider, excluding imported ones.
+func (r *Repository) CountPanoramaLocations(ctx context.Context, provider game.PanoramaProvider) (int, error) {
 	tx := r.txManager.GetQueryEngine(ctx)
 
-	ctx, span := r.tracer.Start(ctx, "GetYandexAirview")
+	ctx, span := r.tracer.Start(ctx, "CountPanoramaLocations")
 	defer span.End()
 
 	query := `
-		SELECT 
-			id,
-			COALESCE(streetview_id, '') AS streetview_id,
-			lat, 
-			lng
+		SELECT COUNT(*)
 		FROM panorama_location
-		WHERE provider = 'yandex_air' AND id = @location_id
+		WHERE provider = @provider AND NOT imported
 	`
 
-	var airv game.YandexAirview
+	var count int
 
-	err := pgxscan.Get(ctx, tx, &airv, query, pgx.NamedArgs{"location_id": id})
+	err := pgxscan.Get(ctx, tx, &count, query, pgx.NamedArgs{"provider": provider})
 	if err != nil {
-		return game.YandexAirview{}, fmt.Errorf("failed to get air view by id: %w", err)
+		return 0, fmt.Errorf("failed to count panorama locations: %w", err)
 	}
 
-	return airv, nil
+	return count, nil
 }
 
-// RandomYandexStreetview gets a random Yandex streetview from the database.
-func (r *Repository) RandomYandexStreetview(ctx context.Context) (game.YandexStreetview, error) {
+// GetPanoramaLocationIDs returns IDs of provider panorama locations at provided offsets,
+// when locations are ordered by ID. IDs are returned in the order of offsets.
+func (r *Repository) GetPanoramaLocationIDs(
+	ctx context.Context,
+	provider game.PanoramaProvider,
+	offsets []int,
+) ([]int, error) {
 	tx := r.txManager.GetQueryEngine(ctx)
 
-	ctx, span := r.tracer.Start(ctx, "RandomYandexStreetview")
+	ctx, span := r.tracer.Start(ctx, "GetPanoramaLocationIDs")
 	defer span.End()
 
 	query := `
-		SELECT 
-			id,
-			lat, 
-			lng
-		FROM panorama_location AS pl
-		WHERE provider = 'yandex'
-		ORDER BY random() 
-		LIMIT 1
+		SELECT pl.id
+		FROM UNNEST(@offsets::BIGINT[]) WITH ORDINALITY AS o(row_offset, ord)
+		JOIN (
+			SELECT
+				id,
+				ROW_NUMBER() OVER (ORDER BY id) - 1 AS row_offset
+			FROM panorama_location
+			WHERE provider = @provider AND NOT imported
+		) AS pl
+			ON pl.row_offset = o.row_offset
+		ORDER BY o.ord
 	`
 
-	var stv game.YandexStreetview
+	var ids []int
 
-	err := pgxscan.Get(ctx, tx, &stv, query)
+	err := pgxscan.Select(ctx, tx, &ids, query, pgx.NamedArgs{
+		"provider": provider,
+		"offsets":  offsets,
+	})
 	if err != nil {
-		return game.YandexStreetview{}, fmt.Errorf("failed to get random streetview: %w", err)
+		return nil, fmt.Errorf("failed to get panorama location ids: %w", err)
 	}
 
-	return stv, nil
+	return ids, nil
 }
 
-// GetYandexStreetview gets a Yandex streetview by id from the database.
-func (r *Repository) GetYandexStreetview(ctx context.Context, id int) (game.YandexStreetview, error) {
+// GetPanoramaLocationBounds returns the bounding box of panorama locations of providers, excluding imported ones.
+// Bounds of every provider are precalculated by data migrations.
+// Returns game.ErrPanoramaNotFound if providers have no locations.
+func (r *Repository) GetPanoramaLocationBounds(
+	ctx context.Context,
+	providers []game.PanoramaProvider,
+) (game.Bounds, error) {
 	tx := r.txManager.GetQueryEngine(ctx)
 
-	ctx, span := r.tracer.Start(ctx, "GetYandexStreetview")
+	ctx, span := r.tracer.Start(ctx, "GetPanoramaLocationBounds")
 	defer span.End()
 
 	query := `
 		SELECT
-			id,
-			lat, 
-			lng
-		FROM panorama_location AS pl
-		WHERE provider = 'yandex' AND id = @id
+			MIN(min_lat) AS min_lat,
+			MIN(min_lng) AS min_lng,
+			MAX(max_lat) AS max_lat,
+			MAX(max_lng) AS max_lng
+		FROM panorama_provider_bounds
+		WHERE provider = ANY(@providers::VARCHAR[])
+		HAVING COUNT(*) > 0
 	`
 
-	var stv game.YandexStreetview
+	var bounds game.Bounds
 
-	err := pgxscan.Get(ctx, tx, &stv, query, pgx.NamedArgs{"id": id})
-	if err != nil {
-		return game.YandexStreetview{}, fmt.Errorf("failed to get streetview by id: %w", err)
+	err := pgxscan.Get(ctx, tx, &bounds, query, pgx.NamedArgs{"providers": providers})
+	if pgxscan.NotFound(err) {
+		return game.Bounds{}, game.ErrPanoramaNotFound
+	} else if err != nil {
+		return game.Bounds{}, fmt.Errorf("failed to get panorama location bounds: %w", err)
 	}
 
-	return stv, nil
+	return bounds, nil
 }
 
-// RandomSeznamStreetview gets a random Seznam streetview from the database.
-func (r *Repository) RandomSeznamStreetview(ctx context.Context) (game.SeznamStreetview, error) {
+// GetMapLocationBounds returns the bounding box of locations of a map, which is saved when locations are added.
+// Returns game.ErrPanoramaNotFound if the map has no locations.
+func (r *Repository) GetMapLocationBounds(ctx context.Context, mapID int) (game.Bounds, error) {
 	tx := r.txManager.GetQueryEngine(ctx)
 
-	ctx, span := r.tracer.Start(ctx, "RandomSeznamStreetview")
+	ctx, span := r.tracer.Start(ctx, "GetMapLocationBounds")
 	defer span.End()
 
 	query := `
-		SELECT 
-			id,
-			lat, 
-			lng
-		FROM panorama_location
-		WHERE provider = 'seznam'
-		ORDER BY random() 
+		SELECT min_lat, min_lng, max_lat, max_lng
+		FROM game_map
+		WHERE id = @map_id AND min_lat IS NOT NULL
+	`
+
+	var bounds game.Bounds
+
+	err := pgxscan.Get(ctx, tx, &bounds, query, pgx.NamedArgs{"map_id": mapID})
+	if pgxscan.NotFound(err) {
+		return game.Bounds{}, game.ErrPanoramaNotFound
+	} else if err != nil {
+		return game.Bounds{}, fmt.Errorf("failed to get map location bounds: %w", err)
+	}
+
+	return bounds, nil
+}
+
+// RandomMapStreetview gets a random location of a map from the database.
+func (r *Repository) RandomMapStreetview(
+	ctx context.Context,
+	mapID int,
+	exclude []int,
+) (game.PanoramaMetadata, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "RandomMapStreetview")
+	defer span.End()
+
+	// maps are small enough to sort their locations by random key, see RandomPanoramaLocation
+	query := `
+		WITH sample AS (
+			SELECT random() AS random_key
+		), map_location AS (
+			SELECT
+				pl.id,
+				COALESCE(pl.streetview_id, '') AS streetview_id,
+				pl.provider,
+				pl.lat,
+				pl.lng,
+				COALESCE(pl.country_code, '') AS country_code,
+				pl.random_key
+			FROM game_map_location AS gml
+			JOIN panorama_location AS pl
+				ON pl.id = gml.location_id
+			WHERE gml.map_id = @map_id
+				AND pl.id <> ALL(COALESCE(@exclude::BIGINT[], '{}'))
+		)
+		SELECT id, streetview_id, provider, lat, lng, country_code
+		FROM map_location
+		ORDER BY random_key < (SELECT random_key FROM sample), random_key
 		LIMIT 1
 	`
 
-	var stv game.SeznamStreetview
+	var stv game.PanoramaMetadata
 
-	err := pgxscan.Get(ctx, tx, &stv, query)
-	if err != nil {
-		return stv, fmt.Errorf("failed to get random streetview point: %w", err)
+	err := pgxscan.Get(ctx, tx, &stv, query, pgx.NamedArgs{
+		"map_id":  mapID,
+		"exclude": exclude,
+	})
+	if pgxscan.NotFound(err) {
+		return game.PanoramaMetadata{}, game.ErrPanoramaNotFound
+	} else if err != nil {
+		return game.PanoramaMetadata{}, fmt.Errorf("failed to get random map streetview: %w", err)
 	}
 
 	return stv, nil
 }
 
-// GetSeznamStreetview gets a Seznam streetview by id from the database.
-func (r *Repository) GetSeznamStreetview(ctx context.Context, id int) (game.SeznamStreetview, error) {
+// GetRecentLocationIDs returns IDs of locations played in the last games of provided users.
+// Both singleplayer and multiplayer games are counted, the last games are chosen for every user separately.
+func (r *Repository) GetRecentLocationIDs(ctx context.Context, userIDs []int, games int) ([]int, error) {
 	tx := r.txManager.GetQueryEngine(ctx)
 
-	ctx, span := r.tracer.Start(ctx, "GetSeznamStreetview")
+	ctx, span := r.tracer.Start(ctx, "GetRecentLocationIDs")
 	defer span.End()
 
 	query := `
-		SELECT 
-			id,
-			lat, 
-			lng
-		FROM panorama_location
-		WHERE provider = 'seznam' AND id = @id
+		WITH user_game AS (
+			SELECT
+				sg.user_id,
+				sg.id AS game_id,
+				FALSE AS multiplayer,
+				sg.created_at
+			FROM singleplayer_game AS sg
+			WHERE sg.user_id = ANY(@user_ids::BIGINT[])
+			UNION ALL
+			SELECT
+				mgu.user_id,
+				mgu.game_id,
+				TRUE AS multiplayer,
+				mg.created_at
+			FROM multiplayer_game_user AS mgu
+			JOIN multiplayer_game AS mg
+				ON mg.id = mgu.game_id
+			WHERE mgu.user_id = ANY(@user_ids::BIGINT[])
+		), recent_game AS (
+			SELECT DISTINCT game_id, multiplayer
+			FROM (
+				SELECT
+					game_id,
+					multiplayer,
+					ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY created_at DESC) AS game_num
+				FROM user_game
+			) AS ug
+			WHERE game_num <= @games
+		)
+		SELECT sr.location_id
+		FROM singleplayer_round AS sr
+		JOIN recent_game AS rg
+			ON rg.game_id = sr.game_id AND NOT rg.multiplayer
+		UNION
+		SELECT mr.location_id
+		FROM multiplayer_round AS mr
+		JOIN recent_game AS rg
+			ON rg.game_id = mr.game_id AND rg.multiplayer
 	`
 
-	var stv game.SeznamStreetview
+	var ids []int
 
-	err := pgxscan.Get(ctx, tx, &stv, query, pgx.NamedArgs{"id": id})
+	err := pgxscan.Select(ctx, tx, &ids, query, pgx.NamedArgs{
+		"user_ids": userIDs,
+		"games":    games,
+	})
 	if err != nil {
-		return stv, fmt.Errorf("failed to get streetview point by id: %w", err)
+		return nil, fmt.Errorf("failed to get recent location ids: %w", err)
 	}
 
-	return stv, nil
+	return ids, nil
 }
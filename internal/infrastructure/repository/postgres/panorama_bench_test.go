@@ -0,0 +1,88 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	postgresRepo "github.com/VasySS/segoya-backend/internal/infrastructure/repository/postgres"
+	"github.com/VasySS/segoya-backend/migrations/tables"
+	"github.com/VasySS/segoya-backend/tests/containers"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+// BenchmarkRandomLocation compares random location sampling with sorting all locations by RANDOM()
+// on a growing amount of locations. Sampling time should not depend on the amount of locations.
+func BenchmarkRandomLocation(b *testing.B) {
+	ctx := context.Background()
+
+	postgresContainer, err := containers.NewPostgresContainer(ctx)
+	require.NoError(b, err)
+
+	b.Cleanup(func() {
+		require.NoError(b, postgresContainer.Terminate(ctx))
+	})
+
+	pool, err := pgxpool.New(ctx, postgresContainer.ConnectionString)
+	require.NoError(b, err)
+
+	b.Cleanup(pool.Close)
+
+	err = tables.RunGooseMigrations(ctx, pool, "up")
+	require.NoError(b, err)
+
+	repo := postgresRepo.New(postgresRepo.NewTxManager(pool))
+
+	seeded := 0
+
+	for _, size := range []int{10_000, 100_000, 1_000_000} {
+		// every size is seeded on top of the previous one
+		_, err := pool.Exec(ctx, `
+			INSERT INTO panorama_location (provider, lat, lng)
+			SELECT 'google', random() * 180 - 90, random() * 360 - 180
+			FROM generate_series(1, $1)
+		`, size-seeded)
+		require.NoError(b, err)
+
+		_, err = pool.Exec(ctx, "ANALYZE panorama_location")
+		require.NoError(b, err)
+
+		seeded = size
+
+		b.Run(fmt.Sprintf("sampler/%d", size), func(b *testing.B) {
+			for b.Loop() {
+				_, err := repo.RandomPanoramaLocation(ctx, game.GoogleProvider, nil)
+				require.NoError(b, err)
+			}
+		})
+
+		b.Run(fmt.Sprintf("sampler_with_exclude/%d", size), func(b *testing.B) {
+			exclude := make([]int, 100)
+			for i := range exclude {
+				exclude[i] = i + 1
+			}
+
+			for b.Loop() {
+				_, err := repo.RandomPanoramaLocation(ctx, game.GoogleProvider, exclude)
+				require.NoError(b, err)
+			}
+		})
+
+		b.Run(fmt.Sprintf("order_by_random/%d", size), func(b *testing.B) {
+			query := `
+				SELECT id
+				FROM panorama_location
+				WHERE provider = 'google' AND NOT imported
+				ORDER BY RANDOM()
+				LIMIT 1
+			`
+
+			for b.Loop() {
+				var id int
+				require.NoError(b, pool.QueryRow(ctx, query).Scan(&id))
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/VasySS/segoya-backend/internal/entity/game"
 	postgresRepo "github.com/VasySS/segoya-backend/internal/infrastructure/repository/postgres"
 	"github.com/VasySS/segoya-backend/migrations/data"
 	"github.com/VasySS/segoya-backend/migrations/tables"
@@ -69,86 +70,107 @@ func (s *PanoramaTestSuite) SetupTest() {
 	s.postgresRepo = repo
 }
 
-func (s *PanoramaTestSuite) TestRandomGoogleStreetview() {
-	panorama, err := s.postgresRepo.RandomGoogleStreetview(s.ctx)
+func (s *PanoramaTestSuite) TestRandomPanoramaLocation() {
+	providers := []game.PanoramaProvider{
+		game.GoogleProvider,
+		game.YandexProvider,
+		game.YandexAirProvider,
+		game.SeznamProvider,
+	}
+
+	for _, provider := range providers {
+		panorama, err := s.postgresRepo.RandomPanoramaLocation(s.ctx, provider, nil)
+		s.Require().NoError(err)
+
+		s.NotEmpty(panorama.ID)
+		s.NotEmpty(panorama.Lat)
+		s.NotEmpty(panorama.Lng)
+
+		if provider == game.YandexAirProvider {
+			s.NotEmpty(panorama.StreetviewID)
+		}
+	}
+}
+
+func (s *PanoramaTestSuite) TestRandomPanoramaLocationExclude() {
+	original, err := s.postgresRepo.RandomPanoramaLocation(s.ctx, game.GoogleProvider, nil)
 	s.Require().NoError(err)
 
-	s.NotEmpty(panorama.ID)
-	s.NotEmpty(panorama.Lat)
-	s.NotEmpty(panorama.Lng)
+	for range 10 {
+		panorama, err := s.postgresRepo.RandomPanoramaLocation(s.ctx, game.GoogleProvider, []int{original.ID})
+		s.Require().NoError(err)
+		s.NotEqual(original.ID, panorama.ID)
+	}
 }
 
-func (s *PanoramaTestSuite) TestGoogleStreetviewByID() {
-	original, err := s.postgresRepo.RandomGoogleStreetview(s.ctx)
+func (s *PanoramaTestSuite) TestGetPanoramaLocation() {
+	original, err := s.postgresRepo.RandomPanoramaLocation(s.ctx, game.YandexAirProvider, nil)
 	s.Require().NoError(err)
 
-	fetched, err := s.postgresRepo.GetGoogleStreetview(s.ctx, original.ID)
+	fetched, err := s.postgresRepo.GetPanoramaLocation(s.ctx, game.YandexAirProvider, original.ID)
 	s.Require().NoError(err)
 
-	s.InEpsilon(original.ID, fetched.ID, 0.01)
-	s.InEpsilon(original.Lat, fetched.Lat, 0.01)
-	s.InEpsilon(original.Lng, fetched.Lng, 0.01)
-}
-
-func (s *PanoramaTestSuite) TestRandomYandexStreetview() {
-	panorama, err := s.postgresRepo.RandomYandexStreetview(s.ctx)
-	s.Require().NoError(err)
+	s.Equal(original.ID, fetched.ID)
+	s.Equal(original.StreetviewID, fetched.StreetviewID)
+	s.InDelta(original.Lat, fetched.Lat, 0.01)
+	s.InDelta(original.Lng, fetched.Lng, 0.01)
 
-	s.NotEmpty(panorama.ID)
-	s.NotEmpty(panorama.Lat)
-	s.NotEmpty(panorama.Lng)
+	// location belongs to another provider
+	_, err = s.postgresRepo.GetPanoramaLocation(s.ctx, game.GoogleProvider, original.ID)
+	s.ErrorIs(err, game.ErrPanoramaNotFound)
 }
 
-func (s *PanoramaTestSuite) TestYandexStreetviewByID() {
-	original, err := s.postgresRepo.RandomYandexStreetview(s.ctx)
+func (s *PanoramaTestSuite) TestSetPanoramaStreetviewID() {
+	original, err := s.postgresRepo.RandomPanoramaLocation(s.ctx, game.SeznamProvider, nil)
 	s.Require().NoError(err)
 
-	fetched, err := s.postgresRepo.GetYandexStreetview(s.ctx, original.ID)
+	err = s.postgresRepo.SetPanoramaStreetviewID(s.ctx, original.ID, "located_streetview_id")
 	s.Require().NoError(err)
 
-	s.InEpsilon(original.Lat, fetched.Lat, 0.01)
-	s.InEpsilon(original.Lng, fetched.Lng, 0.01)
-}
-
-func (s *PanoramaTestSuite) TestRandomYandexAirview() {
-	panorama, err := s.postgresRepo.RandomYandexAirview(s.ctx)
+	fetched, err := s.postgresRepo.GetPanoramaLocation(s.ctx, game.SeznamProvider, original.ID)
 	s.Require().NoError(err)
+	s.Equal("located_streetview_id", fetched.StreetviewID)
 
-	s.NotEmpty(panorama.StreetviewID)
-	s.NotEmpty(panorama.Lat)
-	s.NotEmpty(panorama.Lng)
+	err = s.postgresRepo.SetPanoramaStreetviewID(s.ctx, -1, "located_streetview_id")
+	s.ErrorIs(err, game.ErrPanoramaNotFound)
 }
 
-func (s *PanoramaTestSuite) TestYandexAirviewByID() {
-	original, err := s.postgresRepo.RandomYandexAirview(s.ctx)
+func (s *PanoramaTestSuite) TestGetPanoramaLocationIDs() {
+	count, err := s.postgresRepo.CountPanoramaLocations(s.ctx, game.GoogleProvider)
 	s.Require().NoError(err)
+	s.Require().Greater(count, 2)
 
-	fetched, err := s.postgresRepo.GetYandexAirview(s.ctx, original.ID)
+	ids, err := s.postgresRepo.GetPanoramaLocationIDs(s.ctx, game.GoogleProvider, []int{2, 0, count - 1})
 	s.Require().NoError(err)
+	s.Require().Len(ids, 3)
 
-	s.Equal(original.ID, fetched.ID)
-	s.Equal(original.StreetviewID, fetched.StreetviewID)
-	s.InEpsilon(original.Lat, fetched.Lat, 0.01)
-	s.InEpsilon(original.Lng, fetched.Lng, 0.01)
-}
+	// ids are returned in order of offsets
+	s.Greater(ids[0], ids[1])
+	s.Greater(ids[2], ids[0])
 
-func (s *PanoramaTestSuite) TestRandomSeznamStreetview() {
-	panorama, err := s.postgresRepo.RandomSeznamStreetview(s.ctx)
-	s.Require().NoError(err)
-
-	s.NotEmpty(panorama.ID)
-	s.NotEmpty(panorama.Lat)
-	s.NotEmpty(panorama.Lng)
+	for _, id := range ids {
+		_, err := s.postgresRepo.GetPanoramaLocation(s.ctx, game.GoogleProvider, id)
+		s.Require().NoError(err)
+	}
 }
 
-func (s *PanoramaTestSuite) TestSeznamStreetviewByID() {
-	original, err := s.postgresRepo.RandomSeznamStreetview(s.ctx)
+func (s *PanoramaTestSuite) TestGetPanoramaLocationBounds() {
+	seznam, err := s.postgresRepo.GetPanoramaLocationBounds(s.ctx, []game.PanoramaProvider{game.SeznamProvider})
 	s.Require().NoError(err)
+	s.Less(seznam.MinLat, seznam.MaxLat)
+	s.Less(seznam.MinLng, seznam.MaxLng)
 
-	fetched, err := s.postgresRepo.GetSeznamStreetview(s.ctx, original.ID)
+	// bounds of several providers contain bounds of every provider
+	mixed, err := s.postgresRepo.GetPanoramaLocationBounds(s.ctx, []game.PanoramaProvider{
+		game.GoogleProvider,
+		game.SeznamProvider,
+	})
 	s.Require().NoError(err)
+	s.LessOrEqual(mixed.MinLat, seznam.MinLat)
+	s.LessOrEqual(mixed.MinLng, seznam.MinLng)
+	s.GreaterOrEqual(mixed.MaxLat, seznam.MaxLat)
+	s.GreaterOrEqual(mixed.MaxLng, seznam.MaxLng)
 
-	s.Equal(original.ID, fetched.ID)
-	s.InDelta(original.Lat, fetched.Lat, 0.01)
-	s.InDelta(original.Lng, fetched.Lng, 0.01)
+	_, err = s.postgresRepo.GetPanoramaLocationBounds(s.ctx, []game.PanoramaProvider{"unknown"})
+	s.ErrorIs(err, game.ErrPanoramaNotFound)
 }
@@ -2,12 +2,14 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/VasySS/segoya-backend/internal/dto"
 	"github.com/VasySS/segoya-backend/internal/entity/game/singleplayer"
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
 // LockSingleplayerGame locks singleplayer game by id exclusively.
@@ -40,22 +42,42 @@ func (r *Repository) NewSingleplayerGame(
 
 	query := `
 		INSERT INTO singleplayer_game
-		(user_id, rounds, movement_allowed, provider, created_at, timer_seconds)
-		VALUES (@user_id, @rounds, @movement_allowed, @provider, @created_at, @timer_seconds) 
+		(user_id, rounds, movement_allowed, provider, providers, score_distance, scoring, created_at,
+			timer_seconds, daily_challenge_id, challenge_id, map_id)
+		VALUES (@user_id, @rounds, @movement_allowed, @provider, COALESCE(@providers::VARCHAR[], '{}'),
+			NULLIF(@score_distance::DOUBLE PRECISION, 0), COALESCE(NULLIF(@scoring, ''), 'classic'),
+			@created_at, @timer_seconds, 
+			NULLIF(@daily_challenge_id, 0), NULLIF(@challenge_id, 0), NULLIF(@map_id, 0)) 
 		RETURNING id
 	`
 
 	var gameID int
 
 	err := tx.QueryRow(ctx, query, pgx.NamedArgs{
-		"user_id":          req.UserID,
-		"rounds":           req.Rounds,
-		"movement_allowed": req.MovementAllowed,
-		"provider":         req.Provider,
-		"created_at":       req.RequestTime,
-		"timer_seconds":    req.TimerSeconds,
+		"user_id":            req.UserID,
+		"rounds":             req.Rounds,
+		"movement_allowed":   req.MovementAllowed,
+		"provider":           req.Provider,
+		"providers":          req.Providers,
+		"score_distance":     req.ScoreDistance,
+		"scoring":            req.Scoring,
+		"created_at":         req.RequestTime,
+		"timer_seconds":      req.TimerSeconds,
+		"daily_challenge_id": req.DailyChallengeID,
+		"challenge_id":       req.ChallengeID,
+		"map_id":             req.MapID,
 	}).Scan(&gameID)
 	if err != nil {
+		// every daily challenge and challenge can be played by user only once
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			if pgErr.ConstraintName == "singleplayer_game_challenge_id_user_id_idx" {
+				return -1, singleplayer.ErrChallengeAlreadyPlayed
+			}
+
+			return -1, singleplayer.ErrDailyChallengeAlreadyPlayed
+		}
+
 		return -1, fmt.Errorf("failed to create singleplayer game: %w", err)
 	}
 
@@ -78,10 +100,16 @@ func (r *Repository) GetSingleplayerGame(ctx context.Context, gameID int) (singl
 			sg.timer_seconds,
 			sg.movement_allowed,
 			sg.provider,
+			sg.providers,
+			COALESCE(sg.score_distance, 0) AS score_distance,
+			sg.scoring,
 			COALESCE(SUM(srg.score), 0) AS score,
 			sg.finished,
 			sg.created_at,
-			COALESCE(sg.ended_at, '0001-01-01 00:00:00') AS ended_at
+			COALESCE(sg.ended_at, '0001-01-01 00:00:00') AS ended_at,
+			COALESCE(sg.daily_challenge_id, 0) AS daily_challenge_id,
+			COALESCE(sg.challenge_id, 0) AS challenge_id,
+			COALESCE(sg.map_id, 0) AS map_id
 		FROM singleplayer_game AS sg
 		LEFT JOIN singleplayer_round AS sr
 			ON sr.game_id = sg.id
@@ -138,10 +166,16 @@ func (r *Repository) GetSingleplayerGames(
 			sg.timer_seconds,
 			sg.movement_allowed,
 			sg.provider,
+			sg.providers,
+			COALESCE(sg.score_distance, 0) AS score_distance,
+			sg.scoring,
 			COALESCE(SUM(srg.score), 0) AS score,
 			sg.finished,
 			sg.created_at,
-			COALESCE(sg.ended_at, '0001-01-01 00:00:00') AS ended_at
+			COALESCE(sg.ended_at, '0001-01-01 00:00:00') AS ended_at,
+			COALESCE(sg.daily_challenge_id, 0) AS daily_challenge_id,
+			COALESCE(sg.challenge_id, 0) AS challenge_id,
+			COALESCE(sg.map_id, 0) AS map_id
 		FROM singleplayer_game AS sg
 		LEFT JOIN singleplayer_round AS sr
 			ON sr.game_id = sg.id
@@ -207,8 +241,8 @@ func (r *Repository) NewSingleplayerRound(
 
 	roundQuery := `
 		INSERT INTO singleplayer_round
-		(game_id, location_id, created_at, started_at, round_num) 
-		VALUES (@game_id, @location_id, @created_at, @started_at, @round_num)
+		(game_id, location_id, provider, created_at, started_at, round_num) 
+		VALUES (@game_id, @location_id, @provider, @created_at, @started_at, @round_num)
 		ON CONFLICT (game_id, round_num) DO NOTHING
 		RETURNING id
 	`
@@ -218,6 +252,7 @@ func (r *Repository) NewSingleplayerRound(
 	err := pgxscan.Get(ctx, tx, &roundID, roundQuery, pgx.NamedArgs{
 		"game_id":     req.GameID,
 		"location_id": req.LocationID,
+		"provider":    req.Provider,
 		"created_at":  req.CreatedAt,
 		"started_at":  req.StartedAt,
 		"round_num":   req.RoundNum,
@@ -246,6 +281,7 @@ func (r *Repository) GetSingleplayerRound(ctx context.Context, gameID, roundNum
 			sr.id, 
 			sr.game_id, 
 			COALESCE(pl.streetview_id, '') AS streetview_id,
+			sr.provider,
 			pl.lat,
 			pl.lng,
 			sr.round_num, 
@@ -286,6 +322,7 @@ func (r *Repository) GetSingleplayerRoundByID(ctx context.Context, roundID int)
 			sr.id,
 			sr.game_id,
 			COALESCE(pl.streetview_id, '') AS streetview_id,
+			sr.provider,
 			pl.lat,
 			pl.lng,
 			sr.round_num,
@@ -327,7 +364,11 @@ func (r *Repository) GetSingleplayerGameGuesses(
 			srg.lat AS guess_lat, 
 			srg.lng AS guess_lng, 
 			srg.score, 
-			srg.distance_miss_meters AS miss_distance
+			srg.score_bonus,
+			srg.score_penalty,
+			srg.distance_miss_meters AS miss_distance,
+			COALESCE(pl.country_code, '') AS round_country,
+			COALESCE(srg.country_code, '') AS guess_country
 		FROM singleplayer_round AS sr 
 		JOIN panorama_location AS pl 
 			ON pl.id = sr.location_id
@@ -363,8 +404,11 @@ func (r *Repository) NewSingleplayerRoundGuess(
 		WITH 
 		insert_guess AS (
 			INSERT INTO singleplayer_round_guess
-			(round_id, created_at, lat, lng, score, distance_miss_meters)
-			VALUES (@round_id, @created_at, @lat, @lng, @score, @distance)
+			(round_id, created_at, lat, lng, country_code, score, score_bonus, score_penalty, distance_miss_meters)
+			VALUES (
+				@round_id, @created_at, @lat, @lng, NULLIF(@country_code, ''),
+				@score, @score_bonus, @score_penalty, @distance
+			)
 		)
 		
 		UPDATE singleplayer_round
@@ -375,13 +419,16 @@ func (r *Repository) NewSingleplayerRoundGuess(
 	`
 
 	_, err := tx.Exec(ctx, combinedQuery, pgx.NamedArgs{
-		"round_id":   req.RoundID,
-		"lat":        req.Guess.Lat,
-		"lng":        req.Guess.Lng,
-		"score":      req.Score,
-		"distance":   req.Distance,
-		"created_at": req.RequestTime,
-		"ended_at":   req.RequestTime,
+		"round_id":      req.RoundID,
+		"lat":           req.Guess.Lat,
+		"lng":           req.Guess.Lng,
+		"country_code":  req.CountryCode,
+		"score":         req.Score,
+		"score_bonus":   req.ScoreBonus,
+		"score_penalty": req.ScorePenalty,
+		"distance":      req.Distance,
+		"created_at":    req.RequestTime,
+		"ended_at":      req.RequestTime,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to set singleplayer guess and update round: %w", err)
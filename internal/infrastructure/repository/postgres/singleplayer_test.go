@@ -119,6 +119,7 @@ func (s *SingleplayerTestSuite) newTestRound(
 		StartedAt:  time.Now().UTC().Add(time.Second * 10),
 		GameID:     gameID,
 		LocationID: gofakeit.Number(1, 100),
+		Provider:   game.PanoramaProvider(gofakeit.RandomString([]string{"seznam", "yandex", "yandex_air", "google"})),
 		RoundNum:   roundNum,
 	}
 
@@ -142,6 +143,35 @@ func (s *SingleplayerTestSuite) TestNewSingleplayerGame() {
 	s.WithinDuration(gameReq.RequestTime, game.CreatedAt, 5*time.Millisecond)
 }
 
+func (s *SingleplayerTestSuite) TestNewMixedProviderSingleplayerGame() {
+	newUser := s.newTestUser()
+
+	gameID, err := s.postgresRepo.NewSingleplayerGame(s.ctx, dto.NewSingleplayerGameRequest{
+		RequestTime:   time.Now().UTC(),
+		UserID:        newUser.ID,
+		Rounds:        5,
+		Provider:      string(game.MixedProvider),
+		Providers:     []game.PanoramaProvider{game.GoogleProvider, game.SeznamProvider},
+		ScoreDistance: 321.5,
+		Scoring:       game.TimedScoring,
+	})
+	s.Require().NoError(err)
+
+	mixedGame, err := s.postgresRepo.GetSingleplayerGame(s.ctx, gameID)
+	s.Require().NoError(err)
+	s.Equal(game.MixedProvider, mixedGame.Provider)
+	s.Equal([]game.PanoramaProvider{game.GoogleProvider, game.SeznamProvider}, mixedGame.Providers)
+	s.InDelta(321.5, mixedGame.ScoreDistance, 0.001)
+	s.Equal(game.TimedScoring, mixedGame.Scoring)
+
+	// games without allowed providers can be played on all providers,
+	// games without score distance are scored by provider, games without scoring mode are classic
+	newTestGame, _ := s.newTestGame(newUser.ID)
+	s.Empty(newTestGame.Providers)
+	s.Zero(newTestGame.ScoreDistance)
+	s.Equal(game.ClassicScoring, newTestGame.Scoring)
+}
+
 func (s *SingleplayerTestSuite) TestSingleplayerGameByID() {
 	newUser := s.newTestUser()
 
@@ -207,6 +237,7 @@ func (s *SingleplayerTestSuite) TestNewSingleplayerRound() {
 
 	s.Equal(newRoundReq.GameID, newRound.GameID)
 	s.Equal(newRoundReq.RoundNum, newRound.RoundNum)
+	s.Equal(newRoundReq.Provider, newRound.Provider)
 	s.WithinDuration(newRoundReq.CreatedAt, newRound.CreatedAt, 5*time.Millisecond)
 	s.WithinDuration(newRoundReq.StartedAt, newRound.StartedAt, 5*time.Millisecond)
 }
@@ -266,6 +297,7 @@ func (s *SingleplayerTestSuite) TestSingleplayerRoundsWithGuesses() {
 			StartedAt:  time.Now().UTC().Add(time.Second * 10),
 			GameID:     newGame.ID,
 			LocationID: gofakeit.Number(1, 10),
+			Provider:   game.GoogleProvider,
 			RoundNum:   i,
 		}
 
@@ -280,8 +312,11 @@ func (s *SingleplayerTestSuite) TestSingleplayerRoundsWithGuesses() {
 				Lat: gofakeit.Latitude(),
 				Lng: gofakeit.Longitude(),
 			},
-			Score:    gofakeit.Number(0, 5000),
-			Distance: gofakeit.Number(0, 10000),
+			Score:        gofakeit.Number(0, 5000),
+			ScoreBonus:   gofakeit.Number(0, 1000),
+			ScorePenalty: gofakeit.Number(0, 1000),
+			Distance:     gofakeit.Number(0, 10000),
+			CountryCode:  gofakeit.CountryAbr(),
 		}
 
 		err = s.postgresRepo.NewSingleplayerRoundGuess(s.ctx, newRoundGuessReq)
@@ -299,7 +334,10 @@ func (s *SingleplayerTestSuite) TestSingleplayerRoundsWithGuesses() {
 			GuessLat:     newRoundGuessReq.Guess.Lat,
 			GuessLng:     newRoundGuessReq.Guess.Lng,
 			Score:        newRoundGuessReq.Score,
+			ScoreBonus:   newRoundGuessReq.ScoreBonus,
+			ScorePenalty: newRoundGuessReq.ScorePenalty,
 			MissDistance: newRoundGuessReq.Distance,
+			GuessCountry: newRoundGuessReq.CountryCode,
 		})
 	}
 
@@ -319,3 +357,216 @@ func (s *SingleplayerTestSuite) TestSingleplayerRoundsWithGuesses() {
 	s.Equal(gameScore, updatedGame.Score)
 	s.Equal(updatedGame.Rounds, updatedGame.RoundCurrent)
 }
+
+func (s *SingleplayerTestSuite) newTestDailyChallenge() singleplayer.DailyChallenge {
+	challengeReq := dto.NewDailyChallengeRequest{
+		RequestTime: time.Now().UTC(),
+		Date:        time.Date(2025, 1, gofakeit.Number(1, 31), 0, 0, 0, 0, time.UTC),
+		Provider:    game.GoogleProvider,
+		LocationIDs: []int{1, 2, 3, 4, 5},
+	}
+
+	err := s.postgresRepo.NewDailyChallenge(s.ctx, challengeReq)
+	s.Require().NoError(err)
+
+	challenge, err := s.postgresRepo.GetDailyChallenge(s.ctx, challengeReq.Date, challengeReq.Provider)
+	s.Require().NoError(err)
+
+	return challenge
+}
+
+func (s *SingleplayerTestSuite) TestDailyChallenge() {
+	_, err := s.postgresRepo.GetDailyChallengeByID(s.ctx, 111)
+	s.Require().ErrorIs(err, singleplayer.ErrDailyChallengeNotFound)
+
+	challenge := s.newTestDailyChallenge()
+	s.Equal([]int{1, 2, 3, 4, 5}, challenge.LocationIDs)
+
+	// challenge of the same date and provider is not overwritten
+	err = s.postgresRepo.NewDailyChallenge(s.ctx, dto.NewDailyChallengeRequest{
+		RequestTime: time.Now().UTC(),
+		Date:        challenge.Date,
+		Provider:    challenge.Provider,
+		LocationIDs: []int{6, 7, 8, 9, 10},
+	})
+	s.Require().NoError(err)
+
+	sameChallenge, err := s.postgresRepo.GetDailyChallengeByID(s.ctx, challenge.ID)
+	s.Require().NoError(err)
+	s.Equal(challenge, sameChallenge)
+}
+
+func (s *SingleplayerTestSuite) TestDailyChallengeCanBePlayedOnce() {
+	newUser := s.newTestUser()
+	challenge := s.newTestDailyChallenge()
+
+	gameReq := dto.NewSingleplayerGameRequest{
+		RequestTime:      time.Now().UTC(),
+		UserID:           newUser.ID,
+		Rounds:           len(challenge.LocationIDs),
+		TimerSeconds:     120,
+		Provider:         string(challenge.Provider),
+		DailyChallengeID: challenge.ID,
+	}
+
+	gameID, err := s.postgresRepo.NewSingleplayerGame(s.ctx, gameReq)
+	s.Require().NoError(err)
+
+	newGame, err := s.postgresRepo.GetSingleplayerGame(s.ctx, gameID)
+	s.Require().NoError(err)
+	s.Equal(challenge.ID, newGame.DailyChallengeID)
+
+	_, err = s.postgresRepo.NewSingleplayerGame(s.ctx, gameReq)
+	s.Require().ErrorIs(err, singleplayer.ErrDailyChallengeAlreadyPlayed)
+}
+
+func (s *SingleplayerTestSuite) TestDailyChallengeLeaderboard() {
+	challenge := s.newTestDailyChallenge()
+	scores := []int{3000, 5000, 3000}
+
+	for _, score := range scores {
+		newUser := s.newTestUser()
+
+		gameID, err := s.postgresRepo.NewSingleplayerGame(s.ctx, dto.NewSingleplayerGameRequest{
+			RequestTime:      time.Now().UTC(),
+			UserID:           newUser.ID,
+			Rounds:           1,
+			TimerSeconds:     120,
+			Provider:         string(challenge.Provider),
+			DailyChallengeID: challenge.ID,
+		})
+		s.Require().NoError(err)
+
+		round, _ := s.newTestRound(gameID, 1)
+
+		err = s.postgresRepo.NewSingleplayerRoundGuess(s.ctx, dto.NewSingleplayerRoundGuessRequest{
+			RequestTime: time.Now().UTC(),
+			RoundID:     round.ID,
+			GameID:      gameID,
+			Score:       score,
+		})
+		s.Require().NoError(err)
+
+		err = s.postgresRepo.EndSingleplayerGame(s.ctx, dto.EndSingleplayerGameRequestDB{
+			RequestTime: time.Now().UTC(),
+			GameID:      gameID,
+		})
+		s.Require().NoError(err)
+	}
+
+	// unfinished games are not included
+	unfinishedUser := s.newTestUser()
+	_, err := s.postgresRepo.NewSingleplayerGame(s.ctx, dto.NewSingleplayerGameRequest{
+		RequestTime:      time.Now().UTC(),
+		UserID:           unfinishedUser.ID,
+		Rounds:           1,
+		TimerSeconds:     120,
+		Provider:         string(challenge.Provider),
+		DailyChallengeID: challenge.ID,
+	})
+	s.Require().NoError(err)
+
+	results, total, err := s.postgresRepo.GetDailyChallengeLeaderboard(s.ctx, dto.GetDailyChallengeLeaderboardRequestDB{
+		ChallengeID: challenge.ID,
+		Page:        1,
+		PageSize:    10,
+	})
+	s.Require().NoError(err)
+
+	s.Equal(3, total)
+	s.Require().Len(results, 3)
+	s.Equal(1, results[0].Place)
+	s.Equal(5000, results[0].Score)
+	s.Equal(2, results[1].Place)
+	s.Equal(2, results[2].Place)
+}
+
+func (s *SingleplayerTestSuite) TestSingleplayerChallenge() {
+	creator := s.newTestUser()
+	sourceGame, _ := s.newTestGame(creator.ID)
+
+	_, firstRoundReq := s.newTestRound(sourceGame.ID, 1)
+	_, secondRoundReq := s.newTestRound(sourceGame.ID, 2)
+
+	locationIDs, err := s.postgresRepo.GetSingleplayerGameLocationIDs(s.ctx, sourceGame.ID)
+	s.Require().NoError(err)
+	s.Len(locationIDs, 2)
+
+	_, err = s.postgresRepo.GetSingleplayerChallenge(s.ctx, "unknown")
+	s.Require().ErrorIs(err, singleplayer.ErrChallengeNotFound)
+
+	challengeID, err := s.postgresRepo.NewSingleplayerChallenge(s.ctx, dto.NewSingleplayerChallengeRequestDB{
+		RequestTime: time.Now().UTC(),
+		Token:       gofakeit.LetterN(16),
+		GameID:      sourceGame.ID,
+		UserID:      creator.ID,
+		LocationIDs: locationIDs,
+	})
+	s.Require().NoError(err)
+
+	challenge, err := s.postgresRepo.GetSingleplayerChallengeByID(s.ctx, challengeID)
+	s.Require().NoError(err)
+	s.Equal(sourceGame.Rounds, challenge.Rounds)
+	s.Equal(sourceGame.TimerSeconds, challenge.TimerSeconds)
+	s.Equal(sourceGame.Provider, challenge.Provider)
+	s.Equal(locationIDs, challenge.LocationIDs)
+	s.Equal(
+		[]game.PanoramaProvider{firstRoundReq.Provider, secondRoundReq.Provider},
+		challenge.LocationProviders,
+	)
+
+	byToken, err := s.postgresRepo.GetSingleplayerChallenge(s.ctx, challenge.Token)
+	s.Require().NoError(err)
+	s.Equal(challenge, byToken)
+
+	byGame, err := s.postgresRepo.GetSingleplayerChallengeByGameID(s.ctx, sourceGame.ID)
+	s.Require().NoError(err)
+	s.Equal(challenge, byGame)
+
+	challenger := s.newTestUser()
+	challengeGameReq := dto.NewSingleplayerGameRequest{
+		RequestTime:  time.Now().UTC(),
+		UserID:       challenger.ID,
+		Rounds:       challenge.Rounds,
+		TimerSeconds: challenge.TimerSeconds,
+		Provider:     string(challenge.Provider),
+		ChallengeID:  challenge.ID,
+	}
+
+	challengeGameID, err := s.postgresRepo.NewSingleplayerGame(s.ctx, challengeGameReq)
+	s.Require().NoError(err)
+
+	_, err = s.postgresRepo.NewSingleplayerGame(s.ctx, challengeGameReq)
+	s.Require().ErrorIs(err, singleplayer.ErrChallengeAlreadyPlayed)
+
+	results, err := s.postgresRepo.GetSingleplayerChallengeResults(s.ctx, challenge.ID)
+	s.Require().NoError(err)
+	s.Require().Len(results, 2)
+	s.ElementsMatch(
+		[]int{sourceGame.ID, challengeGameID},
+		[]int{results[0].GameID, results[1].GameID},
+	)
+}
+
+func (s *SingleplayerTestSuite) TestGetRecentLocationIDs() {
+	testUser := s.newTestUser()
+
+	oldGame, _ := s.newTestGame(testUser.ID)
+	_, oldRound := s.newTestRound(oldGame.ID, 1)
+
+	newGame, _ := s.newTestGame(testUser.ID)
+	_, newRound := s.newTestRound(newGame.ID, 1)
+
+	locationIDs, err := s.postgresRepo.GetRecentLocationIDs(s.ctx, []int{testUser.ID}, 1)
+	s.Require().NoError(err)
+	s.Equal([]int{newRound.LocationID}, locationIDs)
+
+	locationIDs, err = s.postgresRepo.GetRecentLocationIDs(s.ctx, []int{testUser.ID}, 2)
+	s.Require().NoError(err)
+	s.Contains(locationIDs, oldRound.LocationID)
+	s.Contains(locationIDs, newRound.LocationID)
+
+	locationIDs, err = s.postgresRepo.GetRecentLocationIDs(s.ctx, []int{s.newTestUser().ID}, 2)
+	s.Require().NoError(err)
+	s.Empty(locationIDs)
+}
@@ -0,0 +1,338 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game/streak"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+)
+
+// LockStreakGame locks country streak game by id exclusively.
+func (r *Repository) LockStreakGame(ctx context.Context, gameID int) error {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	query := `
+		SELECT id
+		FROM streak_game
+		WHERE id = @game_id
+		FOR UPDATE
+	`
+
+	if _, err := tx.Exec(ctx, query, pgx.NamedArgs{"game_id": gameID}); err != nil {
+		return fmt.Errorf("failed to lock game: %w", err)
+	}
+
+	return nil
+}
+
+// NewStreakGame creates new country streak game.
+func (r *Repository) NewStreakGame(ctx context.Context, req dto.NewStreakGameRequest) (int, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "NewStreakGame")
+	defer span.End()
+
+	query := `
+		INSERT INTO streak_game
+		(user_id, provider, providers, movement_allowed, timer_seconds, created_at)
+		VALUES (@user_id, @provider, COALESCE(@providers::VARCHAR[], '{}'), @movement_allowed,
+			@timer_seconds, @created_at)
+		RETURNING id
+	`
+
+	var gameID int
+
+	err := tx.QueryRow(ctx, query, pgx.NamedArgs{
+		"user_id":          req.UserID,
+		"provider":         req.Provider,
+		"providers":        req.Providers,
+		"movement_allowed": req.MovementAllowed,
+		"timer_seconds":    req.TimerSeconds,
+		"created_at":       req.RequestTime,
+	}).Scan(&gameID)
+	if err != nil {
+		return -1, fmt.Errorf("failed to create streak game: %w", err)
+	}
+
+	return gameID, nil
+}
+
+// GetStreakGame returns country streak game by id.
+func (r *Repository) GetStreakGame(ctx context.Context, gameID int) (streak.Game, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "GetStreakGame")
+	defer span.End()
+
+	query := `
+		SELECT
+			sg.id,
+			sg.user_id,
+			COUNT(sr.id) AS round_current,
+			sg.timer_seconds,
+			sg.movement_allowed,
+			sg.provider,
+			sg.providers,
+			sg.streak,
+			sg.finished,
+			sg.created_at,
+			COALESCE(sg.ended_at, '0001-01-01 00:00:00') AS ended_at
+		FROM streak_game AS sg
+		LEFT JOIN streak_round AS sr
+			ON sr.game_id = sg.id
+		WHERE sg.id = @game_id
+		GROUP BY sg.id
+	`
+
+	var game streak.Game
+
+	err := pgxscan.Get(ctx, tx, &game, query, pgx.NamedArgs{"game_id": gameID})
+	if pgxscan.NotFound(err) {
+		return streak.Game{}, streak.ErrGameNotFound
+	} else if err != nil {
+		return streak.Game{}, fmt.Errorf("failed to get streak game: %w", err)
+	}
+
+	return game, nil
+}
+
+// GetStreakGameLocationIDs returns location IDs of country streak game rounds, ordered by round number.
+func (r *Repository) GetStreakGameLocationIDs(ctx context.Context, gameID int) ([]int, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "GetStreakGameLocationIDs")
+	defer span.End()
+
+	query := `
+		SELECT location_id
+		FROM streak_round
+		WHERE game_id = @game_id
+		ORDER BY round_num
+	`
+
+	var ids []int
+
+	err := pgxscan.Select(ctx, tx, &ids, query, pgx.NamedArgs{"game_id": gameID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get streak game location ids: %w", err)
+	}
+
+	return ids, nil
+}
+
+// NewStreakRound creates new country streak round.
+func (r *Repository) NewStreakRound(ctx context.Context, req dto.NewStreakRoundDBRequest) (streak.Round, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "NewStreakRound")
+	defer span.End()
+
+	query := `
+		INSERT INTO streak_round
+		(game_id, location_id, provider, round_num, country_code, created_at, started_at)
+		VALUES (@game_id, @location_id, @provider, @round_num, @country_code, @created_at, @started_at)
+		RETURNING round_num
+	`
+
+	var roundNum int
+
+	err := pgxscan.Get(ctx, tx, &roundNum, query, pgx.NamedArgs{
+		"game_id":      req.GameID,
+		"location_id":  req.LocationID,
+		"provider":     req.Provider,
+		"round_num":    req.RoundNum,
+		"country_code": req.CountryCode,
+		"created_at":   req.CreatedAt,
+		"started_at":   req.StartedAt,
+	})
+	if err != nil {
+		return streak.Round{}, fmt.Errorf("failed to create streak round: %w", err)
+	}
+
+	return r.GetStreakRound(ctx, req.GameID, roundNum)
+}
+
+// GetStreakRound returns a country streak round.
+func (r *Repository) GetStreakRound(ctx context.Context, gameID, roundNum int) (streak.Round, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "GetStreakRound")
+	defer span.End()
+
+	query := `
+		SELECT
+			sr.id,
+			sr.game_id,
+			COALESCE(pl.streetview_id, '') AS streetview_id,
+			sr.provider,
+			pl.lat,
+			pl.lng,
+			sr.country_code,
+			COALESCE(sr.guess_country_code, '') AS guess_country,
+			sr.correct,
+			sr.round_num,
+			sr.finished,
+			sr.created_at,
+			sr.started_at,
+			COALESCE(sr.ended_at, '0001-01-01 00:00:00') AS ended_at
+		FROM streak_round AS sr
+		JOIN panorama_location AS pl
+			ON pl.id = sr.location_id
+		WHERE sr.game_id = @game_id AND sr.round_num = @round_num
+	`
+
+	var round streak.Round
+
+	err := pgxscan.Get(ctx, tx, &round, query, pgx.NamedArgs{
+		"game_id":   gameID,
+		"round_num": roundNum,
+	})
+	if pgxscan.NotFound(err) {
+		return streak.Round{}, streak.ErrRoundNotFound
+	} else if err != nil {
+		return streak.Round{}, fmt.Errorf("failed to get streak round: %w", err)
+	}
+
+	return round, nil
+}
+
+// NewStreakRoundGuess saves a guess of country streak round and finishes the round.
+// Streak of the game is increased for a correct guess, otherwise the game is finished.
+func (r *Repository) NewStreakRoundGuess(ctx context.Context, req dto.NewStreakRoundGuessRequest) error {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "NewStreakRoundGuess")
+	defer span.End()
+
+	// Using CTE to update both round and game
+	combinedQuery := `
+		WITH
+		update_round AS (
+			UPDATE streak_round
+			SET
+				guess_country_code = NULLIF(@country_code, ''),
+				correct = @correct,
+				finished = true,
+				ended_at = @ended_at
+			WHERE id = @round_id
+		)
+
+		UPDATE streak_game
+		SET
+			streak = streak + CASE WHEN @correct THEN 1 ELSE 0 END,
+			finished = NOT @correct,
+			ended_at = CASE WHEN @correct THEN NULL ELSE @ended_at::TIMESTAMP END
+		WHERE id = @game_id
+	`
+
+	cmd, err := tx.Exec(ctx, combinedQuery, pgx.NamedArgs{
+		"round_id":     req.RoundID,
+		"game_id":      req.GameID,
+		"country_code": req.CountryCode,
+		"correct":      req.Correct,
+		"ended_at":     req.RequestTime,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set streak guess and update round: %w", err)
+	} else if cmd.RowsAffected() == 0 {
+		return streak.ErrGameNotFound
+	}
+
+	return nil
+}
+
+// GetBestStreak returns the longest streak among finished country streak games of the user,
+// the earliest game is returned among games with equal streak. Empty result is returned if there are no games.
+func (r *Repository) GetBestStreak(ctx context.Context, userID int) (streak.BestStreak, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "GetBestStreak")
+	defer span.End()
+
+	query := `
+		SELECT
+			id AS game_id,
+			streak,
+			ended_at
+		FROM streak_game
+		WHERE user_id = @user_id AND finished = true
+		ORDER BY streak DESC, ended_at, id
+		LIMIT 1
+	`
+
+	var best streak.BestStreak
+
+	err := pgxscan.Get(ctx, tx, &best, query, pgx.NamedArgs{"user_id": userID})
+	if pgxscan.NotFound(err) {
+		return streak.BestStreak{}, nil
+	} else if err != nil {
+		return streak.BestStreak{}, fmt.Errorf("failed to get best streak: %w", err)
+	}
+
+	return best, nil
+}
+
+// GetStreakLeaderboard returns the best finished game of every user with a non-zero streak, ranked by streak.
+func (r *Repository) GetStreakLeaderboard(
+	ctx context.Context,
+	req dto.GetStreakLeaderboardRequest,
+) ([]streak.LeaderboardResult, int, error) {
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	ctx, span := r.tracer.Start(ctx, "GetStreakLeaderboard")
+	defer span.End()
+
+	var totalResults int
+
+	countQuery := `
+		SELECT COUNT(DISTINCT user_id)
+		FROM streak_game
+		WHERE finished = true AND streak > 0
+	`
+
+	if err := pgxscan.Get(ctx, tx, &totalResults, countQuery); err != nil {
+		return nil, 0, fmt.Errorf("failed to get total streak leaderboard results count: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	query := `
+		WITH results AS (
+			SELECT DISTINCT ON (sg.user_id)
+				sg.id AS game_id,
+				sg.user_id,
+				sg.streak,
+				sg.ended_at
+			FROM streak_game AS sg
+			WHERE sg.finished = true AND sg.streak > 0
+			ORDER BY sg.user_id, sg.streak DESC, sg.ended_at, sg.id
+		)
+		SELECT
+			RANK() OVER (ORDER BY res.streak DESC) AS place,
+			res.user_id,
+			u.username,
+			COALESCE(u.avatar_hash, '') AS avatar_hash,
+			res.game_id,
+			res.streak,
+			res.ended_at
+		FROM results AS res
+		JOIN user_info AS u
+			ON u.id = res.user_id
+		ORDER BY res.streak DESC, res.ended_at, res.game_id
+		LIMIT @limit OFFSET @offset
+	`
+
+	var results []streak.LeaderboardResult
+
+	err := pgxscan.Select(ctx, tx, &results, query, pgx.NamedArgs{
+		"limit":  req.PageSize,
+		"offset": offset,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get streak leaderboard: %w", err)
+	}
+
+	return results, totalResults, nil
+}
@@ -0,0 +1,287 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/streak"
+	"github.com/VasySS/segoya-backend/internal/entity/user"
+	postgresRepo "github.com/VasySS/segoya-backend/internal/infrastructure/repository/postgres"
+	"github.com/VasySS/segoya-backend/migrations/data"
+	"github.com/VasySS/segoya-backend/migrations/tables"
+	"github.com/VasySS/segoya-backend/tests/containers"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestStreakTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(StreakTestSuite))
+}
+
+type StreakTestSuite struct {
+	suite.Suite
+	ctx               context.Context
+	postgresContainer *containers.PostgresContainer
+	postgresRepo      *postgresRepo.Repository
+}
+
+func (s *StreakTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+
+	postgresContainer, err := containers.NewPostgresContainer(s.ctx)
+	s.Require().NoError(err)
+
+	s.postgresContainer = postgresContainer
+
+	migrationsPool, err := pgxpool.New(s.ctx, postgresContainer.ConnectionString)
+	s.Require().NoError(err)
+
+	err = tables.RunGooseMigrations(s.ctx, migrationsPool, "up")
+	s.Require().NoError(err)
+
+	err = data.MigrateAllCSVData(s.ctx, migrationsPool)
+	s.Require().NoError(err)
+
+	// testcontainers lib needs all connections to db to be closed
+	migrationsPool.Close()
+
+	// save db state with all migrations applied
+	err = s.postgresContainer.Snapshot(s.ctx)
+	s.Require().NoError(err)
+}
+
+func (s *StreakTestSuite) TearDownSuite() {
+	err := s.postgresContainer.Terminate(s.ctx)
+	s.Require().NoError(err)
+}
+
+func (s *StreakTestSuite) SetupTest() {
+	// restore db state with migrations only
+	err := s.postgresContainer.Restore(s.ctx)
+	s.Require().NoError(err)
+
+	pool, err := pgxpool.New(s.ctx, s.postgresContainer.ConnectionString)
+	s.Require().NoError(err)
+
+	txManger := postgresRepo.NewTxManager(pool)
+	repo := postgresRepo.New(txManger)
+
+	s.postgresRepo = repo
+}
+
+func (s *StreakTestSuite) newTestUser() user.PrivateProfile {
+	newUserReq := dto.RegisterRequestDB{
+		RequestTime: time.Now().UTC(),
+		Username:    gofakeit.Username(),
+		Name:        gofakeit.Name(),
+		Password:    gofakeit.LetterN(60), // emulating bcrypt hash
+	}
+
+	err := s.postgresRepo.NewUser(s.ctx, newUserReq)
+	s.Require().NoError(err)
+
+	newUser, err := s.postgresRepo.GetUserByUsername(s.ctx, newUserReq.Username)
+	s.Require().NoError(err)
+
+	return newUser
+}
+
+func (s *StreakTestSuite) newTestGame(userID int) (streak.Game, dto.NewStreakGameRequest) {
+	gameReq := dto.NewStreakGameRequest{
+		RequestTime:     time.Now().UTC(),
+		UserID:          userID,
+		TimerSeconds:    gofakeit.Number(10, 600),
+		MovementAllowed: gofakeit.Bool(),
+		Provider:        game.PanoramaProvider(gofakeit.RandomString([]string{"seznam", "yandex", "google"})),
+	}
+
+	gameID, err := s.postgresRepo.NewStreakGame(s.ctx, gameReq)
+	s.Require().NoError(err)
+
+	newGame, err := s.postgresRepo.GetStreakGame(s.ctx, gameID)
+	s.Require().NoError(err)
+
+	return newGame, gameReq
+}
+
+func (s *StreakTestSuite) newTestRound(gameID, roundNum int) (streak.Round, dto.NewStreakRoundDBRequest) {
+	roundReq := dto.NewStreakRoundDBRequest{
+		CreatedAt:   time.Now().UTC(),
+		StartedAt:   time.Now().UTC().Add(time.Second * 10),
+		GameID:      gameID,
+		LocationID:  gofakeit.Number(1, 100),
+		Provider:    game.PanoramaProvider(gofakeit.RandomString([]string{"seznam", "yandex", "google"})),
+		RoundNum:    roundNum,
+		CountryCode: gofakeit.CountryAbr(),
+	}
+
+	round, err := s.postgresRepo.NewStreakRound(s.ctx, roundReq)
+	s.Require().NoError(err)
+
+	return round, roundReq
+}
+
+// playTestGame plays a game with a streak of provided length, that ends with a miss.
+func (s *StreakTestSuite) playTestGame(userID, length int) streak.Game {
+	newGame, _ := s.newTestGame(userID)
+
+	for i := range length + 1 {
+		round, _ := s.newTestRound(newGame.ID, i+1)
+
+		err := s.postgresRepo.NewStreakRoundGuess(s.ctx, dto.NewStreakRoundGuessRequest{
+			RequestTime: time.Now().UTC(),
+			RoundID:     round.ID,
+			GameID:      newGame.ID,
+			CountryCode: round.CountryCode,
+			Correct:     i < length,
+		})
+		s.Require().NoError(err)
+	}
+
+	playedGame, err := s.postgresRepo.GetStreakGame(s.ctx, newGame.ID)
+	s.Require().NoError(err)
+
+	return playedGame
+}
+
+func (s *StreakTestSuite) TestNewStreakGame() {
+	newUser := s.newTestUser()
+	newGame, gameReq := s.newTestGame(newUser.ID)
+
+	s.Equal(newUser.ID, newGame.UserID)
+	s.Equal(0, newGame.RoundCurrent)
+	s.Equal(gameReq.TimerSeconds, newGame.TimerSeconds)
+	s.Equal(gameReq.MovementAllowed, newGame.MovementAllowed)
+	s.Equal(gameReq.Provider, newGame.Provider)
+	s.Empty(newGame.Providers)
+	s.Equal(0, newGame.Streak)
+	s.False(newGame.Finished)
+}
+
+func (s *StreakTestSuite) TestGetStreakGameNotFound() {
+	_, err := s.postgresRepo.GetStreakGame(s.ctx, 1_000_000)
+	s.Require().ErrorIs(err, streak.ErrGameNotFound)
+}
+
+func (s *StreakTestSuite) TestNewStreakRound() {
+	newUser := s.newTestUser()
+	newGame, _ := s.newTestGame(newUser.ID)
+	round, roundReq := s.newTestRound(newGame.ID, 1)
+
+	s.Equal(newGame.ID, round.GameID)
+	s.Equal(1, round.RoundNum)
+	s.Equal(roundReq.Provider, round.Provider)
+	s.Equal(roundReq.CountryCode, round.CountryCode)
+	s.False(round.Finished)
+
+	ids, err := s.postgresRepo.GetStreakGameLocationIDs(s.ctx, newGame.ID)
+	s.Require().NoError(err)
+	s.Equal([]int{roundReq.LocationID}, ids)
+
+	_, err = s.postgresRepo.GetStreakRound(s.ctx, newGame.ID, 2)
+	s.Require().ErrorIs(err, streak.ErrRoundNotFound)
+}
+
+func (s *StreakTestSuite) TestNewStreakRoundGuess() {
+	newUser := s.newTestUser()
+	newGame, _ := s.newTestGame(newUser.ID)
+	firstRound, _ := s.newTestRound(newGame.ID, 1)
+
+	err := s.postgresRepo.NewStreakRoundGuess(s.ctx, dto.NewStreakRoundGuessRequest{
+		RequestTime: time.Now().UTC(),
+		RoundID:     firstRound.ID,
+		GameID:      newGame.ID,
+		CountryCode: firstRound.CountryCode,
+		Correct:     true,
+	})
+	s.Require().NoError(err)
+
+	firstRound, err = s.postgresRepo.GetStreakRound(s.ctx, newGame.ID, 1)
+	s.Require().NoError(err)
+	s.True(firstRound.Finished)
+	s.True(firstRound.Correct)
+	s.Equal(firstRound.CountryCode, firstRound.GuessCountry)
+
+	playedGame, err := s.postgresRepo.GetStreakGame(s.ctx, newGame.ID)
+	s.Require().NoError(err)
+	s.Equal(1, playedGame.Streak)
+	s.False(playedGame.Finished)
+
+	secondRound, _ := s.newTestRound(newGame.ID, 2)
+
+	err = s.postgresRepo.NewStreakRoundGuess(s.ctx, dto.NewStreakRoundGuessRequest{
+		RequestTime: time.Now().UTC(),
+		RoundID:     secondRound.ID,
+		GameID:      newGame.ID,
+		Correct:     false,
+	})
+	s.Require().NoError(err)
+
+	secondRound, err = s.postgresRepo.GetStreakRound(s.ctx, newGame.ID, 2)
+	s.Require().NoError(err)
+	s.True(secondRound.Finished)
+	s.False(secondRound.Correct)
+	s.Empty(secondRound.GuessCountry)
+
+	playedGame, err = s.postgresRepo.GetStreakGame(s.ctx, newGame.ID)
+	s.Require().NoError(err)
+	s.Equal(1, playedGame.Streak)
+	s.Equal(2, playedGame.RoundCurrent)
+	s.True(playedGame.Finished)
+	s.False(playedGame.EndedAt.IsZero())
+}
+
+func (s *StreakTestSuite) TestGetBestStreak() {
+	newUser := s.newTestUser()
+
+	best, err := s.postgresRepo.GetBestStreak(s.ctx, newUser.ID)
+	s.Require().NoError(err)
+	s.Equal(streak.BestStreak{}, best)
+
+	s.playTestGame(newUser.ID, 1)
+	bestGame := s.playTestGame(newUser.ID, 3)
+	s.playTestGame(newUser.ID, 2)
+
+	// unfinished games are not counted
+	s.newTestGame(newUser.ID)
+
+	best, err = s.postgresRepo.GetBestStreak(s.ctx, newUser.ID)
+	s.Require().NoError(err)
+	s.Equal(bestGame.ID, best.GameID)
+	s.Equal(3, best.Streak)
+}
+
+func (s *StreakTestSuite) TestGetStreakLeaderboard() {
+	first := s.newTestUser()
+	second := s.newTestUser()
+	third := s.newTestUser()
+
+	s.playTestGame(first.ID, 2)
+	firstBest := s.playTestGame(first.ID, 4)
+	secondBest := s.playTestGame(second.ID, 4)
+	// games without correct guesses are not in the leaderboard
+	s.playTestGame(third.ID, 0)
+
+	results, total, err := s.postgresRepo.GetStreakLeaderboard(s.ctx, dto.GetStreakLeaderboardRequest{
+		Page:     1,
+		PageSize: 10,
+	})
+	s.Require().NoError(err)
+	s.Equal(2, total)
+	s.Require().Len(results, 2)
+
+	s.Equal(1, results[0].Place)
+	s.Equal(first.ID, results[0].UserID)
+	s.Equal(firstBest.ID, results[0].GameID)
+	s.Equal(4, results[0].Streak)
+
+	s.Equal(1, results[1].Place)
+	s.Equal(second.ID, results[1].UserID)
+	s.Equal(secondBest.ID, results[1].GameID)
+}
@@ -2,13 +2,18 @@ package valkey
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
 	"github.com/VasySS/segoya-backend/internal/entity/lobby"
+	"github.com/google/uuid"
 	"github.com/valkey-io/valkey-go"
 )
 
@@ -17,15 +22,93 @@ const (
 	lobbiesPrefix             = "lobbies:sorted"
 	lobbyIDField              = "id"
 	lobbyCreatorIDField       = "creatorID"
+	lobbyCreatorUsernameField = "creatorUsername"
 	lobbyCreatedAtField       = "createdAt"
 	lobbyRoundsField          = "rounds"
 	lobbyProviderField        = "provider"
+	lobbyProvidersField       = "providers"
 	lobbyTimerSecondsField    = "timerSeconds"
 	lobbyMovementAllowedField = "movementAllowed"
 	lobbyMaxPlayersField      = "maxPlayers"
 	lobbyCurrentPlayersField  = "currentPlayers"
+	lobbyMapIDField           = "mapID"
+	lobbyScoreDistanceField   = "scoreDistance"
+	lobbyScoringField         = "scoring"
+	lobbyModeField            = "mode"
+	lobbyTeamsField           = "teams"
+	lobbyTeamScoringField     = "teamScoring"
+	lobbySpectatorsField      = "spectators"
+	lobbySpectatorDelayField  = "spectatorDelaySeconds"
+	lobbyVisibilityField      = "visibility"
+	lobbyPasswordHashField    = "passwordHash"
+	lobbyInvitedUserIDsField  = "invitedUserIDs"
+	lobbyRequireReadyField    = "requireReady"
+	// lobbyTeamsSuffix is a suffix of a hash key with teams picked by lobby users
+	lobbyTeamsSuffix = ":teams"
+	// lobbyPlayersSuffix is a suffix of a list key with IDs of connected lobby users in order of connection
+	lobbyPlayersSuffix = ":players"
+	// lobbyBansSuffix is a suffix of a set key with IDs of users banned from the lobby
+	lobbyBansSuffix = ":bans"
+	// lobbyReadySuffix is a suffix of a set key with IDs of lobby users who are ready to start the game
+	lobbyReadySuffix = ":ready"
+	// lobbyCountdownSuffix is a suffix of a string key with a token of the pending game start countdown,
+	// it expires on its own, so it isn't one of lobbyKeys
+	lobbyCountdownSuffix = ":countdown"
+	// lobbyGameStarting is a value of the countdown key while the game of the lobby is being started
+	lobbyGameStarting = "starting"
 )
 
+// Indexes of listed lobbies, used to filter and sort the list of lobbies. Like lobbiesPrefix (which has all
+// listed lobbies by creation time), they are sorted sets of lobby IDs scored by the lobby setting.
+const (
+	lobbiesPlayersIndex   = "lobbies:players"
+	lobbiesFreeSlotsIndex = "lobbies:freeSlots"
+	lobbiesRoundsIndex    = "lobbies:rounds"
+	lobbiesTimerIndex     = "lobbies:timer"
+	lobbiesMovementIndex  = "lobbies:movement"
+	// lobbiesProviderIndex is a prefix of indexes of lobbies by provider, scored by creation time
+	lobbiesProviderIndex = "lobbies:provider:"
+	// lobbiesCreatorIndex has "<lowercase creator username>:<lobby id>" members with the same score,
+	// so that lobbies can be searched by the beginning of the username
+	lobbiesCreatorIndex = "lobbies:creator"
+	// lobbiesProviders and lobbiesCreators are hashes of provider and member of lobbiesCreatorIndex by lobby ID,
+	// unlike lobby keys they don't expire, so that expired lobbies can be removed from those indexes too
+	lobbiesProviders = "lobbies:providers"
+	lobbiesCreators  = "lobbies:creators"
+	// lobbiesQueryKey is a prefix of temporary keys used while getting lobbies
+	lobbiesQueryKey = "lobbies:query"
+)
+
+// lobbyIndexes returns indexes, which have lobby IDs as members.
+func lobbyIndexes() []string {
+	return []string{
+		lobbiesPrefix,
+		lobbiesPlayersIndex,
+		lobbiesFreeSlotsIndex,
+		lobbiesRoundsIndex,
+		lobbiesTimerIndex,
+		lobbiesMovementIndex,
+	}
+}
+
+// creatorIndexMember returns a member of the lobby in the index of lobbies by creator username.
+func creatorIndexMember(id, username string) string {
+	return strings.ToLower(username) + ":" + id
+}
+
+// lobbyKeys returns all keys of the lobby, which are deleted and expire together.
+func lobbyKeys(id string) []string {
+	key := lobbyPrefix + id
+
+	return []string{
+		key,
+		key + lobbyTeamsSuffix,
+		key + lobbyPlayersSuffix,
+		key + lobbyBansSuffix,
+		key + lobbyReadySuffix,
+	}
+}
+
 // NewLobby creates new lobby in the database.
 func (r *Repository) NewLobby(ctx context.Context, req dto.NewLobbyRequestDB) error {
 	ctx, span := r.tracer.Start(ctx, "NewLobby")
@@ -35,34 +118,101 @@ func (r *Repository) NewLobby(ctx context.Context, req dto.NewLobbyRequestDB) er
 	fields := map[string]string{
 		lobbyIDField:              req.ID,
 		lobbyCreatorIDField:       strconv.Itoa(req.CreatorID),
+		lobbyCreatorUsernameField: req.CreatorUsername,
 		lobbyCreatedAtField:       req.RequestTime.Format(time.RFC3339),
 		lobbyRoundsField:          strconv.Itoa(req.Rounds),
 		lobbyProviderField:        req.Provider,
+		lobbyProvidersField:       strings.Join(req.Providers, ","),
 		lobbyTimerSecondsField:    strconv.Itoa(req.TimerSeconds),
 		lobbyMovementAllowedField: strconv.FormatBool(req.MovementAllowed),
 		lobbyMaxPlayersField:      strconv.Itoa(req.MaxPlayers),
 		lobbyCurrentPlayersField:  "0",
+		lobbyMapIDField:           strconv.Itoa(req.MapID),
+		lobbyScoreDistanceField:   strconv.FormatFloat(req.ScoreDistance, 'f', -1, 64),
+		lobbyScoringField:         req.Scoring,
+		lobbyModeField:            req.Mode,
+		lobbyTeamsField:           strconv.Itoa(req.Teams),
+		lobbyTeamScoringField:     req.TeamScoring,
+		lobbySpectatorsField:      strconv.FormatBool(req.Spectators),
+		lobbySpectatorDelayField:  strconv.Itoa(req.SpectatorDelaySeconds),
+		lobbyVisibilityField:      string(req.Visibility),
+		lobbyPasswordHashField:    req.PasswordHash,
+		lobbyInvitedUserIDsField:  joinUserIDs(req.InvitedUserIDs),
+		lobbyRequireReadyField:    strconv.FormatBool(req.RequireReady),
 	}
 
-	cmd := r.valkey.B().Hset().Key(key).FieldValue()
+	hset := r.valkey.B().Hset().Key(key).FieldValue()
 	for field, value := range fields {
-		cmd = cmd.FieldValue(field, value)
+		hset = hset.FieldValue(field, value)
 	}
 
-	if err := r.valkey.Do(ctx, cmd.Build()).Error(); err != nil {
-		return fmt.Errorf("failed to create lobby: %w", err)
+	// the lobby and its index entries are written in one transaction, so that a listed lobby is never
+	// missing from some of the indexes
+	cmds := valkey.Commands{r.valkey.B().Multi().Build(), hset.Build()}
+
+	// hidden lobbies are not added to the list of lobbies
+	if req.Visibility.Listed() {
+		cmds = append(cmds, r.listLobbyCommands(req)...)
 	}
 
-	addSorted := r.valkey.B().Zadd().Key(lobbiesPrefix).ScoreMember().
-		ScoreMember(float64(req.RequestTime.Unix()), req.ID)
+	cmds = append(cmds, r.valkey.B().Exec().Build())
+
+	results := r.valkey.DoMulti(ctx, cmds...)
+
+	replies, err := results[len(results)-1].ToArray()
+	if err != nil {
+		return fmt.Errorf("failed to create lobby: %w", err)
+	}
 
-	if err := r.valkey.Do(ctx, addSorted.Build()).Error(); err != nil {
-		return fmt.Errorf("failed to add lobby to sorted set: %w", err)
+	for _, reply := range replies {
+		if err := reply.Error(); err != nil {
+			return fmt.Errorf("failed to create lobby: %w", err)
+		}
 	}
 
 	return nil
 }
 
+// listLobbyCommands returns commands, which add the new lobby to indexes of listed lobbies.
+func (r *Repository) listLobbyCommands(req dto.NewLobbyRequestDB) valkey.Commands {
+	createdAt := float64(req.RequestTime.Unix())
+	indexes := []struct {
+		key    string
+		score  float64
+		member string
+	}{
+		{lobbiesPrefix, createdAt, req.ID},
+		{lobbiesPlayersIndex, 0, req.ID},
+		{lobbiesFreeSlotsIndex, float64(req.MaxPlayers), req.ID},
+		{lobbiesRoundsIndex, float64(req.Rounds), req.ID},
+		{lobbiesTimerIndex, float64(req.TimerSeconds), req.ID},
+		{lobbiesMovementIndex, boolScore(req.MovementAllowed), req.ID},
+		{lobbiesProviderIndex + req.Provider, createdAt, req.ID},
+		{lobbiesCreatorIndex, 0, creatorIndexMember(req.ID, req.CreatorUsername)},
+	}
+
+	cmds := make(valkey.Commands, 0, len(indexes)+2)
+	for _, idx := range indexes {
+		cmds = append(cmds, r.valkey.B().Zadd().Key(idx.key).ScoreMember().ScoreMember(idx.score, idx.member).Build())
+	}
+
+	return append(cmds,
+		r.valkey.B().Hset().Key(lobbiesProviders).FieldValue().FieldValue(req.ID, req.Provider).Build(),
+		r.valkey.B().Hset().Key(lobbiesCreators).FieldValue().
+			FieldValue(req.ID, creatorIndexMember(req.ID, req.CreatorUsername)).
+			Build(),
+	)
+}
+
+// boolScore returns a score of a boolean setting in lobby indexes.
+func boolScore(v bool) float64 {
+	if v {
+		return 1
+	}
+
+	return 0
+}
+
 // GetLobby gets lobby from the database.
 func (r *Repository) GetLobby(ctx context.Context, id string) (lobby.Lobby, error) {
 	ctx, span := r.tracer.Start(ctx, "Lobby")
@@ -83,15 +233,36 @@ func (r *Repository) GetLobby(ctx context.Context, id string) (lobby.Lobby, erro
 	return parseLobbyData(id, resp)
 }
 
+// changeLobbyPlayersLua changes current amount of players in the lobby and updates indexes of listed lobbies.
+// KEYS[1] is the lobby key, KEYS[2] is the index of listed lobbies by creation time, KEYS[3] and KEYS[4] are
+// indexes by current players and free slots. ARGV[1] is the lobby ID, ARGV[2] and ARGV[3] are current
+// and max players fields, ARGV[4] is the change. It returns nil if the lobby doesn't exist (so that a deleted or
+// expired lobby isn't recreated without settings and expiration).
+const changeLobbyPlayersLua = `
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return nil
+end
+
+local current = redis.call('HINCRBY', KEYS[1], ARGV[2], ARGV[4])
+
+if redis.call('ZSCORE', KEYS[2], ARGV[1]) then
+	local max = tonumber(redis.call('HGET', KEYS[1], ARGV[3])) or 0
+
+	redis.call('ZADD', KEYS[3], current, ARGV[1])
+	redis.call('ZADD', KEYS[4], max - current, ARGV[1])
+end
+
+return current
+`
+
+var changeLobbyPlayersScript = valkey.NewLuaScript(changeLobbyPlayersLua) //nolint:gochecknoglobals
+
 // IncrementLobbyPlayers increments current amount of players in the lobby.
 func (r *Repository) IncrementLobbyPlayers(ctx context.Context, id string) error {
 	ctx, span := r.tracer.Start(ctx, "LobbyIncrementPlayers")
 	defer span.End()
 
-	key := lobbyPrefix + id
-	cmd := r.valkey.B().Hincrby().Key(key).Field(lobbyCurrentPlayersField).Increment(1).Build()
-
-	if err := r.valkey.Do(ctx, cmd).Error(); err != nil {
+	if err := r.changeLobbyPlayers(ctx, id, 1); err != nil {
 		return fmt.Errorf("failed to increment current players: %w", err)
 	}
 
@@ -103,41 +274,589 @@ func (r *Repository) DecrementLobbyPlayers(ctx context.Context, id string) error
 	ctx, span := r.tracer.Start(ctx, "LobbyDecrementPlayers")
 	defer span.End()
 
+	if err := r.changeLobbyPlayers(ctx, id, -1); err != nil {
+		return fmt.Errorf("failed to decrement current players: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) changeLobbyPlayers(ctx context.Context, id string, change int) error {
+	keys := []string{lobbyPrefix + id, lobbiesPrefix, lobbiesPlayersIndex, lobbiesFreeSlotsIndex}
+	args := []string{id, lobbyCurrentPlayersField, lobbyMaxPlayersField, strconv.Itoa(change)}
+
+	err := changeLobbyPlayersScript.Exec(ctx, r.valkey, keys, args).Error()
+	if valkey.IsValkeyNil(err) {
+		return lobby.ErrNotFound
+	}
+
+	return err
+}
+
+// updateLobbySettingsLua updates settings of a lobby atomically - only if the lobby exists, its provider wasn't
+// changed since it was read and its current players fit into new max players. Indexes of listed lobbies are
+// updated too. KEYS[1] is the lobby key, KEYS[2] is the index of listed lobbies by creation time, KEYS[3..6] are
+// indexes by free slots, rounds, timer and movement, KEYS[7] and KEYS[8] are indexes by old and new provider,
+// KEYS[9] is the hash of providers of listed lobbies. ARGV[1] is the lobby ID, ARGV[2] is the current players
+// field, ARGV[3] is the provider field, ARGV[4] and ARGV[5] are old and new provider, ARGV[6..9] are new max
+// players, rounds, timer and movement scores, the rest are field-value pairs of new settings.
+// It returns 0 if the lobby doesn't exist, -1 if max players is too low, -2 if the provider was changed
+// and 1 on success.
+const updateLobbySettingsLua = `
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+
+if (redis.call('HGET', KEYS[1], ARGV[3]) or '') ~= ARGV[4] then
+	return -2
+end
+
+local current = tonumber(redis.call('HGET', KEYS[1], ARGV[2]))
+if current > tonumber(ARGV[6]) then
+	return -1
+end
+
+redis.call('HSET', KEYS[1], unpack(ARGV, 10))
+
+local createdAt = redis.call('ZSCORE', KEYS[2], ARGV[1])
+if createdAt then
+	redis.call('ZADD', KEYS[3], tonumber(ARGV[6]) - current, ARGV[1])
+	redis.call('ZADD', KEYS[4], ARGV[7], ARGV[1])
+	redis.call('ZADD', KEYS[5], ARGV[8], ARGV[1])
+	redis.call('ZADD', KEYS[6], ARGV[9], ARGV[1])
+	redis.call('ZREM', KEYS[7], ARGV[1])
+	redis.call('ZADD', KEYS[8], createdAt, ARGV[1])
+	redis.call('HSET', KEYS[9], ARGV[1], ARGV[5])
+end
+
+return 1
+`
+
+// updateLobbySettingsAttempts limits retries of updating lobby settings, when its provider is changed
+// concurrently (indexes by provider are passed to the script, so the old provider is read before it).
+const updateLobbySettingsAttempts = 3
+
+var updateLobbySettingsScript = valkey.NewLuaScript(updateLobbySettingsLua) //nolint:gochecknoglobals
+
+// UpdateLobbySettings changes settings of the lobby.
+func (r *Repository) UpdateLobbySettings(ctx context.Context, req dto.UpdateLobbySettingsRequestDB) error {
+	ctx, span := r.tracer.Start(ctx, "UpdateLobbySettings")
+	defer span.End()
+
+	for range updateLobbySettingsAttempts {
+		getProvider := r.valkey.B().Hget().Key(lobbyPrefix + req.ID).Field(lobbyProviderField).Build()
+
+		oldProvider, err := r.valkey.Do(ctx, getProvider).ToString()
+		if err != nil && !valkey.IsValkeyNil(err) {
+			return fmt.Errorf("failed to get lobby provider: %w", err)
+		}
+
+		res, err := r.updateLobbySettings(ctx, req, oldProvider)
+		if err != nil {
+			return fmt.Errorf("failed to update lobby settings: %w", err)
+		}
+
+		switch res {
+		case -2:
+			continue
+		case 0:
+			return lobby.ErrNotFound
+		case -1:
+			return lobby.ErrWrongSettings
+		default:
+			return nil
+		}
+	}
+
+	return errors.New("failed to update lobby settings: lobby provider is changed concurrently")
+}
+
+// updateLobbySettings runs the script updating lobby settings, if the lobby still has the old provider.
+func (r *Repository) updateLobbySettings(
+	ctx context.Context,
+	req dto.UpdateLobbySettingsRequestDB,
+	oldProvider string,
+) (int64, error) {
+	keys := []string{
+		lobbyPrefix + req.ID,
+		lobbiesPrefix,
+		lobbiesFreeSlotsIndex,
+		lobbiesRoundsIndex,
+		lobbiesTimerIndex,
+		lobbiesMovementIndex,
+		lobbiesProviderIndex + oldProvider,
+		lobbiesProviderIndex + req.Settings.Provider,
+		lobbiesProviders,
+	}
+
+	maxPlayers := strconv.Itoa(req.Settings.MaxPlayers)
+	rounds := strconv.Itoa(req.Settings.Rounds)
+	timerSeconds := strconv.Itoa(req.Settings.TimerSeconds)
+	args := []string{
+		req.ID,
+		lobbyCurrentPlayersField,
+		lobbyProviderField,
+		oldProvider,
+		req.Settings.Provider,
+		maxPlayers,
+		rounds,
+		timerSeconds,
+		strconv.FormatFloat(boolScore(req.Settings.MovementAllowed), 'f', -1, 64),
+		lobbyRoundsField, rounds,
+		lobbyProviderField, req.Settings.Provider,
+		lobbyProvidersField, strings.Join(req.Settings.Providers, ","),
+		lobbyTimerSecondsField, timerSeconds,
+		lobbyMovementAllowedField, strconv.FormatBool(req.Settings.MovementAllowed),
+		lobbyMaxPlayersField, maxPlayers,
+		lobbyRequireReadyField, strconv.FormatBool(req.Settings.RequireReady),
+	}
+
+	return updateLobbySettingsScript.Exec(ctx, r.valkey, keys, args).AsInt64()
+}
+
+// changeLobbyKeyLua runs a command changing a key of the lobby and copies expiration of the lobby to the key,
+// so that keys created while the lobby is empty expire together with it. KEYS[1] is the lobby key, KEYS[2] is
+// the changed key, ARGV[1] is the command and the rest are its arguments after the key.
+const changeLobbyKeyLua = `
+local result = redis.call(ARGV[1], KEYS[2], unpack(ARGV, 2))
+
+local ttl = redis.call('PTTL', KEYS[1])
+if ttl > 0 then
+	redis.call('PEXPIRE', KEYS[2], ttl)
+end
+
+return result
+`
+
+var changeLobbyKeyScript = valkey.NewLuaScript(changeLobbyKeyLua) //nolint:gochecknoglobals
+
+// changeLobbyKey runs the command on the key of the lobby with the suffix, keeping it expiring with the lobby.
+func (r *Repository) changeLobbyKey(ctx context.Context, id, suffix string, cmd ...string) error {
 	key := lobbyPrefix + id
-	cmd := r.valkey.B().Hincrby().Key(key).Field(lobbyCurrentPlayersField).Increment(-1).Build()
+
+	return changeLobbyKeyScript.Exec(ctx, r.valkey, []string{key, key + suffix}, cmd).Error()
+}
+
+// SetLobbyUserTeam saves a team picked by a lobby user.
+func (r *Repository) SetLobbyUserTeam(ctx context.Context, req dto.SwitchLobbyTeamRequest) error {
+	ctx, span := r.tracer.Start(ctx, "SetLobbyUserTeam")
+	defer span.End()
+
+	err := r.changeLobbyKey(ctx, req.LobbyID, lobbyTeamsSuffix, "HSET", strconv.Itoa(req.UserID), strconv.Itoa(req.Team))
+	if err != nil {
+		return fmt.Errorf("failed to set lobby user team: %w", err)
+	}
+
+	return nil
+}
+
+// GetLobbyTeams returns teams picked by lobby users by their IDs.
+func (r *Repository) GetLobbyTeams(ctx context.Context, id string) (map[int]int, error) {
+	ctx, span := r.tracer.Start(ctx, "GetLobbyTeams")
+	defer span.End()
+
+	key := lobbyPrefix + id + lobbyTeamsSuffix
+	cmd := r.valkey.B().Hgetall().Key(key).Build()
+
+	resp, err := r.valkey.Do(ctx, cmd).AsStrMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lobby teams: %w", err)
+	}
+
+	teams := make(map[int]int, len(resp))
+
+	for userIDStr, teamStr := range resp {
+		userID, err := strconv.Atoi(userIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user_id: %w", err)
+		}
+
+		team, err := strconv.Atoi(teamStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid team: %w", err)
+		}
+
+		teams[userID] = team
+	}
+
+	return teams, nil
+}
+
+// AddLobbyPlayer saves a user connected to the lobby, after all previously connected users.
+func (r *Repository) AddLobbyPlayer(ctx context.Context, id string, userID int) error {
+	ctx, span := r.tracer.Start(ctx, "AddLobbyPlayer")
+	defer span.End()
+
+	if err := r.changeLobbyKey(ctx, id, lobbyPlayersSuffix, "RPUSH", strconv.Itoa(userID)); err != nil {
+		return fmt.Errorf("failed to add lobby player: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveLobbyPlayer removes a user disconnected from the lobby
+// (only once, as the same user can be connected multiple times).
+func (r *Repository) RemoveLobbyPlayer(ctx context.Context, id string, userID int) error {
+	ctx, span := r.tracer.Start(ctx, "RemoveLobbyPlayer")
+	defer span.End()
+
+	key := lobbyPrefix + id + lobbyPlayersSuffix
+	cmd := r.valkey.B().Lrem().Key(key).Count(1).Element(strconv.Itoa(userID)).Build()
 
 	if err := r.valkey.Do(ctx, cmd).Error(); err != nil {
-		return fmt.Errorf("failed to decrement current players: %w", err)
+		return fmt.Errorf("failed to remove lobby player: %w", err)
+	}
+
+	return nil
+}
+
+// GetLobbyPlayers returns IDs of users connected to the lobby in order of connection.
+func (r *Repository) GetLobbyPlayers(ctx context.Context, id string) ([]int, error) {
+	ctx, span := r.tracer.Start(ctx, "GetLobbyPlayers")
+	defer span.End()
+
+	key := lobbyPrefix + id + lobbyPlayersSuffix
+	cmd := r.valkey.B().Lrange().Key(key).Start(0).Stop(-1).Build()
+
+	resp, err := r.valkey.Do(ctx, cmd).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lobby players: %w", err)
+	}
+
+	players := make([]int, 0, len(resp))
+
+	for _, userIDStr := range resp {
+		userID, err := strconv.Atoi(userIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user_id: %w", err)
+		}
+
+		players = append(players, userID)
+	}
+
+	return players, nil
+}
+
+// setLobbyCreatorLua passes the host role of the lobby to another user, if the lobby still has the old creator,
+// and updates the index of listed lobbies by creator username. KEYS[1] is the lobby key, KEYS[2] is the index by
+// creator username and KEYS[3] is the hash of its members. ARGV[1] is the lobby ID, ARGV[2] and ARGV[3] are
+// creator ID and username fields, ARGV[4] is the old creator ID, ARGV[5] and ARGV[6] are the new creator ID and
+// username and ARGV[7] is the new member of the index. It returns nil if the lobby doesn't exist, 0 if the creator
+// was changed and 1 on success.
+const setLobbyCreatorLua = `
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return nil
+end
+
+if redis.call('HGET', KEYS[1], ARGV[2]) ~= ARGV[4] then
+	return 0
+end
+
+redis.call('HSET', KEYS[1], ARGV[2], ARGV[5], ARGV[3], ARGV[6])
+
+-- only listed lobbies have members in the index
+local old = redis.call('HGET', KEYS[3], ARGV[1])
+if old then
+	redis.call('ZREM', KEYS[2], old)
+	redis.call('ZADD', KEYS[2], 0, ARGV[7])
+	redis.call('HSET', KEYS[3], ARGV[1], ARGV[7])
+end
+
+return 1
+`
+
+var setLobbyCreatorScript = valkey.NewLuaScript(setLobbyCreatorLua) //nolint:gochecknoglobals
+
+// SetLobbyCreator passes the host role of the lobby from its old creator to another user.
+// It returns false if the lobby doesn't have the old creator anymore.
+func (r *Repository) SetLobbyCreator(ctx context.Context, req dto.SetLobbyCreatorRequestDB) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "SetLobbyCreator")
+	defer span.End()
+
+	keys := []string{lobbyPrefix + req.ID, lobbiesCreatorIndex, lobbiesCreators}
+	args := []string{
+		req.ID,
+		lobbyCreatorIDField,
+		lobbyCreatorUsernameField,
+		strconv.Itoa(req.OldCreatorID),
+		strconv.Itoa(req.CreatorID),
+		req.CreatorUsername,
+		creatorIndexMember(req.ID, req.CreatorUsername),
+	}
+
+	set, err := setLobbyCreatorScript.Exec(ctx, r.valkey, keys, args).AsInt64()
+	if valkey.IsValkeyNil(err) {
+		return false, lobby.ErrNotFound
+	} else if err != nil {
+		return false, fmt.Errorf("failed to set lobby creator: %w", err)
+	}
+
+	return set == 1, nil
+}
+
+// BanLobbyUser forbids a user to join the lobby.
+func (r *Repository) BanLobbyUser(ctx context.Context, id string, userID int) error {
+	ctx, span := r.tracer.Start(ctx, "BanLobbyUser")
+	defer span.End()
+
+	if err := r.changeLobbyKey(ctx, id, lobbyBansSuffix, "SADD", strconv.Itoa(userID)); err != nil {
+		return fmt.Errorf("failed to ban lobby user: %w", err)
+	}
+
+	return nil
+}
+
+// IsLobbyUserBanned returns true if the user is banned from the lobby.
+func (r *Repository) IsLobbyUserBanned(ctx context.Context, id string, userID int) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "IsLobbyUserBanned")
+	defer span.End()
+
+	key := lobbyPrefix + id + lobbyBansSuffix
+	cmd := r.valkey.B().Sismember().Key(key).Member(strconv.Itoa(userID)).Build()
+
+	banned, err := r.valkey.Do(ctx, cmd).AsBool()
+	if err != nil {
+		return false, fmt.Errorf("failed to check lobby ban: %w", err)
+	}
+
+	return banned, nil
+}
+
+// SetLobbyUserReady saves whether a lobby user is ready to start the game,
+// it is kept after the user disconnects, so that it survives reconnects.
+func (r *Repository) SetLobbyUserReady(ctx context.Context, req dto.SetLobbyUserReadyRequest) error {
+	ctx, span := r.tracer.Start(ctx, "SetLobbyUserReady")
+	defer span.End()
+
+	cmd := "SREM"
+	if req.Ready {
+		cmd = "SADD"
+	}
+
+	if err := r.changeLobbyKey(ctx, req.LobbyID, lobbyReadySuffix, cmd, strconv.Itoa(req.UserID)); err != nil {
+		return fmt.Errorf("failed to set lobby user ready: %w", err)
+	}
+
+	return nil
+}
+
+// GetLobbyReadyUsers returns IDs of lobby users who are ready to start the game.
+func (r *Repository) GetLobbyReadyUsers(ctx context.Context, id string) ([]int, error) {
+	ctx, span := r.tracer.Start(ctx, "GetLobbyReadyUsers")
+	defer span.End()
+
+	key := lobbyPrefix + id + lobbyReadySuffix
+	cmd := r.valkey.B().Smembers().Key(key).Build()
+
+	resp, err := r.valkey.Do(ctx, cmd).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lobby ready users: %w", err)
+	}
+
+	ready := make([]int, 0, len(resp))
+
+	for _, userIDStr := range resp {
+		userID, err := strconv.Atoi(userIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user_id: %w", err)
+		}
+
+		ready = append(ready, userID)
+	}
+
+	return ready, nil
+}
+
+// ResetLobbyReady makes every user of the lobby not ready.
+func (r *Repository) ResetLobbyReady(ctx context.Context, id string) error {
+	ctx, span := r.tracer.Start(ctx, "ResetLobbyReady")
+	defer span.End()
+
+	cmd := r.valkey.B().Del().Key(lobbyPrefix + id + lobbyReadySuffix).Build()
+	if err := r.valkey.Do(ctx, cmd).Error(); err != nil {
+		return fmt.Errorf("failed to reset lobby ready users: %w", err)
 	}
 
 	return nil
 }
 
-// DeleteLobby deletes lobby from the database.
+// SetLobbyCountdown saves a token of the game start countdown of the lobby, if there is no pending countdown
+// and the game isn't being started. It returns false if the countdown wasn't saved.
+func (r *Repository) SetLobbyCountdown(ctx context.Context, id, token string, ttl time.Duration) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "SetLobbyCountdown")
+	defer span.End()
+
+	key := lobbyPrefix + id + lobbyCountdownSuffix
+	cmd := r.valkey.B().Set().Key(key).Value(token).Nx().PxMilliseconds(ttl.Milliseconds()).Build()
+
+	err := r.valkey.Do(ctx, cmd).Error()
+	if valkey.IsValkeyNil(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to set lobby countdown: %w", err)
+	}
+
+	return true, nil
+}
+
+// deleteLobbyCountdownLua deletes the countdown key, unless the game is being started.
+// KEYS[1] is the countdown key, ARGV[1] is the token of the countdown (empty to delete any countdown)
+// and ARGV[2] is the value of the key while the game is being started.
+const deleteLobbyCountdownLua = `
+local current = redis.call('GET', KEYS[1])
+if not current or current == ARGV[2] then
+	return 0
+end
+
+if ARGV[1] ~= '' and current ~= ARGV[1] then
+	return 0
+end
+
+return redis.call('DEL', KEYS[1])
+`
+
+var deleteLobbyCountdownScript = valkey.NewLuaScript(deleteLobbyCountdownLua) //nolint:gochecknoglobals
+
+// DeleteLobbyCountdown cancels the pending game start countdown of the lobby with the given token
+// (or any countdown, if the token is empty). It returns true if the countdown was cancelled.
+func (r *Repository) DeleteLobbyCountdown(ctx context.Context, id, token string) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "DeleteLobbyCountdown")
+	defer span.End()
+
+	keys := []string{lobbyPrefix + id + lobbyCountdownSuffix}
+	args := []string{token, lobbyGameStarting}
+
+	deleted, err := deleteLobbyCountdownScript.Exec(ctx, r.valkey, keys, args).AsInt64()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete lobby countdown: %w", err)
+	}
+
+	return deleted == 1, nil
+}
+
+// claimLobbyGameStartLua marks the game of the lobby as being started, if the countdown key has the expected
+// value. KEYS[1] is the countdown key, ARGV[1] is the token of the countdown (empty when no countdown must be
+// pending), ARGV[2] is the value of the key while the game is being started and ARGV[3] is its TTL in ms.
+const claimLobbyGameStartLua = `
+local current = redis.call('GET', KEYS[1]) or ''
+if current ~= ARGV[1] then
+	return 0
+end
+
+redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+
+return 1
+`
+
+var claimLobbyGameStartScript = valkey.NewLuaScript(claimLobbyGameStartLua) //nolint:gochecknoglobals
+
+// ClaimLobbyGameStart marks the game of the lobby as being started, so that it is started only once. The game
+// started by the countdown is claimed with its token, other games can only be claimed without a pending countdown.
+// It returns false if the game can't be started by the caller.
+func (r *Repository) ClaimLobbyGameStart(ctx context.Context, id, token string, ttl time.Duration) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "ClaimLobbyGameStart")
+	defer span.End()
+
+	keys := []string{lobbyPrefix + id + lobbyCountdownSuffix}
+	args := []string{token, lobbyGameStarting, strconv.FormatInt(ttl.Milliseconds(), 10)}
+
+	claimed, err := claimLobbyGameStartScript.Exec(ctx, r.valkey, keys, args).AsInt64()
+	if err != nil {
+		return false, fmt.Errorf("failed to claim lobby game start: %w", err)
+	}
+
+	return claimed == 1, nil
+}
+
+// ReleaseLobbyGameStart removes the mark of the game being started, after the game failed to start.
+func (r *Repository) ReleaseLobbyGameStart(ctx context.Context, id string) error {
+	ctx, span := r.tracer.Start(ctx, "ReleaseLobbyGameStart")
+	defer span.End()
+
+	cmd := r.valkey.B().Del().Key(lobbyPrefix + id + lobbyCountdownSuffix).Build()
+
+	if err := r.valkey.Do(ctx, cmd).Error(); err != nil {
+		return fmt.Errorf("failed to release lobby game start: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteLobby deletes lobby from the database and removes it from the list of lobbies.
 func (r *Repository) DeleteLobby(ctx context.Context, id string) error {
 	ctx, span := r.tracer.Start(ctx, "DeleteLobby")
 	defer span.End()
 
 	key := lobbyPrefix + id
-	cmd := r.valkey.B().Del().Key(key).Build()
+	cmd := r.valkey.B().Del().Key(append(lobbyKeys(id), key+lobbyCountdownSuffix)...).Build()
 
 	if err := r.valkey.Do(ctx, cmd).Error(); err != nil {
 		return fmt.Errorf("failed to delete lobby: %w", err)
 	}
 
+	if err := r.unlistLobby(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete lobby: %w", err)
+	}
+
+	return nil
+}
+
+// unlistLobby removes the lobby from every index of listed lobbies, it also works for expired lobbies.
+func (r *Repository) unlistLobby(ctx context.Context, id string) error {
+	getMembers := valkey.Commands{
+		r.valkey.B().Hget().Key(lobbiesProviders).Field(id).Build(),
+		r.valkey.B().Hget().Key(lobbiesCreators).Field(id).Build(),
+	}
+
+	members := make([]string, 0, len(getMembers))
+
+	for _, res := range r.valkey.DoMulti(ctx, getMembers...) {
+		// members are nil for hidden lobbies
+		member, err := res.ToString()
+		if err != nil && !valkey.IsValkeyNil(err) {
+			return fmt.Errorf("failed to get lobby index members: %w", err)
+		}
+
+		members = append(members, member)
+	}
+
+	provider, creatorMember := members[0], members[1]
+
+	cmds := valkey.Commands{
+		r.valkey.B().Zrem().Key(lobbiesProviderIndex + provider).Member(id).Build(),
+		r.valkey.B().Zrem().Key(lobbiesCreatorIndex).Member(creatorMember).Build(),
+		r.valkey.B().Hdel().Key(lobbiesProviders).Field(id).Build(),
+		r.valkey.B().Hdel().Key(lobbiesCreators).Field(id).Build(),
+	}
+
+	for _, idx := range lobbyIndexes() {
+		cmds = append(cmds, r.valkey.B().Zrem().Key(idx).Member(id).Build())
+	}
+
+	for _, res := range r.valkey.DoMulti(ctx, cmds...) {
+		if err := res.Error(); err != nil {
+			return fmt.Errorf("failed to remove lobby from indexes: %w", err)
+		}
+	}
+
 	return nil
 }
 
-// AddLobbyExpiration sets expiration for the lobby (to remove empty lobbies).
+// AddLobbyExpiration sets expiration for the lobby (to remove empty lobbies). Keys of the lobby created later
+// copy its expiration.
 func (r *Repository) AddLobbyExpiration(ctx context.Context, id string, ttl time.Duration) error {
 	ctx, span := r.tracer.Start(ctx, "AddLobbyExpiration")
 	defer span.End()
 
-	key := lobbyPrefix + id
-	cmd := r.valkey.B().Expire().Key(key).Seconds(int64(ttl.Seconds())).Build()
+	// teams, players, bans and ready users expire together with the lobby
+	for _, k := range lobbyKeys(id) {
+		cmd := r.valkey.B().Expire().Key(k).Seconds(int64(ttl.Seconds())).Build()
 
-	if err := r.valkey.Do(ctx, cmd).Error(); err != nil {
-		return fmt.Errorf("failed to set lobby expiration: %w", err)
+		if err := r.valkey.Do(ctx, cmd).Error(); err != nil {
+			return fmt.Errorf("failed to set lobby expiration: %w", err)
+		}
 	}
 
 	return nil
@@ -148,17 +867,174 @@ func (r *Repository) DeleteLobbyExpiration(ctx context.Context, id string) error
 	ctx, span := r.tracer.Start(ctx, "DeleteLobbyExpiration")
 	defer span.End()
 
-	key := lobbyPrefix + id
-	cmd := r.valkey.B().Persist().Key(key).Build()
+	for _, k := range lobbyKeys(id) {
+		cmd := r.valkey.B().Persist().Key(k).Build()
 
-	if err := r.valkey.Do(ctx, cmd).Error(); err != nil {
-		return fmt.Errorf("failed to delete lobby expiration: %w", err)
+		if err := r.valkey.Do(ctx, cmd).Error(); err != nil {
+			return fmt.Errorf("failed to delete lobby expiration: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// pruneExpiredLobbies removes lobbies, which have expired, from indexes of listed lobbies.
+func (r *Repository) pruneExpiredLobbies(ctx context.Context) error {
+	ids, err := r.valkey.Do(ctx, r.valkey.B().Zrange().Key(lobbiesPrefix).Min("0").Max("-1").Build()).AsStrSlice()
+	if err != nil {
+		return fmt.Errorf("failed to get listed lobby IDs: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	cmds := make(valkey.Commands, len(ids))
+	for i, id := range ids {
+		cmds[i] = r.valkey.B().Exists().Key(lobbyPrefix + id).Build()
+	}
+
+	for i, res := range r.valkey.DoMulti(ctx, cmds...) {
+		exists, err := res.AsBool()
+		if err != nil {
+			return fmt.Errorf("failed to check lobby existence: %w", err)
+		}
+
+		if exists {
+			continue
+		}
+
+		if err := r.unlistLobby(ctx, ids[i]); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-// GetLobbies gets all lobbies from the database.
+// getLobbiesLua returns the total amount of lobbies present in every filter index and a page of their IDs
+// in order of the sort index. Filter indexes are intersected with the sort index through temporary keys.
+// KEYS[1] is the sort index, KEYS[2] is a temporary key for the result, the rest are pairs of filter index
+// and its temporary key. ARGV[1] and ARGV[2] are start and stop of the page, ARGV[3] is "true" for descending
+// order, then every filter has its kind ("score" or "lex"), min and max. Members of lex indexes end with
+// ":<lobby id>".
+const getLobbiesLua = `
+local result = KEYS[1]
+local filters = (#KEYS - 2) / 2
+
+if filters > 0 then
+	local args = {KEYS[2], filters + 1, KEYS[1]}
+	local temp = {}
+
+	for i = 1, filters do
+		local key, filtered = KEYS[i * 2 + 1], KEYS[i * 2 + 2]
+		local kind, min, max = ARGV[i * 3 + 1], ARGV[i * 3 + 2], ARGV[i * 3 + 3]
+
+		if kind == 'lex' then
+			redis.call('DEL', filtered)
+
+			for _, member in ipairs(redis.call('ZRANGE', key, min, max, 'BYLEX')) do
+				redis.call('ZADD', filtered, 0, string.match(member, '[^:]+$'))
+			end
+		else
+			redis.call('ZRANGESTORE', filtered, key, min, max, 'BYSCORE')
+		end
+
+		table.insert(args, filtered)
+		table.insert(temp, filtered)
+	end
+
+	-- only the sort index is used for scores of the result
+	table.insert(args, 'WEIGHTS')
+	table.insert(args, 1)
+
+	for _ = 1, filters do
+		table.insert(args, 0)
+	end
+
+	redis.call('ZINTERSTORE', unpack(args))
+	redis.call('DEL', unpack(temp))
+
+	result = KEYS[2]
+end
+
+local total = redis.call('ZCARD', result)
+
+local ids
+if ARGV[3] == 'true' then
+	ids = redis.call('ZRANGE', result, ARGV[1], ARGV[2], 'REV')
+else
+	ids = redis.call('ZRANGE', result, ARGV[1], ARGV[2])
+end
+
+if filters > 0 then
+	redis.call('DEL', KEYS[2])
+end
+
+return {total, ids}
+`
+
+var getLobbiesScript = valkey.NewLuaScript(getLobbiesLua) //nolint:gochecknoglobals
+
+// lobbiesFilter is a filter of the list of lobbies by an index.
+type lobbiesFilter struct {
+	index    string
+	lex      bool
+	min, max string
+}
+
+// lobbiesFilters returns filters of the list of lobbies, that are set in the request.
+func lobbiesFilters(req dto.GetLobbiesRequest) []lobbiesFilter {
+	var filters []lobbiesFilter
+
+	if req.Provider != "" {
+		filters = append(filters, lobbiesFilter{index: lobbiesProviderIndex + req.Provider, min: "-inf", max: "+inf"})
+	}
+
+	if req.RoundsMin != 0 || req.RoundsMax != 0 {
+		filter := lobbiesFilter{index: lobbiesRoundsIndex, min: "-inf", max: "+inf"}
+
+		if req.RoundsMin != 0 {
+			filter.min = strconv.Itoa(req.RoundsMin)
+		}
+
+		if req.RoundsMax != 0 {
+			filter.max = strconv.Itoa(req.RoundsMax)
+		}
+
+		filters = append(filters, filter)
+	}
+
+	if req.TimerSeconds != nil {
+		timer := strconv.Itoa(*req.TimerSeconds)
+		filters = append(filters, lobbiesFilter{index: lobbiesTimerIndex, min: timer, max: timer})
+	}
+
+	if req.MovementAllowed != nil {
+		movement := strconv.FormatFloat(boolScore(*req.MovementAllowed), 'f', -1, 64)
+		filters = append(filters, lobbiesFilter{index: lobbiesMovementIndex, min: movement, max: movement})
+	}
+
+	if req.FreeSlots {
+		filters = append(filters, lobbiesFilter{index: lobbiesFreeSlotsIndex, min: "1", max: "+inf"})
+	}
+
+	if req.CreatorUsername != "" {
+		// members of the index start with the username, so they are between the prefix and the prefix with
+		// the largest byte appended
+		prefix := strings.ToLower(req.CreatorUsername)
+		filters = append(filters, lobbiesFilter{
+			index: lobbiesCreatorIndex,
+			lex:   true,
+			min:   "[" + prefix,
+			max:   "[" + prefix + "\xff",
+		})
+	}
+
+	return filters
+}
+
+// GetLobbies gets listed lobbies matching filters of the request from the database.
 func (r *Repository) GetLobbies(ctx context.Context, req dto.GetLobbiesRequest) ([]lobby.Lobby, int, error) {
 	ctx, span := r.tracer.Start(ctx, "Lobbies")
 	defer span.End()
@@ -166,23 +1042,60 @@ func (r *Repository) GetLobbies(ctx context.Context, req dto.GetLobbiesRequest)
 	start := (req.Page - 1) * req.PageSize
 	end := start + req.PageSize - 1 // Inclusive range
 
-	// Get paginated lobby IDs from sorted set
-	zrevrangeCmd := r.valkey.B().Zrevrange().
-		Key(lobbiesPrefix).
-		Start(int64(start)).
-		Stop(int64(end)).
-		Build()
+	sortIndex, desc := lobbiesPrefix, true
+
+	switch req.Order {
+	case lobby.OldestOrder:
+		desc = false
+	case lobby.MostPlayersOrder:
+		sortIndex = lobbiesPlayersIndex
+	case lobby.FewestPlayersOrder:
+		sortIndex, desc = lobbiesPlayersIndex, false
+	case lobby.NewestOrder:
+	}
 
-	totalCmd := r.valkey.B().Zcard().Key(lobbiesPrefix).Build()
-	total, _ := r.valkey.Do(ctx, totalCmd).AsInt64()
+	// expired lobbies are removed from indexes first, so that they aren't counted in the total
+	if err := r.pruneExpiredLobbies(ctx); err != nil {
+		return nil, 0, fmt.Errorf("failed to prune expired lobbies: %w", err)
+	}
 
-	lobbyIDs, err := r.valkey.Do(ctx, zrevrangeCmd).AsStrSlice()
+	// temporary keys are unique for the request, so that concurrent requests don't overwrite each other's results
+	queryKey := lobbiesQueryKey + ":" + uuid.New().String()
+
+	keys := []string{sortIndex, queryKey}
+	args := []string{strconv.Itoa(start), strconv.Itoa(end), strconv.FormatBool(desc)}
+
+	for i, f := range lobbiesFilters(req) {
+		kind := "score"
+		if f.lex {
+			kind = "lex"
+		}
+
+		keys = append(keys, f.index, queryKey+":"+strconv.Itoa(i+1))
+		args = append(args, kind, f.min, f.max)
+	}
+
+	resp, err := getLobbiesScript.Exec(ctx, r.valkey, keys, args).ToArray()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get lobby IDs: %w", err)
+	}
+
+	if len(resp) != 2 {
+		return nil, 0, fmt.Errorf("unexpected lobbies response length: %d", len(resp))
+	}
+
+	total, err := resp[0].AsInt64()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get lobbies total: %w", err)
+	}
+
+	lobbyIDs, err := resp[1].AsStrSlice()
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get lobby IDs: %w", err)
 	}
 
 	if len(lobbyIDs) == 0 {
-		return []lobby.Lobby{}, 0, nil
+		return []lobby.Lobby{}, int(total), nil
 	}
 
 	// Pipeline HGETALL commands for all paginated lobbies
@@ -207,6 +1120,11 @@ func (r *Repository) GetLobbies(ctx context.Context, req dto.GetLobbiesRequest)
 		if len(resp) == 0 {
 			slog.Debug("empty lobby data", slog.String("lobbyID", lobbyIDs[i]))
 
+			// the lobby has expired after the expired lobbies were pruned
+			if err := r.unlistLobby(ctx, lobbyIDs[i]); err != nil {
+				slog.Debug("error removing expired lobby from indexes", slog.Any("error", err))
+			}
+
 			continue
 		}
 
@@ -261,15 +1179,155 @@ func parseLobbyData(id string, data map[string]string) (lobby.Lobby, error) {
 		return lobby.Lobby{}, fmt.Errorf("invalid max_players: %w", err)
 	}
 
+	// lobbies created before maps were introduced have no map field
+	var mapID int
+	if v, ok := data[lobbyMapIDField]; ok {
+		mapID, err = strconv.Atoi(v)
+		if err != nil {
+			return lobby.Lobby{}, fmt.Errorf("invalid map_id: %w", err)
+		}
+	}
+
+	// lobbies created before score distance was introduced have no score distance field
+	var scoreDistance float64
+	if v, ok := data[lobbyScoreDistanceField]; ok {
+		scoreDistance, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			return lobby.Lobby{}, fmt.Errorf("invalid score_distance: %w", err)
+		}
+	}
+
+	// lobbies created before scoring modes were introduced are scored as classic
+	scoring := data[lobbyScoringField]
+	if scoring == "" {
+		scoring = string(game.ClassicScoring)
+	}
+
+	// lobbies created before game modes were introduced start classic games
+	mode := data[lobbyModeField]
+	if mode == "" {
+		mode = string(multiplayer.ClassicMode)
+	}
+
+	// lobbies created before teams were introduced have no teams field
+	var teams int
+	if v, ok := data[lobbyTeamsField]; ok {
+		teams, err = strconv.Atoi(v)
+		if err != nil {
+			return lobby.Lobby{}, fmt.Errorf("invalid teams: %w", err)
+		}
+	}
+
+	spectators, spectatorDelay, err := parseLobbySpectators(data)
+	if err != nil {
+		return lobby.Lobby{}, err
+	}
+
+	invitedUserIDs, err := parseUserIDs(data[lobbyInvitedUserIDsField])
+	if err != nil {
+		return lobby.Lobby{}, fmt.Errorf("invalid invited_user_ids: %w", err)
+	}
+
+	// lobbies created before ready-check was introduced don't require players to be ready
+	var requireReady bool
+	if v, ok := data[lobbyRequireReadyField]; ok {
+		requireReady, err = strconv.ParseBool(v)
+		if err != nil {
+			return lobby.Lobby{}, fmt.Errorf("invalid require_ready: %w", err)
+		}
+	}
+
+	// lobbies created before visibility was introduced are public
+	visibility := lobby.Visibility(data[lobbyVisibilityField])
+	if visibility == "" {
+		visibility = lobby.PublicVisibility
+	}
+
+	// providers are stored comma-separated, lobbies created before mixed providers have no providers field
+	var providers []string
+	if v := data[lobbyProvidersField]; v != "" {
+		providers = strings.Split(v, ",")
+	}
+
 	return lobby.Lobby{
-		ID:              id,
-		CreatorID:       creatorID,
-		CreatedAt:       createdAt,
-		Rounds:          rounds,
-		Provider:        data[lobbyProviderField],
-		TimerSeconds:    timerSeconds,
-		MaxPlayers:      maxPlayers,
-		MovementAllowed: movementAllowed,
-		CurrentPlayers:  currentPlayers,
+		ID:                    id,
+		CreatorID:             creatorID,
+		CreatedAt:             createdAt,
+		Rounds:                rounds,
+		Provider:              data[lobbyProviderField],
+		Providers:             providers,
+		TimerSeconds:          timerSeconds,
+		MaxPlayers:            maxPlayers,
+		MovementAllowed:       movementAllowed,
+		CurrentPlayers:        currentPlayers,
+		MapID:                 mapID,
+		ScoreDistance:         scoreDistance,
+		Scoring:               scoring,
+		Mode:                  mode,
+		Teams:                 teams,
+		TeamScoring:           data[lobbyTeamScoringField],
+		Spectators:            spectators,
+		SpectatorDelaySeconds: spectatorDelay,
+		Visibility:            visibility,
+		RequireReady:          requireReady,
+		PasswordHash:          data[lobbyPasswordHashField],
+		InvitedUserIDs:        invitedUserIDs,
 	}, nil
 }
+
+// parseLobbySpectators parses spectator settings of a lobby,
+// lobbies created before spectators were introduced have no spectator fields and can't be spectated.
+func parseLobbySpectators(data map[string]string) (bool, int, error) {
+	var (
+		spectators bool
+		delay      int
+		err        error
+	)
+
+	if v, ok := data[lobbySpectatorsField]; ok {
+		spectators, err = strconv.ParseBool(v)
+		if err != nil {
+			return false, 0, fmt.Errorf("invalid spectators: %w", err)
+		}
+	}
+
+	if v, ok := data[lobbySpectatorDelayField]; ok {
+		delay, err = strconv.Atoi(v)
+		if err != nil {
+			return false, 0, fmt.Errorf("invalid spectator_delay_seconds: %w", err)
+		}
+	}
+
+	return spectators, delay, nil
+}
+
+// joinUserIDs returns user IDs joined with commas.
+func joinUserIDs(ids []int) string {
+	strs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		strs = append(strs, strconv.Itoa(id))
+	}
+
+	return strings.Join(strs, ",")
+}
+
+// parseUserIDs parses comma-separated user IDs, nil is returned for an empty string.
+func parseUserIDs(v string) ([]int, error) {
+	if v == "" {
+		return nil, nil
+	}
+
+	strs := strings.Split(v, ",")
+	ids := make([]int, 0, len(strs))
+
+	for _, str := range strs {
+		id, err := strconv.Atoi(str)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
@@ -2,6 +2,7 @@ package valkey_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -24,6 +25,7 @@ type LobbyTestSuite struct {
 	suite.Suite
 	ctx             context.Context
 	valkeyContainer *containers.ValkeyContainer
+	valkeyClient    valkey.Client
 	valkeyRepo      *valkeyRepo.Repository
 }
 
@@ -39,6 +41,7 @@ func (s *LobbyTestSuite) SetupSuite() {
 	repo := valkeyRepo.New(valkeyClient)
 
 	s.valkeyContainer = valkeyContainer
+	s.valkeyClient = valkeyClient
 	s.valkeyRepo = repo
 }
 
@@ -69,22 +72,75 @@ func (s *LobbyTestSuite) TestNewLobby() {
 	s.WithinDuration(req.RequestTime, l.CreatedAt, 1*time.Second)
 	s.Equal(req.Rounds, l.Rounds)
 	s.Equal(req.Provider, l.Provider)
+	s.Nil(l.Providers)
+	s.Zero(l.ScoreDistance)
+	s.Equal("classic", l.Scoring)
+	s.Equal("classic", l.Mode)
+	s.Zero(l.Teams)
+	s.False(l.Spectators)
+	s.Equal(lobby.PublicVisibility, l.Visibility)
 	s.Equal(req.TimerSeconds, l.TimerSeconds)
 	s.Equal(req.MovementAllowed, l.MovementAllowed)
 	s.Equal(req.MaxPlayers, l.MaxPlayers)
 	s.Equal(0, l.CurrentPlayers)
 }
 
-func (s *LobbyTestSuite) TestGetLobby() {
+func (s *LobbyTestSuite) TestNewLobbyListed() {
 	req := dto.NewLobbyRequestDB{
 		ID:              gofakeit.UUID(),
-		CreatorID:       gofakeit.IntRange(1, 100),
+		CreatorID:       1,
+		CreatorUsername: "Creator",
 		RequestTime:     time.Now().UTC(),
-		Rounds:          gofakeit.IntRange(1, 10),
+		Rounds:          3,
 		Provider:        "google",
-		TimerSeconds:    gofakeit.IntRange(10, 60),
-		MovementAllowed: true,
-		MaxPlayers:      gofakeit.IntRange(2, 10),
+		TimerSeconds:    60,
+		MaxPlayers:      4,
+		Visibility:      lobby.PublicVisibility,
+	}
+
+	s.Require().NoError(s.valkeyRepo.NewLobby(s.ctx, req))
+
+	for index, want := range map[string]float64{
+		"lobbies:sorted":          float64(req.RequestTime.Unix()),
+		"lobbies:players":         0,
+		"lobbies:freeSlots":       float64(req.MaxPlayers),
+		"lobbies:rounds":          float64(req.Rounds),
+		"lobbies:timer":           float64(req.TimerSeconds),
+		"lobbies:provider:google": float64(req.RequestTime.Unix()),
+	} {
+		score, err := s.valkeyClient.Do(s.ctx, s.valkeyClient.B().Zscore().Key(index).Member(req.ID).Build()).AsFloat64()
+		s.Require().NoError(err, index)
+		s.InDelta(want, score, 0, index)
+	}
+
+	hidden := req
+	hidden.ID = gofakeit.UUID()
+	hidden.Visibility = lobby.UnlistedVisibility
+
+	s.Require().NoError(s.valkeyRepo.NewLobby(s.ctx, hidden))
+
+	err := s.valkeyClient.Do(s.ctx, s.valkeyClient.B().Zscore().Key("lobbies:sorted").Member(hidden.ID).Build()).Error()
+	s.True(valkey.IsValkeyNil(err))
+}
+
+func (s *LobbyTestSuite) TestGetLobby() {
+	req := dto.NewLobbyRequestDB{
+		ID:                    gofakeit.UUID(),
+		CreatorID:             gofakeit.IntRange(1, 100),
+		RequestTime:           time.Now().UTC(),
+		Rounds:                gofakeit.IntRange(1, 10),
+		Provider:              "mixed",
+		Providers:             []string{"google", "seznam"},
+		ScoreDistance:         123.5,
+		Scoring:               "timed",
+		Mode:                  "battle_royale",
+		Teams:                 2,
+		TeamScoring:           "average",
+		Spectators:            true,
+		SpectatorDelaySeconds: 30,
+		TimerSeconds:          gofakeit.IntRange(10, 60),
+		MovementAllowed:       true,
+		MaxPlayers:            gofakeit.IntRange(2, 10),
 	}
 
 	err := s.valkeyRepo.NewLobby(s.ctx, req)
@@ -97,6 +153,14 @@ func (s *LobbyTestSuite) TestGetLobby() {
 	s.Equal(req.CreatorID, l.CreatorID)
 	s.Equal(req.Rounds, l.Rounds)
 	s.Equal(req.Provider, l.Provider)
+	s.Equal(req.Providers, l.Providers)
+	s.InDelta(req.ScoreDistance, l.ScoreDistance, 0.001)
+	s.Equal(req.Scoring, l.Scoring)
+	s.Equal(req.Mode, l.Mode)
+	s.Equal(req.Teams, l.Teams)
+	s.Equal(req.TeamScoring, l.TeamScoring)
+	s.Equal(req.Spectators, l.Spectators)
+	s.Equal(req.SpectatorDelaySeconds, l.SpectatorDelaySeconds)
 	s.Equal(req.TimerSeconds, l.TimerSeconds)
 	s.Equal(req.MovementAllowed, l.MovementAllowed)
 	s.Equal(req.MaxPlayers, l.MaxPlayers)
@@ -110,8 +174,15 @@ func (s *LobbyTestSuite) TestGetLobby() {
 
 func (s *LobbyTestSuite) TestGetLobbies() {
 	newLobbyIDs := make([]string, 0, 3)
-
-	for range 3 {
+	hiddenLobbyIDs := make([]string, 0, 2)
+
+	for _, visibility := range []lobby.Visibility{
+		lobby.PublicVisibility,
+		lobby.UnlistedVisibility,
+		lobby.PasswordVisibility,
+		lobby.InviteOnlyVisibility,
+		lobby.PublicVisibility,
+	} {
 		req := dto.NewLobbyRequestDB{
 			ID:              gofakeit.UUID(),
 			CreatorID:       gofakeit.IntRange(1, 100),
@@ -121,11 +192,17 @@ func (s *LobbyTestSuite) TestGetLobbies() {
 			TimerSeconds:    gofakeit.IntRange(10, 60),
 			MovementAllowed: true,
 			MaxPlayers:      gofakeit.IntRange(2, 10),
+			Visibility:      visibility,
 		}
 
 		err := s.valkeyRepo.NewLobby(s.ctx, req)
 		s.Require().NoError(err)
 
+		if !visibility.Listed() {
+			hiddenLobbyIDs = append(hiddenLobbyIDs, req.ID)
+			continue
+		}
+
 		newLobbyIDs = append(newLobbyIDs, req.ID)
 	}
 
@@ -145,6 +222,52 @@ func (s *LobbyTestSuite) TestGetLobbies() {
 	for _, id := range newLobbyIDs {
 		s.Contains(gotLobbyIDs, id)
 	}
+
+	for _, id := range hiddenLobbyIDs {
+		s.NotContains(gotLobbyIDs, id)
+	}
+}
+
+func (s *LobbyTestSuite) TestLobbyVisibility() {
+	req := dto.NewLobbyRequestDB{
+		ID:             gofakeit.UUID(),
+		CreatorID:      gofakeit.IntRange(1, 100),
+		RequestTime:    time.Now().UTC(),
+		Rounds:         gofakeit.IntRange(1, 10),
+		Provider:       "google",
+		MaxPlayers:     gofakeit.IntRange(2, 10),
+		Visibility:     lobby.InviteOnlyVisibility,
+		InvitedUserIDs: []int{101, 102},
+	}
+
+	err := s.valkeyRepo.NewLobby(s.ctx, req)
+	s.Require().NoError(err)
+
+	l, err := s.valkeyRepo.GetLobby(s.ctx, req.ID)
+	s.Require().NoError(err)
+	s.Equal(lobby.InviteOnlyVisibility, l.Visibility)
+	s.Equal(req.InvitedUserIDs, l.InvitedUserIDs)
+	s.Empty(l.PasswordHash)
+
+	req = dto.NewLobbyRequestDB{
+		ID:           gofakeit.UUID(),
+		CreatorID:    gofakeit.IntRange(1, 100),
+		RequestTime:  time.Now().UTC(),
+		Rounds:       gofakeit.IntRange(1, 10),
+		Provider:     "google",
+		MaxPlayers:   gofakeit.IntRange(2, 10),
+		Visibility:   lobby.PasswordVisibility,
+		PasswordHash: "hash",
+	}
+
+	err = s.valkeyRepo.NewLobby(s.ctx, req)
+	s.Require().NoError(err)
+
+	l, err = s.valkeyRepo.GetLobby(s.ctx, req.ID)
+	s.Require().NoError(err)
+	s.Equal(lobby.PasswordVisibility, l.Visibility)
+	s.Equal(req.PasswordHash, l.PasswordHash)
+	s.Nil(l.InvitedUserIDs)
 }
 
 func (s *LobbyTestSuite) TestIncrementLobbyPlayers() {
@@ -170,6 +293,18 @@ func (s *LobbyTestSuite) TestIncrementLobbyPlayers() {
 	l, err := s.valkeyRepo.GetLobby(s.ctx, req.ID)
 	s.Require().NoError(err)
 	s.Equal(2, l.CurrentPlayers)
+
+	s.Require().NoError(s.valkeyRepo.DeleteLobby(s.ctx, req.ID))
+
+	// deleted lobby isn't recreated by players leaving or joining it
+	err = s.valkeyRepo.IncrementLobbyPlayers(s.ctx, req.ID)
+	s.Require().ErrorIs(err, lobby.ErrNotFound)
+
+	err = s.valkeyRepo.DecrementLobbyPlayers(s.ctx, req.ID)
+	s.Require().ErrorIs(err, lobby.ErrNotFound)
+
+	_, err = s.valkeyRepo.GetLobby(s.ctx, req.ID)
+	s.Equal(lobby.ErrNotFound, err)
 }
 
 func (s *LobbyTestSuite) TestDecrementLobbyPlayers() {
@@ -200,6 +335,535 @@ func (s *LobbyTestSuite) TestDecrementLobbyPlayers() {
 	s.Equal(1, l.CurrentPlayers)
 }
 
+func (s *LobbyTestSuite) TestUpdateLobbySettings() {
+	req := dto.NewLobbyRequestDB{
+		ID:              gofakeit.UUID(),
+		CreatorID:       gofakeit.IntRange(1, 100),
+		RequestTime:     time.Now().UTC(),
+		Rounds:          5,
+		Provider:        "google",
+		TimerSeconds:    60,
+		MovementAllowed: true,
+		MaxPlayers:      5,
+	}
+
+	err := s.valkeyRepo.NewLobby(s.ctx, req)
+	s.Require().NoError(err)
+
+	for range 3 {
+		err = s.valkeyRepo.IncrementLobbyPlayers(s.ctx, req.ID)
+		s.Require().NoError(err)
+	}
+
+	settings := lobby.Settings{
+		Rounds:          3,
+		Provider:        "mixed",
+		Providers:       []string{"google", "yandex"},
+		TimerSeconds:    0,
+		MovementAllowed: false,
+		MaxPlayers:      3,
+		RequireReady:    true,
+	}
+
+	err = s.valkeyRepo.UpdateLobbySettings(s.ctx, dto.UpdateLobbySettingsRequestDB{
+		ID:       req.ID,
+		Settings: settings,
+	})
+	s.Require().NoError(err)
+
+	l, err := s.valkeyRepo.GetLobby(s.ctx, req.ID)
+	s.Require().NoError(err)
+	s.Equal(settings.Rounds, l.Rounds)
+	s.Equal(settings.Provider, l.Provider)
+	s.Equal(settings.Providers, l.Providers)
+	s.Equal(settings.TimerSeconds, l.TimerSeconds)
+	s.Equal(settings.MovementAllowed, l.MovementAllowed)
+	s.Equal(settings.MaxPlayers, l.MaxPlayers)
+	s.Equal(settings.RequireReady, l.RequireReady)
+	s.Equal(3, l.CurrentPlayers)
+
+	// max players can't be lower than the amount of connected players
+	settings.MaxPlayers = 2
+
+	err = s.valkeyRepo.UpdateLobbySettings(s.ctx, dto.UpdateLobbySettingsRequestDB{
+		ID:       req.ID,
+		Settings: settings,
+	})
+	s.Require().ErrorIs(err, lobby.ErrWrongSettings)
+
+	err = s.valkeyRepo.UpdateLobbySettings(s.ctx, dto.UpdateLobbySettingsRequestDB{
+		ID:       gofakeit.UUID(),
+		Settings: settings,
+	})
+	s.Require().ErrorIs(err, lobby.ErrNotFound)
+}
+
+func (s *LobbyTestSuite) TestLobbyTeams() {
+	lobbyID := gofakeit.UUID()
+
+	teams, err := s.valkeyRepo.GetLobbyTeams(s.ctx, lobbyID)
+	s.Require().NoError(err)
+	s.Empty(teams)
+
+	for _, req := range []dto.SwitchLobbyTeamRequest{
+		{LobbyID: lobbyID, UserID: 1, Team: 1},
+		{LobbyID: lobbyID, UserID: 2, Team: 2},
+		// users can switch teams
+		{LobbyID: lobbyID, UserID: 1, Team: 2},
+	} {
+		s.Require().NoError(s.valkeyRepo.SetLobbyUserTeam(s.ctx, req))
+	}
+
+	teams, err = s.valkeyRepo.GetLobbyTeams(s.ctx, lobbyID)
+	s.Require().NoError(err)
+	s.Equal(map[int]int{1: 2, 2: 2}, teams)
+
+	s.Require().NoError(s.valkeyRepo.DeleteLobby(s.ctx, lobbyID))
+
+	teams, err = s.valkeyRepo.GetLobbyTeams(s.ctx, lobbyID)
+	s.Require().NoError(err)
+	s.Empty(teams)
+}
+
+func (s *LobbyTestSuite) TestLobbyPlayers() {
+	lobbyID := gofakeit.UUID()
+
+	for _, userID := range []int{1, 2, 3, 2} {
+		s.Require().NoError(s.valkeyRepo.AddLobbyPlayer(s.ctx, lobbyID, userID))
+	}
+
+	players, err := s.valkeyRepo.GetLobbyPlayers(s.ctx, lobbyID)
+	s.Require().NoError(err)
+	s.Equal([]int{1, 2, 3, 2}, players)
+
+	// only one connection of the user is removed
+	s.Require().NoError(s.valkeyRepo.RemoveLobbyPlayer(s.ctx, lobbyID, 2))
+	s.Require().NoError(s.valkeyRepo.RemoveLobbyPlayer(s.ctx, lobbyID, 1))
+
+	players, err = s.valkeyRepo.GetLobbyPlayers(s.ctx, lobbyID)
+	s.Require().NoError(err)
+	s.Equal([]int{3, 2}, players)
+
+	s.Require().NoError(s.valkeyRepo.DeleteLobby(s.ctx, lobbyID))
+
+	players, err = s.valkeyRepo.GetLobbyPlayers(s.ctx, lobbyID)
+	s.Require().NoError(err)
+	s.Empty(players)
+}
+
+func (s *LobbyTestSuite) TestSetLobbyCreator() {
+	req := dto.NewLobbyRequestDB{
+		ID:          gofakeit.UUID(),
+		CreatorID:   1,
+		RequestTime: time.Now().UTC(),
+		Rounds:      gofakeit.IntRange(1, 10),
+		Provider:    "google",
+		MaxPlayers:  gofakeit.IntRange(2, 10),
+	}
+
+	err := s.valkeyRepo.NewLobby(s.ctx, req)
+	s.Require().NoError(err)
+
+	set, err := s.valkeyRepo.SetLobbyCreator(s.ctx, dto.SetLobbyCreatorRequestDB{
+		ID:              req.ID,
+		OldCreatorID:    1,
+		CreatorID:       2,
+		CreatorUsername: "NewHost" + req.ID,
+	})
+	s.Require().NoError(err)
+	s.True(set)
+
+	// host role is passed only by its current holder
+	set, err = s.valkeyRepo.SetLobbyCreator(s.ctx, dto.SetLobbyCreatorRequestDB{
+		ID:              req.ID,
+		OldCreatorID:    1,
+		CreatorID:       3,
+		CreatorUsername: "OtherHost" + req.ID,
+	})
+	s.Require().NoError(err)
+	s.False(set)
+
+	l, err := s.valkeyRepo.GetLobby(s.ctx, req.ID)
+	s.Require().NoError(err)
+	s.Equal(2, l.CreatorID)
+
+	s.Require().NoError(s.valkeyRepo.DeleteLobby(s.ctx, req.ID))
+
+	_, err = s.valkeyRepo.SetLobbyCreator(s.ctx, dto.SetLobbyCreatorRequestDB{
+		ID:              req.ID,
+		OldCreatorID:    2,
+		CreatorID:       3,
+		CreatorUsername: "OtherHost" + req.ID,
+	})
+	s.Require().ErrorIs(err, lobby.ErrNotFound)
+}
+
+func (s *LobbyTestSuite) TestGetLobbiesFilters() {
+	// unique provider and creator username, so that lobbies of other tests are not listed
+	provider := gofakeit.UUID()
+	creator := "Creator" + provider
+	now := time.Now().UTC()
+
+	reqs := []dto.NewLobbyRequestDB{
+		{
+			ID:              gofakeit.UUID(),
+			CreatorID:       1,
+			CreatorUsername: creator,
+			RequestTime:     now.Add(-2 * time.Minute),
+			Rounds:          3,
+			Provider:        provider,
+			TimerSeconds:    60,
+			MovementAllowed: true,
+			MaxPlayers:      2,
+			Visibility:      lobby.PublicVisibility,
+		},
+		{
+			ID:              gofakeit.UUID(),
+			CreatorID:       2,
+			CreatorUsername: "Other" + provider,
+			RequestTime:     now.Add(-time.Minute),
+			Rounds:          5,
+			Provider:        provider,
+			TimerSeconds:    120,
+			MovementAllowed: false,
+			MaxPlayers:      5,
+			Visibility:      lobby.PublicVisibility,
+		},
+		{
+			ID:              gofakeit.UUID(),
+			CreatorID:       3,
+			CreatorUsername: creator,
+			RequestTime:     now,
+			Rounds:          8,
+			Provider:        provider,
+			TimerSeconds:    60,
+			MovementAllowed: false,
+			MaxPlayers:      5,
+			Visibility:      lobby.PublicVisibility,
+		},
+	}
+
+	for _, req := range reqs {
+		s.Require().NoError(s.valkeyRepo.NewLobby(s.ctx, req))
+	}
+
+	// first lobby is full, second lobby has more players than the third one
+	for range 2 {
+		s.Require().NoError(s.valkeyRepo.IncrementLobbyPlayers(s.ctx, reqs[0].ID))
+	}
+
+	for range 3 {
+		s.Require().NoError(s.valkeyRepo.IncrementLobbyPlayers(s.ctx, reqs[1].ID))
+	}
+
+	s.Require().NoError(s.valkeyRepo.IncrementLobbyPlayers(s.ctx, reqs[2].ID))
+
+	timer := 60
+	movement := false
+
+	tests := []struct {
+		name string
+		req  dto.GetLobbiesRequest
+		want []string
+	}{
+		{
+			name: "newest first",
+			req:  dto.GetLobbiesRequest{},
+			want: []string{reqs[2].ID, reqs[1].ID, reqs[0].ID},
+		},
+		{
+			name: "oldest first",
+			req:  dto.GetLobbiesRequest{Order: lobby.OldestOrder},
+			want: []string{reqs[0].ID, reqs[1].ID, reqs[2].ID},
+		},
+		{
+			name: "most players first",
+			req:  dto.GetLobbiesRequest{Order: lobby.MostPlayersOrder},
+			want: []string{reqs[1].ID, reqs[0].ID, reqs[2].ID},
+		},
+		{
+			name: "fewest players first",
+			req:  dto.GetLobbiesRequest{Order: lobby.FewestPlayersOrder},
+			want: []string{reqs[2].ID, reqs[0].ID, reqs[1].ID},
+		},
+		{
+			name: "rounds range",
+			req:  dto.GetLobbiesRequest{RoundsMin: 4, RoundsMax: 10},
+			want: []string{reqs[2].ID, reqs[1].ID},
+		},
+		{
+			name: "timer",
+			req:  dto.GetLobbiesRequest{TimerSeconds: &timer},
+			want: []string{reqs[2].ID, reqs[0].ID},
+		},
+		{
+			name: "movement not allowed",
+			req:  dto.GetLobbiesRequest{MovementAllowed: &movement},
+			want: []string{reqs[2].ID, reqs[1].ID},
+		},
+		{
+			name: "free slots",
+			req:  dto.GetLobbiesRequest{FreeSlots: true},
+			want: []string{reqs[2].ID, reqs[1].ID},
+		},
+		{
+			name: "creator username prefix",
+			req:  dto.GetLobbiesRequest{CreatorUsername: strings.ToUpper(creator[:12])},
+			want: []string{reqs[2].ID, reqs[0].ID},
+		},
+		{
+			name: "combined filters",
+			req: dto.GetLobbiesRequest{
+				Order:           lobby.OldestOrder,
+				TimerSeconds:    &timer,
+				FreeSlots:       true,
+				CreatorUsername: creator,
+			},
+			want: []string{reqs[2].ID},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.req.Page = 1
+			tt.req.PageSize = 10
+			tt.req.Provider = provider
+
+			lobbies, total, err := s.valkeyRepo.GetLobbies(s.ctx, tt.req)
+			s.Require().NoError(err)
+			s.Equal(len(tt.want), total)
+
+			got := make([]string, len(lobbies))
+			for i, l := range lobbies {
+				got[i] = l.ID
+			}
+
+			s.Equal(tt.want, got)
+		})
+	}
+
+	// new host is found by their username
+	set, err := s.valkeyRepo.SetLobbyCreator(s.ctx, dto.SetLobbyCreatorRequestDB{
+		ID:              reqs[1].ID,
+		OldCreatorID:    2,
+		CreatorID:       1,
+		CreatorUsername: creator,
+	})
+	s.Require().NoError(err)
+	s.True(set)
+
+	lobbies, total, err := s.valkeyRepo.GetLobbies(s.ctx, dto.GetLobbiesRequest{
+		Page:            1,
+		PageSize:        10,
+		CreatorUsername: "Other" + provider,
+	})
+	s.Require().NoError(err)
+	s.Zero(total)
+	s.Empty(lobbies)
+
+	lobbies, total, err = s.valkeyRepo.GetLobbies(s.ctx, dto.GetLobbiesRequest{
+		Page:            1,
+		PageSize:        10,
+		Provider:        provider,
+		CreatorUsername: creator,
+	})
+	s.Require().NoError(err)
+	s.Equal(3, total)
+	s.Len(lobbies, 3)
+
+	// deleted lobbies are removed from indexes
+	for _, req := range reqs {
+		s.Require().NoError(s.valkeyRepo.DeleteLobby(s.ctx, req.ID))
+	}
+
+	lobbies, total, err = s.valkeyRepo.GetLobbies(s.ctx, dto.GetLobbiesRequest{
+		Page:     1,
+		PageSize: 10,
+		Provider: provider,
+	})
+	s.Require().NoError(err)
+	s.Zero(total)
+	s.Empty(lobbies)
+}
+
+func (s *LobbyTestSuite) TestGetLobbiesFiltersExpired() {
+	// unique provider and creator username, so that lobbies of other tests are not listed
+	provider := gofakeit.UUID()
+	creator := "Creator" + provider
+
+	req := dto.NewLobbyRequestDB{
+		ID:              gofakeit.UUID(),
+		CreatorID:       1,
+		CreatorUsername: creator,
+		RequestTime:     time.Now().UTC(),
+		Rounds:          3,
+		Provider:        provider,
+		TimerSeconds:    60,
+		MaxPlayers:      2,
+		Visibility:      lobby.PublicVisibility,
+	}
+
+	s.Require().NoError(s.valkeyRepo.NewLobby(s.ctx, req))
+	s.Require().NoError(s.valkeyRepo.AddLobbyExpiration(s.ctx, req.ID, time.Second))
+
+	time.Sleep(2 * time.Second)
+
+	// the expired lobby is removed from every index before lobbies are counted
+	for _, filter := range []dto.GetLobbiesRequest{
+		{Page: 1, PageSize: 10, Provider: provider},
+		{Page: 1, PageSize: 10, CreatorUsername: creator},
+	} {
+		lobbies, total, err := s.valkeyRepo.GetLobbies(s.ctx, filter)
+		s.Require().NoError(err)
+		s.Zero(total)
+		s.Empty(lobbies)
+	}
+
+	for _, index := range []string{"lobbies:provider:" + provider, "lobbies:creator"} {
+		cmd := s.valkeyClient.B().Zrange().Key(index).Min("0").Max("-1").Build()
+
+		members, err := s.valkeyClient.Do(s.ctx, cmd).AsStrSlice()
+		s.Require().NoError(err)
+
+		for _, member := range members {
+			s.NotContains(member, req.ID)
+		}
+	}
+}
+
+func (s *LobbyTestSuite) TestLobbyBans() {
+	lobbyID := gofakeit.UUID()
+
+	banned, err := s.valkeyRepo.IsLobbyUserBanned(s.ctx, lobbyID, 1)
+	s.Require().NoError(err)
+	s.False(banned)
+
+	s.Require().NoError(s.valkeyRepo.BanLobbyUser(s.ctx, lobbyID, 1))
+
+	banned, err = s.valkeyRepo.IsLobbyUserBanned(s.ctx, lobbyID, 1)
+	s.Require().NoError(err)
+	s.True(banned)
+
+	// bans are kept per lobby
+	banned, err = s.valkeyRepo.IsLobbyUserBanned(s.ctx, gofakeit.UUID(), 1)
+	s.Require().NoError(err)
+	s.False(banned)
+
+	s.Require().NoError(s.valkeyRepo.DeleteLobby(s.ctx, lobbyID))
+
+	banned, err = s.valkeyRepo.IsLobbyUserBanned(s.ctx, lobbyID, 1)
+	s.Require().NoError(err)
+	s.False(banned)
+}
+
+func (s *LobbyTestSuite) TestLobbyReadyUsers() {
+	lobbyID := gofakeit.UUID()
+
+	ready, err := s.valkeyRepo.GetLobbyReadyUsers(s.ctx, lobbyID)
+	s.Require().NoError(err)
+	s.Empty(ready)
+
+	for _, req := range []dto.SetLobbyUserReadyRequest{
+		{LobbyID: lobbyID, UserID: 1, Ready: true},
+		{LobbyID: lobbyID, UserID: 2, Ready: true},
+		{LobbyID: lobbyID, UserID: 3, Ready: true},
+		// users can change their mind
+		{LobbyID: lobbyID, UserID: 2, Ready: false},
+		{LobbyID: lobbyID, UserID: 4, Ready: false},
+	} {
+		s.Require().NoError(s.valkeyRepo.SetLobbyUserReady(s.ctx, req))
+	}
+
+	ready, err = s.valkeyRepo.GetLobbyReadyUsers(s.ctx, lobbyID)
+	s.Require().NoError(err)
+	s.ElementsMatch([]int{1, 3}, ready)
+
+	s.Require().NoError(s.valkeyRepo.ResetLobbyReady(s.ctx, lobbyID))
+
+	ready, err = s.valkeyRepo.GetLobbyReadyUsers(s.ctx, lobbyID)
+	s.Require().NoError(err)
+	s.Empty(ready)
+
+	s.Require().NoError(s.valkeyRepo.SetLobbyUserReady(s.ctx, dto.SetLobbyUserReadyRequest{
+		LobbyID: lobbyID,
+		UserID:  1,
+		Ready:   true,
+	}))
+	s.Require().NoError(s.valkeyRepo.DeleteLobby(s.ctx, lobbyID))
+
+	ready, err = s.valkeyRepo.GetLobbyReadyUsers(s.ctx, lobbyID)
+	s.Require().NoError(err)
+	s.Empty(ready)
+}
+
+func (s *LobbyTestSuite) TestLobbyCountdown() {
+	lobbyID := gofakeit.UUID()
+	ttl := time.Minute
+
+	started, err := s.valkeyRepo.SetLobbyCountdown(s.ctx, lobbyID, "first", ttl)
+	s.Require().NoError(err)
+	s.True(started)
+
+	// the countdown is started only once, even if everyone got ready on several instances
+	started, err = s.valkeyRepo.SetLobbyCountdown(s.ctx, lobbyID, "second", ttl)
+	s.Require().NoError(err)
+	s.False(started)
+
+	// the game can't be started by another countdown or without one while the countdown is pending
+	for _, token := range []string{"second", ""} {
+		claimed, err := s.valkeyRepo.ClaimLobbyGameStart(s.ctx, lobbyID, token, ttl)
+		s.Require().NoError(err)
+		s.False(claimed)
+	}
+
+	// any instance can cancel the countdown
+	cancelled, err := s.valkeyRepo.DeleteLobbyCountdown(s.ctx, lobbyID, "")
+	s.Require().NoError(err)
+	s.True(cancelled)
+
+	claimed, err := s.valkeyRepo.ClaimLobbyGameStart(s.ctx, lobbyID, "first", ttl)
+	s.Require().NoError(err)
+	s.False(claimed)
+
+	started, err = s.valkeyRepo.SetLobbyCountdown(s.ctx, lobbyID, "second", ttl)
+	s.Require().NoError(err)
+	s.True(started)
+
+	// only the countdown with the token can be cancelled by its holder
+	cancelled, err = s.valkeyRepo.DeleteLobbyCountdown(s.ctx, lobbyID, "first")
+	s.Require().NoError(err)
+	s.False(cancelled)
+
+	claimed, err = s.valkeyRepo.ClaimLobbyGameStart(s.ctx, lobbyID, "second", ttl)
+	s.Require().NoError(err)
+	s.True(claimed)
+
+	// the game that is being started can't be cancelled, started again or by a new countdown
+	cancelled, err = s.valkeyRepo.DeleteLobbyCountdown(s.ctx, lobbyID, "")
+	s.Require().NoError(err)
+	s.False(cancelled)
+
+	claimed, err = s.valkeyRepo.ClaimLobbyGameStart(s.ctx, lobbyID, "", ttl)
+	s.Require().NoError(err)
+	s.False(claimed)
+
+	started, err = s.valkeyRepo.SetLobbyCountdown(s.ctx, lobbyID, "third", ttl)
+	s.Require().NoError(err)
+	s.False(started)
+
+	s.Require().NoError(s.valkeyRepo.ReleaseLobbyGameStart(s.ctx, lobbyID))
+
+	claimed, err = s.valkeyRepo.ClaimLobbyGameStart(s.ctx, lobbyID, "", ttl)
+	s.Require().NoError(err)
+	s.True(claimed)
+
+	s.Require().NoError(s.valkeyRepo.DeleteLobby(s.ctx, lobbyID))
+
+	started, err = s.valkeyRepo.SetLobbyCountdown(s.ctx, lobbyID, "fourth", ttl)
+	s.Require().NoError(err)
+	s.True(started)
+}
+
 func (s *LobbyTestSuite) TestDeleteLobby() {
 	req := dto.NewLobbyRequestDB{
 		ID:              gofakeit.UUID(),
@@ -247,10 +911,26 @@ func (s *LobbyTestSuite) TestAddLobbyExpiration() {
 	_, err = s.valkeyRepo.GetLobby(s.ctx, req.ID)
 	s.Require().NoError(err)
 
+	// keys created while the lobby is empty expire together with it
+	s.Require().NoError(s.valkeyRepo.BanLobbyUser(s.ctx, req.ID, 1))
+	s.Require().NoError(s.valkeyRepo.SetLobbyUserReady(s.ctx, dto.SetLobbyUserReadyRequest{
+		LobbyID: req.ID,
+		UserID:  2,
+		Ready:   true,
+	}))
+
 	time.Sleep(3 * time.Second)
 
 	_, err = s.valkeyRepo.GetLobby(s.ctx, req.ID)
 	s.Equal(lobby.ErrNotFound, err)
+
+	banned, err := s.valkeyRepo.IsLobbyUserBanned(s.ctx, req.ID, 1)
+	s.Require().NoError(err)
+	s.False(banned)
+
+	ready, err := s.valkeyRepo.GetLobbyReadyUsers(s.ctx, req.ID)
+	s.Require().NoError(err)
+	s.Empty(ready)
 }
 
 func (s *LobbyTestSuite) TestDeleteLobbyExpiration() {
@@ -1,8 +1,6 @@
 package token
 
-import (
-	"errors"
-)
+import "errors"
 
 // Type is a type of token (access or refresh).
 type Type string
@@ -12,15 +10,18 @@ const (
 	AccessToken Type = "access"
 	// RefreshToken is a type of refresh token.
 	RefreshToken Type = "refresh"
+	// PanoramaToken is a type of panorama token.
+	PanoramaToken Type = "panorama"
 )
 
 // A list of keys in the claims.
 const (
-	ClaimsSessionIDKey string = "sessionID"
-	ClaimsUserIDKey    string = "userID"
-	ClaimsUsernameKey  string = "username"
-	ClaimsNameKey      string = "name"
-	ClaimsTokenTypeKey string = "type"
+	ClaimsSessionIDKey    string = "sessionID"
+	ClaimsUserIDKey       string = "userID"
+	ClaimsUsernameKey     string = "username"
+	ClaimsNameKey         string = "name"
+	ClaimsTokenTypeKey    string = "type"
+	ClaimsStreetviewIDKey string = "streetviewID"
 )
 
 var (
@@ -34,6 +35,8 @@ var (
 	ErrClaimsTypeNotFound = errors.New("type claim not found")
 	// ErrClaimsSessionIDNotFound is returned when the sessionID claim is not found in the claims.
 	ErrClaimsSessionIDNotFound = errors.New("sessionID claim not found")
+	// ErrClaimsStreetviewIDNotFound is returned when the streetviewID claim is not found in the claims.
+	ErrClaimsStreetviewIDNotFound = errors.New("streetviewID claim not found")
 )
 
 // GetUserID returns the user ID from the claims.
@@ -85,3 +88,13 @@ func GetSessionID(claims map[string]any) (string, error) {
 
 	return sessionID, nil
 }
+
+// GetStreetviewID returns the panorama streetview ID from the claims.
+func GetStreetviewID(claims map[string]any) (string, error) {
+	streetviewID, ok := claims[ClaimsStreetviewIDKey].(string)
+	if !ok {
+		return "", ErrClaimsStreetviewIDNotFound
+	}
+
+	return streetviewID, nil
+}
@@ -0,0 +1,79 @@
+package token
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/user"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwe"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+// EncryptionMethod is a key encryption method used to encrypt panorama tokens.
+const EncryptionMethod = jwa.DIRECT
+
+// NewPanoramaToken creates new encrypted panorama token string,
+// so that panorama location can't be read by clients.
+func (s *Service) NewPanoramaToken(current time.Time, req game.PanoramaTokenClaims) (string, error) {
+	expirationTime := current.Add(s.panoramaTokenTTL)
+
+	panoramaToken, err := jwt.NewBuilder().
+		IssuedAt(current).
+		Expiration(expirationTime).
+		Claim(ClaimsStreetviewIDKey, req.StreetviewID).
+		Claim(ClaimsTokenTypeKey, PanoramaToken).
+		Build()
+	if err != nil {
+		return "", fmt.Errorf("failed to build panorama token: %w", err)
+	}
+
+	encryptedToken, err := jwt.NewSerializer().
+		Encrypt(jwt.WithKey(EncryptionMethod, s.panoramaKey)).
+		Serialize(panoramaToken)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt panorama token: %w", err)
+	}
+
+	return string(encryptedToken), nil
+}
+
+// ParsePanoramaToken decrypts panorama token and returns its claims.
+func (s *Service) ParsePanoramaToken(token string) (game.PanoramaTokenClaims, error) {
+	payload, err := jwe.Decrypt([]byte(token), jwe.WithKey(EncryptionMethod, s.panoramaKey))
+	if err != nil {
+		return game.PanoramaTokenClaims{}, fmt.Errorf("error decrypting panorama token: %w", err)
+	}
+
+	panoramaToken, err := jwt.Parse(payload,
+		jwt.WithVerify(false),
+		jwt.WithAcceptableSkew(parsingAcceptableSkew),
+	)
+	if err != nil {
+		return game.PanoramaTokenClaims{}, fmt.Errorf("error parsing panorama token: %w", err)
+	}
+
+	claims := panoramaToken.PrivateClaims()
+	if claims == nil {
+		return game.PanoramaTokenClaims{}, ErrNoPrivateClaims
+	}
+
+	tokenType, err := GetType(claims)
+	if err != nil {
+		return game.PanoramaTokenClaims{}, err
+	}
+
+	if tokenType != PanoramaToken {
+		return game.PanoramaTokenClaims{}, user.ErrWrongTokenType
+	}
+
+	streetviewID, err := GetStreetviewID(claims)
+	if err != nil {
+		return game.PanoramaTokenClaims{}, err
+	}
+
+	return game.PanoramaTokenClaims{
+		StreetviewID: streetviewID,
+	}, nil
+}
@@ -3,6 +3,7 @@ package token
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"time"
@@ -25,20 +26,26 @@ type tokenCtxKey struct{}
 
 // Service is a service for working with tokens.
 type Service struct {
-	jwtSecret       []byte
-	accessTokenTTL  time.Duration
-	refreshTokenTTL time.Duration
+	jwtSecret        []byte
+	panoramaKey      []byte
+	accessTokenTTL   time.Duration
+	refreshTokenTTL  time.Duration
+	panoramaTokenTTL time.Duration
 }
 
 // NewService creates new token service.
 func NewService(
 	jwtSecret string,
-	accessTokenTTL, refreshTokenTTL time.Duration,
+	accessTokenTTL, refreshTokenTTL, panoramaTokenTTL time.Duration,
 ) *Service {
+	panoramaKey := sha256.Sum256([]byte("panorama:" + jwtSecret))
+
 	return &Service{
-		jwtSecret:       []byte(jwtSecret),
-		accessTokenTTL:  accessTokenTTL,
-		refreshTokenTTL: refreshTokenTTL,
+		jwtSecret:        []byte(jwtSecret),
+		panoramaKey:      panoramaKey[:],
+		accessTokenTTL:   accessTokenTTL,
+		refreshTokenTTL:  refreshTokenTTL,
+		panoramaTokenTTL: panoramaTokenTTL,
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/VasySS/segoya-backend/internal/entity/game"
 	"github.com/VasySS/segoya-backend/internal/entity/user"
 	"github.com/VasySS/segoya-backend/internal/infrastructure/token"
 	"github.com/lestrrat-go/jwx/v2/jwt"
@@ -12,7 +13,7 @@ import (
 )
 
 func setupService(jwtSecret []byte, accessTokenTTL, refreshTokenTTL time.Duration) *token.Service {
-	return token.NewService(string(jwtSecret), accessTokenTTL, refreshTokenTTL)
+	return token.NewService(string(jwtSecret), accessTokenTTL, refreshTokenTTL, time.Hour)
 }
 
 func TestNewAccessToken(t *testing.T) {
@@ -269,6 +270,72 @@ func TestParseRefreshToken(t *testing.T) {
 	})
 }
 
+func TestPanoramaToken(t *testing.T) {
+	t.Parallel()
+
+	var (
+		jwtSecret       = []byte("jwt-secret")
+		accessTokenTTL  = time.Hour
+		refreshTokenTTL = 31 * 24 * time.Hour
+	)
+
+	service := setupService(jwtSecret, accessTokenTTL, refreshTokenTTL)
+	now := time.Now().UTC()
+
+	claims := game.PanoramaTokenClaims{
+		StreetviewID: "streetview-123",
+	}
+
+	t.Run("Valid Panorama Token", func(t *testing.T) {
+		t.Parallel()
+
+		tokenStr, err := service.NewPanoramaToken(now, claims)
+		require.NoError(t, err)
+		assert.NotContains(t, tokenStr, claims.StreetviewID)
+
+		parsedClaims, err := service.ParsePanoramaToken(tokenStr)
+		require.NoError(t, err)
+		assert.Equal(t, claims, parsedClaims)
+	})
+
+	t.Run("Expired Panorama Token", func(t *testing.T) {
+		t.Parallel()
+
+		tokenStr, err := service.NewPanoramaToken(now.Add(-2*time.Hour), claims)
+		require.NoError(t, err)
+
+		_, err = service.ParsePanoramaToken(tokenStr)
+		assert.ErrorContains(t, err, "\"exp\" not satisfied")
+	})
+
+	t.Run("Wrong Secret", func(t *testing.T) {
+		t.Parallel()
+
+		tokenStr, err := service.NewPanoramaToken(now, claims)
+		require.NoError(t, err)
+
+		otherService := setupService([]byte("other-secret"), accessTokenTTL, refreshTokenTTL)
+
+		_, err = otherService.ParsePanoramaToken(tokenStr)
+		assert.Error(t, err)
+	})
+
+	t.Run("Access Token Is Not Panorama Token", func(t *testing.T) {
+		t.Parallel()
+
+		tokenStr, err := service.NewAccessToken(now, user.AccessTokenClaims{
+			SessionID: "session-123",
+			UserID:    456,
+			Username:  "testuser",
+			Name:      "Test User",
+		})
+		require.NoError(t, err)
+
+		_, err = service.ParsePanoramaToken(tokenStr)
+		assert.Error(t, err)
+	})
+}
+
 func TestContext(t *testing.T) {
 	t.Parallel()
 
@@ -2,6 +2,7 @@
 package melody
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -18,8 +19,10 @@ type WebSocketService struct {
 }
 
 // NewWebSocketService creates a new instance of WebSocketService with an initialized Melody WebSocket instance.
-func NewWebSocketService() *WebSocketService {
+// The first of subprotocols offered by the client is selected during the upgrade.
+func NewWebSocketService(subprotocols ...string) *WebSocketService {
 	m := melody.New()
+	m.Upgrader.Subprotocols = subprotocols
 
 	return &WebSocketService{
 		m: m,
@@ -110,6 +113,81 @@ func (ws *WebSocketService) BroadcastOthers(
 	return nil
 }
 
+// Join registers the session as present in its broadcast group.
+func (ws *WebSocketService) Join(session transport.WebSocketSession, member any) error {
+	if _, ok := session.GetBroadcastID(); !ok {
+		return transport.ErrNoBroadcastID
+	}
+
+	memberBytes, err := json.Marshal(member)
+	if err != nil {
+		return fmt.Errorf("failed to marshal member: %w", err)
+	}
+
+	session.Set(webSocketMemberKey, json.RawMessage(memberBytes))
+
+	return nil
+}
+
+// Members returns information of all clients present in the broadcast group.
+// Closed sessions are skipped, so disconnecting client is not returned from the disconnect handler.
+func (ws *WebSocketService) Members(broadcastID string) ([]json.RawMessage, error) {
+	sessions, err := ws.m.Sessions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sessions: %w", err)
+	}
+
+	members := make([]json.RawMessage, 0)
+
+	for _, ms := range sessions {
+		if ms.IsClosed() {
+			continue
+		}
+
+		session := NewSession(ms)
+
+		if id, ok := session.GetBroadcastID(); !ok || id != broadcastID {
+			continue
+		}
+
+		if member, ok := session.member(); ok {
+			members = append(members, member)
+		}
+	}
+
+	return members, nil
+}
+
+// Disconnect closes sessions of the client present in the broadcast group.
+func (ws *WebSocketService) Disconnect(broadcastID string, member json.RawMessage) error {
+	sessions, err := ws.m.Sessions()
+	if err != nil {
+		return fmt.Errorf("failed to get sessions: %w", err)
+	}
+
+	for _, ms := range sessions {
+		if ms.IsClosed() {
+			continue
+		}
+
+		session := NewSession(ms)
+
+		if id, ok := session.GetBroadcastID(); !ok || id != broadcastID {
+			continue
+		}
+
+		if m, ok := session.member(); !ok || !bytes.Equal(m, member) {
+			continue
+		}
+
+		if err := session.Close(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // SetMessageHandler sets the handler function to process incoming WebSocket messages.
 func (ws *WebSocketService) SetMessageHandler(handler transport.WebSocketMessageHandler) {
 	ws.m.HandleMessage(func(session *melody.Session, msgBytes []byte) {
@@ -13,6 +13,7 @@ import (
 const (
 	webSocketIDKey          = "melody:id"
 	webSocketBroadcastIDKey = "melody:broadcastID"
+	webSocketMemberKey      = "melody:member"
 )
 
 var _ transport.WebSocketSession = (*Session)(nil)
@@ -71,6 +72,18 @@ func (s *Session) GetBroadcastID() (string, bool) {
 	return broadcastID, true
 }
 
+// member returns client information stored in the session by WebSocketService.Join.
+func (s *Session) member() (json.RawMessage, bool) {
+	val, ok := s.Get(webSocketMemberKey)
+	if !ok {
+		return nil, false
+	}
+
+	member, ok := val.(json.RawMessage)
+
+	return member, ok
+}
+
 // Request returns the HTTP request associated with the WebSocket connection.
 func (s *Session) Request() *http.Request {
 	return s.ms.Request
@@ -102,3 +115,12 @@ func (s *Session) SendError(text string) {
 	msgBytes, _ := json.Marshal(msg) //nolint:errchkjson
 	_ = s.ms.Write(msgBytes)
 }
+
+// Close closes the WebSocket connection.
+func (s *Session) Close() error {
+	if err := s.ms.Close(); err != nil {
+		return fmt.Errorf("failed to close session: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,406 @@
+// Package pubsub contains a WebSocket service decorator, that shares broadcasts and
+// connected clients between multiple application instances through Valkey.
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/infrastructure/transport"
+	"github.com/google/uuid"
+	"github.com/valkey-io/valkey-go"
+)
+
+const (
+	keyPrefix = "ws:"
+	// instanceTTL is the time after which presence of a stopped (or crashed) instance is considered stale.
+	instanceTTL = 30 * time.Second
+	// heartbeatInterval is how often the instance refreshes its liveness key and presence keys of its clients.
+	heartbeatInterval = 10 * time.Second
+	// operationTimeout limits time of a single Valkey operation, because websocket methods have no context.
+	operationTimeout = 5 * time.Second
+)
+
+var _ transport.WebSocketService = (*WebSocketService)(nil)
+
+// envelope is a message published to other instances.
+// If Disconnect is set, sessions of the member are closed instead of delivering the message.
+type envelope struct {
+	Origin     string                           `json:"origin"`
+	Message    transport.WebSocketMessageOutput `json:"message"`
+	Disconnect json.RawMessage                  `json:"disconnect,omitempty"`
+}
+
+// WebSocketService wraps a local WebSocket service, so that broadcasts are delivered to clients
+// connected to every application instance and connected clients are visible to all instances.
+//
+// Broadcasts are published to Valkey channel keyed by broadcast ID, every instance delivers them to its local sessions.
+// Connected clients are stored in a Valkey hash per broadcast ID, entries of stopped instances are ignored.
+// The hash expires, unless some instance with clients in the broadcast group keeps refreshing it.
+type WebSocketService struct {
+	transport.WebSocketService
+
+	client     valkey.Client
+	namespace  string
+	instanceID string
+
+	// present has presence fields of local sessions by broadcast ID, so that their presence keys are refreshed
+	mu      sync.Mutex
+	present map[string]map[string]struct{}
+
+	ctx    context.Context //nolint:containedctx
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewWebSocketService creates a new WebSocketService and starts receiving broadcasts from other instances.
+//
+// client - Valkey client used for pub/sub and presence registry.
+// local - WebSocket service that handles connections of this instance.
+// namespace - name that separates different WebSocket services (e.g. "lobby" and "multiplayer").
+func NewWebSocketService(
+	client valkey.Client,
+	local transport.WebSocketService,
+	namespace string,
+) *WebSocketService {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ws := &WebSocketService{
+		WebSocketService: local,
+		client:           client,
+		namespace:        namespace,
+		instanceID:       uuid.New().String(),
+		present:          make(map[string]map[string]struct{}),
+		ctx:              ctx,
+		cancel:           cancel,
+	}
+
+	// instance must be alive before its clients join, otherwise their presence is considered stale
+	ws.refresh()
+
+	ws.wg.Add(2)
+
+	go ws.receive()
+	go ws.heartbeat()
+
+	return ws
+}
+
+// Close stops receiving broadcasts from other instances and closes the local WebSocket service.
+func (ws *WebSocketService) Close() error {
+	ws.cancel()
+	ws.wg.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), operationTimeout)
+	defer cancel()
+
+	// presence entries of this instance become stale right away
+	if err := ws.client.Do(ctx, ws.client.B().Del().Key(ws.instanceKey(ws.instanceID)).Build()).Error(); err != nil {
+		slog.Error("error removing ws instance key", slog.Any("error", err))
+	}
+
+	if err := ws.WebSocketService.Close(); err != nil {
+		return fmt.Errorf("failed to close local ws: %w", err)
+	}
+
+	return nil
+}
+
+// Broadcast sends a message to all clients in the broadcast group, on all instances.
+func (ws *WebSocketService) Broadcast(id string, message transport.WebSocketMessageOutput) error {
+	if err := ws.WebSocketService.Broadcast(id, message); err != nil {
+		return fmt.Errorf("failed to broadcast locally: %w", err)
+	}
+
+	return ws.publish(id, envelope{Message: message})
+}
+
+// BroadcastOthers sends a message to all clients in the broadcast group except the broadcaster, on all instances.
+func (ws *WebSocketService) BroadcastOthers(
+	id string,
+	broadcaster transport.WebSocketSession,
+	message transport.WebSocketMessageOutput,
+) error {
+	if err := ws.WebSocketService.BroadcastOthers(id, broadcaster, message); err != nil {
+		return fmt.Errorf("failed to broadcast locally: %w", err)
+	}
+
+	// broadcaster session is connected to this instance, so others can receive the message as is
+	return ws.publish(id, envelope{Message: message})
+}
+
+// Join registers the session as present in its broadcast group for all instances.
+func (ws *WebSocketService) Join(session transport.WebSocketSession, member any) error {
+	id, ok := session.GetBroadcastID()
+	if !ok {
+		return transport.ErrNoBroadcastID
+	}
+
+	if err := ws.WebSocketService.Join(session, member); err != nil {
+		return fmt.Errorf("failed to join locally: %w", err)
+	}
+
+	memberBytes, err := json.Marshal(member)
+	if err != nil {
+		return fmt.Errorf("failed to marshal member: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ws.ctx, operationTimeout)
+	defer cancel()
+
+	key, field := ws.presenceKey(id), ws.presenceField(session)
+	cmds := valkey.Commands{
+		ws.client.B().Hset().Key(key).FieldValue().FieldValue(field, string(memberBytes)).Build(),
+		ws.client.B().Pexpire().Key(key).Milliseconds(instanceTTL.Milliseconds()).Build(),
+	}
+
+	for _, res := range ws.client.DoMulti(ctx, cmds...) {
+		if err := res.Error(); err != nil {
+			return fmt.Errorf("failed to save presence: %w", err)
+		}
+	}
+
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	if ws.present[id] == nil {
+		ws.present[id] = make(map[string]struct{})
+	}
+
+	ws.present[id][field] = struct{}{}
+
+	return nil
+}
+
+// Members returns information of all clients present in the broadcast group on all running instances.
+func (ws *WebSocketService) Members(id string) ([]json.RawMessage, error) {
+	ctx, cancel := context.WithTimeout(ws.ctx, operationTimeout)
+	defer cancel()
+
+	entries, err := ws.client.Do(ctx, ws.client.B().Hgetall().Key(ws.presenceKey(id)).Build()).AsStrMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get presence: %w", err)
+	}
+
+	alive := map[string]bool{ws.instanceID: true}
+	members := make([]json.RawMessage, 0, len(entries))
+	stale := make([]string, 0)
+
+	for field, member := range entries {
+		instanceID, _, _ := strings.Cut(field, ":")
+
+		isAlive, checked := alive[instanceID]
+		if !checked {
+			cmd := ws.client.B().Exists().Key(ws.instanceKey(instanceID)).Build()
+
+			exists, err := ws.client.Do(ctx, cmd).AsInt64()
+			if err != nil {
+				return nil, fmt.Errorf("failed to check instance: %w", err)
+			}
+
+			isAlive = exists == 1
+			alive[instanceID] = isAlive
+		}
+
+		if !isAlive {
+			stale = append(stale, field)
+			continue
+		}
+
+		members = append(members, json.RawMessage(member))
+	}
+
+	if len(stale) != 0 {
+		cmd := ws.client.B().Hdel().Key(ws.presenceKey(id)).Field(stale...).Build()
+		if err := ws.client.Do(ctx, cmd).Error(); err != nil {
+			slog.Error("error removing stale ws presence", slog.Any("error", err))
+		}
+	}
+
+	return members, nil
+}
+
+// Disconnect closes sessions of the client present in the broadcast group, on all instances.
+func (ws *WebSocketService) Disconnect(id string, member json.RawMessage) error {
+	if err := ws.WebSocketService.Disconnect(id, member); err != nil {
+		return fmt.Errorf("failed to disconnect locally: %w", err)
+	}
+
+	return ws.publish(id, envelope{Disconnect: member})
+}
+
+// SetDisconnectHandler sets the handler function to handle WebSocket disconnections.
+// Disconnected session is removed from the presence registry before the handler is called.
+func (ws *WebSocketService) SetDisconnectHandler(handler transport.WebSocketDisconnectHandler) {
+	ws.WebSocketService.SetDisconnectHandler(func(session transport.WebSocketSession) {
+		ws.leave(session)
+		handler(session)
+	})
+}
+
+// leave removes the session from the presence registry. The presence key is deleted together with its last field,
+// or expires if only entries of stopped instances are left in it.
+func (ws *WebSocketService) leave(session transport.WebSocketSession) {
+	id, ok := session.GetBroadcastID()
+	if !ok {
+		return
+	}
+
+	field := ws.presenceField(session)
+
+	ws.mu.Lock()
+	delete(ws.present[id], field)
+
+	if len(ws.present[id]) == 0 {
+		delete(ws.present, id)
+	}
+	ws.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), operationTimeout)
+	defer cancel()
+
+	cmd := ws.client.B().Hdel().Key(ws.presenceKey(id)).Field(field).Build()
+	if err := ws.client.Do(ctx, cmd).Error(); err != nil {
+		slog.Error("error removing ws presence", slog.Any("error", err))
+	}
+}
+
+// publish sends the envelope to other instances.
+func (ws *WebSocketService) publish(id string, env envelope) error {
+	env.Origin = ws.instanceID
+
+	msgBytes, err := json.Marshal(env)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ws.ctx, operationTimeout)
+	defer cancel()
+
+	cmd := ws.client.B().Publish().Channel(ws.channel(id)).Message(string(msgBytes)).Build()
+	if err := ws.client.Do(ctx, cmd).Error(); err != nil {
+		return fmt.Errorf("failed to publish message: %w", err)
+	}
+
+	return nil
+}
+
+// receive delivers messages published by other instances to local sessions until the service is closed.
+func (ws *WebSocketService) receive() {
+	defer ws.wg.Done()
+
+	pattern := ws.channel("*")
+	cmd := ws.client.B().Psubscribe().Pattern(pattern).Build()
+
+	for ws.ctx.Err() == nil {
+		err := ws.client.Receive(ws.ctx, cmd, func(msg valkey.PubSubMessage) {
+			var env envelope
+			if err := json.Unmarshal([]byte(msg.Message), &env); err != nil {
+				slog.Error("error unmarshalling ws broadcast", slog.Any("error", err))
+				return
+			}
+
+			if env.Origin == ws.instanceID {
+				return
+			}
+
+			id := strings.TrimPrefix(msg.Channel, ws.channel(""))
+
+			if len(env.Disconnect) != 0 {
+				if err := ws.WebSocketService.Disconnect(id, env.Disconnect); err != nil {
+					slog.Error("error disconnecting ws member", slog.Any("error", err))
+				}
+
+				return
+			}
+
+			if err := ws.WebSocketService.Broadcast(id, env.Message); err != nil {
+				slog.Error("error delivering ws broadcast", slog.Any("error", err))
+			}
+		})
+		if err != nil && ws.ctx.Err() == nil {
+			slog.Error("error receiving ws broadcasts, resubscribing", slog.Any("error", err))
+
+			select {
+			case <-ws.ctx.Done():
+			case <-time.After(time.Second):
+			}
+		}
+	}
+}
+
+// heartbeat keeps the instance liveness key and presence keys of local clients alive until the service is closed.
+func (ws *WebSocketService) heartbeat() {
+	defer ws.wg.Done()
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ws.ctx.Done():
+			return
+		case <-ticker.C:
+			ws.refresh()
+			ws.refreshPresence()
+		}
+	}
+}
+
+// refresh prolongs the instance liveness key.
+func (ws *WebSocketService) refresh() {
+	ctx, cancel := context.WithTimeout(ws.ctx, operationTimeout)
+	defer cancel()
+
+	cmd := ws.client.B().Set().Key(ws.instanceKey(ws.instanceID)).Value("1").Ex(instanceTTL).Build()
+	if err := ws.client.Do(ctx, cmd).Error(); err != nil && ws.ctx.Err() == nil {
+		slog.Error("error refreshing ws instance key", slog.Any("error", err))
+	}
+}
+
+// refreshPresence prolongs presence keys of broadcast groups, which have clients connected to this instance.
+func (ws *WebSocketService) refreshPresence() {
+	ws.mu.Lock()
+	cmds := make(valkey.Commands, 0, len(ws.present))
+
+	for id := range ws.present {
+		cmds = append(cmds, ws.client.B().Pexpire().Key(ws.presenceKey(id)).
+			Milliseconds(instanceTTL.Milliseconds()).
+			Build())
+	}
+	ws.mu.Unlock()
+
+	if len(cmds) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ws.ctx, operationTimeout)
+	defer cancel()
+
+	for _, res := range ws.client.DoMulti(ctx, cmds...) {
+		if err := res.Error(); err != nil && ws.ctx.Err() == nil {
+			slog.Error("error refreshing ws presence key", slog.Any("error", err))
+		}
+	}
+}
+
+func (ws *WebSocketService) channel(id string) string {
+	return keyPrefix + ws.namespace + ":broadcast:" + id
+}
+
+func (ws *WebSocketService) presenceKey(id string) string {
+	return keyPrefix + ws.namespace + ":presence:" + id
+}
+
+func (ws *WebSocketService) presenceField(session transport.WebSocketSession) string {
+	return ws.instanceID + ":" + session.ID()
+}
+
+func (ws *WebSocketService) instanceKey(instanceID string) string {
+	return keyPrefix + "instance:" + instanceID
+}
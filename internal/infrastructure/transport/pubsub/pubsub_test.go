@@ -0,0 +1,326 @@
+package pubsub_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/infrastructure/transport"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/transport/pubsub"
+	"github.com/VasySS/segoya-backend/tests/containers"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+	"github.com/valkey-io/valkey-go"
+)
+
+func TestPubSubTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(PubSubTestSuite))
+}
+
+type PubSubTestSuite struct {
+	suite.Suite
+	ctx             context.Context
+	valkeyContainer *containers.ValkeyContainer
+	valkeyClient    valkey.Client
+}
+
+func (s *PubSubTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+
+	valkeyContainer, err := containers.NewValkeyContainer(s.ctx)
+	s.Require().NoError(err)
+
+	valkeyClient, err := valkey.NewClient(valkey.MustParseURL(valkeyContainer.ConnectionString))
+	s.Require().NoError(err)
+
+	s.valkeyContainer = valkeyContainer
+	s.valkeyClient = valkeyClient
+}
+
+func (s *PubSubTestSuite) TearDownSuite() {
+	s.valkeyClient.Close()
+
+	err := s.valkeyContainer.Terminate(s.ctx)
+	s.Require().NoError(err)
+}
+
+func (s *PubSubTestSuite) newInstance(namespace string) (*pubsub.WebSocketService, *localService) {
+	local := &localService{}
+	ws := pubsub.NewWebSocketService(s.valkeyClient, local, namespace)
+
+	s.T().Cleanup(func() {
+		_ = ws.Close()
+	})
+
+	return ws, local
+}
+
+func (s *PubSubTestSuite) TestBroadcastIsDeliveredToAllInstances() {
+	namespace := gofakeit.UUID()
+	first, firstLocal := s.newInstance(namespace)
+	_, secondLocal := s.newInstance(namespace)
+
+	msg := transport.WebSocketMessageOutput{
+		Type:    "chat",
+		Payload: map[string]any{"message": "hello"},
+	}
+
+	// subscription is started in background, so repeat until other instance receives the message
+	s.Eventually(func() bool {
+		s.Require().NoError(first.Broadcast("lobby1", msg))
+		return len(secondLocal.broadcasts()) != 0
+	}, 5*time.Second, 100*time.Millisecond)
+
+	received := secondLocal.broadcasts()[0]
+	s.Equal("lobby1", received.id)
+	s.Equal(msg, received.message)
+
+	// once subscribed, every broadcast is delivered exactly once to each instance
+	firstCount, secondCount := len(firstLocal.broadcasts()), len(secondLocal.broadcasts())
+	s.Require().NoError(first.Broadcast("lobby1", msg))
+
+	s.Eventually(func() bool {
+		return len(secondLocal.broadcasts()) == secondCount+1
+	}, 5*time.Second, 100*time.Millisecond)
+
+	s.Never(func() bool {
+		return len(firstLocal.broadcasts()) != firstCount+1
+	}, 500*time.Millisecond, 100*time.Millisecond)
+}
+
+func (s *PubSubTestSuite) TestBroadcastIsIsolatedByNamespace() {
+	namespace := gofakeit.UUID()
+	first, _ := s.newInstance(gofakeit.UUID())
+	second, _ := s.newInstance(namespace)
+	_, thirdLocal := s.newInstance(namespace)
+
+	msg := transport.WebSocketMessageOutput{Type: "chat"}
+
+	s.Eventually(func() bool {
+		s.Require().NoError(second.Broadcast("same", msg))
+		return len(thirdLocal.broadcasts()) != 0
+	}, 5*time.Second, 100*time.Millisecond)
+
+	s.Require().NoError(first.Broadcast("other", msg))
+
+	s.Never(func() bool {
+		return slices.ContainsFunc(thirdLocal.broadcasts(), func(b broadcast) bool {
+			return b.id == "other"
+		})
+	}, 500*time.Millisecond, 100*time.Millisecond)
+}
+
+func (s *PubSubTestSuite) TestMembersAreSharedBetweenInstances() {
+	namespace := gofakeit.UUID()
+	first, _ := s.newInstance(namespace)
+	second, _ := s.newInstance(namespace)
+
+	firstSession := newSession("game1")
+	secondSession := newSession("game1")
+	otherGameSession := newSession("game2")
+
+	s.Require().NoError(first.Join(firstSession, map[string]any{"id": 1}))
+	s.Require().NoError(second.Join(secondSession, map[string]any{"id": 2}))
+	s.Require().NoError(second.Join(otherGameSession, map[string]any{"id": 3}))
+
+	members, err := second.Members("game1")
+	s.Require().NoError(err)
+	s.ElementsMatch([]json.RawMessage{
+		json.RawMessage(`{"id":1}`),
+		json.RawMessage(`{"id":2}`),
+	}, members)
+}
+
+func (s *PubSubTestSuite) TestDisconnectRemovesMember() {
+	ws, local := s.newInstance(gofakeit.UUID())
+
+	disconnected := make(chan []json.RawMessage, 1)
+
+	ws.SetDisconnectHandler(func(session transport.WebSocketSession) {
+		id, _ := session.GetBroadcastID()
+		members, err := ws.Members(id)
+		s.NoError(err)
+
+		disconnected <- members
+	})
+
+	session := newSession("game1")
+	s.Require().NoError(ws.Join(session, map[string]any{"id": 1}))
+
+	local.disconnect(session)
+
+	s.Empty(<-disconnected)
+}
+
+func (s *PubSubTestSuite) TestPresenceKeyExpires() {
+	namespace := gofakeit.UUID()
+	ws, local := s.newInstance(namespace)
+	ws.SetDisconnectHandler(func(transport.WebSocketSession) {})
+
+	key := "ws:" + namespace + ":presence:game1"
+	session := newSession("game1")
+	s.Require().NoError(ws.Join(session, map[string]any{"id": 1}))
+
+	ttl, err := s.valkeyClient.Do(s.ctx, s.valkeyClient.B().Pttl().Key(key).Build()).AsInt64()
+	s.Require().NoError(err)
+	s.Positive(ttl)
+	s.LessOrEqual(ttl, (30 * time.Second).Milliseconds())
+
+	// the key is deleted when the last client leaves
+	local.disconnect(session)
+
+	exists, err := s.valkeyClient.Do(s.ctx, s.valkeyClient.B().Exists().Key(key).Build()).AsInt64()
+	s.Require().NoError(err)
+	s.Zero(exists)
+}
+
+func (s *PubSubTestSuite) TestDisconnectIsDeliveredToAllInstances() {
+	namespace := gofakeit.UUID()
+	first, firstLocal := s.newInstance(namespace)
+	_, secondLocal := s.newInstance(namespace)
+
+	member := json.RawMessage(`{"id":1}`)
+
+	s.Eventually(func() bool {
+		s.Require().NoError(first.Disconnect("lobby1", member))
+		return len(secondLocal.disconnectedMembers()) != 0
+	}, 5*time.Second, 100*time.Millisecond)
+
+	s.Equal(broadcastMember{id: "lobby1", member: member}, secondLocal.disconnectedMembers()[0])
+	s.NotEmpty(firstLocal.disconnectedMembers())
+	s.Empty(secondLocal.broadcasts())
+}
+
+func (s *PubSubTestSuite) TestJoinWithoutBroadcastID() {
+	ws, _ := s.newInstance(gofakeit.UUID())
+
+	err := ws.Join(newSession(""), map[string]any{"id": 1})
+	s.ErrorIs(err, transport.ErrNoBroadcastID)
+}
+
+func (s *PubSubTestSuite) TestClosedInstanceMembersAreStale() {
+	namespace := gofakeit.UUID()
+	first, _ := s.newInstance(namespace)
+	second := pubsub.NewWebSocketService(s.valkeyClient, &localService{}, namespace)
+
+	s.Require().NoError(first.Join(newSession("game1"), map[string]any{"id": 1}))
+	s.Require().NoError(second.Join(newSession("game1"), map[string]any{"id": 2}))
+	s.Require().NoError(second.Close())
+
+	members, err := first.Members("game1")
+	s.Require().NoError(err)
+	s.Equal([]json.RawMessage{json.RawMessage(`{"id":1}`)}, members)
+}
+
+type broadcast struct {
+	id      string
+	message transport.WebSocketMessageOutput
+}
+
+type broadcastMember struct {
+	id     string
+	member json.RawMessage
+}
+
+// localService is a WebSocket service of a single instance without real connections.
+type localService struct {
+	mu                sync.Mutex
+	sent              []broadcast
+	disconnected      []broadcastMember
+	disconnectHandler transport.WebSocketDisconnectHandler
+}
+
+func (l *localService) broadcasts() []broadcast {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return append([]broadcast(nil), l.sent...)
+}
+
+func (l *localService) disconnectedMembers() []broadcastMember {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return append([]broadcastMember(nil), l.disconnected...)
+}
+
+func (l *localService) disconnect(session transport.WebSocketSession) {
+	l.disconnectHandler(session)
+}
+
+func (l *localService) HandleRequest(http.ResponseWriter, *http.Request) error { return nil }
+func (l *localService) Sessions() []transport.WebSocketSession                 { return nil }
+func (l *localService) Close() error                                           { return nil }
+
+func (l *localService) Broadcast(id string, message transport.WebSocketMessageOutput) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sent = append(l.sent, broadcast{id: id, message: message})
+
+	return nil
+}
+
+func (l *localService) BroadcastOthers(
+	id string,
+	_ transport.WebSocketSession,
+	message transport.WebSocketMessageOutput,
+) error {
+	return l.Broadcast(id, message)
+}
+
+func (l *localService) Join(transport.WebSocketSession, any) error { return nil }
+func (l *localService) Members(string) ([]json.RawMessage, error)  { return nil, nil }
+
+func (l *localService) Disconnect(id string, member json.RawMessage) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.disconnected = append(l.disconnected, broadcastMember{id: id, member: member})
+
+	return nil
+}
+
+func (l *localService) SetMessageHandler(transport.WebSocketMessageHandler) {}
+func (l *localService) SetConnectHandler(transport.WebSocketConnectHandler) {}
+
+func (l *localService) SetDisconnectHandler(handler transport.WebSocketDisconnectHandler) {
+	l.disconnectHandler = handler
+}
+
+// session is a WebSocket session without real connection.
+type session struct {
+	id          string
+	broadcastID string
+	keys        map[string]any
+}
+
+func newSession(broadcastID string) *session {
+	return &session{
+		id:          gofakeit.UUID(),
+		broadcastID: broadcastID,
+		keys:        make(map[string]any),
+	}
+}
+
+func (s *session) ID() string                 { return s.id }
+func (s *session) Request() *http.Request     { return nil }
+func (s *session) Get(key string) (any, bool) { v, ok := s.keys[key]; return v, ok }
+func (s *session) Set(key string, value any)  { s.keys[key] = value }
+func (s *session) SetBroadcastID(id string)   { s.broadcastID = id }
+func (s *session) SendError(string)           {}
+func (s *session) Close() error               { return nil }
+
+func (s *session) GetBroadcastID() (string, bool) {
+	return s.broadcastID, s.broadcastID != ""
+}
+
+func (s *session) SendMessage(transport.WebSocketMessageOutputType, map[string]any) error {
+	return nil
+}
@@ -3,9 +3,13 @@ package transport
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 )
 
+// ErrNoBroadcastID is returned when the session has no broadcast ID set.
+var ErrNoBroadcastID = errors.New("broadcast id is not set in session")
+
 type (
 	// WebSocketMessageInputType is a message type for input messages.
 	WebSocketMessageInputType string
@@ -33,6 +37,8 @@ type (
 		SetBroadcastID(id string)
 		SendMessage(typ WebSocketMessageOutputType, payload map[string]any) error
 		SendError(message string)
+		// Close closes the connection from the server side, disconnect handler is called afterwards.
+		Close() error
 	}
 
 	// WebSocketMessageHandler is a handler for websocket messages.
@@ -49,6 +55,14 @@ type (
 		Close() error
 		Broadcast(id string, message WebSocketMessageOutput) error
 		BroadcastOthers(id string, broadcaster WebSocketSession, message WebSocketMessageOutput) error
+		// Join registers the session (with broadcast ID already set) as present in its broadcast group,
+		// member is any JSON-serializable information about the connected client.
+		Join(session WebSocketSession, member any) error
+		// Members returns information of all clients present in the broadcast group.
+		Members(id string) ([]json.RawMessage, error)
+		// Disconnect closes sessions of the client present in the broadcast group,
+		// member is client information as returned by Members.
+		Disconnect(id string, member json.RawMessage) error
 		SetMessageHandler(handler WebSocketMessageHandler)
 		SetConnectHandler(handler WebSocketConnectHandler)
 		SetDisconnectHandler(handler WebSocketDisconnectHandler)
@@ -0,0 +1,16 @@
+package gamemap
+
+import "github.com/VasySS/segoya-backend/internal/config"
+
+// Config contains configuration for map usecase.
+type Config struct {
+	// Maximum amount of locations in a single import.
+	ImportMaxSize int
+}
+
+// NewConfig returns a new local config from general config.
+func NewConfig(cfg config.Config) Config {
+	return Config{
+		ImportMaxSize: cfg.Limits.MapImportMaxSize,
+	}
+}
@@ -0,0 +1,255 @@
+package gamemap
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/gamemap"
+)
+
+// NewMap creates a new map, with existing panorama locations of the provider if they are provided.
+func (uc Usecase) NewMap(ctx context.Context, req dto.NewMapRequest) (int, error) {
+	ctx, span := uc.tracer.Start(ctx, "NewMap")
+	defer span.End()
+
+	var response int
+
+	err := uc.repo.RunTx(ctx, func(ctx context.Context) error {
+		// maps can only be created for registered providers, mixed provider is not one of them
+		if _, err := uc.pano.StreetviewIDRequired(req.Provider); err != nil {
+			return fmt.Errorf("failed to check map provider: %w", err)
+		}
+
+		id, err := uc.repo.NewMap(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to create map: %w", err)
+		}
+
+		if len(req.LocationIDs) != 0 {
+			if _, err := uc.addLocations(ctx, id, req.Provider, req.LocationIDs); err != nil {
+				return err
+			}
+		}
+
+		response = id
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return 0, fmt.Errorf("failed to create map: %w", err)
+	}
+
+	return response, nil
+}
+
+// GetMap returns a map by ID. Private maps of other users are reported as not found.
+func (uc Usecase) GetMap(ctx context.Context, req dto.GetMapRequest) (gamemap.Map, error) {
+	ctx, span := uc.tracer.Start(ctx, "GetMap")
+	defer span.End()
+
+	m, err := uc.repo.GetMap(ctx, req.MapID)
+	if err != nil {
+		span.RecordError(err)
+		return gamemap.Map{}, fmt.Errorf("failed to get map: %w", err)
+	}
+
+	if !m.AccessibleBy(req.UserID) {
+		return gamemap.Map{}, gamemap.ErrNotFound
+	}
+
+	return m, nil
+}
+
+// GetPlayableMap returns a map by ID, if the user can start a game on it.
+func (uc Usecase) GetPlayableMap(ctx context.Context, req dto.GetMapRequest) (gamemap.Map, error) {
+	ctx, span := uc.tracer.Start(ctx, "GetPlayableMap")
+	defer span.End()
+
+	m, err := uc.GetMap(ctx, req)
+	if err != nil {
+		return gamemap.Map{}, err
+	}
+
+	if m.LocationsCount == 0 {
+		return gamemap.Map{}, gamemap.ErrEmpty
+	}
+
+	return m, nil
+}
+
+// GetMaps returns a page of public maps, or of all maps created by the user.
+func (uc Usecase) GetMaps(ctx context.Context, req dto.GetMapsRequest) ([]gamemap.Map, int, error) {
+	ctx, span := uc.tracer.Start(ctx, "GetMaps")
+	defer span.End()
+
+	maps, total, err := uc.repo.GetMaps(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		return nil, 0, fmt.Errorf("failed to get maps: %w", err)
+	}
+
+	return maps, total, nil
+}
+
+// UpdateMap updates name, description and visibility of a map created by the user.
+func (uc Usecase) UpdateMap(ctx context.Context, req dto.UpdateMapRequest) error {
+	ctx, span := uc.tracer.Start(ctx, "UpdateMap")
+	defer span.End()
+
+	err := uc.repo.RunTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.getOwnMap(ctx, req.UserID, req.MapID); err != nil {
+			return err
+		}
+
+		if err := uc.repo.UpdateMap(ctx, req); err != nil {
+			return fmt.Errorf("failed to update map: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("failed to update map: %w", err)
+	}
+
+	return nil
+}
+
+// AddMapLocations adds existing panorama locations of the map provider to a map created by the user.
+// Returns the amount of locations, that were not in the map before.
+func (uc Usecase) AddMapLocations(ctx context.Context, req dto.AddMapLocationsRequest) (int, error) {
+	ctx, span := uc.tracer.Start(ctx, "AddMapLocations")
+	defer span.End()
+
+	var response int
+
+	err := uc.repo.RunTx(ctx, func(ctx context.Context) error {
+		m, err := uc.getOwnMap(ctx, req.UserID, req.MapID)
+		if err != nil {
+			return err
+		}
+
+		added, err := uc.addLocations(ctx, m.ID, m.Provider, req.LocationIDs)
+		if err != nil {
+			return err
+		}
+
+		response = added
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return 0, fmt.Errorf("failed to add map locations: %w", err)
+	}
+
+	return response, nil
+}
+
+// ImportMapLocations parses locations in CSV or GeoJSON format and adds them to a map created by the user.
+// Returns the amount of imported locations.
+func (uc Usecase) ImportMapLocations(ctx context.Context, req dto.ImportMapLocationsRequest) (int, error) {
+	ctx, span := uc.tracer.Start(ctx, "ImportMapLocations")
+	defer span.End()
+
+	locations, err := parseLocations(req.Format, req.Data)
+	if err != nil {
+		span.RecordError(err)
+		return 0, fmt.Errorf("failed to parse locations: %w", err)
+	}
+
+	if len(locations) > uc.cfg.ImportMaxSize {
+		return 0, fmt.Errorf("%w: more than %d locations", gamemap.ErrInvalidImport, uc.cfg.ImportMaxSize)
+	}
+
+	var response int
+
+	err = uc.repo.RunTx(ctx, func(ctx context.Context) error {
+		m, err := uc.getOwnMap(ctx, req.UserID, req.MapID)
+		if err != nil {
+			return err
+		}
+
+		streetviewIDRequired, err := uc.pano.StreetviewIDRequired(m.Provider)
+		if err != nil {
+			return fmt.Errorf("failed to get map provider: %w", err)
+		}
+
+		if err := validateLocations(streetviewIDRequired, locations); err != nil {
+			return err
+		}
+
+		for i := range locations {
+			locations[i].Place = uc.pano.Place(locations[i].LatLng)
+		}
+
+		imported, err := uc.repo.ImportMapLocations(ctx, dto.ImportMapLocationsRequestDB{
+			MapID:     m.ID,
+			Provider:  m.Provider,
+			Locations: locations,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to import map locations: %w", err)
+		}
+
+		response = imported
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return 0, fmt.Errorf("failed to import map locations: %w", err)
+	}
+
+	return response, nil
+}
+
+// getOwnMap returns a map, if it was created by the user.
+func (uc Usecase) getOwnMap(ctx context.Context, userID, mapID int) (gamemap.Map, error) {
+	m, err := uc.repo.GetMap(ctx, mapID)
+	if err != nil {
+		return gamemap.Map{}, fmt.Errorf("failed to get map: %w", err)
+	}
+
+	if m.UserID != userID {
+		// private maps of other users are hidden completely
+		if !m.AccessibleBy(userID) {
+			return gamemap.Map{}, gamemap.ErrNotFound
+		}
+
+		return gamemap.Map{}, gamemap.ErrWrongUserID
+	}
+
+	return m, nil
+}
+
+// addLocations adds existing panorama locations to a map. All locations must exist and belong to the provider.
+func (uc Usecase) addLocations(
+	ctx context.Context,
+	mapID int,
+	provider game.PanoramaProvider,
+	locationIDs []int,
+) (int, error) {
+	ids := slices.Clone(locationIDs)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	found, added, err := uc.repo.AddMapLocations(ctx, dto.AddMapLocationsRequestDB{
+		MapID:       mapID,
+		Provider:    provider,
+		LocationIDs: ids,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to add map locations: %w", err)
+	}
+
+	if found != len(ids) {
+		return 0, gamemap.ErrLocationNotFound
+	}
+
+	return added, nil
+}
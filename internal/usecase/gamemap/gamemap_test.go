@@ -0,0 +1,438 @@
+package gamemap_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	gamemapEntity "github.com/VasySS/segoya-backend/internal/entity/game/gamemap"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/repository"
+	"github.com/VasySS/segoya-backend/internal/usecase/gamemap"
+	"github.com/VasySS/segoya-backend/internal/usecase/gamemap/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUsecase_NewMap(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	type fields struct {
+		repo *mocks.Repository
+		pano *mocks.PanoramaUsecase
+	}
+
+	type args struct {
+		req dto.NewMapRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		want    int
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "successfully create empty map",
+			args: args{
+				req: dto.NewMapRequest{
+					RequestTime: now,
+					UserID:      1,
+					Name:        "Capitals",
+					Provider:    game.GoogleProvider,
+					Visibility:  gamemapEntity.VisibilityPublic,
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.pano.On("StreetviewIDRequired", args.req.Provider).
+					Return(false, nil)
+				fs.repo.On("NewMap", mock.Anything, args.req).
+					Return(10, nil)
+			},
+			want:    10,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "successfully create map with existing locations",
+			args: args{
+				req: dto.NewMapRequest{
+					RequestTime: now,
+					UserID:      1,
+					Name:        "Capitals",
+					Provider:    game.GoogleProvider,
+					Visibility:  gamemapEntity.VisibilityPrivate,
+					LocationIDs: []int{3, 1, 3, 2},
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.pano.On("StreetviewIDRequired", args.req.Provider).
+					Return(false, nil)
+				fs.repo.On("NewMap", mock.Anything, args.req).
+					Return(10, nil)
+				fs.repo.On("AddMapLocations", mock.Anything, dto.AddMapLocationsRequestDB{
+					MapID:       10,
+					Provider:    game.GoogleProvider,
+					LocationIDs: []int{1, 2, 3},
+				}).Return(3, 3, nil)
+			},
+			want:    10,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "unknown provider",
+			args: args{
+				req: dto.NewMapRequest{
+					RequestTime: now,
+					UserID:      1,
+					Name:        "Capitals",
+					Provider:    game.MixedProvider,
+					Visibility:  gamemapEntity.VisibilityPublic,
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.pano.On("StreetviewIDRequired", args.req.Provider).
+					Return(false, game.ErrUnknownProvider)
+			},
+			want: 0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, game.ErrUnknownProvider)
+			},
+		},
+		{
+			name: "location does not exist or belongs to another provider",
+			args: args{
+				req: dto.NewMapRequest{
+					RequestTime: now,
+					UserID:      1,
+					Name:        "Capitals",
+					Provider:    game.GoogleProvider,
+					Visibility:  gamemapEntity.VisibilityPublic,
+					LocationIDs: []int{1, 2},
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.pano.On("StreetviewIDRequired", args.req.Provider).
+					Return(false, nil)
+				fs.repo.On("NewMap", mock.Anything, args.req).
+					Return(10, nil)
+				fs.repo.On("AddMapLocations", mock.Anything, mock.Anything).
+					Return(1, 1, nil)
+			},
+			want: 0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, gamemapEntity.ErrLocationNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			pano := mocks.NewPanoramaUsecase(t)
+			fs := fields{repo: repo, pano: pano}
+			uc := gamemap.NewUsecase(gamemap.Config{}, repo, pano)
+
+			repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+				Return(func(ctx context.Context, fn repository.TxFunc) error {
+					return fn(ctx)
+				})
+
+			tt.setup(fs, tt.args)
+
+			got, err := uc.NewMap(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUsecase_GetPlayableMap(t *testing.T) {
+	t.Parallel()
+
+	publicMap := gamemapEntity.Map{
+		ID:             10,
+		UserID:         1,
+		Provider:       game.SeznamProvider,
+		Visibility:     gamemapEntity.VisibilityPublic,
+		LocationsCount: 25,
+	}
+
+	type fields struct {
+		repo *mocks.Repository
+	}
+
+	type args struct {
+		req dto.GetMapRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		want    gamemapEntity.Map
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "public map of another user",
+			args: args{req: dto.GetMapRequest{UserID: 2, MapID: 10}},
+			setup: func(fs fields, args args) {
+				fs.repo.On("GetMap", mock.Anything, args.req.MapID).
+					Return(publicMap, nil)
+			},
+			want:    publicMap,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "private map of another user is not found",
+			args: args{req: dto.GetMapRequest{UserID: 2, MapID: 10}},
+			setup: func(fs fields, args args) {
+				privateMap := publicMap
+				privateMap.Visibility = gamemapEntity.VisibilityPrivate
+
+				fs.repo.On("GetMap", mock.Anything, args.req.MapID).
+					Return(privateMap, nil)
+			},
+			want: gamemapEntity.Map{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, gamemapEntity.ErrNotFound)
+			},
+		},
+		{
+			name: "map without locations",
+			args: args{req: dto.GetMapRequest{UserID: 1, MapID: 10}},
+			setup: func(fs fields, args args) {
+				emptyMap := publicMap
+				emptyMap.LocationsCount = 0
+
+				fs.repo.On("GetMap", mock.Anything, args.req.MapID).
+					Return(emptyMap, nil)
+			},
+			want: gamemapEntity.Map{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, gamemapEntity.ErrEmpty)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			fs := fields{repo: repo}
+			uc := gamemap.NewUsecase(gamemap.Config{}, repo, nil)
+
+			tt.setup(fs, tt.args)
+
+			got, err := uc.GetPlayableMap(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUsecase_ImportMapLocations(t *testing.T) {
+	t.Parallel()
+
+	googleMap := gamemapEntity.Map{
+		ID:         10,
+		UserID:     1,
+		Provider:   game.GoogleProvider,
+		Visibility: gamemapEntity.VisibilityUnlisted,
+	}
+
+	airMap := googleMap
+	airMap.Provider = game.YandexAirProvider
+
+	type fields struct {
+		repo *mocks.Repository
+		pano *mocks.PanoramaUsecase
+	}
+
+	type args struct {
+		req dto.ImportMapLocationsRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		want    int
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "csv with header",
+			args: args{req: dto.ImportMapLocationsRequest{
+				UserID: 1,
+				MapID:  10,
+				Format: gamemapEntity.ImportFormatCSV,
+				Data:   "lat,lng\n55.75,37.61\n-33.86, 151.2\n",
+			}},
+			setup: func(fs fields, args args) {
+				fs.repo.On("GetMap", mock.Anything, args.req.MapID).
+					Return(googleMap, nil)
+				fs.pano.On("StreetviewIDRequired", googleMap.Provider).
+					Return(false, nil)
+				fs.pano.On("Place", game.LatLng{Lat: 55.75, Lng: 37.61}).
+					Return(game.Place{CountryCode: "RU", RegionCode: "RU-MOW"})
+				fs.pano.On("Place", game.LatLng{Lat: -33.86, Lng: 151.2}).
+					Return(game.Place{CountryCode: "AU", RegionCode: "AU-NSW"})
+				fs.repo.On("ImportMapLocations", mock.Anything, dto.ImportMapLocationsRequestDB{
+					MapID:    googleMap.ID,
+					Provider: googleMap.Provider,
+					Locations: []gamemapEntity.Location{
+						{
+							LatLng: game.LatLng{Lat: 55.75, Lng: 37.61},
+							Place:  game.Place{CountryCode: "RU", RegionCode: "RU-MOW"},
+						},
+						{
+							LatLng: game.LatLng{Lat: -33.86, Lng: 151.2},
+							Place:  game.Place{CountryCode: "AU", RegionCode: "AU-NSW"},
+						},
+					},
+				}).Return(2, nil)
+			},
+			want:    2,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "geojson with streetview ids",
+			args: args{req: dto.ImportMapLocationsRequest{
+				UserID: 1,
+				MapID:  10,
+				Format: gamemapEntity.ImportFormatGeoJSON,
+				Data: `{"type":"FeatureCollection","features":[{"type":"Feature",` +
+					`"geometry":{"type":"Point","coordinates":[37.61,55.75]},"properties":{"streetviewID":"abc"}}]}`,
+			}},
+			setup: func(fs fields, args args) {
+				fs.repo.On("GetMap", mock.Anything, args.req.MapID).
+					Return(airMap, nil)
+				fs.pano.On("StreetviewIDRequired", airMap.Provider).
+					Return(true, nil)
+				// locations outside of known countries are imported without place
+				fs.pano.On("Place", game.LatLng{Lat: 55.75, Lng: 37.61}).
+					Return(game.Place{})
+				fs.repo.On("ImportMapLocations", mock.Anything, dto.ImportMapLocationsRequestDB{
+					MapID:    airMap.ID,
+					Provider: airMap.Provider,
+					Locations: []gamemapEntity.Location{
+						{LatLng: game.LatLng{Lat: 55.75, Lng: 37.61}, StreetviewID: "abc"},
+					},
+				}).Return(1, nil)
+			},
+			want:    1,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "csv with invalid coordinates",
+			args: args{req: dto.ImportMapLocationsRequest{
+				UserID: 1,
+				MapID:  10,
+				Format: gamemapEntity.ImportFormatCSV,
+				Data:   "55.75,37.61\nnorth,east\n",
+			}},
+			setup: func(_ fields, _ args) {},
+			want:  0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, gamemapEntity.ErrInvalidImport)
+			},
+		},
+		{
+			name: "coordinates out of range",
+			args: args{req: dto.ImportMapLocationsRequest{
+				UserID: 1,
+				MapID:  10,
+				Format: gamemapEntity.ImportFormatCSV,
+				Data:   "95.1,37.61\n",
+			}},
+			setup: func(fs fields, args args) {
+				fs.repo.On("GetMap", mock.Anything, args.req.MapID).
+					Return(googleMap, nil)
+				fs.pano.On("StreetviewIDRequired", googleMap.Provider).
+					Return(false, nil)
+			},
+			want: 0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, gamemapEntity.ErrInvalidImport)
+			},
+		},
+		{
+			name: "yandex air view without streetview id",
+			args: args{req: dto.ImportMapLocationsRequest{
+				UserID: 1,
+				MapID:  10,
+				Format: gamemapEntity.ImportFormatCSV,
+				Data:   "55.75,37.61\n",
+			}},
+			setup: func(fs fields, args args) {
+				fs.repo.On("GetMap", mock.Anything, args.req.MapID).
+					Return(airMap, nil)
+				fs.pano.On("StreetviewIDRequired", airMap.Provider).
+					Return(true, nil)
+			},
+			want: 0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, gamemapEntity.ErrInvalidImport)
+			},
+		},
+		{
+			name: "too many locations",
+			args: args{req: dto.ImportMapLocationsRequest{
+				UserID: 1,
+				MapID:  10,
+				Format: gamemapEntity.ImportFormatCSV,
+				Data:   "1,1\n2,2\n3,3\n4,4\n",
+			}},
+			setup: func(_ fields, _ args) {},
+			want:  0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, gamemapEntity.ErrInvalidImport)
+			},
+		},
+		{
+			name: "map of another user",
+			args: args{req: dto.ImportMapLocationsRequest{
+				UserID: 2,
+				MapID:  10,
+				Format: gamemapEntity.ImportFormatCSV,
+				Data:   "55.75,37.61\n",
+			}},
+			setup: func(fs fields, args args) {
+				fs.repo.On("GetMap", mock.Anything, args.req.MapID).
+					Return(googleMap, nil)
+			},
+			want: 0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, gamemapEntity.ErrWrongUserID)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			pano := mocks.NewPanoramaUsecase(t)
+			fs := fields{repo: repo, pano: pano}
+			uc := gamemap.NewUsecase(gamemap.Config{ImportMaxSize: 3}, repo, pano)
+
+			repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+				Return(func(ctx context.Context, fn repository.TxFunc) error {
+					return fn(ctx)
+				}).Maybe()
+
+			tt.setup(fs, tt.args)
+
+			got, err := uc.ImportMapLocations(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
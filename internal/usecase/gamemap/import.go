@@ -0,0 +1,147 @@
+package gamemap
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/gamemap"
+)
+
+// geoJSONFeatureCollection is a subset of GeoJSON FeatureCollection, that is used for importing map locations.
+type geoJSONFeatureCollection struct {
+	Type     string `json:"type"`
+	Features []struct {
+		Type     string `json:"type"`
+		Geometry struct {
+			Type        string    `json:"type"`
+			Coordinates []float64 `json:"coordinates"`
+		} `json:"geometry"`
+		Properties map[string]any `json:"properties"`
+	} `json:"features"`
+}
+
+// parseLocations parses map locations in provided format.
+func parseLocations(format gamemap.ImportFormat, data string) ([]gamemap.Location, error) {
+	var (
+		locations []gamemap.Location
+		err       error
+	)
+
+	switch format {
+	case gamemap.ImportFormatCSV:
+		locations, err = parseCSV(data)
+	case gamemap.ImportFormatGeoJSON:
+		locations, err = parseGeoJSON(data)
+	default:
+		return nil, fmt.Errorf("%w: unknown format %q", gamemap.ErrInvalidImport, format)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(locations) == 0 {
+		return nil, fmt.Errorf("%w: no locations", gamemap.ErrInvalidImport)
+	}
+
+	return locations, nil
+}
+
+// parseCSV parses "lat,lng[,streetview_id]" rows. The first row is skipped if it is a header.
+func parseCSV(data string) ([]gamemap.Location, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var locations []gamemap.Location
+
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %w", gamemap.ErrInvalidImport, err)
+		}
+
+		if len(record) < 2 || len(record) > 3 {
+			return nil, fmt.Errorf("%w: row %d: expected 2 or 3 columns", gamemap.ErrInvalidImport, row)
+		}
+
+		lat, latErr := strconv.ParseFloat(record[0], 64)
+		lng, lngErr := strconv.ParseFloat(record[1], 64)
+
+		if latErr != nil || lngErr != nil {
+			if row == 1 {
+				continue
+			}
+
+			return nil, fmt.Errorf("%w: row %d: invalid coordinates", gamemap.ErrInvalidImport, row)
+		}
+
+		loc := gamemap.Location{LatLng: game.LatLng{Lat: lat, Lng: lng}}
+		if len(record) == 3 {
+			loc.StreetviewID = strings.TrimSpace(record[2])
+		}
+
+		locations = append(locations, loc)
+	}
+
+	return locations, nil
+}
+
+// parseGeoJSON parses a FeatureCollection of Point features.
+// Streetview ID is read from the "streetviewID" property of a feature.
+func parseGeoJSON(data string) ([]gamemap.Location, error) {
+	var collection geoJSONFeatureCollection
+	if err := json.Unmarshal([]byte(data), &collection); err != nil {
+		return nil, fmt.Errorf("%w: %w", gamemap.ErrInvalidImport, err)
+	}
+
+	if collection.Type != "FeatureCollection" {
+		return nil, fmt.Errorf("%w: expected FeatureCollection", gamemap.ErrInvalidImport)
+	}
+
+	locations := make([]gamemap.Location, 0, len(collection.Features))
+
+	for i, feature := range collection.Features {
+		if feature.Geometry.Type != "Point" || len(feature.Geometry.Coordinates) < 2 {
+			return nil, fmt.Errorf("%w: feature %d: expected Point geometry", gamemap.ErrInvalidImport, i)
+		}
+
+		// GeoJSON positions are in [longitude, latitude] order
+		loc := gamemap.Location{LatLng: game.LatLng{
+			Lat: feature.Geometry.Coordinates[1],
+			Lng: feature.Geometry.Coordinates[0],
+		}}
+
+		if streetviewID, ok := feature.Properties["streetviewID"].(string); ok {
+			loc.StreetviewID = streetviewID
+		}
+
+		locations = append(locations, loc)
+	}
+
+	return locations, nil
+}
+
+// validateLocations checks that locations have valid coordinates and can be shown by the provider.
+func validateLocations(streetviewIDRequired bool, locations []gamemap.Location) error {
+	for i, loc := range locations {
+		if loc.Lat < -90 || loc.Lat > 90 || loc.Lng < -180 || loc.Lng > 180 {
+			return fmt.Errorf("%w: location %d: coordinates out of range", gamemap.ErrInvalidImport, i+1)
+		}
+
+		// some panoramas (e.g. yandex air views) can not be found by coordinates
+		if streetviewIDRequired && loc.StreetviewID == "" {
+			return fmt.Errorf("%w: location %d: streetview id is required", gamemap.ErrInvalidImport, i+1)
+		}
+	}
+
+	return nil
+}
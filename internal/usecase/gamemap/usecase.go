@@ -0,0 +1,59 @@
+// Package gamemap manages user-created maps, that consist of chosen or imported locations
+// and can be played in singleplayer games and lobbies.
+package gamemap
+
+import (
+	"context"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/gamemap"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Repository provides access to map data.
+//
+//go:generate go tool mockery --name=Repository
+type Repository interface {
+	repository.TxManager
+	NewMap(ctx context.Context, req dto.NewMapRequest) (int, error)
+	GetMap(ctx context.Context, id int) (gamemap.Map, error)
+	GetMaps(ctx context.Context, req dto.GetMapsRequest) ([]gamemap.Map, int, error)
+	UpdateMap(ctx context.Context, req dto.UpdateMapRequest) error
+	AddMapLocations(ctx context.Context, req dto.AddMapLocationsRequestDB) (found, added int, err error)
+	ImportMapLocations(ctx context.Context, req dto.ImportMapLocationsRequestDB) (int, error)
+}
+
+// PanoramaUsecase provides information about panorama providers and locations.
+//
+//go:generate go tool mockery --name=PanoramaUsecase
+type PanoramaUsecase interface {
+	StreetviewIDRequired(provider game.PanoramaProvider) (bool, error)
+	Place(location game.LatLng) game.Place
+}
+
+// Usecase contains business logic for map management.
+type Usecase struct {
+	cfg    Config
+	repo   Repository
+	pano   PanoramaUsecase
+	tracer trace.Tracer
+}
+
+// NewUsecase creates and returns a new Usecase instance with the provided dependencies.
+//
+// cfg - Configuration settings for the Usecase.
+//
+// repo - Implementation of the Repository interface for accessing map data.
+//
+// pano - Implementation of the PanoramaUsecase interface for validating imported locations.
+func NewUsecase(cfg Config, repo Repository, pano PanoramaUsecase) *Usecase {
+	return &Usecase{
+		cfg:    cfg,
+		repo:   repo,
+		pano:   pano,
+		tracer: otel.GetTracerProvider().Tracer("GameMapUsecase"),
+	}
+}
@@ -8,14 +8,18 @@ import (
 
 // Config contains lobby configuration.
 type Config struct {
-	LobbyExpiration time.Duration
-	LobbyIDLength   int
+	LobbyExpiration  time.Duration
+	LobbyIDLength    int
+	ReadyCountdown   time.Duration
+	GameStartTimeout time.Duration
 }
 
 // NewConfig returns a new local lobby config from general config.
 func NewConfig(conf config.Config) Config {
 	return Config{
-		LobbyExpiration: conf.Limits.LobbyExpiration,
-		LobbyIDLength:   conf.Limits.LobbyIDLength,
+		LobbyExpiration:  conf.Limits.LobbyExpiration,
+		LobbyIDLength:    conf.Limits.LobbyIDLength,
+		ReadyCountdown:   conf.Limits.LobbyReadyCountdown,
+		GameStartTimeout: conf.Limits.LobbyGameStartTimeout,
 	}
 }
@@ -0,0 +1,71 @@
+package lobby
+
+import (
+	"sync"
+	"time"
+)
+
+// countdowns keeps track of pending game start countdown timers of this instance (one per lobby),
+// so that they can be cancelled when the countdown is cancelled and stopped on shutdown.
+type countdowns struct {
+	mu     sync.Mutex
+	timers map[string]*time.Timer
+}
+
+func newCountdowns() *countdowns {
+	return &countdowns{
+		timers: make(map[string]*time.Timer),
+	}
+}
+
+// schedule runs fn after the given duration, replacing previously scheduled timer of the lobby.
+func (c *countdowns) schedule(lobbyID string, after time.Duration, fn func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if t, ok := c.timers[lobbyID]; ok {
+		t.Stop()
+	}
+
+	var timer *time.Timer
+
+	timer = time.AfterFunc(after, func() {
+		c.mu.Lock()
+		if c.timers[lobbyID] == timer {
+			delete(c.timers, lobbyID)
+		}
+		c.mu.Unlock()
+
+		fn()
+	})
+
+	c.timers[lobbyID] = timer
+}
+
+// cancel stops scheduled timer of the lobby, if there is one.
+func (c *countdowns) cancel(lobbyID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if t, ok := c.timers[lobbyID]; ok {
+		t.Stop()
+		delete(c.timers, lobbyID)
+	}
+}
+
+// stop cancels all scheduled timers.
+func (c *countdowns) stop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for lobbyID, t := range c.timers {
+		t.Stop()
+		delete(c.timers, lobbyID)
+	}
+}
+
+// Close stops game start countdown timers of this instance. Their countdowns are left in the repository
+// and expire, as no instance starts the game after them.
+func (uc Usecase) Close() {
+	uc.countdowns.stop()
+}
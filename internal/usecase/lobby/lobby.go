@@ -13,17 +13,80 @@ func (uc Usecase) NewLobby(ctx context.Context, req dto.NewLobbyRequest) (string
 	ctx, span := uc.tracer.Start(ctx, "NewLobby")
 	defer span.End()
 
+	if req.MapID != 0 {
+		m, err := uc.maps.GetPlayableMap(ctx, dto.GetMapRequest{
+			UserID: req.CreatorID,
+			MapID:  req.MapID,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to get map: %w", err)
+		}
+
+		req.Provider = string(m.Provider)
+		req.Providers = nil
+	}
+
+	providers := lobby.Settings{Provider: req.Provider, Providers: req.Providers}
+	if !providers.ValidProviders(uc.pano.ValidGameProvider) {
+		return "", lobby.ErrWrongSettings
+	}
+
+	if req.Visibility == "" {
+		req.Visibility = lobby.PublicVisibility
+	}
+
+	var (
+		passwordHash   string
+		invitedUserIDs []int
+	)
+
+	switch req.Visibility {
+	case lobby.PasswordVisibility:
+		if req.Password == "" {
+			return "", lobby.ErrPasswordRequired
+		}
+
+		hash, err := uc.hasher.GenerateHashFromPassword(req.Password)
+		if err != nil {
+			return "", fmt.Errorf("failed to hash lobby password: %w", err)
+		}
+
+		passwordHash = hash
+	case lobby.InviteOnlyVisibility:
+		invitedUserIDs = req.InvitedUserIDs
+	}
+
+	// username of the creator is saved to search lobbies by it
+	creator, err := uc.userRepo.GetUserByID(ctx, req.CreatorID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get lobby creator: %w", err)
+	}
+
 	id := uc.rnd.NewRandomHexString(uc.conf.LobbyIDLength)
 
 	dbReq := dto.NewLobbyRequestDB{
-		ID:              id,
-		RequestTime:     req.RequestTime,
-		MaxPlayers:      req.MaxPlayers,
-		CreatorID:       req.CreatorID,
-		Rounds:          req.Rounds,
-		Provider:        req.Provider,
-		TimerSeconds:    req.TimerSeconds,
-		MovementAllowed: req.MovementAllowed,
+		ID:                    id,
+		RequestTime:           req.RequestTime,
+		MaxPlayers:            req.MaxPlayers,
+		CreatorID:             req.CreatorID,
+		CreatorUsername:       creator.Username,
+		Rounds:                req.Rounds,
+		Provider:              req.Provider,
+		Providers:             req.Providers,
+		TimerSeconds:          req.TimerSeconds,
+		MovementAllowed:       req.MovementAllowed,
+		MapID:                 req.MapID,
+		ScoreDistance:         req.ScoreDistance,
+		Scoring:               req.Scoring,
+		Mode:                  req.Mode,
+		Teams:                 req.Teams,
+		TeamScoring:           req.TeamScoring,
+		Spectators:            req.Spectators,
+		SpectatorDelaySeconds: req.SpectatorDelaySeconds,
+		Visibility:            req.Visibility,
+		PasswordHash:          passwordHash,
+		InvitedUserIDs:        invitedUserIDs,
+		RequireReady:          req.RequireReady,
 	}
 
 	if err := uc.lobbyRepo.NewLobby(ctx, dbReq); err != nil {
@@ -62,7 +125,7 @@ func (uc Usecase) DeleteLobby(ctx context.Context, id string) error {
 	return nil
 }
 
-// GetLobbies lists all currently active lobbies.
+// GetLobbies lists currently active lobbies, that match filters of the request.
 func (uc Usecase) GetLobbies(ctx context.Context, req dto.GetLobbiesRequest) ([]lobby.Lobby, int, error) {
 	ctx, span := uc.tracer.Start(ctx, "GetLobbies")
 	defer span.End()
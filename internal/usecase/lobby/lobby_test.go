@@ -6,7 +6,10 @@ import (
 	"time"
 
 	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/gamemap"
 	lobbyEntity "github.com/VasySS/segoya-backend/internal/entity/lobby"
+	"github.com/VasySS/segoya-backend/internal/entity/user"
 	"github.com/VasySS/segoya-backend/internal/usecase/lobby"
 	"github.com/VasySS/segoya-backend/internal/usecase/lobby/mocks"
 	"github.com/stretchr/testify/assert"
@@ -26,10 +29,16 @@ func TestUsecase_NewLobby(t *testing.T) {
 		MovementAllowed: true,
 	}
 
+	creator := user.PrivateProfile{PublicProfile: user.PublicProfile{ID: 1, Username: "Creator"}}
+
 	type fields struct {
 		conf      lobby.Config
 		rnd       *mocks.RandomGenerator
+		hasher    *mocks.PasswordHasher
+		userRepo  *mocks.UserRepository
 		lobbyRepo *mocks.Repository
+		maps      *mocks.MapUsecase
+		pano      *mocks.PanoramaUsecase
 	}
 
 	type args struct {
@@ -51,18 +60,166 @@ func TestUsecase_NewLobby(t *testing.T) {
 			setup: func(fs fields, args args) {
 				lobbyID := "1234567890"
 
+				fs.pano.On("ValidGameProvider", game.PanoramaProvider(args.req.Provider)).
+					Return(true)
+
+				fs.userRepo.On("GetUserByID", mock.Anything, args.req.CreatorID).
+					Return(creator, nil)
+
 				fs.rnd.On("NewRandomHexString", fs.conf.LobbyIDLength).
 					Return(lobbyID)
 
 				fs.lobbyRepo.On("NewLobby", mock.Anything, dto.NewLobbyRequestDB{
 					ID:              lobbyID,
 					CreatorID:       args.req.CreatorID,
+					CreatorUsername: creator.Username,
 					RequestTime:     args.req.RequestTime,
 					Rounds:          args.req.Rounds,
 					MaxPlayers:      args.req.MaxPlayers,
 					Provider:        args.req.Provider,
 					TimerSeconds:    args.req.TimerSeconds,
 					MovementAllowed: args.req.MovementAllowed,
+					Visibility:      lobbyEntity.PublicVisibility,
+				}).Return(nil)
+
+				fs.lobbyRepo.On("AddLobbyExpiration", mock.Anything, lobbyID, mock.AnythingOfType("time.Duration")).
+					Return(nil)
+			},
+			want:    "1234567890",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "successfully create lobby on map",
+			args: args{
+				req: dto.NewLobbyRequest{
+					RequestTime:  newLobbyReq.RequestTime,
+					MaxPlayers:   4,
+					CreatorID:    1,
+					Rounds:       5,
+					TimerSeconds: 60,
+					MapID:        10,
+				},
+			},
+			setup: func(fs fields, args args) {
+				lobbyID := "1234567890"
+
+				fs.maps.On("GetPlayableMap", mock.Anything, dto.GetMapRequest{
+					UserID: args.req.CreatorID,
+					MapID:  args.req.MapID,
+				}).Return(gamemap.Map{ID: args.req.MapID, Provider: game.YandexProvider, LocationsCount: 3}, nil)
+
+				fs.pano.On("ValidGameProvider", game.YandexProvider).
+					Return(true)
+
+				fs.userRepo.On("GetUserByID", mock.Anything, args.req.CreatorID).
+					Return(creator, nil)
+
+				fs.rnd.On("NewRandomHexString", fs.conf.LobbyIDLength).
+					Return(lobbyID)
+
+				fs.lobbyRepo.On("NewLobby", mock.Anything, dto.NewLobbyRequestDB{
+					ID:              lobbyID,
+					CreatorID:       args.req.CreatorID,
+					CreatorUsername: creator.Username,
+					RequestTime:     args.req.RequestTime,
+					Rounds:          args.req.Rounds,
+					MaxPlayers:      args.req.MaxPlayers,
+					Provider:        string(game.YandexProvider),
+					TimerSeconds:    args.req.TimerSeconds,
+					MapID:           args.req.MapID,
+					Visibility:      lobbyEntity.PublicVisibility,
+				}).Return(nil)
+
+				fs.lobbyRepo.On("AddLobbyExpiration", mock.Anything, lobbyID, mock.AnythingOfType("time.Duration")).
+					Return(nil)
+			},
+			want:    "1234567890",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "successfully create password-protected lobby",
+			args: args{
+				req: dto.NewLobbyRequest{
+					RequestTime: newLobbyReq.RequestTime,
+					MaxPlayers:  4,
+					CreatorID:   1,
+					Rounds:      5,
+					Provider:    "google",
+					Visibility:  lobbyEntity.PasswordVisibility,
+					Password:    "secret",
+					// invited users are ignored for lobbies, that are not invite-only
+					InvitedUserIDs: []int{2, 3},
+				},
+			},
+			setup: func(fs fields, args args) {
+				lobbyID := "1234567890"
+
+				fs.pano.On("ValidGameProvider", game.PanoramaProvider(args.req.Provider)).
+					Return(true)
+
+				fs.hasher.On("GenerateHashFromPassword", args.req.Password).
+					Return("hash", nil)
+
+				fs.userRepo.On("GetUserByID", mock.Anything, args.req.CreatorID).
+					Return(creator, nil)
+
+				fs.rnd.On("NewRandomHexString", fs.conf.LobbyIDLength).
+					Return(lobbyID)
+
+				fs.lobbyRepo.On("NewLobby", mock.Anything, dto.NewLobbyRequestDB{
+					ID:              lobbyID,
+					CreatorID:       args.req.CreatorID,
+					CreatorUsername: creator.Username,
+					RequestTime:     args.req.RequestTime,
+					Rounds:          args.req.Rounds,
+					MaxPlayers:      args.req.MaxPlayers,
+					Provider:        args.req.Provider,
+					Visibility:      lobbyEntity.PasswordVisibility,
+					PasswordHash:    "hash",
+				}).Return(nil)
+
+				fs.lobbyRepo.On("AddLobbyExpiration", mock.Anything, lobbyID, mock.AnythingOfType("time.Duration")).
+					Return(nil)
+			},
+			want:    "1234567890",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "successfully create invite-only lobby",
+			args: args{
+				req: dto.NewLobbyRequest{
+					RequestTime:    newLobbyReq.RequestTime,
+					MaxPlayers:     4,
+					CreatorID:      1,
+					Rounds:         5,
+					Provider:       "google",
+					Visibility:     lobbyEntity.InviteOnlyVisibility,
+					Password:       "ignored",
+					InvitedUserIDs: []int{2, 3},
+				},
+			},
+			setup: func(fs fields, args args) {
+				lobbyID := "1234567890"
+
+				fs.pano.On("ValidGameProvider", game.PanoramaProvider(args.req.Provider)).
+					Return(true)
+
+				fs.userRepo.On("GetUserByID", mock.Anything, args.req.CreatorID).
+					Return(creator, nil)
+
+				fs.rnd.On("NewRandomHexString", fs.conf.LobbyIDLength).
+					Return(lobbyID)
+
+				fs.lobbyRepo.On("NewLobby", mock.Anything, dto.NewLobbyRequestDB{
+					ID:              lobbyID,
+					CreatorID:       args.req.CreatorID,
+					CreatorUsername: creator.Username,
+					RequestTime:     args.req.RequestTime,
+					Rounds:          args.req.Rounds,
+					MaxPlayers:      args.req.MaxPlayers,
+					Provider:        args.req.Provider,
+					Visibility:      lobbyEntity.InviteOnlyVisibility,
+					InvitedUserIDs:  args.req.InvitedUserIDs,
 				}).Return(nil)
 
 				fs.lobbyRepo.On("AddLobbyExpiration", mock.Anything, lobbyID, mock.AnythingOfType("time.Duration")).
@@ -71,12 +228,93 @@ func TestUsecase_NewLobby(t *testing.T) {
 			want:    "1234567890",
 			wantErr: assert.NoError,
 		},
+		{
+			name: "password-protected lobby without password",
+			args: args{
+				req: dto.NewLobbyRequest{
+					RequestTime: newLobbyReq.RequestTime,
+					CreatorID:   1,
+					Provider:    "google",
+					Visibility:  lobbyEntity.PasswordVisibility,
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.pano.On("ValidGameProvider", game.PanoramaProvider(args.req.Provider)).
+					Return(true)
+			},
+			want: "",
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrPasswordRequired)
+			},
+		},
+		{
+			name: "map is not found",
+			args: args{
+				req: dto.NewLobbyRequest{
+					RequestTime: newLobbyReq.RequestTime,
+					CreatorID:   1,
+					MapID:       10,
+				},
+			},
+			setup: func(fs fields, _ args) {
+				fs.maps.On("GetPlayableMap", mock.Anything, mock.Anything).
+					Return(gamemap.Map{}, gamemap.ErrNotFound)
+			},
+			want: "",
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, gamemap.ErrNotFound)
+			},
+		},
+		{
+			name: "unknown provider",
+			args: args{
+				req: dto.NewLobbyRequest{
+					RequestTime: newLobbyReq.RequestTime,
+					CreatorID:   1,
+					Provider:    "unknown",
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.pano.On("ValidGameProvider", game.PanoramaProvider(args.req.Provider)).
+					Return(false)
+			},
+			want: "",
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrWrongSettings)
+			},
+		},
+		{
+			name: "mixed provider with unknown allowed provider",
+			args: args{
+				req: dto.NewLobbyRequest{
+					RequestTime: newLobbyReq.RequestTime,
+					CreatorID:   1,
+					Provider:    string(game.MixedProvider),
+					Providers:   []string{"google", "unknown"},
+				},
+			},
+			setup: func(fs fields, _ args) {
+				fs.pano.On("ValidGameProvider", game.MixedProvider).Return(true)
+				fs.pano.On("ValidGameProvider", game.GoogleProvider).Return(true)
+				fs.pano.On("ValidGameProvider", game.PanoramaProvider("unknown")).Return(false)
+			},
+			want: "",
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrWrongSettings)
+			},
+		},
 		{
 			name: "failed to create lobby",
 			args: args{
 				req: newLobbyReq,
 			},
-			setup: func(fs fields, _ args) {
+			setup: func(fs fields, args args) {
+				fs.pano.On("ValidGameProvider", game.PanoramaProvider(args.req.Provider)).
+					Return(true)
+
+				fs.userRepo.On("GetUserByID", mock.Anything, args.req.CreatorID).
+					Return(creator, nil)
+
 				fs.rnd.On("NewRandomHexString", fs.conf.LobbyIDLength).
 					Return("1234567890")
 
@@ -94,15 +332,23 @@ func TestUsecase_NewLobby(t *testing.T) {
 
 			lobbyRepo := mocks.NewRepository(t)
 			rnd := mocks.NewRandomGenerator(t)
+			hasher := mocks.NewPasswordHasher(t)
+			userRepo := mocks.NewUserRepository(t)
+			maps := mocks.NewMapUsecase(t)
+			pano := mocks.NewPanoramaUsecase(t)
 			conf := lobby.Config{LobbyIDLength: 10}
 			fs := fields{
 				conf:      conf,
 				rnd:       rnd,
+				hasher:    hasher,
+				userRepo:  userRepo,
 				lobbyRepo: lobbyRepo,
+				maps:      maps,
+				pano:      pano,
 			}
 			tt.setup(fs, tt.args)
 
-			uc := lobby.NewUsecase(conf, rnd, nil, lobbyRepo, nil)
+			uc := lobby.NewUsecase(conf, rnd, hasher, userRepo, lobbyRepo, nil, maps, pano)
 
 			got, err := uc.NewLobby(t.Context(), tt.args.req)
 			tt.wantErr(t, err)
@@ -165,7 +411,7 @@ func TestUsecase_GetLobby(t *testing.T) {
 			}
 			tt.setup(fs, tt.args)
 
-			uc := lobby.NewUsecase(lobby.Config{}, nil, nil, lobbyRepo, nil)
+			uc := lobby.NewUsecase(lobby.Config{}, nil, nil, nil, lobbyRepo, nil, nil, nil)
 
 			got, err := uc.GetLobby(t.Context(), tt.args.id)
 			tt.wantErr(t, err)
@@ -225,7 +471,7 @@ func TestUsecase_DeleteLobby(t *testing.T) {
 			}
 			tt.setup(fs, tt.args)
 
-			uc := lobby.NewUsecase(lobby.Config{}, nil, nil, lobbyRepo, nil)
+			uc := lobby.NewUsecase(lobby.Config{}, nil, nil, nil, lobbyRepo, nil, nil, nil)
 
 			err := uc.DeleteLobby(t.Context(), tt.args.id)
 			tt.wantErr(t, err)
@@ -304,7 +550,7 @@ func TestUsecase_GetLobbies(t *testing.T) {
 			}
 			tt.setup(fs, tt.args)
 
-			uc := lobby.NewUsecase(lobby.Config{}, nil, nil, lobbyRepo, nil)
+			uc := lobby.NewUsecase(lobby.Config{}, nil, nil, nil, lobbyRepo, nil, nil, nil)
 
 			got, total, err := uc.GetLobbies(t.Context(), tt.args.req)
 			tt.wantErr(t, err)
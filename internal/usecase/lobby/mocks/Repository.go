@@ -36,6 +36,70 @@ func (_m *Repository) AddLobbyExpiration(ctx context.Context, id string, ttl tim
 	return r0
 }
 
+// AddLobbyPlayer provides a mock function with given fields: ctx, id, userID
+func (_m *Repository) AddLobbyPlayer(ctx context.Context, id string, userID int) error {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddLobbyPlayer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BanLobbyUser provides a mock function with given fields: ctx, id, userID
+func (_m *Repository) BanLobbyUser(ctx context.Context, id string, userID int) error {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BanLobbyUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClaimLobbyGameStart provides a mock function with given fields: ctx, id, token, ttl
+func (_m *Repository) ClaimLobbyGameStart(ctx context.Context, id string, token string, ttl time.Duration) (bool, error) {
+	ret := _m.Called(ctx, id, token, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimLobbyGameStart")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) (bool, error)); ok {
+		return rf(ctx, id, token, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) bool); ok {
+		r0 = rf(ctx, id, token, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Duration) error); ok {
+		r1 = rf(ctx, id, token, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DecrementLobbyPlayers provides a mock function with given fields: ctx, id
 func (_m *Repository) DecrementLobbyPlayers(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
@@ -72,6 +136,34 @@ func (_m *Repository) DeleteLobby(ctx context.Context, id string) error {
 	return r0
 }
 
+// DeleteLobbyCountdown provides a mock function with given fields: ctx, id, token
+func (_m *Repository) DeleteLobbyCountdown(ctx context.Context, id string, token string) (bool, error) {
+	ret := _m.Called(ctx, id, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLobbyCountdown")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, id, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, id, token)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteLobbyExpiration provides a mock function with given fields: ctx, id
 func (_m *Repository) DeleteLobbyExpiration(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
@@ -155,6 +247,96 @@ func (_m *Repository) GetLobby(ctx context.Context, id string) (entitylobby.Lobb
 	return r0, r1
 }
 
+// GetLobbyPlayers provides a mock function with given fields: ctx, id
+func (_m *Repository) GetLobbyPlayers(ctx context.Context, id string) ([]int, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLobbyPlayers")
+	}
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]int, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []int); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLobbyReadyUsers provides a mock function with given fields: ctx, id
+func (_m *Repository) GetLobbyReadyUsers(ctx context.Context, id string) ([]int, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLobbyReadyUsers")
+	}
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]int, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []int); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLobbyTeams provides a mock function with given fields: ctx, id
+func (_m *Repository) GetLobbyTeams(ctx context.Context, id string) (map[int]int, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLobbyTeams")
+	}
+
+	var r0 map[int]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (map[int]int, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[int]int); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IncrementLobbyPlayers provides a mock function with given fields: ctx, id
 func (_m *Repository) IncrementLobbyPlayers(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
@@ -173,6 +355,34 @@ func (_m *Repository) IncrementLobbyPlayers(ctx context.Context, id string) erro
 	return r0
 }
 
+// IsLobbyUserBanned provides a mock function with given fields: ctx, id, userID
+func (_m *Repository) IsLobbyUserBanned(ctx context.Context, id string, userID int) (bool, error) {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsLobbyUserBanned")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) (bool, error)); ok {
+		return rf(ctx, id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) bool); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewLobby provides a mock function with given fields: ctx, req
 func (_m *Repository) NewLobby(ctx context.Context, req dto.NewLobbyRequestDB) error {
 	ret := _m.Called(ctx, req)
@@ -191,6 +401,170 @@ func (_m *Repository) NewLobby(ctx context.Context, req dto.NewLobbyRequestDB) e
 	return r0
 }
 
+// ReleaseLobbyGameStart provides a mock function with given fields: ctx, id
+func (_m *Repository) ReleaseLobbyGameStart(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseLobbyGameStart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveLobbyPlayer provides a mock function with given fields: ctx, id, userID
+func (_m *Repository) RemoveLobbyPlayer(ctx context.Context, id string, userID int) error {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveLobbyPlayer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResetLobbyReady provides a mock function with given fields: ctx, id
+func (_m *Repository) ResetLobbyReady(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetLobbyReady")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetLobbyCountdown provides a mock function with given fields: ctx, id, token, ttl
+func (_m *Repository) SetLobbyCountdown(ctx context.Context, id string, token string, ttl time.Duration) (bool, error) {
+	ret := _m.Called(ctx, id, token, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLobbyCountdown")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) (bool, error)); ok {
+		return rf(ctx, id, token, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) bool); ok {
+		r0 = rf(ctx, id, token, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Duration) error); ok {
+		r1 = rf(ctx, id, token, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetLobbyCreator provides a mock function with given fields: ctx, req
+func (_m *Repository) SetLobbyCreator(ctx context.Context, req dto.SetLobbyCreatorRequestDB) (bool, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLobbyCreator")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.SetLobbyCreatorRequestDB) (bool, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.SetLobbyCreatorRequestDB) bool); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.SetLobbyCreatorRequestDB) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetLobbyUserReady provides a mock function with given fields: ctx, req
+func (_m *Repository) SetLobbyUserReady(ctx context.Context, req dto.SetLobbyUserReadyRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLobbyUserReady")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.SetLobbyUserReadyRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetLobbyUserTeam provides a mock function with given fields: ctx, req
+func (_m *Repository) SetLobbyUserTeam(ctx context.Context, req dto.SwitchLobbyTeamRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLobbyUserTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.SwitchLobbyTeamRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateLobbySettings provides a mock function with given fields: ctx, req
+func (_m *Repository) UpdateLobbySettings(ctx context.Context, req dto.UpdateLobbySettingsRequestDB) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLobbySettings")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.UpdateLobbySettingsRequestDB) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRepository(t interface {
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/gamemap"
 	"github.com/VasySS/segoya-backend/internal/entity/lobby"
 	"github.com/VasySS/segoya-backend/internal/entity/user"
 	"go.opentelemetry.io/otel"
@@ -25,8 +27,24 @@ type Repository interface {
 	GetLobbies(ctx context.Context, req dto.GetLobbiesRequest) ([]lobby.Lobby, int, error)
 	IncrementLobbyPlayers(ctx context.Context, id string) error
 	DecrementLobbyPlayers(ctx context.Context, id string) error
+	UpdateLobbySettings(ctx context.Context, req dto.UpdateLobbySettingsRequestDB) error
 	AddLobbyExpiration(ctx context.Context, id string, ttl time.Duration) error
 	DeleteLobbyExpiration(ctx context.Context, id string) error
+	SetLobbyUserTeam(ctx context.Context, req dto.SwitchLobbyTeamRequest) error
+	GetLobbyTeams(ctx context.Context, id string) (map[int]int, error)
+	AddLobbyPlayer(ctx context.Context, id string, userID int) error
+	RemoveLobbyPlayer(ctx context.Context, id string, userID int) error
+	GetLobbyPlayers(ctx context.Context, id string) ([]int, error)
+	SetLobbyCreator(ctx context.Context, req dto.SetLobbyCreatorRequestDB) (bool, error)
+	BanLobbyUser(ctx context.Context, id string, userID int) error
+	IsLobbyUserBanned(ctx context.Context, id string, userID int) (bool, error)
+	SetLobbyUserReady(ctx context.Context, req dto.SetLobbyUserReadyRequest) error
+	GetLobbyReadyUsers(ctx context.Context, id string) ([]int, error)
+	ResetLobbyReady(ctx context.Context, id string) error
+	SetLobbyCountdown(ctx context.Context, id, token string, ttl time.Duration) (bool, error)
+	DeleteLobbyCountdown(ctx context.Context, id, token string) (bool, error)
+	ClaimLobbyGameStart(ctx context.Context, id, token string, ttl time.Duration) (bool, error)
+	ReleaseLobbyGameStart(ctx context.Context, id string) error
 }
 
 // UserRepository provides access to user data.
@@ -43,6 +61,20 @@ type MultiplayerUsecase interface {
 	NewGame(ctx context.Context, req dto.NewMultiplayerGameRequest) (int, error)
 }
 
+// MapUsecase provides access to user-created maps.
+//
+//go:generate go tool mockery --name=MapUsecase
+type MapUsecase interface {
+	GetPlayableMap(ctx context.Context, req dto.GetMapRequest) (gamemap.Map, error)
+}
+
+// PanoramaUsecase provides validation of panorama providers.
+//
+//go:generate go tool mockery --name=PanoramaUsecase
+type PanoramaUsecase interface {
+	ValidGameProvider(provider game.PanoramaProvider) bool
+}
+
 // RandomGenerator provides cryptographically secure random string generation.
 //
 //go:generate go tool mockery --name=RandomGenerator
@@ -50,14 +82,27 @@ type RandomGenerator interface {
 	NewRandomHexString(length int) string
 }
 
+// PasswordHasher provides hashing of lobby passwords.
+//
+//go:generate go tool mockery --name=PasswordHasher
+type PasswordHasher interface {
+	GenerateHashFromPassword(password string) (string, error)
+	CompareHashAndPassword(hash, password string) error
+}
+
 // Usecase contains business logic for lobby management.
 type Usecase struct {
 	conf      Config
 	rnd       RandomGenerator
+	hasher    PasswordHasher
 	lobbyRepo Repository
 	userRepo  UserRepository
 	mult      MultiplayerUsecase
-	tracer    trace.Tracer
+	maps      MapUsecase
+	pano      PanoramaUsecase
+	// countdowns are game start countdown timers of lobbies, which were started on this instance
+	countdowns *countdowns
+	tracer     trace.Tracer
 }
 
 // NewUsecase creates and returns a new instance of Usecase with the provided dependencies.
@@ -66,24 +111,37 @@ type Usecase struct {
 //
 // rnd - Instance of RandomGenerator for generating random strings.
 //
+// hasher - Implementation of PasswordHasher for hashing passwords of password-protected lobbies.
+//
 // userRepo - Implementation of UserRepository for accessing user data.
 //
 // lobbyRepo - Implementation of LobbyRepository for managing lobby data.
 //
 // mult - Implementation of MultiplayerUsecase for managing multiplayer games.
+//
+// maps - Implementation of MapUsecase for accessing user-created maps.
+//
+// pano - Implementation of PanoramaUsecase for validating panorama providers.
 func NewUsecase(
 	conf Config,
 	rnd RandomGenerator,
+	hasher PasswordHasher,
 	userRepo UserRepository,
 	lobbyRepo Repository,
 	mult MultiplayerUsecase,
+	maps MapUsecase,
+	pano PanoramaUsecase,
 ) *Usecase {
 	return &Usecase{
-		conf:      conf,
-		rnd:       rnd,
-		lobbyRepo: lobbyRepo,
-		userRepo:  userRepo,
-		mult:      mult,
-		tracer:    otel.GetTracerProvider().Tracer("LobbyUsecase"),
+		conf:       conf,
+		rnd:        rnd,
+		hasher:     hasher,
+		lobbyRepo:  lobbyRepo,
+		userRepo:   userRepo,
+		mult:       mult,
+		maps:       maps,
+		pano:       pano,
+		countdowns: newCountdowns(),
+		tracer:     otel.GetTracerProvider().Tracer("LobbyUsecase"),
 	}
 }
@@ -3,8 +3,11 @@ package lobby
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
 	"github.com/VasySS/segoya-backend/internal/entity/lobby"
 	"github.com/VasySS/segoya-backend/internal/entity/user"
 )
@@ -12,30 +15,37 @@ import (
 // ConnectLobbyUser handles a user joining a lobby (called from the websocket).
 func (uc Usecase) ConnectLobbyUser(
 	ctx context.Context,
-	lobbyID string,
-	userID int,
+	req dto.ConnectLobbyUserRequest,
 ) (user.PublicProfile, error) {
 	ctx, span := uc.tracer.Start(ctx, "ConnectLobbyUser")
 	defer span.End()
 
-	lobbyRepo, err := uc.lobbyRepo.GetLobby(ctx, lobbyID)
+	lobbyRepo, err := uc.lobbyRepo.GetLobby(ctx, req.LobbyID)
 	if err != nil {
 		return user.PublicProfile{}, fmt.Errorf("error getting lobby: %w", err)
 	}
 
+	if err := uc.checkAccess(ctx, lobbyRepo, req); err != nil {
+		return user.PublicProfile{}, err
+	}
+
 	if lobbyRepo.CurrentPlayers >= lobbyRepo.MaxPlayers {
 		return user.PublicProfile{}, lobby.ErrLobbyIsFull
 	}
 
-	if err := uc.lobbyRepo.IncrementLobbyPlayers(ctx, lobbyID); err != nil {
+	if err := uc.lobbyRepo.IncrementLobbyPlayers(ctx, req.LobbyID); err != nil {
 		return user.PublicProfile{}, fmt.Errorf("error incrementing current players: %w", err)
 	}
 
-	if err := uc.lobbyRepo.DeleteLobbyExpiration(ctx, lobbyID); err != nil {
+	if err := uc.lobbyRepo.AddLobbyPlayer(ctx, req.LobbyID, req.UserID); err != nil {
+		return user.PublicProfile{}, fmt.Errorf("error adding lobby player: %w", err)
+	}
+
+	if err := uc.lobbyRepo.DeleteLobbyExpiration(ctx, req.LobbyID); err != nil {
 		return user.PublicProfile{}, fmt.Errorf("error deleting lobby expiration: %w", err)
 	}
 
-	userRepo, err := uc.userRepo.GetUserByID(ctx, userID)
+	userRepo, err := uc.userRepo.GetUserByID(ctx, req.UserID)
 	if err != nil {
 		return user.PublicProfile{}, fmt.Errorf("error getting user profile: %w", err)
 	}
@@ -43,35 +53,410 @@ func (uc Usecase) ConnectLobbyUser(
 	return userRepo.ToPublicProfile(), nil
 }
 
+// CheckLobbyAccess returns an error if the user can't join the lobby - they are banned from it,
+// the password of the lobby is wrong or they are not invited to the lobby (called before the websocket upgrade).
+func (uc Usecase) CheckLobbyAccess(ctx context.Context, req dto.ConnectLobbyUserRequest) error {
+	ctx, span := uc.tracer.Start(ctx, "CheckLobbyAccess")
+	defer span.End()
+
+	l, err := uc.lobbyRepo.GetLobby(ctx, req.LobbyID)
+	if err != nil {
+		return fmt.Errorf("error getting lobby: %w", err)
+	}
+
+	return uc.checkAccess(ctx, l, req)
+}
+
+// checkAccess checks that the user can join the lobby by its bans and visibility.
+// Creator of the lobby can join it without a password.
+func (uc Usecase) checkAccess(ctx context.Context, l lobby.Lobby, req dto.ConnectLobbyUserRequest) error {
+	banned, err := uc.lobbyRepo.IsLobbyUserBanned(ctx, req.LobbyID, req.UserID)
+	if err != nil {
+		return fmt.Errorf("error checking user ban: %w", err)
+	}
+
+	if banned {
+		return lobby.ErrUserIsBanned
+	}
+
+	switch l.Visibility {
+	case lobby.PasswordVisibility:
+		if l.CreatorID == req.UserID {
+			return nil
+		}
+
+		if err := uc.hasher.CompareHashAndPassword(l.PasswordHash, req.Password); err != nil {
+			return lobby.ErrWrongPassword
+		}
+	case lobby.InviteOnlyVisibility:
+		if !l.Invited(req.UserID) {
+			return lobby.ErrNotInvited
+		}
+	case lobby.PublicVisibility, lobby.UnlistedVisibility:
+	}
+
+	return nil
+}
+
 // DisconnectLobbyUser handles a user leaving a lobby (called from the websocket).
+// If the creator leaves, the host role passes to the longest-connected user,
+// whose ID is returned (0 if the host hasn't changed).
 func (uc Usecase) DisconnectLobbyUser(
 	ctx context.Context,
 	lobbyID string,
-	_ int,
-) error {
+	userID int,
+) (int, error) {
 	ctx, span := uc.tracer.Start(ctx, "DisconnectLobbyUser")
 	defer span.End()
 
 	lobby, err := uc.lobbyRepo.GetLobby(ctx, lobbyID)
 	if err != nil {
-		return fmt.Errorf("error getting lobby from db: %w", err)
+		return 0, fmt.Errorf("error getting lobby from db: %w", err)
 	}
 
 	// delete lobby if it is empty for some time
 	if lobby.CurrentPlayers == 1 {
 		if err := uc.lobbyRepo.AddLobbyExpiration(ctx, lobbyID, uc.conf.LobbyExpiration); err != nil {
-			return fmt.Errorf("error deleting lobby: %w", err)
+			return 0, fmt.Errorf("error deleting lobby: %w", err)
 		}
 	}
 
 	if err := uc.lobbyRepo.DecrementLobbyPlayers(ctx, lobbyID); err != nil {
-		return fmt.Errorf("error decrementing current players: %w", err)
+		return 0, fmt.Errorf("error decrementing current players: %w", err)
+	}
+
+	if err := uc.lobbyRepo.RemoveLobbyPlayer(ctx, lobbyID, userID); err != nil {
+		return 0, fmt.Errorf("error removing lobby player: %w", err)
+	}
+
+	if lobby.CreatorID != userID {
+		return 0, nil
+	}
+
+	players, err := uc.lobbyRepo.GetLobbyPlayers(ctx, lobbyID)
+	if err != nil {
+		return 0, fmt.Errorf("error getting lobby players: %w", err)
+	}
+
+	// creator is still connected from another session, or nobody is left to pass the host role to
+	if len(players) == 0 || slices.Contains(players, userID) {
+		return 0, nil
+	}
+
+	passed, err := uc.setLobbyCreator(ctx, lobbyID, userID, players[0])
+	if err != nil {
+		return 0, err
+	}
+
+	// host role was already passed by another request
+	if !passed {
+		return 0, nil
+	}
+
+	return players[0], nil
+}
+
+// setLobbyCreator passes the host role of the lobby from the old creator to the user.
+// It returns false if the lobby doesn't have the old creator anymore.
+func (uc Usecase) setLobbyCreator(ctx context.Context, lobbyID string, oldCreatorID, userID int) (bool, error) {
+	u, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("error getting new host profile: %w", err)
+	}
+
+	passed, err := uc.lobbyRepo.SetLobbyCreator(ctx, dto.SetLobbyCreatorRequestDB{
+		ID:              lobbyID,
+		OldCreatorID:    oldCreatorID,
+		CreatorID:       userID,
+		CreatorUsername: u.Username,
+	})
+	if err != nil {
+		return false, fmt.Errorf("error passing host role: %w", err)
+	}
+
+	return passed, nil
+}
+
+// KickLobbyUser removes a user from the lobby by its creator and optionally bans them,
+// so that they can't join the lobby again (called from the websocket).
+// Connection of the user must be closed by the caller.
+func (uc Usecase) KickLobbyUser(ctx context.Context, req dto.KickLobbyUserRequest) error {
+	ctx, span := uc.tracer.Start(ctx, "KickLobbyUser")
+	defer span.End()
+
+	if err := uc.checkModerationTarget(ctx, req.LobbyID, req.CreatorID, req.UserID); err != nil {
+		return err
+	}
+
+	if !req.Ban {
+		return nil
+	}
+
+	if err := uc.lobbyRepo.BanLobbyUser(ctx, req.LobbyID, req.UserID); err != nil {
+		return fmt.Errorf("error banning user: %w", err)
+	}
+
+	return nil
+}
+
+// TransferLobbyHost passes the host role of the lobby from its creator to another connected user
+// (called from the websocket).
+func (uc Usecase) TransferLobbyHost(ctx context.Context, req dto.TransferLobbyHostRequest) error {
+	ctx, span := uc.tracer.Start(ctx, "TransferLobbyHost")
+	defer span.End()
+
+	if err := uc.checkModerationTarget(ctx, req.LobbyID, req.CreatorID, req.UserID); err != nil {
+		return err
+	}
+
+	passed, err := uc.setLobbyCreator(ctx, req.LobbyID, req.CreatorID, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	// host role was passed by the creator concurrently
+	if !passed {
+		return lobby.ErrOnlyCreatorCanModerate
+	}
+
+	return nil
+}
+
+// checkModerationTarget returns an error if the user is not the creator of the lobby
+// or the target user is not another user connected to the lobby.
+func (uc Usecase) checkModerationTarget(ctx context.Context, lobbyID string, creatorID, targetID int) error {
+	l, err := uc.lobbyRepo.GetLobby(ctx, lobbyID)
+	if err != nil {
+		return fmt.Errorf("error getting lobby: %w", err)
+	}
+
+	if l.CreatorID != creatorID {
+		return lobby.ErrOnlyCreatorCanModerate
+	}
+
+	if targetID == creatorID {
+		return lobby.ErrWrongModerationTarget
+	}
+
+	players, err := uc.lobbyRepo.GetLobbyPlayers(ctx, lobbyID)
+	if err != nil {
+		return fmt.Errorf("error getting lobby players: %w", err)
+	}
+
+	if !slices.Contains(players, targetID) {
+		return lobby.ErrWrongModerationTarget
 	}
 
 	return nil
 }
 
+// SwitchLobbyTeam moves a user to another team of the lobby (called from the websocket).
+func (uc Usecase) SwitchLobbyTeam(ctx context.Context, req dto.SwitchLobbyTeamRequest) error {
+	ctx, span := uc.tracer.Start(ctx, "SwitchLobbyTeam")
+	defer span.End()
+
+	l, err := uc.lobbyRepo.GetLobby(ctx, req.LobbyID)
+	if err != nil {
+		return fmt.Errorf("error getting lobby: %w", err)
+	}
+
+	if !l.ValidTeam(req.Team) {
+		return lobby.ErrWrongTeam
+	}
+
+	if err := uc.lobbyRepo.SetLobbyUserTeam(ctx, req); err != nil {
+		return fmt.Errorf("error setting user team: %w", err)
+	}
+
+	return nil
+}
+
+// GetLobbyTeams returns teams picked by lobby users by their IDs (called from the websocket).
+func (uc Usecase) GetLobbyTeams(ctx context.Context, lobbyID string) (map[int]int, error) {
+	ctx, span := uc.tracer.Start(ctx, "GetLobbyTeams")
+	defer span.End()
+
+	teams, err := uc.lobbyRepo.GetLobbyTeams(ctx, lobbyID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting lobby teams: %w", err)
+	}
+
+	return teams, nil
+}
+
+// UpdateLobbySettings changes settings of a lobby by its creator and returns the updated lobby
+// (called from the websocket). Provider of a lobby with a user-created map can't be changed.
+// Players got ready for the old settings, so their ready states and the pending countdown are reset.
+func (uc Usecase) UpdateLobbySettings(
+	ctx context.Context,
+	req dto.UpdateLobbySettingsRequest,
+) (dto.UpdateLobbySettingsResponse, error) {
+	ctx, span := uc.tracer.Start(ctx, "UpdateLobbySettings")
+	defer span.End()
+
+	l, err := uc.lobbyRepo.GetLobby(ctx, req.LobbyID)
+	if err != nil {
+		return dto.UpdateLobbySettingsResponse{}, fmt.Errorf("error getting lobby: %w", err)
+	}
+
+	if l.CreatorID != req.UserID {
+		return dto.UpdateLobbySettingsResponse{}, lobby.ErrOnlyCreatorCanChangeSettings
+	}
+
+	settings := req.Settings
+
+	if l.MapID != 0 {
+		settings.Provider = l.Provider
+	}
+
+	if settings.Provider != string(game.MixedProvider) {
+		settings.Providers = nil
+	}
+
+	if !settings.Valid(uc.pano.ValidGameProvider) || settings.MaxPlayers < l.CurrentPlayers {
+		return dto.UpdateLobbySettingsResponse{}, lobby.ErrWrongSettings
+	}
+
+	if err := uc.lobbyRepo.UpdateLobbySettings(ctx, dto.UpdateLobbySettingsRequestDB{
+		ID:       req.LobbyID,
+		Settings: settings,
+	}); err != nil {
+		return dto.UpdateLobbySettingsResponse{}, fmt.Errorf("error updating lobby settings: %w", err)
+	}
+
+	cancelled, err := uc.CancelLobbyCountdown(ctx, req.LobbyID, "")
+	if err != nil {
+		return dto.UpdateLobbySettingsResponse{}, err
+	}
+
+	if err := uc.lobbyRepo.ResetLobbyReady(ctx, req.LobbyID); err != nil {
+		return dto.UpdateLobbySettingsResponse{}, fmt.Errorf("error resetting lobby ready users: %w", err)
+	}
+
+	l, err = uc.lobbyRepo.GetLobby(ctx, req.LobbyID)
+	if err != nil {
+		return dto.UpdateLobbySettingsResponse{}, fmt.Errorf("error getting updated lobby: %w", err)
+	}
+
+	return dto.UpdateLobbySettingsResponse{
+		Lobby:              l,
+		CountdownCancelled: cancelled,
+	}, nil
+}
+
+// SetLobbyUserReady changes the ready state of a lobby user and returns true if every connected player
+// is ready after it, so that the countdown to the game start can begin (called from the websocket).
+func (uc Usecase) SetLobbyUserReady(ctx context.Context, req dto.SetLobbyUserReadyRequest) (bool, error) {
+	ctx, span := uc.tracer.Start(ctx, "SetLobbyUserReady")
+	defer span.End()
+
+	if err := uc.lobbyRepo.SetLobbyUserReady(ctx, req); err != nil {
+		return false, fmt.Errorf("error setting user ready: %w", err)
+	}
+
+	if !req.Ready {
+		return false, nil
+	}
+
+	return uc.lobbyReady(ctx, req.LobbyID)
+}
+
+// LobbyReady returns true if every connected player of the lobby is ready
+// (called from the websocket, e.g. after a player who wasn't ready has left).
+func (uc Usecase) LobbyReady(ctx context.Context, lobbyID string) (bool, error) {
+	ctx, span := uc.tracer.Start(ctx, "LobbyReady")
+	defer span.End()
+
+	return uc.lobbyReady(ctx, lobbyID)
+}
+
+// GetLobbyReadyUsers returns IDs of lobby users who are ready to start the game (called from the websocket).
+func (uc Usecase) GetLobbyReadyUsers(ctx context.Context, lobbyID string) ([]int, error) {
+	ctx, span := uc.tracer.Start(ctx, "GetLobbyReadyUsers")
+	defer span.End()
+
+	ready, err := uc.lobbyRepo.GetLobbyReadyUsers(ctx, lobbyID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting lobby ready users: %w", err)
+	}
+
+	return ready, nil
+}
+
+// lobbyReady returns true if every connected player is ready, the countdown is only started
+// when there are at least two players.
+func (uc Usecase) lobbyReady(ctx context.Context, lobbyID string) (bool, error) {
+	players, err := uc.lobbyRepo.GetLobbyPlayers(ctx, lobbyID)
+	if err != nil {
+		return false, fmt.Errorf("error getting lobby players: %w", err)
+	}
+
+	ready, err := uc.lobbyRepo.GetLobbyReadyUsers(ctx, lobbyID)
+	if err != nil {
+		return false, fmt.Errorf("error getting lobby ready users: %w", err)
+	}
+
+	return len(players) > 1 && lobby.AllReady(players, ready), nil
+}
+
+// StartLobbyCountdown starts the countdown to the game start, which can be seen and cancelled from every instance
+// (called from the websocket after every player got ready). When the countdown runs out, start is called
+// on this instance with a token of the countdown, that is used to start the game. It returns false
+// if the countdown is already pending.
+func (uc Usecase) StartLobbyCountdown(
+	ctx context.Context,
+	lobbyID string,
+	start func(ctx context.Context, token string),
+) (bool, error) {
+	ctx, span := uc.tracer.Start(ctx, "StartLobbyCountdown")
+	defer span.End()
+
+	token := uc.rnd.NewRandomHexString(uc.conf.LobbyIDLength)
+
+	// the countdown is kept until the game start is claimed after it runs out
+	ttl := uc.conf.ReadyCountdown + uc.conf.GameStartTimeout
+
+	started, err := uc.lobbyRepo.SetLobbyCountdown(ctx, lobbyID, token, ttl)
+	if err != nil {
+		return false, fmt.Errorf("error starting lobby countdown: %w", err)
+	}
+
+	if !started {
+		return false, nil
+	}
+
+	uc.countdowns.schedule(lobbyID, uc.conf.ReadyCountdown, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), uc.conf.GameStartTimeout)
+		defer cancel()
+
+		start(ctx, token)
+	})
+
+	return true, nil
+}
+
+// CancelLobbyCountdown cancels the pending countdown with the given token, or any countdown if the token is empty
+// (called from the websocket). It returns true if the countdown was cancelled. Timer of a countdown
+// started on another instance still fires there, but the game isn't started by the cancelled token.
+func (uc Usecase) CancelLobbyCountdown(ctx context.Context, lobbyID, token string) (bool, error) {
+	ctx, span := uc.tracer.Start(ctx, "CancelLobbyCountdown")
+	defer span.End()
+
+	cancelled, err := uc.lobbyRepo.DeleteLobbyCountdown(ctx, lobbyID, token)
+	if err != nil {
+		return false, fmt.Errorf("error cancelling lobby countdown: %w", err)
+	}
+
+	if cancelled {
+		uc.countdowns.cancel(lobbyID)
+	}
+
+	return cancelled, nil
+}
+
 // StartLobbyGame initiates a multiplayer game from a lobby (called from the websocket).
+// Every connected player must be ready, if the lobby requires it or the game is started by the countdown.
+// The game is started only once, even if it is started from several instances at the same time.
 func (uc Usecase) StartLobbyGame(
 	ctx context.Context,
 	req dto.StartLobbyGameRequest,
@@ -88,14 +473,85 @@ func (uc Usecase) StartLobbyGame(
 		return 0, lobby.ErrOnlyCreatorCanStart
 	}
 
+	claimed, err := uc.lobbyRepo.ClaimLobbyGameStart(ctx, req.LobbyID, req.CountdownToken, uc.conf.GameStartTimeout)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim lobby game start: %w", err)
+	}
+
+	switch {
+	case !claimed && req.CountdownToken != "":
+		return 0, lobby.ErrCountdownCancelled
+	case !claimed:
+		return 0, lobby.ErrGameStarting
+	}
+
+	gameID, err := uc.startLobbyGame(ctx, req, lobbyRepo)
+	if err != nil {
+		if releaseErr := uc.lobbyRepo.ReleaseLobbyGameStart(ctx, req.LobbyID); releaseErr != nil {
+			return 0, fmt.Errorf("failed to release lobby game start: %w (after: %w)", releaseErr, err)
+		}
+
+		return 0, err
+	}
+
+	return gameID, nil
+}
+
+// startLobbyGame creates a multiplayer game with settings of the lobby and deletes the lobby.
+func (uc Usecase) startLobbyGame(
+	ctx context.Context,
+	req dto.StartLobbyGameRequest,
+	l lobby.Lobby,
+) (int, error) {
+	userIDs := make([]int, 0, len(req.ConnectedPlayers))
+	for _, p := range req.ConnectedPlayers {
+		userIDs = append(userIDs, p.ID)
+	}
+
+	if l.RequireReady || req.CountdownToken != "" {
+		ready, err := uc.lobbyRepo.GetLobbyReadyUsers(ctx, req.LobbyID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get lobby ready users: %w", err)
+		}
+
+		if !lobby.AllReady(userIDs, ready) {
+			return 0, lobby.ErrPlayersNotReady
+		}
+	}
+
+	var providers []game.PanoramaProvider
+	for _, p := range l.Providers {
+		providers = append(providers, game.PanoramaProvider(p))
+	}
+
+	var teams map[int]int
+
+	if l.Teams != 0 {
+		picked, err := uc.lobbyRepo.GetLobbyTeams(ctx, req.LobbyID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get lobby teams: %w", err)
+		}
+
+		teams = l.AssignTeams(userIDs, picked)
+	}
+
 	gameID, err := uc.mult.NewGame(ctx, dto.NewMultiplayerGameRequest{
-		RequestTime:      req.RequestTime,
-		CreatorID:        req.Creator.ID,
-		ConnectedPlayers: req.ConnectedPlayers,
-		Rounds:           lobbyRepo.Rounds,
-		TimerSeconds:     lobbyRepo.TimerSeconds,
-		MovementAllowed:  lobbyRepo.MovementAllowed,
-		Provider:         lobbyRepo.Provider,
+		RequestTime:           req.RequestTime,
+		CreatorID:             req.Creator.ID,
+		ConnectedPlayers:      req.ConnectedPlayers,
+		Rounds:                l.Rounds,
+		TimerSeconds:          l.TimerSeconds,
+		MovementAllowed:       l.MovementAllowed,
+		Provider:              l.Provider,
+		Providers:             providers,
+		MapID:                 l.MapID,
+		ScoreDistance:         l.ScoreDistance,
+		Scoring:               game.ScoringMode(l.Scoring),
+		Mode:                  multiplayer.Mode(l.Mode),
+		Teams:                 teams,
+		TeamScoring:           multiplayer.TeamScoring(l.TeamScoring),
+		Spectators:            l.Spectators,
+		SpectatorDelaySeconds: l.SpectatorDelaySeconds,
 	})
 	if err != nil {
 		return 0, fmt.Errorf("error starting game: %w", err)
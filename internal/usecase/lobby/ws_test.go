@@ -1,10 +1,13 @@
 package lobby_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
 	lobbyEntity "github.com/VasySS/segoya-backend/internal/entity/lobby"
 	"github.com/VasySS/segoya-backend/internal/entity/user"
 	"github.com/VasySS/segoya-backend/internal/usecase/lobby"
@@ -16,14 +19,31 @@ import (
 func TestUsecase_LobbyUserConnect(t *testing.T) {
 	t.Parallel()
 
+	connectReq := dto.ConnectLobbyUserRequest{
+		LobbyID: "1234567890",
+		UserID:  1,
+	}
+
 	type fields struct {
+		hasher    *mocks.PasswordHasher
 		lobbyRepo *mocks.Repository
 		userRepo  *mocks.UserRepository
 	}
 
 	type args struct {
-		lobbyID string
-		userID  int
+		req dto.ConnectLobbyUserRequest
+	}
+
+	// connected expects the user to be connected to the lobby after all checks
+	connected := func(fs fields, args args) {
+		fs.lobbyRepo.On("IncrementLobbyPlayers", mock.Anything, args.req.LobbyID).
+			Return(nil)
+		fs.lobbyRepo.On("AddLobbyPlayer", mock.Anything, args.req.LobbyID, args.req.UserID).
+			Return(nil)
+		fs.lobbyRepo.On("DeleteLobbyExpiration", mock.Anything, args.req.LobbyID).
+			Return(nil)
+		fs.userRepo.On("GetUserByID", mock.Anything, args.req.UserID).
+			Return(user.PrivateProfile{PublicProfile: user.PublicProfile{ID: args.req.UserID}}, nil)
 	}
 
 	tests := []struct {
@@ -36,22 +56,19 @@ func TestUsecase_LobbyUserConnect(t *testing.T) {
 		{
 			name: "successfully connect user to lobby",
 			args: args{
-				lobbyID: "1234567890",
-				userID:  1,
+				req: connectReq,
 			},
 			setup: func(fs fields, args args) {
-				fs.lobbyRepo.On("GetLobby", mock.Anything, args.lobbyID).
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
 					Return(lobbyEntity.Lobby{
-						ID:             args.lobbyID,
+						ID:             args.req.LobbyID,
 						CurrentPlayers: 4,
 						MaxPlayers:     5,
+						Visibility:     lobbyEntity.PublicVisibility,
 					}, nil)
-				fs.lobbyRepo.On("IncrementLobbyPlayers", mock.Anything, args.lobbyID).
-					Return(nil)
-				fs.lobbyRepo.On("DeleteLobbyExpiration", mock.Anything, args.lobbyID).
-					Return(nil)
-				fs.userRepo.On("GetUserByID", mock.Anything, args.userID).
-					Return(user.PrivateProfile{PublicProfile: user.PublicProfile{ID: args.userID}}, nil)
+				fs.lobbyRepo.On("IsLobbyUserBanned", mock.Anything, args.req.LobbyID, args.req.UserID).
+					Return(false, nil)
+				connected(fs, args)
 			},
 			want:    user.PublicProfile{ID: 1},
 			wantErr: assert.NoError,
@@ -59,71 +76,315 @@ func TestUsecase_LobbyUserConnect(t *testing.T) {
 		{
 			name: "trying to connect to full lobby",
 			args: args{
-				lobbyID: "1234567890",
-				userID:  1,
+				req: connectReq,
 			},
 			setup: func(fs fields, args args) {
-				fs.lobbyRepo.On("GetLobby", mock.Anything, args.lobbyID).
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
 					Return(lobbyEntity.Lobby{
-						ID:             args.lobbyID,
+						ID:             args.req.LobbyID,
 						CurrentPlayers: 5,
 						MaxPlayers:     5,
 					}, nil)
+				fs.lobbyRepo.On("IsLobbyUserBanned", mock.Anything, args.req.LobbyID, args.req.UserID).
+					Return(false, nil)
 			},
 			want:    user.PublicProfile{},
 			wantErr: assert.Error,
 		},
+		{
+			name: "banned user can't connect to lobby",
+			args: args{
+				req: connectReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{
+						ID:             args.req.LobbyID,
+						CurrentPlayers: 1,
+						MaxPlayers:     5,
+					}, nil)
+				fs.lobbyRepo.On("IsLobbyUserBanned", mock.Anything, args.req.LobbyID, args.req.UserID).
+					Return(true, nil)
+			},
+			want: user.PublicProfile{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrUserIsBanned)
+			},
+		},
+		{
+			name: "connect to password-protected lobby",
+			args: args{
+				req: dto.ConnectLobbyUserRequest{
+					LobbyID:  connectReq.LobbyID,
+					UserID:   connectReq.UserID,
+					Password: "secret",
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{
+						ID:             args.req.LobbyID,
+						CreatorID:      2,
+						CurrentPlayers: 1,
+						MaxPlayers:     5,
+						Visibility:     lobbyEntity.PasswordVisibility,
+						PasswordHash:   "hash",
+					}, nil)
+				fs.lobbyRepo.On("IsLobbyUserBanned", mock.Anything, args.req.LobbyID, args.req.UserID).
+					Return(false, nil)
+				fs.hasher.On("CompareHashAndPassword", "hash", args.req.Password).
+					Return(nil)
+				connected(fs, args)
+			},
+			want:    user.PublicProfile{ID: 1},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "wrong password of lobby",
+			args: args{
+				req: dto.ConnectLobbyUserRequest{
+					LobbyID:  connectReq.LobbyID,
+					UserID:   connectReq.UserID,
+					Password: "wrong",
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{
+						ID:             args.req.LobbyID,
+						CreatorID:      2,
+						CurrentPlayers: 1,
+						MaxPlayers:     5,
+						Visibility:     lobbyEntity.PasswordVisibility,
+						PasswordHash:   "hash",
+					}, nil)
+				fs.lobbyRepo.On("IsLobbyUserBanned", mock.Anything, args.req.LobbyID, args.req.UserID).
+					Return(false, nil)
+				fs.hasher.On("CompareHashAndPassword", "hash", args.req.Password).
+					Return(errors.New("mismatch"))
+			},
+			want: user.PublicProfile{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrWrongPassword)
+			},
+		},
+		{
+			name: "creator connects to password-protected lobby without password",
+			args: args{
+				req: connectReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{
+						ID:           args.req.LobbyID,
+						CreatorID:    args.req.UserID,
+						MaxPlayers:   5,
+						Visibility:   lobbyEntity.PasswordVisibility,
+						PasswordHash: "hash",
+					}, nil)
+				fs.lobbyRepo.On("IsLobbyUserBanned", mock.Anything, args.req.LobbyID, args.req.UserID).
+					Return(false, nil)
+				connected(fs, args)
+			},
+			want:    user.PublicProfile{ID: 1},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "invited user connects to invite-only lobby",
+			args: args{
+				req: connectReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{
+						ID:             args.req.LobbyID,
+						CreatorID:      2,
+						CurrentPlayers: 1,
+						MaxPlayers:     5,
+						Visibility:     lobbyEntity.InviteOnlyVisibility,
+						InvitedUserIDs: []int{3, args.req.UserID},
+					}, nil)
+				fs.lobbyRepo.On("IsLobbyUserBanned", mock.Anything, args.req.LobbyID, args.req.UserID).
+					Return(false, nil)
+				connected(fs, args)
+			},
+			want:    user.PublicProfile{ID: 1},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "user is not invited to lobby",
+			args: args{
+				req: connectReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{
+						ID:             args.req.LobbyID,
+						CreatorID:      2,
+						CurrentPlayers: 1,
+						MaxPlayers:     5,
+						Visibility:     lobbyEntity.InviteOnlyVisibility,
+						InvitedUserIDs: []int{3},
+					}, nil)
+				fs.lobbyRepo.On("IsLobbyUserBanned", mock.Anything, args.req.LobbyID, args.req.UserID).
+					Return(false, nil)
+			},
+			want: user.PublicProfile{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrNotInvited)
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
+			hasher := mocks.NewPasswordHasher(t)
 			lobbyRepo := mocks.NewRepository(t)
 			userRepo := mocks.NewUserRepository(t)
 			fs := fields{
+				hasher:    hasher,
 				lobbyRepo: lobbyRepo,
 				userRepo:  userRepo,
 			}
 			tt.setup(fs, tt.args)
 
-			uc := lobby.NewUsecase(lobby.Config{}, nil, userRepo, lobbyRepo, nil)
+			uc := lobby.NewUsecase(lobby.Config{}, nil, hasher, userRepo, lobbyRepo, nil, nil, nil)
 
-			got, err := uc.ConnectLobbyUser(t.Context(), tt.args.lobbyID, tt.args.userID)
+			got, err := uc.ConnectLobbyUser(t.Context(), tt.args.req)
 			tt.wantErr(t, err)
 			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
+func TestUsecase_CheckLobbyAccess(t *testing.T) {
+	t.Parallel()
+
+	accessReq := dto.ConnectLobbyUserRequest{
+		LobbyID:  "1234567890",
+		UserID:   1,
+		Password: "secret",
+	}
+
+	type fields struct {
+		hasher    *mocks.PasswordHasher
+		lobbyRepo *mocks.Repository
+	}
+
+	type args struct {
+		req dto.ConnectLobbyUserRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "user can join unlisted lobby",
+			args: args{
+				req: accessReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{ID: args.req.LobbyID, Visibility: lobbyEntity.UnlistedVisibility}, nil)
+				fs.lobbyRepo.On("IsLobbyUserBanned", mock.Anything, args.req.LobbyID, args.req.UserID).
+					Return(false, nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "wrong password of lobby",
+			args: args{
+				req: accessReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{
+						ID:           args.req.LobbyID,
+						CreatorID:    2,
+						Visibility:   lobbyEntity.PasswordVisibility,
+						PasswordHash: "hash",
+					}, nil)
+				fs.lobbyRepo.On("IsLobbyUserBanned", mock.Anything, args.req.LobbyID, args.req.UserID).
+					Return(false, nil)
+				fs.hasher.On("CompareHashAndPassword", "hash", args.req.Password).
+					Return(errors.New("mismatch"))
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrWrongPassword)
+			},
+		},
+		{
+			name: "lobby not found",
+			args: args{
+				req: accessReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{}, lobbyEntity.ErrNotFound)
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			hasher := mocks.NewPasswordHasher(t)
+			lobbyRepo := mocks.NewRepository(t)
+			fs := fields{
+				hasher:    hasher,
+				lobbyRepo: lobbyRepo,
+			}
+			tt.setup(fs, tt.args)
+
+			uc := lobby.NewUsecase(lobby.Config{}, nil, hasher, nil, lobbyRepo, nil, nil, nil)
+
+			err := uc.CheckLobbyAccess(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
 func TestUsecase_LobbyUserDisconnect(t *testing.T) {
 	t.Parallel()
 
 	type fields struct {
 		conf      lobby.Config
+		userRepo  *mocks.UserRepository
 		lobbyRepo *mocks.Repository
 	}
 
 	type args struct {
 		lobbyID string
-		_       int
+		userID  int
 	}
 
 	tests := []struct {
 		name    string
 		args    args
 		setup   func(fields, args)
+		want    int
 		wantErr assert.ErrorAssertionFunc
 	}{
 		{
 			name: "successfully disconnect user from lobby (last user)",
 			args: args{
 				lobbyID: "1234567890",
+				userID:  2,
 			},
 			setup: func(fs fields, args args) {
 				fs.lobbyRepo.On("GetLobby", mock.Anything, args.lobbyID).
 					Return(lobbyEntity.Lobby{
 						ID:             args.lobbyID,
+						CreatorID:      1,
 						CurrentPlayers: 1,
 						MaxPlayers:     5,
 					}, nil)
@@ -133,25 +394,99 @@ func TestUsecase_LobbyUserDisconnect(t *testing.T) {
 
 				fs.lobbyRepo.On("DecrementLobbyPlayers", mock.Anything, args.lobbyID).
 					Return(nil)
+
+				fs.lobbyRepo.On("RemoveLobbyPlayer", mock.Anything, args.lobbyID, args.userID).
+					Return(nil)
 			},
+			want:    0,
 			wantErr: assert.NoError,
 		},
 		{
 			name: "successfully disconnect user from lobby (not last user)",
 			args: args{
 				lobbyID: "1234567890",
+				userID:  2,
 			},
 			setup: func(fs fields, args args) {
 				fs.lobbyRepo.On("GetLobby", mock.Anything, args.lobbyID).
 					Return(lobbyEntity.Lobby{
 						ID:             args.lobbyID,
+						CreatorID:      1,
 						CurrentPlayers: 2,
 						MaxPlayers:     5,
 					}, nil)
 
 				fs.lobbyRepo.On("DecrementLobbyPlayers", mock.Anything, args.lobbyID).
 					Return(nil)
+
+				fs.lobbyRepo.On("RemoveLobbyPlayer", mock.Anything, args.lobbyID, args.userID).
+					Return(nil)
+			},
+			want:    0,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "host role passes to longest-connected user",
+			args: args{
+				lobbyID: "1234567890",
+				userID:  1,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.lobbyID).
+					Return(lobbyEntity.Lobby{
+						ID:             args.lobbyID,
+						CreatorID:      args.userID,
+						CurrentPlayers: 3,
+						MaxPlayers:     5,
+					}, nil)
+
+				fs.lobbyRepo.On("DecrementLobbyPlayers", mock.Anything, args.lobbyID).
+					Return(nil)
+
+				fs.lobbyRepo.On("RemoveLobbyPlayer", mock.Anything, args.lobbyID, args.userID).
+					Return(nil)
+
+				fs.lobbyRepo.On("GetLobbyPlayers", mock.Anything, args.lobbyID).
+					Return([]int{3, 2}, nil)
+
+				fs.userRepo.On("GetUserByID", mock.Anything, 3).
+					Return(user.PrivateProfile{PublicProfile: user.PublicProfile{ID: 3, Username: "NewHost"}}, nil)
+
+				fs.lobbyRepo.On("SetLobbyCreator", mock.Anything, dto.SetLobbyCreatorRequestDB{
+					ID:              args.lobbyID,
+					OldCreatorID:    args.userID,
+					CreatorID:       3,
+					CreatorUsername: "NewHost",
+				}).Return(true, nil)
+			},
+			want:    3,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "host is still connected from another session",
+			args: args{
+				lobbyID: "1234567890",
+				userID:  1,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.lobbyID).
+					Return(lobbyEntity.Lobby{
+						ID:             args.lobbyID,
+						CreatorID:      args.userID,
+						CurrentPlayers: 3,
+						MaxPlayers:     5,
+					}, nil)
+
+				fs.lobbyRepo.On("DecrementLobbyPlayers", mock.Anything, args.lobbyID).
+					Return(nil)
+
+				fs.lobbyRepo.On("RemoveLobbyPlayer", mock.Anything, args.lobbyID, args.userID).
+					Return(nil)
+
+				fs.lobbyRepo.On("GetLobbyPlayers", mock.Anything, args.lobbyID).
+					Return([]int{2, args.userID}, nil)
 			},
+			want:    0,
 			wantErr: assert.NoError,
 		},
 	}
@@ -160,20 +495,23 @@ func TestUsecase_LobbyUserDisconnect(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
+			userRepo := mocks.NewUserRepository(t)
 			lobbyRepo := mocks.NewRepository(t)
 			conf := lobby.Config{
 				LobbyExpiration: 3 * time.Minute,
 			}
 			fs := fields{
 				conf:      conf,
+				userRepo:  userRepo,
 				lobbyRepo: lobbyRepo,
 			}
 			tt.setup(fs, tt.args)
 
-			uc := lobby.NewUsecase(conf, nil, nil, lobbyRepo, nil)
+			uc := lobby.NewUsecase(conf, nil, nil, userRepo, lobbyRepo, nil, nil, nil)
 
-			err := uc.DisconnectLobbyUser(t.Context(), tt.args.lobbyID, 0)
+			got, err := uc.DisconnectLobbyUser(t.Context(), tt.args.lobbyID, tt.args.userID)
 			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
@@ -198,6 +536,7 @@ func TestUsecase_LobbyGameStart(t *testing.T) {
 	}
 
 	type fields struct {
+		conf      lobby.Config
 		lobbyRepo *mocks.Repository
 		mult      *mocks.MultiplayerUsecase
 	}
@@ -231,6 +570,9 @@ func TestUsecase_LobbyGameStart(t *testing.T) {
 						Provider:        "google",
 					}, nil)
 
+				fs.lobbyRepo.On("ClaimLobbyGameStart", mock.Anything, args.req.LobbyID, args.req.CountdownToken,
+					fs.conf.GameStartTimeout).Return(true, nil)
+
 				fs.mult.On("NewGame", mock.Anything, dto.NewMultiplayerGameRequest{
 					RequestTime:      args.req.RequestTime,
 					CreatorID:        args.req.Creator.ID,
@@ -247,6 +589,62 @@ func TestUsecase_LobbyGameStart(t *testing.T) {
 			want:    1,
 			wantErr: assert.NoError,
 		},
+		{
+			name: "players who haven't picked a team are assigned to the smallest teams",
+			args: args{
+				req: dto.StartLobbyGameRequest{
+					RequestTime: startLobbyReq.RequestTime,
+					LobbyID:     startLobbyReq.LobbyID,
+					Creator:     startLobbyReq.Creator,
+					ConnectedPlayers: []user.PublicProfile{
+						{ID: 1}, {ID: 3}, {ID: 4}, {ID: 5},
+					},
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{
+						ID:                    args.req.LobbyID,
+						CreatorID:             args.req.Creator.ID,
+						CurrentPlayers:        4,
+						MaxPlayers:            5,
+						Rounds:                10,
+						TimerSeconds:          60,
+						MovementAllowed:       true,
+						Provider:              "google",
+						Teams:                 2,
+						TeamScoring:           "average",
+						Spectators:            true,
+						SpectatorDelaySeconds: 15,
+					}, nil)
+
+				fs.lobbyRepo.On("ClaimLobbyGameStart", mock.Anything, args.req.LobbyID, args.req.CountdownToken,
+					fs.conf.GameStartTimeout).Return(true, nil)
+
+				// user 2 has left the lobby after picking a team
+				fs.lobbyRepo.On("GetLobbyTeams", mock.Anything, args.req.LobbyID).
+					Return(map[int]int{1: 1, 2: 2, 3: 1}, nil)
+
+				fs.mult.On("NewGame", mock.Anything, dto.NewMultiplayerGameRequest{
+					RequestTime:           args.req.RequestTime,
+					CreatorID:             args.req.Creator.ID,
+					ConnectedPlayers:      args.req.ConnectedPlayers,
+					Rounds:                10,
+					TimerSeconds:          60,
+					Provider:              "google",
+					MovementAllowed:       true,
+					Teams:                 map[int]int{1: 1, 3: 1, 4: 2, 5: 2},
+					TeamScoring:           "average",
+					Spectators:            true,
+					SpectatorDelaySeconds: 15,
+				}).Return(1, nil)
+
+				fs.lobbyRepo.On("DeleteLobby", mock.Anything, args.req.LobbyID).
+					Return(nil)
+			},
+			want:    1,
+			wantErr: assert.NoError,
+		},
 		{
 			name: "trying to start game as not creator",
 			args: args{
@@ -268,25 +666,1160 @@ func TestUsecase_LobbyGameStart(t *testing.T) {
 			want:    0,
 			wantErr: assert.Error,
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
+		{
+			name: "successfully start game when every player is ready",
+			args: args{
+				req: startLobbyReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{
+						ID:             args.req.LobbyID,
+						CreatorID:      args.req.Creator.ID,
+						CurrentPlayers: 2,
+						MaxPlayers:     5,
+						Rounds:         10,
+						Provider:       "google",
+						RequireReady:   true,
+					}, nil)
 
-			lobbyRepo := mocks.NewRepository(t)
-			mult := mocks.NewMultiplayerUsecase(t)
-			fs := fields{
-				lobbyRepo: lobbyRepo,
-				mult:      mult,
-			}
-			tt.setup(fs, tt.args)
+				fs.lobbyRepo.On("ClaimLobbyGameStart", mock.Anything, args.req.LobbyID, args.req.CountdownToken,
+					fs.conf.GameStartTimeout).Return(true, nil)
 
-			uc := lobby.NewUsecase(lobby.Config{}, nil, nil, lobbyRepo, mult)
+				// user 2 is ready, but has left the lobby
+				fs.lobbyRepo.On("GetLobbyReadyUsers", mock.Anything, args.req.LobbyID).
+					Return([]int{1, 2, 3}, nil)
 
-			gameID, err := uc.StartLobbyGame(t.Context(), tt.args.req)
-			tt.wantErr(t, err)
-			assert.Equal(t, tt.want, gameID)
+				fs.mult.On("NewGame", mock.Anything, dto.NewMultiplayerGameRequest{
+					RequestTime:      args.req.RequestTime,
+					CreatorID:        args.req.Creator.ID,
+					ConnectedPlayers: args.req.ConnectedPlayers,
+					Rounds:           10,
+					Provider:         "google",
+				}).Return(1, nil)
+
+				fs.lobbyRepo.On("DeleteLobby", mock.Anything, args.req.LobbyID).
+					Return(nil)
+			},
+			want:    1,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "lobby requires every player to be ready",
+			args: args{
+				req: startLobbyReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{
+						ID:             args.req.LobbyID,
+						CreatorID:      args.req.Creator.ID,
+						CurrentPlayers: 2,
+						MaxPlayers:     5,
+						Rounds:         10,
+						Provider:       "google",
+						RequireReady:   true,
+					}, nil)
+
+				fs.lobbyRepo.On("ClaimLobbyGameStart", mock.Anything, args.req.LobbyID, args.req.CountdownToken,
+					fs.conf.GameStartTimeout).Return(true, nil)
+
+				fs.lobbyRepo.On("GetLobbyReadyUsers", mock.Anything, args.req.LobbyID).
+					Return([]int{1}, nil)
+
+				fs.lobbyRepo.On("ReleaseLobbyGameStart", mock.Anything, args.req.LobbyID).
+					Return(nil)
+			},
+			want: 0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrPlayersNotReady)
+			},
+		},
+		{
+			name: "player got unready during countdown",
+			args: args{
+				req: dto.StartLobbyGameRequest{
+					RequestTime:      startLobbyReq.RequestTime,
+					LobbyID:          startLobbyReq.LobbyID,
+					Creator:          startLobbyReq.Creator,
+					ConnectedPlayers: startLobbyReq.ConnectedPlayers,
+					CountdownToken:   "countdown-token",
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{
+						ID:             args.req.LobbyID,
+						CreatorID:      args.req.Creator.ID,
+						CurrentPlayers: 2,
+						MaxPlayers:     5,
+						Rounds:         10,
+						Provider:       "google",
+					}, nil)
+
+				fs.lobbyRepo.On("ClaimLobbyGameStart", mock.Anything, args.req.LobbyID, args.req.CountdownToken,
+					fs.conf.GameStartTimeout).Return(true, nil)
+
+				fs.lobbyRepo.On("GetLobbyReadyUsers", mock.Anything, args.req.LobbyID).
+					Return([]int{3}, nil)
+
+				fs.lobbyRepo.On("ReleaseLobbyGameStart", mock.Anything, args.req.LobbyID).
+					Return(nil)
+			},
+			want: 0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrPlayersNotReady)
+			},
+		},
+		{
+			name: "countdown was cancelled before it ran out",
+			args: args{
+				req: dto.StartLobbyGameRequest{
+					RequestTime:      startLobbyReq.RequestTime,
+					LobbyID:          startLobbyReq.LobbyID,
+					Creator:          startLobbyReq.Creator,
+					ConnectedPlayers: startLobbyReq.ConnectedPlayers,
+					CountdownToken:   "countdown-token",
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{
+						ID:        args.req.LobbyID,
+						CreatorID: args.req.Creator.ID,
+					}, nil)
+
+				fs.lobbyRepo.On("ClaimLobbyGameStart", mock.Anything, args.req.LobbyID, args.req.CountdownToken,
+					fs.conf.GameStartTimeout).Return(false, nil)
+			},
+			want: 0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrCountdownCancelled)
+			},
+		},
+		{
+			name: "game is already being started",
+			args: args{
+				req: startLobbyReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{
+						ID:        args.req.LobbyID,
+						CreatorID: args.req.Creator.ID,
+					}, nil)
+
+				fs.lobbyRepo.On("ClaimLobbyGameStart", mock.Anything, args.req.LobbyID, args.req.CountdownToken,
+					fs.conf.GameStartTimeout).Return(false, nil)
+			},
+			want: 0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrGameStarting)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			lobbyRepo := mocks.NewRepository(t)
+			mult := mocks.NewMultiplayerUsecase(t)
+			fs := fields{
+				conf:      lobby.Config{GameStartTimeout: 10 * time.Second},
+				lobbyRepo: lobbyRepo,
+				mult:      mult,
+			}
+			tt.setup(fs, tt.args)
+
+			uc := lobby.NewUsecase(fs.conf, nil, nil, nil, lobbyRepo, mult, nil, nil)
+
+			gameID, err := uc.StartLobbyGame(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, gameID)
+		})
+	}
+}
+
+func TestUsecase_SwitchLobbyTeam(t *testing.T) {
+	t.Parallel()
+
+	switchTeamReq := dto.SwitchLobbyTeamRequest{
+		LobbyID: "1234567890",
+		UserID:  1,
+		Team:    2,
+	}
+
+	type fields struct {
+		lobbyRepo *mocks.Repository
+	}
+
+	type args struct {
+		req dto.SwitchLobbyTeamRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "successfully switch team",
+			args: args{
+				req: switchTeamReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{ID: args.req.LobbyID, Teams: 2}, nil)
+
+				fs.lobbyRepo.On("SetLobbyUserTeam", mock.Anything, args.req).
+					Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "team doesn't exist in lobby",
+			args: args{
+				req: dto.SwitchLobbyTeamRequest{
+					LobbyID: switchTeamReq.LobbyID,
+					UserID:  switchTeamReq.UserID,
+					Team:    3,
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{ID: args.req.LobbyID, Teams: 2}, nil)
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrWrongTeam)
+			},
+		},
+		{
+			name: "lobby without teams",
+			args: args{
+				req: switchTeamReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{ID: args.req.LobbyID}, nil)
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrWrongTeam)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			lobbyRepo := mocks.NewRepository(t)
+			fs := fields{
+				lobbyRepo: lobbyRepo,
+			}
+			tt.setup(fs, tt.args)
+
+			uc := lobby.NewUsecase(lobby.Config{}, nil, nil, nil, lobbyRepo, nil, nil, nil)
+
+			err := uc.SwitchLobbyTeam(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestUsecase_UpdateLobbySettings(t *testing.T) {
+	t.Parallel()
+
+	settings := lobbyEntity.Settings{
+		Rounds:          3,
+		Provider:        "google",
+		TimerSeconds:    60,
+		MovementAllowed: true,
+		MaxPlayers:      4,
+	}
+
+	updateReq := dto.UpdateLobbySettingsRequest{
+		LobbyID:  "1234567890",
+		UserID:   1,
+		Settings: settings,
+	}
+
+	lobbyResponse := lobbyEntity.Lobby{
+		ID:             updateReq.LobbyID,
+		CreatorID:      1,
+		Rounds:         5,
+		Provider:       "yandex",
+		TimerSeconds:   0,
+		CurrentPlayers: 3,
+		MaxPlayers:     5,
+	}
+
+	type fields struct {
+		lobbyRepo *mocks.Repository
+		pano      *mocks.PanoramaUsecase
+	}
+
+	type args struct {
+		req dto.UpdateLobbySettingsRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		want    dto.UpdateLobbySettingsResponse
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "successfully update settings",
+			args: args{
+				req: updateReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.pano.On("ValidGameProvider", game.GoogleProvider).Return(true)
+
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyResponse, nil).Once()
+
+				fs.lobbyRepo.On("UpdateLobbySettings", mock.Anything, dto.UpdateLobbySettingsRequestDB{
+					ID:       args.req.LobbyID,
+					Settings: settings,
+				}).Return(nil)
+
+				fs.lobbyRepo.On("DeleteLobbyCountdown", mock.Anything, args.req.LobbyID, "").
+					Return(true, nil)
+
+				fs.lobbyRepo.On("ResetLobbyReady", mock.Anything, args.req.LobbyID).
+					Return(nil)
+
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyEntity.Lobby{ID: args.req.LobbyID, CreatorID: 1, Rounds: 3}, nil).Once()
+			},
+			want: dto.UpdateLobbySettingsResponse{
+				Lobby:              lobbyEntity.Lobby{ID: updateReq.LobbyID, CreatorID: 1, Rounds: 3},
+				CountdownCancelled: true,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error resetting ready users",
+			args: args{
+				req: updateReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.pano.On("ValidGameProvider", game.GoogleProvider).Return(true)
+
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyResponse, nil).Once()
+
+				fs.lobbyRepo.On("UpdateLobbySettings", mock.Anything, dto.UpdateLobbySettingsRequestDB{
+					ID:       args.req.LobbyID,
+					Settings: settings,
+				}).Return(nil)
+
+				fs.lobbyRepo.On("DeleteLobbyCountdown", mock.Anything, args.req.LobbyID, "").
+					Return(false, nil)
+
+				fs.lobbyRepo.On("ResetLobbyReady", mock.Anything, args.req.LobbyID).
+					Return(errors.New("some error"))
+			},
+			want:    dto.UpdateLobbySettingsResponse{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "provider of lobby with map is not changed",
+			args: args{
+				req: dto.UpdateLobbySettingsRequest{
+					LobbyID: updateReq.LobbyID,
+					UserID:  updateReq.UserID,
+					Settings: lobbyEntity.Settings{
+						Rounds:     3,
+						Provider:   "mixed",
+						Providers:  []string{"google", "yandex"},
+						MaxPlayers: 4,
+					},
+				},
+			},
+			setup: func(fs fields, args args) {
+				mapLobby := lobbyResponse
+				mapLobby.MapID = 7
+
+				fs.pano.On("ValidGameProvider", game.YandexProvider).Return(true)
+
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(mapLobby, nil).Once()
+
+				fs.lobbyRepo.On("UpdateLobbySettings", mock.Anything, dto.UpdateLobbySettingsRequestDB{
+					ID: args.req.LobbyID,
+					Settings: lobbyEntity.Settings{
+						Rounds:     3,
+						Provider:   "yandex",
+						MaxPlayers: 4,
+					},
+				}).Return(nil)
+
+				fs.lobbyRepo.On("DeleteLobbyCountdown", mock.Anything, args.req.LobbyID, "").
+					Return(false, nil)
+
+				fs.lobbyRepo.On("ResetLobbyReady", mock.Anything, args.req.LobbyID).
+					Return(nil)
+
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(mapLobby, nil).Once()
+			},
+			want: dto.UpdateLobbySettingsResponse{
+				Lobby: lobbyEntity.Lobby{
+					ID:             updateReq.LobbyID,
+					CreatorID:      1,
+					Rounds:         5,
+					Provider:       "yandex",
+					CurrentPlayers: 3,
+					MaxPlayers:     5,
+					MapID:          7,
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "user is not lobby creator",
+			args: args{
+				req: dto.UpdateLobbySettingsRequest{
+					LobbyID:  updateReq.LobbyID,
+					UserID:   2,
+					Settings: settings,
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyResponse, nil)
+			},
+			want: dto.UpdateLobbySettingsResponse{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrOnlyCreatorCanChangeSettings)
+			},
+		},
+		{
+			name: "settings are out of bounds",
+			args: args{
+				req: dto.UpdateLobbySettingsRequest{
+					LobbyID: updateReq.LobbyID,
+					UserID:  updateReq.UserID,
+					Settings: lobbyEntity.Settings{
+						Rounds:       100,
+						Provider:     "google",
+						TimerSeconds: 5,
+						MaxPlayers:   4,
+					},
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyResponse, nil)
+			},
+			want: dto.UpdateLobbySettingsResponse{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrWrongSettings)
+			},
+		},
+		{
+			name: "unknown provider",
+			args: args{
+				req: dto.UpdateLobbySettingsRequest{
+					LobbyID: updateReq.LobbyID,
+					UserID:  updateReq.UserID,
+					Settings: lobbyEntity.Settings{
+						Rounds:     3,
+						Provider:   "unknown",
+						MaxPlayers: 4,
+					},
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.pano.On("ValidGameProvider", game.PanoramaProvider("unknown")).Return(false)
+
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyResponse, nil)
+			},
+			want: dto.UpdateLobbySettingsResponse{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrWrongSettings)
+			},
+		},
+		{
+			name: "mixed provider without providers",
+			args: args{
+				req: dto.UpdateLobbySettingsRequest{
+					LobbyID: updateReq.LobbyID,
+					UserID:  updateReq.UserID,
+					Settings: lobbyEntity.Settings{
+						Rounds:     3,
+						Provider:   "mixed",
+						MaxPlayers: 4,
+					},
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.pano.On("ValidGameProvider", game.MixedProvider).Return(true)
+
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyResponse, nil)
+			},
+			want: dto.UpdateLobbySettingsResponse{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrWrongSettings)
+			},
+		},
+		{
+			name: "max players lower than current players",
+			args: args{
+				req: dto.UpdateLobbySettingsRequest{
+					LobbyID: updateReq.LobbyID,
+					UserID:  updateReq.UserID,
+					Settings: lobbyEntity.Settings{
+						Rounds:     3,
+						Provider:   "google",
+						MaxPlayers: 2,
+					},
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.pano.On("ValidGameProvider", game.GoogleProvider).Return(true)
+
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyResponse, nil)
+			},
+			want: dto.UpdateLobbySettingsResponse{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrWrongSettings)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			lobbyRepo := mocks.NewRepository(t)
+			pano := mocks.NewPanoramaUsecase(t)
+			fs := fields{
+				lobbyRepo: lobbyRepo,
+				pano:      pano,
+			}
+			tt.setup(fs, tt.args)
+
+			uc := lobby.NewUsecase(lobby.Config{}, nil, nil, nil, lobbyRepo, nil, nil, pano)
+
+			got, err := uc.UpdateLobbySettings(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUsecase_KickLobbyUser(t *testing.T) {
+	t.Parallel()
+
+	kickReq := dto.KickLobbyUserRequest{
+		LobbyID:   "1234567890",
+		CreatorID: 1,
+		UserID:    2,
+	}
+
+	lobbyResponse := lobbyEntity.Lobby{
+		ID:             kickReq.LobbyID,
+		CreatorID:      1,
+		CurrentPlayers: 2,
+		MaxPlayers:     5,
+	}
+
+	type fields struct {
+		lobbyRepo *mocks.Repository
+	}
+
+	type args struct {
+		req dto.KickLobbyUserRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "successfully kick user",
+			args: args{
+				req: kickReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyResponse, nil)
+
+				fs.lobbyRepo.On("GetLobbyPlayers", mock.Anything, args.req.LobbyID).
+					Return([]int{1, 2}, nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "successfully ban user",
+			args: args{
+				req: dto.KickLobbyUserRequest{
+					LobbyID:   kickReq.LobbyID,
+					CreatorID: kickReq.CreatorID,
+					UserID:    kickReq.UserID,
+					Ban:       true,
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyResponse, nil)
+
+				fs.lobbyRepo.On("GetLobbyPlayers", mock.Anything, args.req.LobbyID).
+					Return([]int{1, 2}, nil)
+
+				fs.lobbyRepo.On("BanLobbyUser", mock.Anything, args.req.LobbyID, args.req.UserID).
+					Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "user is not lobby creator",
+			args: args{
+				req: dto.KickLobbyUserRequest{
+					LobbyID:   kickReq.LobbyID,
+					CreatorID: 3,
+					UserID:    kickReq.UserID,
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyResponse, nil)
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrOnlyCreatorCanModerate)
+			},
+		},
+		{
+			name: "creator can't kick themselves",
+			args: args{
+				req: dto.KickLobbyUserRequest{
+					LobbyID:   kickReq.LobbyID,
+					CreatorID: kickReq.CreatorID,
+					UserID:    kickReq.CreatorID,
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyResponse, nil)
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrWrongModerationTarget)
+			},
+		},
+		{
+			name: "user is not connected to lobby",
+			args: args{
+				req: kickReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyResponse, nil)
+
+				fs.lobbyRepo.On("GetLobbyPlayers", mock.Anything, args.req.LobbyID).
+					Return([]int{1}, nil)
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrWrongModerationTarget)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			lobbyRepo := mocks.NewRepository(t)
+			fs := fields{
+				lobbyRepo: lobbyRepo,
+			}
+			tt.setup(fs, tt.args)
+
+			uc := lobby.NewUsecase(lobby.Config{}, nil, nil, nil, lobbyRepo, nil, nil, nil)
+
+			err := uc.KickLobbyUser(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestUsecase_TransferLobbyHost(t *testing.T) {
+	t.Parallel()
+
+	transferReq := dto.TransferLobbyHostRequest{
+		LobbyID:   "1234567890",
+		CreatorID: 1,
+		UserID:    2,
+	}
+
+	lobbyResponse := lobbyEntity.Lobby{
+		ID:             transferReq.LobbyID,
+		CreatorID:      1,
+		CurrentPlayers: 2,
+		MaxPlayers:     5,
+	}
+
+	type fields struct {
+		userRepo  *mocks.UserRepository
+		lobbyRepo *mocks.Repository
+	}
+
+	type args struct {
+		req dto.TransferLobbyHostRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "successfully transfer host",
+			args: args{
+				req: transferReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyResponse, nil)
+
+				fs.lobbyRepo.On("GetLobbyPlayers", mock.Anything, args.req.LobbyID).
+					Return([]int{1, 2}, nil)
+
+				fs.userRepo.On("GetUserByID", mock.Anything, args.req.UserID).
+					Return(user.PrivateProfile{PublicProfile: user.PublicProfile{ID: args.req.UserID, Username: "NewHost"}}, nil)
+
+				fs.lobbyRepo.On("SetLobbyCreator", mock.Anything, dto.SetLobbyCreatorRequestDB{
+					ID:              args.req.LobbyID,
+					OldCreatorID:    args.req.CreatorID,
+					CreatorID:       args.req.UserID,
+					CreatorUsername: "NewHost",
+				}).Return(true, nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "host role was passed concurrently",
+			args: args{
+				req: transferReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyResponse, nil)
+
+				fs.lobbyRepo.On("GetLobbyPlayers", mock.Anything, args.req.LobbyID).
+					Return([]int{1, 2}, nil)
+
+				fs.userRepo.On("GetUserByID", mock.Anything, args.req.UserID).
+					Return(user.PrivateProfile{PublicProfile: user.PublicProfile{ID: args.req.UserID, Username: "NewHost"}}, nil)
+
+				fs.lobbyRepo.On("SetLobbyCreator", mock.Anything, dto.SetLobbyCreatorRequestDB{
+					ID:              args.req.LobbyID,
+					OldCreatorID:    args.req.CreatorID,
+					CreatorID:       args.req.UserID,
+					CreatorUsername: "NewHost",
+				}).Return(false, nil)
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrOnlyCreatorCanModerate)
+			},
+		},
+		{
+			name: "user is not lobby creator",
+			args: args{
+				req: dto.TransferLobbyHostRequest{
+					LobbyID:   transferReq.LobbyID,
+					CreatorID: 2,
+					UserID:    2,
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyResponse, nil)
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrOnlyCreatorCanModerate)
+			},
+		},
+		{
+			name: "new host is not connected to lobby",
+			args: args{
+				req: transferReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("GetLobby", mock.Anything, args.req.LobbyID).
+					Return(lobbyResponse, nil)
+
+				fs.lobbyRepo.On("GetLobbyPlayers", mock.Anything, args.req.LobbyID).
+					Return([]int{1, 3}, nil)
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, lobbyEntity.ErrWrongModerationTarget)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userRepo := mocks.NewUserRepository(t)
+			lobbyRepo := mocks.NewRepository(t)
+			fs := fields{
+				userRepo:  userRepo,
+				lobbyRepo: lobbyRepo,
+			}
+			tt.setup(fs, tt.args)
+
+			uc := lobby.NewUsecase(lobby.Config{}, nil, nil, userRepo, lobbyRepo, nil, nil, nil)
+
+			err := uc.TransferLobbyHost(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestUsecase_SetLobbyUserReady(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		lobbyRepo *mocks.Repository
+	}
+
+	type args struct {
+		req dto.SetLobbyUserReadyRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		want    bool
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "last player got ready",
+			args: args{
+				req: dto.SetLobbyUserReadyRequest{LobbyID: "lobby", UserID: 2, Ready: true},
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("SetLobbyUserReady", mock.Anything, args.req).
+					Return(nil)
+
+				fs.lobbyRepo.On("GetLobbyPlayers", mock.Anything, args.req.LobbyID).
+					Return([]int{1, 2}, nil)
+
+				fs.lobbyRepo.On("GetLobbyReadyUsers", mock.Anything, args.req.LobbyID).
+					Return([]int{1, 2}, nil)
+			},
+			want:    true,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "other player is not ready",
+			args: args{
+				req: dto.SetLobbyUserReadyRequest{LobbyID: "lobby", UserID: 2, Ready: true},
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("SetLobbyUserReady", mock.Anything, args.req).
+					Return(nil)
+
+				fs.lobbyRepo.On("GetLobbyPlayers", mock.Anything, args.req.LobbyID).
+					Return([]int{1, 2, 3}, nil)
+
+				fs.lobbyRepo.On("GetLobbyReadyUsers", mock.Anything, args.req.LobbyID).
+					Return([]int{1, 2}, nil)
+			},
+			want:    false,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "countdown is not started for a single player",
+			args: args{
+				req: dto.SetLobbyUserReadyRequest{LobbyID: "lobby", UserID: 1, Ready: true},
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("SetLobbyUserReady", mock.Anything, args.req).
+					Return(nil)
+
+				fs.lobbyRepo.On("GetLobbyPlayers", mock.Anything, args.req.LobbyID).
+					Return([]int{1}, nil)
+
+				fs.lobbyRepo.On("GetLobbyReadyUsers", mock.Anything, args.req.LobbyID).
+					Return([]int{1}, nil)
+			},
+			want:    false,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "player got unready",
+			args: args{
+				req: dto.SetLobbyUserReadyRequest{LobbyID: "lobby", UserID: 1, Ready: false},
+			},
+			setup: func(fs fields, args args) {
+				fs.lobbyRepo.On("SetLobbyUserReady", mock.Anything, args.req).
+					Return(nil)
+			},
+			want:    false,
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			lobbyRepo := mocks.NewRepository(t)
+			fs := fields{
+				lobbyRepo: lobbyRepo,
+			}
+			tt.setup(fs, tt.args)
+
+			uc := lobby.NewUsecase(lobby.Config{}, nil, nil, nil, lobbyRepo, nil, nil, nil)
+
+			allReady, err := uc.SetLobbyUserReady(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, allReady)
+		})
+	}
+}
+
+func TestUsecase_StartLobbyCountdown(t *testing.T) {
+	t.Parallel()
+
+	const (
+		lobbyID = "lobby"
+		token   = "countdown-token"
+	)
+
+	conf := lobby.Config{
+		LobbyIDLength:    16,
+		ReadyCountdown:   10 * time.Millisecond,
+		GameStartTimeout: time.Second,
+	}
+
+	type fields struct {
+		rnd       *mocks.RandomGenerator
+		lobbyRepo *mocks.Repository
+	}
+
+	tests := []struct {
+		name        string
+		setup       func(fields)
+		wantStarted bool
+		wantErr     assert.ErrorAssertionFunc
+	}{
+		{
+			name: "countdown is started",
+			setup: func(fs fields) {
+				fs.rnd.On("NewRandomHexString", conf.LobbyIDLength).
+					Return(token)
+
+				fs.lobbyRepo.On("SetLobbyCountdown", mock.Anything, lobbyID, token, conf.ReadyCountdown+conf.GameStartTimeout).
+					Return(true, nil)
+			},
+			wantStarted: true,
+			wantErr:     assert.NoError,
+		},
+		{
+			name: "countdown is already pending on another instance",
+			setup: func(fs fields) {
+				fs.rnd.On("NewRandomHexString", conf.LobbyIDLength).
+					Return(token)
+
+				fs.lobbyRepo.On("SetLobbyCountdown", mock.Anything, lobbyID, token, conf.ReadyCountdown+conf.GameStartTimeout).
+					Return(false, nil)
+			},
+			wantStarted: false,
+			wantErr:     assert.NoError,
+		},
+		{
+			name: "error saving countdown",
+			setup: func(fs fields) {
+				fs.rnd.On("NewRandomHexString", conf.LobbyIDLength).
+					Return(token)
+
+				fs.lobbyRepo.On("SetLobbyCountdown", mock.Anything, lobbyID, token, conf.ReadyCountdown+conf.GameStartTimeout).
+					Return(false, errors.New("some error"))
+			},
+			wantStarted: false,
+			wantErr:     assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rnd := mocks.NewRandomGenerator(t)
+			lobbyRepo := mocks.NewRepository(t)
+			tt.setup(fields{rnd: rnd, lobbyRepo: lobbyRepo})
+
+			uc := lobby.NewUsecase(conf, rnd, nil, nil, lobbyRepo, nil, nil, nil)
+
+			tokens := make(chan string, 1)
+
+			started, err := uc.StartLobbyCountdown(t.Context(), lobbyID, func(_ context.Context, token string) {
+				tokens <- token
+			})
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantStarted, started)
+
+			if !tt.wantStarted {
+				return
+			}
+
+			select {
+			case got := <-tokens:
+				assert.Equal(t, token, got)
+			case <-time.After(time.Second):
+				t.Fatal("game is not started after the countdown")
+			}
+		})
+	}
+}
+
+func TestUsecase_StopLobbyCountdown(t *testing.T) {
+	t.Parallel()
+
+	const (
+		lobbyID = "lobby"
+		token   = "countdown-token"
+	)
+
+	conf := lobby.Config{
+		LobbyIDLength:    16,
+		ReadyCountdown:   50 * time.Millisecond,
+		GameStartTimeout: time.Second,
+	}
+
+	tests := []struct {
+		name  string
+		setup func(*mocks.Repository)
+		stop  func(*lobby.Usecase)
+	}{
+		{
+			name: "countdown is cancelled",
+			setup: func(repo *mocks.Repository) {
+				repo.On("DeleteLobbyCountdown", mock.Anything, lobbyID, "").
+					Return(true, nil)
+			},
+			stop: func(uc *lobby.Usecase) {
+				_, _ = uc.CancelLobbyCountdown(context.Background(), lobbyID, "")
+			},
+		},
+		{
+			name:  "usecase is closed",
+			setup: func(*mocks.Repository) {},
+			stop: func(uc *lobby.Usecase) {
+				uc.Close()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rnd := mocks.NewRandomGenerator(t)
+			lobbyRepo := mocks.NewRepository(t)
+
+			rnd.On("NewRandomHexString", conf.LobbyIDLength).
+				Return(token)
+			lobbyRepo.On("SetLobbyCountdown", mock.Anything, lobbyID, token, conf.ReadyCountdown+conf.GameStartTimeout).
+				Return(true, nil)
+			tt.setup(lobbyRepo)
+
+			uc := lobby.NewUsecase(conf, rnd, nil, nil, lobbyRepo, nil, nil, nil)
+
+			gameStarted := make(chan struct{}, 1)
+
+			started, err := uc.StartLobbyCountdown(t.Context(), lobbyID, func(context.Context, string) {
+				gameStarted <- struct{}{}
+			})
+			assert.NoError(t, err)
+			assert.True(t, started)
+
+			tt.stop(uc)
+
+			select {
+			case <-gameStarted:
+				t.Fatal("game is started after the countdown was stopped")
+			case <-time.After(4 * conf.ReadyCountdown):
+			}
+		})
+	}
+}
+
+func TestUsecase_CancelLobbyCountdown(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		lobbyID string
+		token   string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(*mocks.Repository, args)
+		want    bool
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "pending countdown is cancelled",
+			args: args{lobbyID: "lobby"},
+			setup: func(repo *mocks.Repository, a args) {
+				repo.On("DeleteLobbyCountdown", mock.Anything, a.lobbyID, a.token).
+					Return(true, nil)
+			},
+			want:    true,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "countdown was replaced by another one",
+			args: args{lobbyID: "lobby", token: "countdown-token"},
+			setup: func(repo *mocks.Repository, a args) {
+				repo.On("DeleteLobbyCountdown", mock.Anything, a.lobbyID, a.token).
+					Return(false, nil)
+			},
+			want:    false,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error deleting countdown",
+			args: args{lobbyID: "lobby"},
+			setup: func(repo *mocks.Repository, a args) {
+				repo.On("DeleteLobbyCountdown", mock.Anything, a.lobbyID, a.token).
+					Return(false, errors.New("some error"))
+			},
+			want:    false,
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			lobbyRepo := mocks.NewRepository(t)
+			tt.setup(lobbyRepo, tt.args)
+
+			uc := lobby.NewUsecase(lobby.Config{}, nil, nil, nil, lobbyRepo, nil, nil, nil)
+
+			cancelled, err := uc.CancelLobbyCountdown(t.Context(), tt.args.lobbyID, tt.args.token)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, cancelled)
 		})
 	}
 }
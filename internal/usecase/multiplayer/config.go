@@ -12,12 +12,28 @@ type Config struct {
 	RoundStartDelay time.Duration
 	// Delay after round has ended to start a new round (to allow users to see results).
 	RoundEndDelay time.Duration
+	// Time given to a disconnected battle royale player to reconnect before they are eliminated.
+	DisconnectGracePeriod time.Duration
+	// Interval between checks for rounds left unfinished by stopped instances, a round is finished by the check
+	// once its timer is overdue by the interval.
+	RoundRecoveryInterval time.Duration
+	// Health players of duel games start with.
+	DuelHealth int
+	// Time left for other duel players to guess after the first guess in a round.
+	DuelGuessTimer time.Duration
+	// Increase of duel damage multiplier with every round.
+	DuelMultiplierStep float64
 }
 
 // NewConfig returns a new local config from general config.
 func NewConfig(cfg config.Config) Config {
 	return Config{
-		RoundStartDelay: cfg.Limits.RoundStartDelay,
-		RoundEndDelay:   cfg.Limits.RoundEndDelay,
+		RoundStartDelay:       cfg.Limits.RoundStartDelay,
+		RoundEndDelay:         cfg.Limits.RoundEndDelay,
+		DisconnectGracePeriod: cfg.Limits.DisconnectGracePeriod,
+		RoundRecoveryInterval: cfg.Limits.RoundRecoveryInterval,
+		DuelHealth:            cfg.Limits.DuelHealth,
+		DuelGuessTimer:        cfg.Limits.DuelGuessTimer,
+		DuelMultiplierStep:    cfg.Limits.DuelMultiplierStep,
 	}
 }
@@ -0,0 +1,200 @@
+package multiplayer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
+	"github.com/VasySS/segoya-backend/internal/entity/user"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/transport"
+)
+
+// DisconnectUser schedules elimination of a disconnected battle royale player,
+// who does not reconnect during the grace period (called from websocket).
+func (uc Usecase) DisconnectUser(ctx context.Context, req dto.DisconnectMultiplayerUserRequest) error {
+	ctx, span := uc.tracer.Start(ctx, "DisconnectUser")
+	defer span.End()
+
+	g, err := uc.repo.GetMultiplayerGame(ctx, req.GameID)
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("failed to get game: %w", err)
+	}
+
+	if g.Mode != multiplayer.BattleRoyaleMode || g.Finished {
+		return nil
+	}
+
+	key := gameUserKey{gameID: req.GameID, userID: req.UserID}
+
+	uc.eliminations.schedule(key, uc.cfg.DisconnectGracePeriod, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), roundFinishTimeout)
+		defer cancel()
+
+		err := uc.EliminateDisconnectedUser(ctx, dto.EliminateDisconnectedMultiplayerUserRequest{
+			RequestTime: time.Now().UTC(),
+			GameID:      req.GameID,
+			UserID:      req.UserID,
+		})
+		if err != nil {
+			slog.Error("error eliminating disconnected user",
+				slog.Int("gameID", req.GameID),
+				slog.Int("userID", req.UserID),
+				slog.Any("error", err),
+			)
+		}
+	})
+
+	return nil
+}
+
+// EliminateDisconnectedUser eliminates a battle royale player if they are still disconnected from the game
+// (called when the grace period runs out). The game is ended if only one player remains.
+func (uc Usecase) EliminateDisconnectedUser(
+	ctx context.Context,
+	req dto.EliminateDisconnectedMultiplayerUserRequest,
+) error {
+	ctx, span := uc.tracer.Start(ctx, "EliminateDisconnectedUser")
+	defer span.End()
+
+	connectedUserIDs, err := uc.connectedUserIDs(req.GameID)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	// player has reconnected in time
+	if slices.Contains(connectedUserIDs, req.UserID) {
+		return nil
+	}
+
+	var (
+		elimination roundResult
+		result      roundResult
+		eliminated  bool
+		roundEnded  bool
+	)
+
+	err = uc.repo.RunTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.LockMultiplayerGame(ctx, req.GameID); err != nil {
+			return fmt.Errorf("failed to lock game: %w", err)
+		}
+
+		g, err := uc.repo.GetMultiplayerGame(ctx, req.GameID)
+		if err != nil {
+			return fmt.Errorf("failed to get game: %w", err)
+		}
+
+		users, err := uc.gameUsers(ctx, req.UserID, req.GameID)
+		if err != nil {
+			return err
+		}
+
+		alreadyEliminated := slices.ContainsFunc(users, func(u user.MultiplayerUser) bool {
+			return u.ID == req.UserID && u.Eliminated()
+		})
+		if g.Finished || alreadyEliminated {
+			return nil
+		}
+
+		users, err = uc.eliminateUsers(ctx, req.RequestTime, g.ID, g.RoundCurrent, []int{req.UserID})
+		if err != nil {
+			return err
+		}
+
+		elimination = newRoundResult(g)
+		elimination.round.RoundNum = g.RoundCurrent
+		elimination.eliminated = []int{req.UserID}
+		eliminated = true
+
+		if multiplayer.AlivePlayers(users) > 1 {
+			return nil
+		}
+
+		r, err := uc.repo.GetMultiplayerRound(ctx, g.ID, g.RoundCurrent)
+		if err != nil {
+			return fmt.Errorf("failed to get current round: %w", err)
+		}
+
+		// game is over, so the current round is ended together with it
+		if !r.Finished {
+			gs, err := uc.repo.GetMultiplayerRoundGuesses(ctx, r.ID)
+			if err != nil {
+				return fmt.Errorf("failed to get round guesses: %w", err)
+			}
+
+			result, err = uc.endRound(ctx, req.RequestTime, g, r, gs)
+			if err != nil {
+				return err
+			}
+
+			roundEnded = true
+
+			return nil
+		}
+
+		gameGuesses, err := uc.finishGame(ctx, req.RequestTime, g.ID)
+		if err != nil {
+			return err
+		}
+
+		result = newRoundResult(g)
+		result.standings = multiplayer.NewStandings(users)
+		result.teamStandings = multiplayer.NewTeamStandings(users, gameGuesses, g.TeamScoring)
+		result.gameFinished = true
+		result.gameGuesses = gameGuesses
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("failed to eliminate disconnected user: %w", err)
+	}
+
+	if !eliminated {
+		return nil
+	}
+
+	// the elimination and results of the game it has ended are sent together, so that they keep their order
+	messages := []transport.WebSocketMessageOutput{usersEliminatedMessage(elimination)}
+
+	switch {
+	case roundEnded:
+		uc.rounds.cancel(result.gameID)
+
+		messages = append(messages, roundResultMessages(result)...)
+	case result.gameFinished:
+		messages = append(messages, gameFinishedMessage(result))
+	}
+
+	uc.broadcastResults(elimination, messages...)
+
+	return nil
+}
+
+// connectedUserIDs returns IDs of players connected to the game (on all running instances).
+func (uc Usecase) connectedUserIDs(gameID int) ([]int, error) {
+	members, err := uc.ws.Members(strconv.Itoa(gameID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game members: %w", err)
+	}
+
+	userIDs := make([]int, 0, len(members))
+
+	for _, m := range members {
+		var u user.MultiplayerUser
+		if err := json.Unmarshal(m, &u); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal game member: %w", err)
+		}
+
+		userIDs = append(userIDs, u.ID)
+	}
+
+	return userIDs, nil
+}
@@ -0,0 +1,248 @@
+package multiplayer_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	multiplayerEntity "github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
+	"github.com/VasySS/segoya-backend/internal/entity/user"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/repository"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/transport"
+	"github.com/VasySS/segoya-backend/internal/usecase/multiplayer"
+	"github.com/VasySS/segoya-backend/internal/usecase/multiplayer/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUsecase_EliminateDisconnectedUser(t *testing.T) {
+	t.Parallel()
+
+	eliminateReq := dto.EliminateDisconnectedMultiplayerUserRequest{
+		RequestTime: time.Now().UTC(),
+		GameID:      1,
+		UserID:      2,
+	}
+
+	gameResponse := multiplayerEntity.Game{
+		ID:           1,
+		RoundCurrent: 3,
+		TimerSeconds: 60,
+		Players:      3,
+		Mode:         multiplayerEntity.BattleRoyaleMode,
+	}
+
+	member := func(id int) json.RawMessage {
+		m, _ := json.Marshal(user.MultiplayerUser{PublicProfile: user.PublicProfile{ID: id}})
+		return m
+	}
+
+	type fields struct {
+		repo *mocks.Repository
+		ws   *mocks.Broadcaster
+	}
+
+	type args struct {
+		req dto.EliminateDisconnectedMultiplayerUserRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "player has reconnected",
+			args: args{
+				req: eliminateReq,
+			},
+			setup: func(fs fields, _ args) {
+				fs.ws.On("Members", "1").
+					Return([]json.RawMessage{member(1), member(2)}, nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "player is eliminated and game continues",
+			args: args{
+				req: eliminateReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.ws.On("Members", "1").
+					Return([]json.RawMessage{member(1)}, nil)
+
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(gameResponse, nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return([]user.MultiplayerUser{
+						{PublicProfile: user.PublicProfile{ID: 1}},
+						{PublicProfile: user.PublicProfile{ID: 2}},
+						{PublicProfile: user.PublicProfile{ID: 3}},
+					}, nil).Once()
+
+				fs.repo.On("EliminateMultiplayerGameUsers", mock.Anything, dto.EliminateMultiplayerUsersRequestDB{
+					RequestTime: args.req.RequestTime,
+					GameID:      gameResponse.ID,
+					RoundNum:    gameResponse.RoundCurrent,
+					UserIDs:     []int{args.req.UserID},
+				}).Return(nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return([]user.MultiplayerUser{
+						{PublicProfile: user.PublicProfile{ID: 1}},
+						{PublicProfile: user.PublicProfile{ID: 2}, EliminatedRound: 3},
+						{PublicProfile: user.PublicProfile{ID: 3}},
+					}, nil).Once()
+
+				fs.ws.On("Broadcast", "1", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageUsersEliminated,
+					Payload: map[string]any{
+						"roundNum": gameResponse.RoundCurrent,
+						"userIDs":  []int{args.req.UserID},
+					},
+				}).Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "last remaining player wins after round has finished",
+			args: args{
+				req: eliminateReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.ws.On("Members", "1").
+					Return([]json.RawMessage{member(1)}, nil)
+
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(gameResponse, nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return([]user.MultiplayerUser{
+						{PublicProfile: user.PublicProfile{ID: 1}, Score: 100},
+						{PublicProfile: user.PublicProfile{ID: 2}, Score: 200},
+						{PublicProfile: user.PublicProfile{ID: 3}, Score: 300, EliminatedRound: 2},
+					}, nil).Once()
+
+				fs.repo.On("EliminateMultiplayerGameUsers", mock.Anything, dto.EliminateMultiplayerUsersRequestDB{
+					RequestTime: args.req.RequestTime,
+					GameID:      gameResponse.ID,
+					RoundNum:    gameResponse.RoundCurrent,
+					UserIDs:     []int{args.req.UserID},
+				}).Return(nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return([]user.MultiplayerUser{
+						{PublicProfile: user.PublicProfile{ID: 1}, Score: 100},
+						{PublicProfile: user.PublicProfile{ID: 2}, Score: 200, EliminatedRound: 3},
+						{PublicProfile: user.PublicProfile{ID: 3}, Score: 300, EliminatedRound: 2},
+					}, nil).Once()
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, gameResponse.ID, gameResponse.RoundCurrent).
+					Return(multiplayerEntity.Round{ID: 7, RoundNum: 3, Finished: true}, nil)
+
+				fs.repo.On("EndMultiplayerGame", mock.Anything, dto.EndMultiplayerGameRequestDB{
+					RequestTime: args.req.RequestTime,
+					GameID:      gameResponse.ID,
+				}).Return(nil)
+
+				gameGuesses := []multiplayerEntity.Guess{
+					{UserID: 1, RoundNum: 1, Score: 100},
+					{UserID: 2, RoundNum: 1, Score: 200},
+				}
+
+				fs.repo.On("GetMultiplayerGameGuesses", mock.Anything, gameResponse.ID).
+					Return(gameGuesses, nil)
+
+				fs.ws.On("Broadcast", "1", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageUsersEliminated,
+					Payload: map[string]any{
+						"roundNum": gameResponse.RoundCurrent,
+						"userIDs":  []int{args.req.UserID},
+					},
+				}).Return(nil)
+
+				fs.ws.On("Broadcast", "1", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageGameFinished,
+					Payload: map[string]any{
+						"standings": []multiplayerEntity.Standing{
+							{Place: 1, UserID: 1, Score: 100},
+							{Place: 2, UserID: 2, Score: 200, EliminatedRound: 3},
+							{Place: 3, UserID: 3, Score: 300, EliminatedRound: 2},
+						},
+						"guesses": gameGuesses,
+					},
+				}).Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "user is not in game",
+			args: args{
+				req: eliminateReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.ws.On("Members", "1").
+					Return([]json.RawMessage{}, nil)
+
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(gameResponse, nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return([]user.MultiplayerUser{
+						{PublicProfile: user.PublicProfile{ID: 1}},
+					}, nil)
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, multiplayerEntity.ErrGameWrongUserID)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			pano := mocks.NewPanoramaUsecase(t)
+			ws := mocks.NewBroadcaster(t)
+			fs := fields{
+				repo: repo,
+				ws:   ws,
+			}
+			tt.setup(fs, tt.args)
+
+			uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano, ws)
+
+			err := uc.EliminateDisconnectedUser(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package multiplayer
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/VasySS/segoya-backend/internal/dto"
 	"github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
@@ -14,19 +15,51 @@ func (uc Usecase) NewGame(ctx context.Context, req dto.NewMultiplayerGameRequest
 	ctx, span := uc.tracer.Start(ctx, "NewGame")
 	defer span.End()
 
-	var response int
+	if err := validatePlayers(req); err != nil {
+		span.RecordError(err)
+		return 0, fmt.Errorf("failed to create game: %w", err)
+	}
 
-	err := uc.repo.RunTx(ctx, func(ctx context.Context) error {
+	if req.Mode == multiplayer.DuelMode && req.Health == 0 {
+		req.Health = uc.cfg.DuelHealth
+	}
+
+	if req.ScoreDistance == 0 {
+		scoreDistance, err := uc.scoreDistance(ctx, req)
+		if err != nil {
+			span.RecordError(err)
+			return 0, fmt.Errorf("failed to get score distance: %w", err)
+		}
+
+		req.ScoreDistance = scoreDistance
+	}
+
+	// panorama of the first round is resolved before the transaction is opened,
+	// as it may be located by an external provider
+	pano, err := uc.firstStreetview(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		return 0, fmt.Errorf("failed to create panorama: %w", err)
+	}
+
+	var (
+		response int
+		game     multiplayer.Game
+		round    multiplayer.Round
+		created  bool
+	)
+
+	err = uc.repo.RunTx(ctx, func(ctx context.Context) error {
 		gID, err := uc.repo.NewMultiplayerGame(ctx, req)
 		if err != nil {
 			return fmt.Errorf("error creating multiplayer game: %w", err)
 		}
 
-		_, err = uc.NewRound(ctx, dto.NewMultiplayerRoundRequest{
+		game, round, created, err = uc.newRound(ctx, dto.NewMultiplayerRoundRequest{
 			RequestTime: req.RequestTime,
 			GameID:      gID,
 			UserID:      req.CreatorID,
-		})
+		}, roundPanorama{RoundNum: 1, Panorama: pano})
 		if err != nil {
 			return fmt.Errorf("failed to create round: %w", err)
 		}
@@ -40,6 +73,9 @@ func (uc Usecase) NewGame(ctx context.Context, req dto.NewMultiplayerGameRequest
 		return 0, fmt.Errorf("failed to create game: %w", err)
 	}
 
+	// the round timer is only scheduled for the committed game
+	uc.startRound(game, round, created)
+
 	return response, nil
 }
 
@@ -78,11 +114,16 @@ func (uc Usecase) GetGame(ctx context.Context, gameID, userID int) (multiplayer.
 
 // EndGame ends a multiplayer game (if it's not finished already) and
 // returns all guesses made during it.
+// Final rankings are broadcasted to all players if the game was ended by this call.
 func (uc Usecase) EndGame(ctx context.Context, req dto.EndMultiplayerGameRequest) ([]multiplayer.Guess, error) {
 	ctx, span := uc.tracer.Start(ctx, "EndGame")
 	defer span.End()
 
-	var response []multiplayer.Guess
+	var (
+		response []multiplayer.Guess
+		result   roundResult
+		ended    bool
+	)
 
 	err := uc.repo.RunTx(ctx, func(ctx context.Context) error {
 		if err := uc.repo.LockMultiplayerGame(ctx, req.GameID); err != nil {
@@ -98,11 +139,11 @@ func (uc Usecase) EndGame(ctx context.Context, req dto.EndMultiplayerGameRequest
 			return fmt.Errorf("failed to get game from repo: %w", err)
 		}
 
-		if game.RoundCurrent < game.Rounds {
+		if game.RoundsLeft() {
 			return multiplayer.ErrGameIsStillActive
 		}
 
-		gs, err := uc.GetGameGuesses(ctx, req.GameID)
+		gs, err := uc.repo.GetMultiplayerGameGuesses(ctx, req.GameID)
 		if err != nil {
 			return fmt.Errorf("failed to get game guesses: %w", err)
 		}
@@ -119,7 +160,18 @@ func (uc Usecase) EndGame(ctx context.Context, req dto.EndMultiplayerGameRequest
 			return fmt.Errorf("failed to update game end in repo: %w", err)
 		}
 
+		users, err := uc.repo.GetMultiplayerGameUsers(ctx, req.GameID)
+		if err != nil {
+			return fmt.Errorf("failed to get game users: %w", err)
+		}
+
 		response = gs
+		result = newRoundResult(game)
+		result.standings = multiplayer.NewStandings(users)
+		result.teamStandings = multiplayer.NewTeamStandings(users, gs, game.TeamScoring)
+		result.gameFinished = true
+		result.gameGuesses = gs
+		ended = true
 
 		return nil
 	})
@@ -128,21 +180,94 @@ func (uc Usecase) EndGame(ctx context.Context, req dto.EndMultiplayerGameRequest
 		return nil, fmt.Errorf("failed to end game: %w", err)
 	}
 
+	if ended {
+		uc.broadcastGameFinished(result)
+	}
+
 	return response, nil
 }
 
-// GetGameGuesses returns all guesses made during a game.
-func (uc Usecase) GetGameGuesses(ctx context.Context, gameID int) ([]multiplayer.Guess, error) {
+// validatePlayers checks that connected players can play the game - players of team games
+// are split into at least two teams and duels are played by two players (or two teams).
+func validatePlayers(req dto.NewMultiplayerGameRequest) error {
+	sides := len(req.ConnectedPlayers)
+
+	if req.TeamScoring != "" {
+		teams := make(map[int]struct{})
+
+		for _, p := range req.ConnectedPlayers {
+			team, ok := req.Teams[p.ID]
+			if !ok || team <= 0 {
+				return multiplayer.ErrWrongTeams
+			}
+
+			teams[team] = struct{}{}
+		}
+
+		if len(teams) < 2 {
+			return multiplayer.ErrWrongTeams
+		}
+
+		sides = len(teams)
+	}
+
+	if req.Mode == multiplayer.DuelMode && sides != duelSides {
+		return multiplayer.ErrWrongPlayersAmount
+	}
+
+	return nil
+}
+
+// GetGameGuesses returns guesses made during finished rounds of a game.
+// Users outside of the game can get them only if the game can be spectated, and guesses of a round
+// are returned to them only after the spectator delay of the game.
+func (uc Usecase) GetGameGuesses(
+	ctx context.Context,
+	req dto.GetMultiplayerGameGuessesRequest,
+) ([]multiplayer.Guess, error) {
 	ctx, span := uc.tracer.Start(ctx, "GetGameGuesses")
 	defer span.End()
 
-	guesses, err := uc.repo.GetMultiplayerGameGuesses(ctx, gameID)
+	var response []multiplayer.Guess
+
+	err := uc.repo.RunTx(ctx, func(ctx context.Context) error {
+		g, err := uc.repo.GetMultiplayerGame(ctx, req.GameID)
+		if err != nil {
+			return fmt.Errorf("failed to get game: %w", err)
+		}
+
+		users, err := uc.repo.GetMultiplayerGameUsers(ctx, req.GameID)
+		if err != nil {
+			return fmt.Errorf("failed to get game users: %w", err)
+		}
+
+		guesses, err := uc.repo.GetMultiplayerGameGuesses(ctx, req.GameID)
+		if err != nil {
+			return fmt.Errorf("failed to get multiplayer game guesses: %w", err)
+		}
+
+		if slices.ContainsFunc(users, func(u user.MultiplayerUser) bool { return u.ID == req.UserID }) {
+			response = guesses
+			return nil
+		}
+
+		if !g.Spectators {
+			return multiplayer.ErrGameWrongUserID
+		}
+
+		// every round is hidden until its own results are revealed to spectators
+		response = slices.DeleteFunc(guesses, func(guess multiplayer.Guess) bool {
+			return req.RequestTime.Before(guess.RoundEndedAt.Add(g.SpectatorDelay()))
+		})
+
+		return nil
+	})
 	if err != nil {
 		span.RecordError(err)
 		return nil, fmt.Errorf("failed to get multiplayer game guesses: %w", err)
 	}
 
-	return guesses, nil
+	return response, nil
 }
 
 // GetGameUsers returns all users info in a game (including those, who left).
@@ -175,16 +300,20 @@ func (uc Usecase) GetGameUser(ctx context.Context, userID, gameID int) (user.Mul
 
 // isUserInGame validates a user's participation in a game.
 func (uc Usecase) isUserInGame(ctx context.Context, userID, gameID int) error {
+	_, err := uc.gameUsers(ctx, userID, gameID)
+	return err
+}
+
+// gameUsers returns all users in a game after validating the user's participation in it.
+func (uc Usecase) gameUsers(ctx context.Context, userID, gameID int) ([]user.MultiplayerUser, error) {
 	users, err := uc.repo.GetMultiplayerGameUsers(ctx, gameID)
 	if err != nil {
-		return fmt.Errorf("failed to get multiplayer game users: %w", err)
+		return nil, fmt.Errorf("failed to get multiplayer game users: %w", err)
 	}
 
-	for _, u := range users {
-		if u.ID == userID {
-			return nil
-		}
+	if !slices.ContainsFunc(users, func(u user.MultiplayerUser) bool { return u.ID == userID }) {
+		return nil, multiplayer.ErrGameWrongUserID
 	}
 
-	return multiplayer.ErrGameWrongUserID
+	return users, nil
 }
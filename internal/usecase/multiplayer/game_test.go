@@ -3,6 +3,7 @@ package multiplayer_test
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	multiplayerEntity "github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
 	"github.com/VasySS/segoya-backend/internal/entity/user"
 	"github.com/VasySS/segoya-backend/internal/infrastructure/repository"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/transport"
 	"github.com/VasySS/segoya-backend/internal/usecase/multiplayer"
 	"github.com/VasySS/segoya-backend/internal/usecase/multiplayer/mocks"
 	"github.com/stretchr/testify/assert"
@@ -63,7 +65,15 @@ func TestUsecase_NewGame(t *testing.T) {
 
 				createdGameID := 123
 
-				fs.repo.On("NewMultiplayerGame", mock.Anything, args.req).
+				fs.pano.On("ScoreDistance", mock.Anything, dto.ScoreDistanceRequest{
+					Provider: game.PanoramaProvider(args.req.Provider),
+				}).Return(700.5, nil)
+
+				// score distance is derived from the played area for new games
+				dbReq := args.req
+				dbReq.ScoreDistance = 700.5
+
+				fs.repo.On("NewMultiplayerGame", mock.Anything, dbReq).
 					Return(createdGameID, nil)
 
 				fs.repo.On("LockMultiplayerGame", mock.Anything, createdGameID).
@@ -99,7 +109,10 @@ func TestUsecase_NewGame(t *testing.T) {
 				createdPanoID := 12341
 				createdStreetviewID := "some_streetview_id"
 
-				fs.pano.On("NewStreetview", mock.Anything, game.PanoramaProvider(args.req.Provider)).
+				fs.pano.On("NewStreetview", mock.Anything, dto.NewStreetviewRequest{
+					Provider: game.PanoramaProvider(args.req.Provider),
+					UserIDs:  []int{1, 2},
+				}).
 					Return(game.PanoramaMetadata{
 						ID:           createdPanoID,
 						StreetviewID: createdStreetviewID,
@@ -124,12 +137,69 @@ func TestUsecase_NewGame(t *testing.T) {
 			want:    123,
 			wantErr: assert.NoError,
 		},
+		{
+			name: "error creating game - score distance error",
+			args: args{
+				req: createGameReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.pano.On("ScoreDistance", mock.Anything, dto.ScoreDistanceRequest{
+					Provider: game.PanoramaProvider(args.req.Provider),
+				}).Return(0.0, errors.New("db error"))
+			},
+			want:    0,
+			wantErr: assert.Error,
+		},
+		{
+			name: "error creating duel - wrong players amount",
+			args: args{
+				req: dto.NewMultiplayerGameRequest{
+					RequestTime: createGameReq.RequestTime,
+					CreatorID:   1,
+					ConnectedPlayers: []user.PublicProfile{
+						{ID: 1, Username: "username1"},
+						{ID: 2, Username: "username2"},
+						{ID: 3, Username: "username3"},
+					},
+					Provider: "google",
+					Mode:     multiplayerEntity.DuelMode,
+				},
+			},
+			setup: func(_ fields, _ args) {},
+			want:  0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, multiplayerEntity.ErrWrongPlayersAmount)
+			},
+		},
+		{
+			name: "error creating team game - players are not split into teams",
+			args: args{
+				req: dto.NewMultiplayerGameRequest{
+					RequestTime:      createGameReq.RequestTime,
+					CreatorID:        1,
+					ConnectedPlayers: createGameReq.ConnectedPlayers,
+					Provider:         "google",
+					Teams:            map[int]int{1: 1, 2: 1},
+					TeamScoring:      multiplayerEntity.BestTeamScoring,
+				},
+			},
+			setup: func(_ fields, _ args) {},
+			want:  0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, multiplayerEntity.ErrWrongTeams)
+			},
+		},
 		{
 			name: "error creating game - tx error",
 			args: args{
 				req: createGameReq,
 			},
 			setup: func(fs fields, _ args) {
+				fs.pano.On("ScoreDistance", mock.Anything, mock.Anything).Return(700.5, nil)
+
+				fs.pano.On("NewStreetview", mock.Anything, mock.Anything).
+					Return(game.PanoramaMetadata{ID: 12341}, nil)
+
 				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
 					Return(func(_ context.Context, _ repository.TxFunc) error {
 						return errors.New("tx error")
@@ -138,6 +208,22 @@ func TestUsecase_NewGame(t *testing.T) {
 			want:    0,
 			wantErr: assert.Error,
 		},
+		{
+			name: "error creating game - panorama error",
+			args: args{
+				req: createGameReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.pano.On("ScoreDistance", mock.Anything, mock.Anything).Return(700.5, nil)
+
+				fs.pano.On("NewStreetview", mock.Anything, dto.NewStreetviewRequest{
+					Provider: game.PanoramaProvider(args.req.Provider),
+					UserIDs:  []int{1, 2},
+				}).Return(game.PanoramaMetadata{}, errors.New("provider error"))
+			},
+			want:    0,
+			wantErr: assert.Error,
+		},
 	}
 
 	for _, tt := range tests {
@@ -146,6 +232,7 @@ func TestUsecase_NewGame(t *testing.T) {
 
 			repo := mocks.NewRepository(t)
 			pano := mocks.NewPanoramaUsecase(t)
+			ws := mocks.NewBroadcaster(t)
 			cfg := multiplayer.Config{
 				RoundStartDelay: 5 * time.Second,
 			}
@@ -156,7 +243,7 @@ func TestUsecase_NewGame(t *testing.T) {
 			}
 			tt.setup(fs, tt.args)
 
-			uc := multiplayer.NewUsecase(cfg, repo, pano)
+			uc := multiplayer.NewUsecase(cfg, repo, pano, ws)
 
 			got, err := uc.NewGame(t.Context(), tt.args.req)
 			tt.wantErr(t, err)
@@ -253,13 +340,14 @@ func TestUsecase_GetGame(t *testing.T) {
 
 			repo := mocks.NewRepository(t)
 			pano := mocks.NewPanoramaUsecase(t)
+			ws := mocks.NewBroadcaster(t)
 			fs := fields{
 				repo: repo,
 				pano: pano,
 			}
 			tt.setup(fs, tt.args)
 
-			uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano)
+			uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano, ws)
 
 			got, err := uc.GetGame(t.Context(), tt.args.gameID, tt.args.userID)
 			tt.wantErr(t, err)
@@ -295,6 +383,7 @@ func TestUsecase_EndGame(t *testing.T) {
 	type fields struct {
 		repo *mocks.Repository
 		pano *mocks.PanoramaUsecase
+		ws   *mocks.Broadcaster
 	}
 
 	type args struct {
@@ -346,6 +435,17 @@ func TestUsecase_EndGame(t *testing.T) {
 					GameID:      createdGameID,
 				}).
 					Return(nil)
+
+				fs.ws.On("Broadcast", "1", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageGameFinished,
+					Payload: map[string]any{
+						"standings": []multiplayerEntity.Standing{
+							{Place: 1, UserID: 1, Username: "username1"},
+							{Place: 1, UserID: 2, Username: "username2"},
+						},
+						"guesses": gameGuesses,
+					},
+				}).Return(nil)
 			},
 			want:    gameGuesses,
 			wantErr: assert.NoError,
@@ -425,13 +525,15 @@ func TestUsecase_EndGame(t *testing.T) {
 
 			repo := mocks.NewRepository(t)
 			pano := mocks.NewPanoramaUsecase(t)
+			ws := mocks.NewBroadcaster(t)
 			fs := fields{
 				repo: repo,
 				pano: pano,
+				ws:   ws,
 			}
 			tt.setup(fs, tt.args)
 
-			uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano)
+			uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano, ws)
 
 			guesses, err := uc.EndGame(t.Context(), tt.args.req)
 			tt.wantErr(t, err)
@@ -440,6 +542,219 @@ func TestUsecase_EndGame(t *testing.T) {
 	}
 }
 
+func TestUsecase_GetGameGuesses(t *testing.T) {
+	t.Parallel()
+
+	requestTime := time.Now().UTC()
+
+	spectatedGame := multiplayerEntity.Game{
+		ID:                    123,
+		RoundCurrent:          2,
+		Rounds:                5,
+		Spectators:            true,
+		SpectatorDelaySeconds: 30,
+	}
+
+	users := []user.MultiplayerUser{
+		{PublicProfile: user.PublicProfile{ID: 1, Username: "username1"}},
+		{PublicProfile: user.PublicProfile{ID: 2, Username: "username2"}},
+	}
+
+	guesses := []multiplayerEntity.Guess{
+		{RoundNum: 1, UserID: 1, Score: 3000, RoundEndedAt: requestTime.Add(-2 * time.Minute)},
+		{RoundNum: 1, UserID: 2, Score: 2000, RoundEndedAt: requestTime.Add(-2 * time.Minute)},
+		{RoundNum: 2, UserID: 1, Score: 4000, RoundEndedAt: requestTime.Add(-10 * time.Second)},
+	}
+
+	type fields struct {
+		repo *mocks.Repository
+	}
+
+	type args struct {
+		req dto.GetMultiplayerGameGuessesRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		want    []multiplayerEntity.Guess
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "player gets guesses of finished rounds",
+			args: args{req: dto.GetMultiplayerGameGuessesRequest{
+				RequestTime: requestTime,
+				GameID:      spectatedGame.ID,
+				UserID:      1,
+			}},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(spectatedGame, nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return(users, nil)
+
+				fs.repo.On("GetMultiplayerGameGuesses", mock.Anything, args.req.GameID).
+					Return(slices.Clone(guesses), nil)
+			},
+			want:    guesses,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "spectator doesn't get guesses of the round during the spectator delay",
+			args: args{req: dto.GetMultiplayerGameGuessesRequest{
+				RequestTime: requestTime,
+				GameID:      spectatedGame.ID,
+				UserID:      333,
+			}},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(spectatedGame, nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return(users, nil)
+
+				fs.repo.On("GetMultiplayerGameGuesses", mock.Anything, args.req.GameID).
+					Return(slices.Clone(guesses), nil)
+			},
+			want:    guesses[:2],
+			wantErr: assert.NoError,
+		},
+		{
+			name: "spectator doesn't get guesses of earlier rounds during their spectator delay",
+			args: args{req: dto.GetMultiplayerGameGuessesRequest{
+				RequestTime: requestTime.Add(-115 * time.Second),
+				GameID:      spectatedGame.ID,
+				UserID:      333,
+			}},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(spectatedGame, nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return(users, nil)
+
+				fs.repo.On("GetMultiplayerGameGuesses", mock.Anything, args.req.GameID).
+					Return(slices.Clone(guesses), nil)
+			},
+			want:    []multiplayerEntity.Guess{},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "spectator gets guesses of the round after the spectator delay",
+			args: args{req: dto.GetMultiplayerGameGuessesRequest{
+				RequestTime: requestTime.Add(time.Minute),
+				GameID:      spectatedGame.ID,
+				UserID:      333,
+			}},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(spectatedGame, nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return(users, nil)
+
+				fs.repo.On("GetMultiplayerGameGuesses", mock.Anything, args.req.GameID).
+					Return(slices.Clone(guesses), nil)
+			},
+			want:    guesses,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "user outside of the game can't get guesses of a game without spectators",
+			args: args{req: dto.GetMultiplayerGameGuessesRequest{
+				RequestTime: requestTime,
+				GameID:      spectatedGame.ID,
+				UserID:      333,
+			}},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				privateGame := spectatedGame
+				privateGame.Spectators = false
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(privateGame, nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return(users, nil)
+
+				fs.repo.On("GetMultiplayerGameGuesses", mock.Anything, args.req.GameID).
+					Return(slices.Clone(guesses), nil)
+			},
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, multiplayerEntity.ErrGameWrongUserID)
+			},
+		},
+		{
+			name: "game not found",
+			args: args{req: dto.GetMultiplayerGameGuessesRequest{
+				RequestTime: requestTime,
+				GameID:      404,
+				UserID:      1,
+			}},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(multiplayerEntity.Game{}, multiplayerEntity.ErrGameNotFound)
+			},
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, multiplayerEntity.ErrGameNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			pano := mocks.NewPanoramaUsecase(t)
+			ws := mocks.NewBroadcaster(t)
+			fs := fields{
+				repo: repo,
+			}
+			tt.setup(fs, tt.args)
+
+			uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano, ws)
+
+			got, err := uc.GetGameGuesses(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestUsecase_GameUser(t *testing.T) {
 	t.Parallel()
 
@@ -500,13 +815,14 @@ func TestUsecase_GameUser(t *testing.T) {
 
 			repo := mocks.NewRepository(t)
 			pano := mocks.NewPanoramaUsecase(t)
+			ws := mocks.NewBroadcaster(t)
 			fs := fields{
 				repo: repo,
 				pano: pano,
 			}
 			tt.setup(fs, tt.args)
 
-			uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano)
+			uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano, ws)
 
 			got, err := uc.GetGameUser(t.Context(), tt.args.userID, tt.args.gameID)
 			tt.wantErr(t, err)
@@ -567,13 +883,14 @@ func TestUsecase_GameUsers(t *testing.T) {
 
 			repo := mocks.NewRepository(t)
 			pano := mocks.NewPanoramaUsecase(t)
+			ws := mocks.NewBroadcaster(t)
 			fs := fields{
 				repo: repo,
 				pano: pano,
 			}
 			tt.setup(fs, tt.args)
 
-			uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano)
+			uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano, ws)
 
 			got, err := uc.GetGameUsers(t.Context(), tt.args.gameID)
 			tt.wantErr(t, err)
@@ -5,7 +5,9 @@ package mocks
 import (
 	context "context"
 
+	dto "github.com/VasySS/segoya-backend/internal/dto"
 	game "github.com/VasySS/segoya-backend/internal/entity/game"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -14,32 +16,22 @@ type PanoramaUsecase struct {
 	mock.Mock
 }
 
-// CalculateScoreAndDistance provides a mock function with given fields: provider, realLat, realLng, userLat, userLng
-func (_m *PanoramaUsecase) CalculateScoreAndDistance(provider game.PanoramaProvider, realLat float64, realLng float64, userLat float64, userLng float64) (int, int) {
-	ret := _m.Called(provider, realLat, realLng, userLat, userLng)
+// CalculateScore provides a mock function with given fields: req
+func (_m *PanoramaUsecase) CalculateScore(req dto.ScoreRequest) game.Score {
+	ret := _m.Called(req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CalculateScoreAndDistance")
-	}
-
-	var r0 int
-	var r1 int
-	if rf, ok := ret.Get(0).(func(game.PanoramaProvider, float64, float64, float64, float64) (int, int)); ok {
-		return rf(provider, realLat, realLng, userLat, userLng)
-	}
-	if rf, ok := ret.Get(0).(func(game.PanoramaProvider, float64, float64, float64, float64) int); ok {
-		r0 = rf(provider, realLat, realLng, userLat, userLng)
-	} else {
-		r0 = ret.Get(0).(int)
+		panic("no return value specified for CalculateScore")
 	}
 
-	if rf, ok := ret.Get(1).(func(game.PanoramaProvider, float64, float64, float64, float64) int); ok {
-		r1 = rf(provider, realLat, realLng, userLat, userLng)
+	var r0 game.Score
+	if rf, ok := ret.Get(0).(func(dto.ScoreRequest) game.Score); ok {
+		r0 = rf(req)
 	} else {
-		r1 = ret.Get(1).(int)
+		r0 = ret.Get(0).(game.Score)
 	}
 
-	return r0, r1
+	return r0
 }
 
 // GetStreetview provides a mock function with given fields: ctx, provider, id
@@ -70,9 +62,9 @@ func (_m *PanoramaUsecase) GetStreetview(ctx context.Context, provider game.Pano
 	return r0, r1
 }
 
-// NewStreetview provides a mock function with given fields: ctx, provider
-func (_m *PanoramaUsecase) NewStreetview(ctx context.Context, provider game.PanoramaProvider) (game.PanoramaMetadata, error) {
-	ret := _m.Called(ctx, provider)
+// NewStreetview provides a mock function with given fields: ctx, req
+func (_m *PanoramaUsecase) NewStreetview(ctx context.Context, req dto.NewStreetviewRequest) (game.PanoramaMetadata, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for NewStreetview")
@@ -80,17 +72,63 @@ func (_m *PanoramaUsecase) NewStreetview(ctx context.Context, provider game.Pano
 
 	var r0 game.PanoramaMetadata
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, game.PanoramaProvider) (game.PanoramaMetadata, error)); ok {
-		return rf(ctx, provider)
+	if rf, ok := ret.Get(0).(func(context.Context, dto.NewStreetviewRequest) (game.PanoramaMetadata, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, game.PanoramaProvider) game.PanoramaMetadata); ok {
-		r0 = rf(ctx, provider)
+	if rf, ok := ret.Get(0).(func(context.Context, dto.NewStreetviewRequest) game.PanoramaMetadata); ok {
+		r0 = rf(ctx, req)
 	} else {
 		r0 = ret.Get(0).(game.PanoramaMetadata)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, game.PanoramaProvider) error); ok {
-		r1 = rf(ctx, provider)
+	if rf, ok := ret.Get(1).(func(context.Context, dto.NewStreetviewRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Place provides a mock function with given fields: location
+func (_m *PanoramaUsecase) Place(location game.LatLng) game.Place {
+	ret := _m.Called(location)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Place")
+	}
+
+	var r0 game.Place
+	if rf, ok := ret.Get(0).(func(game.LatLng) game.Place); ok {
+		r0 = rf(location)
+	} else {
+		r0 = ret.Get(0).(game.Place)
+	}
+
+	return r0
+}
+
+// ScoreDistance provides a mock function with given fields: ctx, req
+func (_m *PanoramaUsecase) ScoreDistance(ctx context.Context, req dto.ScoreDistanceRequest) (float64, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScoreDistance")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ScoreDistanceRequest) (float64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ScoreDistanceRequest) float64); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.ScoreDistanceRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
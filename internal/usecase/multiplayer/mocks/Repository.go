@@ -20,6 +20,42 @@ type Repository struct {
 	mock.Mock
 }
 
+// DamageMultiplayerGameUsers provides a mock function with given fields: ctx, req
+func (_m *Repository) DamageMultiplayerGameUsers(ctx context.Context, req dto.DamageMultiplayerUsersRequestDB) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DamageMultiplayerGameUsers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.DamageMultiplayerUsersRequestDB) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EliminateMultiplayerGameUsers provides a mock function with given fields: ctx, req
+func (_m *Repository) EliminateMultiplayerGameUsers(ctx context.Context, req dto.EliminateMultiplayerUsersRequestDB) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EliminateMultiplayerGameUsers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.EliminateMultiplayerUsersRequestDB) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // EndMultiplayerGame provides a mock function with given fields: ctx, req
 func (_m *Repository) EndMultiplayerGame(ctx context.Context, req dto.EndMultiplayerGameRequestDB) error {
 	ret := _m.Called(ctx, req)
@@ -114,6 +150,36 @@ func (_m *Repository) GetMultiplayerGameGuesses(ctx context.Context, gameID int)
 	return r0, r1
 }
 
+// GetMultiplayerGameLocationIDs provides a mock function with given fields: ctx, gameID
+func (_m *Repository) GetMultiplayerGameLocationIDs(ctx context.Context, gameID int) ([]int, error) {
+	ret := _m.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMultiplayerGameLocationIDs")
+	}
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]int, error)); ok {
+		return rf(ctx, gameID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []int); ok {
+		r0 = rf(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMultiplayerGameUser provides a mock function with given fields: ctx, userID, gameID
 func (_m *Repository) GetMultiplayerGameUser(ctx context.Context, userID int, gameID int) (user.MultiplayerUser, error) {
 	ret := _m.Called(ctx, userID, gameID)
@@ -230,6 +296,64 @@ func (_m *Repository) GetMultiplayerRoundGuesses(ctx context.Context, roundID in
 	return r0, r1
 }
 
+// GetUnfinishedMultiplayerRounds provides a mock function with given fields: ctx
+func (_m *Repository) GetUnfinishedMultiplayerRounds(ctx context.Context) ([]gamemultiplayer.GameRound, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnfinishedMultiplayerRounds")
+	}
+
+	var r0 []gamemultiplayer.GameRound
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]gamemultiplayer.GameRound, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []gamemultiplayer.GameRound); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]gamemultiplayer.GameRound)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserByID provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetUserByID(ctx context.Context, userID int) (user.PrivateProfile, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 user.PrivateProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (user.PrivateProfile, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) user.PrivateProfile); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(user.PrivateProfile)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LockMultiplayerGame provides a mock function with given fields: ctx, gameID
 func (_m *Repository) LockMultiplayerGame(ctx context.Context, gameID int) error {
 	ret := _m.Called(ctx, gameID)
@@ -0,0 +1,62 @@
+package multiplayer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
+)
+
+// newStreetview returns a random panorama for the next round of the game (from the map, if the game is played on it),
+// that was not played in the game or recently by any of the players.
+func (uc Usecase) newStreetview(ctx context.Context, g multiplayer.Game) (game.PanoramaMetadata, error) {
+	users, err := uc.repo.GetMultiplayerGameUsers(ctx, g.ID)
+	if err != nil {
+		return game.PanoramaMetadata{}, fmt.Errorf("failed to get multiplayer game users: %w", err)
+	}
+
+	userIDs := make([]int, 0, len(users))
+	for _, u := range users {
+		userIDs = append(userIDs, u.ID)
+	}
+
+	playedIDs, err := uc.repo.GetMultiplayerGameLocationIDs(ctx, g.ID)
+	if err != nil {
+		return game.PanoramaMetadata{}, fmt.Errorf("failed to get played locations: %w", err)
+	}
+
+	return uc.pano.NewStreetview(ctx, dto.NewStreetviewRequest{
+		Provider:          g.Provider,
+		Providers:         g.Providers,
+		MapID:             g.MapID,
+		UserIDs:           userIDs,
+		PlayedLocationIDs: playedIDs,
+	})
+}
+
+// firstStreetview returns a random panorama for the first round of a new game,
+// that was not played recently by any of the connected players.
+func (uc Usecase) firstStreetview(ctx context.Context, req dto.NewMultiplayerGameRequest) (game.PanoramaMetadata, error) {
+	userIDs := make([]int, 0, len(req.ConnectedPlayers))
+	for _, u := range req.ConnectedPlayers {
+		userIDs = append(userIDs, u.ID)
+	}
+
+	return uc.pano.NewStreetview(ctx, dto.NewStreetviewRequest{
+		Provider:  game.PanoramaProvider(req.Provider),
+		Providers: req.Providers,
+		MapID:     req.MapID,
+		UserIDs:   userIDs,
+	})
+}
+
+// scoreDistance returns score distance of a new game, derived from the size of its played area.
+func (uc Usecase) scoreDistance(ctx context.Context, req dto.NewMultiplayerGameRequest) (float64, error) {
+	return uc.pano.ScoreDistance(ctx, dto.ScoreDistanceRequest{
+		Provider:  game.PanoramaProvider(req.Provider),
+		Providers: req.Providers,
+		MapID:     req.MapID,
+	})
+}
@@ -0,0 +1,293 @@
+package multiplayer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
+	"github.com/VasySS/segoya-backend/internal/entity/user"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/transport"
+)
+
+// roundResult contains everything that is sent to players after a round is ended.
+type roundResult struct {
+	gameID int
+	// spectators are sent the result after the spectator delay, if the game can be spectated
+	spectators     bool
+	spectatorDelay time.Duration
+	requestTime    time.Time
+	round          multiplayer.Round
+	guesses        []multiplayer.Guess
+	standings      []multiplayer.Standing
+	// teamStandings are only calculated for games with teams
+	teamStandings []multiplayer.TeamStanding
+	// eliminated are IDs of battle royale players eliminated after the round
+	eliminated []int
+	// damage is health lost by duel players after the round with the damage multiplier of the round
+	damage     []multiplayer.Damage
+	multiplier float64
+	// game is finished when the last round of the game is ended
+	gameFinished bool
+	gameGuesses  []multiplayer.Guess
+}
+
+// newRoundResult returns an empty result of a round of the game.
+func newRoundResult(g multiplayer.Game) roundResult {
+	return roundResult{
+		gameID:         g.ID,
+		spectators:     g.Spectators,
+		spectatorDelay: g.SpectatorDelay(),
+	}
+}
+
+// endRound ends the round, applies its results to players by the rules of the game mode
+// and calculates cumulative standings of the game.
+// If the round is the last one, the game is ended as well.
+// It must be called inside a transaction after the game is locked.
+func (uc Usecase) endRound(
+	ctx context.Context,
+	requestTime time.Time,
+	g multiplayer.Game,
+	r multiplayer.Round,
+	guesses []multiplayer.Guess,
+) (roundResult, error) {
+	if err := uc.repo.EndMultiplayerRound(ctx, dto.EndMultiplayerRoundRequestDB{
+		RequestTime: requestTime,
+		RoundID:     r.ID,
+	}); err != nil {
+		return roundResult{}, fmt.Errorf("failed to update round end in repo: %w", err)
+	}
+
+	users, err := uc.repo.GetMultiplayerGameUsers(ctx, g.ID)
+	if err != nil {
+		return roundResult{}, fmt.Errorf("failed to get game users: %w", err)
+	}
+
+	result := newRoundResult(g)
+	result.requestTime = requestTime
+	result.round = r
+	result.guesses = guesses
+
+	rules := uc.rules(g)
+
+	if !g.Finished {
+		users, err = rules.applyRound(ctx, &result, users)
+		if err != nil {
+			return roundResult{}, err
+		}
+	}
+
+	result.standings = multiplayer.NewStandings(users)
+
+	if g.Finished || !rules.gameOver(g, r, users) {
+		if !g.TeamGame() {
+			return result, nil
+		}
+
+		gameGuesses, err := uc.repo.GetMultiplayerGameGuesses(ctx, g.ID)
+		if err != nil {
+			return roundResult{}, fmt.Errorf("failed to get game guesses: %w", err)
+		}
+
+		result.teamStandings = multiplayer.NewTeamStandings(users, gameGuesses, g.TeamScoring)
+
+		return result, nil
+	}
+
+	gameGuesses, err := uc.finishGame(ctx, requestTime, g.ID)
+	if err != nil {
+		return roundResult{}, err
+	}
+
+	result.gameFinished = true
+	result.gameGuesses = gameGuesses
+	result.teamStandings = multiplayer.NewTeamStandings(users, gameGuesses, g.TeamScoring)
+
+	return result, nil
+}
+
+// eliminateUsers eliminates players of a battle royale game in the given round and returns updated game users.
+func (uc Usecase) eliminateUsers(
+	ctx context.Context,
+	requestTime time.Time,
+	gameID, roundNum int,
+	userIDs []int,
+) ([]user.MultiplayerUser, error) {
+	if err := uc.repo.EliminateMultiplayerGameUsers(ctx, dto.EliminateMultiplayerUsersRequestDB{
+		RequestTime: requestTime,
+		GameID:      gameID,
+		RoundNum:    roundNum,
+		UserIDs:     userIDs,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to eliminate users: %w", err)
+	}
+
+	users, err := uc.repo.GetMultiplayerGameUsers(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game users: %w", err)
+	}
+
+	return users, nil
+}
+
+// finishGame ends the game and returns all guesses made during it.
+func (uc Usecase) finishGame(ctx context.Context, requestTime time.Time, gameID int) ([]multiplayer.Guess, error) {
+	if err := uc.repo.EndMultiplayerGame(ctx, dto.EndMultiplayerGameRequestDB{
+		RequestTime: requestTime,
+		GameID:      gameID,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to update game end in repo: %w", err)
+	}
+
+	gameGuesses, err := uc.repo.GetMultiplayerGameGuesses(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game guesses: %w", err)
+	}
+
+	return gameGuesses, nil
+}
+
+// broadcastRoundResult notifies all players in a game that the round has ended,
+// reveals the round location and sends final rankings if the game has ended too.
+func (uc Usecase) broadcastRoundResult(res roundResult) {
+	uc.rounds.cancel(res.gameID)
+	uc.broadcastResults(res, roundResultMessages(res)...)
+}
+
+// broadcastGameFinished notifies all players in a game about final rankings.
+func (uc Usecase) broadcastGameFinished(res roundResult) {
+	uc.broadcastResults(res, gameFinishedMessage(res))
+}
+
+// roundResultMessages returns messages about the ended round in the order they are sent to players:
+// round results, players eliminated or damaged after the round and final rankings if the game has ended.
+func roundResultMessages(res roundResult) []transport.WebSocketMessageOutput {
+	payload := map[string]any{
+		"roundNum":  res.round.RoundNum,
+		"location":  res.round.Location(),
+		"guesses":   res.guesses,
+		"standings": res.standings,
+	}
+
+	if res.teamStandings != nil {
+		payload["teamStandings"] = res.teamStandings
+	}
+
+	messages := []transport.WebSocketMessageOutput{{
+		Type:    dto.MultiplayerMessageRoundFinished,
+		Payload: payload,
+	}}
+
+	if len(res.eliminated) != 0 {
+		messages = append(messages, usersEliminatedMessage(res))
+	}
+
+	if len(res.damage) != 0 {
+		messages = append(messages, usersDamagedMessage(res))
+	}
+
+	if res.gameFinished {
+		messages = append(messages, gameFinishedMessage(res))
+	}
+
+	return messages
+}
+
+// usersEliminatedMessage returns a message about players of a battle royale game eliminated in the round.
+func usersEliminatedMessage(res roundResult) transport.WebSocketMessageOutput {
+	return transport.WebSocketMessageOutput{
+		Type: dto.MultiplayerMessageUsersEliminated,
+		Payload: map[string]any{
+			"roundNum": res.round.RoundNum,
+			"userIDs":  res.eliminated,
+		},
+	}
+}
+
+// usersDamagedMessage returns a message about health lost by players of a duel game after the round.
+func usersDamagedMessage(res roundResult) transport.WebSocketMessageOutput {
+	return transport.WebSocketMessageOutput{
+		Type: dto.MultiplayerMessageUsersDamaged,
+		Payload: map[string]any{
+			"roundNum":   res.round.RoundNum,
+			"multiplier": res.multiplier,
+			"damage":     res.damage,
+		},
+	}
+}
+
+// gameFinishedMessage returns a message with final rankings of the game.
+func gameFinishedMessage(res roundResult) transport.WebSocketMessageOutput {
+	payload := map[string]any{
+		"standings": res.standings,
+		"guesses":   res.gameGuesses,
+	}
+
+	if res.teamStandings != nil {
+		payload["teamStandings"] = res.teamStandings
+	}
+
+	return transport.WebSocketMessageOutput{
+		Type:    dto.MultiplayerMessageGameFinished,
+		Payload: payload,
+	}
+}
+
+// broadcastResults sends messages with results of the game to its players and spectators.
+func (uc Usecase) broadcastResults(res roundResult, messages ...transport.WebSocketMessageOutput) {
+	for _, message := range messages {
+		if err := uc.ws.Broadcast(strconv.Itoa(res.gameID), message); err != nil {
+			slog.Error("error broadcasting results",
+				slog.Int("gameID", res.gameID),
+				slog.String("type", string(message.Type)),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	if res.spectators {
+		uc.sendSpectators(res.gameID, res.spectatorDelay, messages...)
+	}
+}
+
+// sendSpectators sends messages to spectators of the game after its spectator delay, so that they can't pass
+// anything to players. Messages are sent together by a single timer, so that they keep the order of the messages
+// to players.
+func (uc Usecase) sendSpectators(gameID int, delay time.Duration, messages ...transport.WebSocketMessageOutput) {
+	if len(messages) == 0 {
+		return
+	}
+
+	send := func() {
+		for _, message := range messages {
+			uc.broadcastSpectators(gameID, message)
+		}
+	}
+
+	if delay <= 0 {
+		send()
+		return
+	}
+
+	key := spectatorMessageKey{
+		gameID: gameID,
+		seq:    uc.spectatorSeq.Add(1),
+	}
+
+	uc.spectators.schedule(key, delay, send)
+}
+
+// broadcastSpectators sends a message to spectators of the game.
+func (uc Usecase) broadcastSpectators(gameID int, message transport.WebSocketMessageOutput) {
+	if err := uc.ws.Broadcast(dto.MultiplayerSpectatorsBroadcastID(gameID), message); err != nil {
+		slog.Error("error broadcasting to spectators",
+			slog.Int("gameID", gameID),
+			slog.String("type", string(message.Type)),
+			slog.Any("error", err),
+		)
+	}
+}
@@ -4,12 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
 	"github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/transport"
 )
 
 // NewRound creates a new multiplayer game round or returns an existing one if it's not finished.
+// Battle royale games get new rounds until they are finished.
+// Panorama of the new round is resolved before the game is locked, as it may be located by an external provider.
+// Unfinished round is scheduled to be finished by the server when its timer runs out
+// (after the round is committed, so that the timer never finishes a round that doesn't exist).
+// Spectators of the game are notified about the new round, so that they can request the game state with it.
 func (uc Usecase) NewRound(
 	ctx context.Context,
 	req dto.NewMultiplayerRoundRequest,
@@ -17,65 +25,156 @@ func (uc Usecase) NewRound(
 	ctx, span := uc.tracer.Start(ctx, "NewRound")
 	defer span.End()
 
-	var response multiplayer.Round
+	next, err := uc.nextRoundPanorama(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		return multiplayer.Round{}, fmt.Errorf("failed to create round: %w", err)
+	}
 
-	err := uc.repo.RunTx(ctx, func(ctx context.Context) error {
-		if err := uc.repo.LockMultiplayerGame(ctx, req.GameID); err != nil {
-			return fmt.Errorf("failed to lock game: %w", err)
-		}
+	var (
+		response multiplayer.Round
+		game     multiplayer.Game
+		created  bool
+	)
 
-		if err := uc.isUserInGame(ctx, req.UserID, req.GameID); err != nil {
-			return err
-		}
+	err = uc.repo.RunTx(ctx, func(ctx context.Context) error {
+		var err error
 
-		game, err := uc.repo.GetMultiplayerGame(ctx, req.GameID)
-		if err != nil {
-			return fmt.Errorf("failed to get multiplayer game: %w", err)
-		}
+		game, response, created, err = uc.newRound(ctx, req, next)
 
-		if existingRound, err := uc.repo.GetMultiplayerRound(ctx, game.ID, game.RoundCurrent); err == nil {
-			newRoundDelayEnd := existingRound.EndedAt.Add(uc.cfg.RoundEndDelay)
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		return multiplayer.Round{}, fmt.Errorf("failed to create round: %w", err)
+	}
 
-			if !existingRound.Finished || req.RequestTime.Before(newRoundDelayEnd) {
-				response = existingRound
-				return nil
-			}
-		} else if !errors.Is(err, multiplayer.ErrRoundNotFound) {
-			return fmt.Errorf("failed to get current round: %w", err)
-		}
+	uc.startRound(game, response, created)
 
-		if game.RoundCurrent != 0 && game.RoundCurrent == game.Rounds {
-			return multiplayer.ErrRoundMaxAmount
-		}
+	return response, nil
+}
 
-		pano, err := uc.pano.NewStreetview(ctx, game.Provider)
-		if err != nil {
-			return fmt.Errorf("failed to create panorama: %w", err)
-		}
+// roundPanorama is a panorama resolved for the round with RoundNum before the game is locked.
+type roundPanorama struct {
+	RoundNum int
+	Panorama game.PanoramaMetadata
+}
 
-		dbReq := dto.NewMultiplayerRoundRequestDB{
-			GameID:     game.ID,
-			LocationID: pano.ID,
-			RoundNum:   game.RoundCurrent + 1,
-			CreatedAt:  req.RequestTime,
-			StartedAt:  req.RequestTime.Add(uc.cfg.RoundStartDelay),
-		}
+// nextRoundPanorama resolves panorama of the next round of the game without locking it,
+// it returns an empty roundPanorama if the game doesn't need a new round.
+func (uc Usecase) nextRoundPanorama(
+	ctx context.Context,
+	req dto.NewMultiplayerRoundRequest,
+) (roundPanorama, error) {
+	if err := uc.isUserInGame(ctx, req.UserID, req.GameID); err != nil {
+		return roundPanorama{}, err
+	}
 
-		round, err := uc.repo.NewMultiplayerRound(ctx, dbReq)
-		if err != nil {
-			return fmt.Errorf("failed to create round: %w", err)
-		}
+	game, err := uc.repo.GetMultiplayerGame(ctx, req.GameID)
+	if err != nil {
+		return roundPanorama{}, fmt.Errorf("failed to get multiplayer game: %w", err)
+	}
 
-		response = round
+	_, needed, err := uc.roundNeeded(ctx, game, req.RequestTime)
+	if err != nil || !needed {
+		return roundPanorama{}, err
+	}
 
-		return nil
-	})
+	pano, err := uc.newStreetview(ctx, game)
 	if err != nil {
-		span.RecordError(err)
-		return multiplayer.Round{}, fmt.Errorf("failed to create round: %w", err)
+		return roundPanorama{}, fmt.Errorf("failed to create panorama: %w", err)
 	}
 
-	return response, nil
+	return roundPanorama{RoundNum: game.RoundCurrent + 1, Panorama: pano}, nil
+}
+
+// newRound creates a new round with the resolved panorama or returns the current one within the transaction
+// of ctx, it returns true if the round was created. The round must be started with startRound after the
+// transaction is committed.
+// Current round is returned if the panorama was resolved for another round (the game was changed
+// after it was resolved), so that the client requests the round again.
+func (uc Usecase) newRound(
+	ctx context.Context,
+	req dto.NewMultiplayerRoundRequest,
+	next roundPanorama,
+) (multiplayer.Game, multiplayer.Round, bool, error) {
+	if err := uc.repo.LockMultiplayerGame(ctx, req.GameID); err != nil {
+		return multiplayer.Game{}, multiplayer.Round{}, false, fmt.Errorf("failed to lock game: %w", err)
+	}
+
+	if err := uc.isUserInGame(ctx, req.UserID, req.GameID); err != nil {
+		return multiplayer.Game{}, multiplayer.Round{}, false, err
+	}
+
+	game, err := uc.repo.GetMultiplayerGame(ctx, req.GameID)
+	if err != nil {
+		return multiplayer.Game{}, multiplayer.Round{}, false, fmt.Errorf("failed to get multiplayer game: %w", err)
+	}
+
+	current, needed, err := uc.roundNeeded(ctx, game, req.RequestTime)
+	if err != nil {
+		return multiplayer.Game{}, multiplayer.Round{}, false, err
+	}
+
+	if !needed || next.RoundNum != game.RoundCurrent+1 {
+		return game, current, false, nil
+	}
+
+	dbReq := dto.NewMultiplayerRoundRequestDB{
+		GameID:     game.ID,
+		LocationID: next.Panorama.ID,
+		Provider:   next.Panorama.Provider,
+		RoundNum:   next.RoundNum,
+		CreatedAt:  req.RequestTime,
+		StartedAt:  req.RequestTime.Add(uc.cfg.RoundStartDelay),
+	}
+
+	round, err := uc.repo.NewMultiplayerRound(ctx, dbReq)
+	if err != nil {
+		return multiplayer.Game{}, multiplayer.Round{}, false, fmt.Errorf("failed to create round: %w", err)
+	}
+
+	return game, round, true, nil
+}
+
+// roundNeeded returns the current round of the game and whether a new round has to be created instead of it.
+func (uc Usecase) roundNeeded(
+	ctx context.Context,
+	game multiplayer.Game,
+	requestTime time.Time,
+) (multiplayer.Round, bool, error) {
+	existingRound, err := uc.repo.GetMultiplayerRound(ctx, game.ID, game.RoundCurrent)
+	if err == nil {
+		newRoundDelayEnd := existingRound.EndedAt.Add(uc.cfg.RoundEndDelay)
+
+		if !existingRound.Finished || requestTime.Before(newRoundDelayEnd) {
+			return existingRound, false, nil
+		}
+	} else if !errors.Is(err, multiplayer.ErrRoundNotFound) {
+		return multiplayer.Round{}, false, fmt.Errorf("failed to get current round: %w", err)
+	}
+
+	if game.RoundCurrent != 0 && !game.RoundsLeft() {
+		return multiplayer.Round{}, false, multiplayer.ErrRoundMaxAmount
+	}
+
+	return existingRound, true, nil
+}
+
+// startRound schedules the end of the round by its timer and notifies spectators about the created round
+// (after the spectator delay, like every message to spectators).
+func (uc Usecase) startRound(game multiplayer.Game, round multiplayer.Round, created bool) {
+	uc.scheduleRoundEnd(game, round)
+
+	if created && game.Spectators {
+		uc.sendSpectators(game.ID, game.SpectatorDelay(), transport.WebSocketMessageOutput{
+			Type: dto.MultiplayerMessageRoundStarted,
+			Payload: map[string]any{
+				"roundNum":  round.RoundNum,
+				"startedAt": round.StartedAt,
+			},
+		})
+	}
 }
 
 // GetRound returns current multiplayer game round by game ID.
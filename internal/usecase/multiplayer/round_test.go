@@ -10,6 +10,7 @@ import (
 	multiplayerEntity "github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
 	"github.com/VasySS/segoya-backend/internal/entity/user"
 	"github.com/VasySS/segoya-backend/internal/infrastructure/repository"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/transport"
 	"github.com/VasySS/segoya-backend/internal/usecase/multiplayer"
 	"github.com/VasySS/segoya-backend/internal/usecase/multiplayer/mocks"
 	"github.com/stretchr/testify/assert"
@@ -41,6 +42,7 @@ func TestUsecase_NewRound(t *testing.T) {
 		cfg  multiplayer.Config
 		repo *mocks.Repository
 		pano *mocks.PanoramaUsecase
+		ws   *mocks.Broadcaster
 	}
 
 	type args struct {
@@ -97,7 +99,14 @@ func TestUsecase_NewRound(t *testing.T) {
 						Finished: true,
 					}, nil)
 
-				fs.pano.On("NewStreetview", mock.Anything, game.PanoramaProvider("google")).
+				fs.repo.On("GetMultiplayerGameLocationIDs", mock.Anything, args.req.GameID).
+					Return([]int{4321}, nil)
+
+				fs.pano.On("NewStreetview", mock.Anything, dto.NewStreetviewRequest{
+					Provider:          game.GoogleProvider,
+					UserIDs:           []int{1, 456},
+					PlayedLocationIDs: []int{4321},
+				}).
 					Return(game.PanoramaMetadata{
 						ID:           createdPanoID,
 						StreetviewID: createdStreetviewID,
@@ -116,7 +125,7 @@ func TestUsecase_NewRound(t *testing.T) {
 			wantErr: assert.NoError,
 		},
 		{
-			name: "user trying to generate new round for a game he is not a participant of",
+			name: "new round is announced to spectators",
 			args: args{
 				req: newRoundReq,
 			},
@@ -129,6 +138,126 @@ func TestUsecase_NewRound(t *testing.T) {
 				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
 					Return(nil)
 
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return([]user.MultiplayerUser{
+						{PublicProfile: user.PublicProfile{ID: 456, Username: "username2"}},
+					}, nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(multiplayerEntity.Game{
+						ID:           args.req.GameID,
+						Provider:     "google",
+						Players:      1,
+						Rounds:       5,
+						RoundCurrent: 1,
+						Spectators:   true,
+					}, nil)
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, args.req.GameID, 1).
+					Return(multiplayerEntity.Round{
+						ID:       2,
+						RoundNum: 2,
+						Finished: true,
+					}, nil)
+
+				fs.repo.On("GetMultiplayerGameLocationIDs", mock.Anything, args.req.GameID).
+					Return([]int{4321}, nil)
+
+				fs.pano.On("NewStreetview", mock.Anything, dto.NewStreetviewRequest{
+					Provider:          game.GoogleProvider,
+					UserIDs:           []int{456},
+					PlayedLocationIDs: []int{4321},
+				}).
+					Return(game.PanoramaMetadata{
+						ID:           createdPanoID,
+						StreetviewID: createdStreetviewID,
+						LatLng:       game.LatLng{Lat: 12.34, Lng: 45.67},
+					}, nil)
+
+				fs.repo.On("NewMultiplayerRound", mock.Anything, dto.NewMultiplayerRoundRequestDB{
+					GameID:     args.req.GameID,
+					LocationID: createdPanoID,
+					RoundNum:   2,
+					CreatedAt:  args.req.RequestTime,
+					StartedAt:  args.req.RequestTime.Add(fs.cfg.RoundStartDelay),
+				}).Return(newRoundResp, nil)
+
+				fs.ws.On("Broadcast", "123:spectators", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageRoundStarted,
+					Payload: map[string]any{
+						"roundNum":  newRoundResp.RoundNum,
+						"startedAt": newRoundResp.StartedAt,
+					},
+				}).Return(nil)
+			},
+			want:    newRoundResp,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "round created by another request while panorama was resolved",
+			args: args{
+				req: newRoundReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return([]user.MultiplayerUser{
+						{PublicProfile: user.PublicProfile{ID: 456, Username: "username2"}},
+					}, nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(multiplayerEntity.Game{
+						ID:           args.req.GameID,
+						Provider:     "google",
+						Players:      1,
+						Rounds:       5,
+						RoundCurrent: 1,
+					}, nil).Once()
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, args.req.GameID, 1).
+					Return(multiplayerEntity.Round{
+						ID:       1,
+						RoundNum: 1,
+						Finished: true,
+					}, nil)
+
+				fs.repo.On("GetMultiplayerGameLocationIDs", mock.Anything, args.req.GameID).
+					Return([]int{4321}, nil)
+
+				fs.pano.On("NewStreetview", mock.Anything, mock.Anything).
+					Return(game.PanoramaMetadata{
+						ID:           createdPanoID,
+						StreetviewID: createdStreetviewID,
+					}, nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(multiplayerEntity.Game{
+						ID:           args.req.GameID,
+						Provider:     "google",
+						Players:      1,
+						Rounds:       5,
+						RoundCurrent: 2,
+					}, nil).Once()
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, args.req.GameID, 2).
+					Return(newRoundResp, nil)
+			},
+			want:    newRoundResp,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "user trying to generate new round for a game he is not a participant of",
+			args: args{
+				req: newRoundReq,
+			},
+			setup: func(fs fields, args args) {
 				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
 					Return([]user.MultiplayerUser{
 						{
@@ -152,14 +281,6 @@ func TestUsecase_NewRound(t *testing.T) {
 				req: newRoundReq,
 			},
 			setup: func(fs fields, args args) {
-				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
-					Return(func(ctx context.Context, fn repository.TxFunc) error {
-						return fn(ctx)
-					})
-
-				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
-					Return(nil)
-
 				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
 					Return([]user.MultiplayerUser{
 						{
@@ -255,6 +376,7 @@ func TestUsecase_NewRound(t *testing.T) {
 
 			repo := mocks.NewRepository(t)
 			pano := mocks.NewPanoramaUsecase(t)
+			ws := mocks.NewBroadcaster(t)
 			cfg := multiplayer.Config{
 				RoundStartDelay: 5 * time.Second,
 				RoundEndDelay:   10 * time.Second,
@@ -263,10 +385,11 @@ func TestUsecase_NewRound(t *testing.T) {
 				cfg:  cfg,
 				repo: repo,
 				pano: pano,
+				ws:   ws,
 			}
 			tt.setup(fs, tt.args)
 
-			uc := multiplayer.NewUsecase(cfg, repo, pano)
+			uc := multiplayer.NewUsecase(cfg, repo, pano, ws)
 
 			got, err := uc.NewRound(t.Context(), tt.args.req)
 			tt.wantErr(t, err)
@@ -388,13 +511,14 @@ func TestUsecase_GetRound(t *testing.T) {
 
 			repo := mocks.NewRepository(t)
 			pano := mocks.NewPanoramaUsecase(t)
+			ws := mocks.NewBroadcaster(t)
 			fs := fields{
 				repo: repo,
 				pano: pano,
 			}
 			tt.setup(fs, tt.args)
 
-			uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano)
+			uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano, ws)
 
 			got, err := uc.GetRound(t.Context(), tt.args.req)
 			tt.wantErr(t, err)
@@ -0,0 +1,180 @@
+package multiplayer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
+	"github.com/VasySS/segoya-backend/internal/entity/user"
+)
+
+// gameRules are rules of a multiplayer game mode, which decide when a round is over,
+// how its results affect players and when the game ends.
+type gameRules interface {
+	// roundDeadline returns the time when the round is finished by the server,
+	// false if the round has no timer (yet).
+	roundDeadline(g multiplayer.Game, r multiplayer.Round) (time.Time, bool)
+	// roundOver returns true if the round can be ended at the given time.
+	roundOver(g multiplayer.Game, r multiplayer.Round, users []user.MultiplayerUser, requestTime time.Time) bool
+	// applyRound applies results of the ended round to players (e.g. eliminates or damages them),
+	// saves them to the result and returns updated game users.
+	// It must be called inside a transaction after the game is locked.
+	applyRound(ctx context.Context, res *roundResult, users []user.MultiplayerUser) ([]user.MultiplayerUser, error)
+	// gameOver returns true if the game ends after the round.
+	gameOver(g multiplayer.Game, r multiplayer.Round, users []user.MultiplayerUser) bool
+}
+
+// rules returns rules of the game mode.
+func (uc Usecase) rules(g multiplayer.Game) gameRules {
+	switch g.Mode {
+	case multiplayer.BattleRoyaleMode:
+		return battleRoyaleRules{uc: uc}
+	case multiplayer.DuelMode:
+		return duelRules{uc: uc, teamScoring: g.TeamScoring}
+	default:
+		return classicRules{}
+	}
+}
+
+// classicRules are rules of classic games - scores are summed across a fixed amount of rounds.
+type classicRules struct{}
+
+func (classicRules) roundDeadline(g multiplayer.Game, r multiplayer.Round) (time.Time, bool) {
+	if g.TimerSeconds == 0 {
+		return time.Time{}, false
+	}
+
+	return r.StartedAt.Add(time.Second * time.Duration(g.TimerSeconds)), true
+}
+
+// roundOver returns true if the timer has run out or every player has guessed,
+// rounds without timer can be ended at any time.
+func (c classicRules) roundOver(
+	g multiplayer.Game,
+	r multiplayer.Round,
+	users []user.MultiplayerUser,
+	requestTime time.Time,
+) bool {
+	deadline, ok := c.roundDeadline(g, r)
+
+	return !ok || !requestTime.Before(deadline) || r.GuessesCount == multiplayer.AlivePlayers(users)
+}
+
+func (classicRules) applyRound(
+	_ context.Context,
+	_ *roundResult,
+	users []user.MultiplayerUser,
+) ([]user.MultiplayerUser, error) {
+	return users, nil
+}
+
+func (classicRules) gameOver(g multiplayer.Game, r multiplayer.Round, _ []user.MultiplayerUser) bool {
+	return r.RoundNum >= g.Rounds
+}
+
+// battleRoyaleRules are rules of battle royale games - lowest-scoring players of each round are eliminated,
+// the game continues until one player remains.
+type battleRoyaleRules struct {
+	classicRules
+
+	uc Usecase
+}
+
+func (b battleRoyaleRules) applyRound(
+	ctx context.Context,
+	res *roundResult,
+	users []user.MultiplayerUser,
+) ([]user.MultiplayerUser, error) {
+	res.eliminated = multiplayer.RoundLosers(users, res.guesses)
+	if len(res.eliminated) == 0 {
+		return users, nil
+	}
+
+	return b.uc.eliminateUsers(ctx, res.requestTime, res.gameID, res.round.RoundNum, res.eliminated)
+}
+
+func (battleRoyaleRules) gameOver(_ multiplayer.Game, _ multiplayer.Round, users []user.MultiplayerUser) bool {
+	return multiplayer.AlivePlayers(users) <= 1
+}
+
+// duelSides is the amount of players (or teams) in a duel.
+const duelSides = 2
+
+// duelRules are rules of duel games - players lose health by the score difference with the best guess
+// of each round (with a multiplier growing every round), the game continues until someone runs out of health.
+// Members of a team share the health, as every one of them receives the damage of the team.
+type duelRules struct {
+	uc          Usecase
+	teamScoring multiplayer.TeamScoring
+}
+
+// roundDeadline returns the time when the round timer runs out, the timer starts after the first guess.
+// Game timer (if it is set) limits the round too.
+func (d duelRules) roundDeadline(g multiplayer.Game, r multiplayer.Round) (time.Time, bool) {
+	deadline, ok := classicRules{}.roundDeadline(g, r)
+
+	if !r.FirstGuessAt.IsZero() {
+		guessDeadline := r.FirstGuessAt.Add(d.uc.cfg.DuelGuessTimer)
+		if !ok || guessDeadline.Before(deadline) {
+			deadline, ok = guessDeadline, true
+		}
+	}
+
+	return deadline, ok
+}
+
+// roundOver returns true if the timer has run out or every player has guessed.
+func (d duelRules) roundOver(
+	g multiplayer.Game,
+	r multiplayer.Round,
+	users []user.MultiplayerUser,
+	requestTime time.Time,
+) bool {
+	deadline, ok := d.roundDeadline(g, r)
+
+	return (ok && !requestTime.Before(deadline)) || r.GuessesCount == len(users)
+}
+
+func (d duelRules) applyRound(
+	ctx context.Context,
+	res *roundResult,
+	users []user.MultiplayerUser,
+) ([]user.MultiplayerUser, error) {
+	res.multiplier = d.multiplier(res.round.RoundNum)
+
+	res.damage = multiplayer.DuelDamage(users, res.guesses, d.teamScoring, res.multiplier)
+	if len(res.damage) == 0 {
+		return users, nil
+	}
+
+	if err := d.uc.repo.DamageMultiplayerGameUsers(ctx, dto.DamageMultiplayerUsersRequestDB{
+		GameID: res.gameID,
+		Damage: res.damage,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to damage users: %w", err)
+	}
+
+	users, err := d.uc.repo.GetMultiplayerGameUsers(ctx, res.gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game users: %w", err)
+	}
+
+	return users, nil
+}
+
+func (duelRules) gameOver(_ multiplayer.Game, _ multiplayer.Round, users []user.MultiplayerUser) bool {
+	for _, u := range users {
+		if u.Health <= 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// multiplier returns the damage multiplier of the round, it grows every round.
+func (d duelRules) multiplier(roundNum int) float64 {
+	return 1 + d.uc.cfg.DuelMultiplierStep*float64(roundNum-1)
+}
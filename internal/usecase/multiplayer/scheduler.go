@@ -0,0 +1,386 @@
+package multiplayer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
+	"github.com/VasySS/segoya-backend/internal/entity/user"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/transport"
+)
+
+// roundFinishTimeout limits the time spent on finishing a round when its timer fires.
+const roundFinishTimeout = 10 * time.Second
+
+// scheduler keeps track of pending timers (one per key), e.g. round end timers of games.
+type scheduler[K comparable] struct {
+	mu     sync.Mutex
+	timers map[K]*time.Timer
+}
+
+func newScheduler[K comparable]() *scheduler[K] {
+	return &scheduler[K]{
+		timers: make(map[K]*time.Timer),
+	}
+}
+
+// schedule runs fn after the given duration, replacing previously scheduled timer for the same key.
+func (s *scheduler[K]) schedule(key K, after time.Duration, fn func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if t, ok := s.timers[key]; ok {
+		t.Stop()
+	}
+
+	var timer *time.Timer
+
+	timer = time.AfterFunc(after, func() {
+		s.mu.Lock()
+		if s.timers[key] == timer {
+			delete(s.timers, key)
+		}
+		s.mu.Unlock()
+
+		fn()
+	})
+
+	s.timers[key] = timer
+}
+
+// cancel stops scheduled timer for the key, if there is one.
+func (s *scheduler[K]) cancel(key K) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if t, ok := s.timers[key]; ok {
+		t.Stop()
+		delete(s.timers, key)
+	}
+}
+
+// stop cancels all scheduled timers.
+func (s *scheduler[K]) stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, t := range s.timers {
+		t.Stop()
+		delete(s.timers, key)
+	}
+}
+
+// Close stops all scheduled round, elimination and spectator message timers.
+func (uc Usecase) Close() {
+	uc.rounds.stop()
+	uc.eliminations.stop()
+	uc.spectators.stop()
+}
+
+// RecoverRounds schedules timers for all unfinished rounds (called on application startup).
+// Recovery is best-effort: rounds left without timers by its error are finished by RunRoundRecovery.
+//
+// Round timers live only in memory of the instance, which has scheduled them, so rounds of an instance
+// that was stopped or has crashed are left without timers. Such rounds are finished by RunRoundRecovery
+// on any of the instances, late by up to two recovery intervals.
+func (uc Usecase) RecoverRounds(ctx context.Context) error {
+	ctx, span := uc.tracer.Start(ctx, "RecoverRounds")
+	defer span.End()
+
+	rounds, err := uc.repo.GetUnfinishedMultiplayerRounds(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("failed to get unfinished rounds: %w", err)
+	}
+
+	for _, gr := range rounds {
+		uc.scheduleRoundEnd(gr.Game, gr.Round)
+	}
+
+	return nil
+}
+
+// RunRoundRecovery finishes orphaned rounds every recovery interval until the context is done.
+func (uc Usecase) RunRoundRecovery(ctx context.Context) {
+	ticker := time.NewTicker(uc.cfg.RoundRecoveryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := uc.FinishOrphanedRounds(ctx, now.UTC()); err != nil {
+				slog.Error("error finishing orphaned rounds", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// FinishOrphanedRounds finishes unfinished rounds, which timer is overdue by the recovery interval,
+// so that the instance owning the round timer has time to finish the round by itself.
+func (uc Usecase) FinishOrphanedRounds(ctx context.Context, now time.Time) error {
+	ctx, span := uc.tracer.Start(ctx, "FinishOrphanedRounds")
+	defer span.End()
+
+	rounds, err := uc.repo.GetUnfinishedMultiplayerRounds(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("failed to get unfinished rounds: %w", err)
+	}
+
+	for _, gr := range rounds {
+		g, r := gr.Game, gr.Round
+
+		deadline, ok := uc.rules(g).roundDeadline(g, r)
+		if !ok || now.Before(deadline.Add(uc.cfg.RoundRecoveryInterval)) {
+			continue
+		}
+
+		// the round is finished only once, even if it is recovered by several instances at the same time
+		if err := uc.FinishRound(ctx, dto.FinishMultiplayerRoundRequest{
+			RequestTime: now,
+			GameID:      g.ID,
+			RoundNum:    r.RoundNum,
+		}); err != nil {
+			span.RecordError(err)
+			slog.Error("error finishing orphaned round",
+				slog.Int("gameID", g.ID),
+				slog.Int("roundNum", r.RoundNum),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	return nil
+}
+
+// FinishRound ends a multiplayer round (if it's not finished already) and
+// broadcasts its results to all players (called when round timer runs out).
+func (uc Usecase) FinishRound(ctx context.Context, req dto.FinishMultiplayerRoundRequest) error {
+	ctx, span := uc.tracer.Start(ctx, "FinishRound")
+	defer span.End()
+
+	var (
+		result roundResult
+		ended  bool
+	)
+
+	err := uc.repo.RunTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.LockMultiplayerGame(ctx, req.GameID); err != nil {
+			return fmt.Errorf("failed to lock game: %w", err)
+		}
+
+		g, err := uc.repo.GetMultiplayerGame(ctx, req.GameID)
+		if err != nil {
+			return fmt.Errorf("failed to get game: %w", err)
+		}
+
+		r, err := uc.repo.GetMultiplayerRound(ctx, g.ID, req.RoundNum)
+		if err != nil {
+			return fmt.Errorf("failed to get round: %w", err)
+		}
+
+		if r.Finished {
+			return nil
+		}
+
+		gs, err := uc.repo.GetMultiplayerRoundGuesses(ctx, r.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get round guesses: %w", err)
+		}
+
+		result, err = uc.endRound(ctx, req.RequestTime, g, r, gs)
+		if err != nil {
+			return err
+		}
+
+		ended = true
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("failed to finish round: %w", err)
+	}
+
+	if ended {
+		uc.broadcastRoundResult(result)
+	}
+
+	return nil
+}
+
+// EndRoundIfGuessed ends current multiplayer round early if all connected players
+// (except eliminated ones) have made their guesses.
+func (uc Usecase) EndRoundIfGuessed(ctx context.Context, req dto.EndMultiplayerRoundIfGuessedRequest) error {
+	ctx, span := uc.tracer.Start(ctx, "EndRoundIfGuessed")
+	defer span.End()
+
+	if len(req.ConnectedUserIDs) == 0 {
+		return nil
+	}
+
+	var (
+		result roundResult
+		ended  bool
+	)
+
+	err := uc.repo.RunTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.LockMultiplayerGame(ctx, req.GameID); err != nil {
+			return fmt.Errorf("failed to lock game: %w", err)
+		}
+
+		g, err := uc.repo.GetMultiplayerGame(ctx, req.GameID)
+		if err != nil {
+			return fmt.Errorf("failed to get game: %w", err)
+		}
+
+		r, err := uc.repo.GetMultiplayerRound(ctx, g.ID, g.RoundCurrent)
+		if err != nil {
+			return fmt.Errorf("failed to get current round: %w", err)
+		}
+
+		if r.Finished {
+			return nil
+		}
+
+		gs, err := uc.repo.GetMultiplayerRoundGuesses(ctx, r.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get round guesses: %w", err)
+		}
+
+		users, err := uc.repo.GetMultiplayerGameUsers(ctx, g.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get game users: %w", err)
+		}
+
+		guessers := 0
+
+		for _, userID := range req.ConnectedUserIDs {
+			// eliminated players are only spectating, so they can't guess
+			eliminated := slices.ContainsFunc(users, func(u user.MultiplayerUser) bool {
+				return u.ID == userID && u.Eliminated()
+			})
+			if eliminated {
+				continue
+			}
+
+			guessed := slices.ContainsFunc(gs, func(g multiplayer.Guess) bool {
+				return g.UserID == userID
+			})
+			if !guessed {
+				return nil
+			}
+
+			guessers++
+		}
+
+		if guessers == 0 {
+			return nil
+		}
+
+		result, err = uc.endRound(ctx, req.RequestTime, g, r, gs)
+		if err != nil {
+			return err
+		}
+
+		ended = true
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("failed to end round: %w", err)
+	}
+
+	if ended {
+		uc.broadcastRoundResult(result)
+	}
+
+	return nil
+}
+
+// scheduleRoundEnd schedules the round to be finished by the server once its timer runs out.
+// Rounds without timer are only finished when all players have made their guesses.
+func (uc Usecase) scheduleRoundEnd(g multiplayer.Game, r multiplayer.Round) {
+	if r.Finished {
+		return
+	}
+
+	deadline, ok := uc.rules(g).roundDeadline(g, r)
+	if !ok {
+		return
+	}
+
+	uc.rounds.schedule(g.ID, time.Until(deadline), func() {
+		ctx, cancel := context.WithTimeout(context.Background(), roundFinishTimeout)
+		defer cancel()
+
+		err := uc.FinishRound(ctx, dto.FinishMultiplayerRoundRequest{
+			RequestTime: time.Now().UTC(),
+			GameID:      g.ID,
+			RoundNum:    r.RoundNum,
+		})
+		if err != nil {
+			slog.Error("error finishing round by timer",
+				slog.Int("gameID", g.ID),
+				slog.Int("roundNum", r.RoundNum),
+				slog.Any("error", err),
+			)
+		}
+	})
+}
+
+// guessRound returns the round with the new guess and true if the guess has changed the round timer
+// (e.g. duel round timer starts with the first guess). It must be called in the transaction of the guess
+// after the game is locked, so that concurrent guesses don't start the timer twice.
+func (uc Usecase) guessRound(g multiplayer.Game, r multiplayer.Round, guessTime time.Time) (multiplayer.Round, bool) {
+	rules := uc.rules(g)
+	oldDeadline, hadDeadline := rules.roundDeadline(g, r)
+
+	if r.FirstGuessAt.IsZero() {
+		r.FirstGuessAt = guessTime
+	}
+
+	r.GuessesCount++
+
+	deadline, ok := rules.roundDeadline(g, r)
+
+	return r, ok && (!hadDeadline || !deadline.Equal(oldDeadline))
+}
+
+// updateRoundTimer reschedules the round end and notifies players (and spectators) about the new round timer.
+func (uc Usecase) updateRoundTimer(g multiplayer.Game, r multiplayer.Round) {
+	deadline, ok := uc.rules(g).roundDeadline(g, r)
+	if !ok {
+		return
+	}
+
+	uc.scheduleRoundEnd(g, r)
+
+	message := transport.WebSocketMessageOutput{
+		Type: dto.MultiplayerMessageRoundTimer,
+		Payload: map[string]any{
+			"roundNum": r.RoundNum,
+			"endsAt":   deadline,
+		},
+	}
+
+	if err := uc.ws.Broadcast(strconv.Itoa(g.ID), message); err != nil {
+		slog.Error("error broadcasting round timer",
+			slog.Int("gameID", g.ID),
+			slog.Any("error", err),
+		)
+	}
+
+	if g.Spectators {
+		uc.sendSpectators(g.ID, g.SpectatorDelay(), message)
+	}
+}
@@ -0,0 +1,1169 @@
+package multiplayer_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	multiplayerEntity "github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
+	"github.com/VasySS/segoya-backend/internal/entity/user"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/repository"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/transport"
+	"github.com/VasySS/segoya-backend/internal/usecase/multiplayer"
+	"github.com/VasySS/segoya-backend/internal/usecase/multiplayer/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUsecase_FinishRound(t *testing.T) {
+	t.Parallel()
+
+	finishRoundReq := dto.FinishMultiplayerRoundRequest{
+		RequestTime: time.Now().UTC(),
+		GameID:      1,
+		RoundNum:    2,
+	}
+
+	gameResponse := multiplayerEntity.Game{
+		ID:           1,
+		Rounds:       3,
+		RoundCurrent: 2,
+		TimerSeconds: 60,
+		Players:      2,
+	}
+
+	roundGuesses := []multiplayerEntity.Guess{
+		{
+			UserID:   1,
+			Username: "username1",
+			RoundNum: 2,
+			Score:    4567,
+		},
+	}
+
+	gameUsers := []user.MultiplayerUser{
+		{PublicProfile: user.PublicProfile{ID: 1, Username: "username1"}, Score: 5000},
+		{PublicProfile: user.PublicProfile{ID: 2, Username: "username2"}, Score: 100},
+	}
+
+	standings := []multiplayerEntity.Standing{
+		{Place: 1, UserID: 1, Username: "username1", Score: 5000},
+		{Place: 2, UserID: 2, Username: "username2", Score: 100},
+	}
+
+	type fields struct {
+		repo *mocks.Repository
+		ws   *mocks.Broadcaster
+	}
+
+	type args struct {
+		req dto.FinishMultiplayerRoundRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "successfully finish round and broadcast results",
+			args: args{
+				req: finishRoundReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(gameResponse, nil)
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, args.req.GameID, args.req.RoundNum).
+					Return(multiplayerEntity.Round{
+						ID:       10,
+						RoundNum: args.req.RoundNum,
+						Lat:      12.34,
+						Lng:      56.78,
+						Finished: false,
+					}, nil)
+
+				fs.repo.On("GetMultiplayerRoundGuesses", mock.Anything, 10).
+					Return(roundGuesses, nil)
+
+				fs.repo.On("EndMultiplayerRound", mock.Anything, dto.EndMultiplayerRoundRequestDB{
+					RequestTime: args.req.RequestTime,
+					RoundID:     10,
+				}).Return(nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return(gameUsers, nil)
+
+				fs.ws.On("Broadcast", "1", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageRoundFinished,
+					Payload: map[string]any{
+						"roundNum":  args.req.RoundNum,
+						"location":  game.LatLng{Lat: 12.34, Lng: 56.78},
+						"guesses":   roundGuesses,
+						"standings": standings,
+					},
+				}).Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "round results are sent to spectators too",
+			args: args{
+				req: finishRoundReq,
+			},
+			setup: func(fs fields, args args) {
+				spectatedGame := gameResponse
+				spectatedGame.Spectators = true
+
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(spectatedGame, nil)
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, args.req.GameID, args.req.RoundNum).
+					Return(multiplayerEntity.Round{
+						ID:       10,
+						RoundNum: args.req.RoundNum,
+						Lat:      12.34,
+						Lng:      56.78,
+					}, nil)
+
+				fs.repo.On("GetMultiplayerRoundGuesses", mock.Anything, 10).
+					Return(roundGuesses, nil)
+
+				fs.repo.On("EndMultiplayerRound", mock.Anything, dto.EndMultiplayerRoundRequestDB{
+					RequestTime: args.req.RequestTime,
+					RoundID:     10,
+				}).Return(nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return(gameUsers, nil)
+
+				roundFinished := transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageRoundFinished,
+					Payload: map[string]any{
+						"roundNum":  args.req.RoundNum,
+						"location":  game.LatLng{Lat: 12.34, Lng: 56.78},
+						"guesses":   roundGuesses,
+						"standings": standings,
+					},
+				}
+
+				fs.ws.On("Broadcast", "1", roundFinished).Return(nil)
+				fs.ws.On("Broadcast", "1:spectators", roundFinished).Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "finishing last round ends the game",
+			args: args{
+				req: dto.FinishMultiplayerRoundRequest{
+					RequestTime: finishRoundReq.RequestTime,
+					GameID:      finishRoundReq.GameID,
+					RoundNum:    3,
+				},
+			},
+			setup: func(fs fields, args args) {
+				gameGuesses := []multiplayerEntity.Guess{
+					{UserID: 1, Username: "username1", RoundNum: 1, Score: 433},
+					{UserID: 2, Username: "username2", RoundNum: 2, Score: 100},
+					{UserID: 1, Username: "username1", RoundNum: 3, Score: 4567},
+				}
+
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(multiplayerEntity.Game{
+						ID:           1,
+						Rounds:       3,
+						RoundCurrent: 3,
+						TimerSeconds: 60,
+						Players:      2,
+					}, nil)
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, args.req.GameID, args.req.RoundNum).
+					Return(multiplayerEntity.Round{
+						ID:       12,
+						RoundNum: args.req.RoundNum,
+						Lat:      12.34,
+						Lng:      56.78,
+						Finished: false,
+					}, nil)
+
+				fs.repo.On("GetMultiplayerRoundGuesses", mock.Anything, 12).
+					Return(gameGuesses[2:], nil)
+
+				fs.repo.On("EndMultiplayerRound", mock.Anything, dto.EndMultiplayerRoundRequestDB{
+					RequestTime: args.req.RequestTime,
+					RoundID:     12,
+				}).Return(nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return(gameUsers, nil)
+
+				fs.repo.On("EndMultiplayerGame", mock.Anything, dto.EndMultiplayerGameRequestDB{
+					RequestTime: args.req.RequestTime,
+					GameID:      args.req.GameID,
+				}).Return(nil)
+
+				fs.repo.On("GetMultiplayerGameGuesses", mock.Anything, args.req.GameID).
+					Return(gameGuesses, nil)
+
+				fs.ws.On("Broadcast", "1", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageRoundFinished,
+					Payload: map[string]any{
+						"roundNum":  args.req.RoundNum,
+						"location":  game.LatLng{Lat: 12.34, Lng: 56.78},
+						"guesses":   gameGuesses[2:],
+						"standings": standings,
+					},
+				}).Return(nil).Once()
+
+				fs.ws.On("Broadcast", "1", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageGameFinished,
+					Payload: map[string]any{
+						"standings": standings,
+						"guesses":   gameGuesses,
+					},
+				}).Return(nil).Once()
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "round is already finished",
+			args: args{
+				req: finishRoundReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(gameResponse, nil)
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, args.req.GameID, args.req.RoundNum).
+					Return(multiplayerEntity.Round{
+						ID:       10,
+						RoundNum: args.req.RoundNum,
+						Finished: true,
+					}, nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "round not found",
+			args: args{
+				req: finishRoundReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(gameResponse, nil)
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, args.req.GameID, args.req.RoundNum).
+					Return(multiplayerEntity.Round{}, multiplayerEntity.ErrRoundNotFound)
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, multiplayerEntity.ErrRoundNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			pano := mocks.NewPanoramaUsecase(t)
+			ws := mocks.NewBroadcaster(t)
+			fs := fields{
+				repo: repo,
+				ws:   ws,
+			}
+			tt.setup(fs, tt.args)
+
+			uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano, ws)
+
+			err := uc.FinishRound(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestUsecase_FinishRoundSpectatorDelay(t *testing.T) {
+	t.Parallel()
+
+	req := dto.FinishMultiplayerRoundRequest{
+		RequestTime: time.Now().UTC(),
+		GameID:      1,
+		RoundNum:    3,
+	}
+
+	spectatedGame := multiplayerEntity.Game{
+		ID:                    1,
+		Rounds:                3,
+		RoundCurrent:          3,
+		TimerSeconds:          60,
+		Players:               2,
+		Spectators:            true,
+		SpectatorDelaySeconds: 1,
+	}
+
+	round := multiplayerEntity.Round{
+		ID:       12,
+		RoundNum: req.RoundNum,
+		Lat:      12.34,
+		Lng:      56.78,
+	}
+
+	gameGuesses := []multiplayerEntity.Guess{
+		{UserID: 1, Username: "username1", RoundNum: 1, Score: 433},
+		{UserID: 1, Username: "username1", RoundNum: 3, Score: 4567},
+	}
+
+	gameUsers := []user.MultiplayerUser{
+		{PublicProfile: user.PublicProfile{ID: 1, Username: "username1"}, Score: 5000},
+		{PublicProfile: user.PublicProfile{ID: 2, Username: "username2"}, Score: 100},
+	}
+
+	standings := []multiplayerEntity.Standing{
+		{Place: 1, UserID: 1, Username: "username1", Score: 5000},
+		{Place: 2, UserID: 2, Username: "username2", Score: 100},
+	}
+
+	repo := mocks.NewRepository(t)
+	pano := mocks.NewPanoramaUsecase(t)
+	ws := mocks.NewBroadcaster(t)
+
+	repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+		Return(func(ctx context.Context, fn repository.TxFunc) error {
+			return fn(ctx)
+		})
+
+	repo.On("LockMultiplayerGame", mock.Anything, req.GameID).Return(nil)
+	repo.On("GetMultiplayerGame", mock.Anything, req.GameID).Return(spectatedGame, nil)
+	repo.On("GetMultiplayerRound", mock.Anything, req.GameID, req.RoundNum).Return(round, nil)
+	repo.On("GetMultiplayerRoundGuesses", mock.Anything, round.ID).Return(gameGuesses[1:], nil)
+	repo.On("EndMultiplayerRound", mock.Anything, dto.EndMultiplayerRoundRequestDB{
+		RequestTime: req.RequestTime,
+		RoundID:     round.ID,
+	}).Return(nil)
+	repo.On("GetMultiplayerGameUsers", mock.Anything, req.GameID).Return(gameUsers, nil)
+	repo.On("EndMultiplayerGame", mock.Anything, dto.EndMultiplayerGameRequestDB{
+		RequestTime: req.RequestTime,
+		GameID:      req.GameID,
+	}).Return(nil)
+	repo.On("GetMultiplayerGameGuesses", mock.Anything, req.GameID).Return(gameGuesses, nil)
+
+	messages := []transport.WebSocketMessageOutput{
+		{
+			Type: dto.MultiplayerMessageRoundFinished,
+			Payload: map[string]any{
+				"roundNum":  req.RoundNum,
+				"location":  round.Location(),
+				"guesses":   gameGuesses[1:],
+				"standings": standings,
+			},
+		},
+		{
+			Type: dto.MultiplayerMessageGameFinished,
+			Payload: map[string]any{
+				"standings": standings,
+				"guesses":   gameGuesses,
+			},
+		},
+	}
+
+	spectated := make(chan transport.WebSocketMessageOutputType, len(messages))
+
+	for _, message := range messages {
+		ws.On("Broadcast", "1", message).Return(nil).Once()
+		ws.On("Broadcast", "1:spectators", message).Return(nil).Once().
+			Run(func(mock.Arguments) { spectated <- message.Type })
+	}
+
+	uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano, ws)
+
+	err := uc.FinishRound(t.Context(), req)
+	assert.NoError(t, err)
+
+	// results of the last round are sent to spectators before final rankings
+	for _, message := range messages {
+		select {
+		case messageType := <-spectated:
+			assert.Equal(t, message.Type, messageType)
+		case <-time.After(3 * time.Second):
+			t.Fatalf("message %s was not sent to spectators", message.Type)
+		}
+	}
+}
+
+func TestUsecase_EndRoundIfGuessed(t *testing.T) {
+	t.Parallel()
+
+	endRoundReq := dto.EndMultiplayerRoundIfGuessedRequest{
+		RequestTime:      time.Now().UTC(),
+		GameID:           1,
+		ConnectedUserIDs: []int{1, 2},
+	}
+
+	gameResponse := multiplayerEntity.Game{
+		ID:           1,
+		Rounds:       5,
+		RoundCurrent: 1,
+		TimerSeconds: 60,
+		Players:      3,
+	}
+
+	roundResponse := multiplayerEntity.Round{
+		ID:       5,
+		RoundNum: 1,
+		Lat:      12.34,
+		Lng:      56.78,
+		Finished: false,
+	}
+
+	type fields struct {
+		repo *mocks.Repository
+		ws   *mocks.Broadcaster
+	}
+
+	type args struct {
+		req dto.EndMultiplayerRoundIfGuessedRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "all connected players have guessed",
+			args: args{
+				req: endRoundReq,
+			},
+			setup: func(fs fields, args args) {
+				guesses := []multiplayerEntity.Guess{
+					{UserID: 1, Username: "username1", RoundNum: 1, Score: 100},
+					{UserID: 2, Username: "username2", RoundNum: 1, Score: 200},
+				}
+
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(gameResponse, nil)
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, gameResponse.ID, gameResponse.RoundCurrent).
+					Return(roundResponse, nil)
+
+				fs.repo.On("GetMultiplayerRoundGuesses", mock.Anything, roundResponse.ID).
+					Return(guesses, nil)
+
+				fs.repo.On("EndMultiplayerRound", mock.Anything, dto.EndMultiplayerRoundRequestDB{
+					RequestTime: args.req.RequestTime,
+					RoundID:     roundResponse.ID,
+				}).Return(nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return([]user.MultiplayerUser{
+						{PublicProfile: user.PublicProfile{ID: 1, Username: "username1"}, Score: 100},
+						{PublicProfile: user.PublicProfile{ID: 2, Username: "username2"}, Score: 200},
+						{PublicProfile: user.PublicProfile{ID: 3, Username: "username3"}, Score: 200},
+					}, nil)
+
+				fs.ws.On("Broadcast", "1", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageRoundFinished,
+					Payload: map[string]any{
+						"roundNum": roundResponse.RoundNum,
+						"location": roundResponse.Location(),
+						"guesses":  guesses,
+						"standings": []multiplayerEntity.Standing{
+							{Place: 1, UserID: 2, Username: "username2", Score: 200},
+							{Place: 1, UserID: 3, Username: "username3", Score: 200},
+							{Place: 3, UserID: 1, Username: "username1", Score: 100},
+						},
+					},
+				}).Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "team standings are sent after round of team game",
+			args: args{
+				req: endRoundReq,
+			},
+			setup: func(fs fields, args args) {
+				teamGame := multiplayerEntity.Game{
+					ID:           1,
+					Rounds:       5,
+					RoundCurrent: 2,
+					TimerSeconds: 60,
+					Players:      3,
+					TeamScoring:  multiplayerEntity.AverageTeamScoring,
+				}
+
+				teamRound := multiplayerEntity.Round{
+					ID:       6,
+					RoundNum: 2,
+					Lat:      12.34,
+					Lng:      56.78,
+				}
+
+				guesses := []multiplayerEntity.Guess{
+					{UserID: 1, Team: 1, Username: "username1", RoundNum: 2, Score: 500},
+					{UserID: 2, Team: 1, Username: "username2", RoundNum: 2, Score: 300},
+				}
+
+				gameGuesses := []multiplayerEntity.Guess{
+					{UserID: 1, Team: 1, Username: "username1", RoundNum: 1, Score: 300},
+					{UserID: 2, Team: 1, Username: "username2", RoundNum: 1, Score: 100},
+					{UserID: 3, Team: 2, Username: "username3", RoundNum: 1, Score: 400},
+					guesses[0],
+					guesses[1],
+				}
+
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(teamGame, nil)
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, teamGame.ID, teamGame.RoundCurrent).
+					Return(teamRound, nil)
+
+				fs.repo.On("GetMultiplayerRoundGuesses", mock.Anything, teamRound.ID).
+					Return(guesses, nil)
+
+				fs.repo.On("EndMultiplayerRound", mock.Anything, dto.EndMultiplayerRoundRequestDB{
+					RequestTime: args.req.RequestTime,
+					RoundID:     teamRound.ID,
+				}).Return(nil)
+
+				// user 3 is not connected, so only the first team is waited for
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return([]user.MultiplayerUser{
+						{PublicProfile: user.PublicProfile{ID: 1, Username: "username1"}, Score: 800, Team: 1},
+						{PublicProfile: user.PublicProfile{ID: 2, Username: "username2"}, Score: 400, Team: 1},
+						{PublicProfile: user.PublicProfile{ID: 3, Username: "username3"}, Score: 400, Team: 2},
+					}, nil)
+
+				fs.repo.On("GetMultiplayerGameGuesses", mock.Anything, teamGame.ID).
+					Return(gameGuesses, nil)
+
+				fs.ws.On("Broadcast", "1", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageRoundFinished,
+					Payload: map[string]any{
+						"roundNum": teamRound.RoundNum,
+						"location": teamRound.Location(),
+						"guesses":  guesses,
+						"standings": []multiplayerEntity.Standing{
+							{Place: 1, UserID: 1, Username: "username1", Score: 800},
+							{Place: 2, UserID: 2, Username: "username2", Score: 400},
+							{Place: 2, UserID: 3, Username: "username3", Score: 400},
+						},
+						// first team averages 200 and 400 points in rounds, second team 400 and 0 points
+						"teamStandings": []multiplayerEntity.TeamStanding{
+							{Place: 1, Team: 1, UserIDs: []int{1, 2}, Score: 600},
+							{Place: 2, Team: 2, UserIDs: []int{3}, Score: 400},
+						},
+					},
+				}).Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "not all connected players have guessed",
+			args: args{
+				req: endRoundReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(gameResponse, nil)
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, gameResponse.ID, gameResponse.RoundCurrent).
+					Return(roundResponse, nil)
+
+				fs.repo.On("GetMultiplayerRoundGuesses", mock.Anything, roundResponse.ID).
+					Return([]multiplayerEntity.Guess{
+						{UserID: 1, Username: "username1", RoundNum: 1, Score: 100},
+					}, nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return([]user.MultiplayerUser{
+						{PublicProfile: user.PublicProfile{ID: 1, Username: "username1"}},
+						{PublicProfile: user.PublicProfile{ID: 2, Username: "username2"}},
+						{PublicProfile: user.PublicProfile{ID: 3, Username: "username3"}},
+					}, nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "eliminated players are not waited for and last player wins battle royale",
+			args: args{
+				req: endRoundReq,
+			},
+			setup: func(fs fields, args args) {
+				battleRoyaleGame := multiplayerEntity.Game{
+					ID:           1,
+					Rounds:       5,
+					RoundCurrent: 2,
+					TimerSeconds: 60,
+					Players:      3,
+					Mode:         multiplayerEntity.BattleRoyaleMode,
+				}
+
+				battleRoyaleRound := multiplayerEntity.Round{
+					ID:       6,
+					RoundNum: 2,
+					Lat:      12.34,
+					Lng:      56.78,
+				}
+
+				guesses := []multiplayerEntity.Guess{
+					{UserID: 1, Username: "username1", RoundNum: 2, Score: 300},
+					{UserID: 3, Username: "username3", RoundNum: 2, Score: 100},
+				}
+
+				gameGuesses := []multiplayerEntity.Guess{
+					{UserID: 2, Username: "username2", RoundNum: 1, Score: 10},
+					guesses[0],
+					guesses[1],
+				}
+
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(battleRoyaleGame, nil)
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, battleRoyaleGame.ID, battleRoyaleGame.RoundCurrent).
+					Return(battleRoyaleRound, nil)
+
+				fs.repo.On("GetMultiplayerRoundGuesses", mock.Anything, battleRoyaleRound.ID).
+					Return(guesses, nil)
+
+				// user 2 was eliminated in the first round and is only spectating
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return([]user.MultiplayerUser{
+						{PublicProfile: user.PublicProfile{ID: 1, Username: "username1"}, Score: 500},
+						{PublicProfile: user.PublicProfile{ID: 2, Username: "username2"}, Score: 10, EliminatedRound: 1},
+						{PublicProfile: user.PublicProfile{ID: 3, Username: "username3"}, Score: 700},
+					}, nil).Twice()
+
+				fs.repo.On("EndMultiplayerRound", mock.Anything, dto.EndMultiplayerRoundRequestDB{
+					RequestTime: args.req.RequestTime,
+					RoundID:     battleRoyaleRound.ID,
+				}).Return(nil)
+
+				fs.repo.On("EliminateMultiplayerGameUsers", mock.Anything, dto.EliminateMultiplayerUsersRequestDB{
+					RequestTime: args.req.RequestTime,
+					GameID:      battleRoyaleGame.ID,
+					RoundNum:    battleRoyaleRound.RoundNum,
+					UserIDs:     []int{3},
+				}).Return(nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return([]user.MultiplayerUser{
+						{PublicProfile: user.PublicProfile{ID: 1, Username: "username1"}, Score: 500},
+						{PublicProfile: user.PublicProfile{ID: 2, Username: "username2"}, Score: 10, EliminatedRound: 1},
+						{PublicProfile: user.PublicProfile{ID: 3, Username: "username3"}, Score: 700, EliminatedRound: 2},
+					}, nil).Once()
+
+				fs.repo.On("EndMultiplayerGame", mock.Anything, dto.EndMultiplayerGameRequestDB{
+					RequestTime: args.req.RequestTime,
+					GameID:      battleRoyaleGame.ID,
+				}).Return(nil)
+
+				fs.repo.On("GetMultiplayerGameGuesses", mock.Anything, battleRoyaleGame.ID).
+					Return(gameGuesses, nil)
+
+				standings := []multiplayerEntity.Standing{
+					{Place: 1, UserID: 1, Username: "username1", Score: 500},
+					{Place: 2, UserID: 3, Username: "username3", Score: 700, EliminatedRound: 2},
+					{Place: 3, UserID: 2, Username: "username2", Score: 10, EliminatedRound: 1},
+				}
+
+				fs.ws.On("Broadcast", "1", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageRoundFinished,
+					Payload: map[string]any{
+						"roundNum":  battleRoyaleRound.RoundNum,
+						"location":  battleRoyaleRound.Location(),
+						"guesses":   guesses,
+						"standings": standings,
+					},
+				}).Return(nil)
+
+				fs.ws.On("Broadcast", "1", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageUsersEliminated,
+					Payload: map[string]any{
+						"roundNum": battleRoyaleRound.RoundNum,
+						"userIDs":  []int{3},
+					},
+				}).Return(nil)
+
+				fs.ws.On("Broadcast", "1", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageGameFinished,
+					Payload: map[string]any{
+						"standings": standings,
+						"guesses":   gameGuesses,
+					},
+				}).Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "duel player loses all health after round with escalated damage",
+			args: args{
+				req: endRoundReq,
+			},
+			setup: func(fs fields, args args) {
+				duelGame := multiplayerEntity.Game{
+					ID:           1,
+					RoundCurrent: 3,
+					Players:      2,
+					Mode:         multiplayerEntity.DuelMode,
+					Health:       6000,
+				}
+
+				duelRound := multiplayerEntity.Round{
+					ID:       7,
+					RoundNum: 3,
+					Lat:      12.34,
+					Lng:      56.78,
+				}
+
+				guesses := []multiplayerEntity.Guess{
+					{UserID: 1, Username: "username1", RoundNum: 3, Score: 4000},
+					{UserID: 2, Username: "username2", RoundNum: 3, Score: 1500},
+				}
+
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(duelGame, nil)
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, duelGame.ID, duelGame.RoundCurrent).
+					Return(duelRound, nil)
+
+				fs.repo.On("GetMultiplayerRoundGuesses", mock.Anything, duelRound.ID).
+					Return(guesses, nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return([]user.MultiplayerUser{
+						{PublicProfile: user.PublicProfile{ID: 1, Username: "username1"}, Score: 9000, Health: 5000},
+						{PublicProfile: user.PublicProfile{ID: 2, Username: "username2"}, Score: 4500, Health: 4000},
+					}, nil).Twice()
+
+				fs.repo.On("EndMultiplayerRound", mock.Anything, dto.EndMultiplayerRoundRequestDB{
+					RequestTime: args.req.RequestTime,
+					RoundID:     duelRound.ID,
+				}).Return(nil)
+
+				// third round damage is doubled with multiplier step of 0.5
+				damage := []multiplayerEntity.Damage{{UserID: 2, Damage: 5000}}
+
+				fs.repo.On("DamageMultiplayerGameUsers", mock.Anything, dto.DamageMultiplayerUsersRequestDB{
+					GameID: duelGame.ID,
+					Damage: damage,
+				}).Return(nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return([]user.MultiplayerUser{
+						{PublicProfile: user.PublicProfile{ID: 1, Username: "username1"}, Score: 9000, Health: 5000},
+						{PublicProfile: user.PublicProfile{ID: 2, Username: "username2"}, Score: 4500, Health: 0},
+					}, nil).Once()
+
+				fs.repo.On("EndMultiplayerGame", mock.Anything, dto.EndMultiplayerGameRequestDB{
+					RequestTime: args.req.RequestTime,
+					GameID:      duelGame.ID,
+				}).Return(nil)
+
+				fs.repo.On("GetMultiplayerGameGuesses", mock.Anything, duelGame.ID).
+					Return(guesses, nil)
+
+				standings := []multiplayerEntity.Standing{
+					{Place: 1, UserID: 1, Username: "username1", Score: 9000, Health: 5000},
+					{Place: 2, UserID: 2, Username: "username2", Score: 4500, Health: 0},
+				}
+
+				fs.ws.On("Broadcast", "1", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageRoundFinished,
+					Payload: map[string]any{
+						"roundNum":  duelRound.RoundNum,
+						"location":  duelRound.Location(),
+						"guesses":   guesses,
+						"standings": standings,
+					},
+				}).Return(nil)
+
+				fs.ws.On("Broadcast", "1", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageUsersDamaged,
+					Payload: map[string]any{
+						"roundNum":   duelRound.RoundNum,
+						"multiplier": 2.0,
+						"damage":     damage,
+					},
+				}).Return(nil)
+
+				fs.ws.On("Broadcast", "1", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageGameFinished,
+					Payload: map[string]any{
+						"standings": standings,
+						"guesses":   guesses,
+					},
+				}).Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "all members of team duel losing team are damaged",
+			args: args{
+				req: endRoundReq,
+			},
+			setup: func(fs fields, args args) {
+				duelGame := multiplayerEntity.Game{
+					ID:           1,
+					RoundCurrent: 1,
+					Players:      4,
+					Mode:         multiplayerEntity.DuelMode,
+					Health:       6000,
+					TeamScoring:  multiplayerEntity.BestTeamScoring,
+				}
+
+				duelRound := multiplayerEntity.Round{
+					ID:       8,
+					RoundNum: 1,
+					Lat:      12.34,
+					Lng:      56.78,
+				}
+
+				guesses := []multiplayerEntity.Guess{
+					{UserID: 1, Team: 1, Username: "username1", RoundNum: 1, Score: 3000},
+					{UserID: 2, Team: 1, Username: "username2", RoundNum: 1, Score: 1000},
+					{UserID: 3, Team: 2, Username: "username3", RoundNum: 1, Score: 4000},
+				}
+
+				users := []user.MultiplayerUser{
+					{PublicProfile: user.PublicProfile{ID: 1, Username: "username1"}, Score: 3000, Health: 6000, Team: 1},
+					{PublicProfile: user.PublicProfile{ID: 2, Username: "username2"}, Score: 1000, Health: 6000, Team: 1},
+					{PublicProfile: user.PublicProfile{ID: 3, Username: "username3"}, Score: 4000, Health: 6000, Team: 2},
+					{PublicProfile: user.PublicProfile{ID: 4, Username: "username4"}, Health: 6000, Team: 2},
+				}
+
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(duelGame, nil)
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, duelGame.ID, duelGame.RoundCurrent).
+					Return(duelRound, nil)
+
+				fs.repo.On("GetMultiplayerRoundGuesses", mock.Anything, duelRound.ID).
+					Return(guesses, nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return(users, nil).Twice()
+
+				fs.repo.On("EndMultiplayerRound", mock.Anything, dto.EndMultiplayerRoundRequestDB{
+					RequestTime: args.req.RequestTime,
+					RoundID:     duelRound.ID,
+				}).Return(nil)
+
+				// best guesses of the teams are compared
+				damage := []multiplayerEntity.Damage{{UserID: 1, Damage: 1000}, {UserID: 2, Damage: 1000}}
+
+				fs.repo.On("DamageMultiplayerGameUsers", mock.Anything, dto.DamageMultiplayerUsersRequestDB{
+					GameID: duelGame.ID,
+					Damage: damage,
+				}).Return(nil)
+
+				damagedUsers := slices.Clone(users)
+				damagedUsers[0].Health = 5000
+				damagedUsers[1].Health = 5000
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return(damagedUsers, nil).Once()
+
+				fs.repo.On("GetMultiplayerGameGuesses", mock.Anything, duelGame.ID).
+					Return(guesses, nil)
+
+				fs.ws.On("Broadcast", "1", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageRoundFinished,
+					Payload: map[string]any{
+						"roundNum": duelRound.RoundNum,
+						"location": duelRound.Location(),
+						"guesses":  guesses,
+						"standings": []multiplayerEntity.Standing{
+							{Place: 1, UserID: 3, Username: "username3", Score: 4000, Health: 6000},
+							{Place: 2, UserID: 4, Username: "username4", Score: 0, Health: 6000},
+							{Place: 3, UserID: 1, Username: "username1", Score: 3000, Health: 5000},
+							{Place: 4, UserID: 2, Username: "username2", Score: 1000, Health: 5000},
+						},
+						"teamStandings": []multiplayerEntity.TeamStanding{
+							{Place: 1, Team: 2, UserIDs: []int{3, 4}, Score: 4000, Health: 6000},
+							{Place: 2, Team: 1, UserIDs: []int{1, 2}, Score: 3000, Health: 5000},
+						},
+					},
+				}).Return(nil)
+
+				fs.ws.On("Broadcast", "1", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageUsersDamaged,
+					Payload: map[string]any{
+						"roundNum":   duelRound.RoundNum,
+						"multiplier": 1.0,
+						"damage":     damage,
+					},
+				}).Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "no players connected",
+			args: args{
+				req: dto.EndMultiplayerRoundIfGuessedRequest{
+					RequestTime: endRoundReq.RequestTime,
+					GameID:      endRoundReq.GameID,
+				},
+			},
+			setup:   func(_ fields, _ args) {},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			pano := mocks.NewPanoramaUsecase(t)
+			ws := mocks.NewBroadcaster(t)
+			fs := fields{
+				repo: repo,
+				ws:   ws,
+			}
+			tt.setup(fs, tt.args)
+
+			uc := multiplayer.NewUsecase(multiplayer.Config{DuelMultiplierStep: 0.5}, repo, pano, ws)
+
+			err := uc.EndRoundIfGuessed(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestUsecase_FinishOrphanedRounds(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	recoveryInterval := 30 * time.Second
+
+	gameResponse := multiplayerEntity.Game{
+		ID:           1,
+		Rounds:       3,
+		RoundCurrent: 2,
+		TimerSeconds: 60,
+		Players:      2,
+	}
+
+	// the round timer has run out long ago, but the instance owning it has stopped
+	orphanedRound := multiplayerEntity.Round{
+		ID:        10,
+		GameID:    gameResponse.ID,
+		RoundNum:  2,
+		StartedAt: now.Add(-2 * time.Minute),
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(*mocks.Repository)
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "orphaned round is finished",
+			setup: func(repo *mocks.Repository) {
+				repo.On("GetUnfinishedMultiplayerRounds", mock.Anything).
+					Return([]multiplayerEntity.GameRound{{Game: gameResponse, Round: orphanedRound}}, nil)
+
+				repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				repo.On("LockMultiplayerGame", mock.Anything, gameResponse.ID).
+					Return(nil)
+
+				repo.On("GetMultiplayerGame", mock.Anything, gameResponse.ID).
+					Return(gameResponse, nil)
+
+				// the round was finished by another instance while the game was locked
+				finishedRound := orphanedRound
+				finishedRound.Finished = true
+
+				repo.On("GetMultiplayerRound", mock.Anything, gameResponse.ID, orphanedRound.RoundNum).
+					Return(finishedRound, nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error finishing a round doesn't stop finishing others",
+			setup: func(repo *mocks.Repository) {
+				otherGame := gameResponse
+				otherGame.ID = 2
+
+				otherRound := orphanedRound
+				otherRound.ID = 20
+				otherRound.GameID = otherGame.ID
+
+				repo.On("GetUnfinishedMultiplayerRounds", mock.Anything).
+					Return([]multiplayerEntity.GameRound{
+						{Game: gameResponse, Round: orphanedRound},
+						{Game: otherGame, Round: otherRound},
+					}, nil)
+
+				repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				repo.On("LockMultiplayerGame", mock.Anything, gameResponse.ID).
+					Return(errors.New("some error"))
+
+				repo.On("LockMultiplayerGame", mock.Anything, otherGame.ID).
+					Return(nil)
+
+				repo.On("GetMultiplayerGame", mock.Anything, otherGame.ID).
+					Return(otherGame, nil)
+
+				finishedRound := otherRound
+				finishedRound.Finished = true
+
+				repo.On("GetMultiplayerRound", mock.Anything, otherGame.ID, otherRound.RoundNum).
+					Return(finishedRound, nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "round with pending timer is skipped",
+			setup: func(repo *mocks.Repository) {
+				pendingRound := orphanedRound
+				pendingRound.StartedAt = now.Add(-time.Minute - recoveryInterval/2)
+
+				repo.On("GetUnfinishedMultiplayerRounds", mock.Anything).
+					Return([]multiplayerEntity.GameRound{{Game: gameResponse, Round: pendingRound}}, nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "round without timer is skipped",
+			setup: func(repo *mocks.Repository) {
+				noTimerGame := gameResponse
+				noTimerGame.TimerSeconds = 0
+
+				repo.On("GetUnfinishedMultiplayerRounds", mock.Anything).
+					Return([]multiplayerEntity.GameRound{{Game: noTimerGame, Round: orphanedRound}}, nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error getting unfinished rounds",
+			setup: func(repo *mocks.Repository) {
+				repo.On("GetUnfinishedMultiplayerRounds", mock.Anything).
+					Return(nil, errors.New("some error"))
+			},
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			tt.setup(repo)
+
+			uc := multiplayer.NewUsecase(
+				multiplayer.Config{RoundRecoveryInterval: recoveryInterval},
+				repo,
+				mocks.NewPanoramaUsecase(t),
+				mocks.NewBroadcaster(t),
+			)
+
+			err := uc.FinishOrphanedRounds(t.Context(), now)
+			tt.wantErr(t, err)
+		})
+	}
+}
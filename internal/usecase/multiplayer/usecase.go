@@ -4,12 +4,15 @@ package multiplayer
 
 import (
 	"context"
+	"encoding/json"
+	"sync/atomic"
 
 	"github.com/VasySS/segoya-backend/internal/dto"
 	"github.com/VasySS/segoya-backend/internal/entity/game"
 	"github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
 	"github.com/VasySS/segoya-backend/internal/entity/user"
 	"github.com/VasySS/segoya-backend/internal/infrastructure/repository"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/transport"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -22,13 +25,18 @@ type GameRepo interface {
 	EndMultiplayerGame(ctx context.Context, req dto.EndMultiplayerGameRequestDB) error
 	GetMultiplayerGameUser(ctx context.Context, userID, gameID int) (user.MultiplayerUser, error)
 	GetMultiplayerGameUsers(ctx context.Context, gameID int) ([]user.MultiplayerUser, error)
+	EliminateMultiplayerGameUsers(ctx context.Context, req dto.EliminateMultiplayerUsersRequestDB) error
+	DamageMultiplayerGameUsers(ctx context.Context, req dto.DamageMultiplayerUsersRequestDB) error
+	GetMultiplayerGameLocationIDs(ctx context.Context, gameID int) ([]int, error)
 	GetMultiplayerGameGuesses(ctx context.Context, gameID int) ([]multiplayer.Guess, error)
+	GetUserByID(ctx context.Context, userID int) (user.PrivateProfile, error)
 }
 
 // RoundRepo provides access to multiplayer round data.
 type RoundRepo interface {
 	NewMultiplayerRound(ctx context.Context, req dto.NewMultiplayerRoundRequestDB) (multiplayer.Round, error)
 	GetMultiplayerRound(ctx context.Context, gameID, roundNum int) (multiplayer.Round, error)
+	GetUnfinishedMultiplayerRounds(ctx context.Context) ([]multiplayer.GameRound, error)
 	EndMultiplayerRound(ctx context.Context, req dto.EndMultiplayerRoundRequestDB) error
 	NewMultiplayerRoundGuess(ctx context.Context, req dto.NewMultiplayerRoundGuessRequestDB) error
 	GetMultiplayerRoundGuesses(ctx context.Context, roundID int) ([]multiplayer.Guess, error)
@@ -47,12 +55,33 @@ type Repository interface {
 //
 //go:generate go tool mockery --name=PanoramaUsecase
 type PanoramaUsecase interface {
-	NewStreetview(ctx context.Context, provider game.PanoramaProvider) (game.PanoramaMetadata, error)
+	NewStreetview(ctx context.Context, req dto.NewStreetviewRequest) (game.PanoramaMetadata, error)
 	GetStreetview(ctx context.Context, provider game.PanoramaProvider, id int) (game.PanoramaMetadata, error)
-	CalculateScoreAndDistance(
-		provider game.PanoramaProvider,
-		realLat, realLng, userLat, userLng float64,
-	) (score int, distance int)
+	ScoreDistance(ctx context.Context, req dto.ScoreDistanceRequest) (float64, error)
+	CalculateScore(req dto.ScoreRequest) game.Score
+	Place(location game.LatLng) game.Place
+}
+
+// Broadcaster defines methods for sending messages to all players connected to a game
+// and checking who is connected to it.
+//
+//go:generate go tool mockery --name=Broadcaster
+type Broadcaster interface {
+	Broadcast(id string, message transport.WebSocketMessageOutput) error
+	Members(id string) ([]json.RawMessage, error)
+}
+
+// gameUserKey identifies a player in a game.
+type gameUserKey struct {
+	gameID int
+	userID int
+}
+
+// spectatorMessageKey is a key of delayed messages to spectators of a game,
+// seq makes keys of messages sent at different times unique.
+type spectatorMessageKey struct {
+	gameID int
+	seq    uint64
 }
 
 // Usecase contains business logic for multiplayer game management.
@@ -60,7 +89,14 @@ type Usecase struct {
 	cfg    Config
 	repo   Repository
 	pano   PanoramaUsecase
-	tracer trace.Tracer
+	ws     Broadcaster
+	rounds *scheduler[int]
+	// eliminations contains pending eliminations of disconnected battle royale players.
+	eliminations *scheduler[gameUserKey]
+	// spectators contains messages, that are sent to spectators after the spectator delay.
+	spectators   *scheduler[spectatorMessageKey]
+	spectatorSeq *atomic.Uint64
+	tracer       trace.Tracer
 }
 
 // NewUsecase creates and returns a new Usecase instance with the provided dependencies.
@@ -68,11 +104,18 @@ type Usecase struct {
 // cfg - Configuration settings for the multiplayer game management.
 // repo - Implementation of the Repository interface for accessing game and round data.
 // pano - Implementation of the PanoramaUsecase interface for panorama-based gameplay interactions.
-func NewUsecase(cfg Config, repo Repository, pano PanoramaUsecase) *Usecase {
+// ws - Implementation of the Broadcaster interface for notifying players about round results
+// and checking whether disconnected players have reconnected.
+func NewUsecase(cfg Config, repo Repository, pano PanoramaUsecase, ws Broadcaster) *Usecase {
 	return &Usecase{
-		cfg:    cfg,
-		repo:   repo,
-		pano:   pano,
-		tracer: otel.GetTracerProvider().Tracer("MultiplayerUsecase"),
+		cfg:          cfg,
+		repo:         repo,
+		pano:         pano,
+		ws:           ws,
+		rounds:       newScheduler[int](),
+		eliminations: newScheduler[gameUserKey](),
+		spectators:   newScheduler[spectatorMessageKey](),
+		spectatorSeq: new(atomic.Uint64),
+		tracer:       otel.GetTracerProvider().Tracer("MultiplayerUsecase"),
 	}
 }
@@ -3,28 +3,54 @@ package multiplayer
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
 	"github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
+	"github.com/VasySS/segoya-backend/internal/entity/user"
 )
 
 // NewRoundGuess saves a user's guess for current round (called from websocket).
+// The round timer is updated, if the guess has changed it.
 func (uc Usecase) NewRoundGuess(ctx context.Context, req dto.NewMultiplayerRoundGuessRequest) error {
 	ctx, span := uc.tracer.Start(ctx, "NewRoundGuess")
 	defer span.End()
 
+	var (
+		multiplayerGame multiplayer.Game
+		round           multiplayer.Round
+		timerChanged    bool
+	)
+
 	err := uc.repo.RunTx(ctx, func(ctx context.Context) error {
-		multiplayerGame, err := uc.repo.GetMultiplayerGame(ctx, req.GameID)
+		// guesses of the round are counted under the lock, so that only the first guess starts the timer
+		if err := uc.repo.LockMultiplayerGame(ctx, req.GameID); err != nil {
+			return fmt.Errorf("failed to lock game: %w", err)
+		}
+
+		var err error
+
+		multiplayerGame, err = uc.repo.GetMultiplayerGame(ctx, req.GameID)
 		if err != nil {
 			return fmt.Errorf("failed to get game: %w", err)
 		}
 
-		if err := uc.isUserInGame(ctx, req.UserID, req.GameID); err != nil {
+		users, err := uc.gameUsers(ctx, req.UserID, req.GameID)
+		if err != nil {
 			return err
 		}
 
-		round, err := uc.repo.GetMultiplayerRound(ctx, multiplayerGame.ID, multiplayerGame.RoundCurrent)
+		// eliminated players of battle royale games can only spectate
+		eliminated := slices.ContainsFunc(users, func(u user.MultiplayerUser) bool {
+			return u.ID == req.UserID && u.Eliminated()
+		})
+		if eliminated {
+			return multiplayer.ErrUserEliminated
+		}
+
+		round, err = uc.repo.GetMultiplayerRound(ctx, multiplayerGame.ID, multiplayerGame.RoundCurrent)
 		if err != nil {
 			return fmt.Errorf("failed to get current round: %w", err)
 		}
@@ -33,27 +59,35 @@ func (uc Usecase) NewRoundGuess(ctx context.Context, req dto.NewMultiplayerRound
 			return multiplayer.ErrRoundAlreadyFinished
 		}
 
-		score, distance := uc.pano.CalculateScoreAndDistance(
-			multiplayerGame.Provider,
-			round.Lat,
-			round.Lng,
-			req.Guess.Lat,
-			req.Guess.Lng,
-		)
+		score := uc.pano.CalculateScore(dto.ScoreRequest{
+			Provider:      round.Provider,
+			Scoring:       multiplayerGame.Scoring,
+			ScoreDistance: multiplayerGame.ScoreDistance,
+			Location:      round.Location(),
+			Guess:         req.Guess,
+			StartedAt:     round.StartedAt,
+			GuessedAt:     req.RequestTime,
+			TimerSeconds:  multiplayerGame.TimerSeconds,
+		})
 
 		err = uc.repo.NewMultiplayerRoundGuess(ctx, dto.NewMultiplayerRoundGuessRequestDB{
-			RequestTime: req.RequestTime,
-			RoundID:     round.ID,
-			UserID:      req.UserID,
-			Score:       score,
-			Distance:    distance,
-			Lat:         req.Guess.Lat,
-			Lng:         req.Guess.Lng,
+			RequestTime:  req.RequestTime,
+			RoundID:      round.ID,
+			UserID:       req.UserID,
+			Score:        score.Total(),
+			ScoreBonus:   score.Bonus,
+			ScorePenalty: score.Penalty,
+			Distance:     score.Distance,
+			CountryCode:  uc.pano.Place(req.Guess).CountryCode,
+			Lat:          req.Guess.Lat,
+			Lng:          req.Guess.Lng,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to set user guess: %w", err)
 		}
 
+		round, timerChanged = uc.guessRound(multiplayerGame, round, req.RequestTime)
+
 		return nil
 	})
 	if err != nil {
@@ -61,23 +95,33 @@ func (uc Usecase) NewRoundGuess(ctx context.Context, req dto.NewMultiplayerRound
 		return fmt.Errorf("failed to set user guess: %w", err)
 	}
 
+	if timerChanged {
+		uc.updateRoundTimer(multiplayerGame, round)
+	}
+
 	return nil
 }
 
 // EndRound ends a multiplayer game round (if it's not finished already) and
 // returns all guesses made during it (called from websocket).
+// Round results are broadcasted to all players if the round was ended by this call.
 func (uc Usecase) EndRound(ctx context.Context, req dto.EndMultiplayerRoundRequest) ([]multiplayer.Guess, error) {
 	ctx, span := uc.tracer.Start(ctx, "EndRound")
 	defer span.End()
 
-	var response []multiplayer.Guess
+	var (
+		response []multiplayer.Guess
+		result   roundResult
+		ended    bool
+	)
 
 	err := uc.repo.RunTx(ctx, func(ctx context.Context) error {
 		if err := uc.repo.LockMultiplayerGame(ctx, req.GameID); err != nil {
 			return fmt.Errorf("failed to lock game: %w", err)
 		}
 
-		if err := uc.isUserInGame(ctx, req.UserID, req.GameID); err != nil {
+		users, err := uc.gameUsers(ctx, req.UserID, req.GameID)
+		if err != nil {
 			return err
 		}
 
@@ -102,20 +146,17 @@ func (uc Usecase) EndRound(ctx context.Context, req dto.EndMultiplayerRoundReque
 			return nil
 		}
 
-		timerEndTime := r.StartedAt.Add(time.Second * time.Duration(g.TimerSeconds))
-		if g.TimerSeconds != 0 && req.RequestTime.Before(timerEndTime) && r.GuessesCount != g.Players {
+		if !uc.rules(g).roundOver(g, r, users, req.RequestTime) {
 			return multiplayer.ErrRoundIsStillActive
 		}
 
-		req := dto.EndMultiplayerRoundRequestDB{
-			RequestTime: req.RequestTime,
-			RoundID:     r.ID,
-		}
-		if err := uc.repo.EndMultiplayerRound(ctx, req); err != nil {
-			return fmt.Errorf("failed to update round end in repo: %w", err)
+		result, err = uc.endRound(ctx, req.RequestTime, g, r, gs)
+		if err != nil {
+			return err
 		}
 
 		response = gs
+		ended = true
 
 		return nil
 	})
@@ -124,5 +165,148 @@ func (uc Usecase) EndRound(ctx context.Context, req dto.EndMultiplayerRoundReque
 		return nil, fmt.Errorf("failed to end round: %w", err)
 	}
 
+	if ended {
+		uc.broadcastRoundResult(result)
+	}
+
 	return response, nil
 }
+
+// ConnectSpectator returns a profile of a user, who is not a player of the game,
+// if the game can be spectated (called on websocket connect).
+func (uc Usecase) ConnectSpectator(ctx context.Context, userID, gameID int) (user.PublicProfile, error) {
+	ctx, span := uc.tracer.Start(ctx, "ConnectSpectator")
+	defer span.End()
+
+	g, err := uc.repo.GetMultiplayerGame(ctx, gameID)
+	if err != nil {
+		span.RecordError(err)
+		return user.PublicProfile{}, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	if !g.Spectators {
+		return user.PublicProfile{}, multiplayer.ErrSpectatorsNotAllowed
+	}
+
+	u, err := uc.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return user.PublicProfile{}, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return u.ToPublicProfile(), nil
+}
+
+// GetGameState returns a snapshot of a multiplayer game for a player or a spectator (called on websocket connect),
+// so that reconnecting players can restore the game without additional requests.
+// Round results and standings are revealed to spectators only after the spectator delay of the game.
+func (uc Usecase) GetGameState(
+	ctx context.Context,
+	req dto.GetMultiplayerGameStateRequest,
+) (multiplayer.GameState, error) {
+	ctx, span := uc.tracer.Start(ctx, "GetGameState")
+	defer span.End()
+
+	var response multiplayer.GameState
+
+	err := uc.repo.RunTx(ctx, func(ctx context.Context) error {
+		users, err := uc.repo.GetMultiplayerGameUsers(ctx, req.GameID)
+		if err != nil {
+			return fmt.Errorf("failed to get game users: %w", err)
+		}
+
+		inGame := slices.ContainsFunc(users, func(u user.MultiplayerUser) bool { return u.ID == req.UserID })
+		if !inGame && !req.Spectator {
+			return multiplayer.ErrGameWrongUserID
+		}
+
+		g, err := uc.repo.GetMultiplayerGame(ctx, req.GameID)
+		if err != nil {
+			return fmt.Errorf("failed to get game: %w", err)
+		}
+
+		if req.Spectator && !g.Spectators {
+			return multiplayer.ErrSpectatorsNotAllowed
+		}
+
+		r, err := uc.repo.GetMultiplayerRound(ctx, g.ID, g.RoundCurrent)
+		if err != nil {
+			return fmt.Errorf("failed to get current round: %w", err)
+		}
+
+		// spectators are notified about a new round after the spectator delay, until then they see the previous one
+		if req.Spectator && r.RoundNum > 1 && req.RequestTime.Before(r.CreatedAt.Add(g.SpectatorDelay())) {
+			r, err = uc.repo.GetMultiplayerRound(ctx, g.ID, r.RoundNum-1)
+			if err != nil {
+				return fmt.Errorf("failed to get previous round: %w", err)
+			}
+
+			g.RoundCurrent = r.RoundNum
+		}
+
+		// spectators see the round (and the game it has ended) as unfinished until its results are revealed to them
+		hidden := req.Spectator && hiddenFromSpectators(g, r, req.RequestTime)
+		if hidden {
+			r.Finished = false
+			g.Finished = false
+			g.EndedAt = time.Time{}
+		}
+
+		gs, err := uc.repo.GetMultiplayerRoundGuesses(ctx, r.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get round guesses: %w", err)
+		}
+
+		response = multiplayer.GameState{
+			Game:           g,
+			Round:          r,
+			GuessedUserIDs: make([]int, 0, len(gs)),
+		}
+
+		for _, guess := range gs {
+			response.GuessedUserIDs = append(response.GuessedUserIDs, guess.UserID)
+
+			if guess.UserID == req.UserID {
+				response.OwnGuess = &game.LatLng{Lat: guess.Lat, Lng: guess.Lng}
+			}
+		}
+
+		// standings already include results of the hidden round, so spectators receive them
+		// with the delayed round results instead
+		if !hidden {
+			response.Standings = multiplayer.NewStandings(users)
+		}
+
+		if !hidden && g.TeamGame() {
+			gameGuesses, err := uc.repo.GetMultiplayerGameGuesses(ctx, g.ID)
+			if err != nil {
+				return fmt.Errorf("failed to get game guesses: %w", err)
+			}
+
+			response.TeamStandings = multiplayer.NewTeamStandings(users, gameGuesses, g.TeamScoring)
+		}
+
+		if r.Finished {
+			response.RoundGuesses = gs
+			return nil
+		}
+
+		if deadline, ok := uc.rules(g).roundDeadline(g, r); ok {
+			response.TimerRemaining = max(deadline.Sub(req.RequestTime), 0)
+		}
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return multiplayer.GameState{}, fmt.Errorf("failed to get game state: %w", err)
+	}
+
+	return response, nil
+}
+
+// hiddenFromSpectators returns true if the round is finished, but its results are not revealed
+// to spectators of the game yet.
+func hiddenFromSpectators(g multiplayer.Game, r multiplayer.Round, requestTime time.Time) bool {
+	return r.Finished && requestTime.Before(r.EndedAt.Add(g.SpectatorDelay()))
+}
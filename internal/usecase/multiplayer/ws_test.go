@@ -10,6 +10,7 @@ import (
 	multiplayerEntity "github.com/VasySS/segoya-backend/internal/entity/game/multiplayer"
 	"github.com/VasySS/segoya-backend/internal/entity/user"
 	"github.com/VasySS/segoya-backend/internal/infrastructure/repository"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/transport"
 	"github.com/VasySS/segoya-backend/internal/usecase/multiplayer"
 	"github.com/VasySS/segoya-backend/internal/usecase/multiplayer/mocks"
 	"github.com/stretchr/testify/assert"
@@ -32,6 +33,7 @@ func TestUsecase_NewMultiplayerRoundGuess(t *testing.T) {
 	type fields struct {
 		repo *mocks.Repository
 		pano *mocks.PanoramaUsecase
+		ws   *mocks.Broadcaster
 	}
 
 	type args struct {
@@ -55,10 +57,16 @@ func TestUsecase_NewMultiplayerRoundGuess(t *testing.T) {
 						return fn(ctx)
 					})
 
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
 				gameResponse := multiplayerEntity.Game{
-					ID:           args.req.GameID,
-					RoundCurrent: 2,
-					Provider:     "google",
+					ID:            args.req.GameID,
+					RoundCurrent:  2,
+					Provider:      game.MixedProvider,
+					ScoreDistance: 120,
+					Scoring:       game.HardScoring,
+					TimerSeconds:  60,
 				}
 
 				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
@@ -76,27 +84,104 @@ func TestUsecase_NewMultiplayerRoundGuess(t *testing.T) {
 						},
 					}, nil)
 
+				// score of mixed provider game rounds depends on provider of the round
 				roundResponse := multiplayerEntity.Round{
-					ID:       1,
-					RoundNum: gameResponse.RoundCurrent,
-					Finished: false,
+					ID:        1,
+					RoundNum:  gameResponse.RoundCurrent,
+					Provider:  game.YandexProvider,
+					Lat:       11.22,
+					Lng:       33.44,
+					Finished:  false,
+					StartedAt: args.req.RequestTime.Add(-10 * time.Second),
 				}
 
 				fs.repo.On("GetMultiplayerRound", mock.Anything, args.req.GameID, gameResponse.RoundCurrent).
 					Return(roundResponse, nil)
 
-				fs.pano.On("CalculateScoreAndDistance",
-					gameResponse.Provider, roundResponse.Lat, roundResponse.Lng, args.req.Guess.Lat, args.req.Guess.Lng).
-					Return(4567, 1234)
+				fs.pano.On("CalculateScore", dto.ScoreRequest{
+					Provider:      roundResponse.Provider,
+					Scoring:       gameResponse.Scoring,
+					ScoreDistance: gameResponse.ScoreDistance,
+					Location:      roundResponse.Location(),
+					Guess:         args.req.Guess,
+					StartedAt:     roundResponse.StartedAt,
+					GuessedAt:     args.req.RequestTime,
+					TimerSeconds:  gameResponse.TimerSeconds,
+				}).
+					Return(game.Score{Base: 4600, Bonus: 17, Penalty: 50, Distance: 1234})
+				fs.pano.On("Place", args.req.Guess).
+					Return(game.Place{CountryCode: "CZ", RegionCode: "CZ-10"})
 
 				fs.repo.On("NewMultiplayerRoundGuess", mock.Anything, dto.NewMultiplayerRoundGuessRequestDB{
-					RequestTime: args.req.RequestTime,
-					RoundID:     roundResponse.ID,
-					UserID:      args.req.UserID,
-					Lat:         args.req.Guess.Lat,
-					Lng:         args.req.Guess.Lng,
-					Score:       4567,
-					Distance:    1234,
+					RequestTime:  args.req.RequestTime,
+					RoundID:      roundResponse.ID,
+					UserID:       args.req.UserID,
+					Lat:          args.req.Guess.Lat,
+					Lng:          args.req.Guess.Lng,
+					Score:        4567,
+					ScoreBonus:   17,
+					ScorePenalty: 50,
+					Distance:     1234,
+					CountryCode:  "CZ",
+				}).Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "first guess of duel round starts round timer",
+			args: args{
+				req: saveGuessReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				gameResponse := multiplayerEntity.Game{
+					ID:           args.req.GameID,
+					RoundCurrent: 1,
+					Provider:     game.GoogleProvider,
+					Mode:         multiplayerEntity.DuelMode,
+					Health:       6000,
+				}
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(gameResponse, nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return([]user.MultiplayerUser{
+						{PublicProfile: user.PublicProfile{ID: 1, Username: "username1"}, Health: 6000},
+						{PublicProfile: user.PublicProfile{ID: 2, Username: "username2"}, Health: 6000},
+					}, nil)
+
+				roundResponse := multiplayerEntity.Round{
+					ID:        1,
+					RoundNum:  gameResponse.RoundCurrent,
+					Provider:  game.GoogleProvider,
+					StartedAt: args.req.RequestTime.Add(-10 * time.Second),
+				}
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, args.req.GameID, gameResponse.RoundCurrent).
+					Return(roundResponse, nil)
+
+				fs.pano.On("CalculateScore", mock.AnythingOfType("dto.ScoreRequest")).
+					Return(game.Score{Base: 3000})
+				fs.pano.On("Place", args.req.Guess).
+					Return(game.Place{})
+
+				fs.repo.On("NewMultiplayerRoundGuess", mock.Anything, mock.AnythingOfType("dto.NewMultiplayerRoundGuessRequestDB")).
+					Return(nil)
+
+				fs.ws.On("Broadcast", "123", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageRoundTimer,
+					Payload: map[string]any{
+						"roundNum": roundResponse.RoundNum,
+						"endsAt":   args.req.RequestTime.Add(15 * time.Second),
+					},
 				}).Return(nil)
 			},
 			wantErr: assert.NoError,
@@ -112,6 +197,9 @@ func TestUsecase_NewMultiplayerRoundGuess(t *testing.T) {
 						return fn(ctx)
 					})
 
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
 				gameResponse := multiplayerEntity.Game{
 					ID:           args.req.GameID,
 					RoundCurrent: 2,
@@ -146,6 +234,42 @@ func TestUsecase_NewMultiplayerRoundGuess(t *testing.T) {
 				return assert.ErrorIs(t, err, multiplayerEntity.ErrRoundAlreadyFinished)
 			},
 		},
+		{
+			name: "eliminated player can't guess",
+			args: args{
+				req: saveGuessReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(multiplayerEntity.Game{
+						ID:           args.req.GameID,
+						RoundCurrent: 3,
+						Mode:         multiplayerEntity.BattleRoyaleMode,
+					}, nil)
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return([]user.MultiplayerUser{
+						{
+							PublicProfile:   user.PublicProfile{ID: 1, Username: "username1"},
+							EliminatedRound: 2,
+						},
+						{
+							PublicProfile: user.PublicProfile{ID: 2, Username: "username2"},
+						},
+					}, nil)
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, multiplayerEntity.ErrUserEliminated)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -154,13 +278,16 @@ func TestUsecase_NewMultiplayerRoundGuess(t *testing.T) {
 
 			repo := mocks.NewRepository(t)
 			pano := mocks.NewPanoramaUsecase(t)
+			ws := mocks.NewBroadcaster(t)
 			fs := fields{
 				repo: repo,
 				pano: pano,
+				ws:   ws,
 			}
 			tt.setup(fs, tt.args)
 
-			uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano)
+			uc := multiplayer.NewUsecase(multiplayer.Config{DuelGuessTimer: 15 * time.Second}, repo, pano, ws)
+			t.Cleanup(uc.Close)
 
 			err := uc.NewRoundGuess(t.Context(), tt.args.req)
 			tt.wantErr(t, err)
@@ -193,6 +320,7 @@ func TestUsecase_EndRound(t *testing.T) {
 	type fields struct {
 		repo *mocks.Repository
 		pano *mocks.PanoramaUsecase
+		ws   *mocks.Broadcaster
 	}
 
 	type args struct {
@@ -223,18 +351,21 @@ func TestUsecase_EndRound(t *testing.T) {
 				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
 					Return([]user.MultiplayerUser{
 						{
-							PublicProfile: user.PublicProfile{ID: 1, Username: "username1"},
+							PublicProfile: user.PublicProfile{ID: 2, Username: "username2"},
 							Connected:     true,
+							Score:         1234,
 						},
 						{
-							PublicProfile: user.PublicProfile{ID: 2, Username: "username2"},
+							PublicProfile: user.PublicProfile{ID: 1, Username: "username1"},
 							Connected:     true,
+							Score:         4567,
 						},
 					}, nil)
 
 				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
 					Return(multiplayerEntity.Game{
 						ID:           args.req.GameID,
+						Rounds:       5,
 						RoundCurrent: 1,
 						TimerSeconds: 30,
 						Players:      2,
@@ -245,6 +376,8 @@ func TestUsecase_EndRound(t *testing.T) {
 					Return(multiplayerEntity.Round{
 						ID:           1,
 						RoundNum:     1,
+						Lat:          12.34,
+						Lng:          56.78,
 						GuessesCount: 2,
 						Finished:     false,
 						StartedAt:    args.req.RequestTime.Add(-31 * time.Second),
@@ -257,6 +390,19 @@ func TestUsecase_EndRound(t *testing.T) {
 					RequestTime: args.req.RequestTime,
 					RoundID:     1,
 				}).Return(nil)
+
+				fs.ws.On("Broadcast", "1", transport.WebSocketMessageOutput{
+					Type: dto.MultiplayerMessageRoundFinished,
+					Payload: map[string]any{
+						"roundNum": 1,
+						"location": game.LatLng{Lat: 12.34, Lng: 56.78},
+						"guesses":  endRoundResponse,
+						"standings": []multiplayerEntity.Standing{
+							{Place: 1, UserID: 1, Username: "username1", Score: 4567},
+							{Place: 2, UserID: 2, Username: "username2", Score: 1234},
+						},
+					},
+				}).Return(nil)
 			},
 			want:    endRoundResponse,
 			wantErr: assert.NoError,
@@ -290,6 +436,7 @@ func TestUsecase_EndRound(t *testing.T) {
 				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
 					Return(multiplayerEntity.Game{
 						ID:           args.req.GameID,
+						Rounds:       5,
 						RoundCurrent: 1,
 						TimerSeconds: 30,
 						Players:      2,
@@ -340,6 +487,7 @@ func TestUsecase_EndRound(t *testing.T) {
 				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
 					Return(multiplayerEntity.Game{
 						ID:           args.req.GameID,
+						Rounds:       5,
 						RoundCurrent: 1,
 						TimerSeconds: 30,
 						Players:      2,
@@ -371,13 +519,15 @@ func TestUsecase_EndRound(t *testing.T) {
 
 			repo := mocks.NewRepository(t)
 			pano := mocks.NewPanoramaUsecase(t)
+			ws := mocks.NewBroadcaster(t)
 			fs := fields{
 				repo: repo,
 				pano: pano,
+				ws:   ws,
 			}
 			tt.setup(fs, tt.args)
 
-			uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano)
+			uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano, ws)
 
 			got, err := uc.EndRound(t.Context(), tt.args.req)
 			tt.wantErr(t, err)
@@ -385,3 +535,355 @@ func TestUsecase_EndRound(t *testing.T) {
 		})
 	}
 }
+
+func TestUsecase_GetGameState(t *testing.T) {
+	t.Parallel()
+
+	getStateReq := dto.GetMultiplayerGameStateRequest{
+		RequestTime: time.Now().UTC(),
+		GameID:      1,
+		UserID:      1,
+	}
+
+	gameResponse := multiplayerEntity.Game{
+		ID:           1,
+		Rounds:       5,
+		RoundCurrent: 2,
+		TimerSeconds: 60,
+		Players:      2,
+	}
+
+	gameUsers := []user.MultiplayerUser{
+		{PublicProfile: user.PublicProfile{ID: 1, Username: "username1"}, Score: 1000},
+		{PublicProfile: user.PublicProfile{ID: 2, Username: "username2"}, Score: 3000},
+	}
+
+	standings := []multiplayerEntity.Standing{
+		{Place: 1, UserID: 2, Username: "username2", Score: 3000},
+		{Place: 2, UserID: 1, Username: "username1", Score: 1000},
+	}
+
+	roundGuesses := []multiplayerEntity.Guess{
+		{UserID: 1, Username: "username1", RoundNum: 2, Lat: 1.5, Lng: 2.5, Score: 500},
+	}
+
+	type fields struct {
+		repo *mocks.Repository
+	}
+
+	type args struct {
+		req dto.GetMultiplayerGameStateRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		want    multiplayerEntity.GameState
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "active round with own guess",
+			args: args{
+				req: getStateReq,
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return(gameUsers, nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(gameResponse, nil)
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, gameResponse.ID, gameResponse.RoundCurrent).
+					Return(multiplayerEntity.Round{
+						ID:        7,
+						RoundNum:  2,
+						StartedAt: args.req.RequestTime.Add(-20 * time.Second),
+					}, nil)
+
+				fs.repo.On("GetMultiplayerRoundGuesses", mock.Anything, 7).
+					Return(roundGuesses, nil)
+			},
+			want: multiplayerEntity.GameState{
+				Game: gameResponse,
+				Round: multiplayerEntity.Round{
+					ID:        7,
+					RoundNum:  2,
+					StartedAt: getStateReq.RequestTime.Add(-20 * time.Second),
+				},
+				OwnGuess:       &game.LatLng{Lat: 1.5, Lng: 2.5},
+				GuessedUserIDs: []int{1},
+				TimerRemaining: 40 * time.Second,
+				Standings:      standings,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "finished round without own guess",
+			args: args{
+				req: dto.GetMultiplayerGameStateRequest{
+					RequestTime: getStateReq.RequestTime,
+					GameID:      getStateReq.GameID,
+					UserID:      2,
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return(gameUsers, nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(gameResponse, nil)
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, gameResponse.ID, gameResponse.RoundCurrent).
+					Return(multiplayerEntity.Round{
+						ID:       7,
+						RoundNum: 2,
+						Finished: true,
+					}, nil)
+
+				fs.repo.On("GetMultiplayerRoundGuesses", mock.Anything, 7).
+					Return(roundGuesses, nil)
+			},
+			want: multiplayerEntity.GameState{
+				Game: gameResponse,
+				Round: multiplayerEntity.Round{
+					ID:       7,
+					RoundNum: 2,
+					Finished: true,
+				},
+				GuessedUserIDs: []int{1},
+				Standings:      standings,
+				RoundGuesses:   roundGuesses,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "spectator sees finished round as active without standings until results are revealed",
+			args: args{
+				req: dto.GetMultiplayerGameStateRequest{
+					RequestTime: getStateReq.RequestTime,
+					GameID:      getStateReq.GameID,
+					UserID:      3,
+					Spectator:   true,
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return(gameUsers, nil)
+
+				spectatedGame := gameResponse
+				spectatedGame.Spectators = true
+				spectatedGame.SpectatorDelaySeconds = 30
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(spectatedGame, nil)
+
+				fs.repo.On("GetMultiplayerRound", mock.Anything, gameResponse.ID, gameResponse.RoundCurrent).
+					Return(multiplayerEntity.Round{
+						ID:        7,
+						RoundNum:  2,
+						Finished:  true,
+						StartedAt: args.req.RequestTime.Add(-70 * time.Second),
+						EndedAt:   args.req.RequestTime.Add(-10 * time.Second),
+					}, nil)
+
+				fs.repo.On("GetMultiplayerRoundGuesses", mock.Anything, 7).
+					Return(roundGuesses, nil)
+			},
+			want: multiplayerEntity.GameState{
+				Game: multiplayerEntity.Game{
+					ID:                    1,
+					Rounds:                5,
+					RoundCurrent:          2,
+					TimerSeconds:          60,
+					Players:               2,
+					Spectators:            true,
+					SpectatorDelaySeconds: 30,
+				},
+				Round: multiplayerEntity.Round{
+					ID:        7,
+					RoundNum:  2,
+					StartedAt: getStateReq.RequestTime.Add(-70 * time.Second),
+					EndedAt:   getStateReq.RequestTime.Add(-10 * time.Second),
+				},
+				GuessedUserIDs: []int{1},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "game can't be spectated",
+			args: args{
+				req: dto.GetMultiplayerGameStateRequest{
+					RequestTime: getStateReq.RequestTime,
+					GameID:      getStateReq.GameID,
+					UserID:      3,
+					Spectator:   true,
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return(gameUsers, nil)
+
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.req.GameID).
+					Return(gameResponse, nil)
+			},
+			want: multiplayerEntity.GameState{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, multiplayerEntity.ErrSpectatorsNotAllowed)
+			},
+		},
+		{
+			name: "user is not in game",
+			args: args{
+				req: dto.GetMultiplayerGameStateRequest{
+					RequestTime: getStateReq.RequestTime,
+					GameID:      getStateReq.GameID,
+					UserID:      3,
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("GetMultiplayerGameUsers", mock.Anything, args.req.GameID).
+					Return(gameUsers, nil)
+			},
+			want: multiplayerEntity.GameState{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, multiplayerEntity.ErrGameWrongUserID)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			pano := mocks.NewPanoramaUsecase(t)
+			ws := mocks.NewBroadcaster(t)
+			fs := fields{
+				repo: repo,
+			}
+			tt.setup(fs, tt.args)
+
+			uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano, ws)
+
+			got, err := uc.GetGameState(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUsecase_ConnectSpectator(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		repo *mocks.Repository
+	}
+
+	type args struct {
+		userID int
+		gameID int
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		want    user.PublicProfile
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "successfully connect spectator",
+			args: args{
+				userID: 3,
+				gameID: 1,
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.gameID).
+					Return(multiplayerEntity.Game{ID: 1, Spectators: true}, nil)
+
+				fs.repo.On("GetUserByID", mock.Anything, args.userID).
+					Return(user.PrivateProfile{
+						PublicProfile: user.PublicProfile{ID: 3, Username: "username3", Name: "name3"},
+					}, nil)
+			},
+			want:    user.PublicProfile{ID: 3, Username: "username3", Name: "name3"},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "game can't be spectated",
+			args: args{
+				userID: 3,
+				gameID: 1,
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.gameID).
+					Return(multiplayerEntity.Game{ID: 1}, nil)
+			},
+			want: user.PublicProfile{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, multiplayerEntity.ErrSpectatorsNotAllowed)
+			},
+		},
+		{
+			name: "game not found",
+			args: args{
+				userID: 3,
+				gameID: 1,
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("GetMultiplayerGame", mock.Anything, args.gameID).
+					Return(multiplayerEntity.Game{}, multiplayerEntity.ErrGameNotFound)
+			},
+			want: user.PublicProfile{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, multiplayerEntity.ErrGameNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			pano := mocks.NewPanoramaUsecase(t)
+			ws := mocks.NewBroadcaster(t)
+			fs := fields{
+				repo: repo,
+			}
+			tt.setup(fs, tt.args)
+
+			uc := multiplayer.NewUsecase(multiplayer.Config{}, repo, pano, ws)
+
+			got, err := uc.ConnectSpectator(t.Context(), tt.args.userID, tt.args.gameID)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
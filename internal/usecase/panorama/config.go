@@ -1,11 +1,31 @@
 package panorama
 
-import "github.com/VasySS/segoya-backend/internal/config"
+import (
+	"github.com/VasySS/segoya-backend/internal/config"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+)
 
 // Config contains configuration for panorama usecase.
-type Config struct{}
+type Config struct {
+	// RecentGamesExcluded is the amount of last games of a player, which locations are not repeated.
+	// 0 means that only locations of the current game are not repeated.
+	RecentGamesExcluded int
+	// MixedProviderWeights are relative chances of providers to be picked for a round of mixed provider game.
+	MixedProviderWeights map[game.PanoramaProvider]int
+	// MinScoreDistance is the lowest score distance in km, used for maps with a few close locations.
+	MinScoreDistance float64
+}
 
 // NewConfig returns a new local config from general config.
-func NewConfig(_ config.Config) Config {
-	return Config{}
+func NewConfig(cfg config.Config) Config {
+	weights := make(map[game.PanoramaProvider]int, len(cfg.PanoramaProviders))
+	for _, provider := range cfg.PanoramaProviders {
+		weights[provider.Name] = provider.MixedWeight
+	}
+
+	return Config{
+		RecentGamesExcluded:  cfg.Limits.RecentGamesExcluded,
+		MixedProviderWeights: weights,
+		MinScoreDistance:     cfg.Limits.MinScoreDistance,
+	}
 }
@@ -0,0 +1,13 @@
+package panorama
+
+import "github.com/VasySS/segoya-backend/internal/entity/game"
+
+// Place returns a country and a region of the location, it is empty for locations outside of known countries
+// and if countries can't be resolved in the application.
+func (uc Usecase) Place(location game.LatLng) game.Place {
+	if uc.countries == nil {
+		return game.Place{}
+	}
+
+	return uc.countries.Place(location.Lat, location.Lng)
+}
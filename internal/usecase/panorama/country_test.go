@@ -0,0 +1,36 @@
+package panorama_test
+
+import (
+	"testing"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/usecase/panorama"
+	"github.com/VasySS/segoya-backend/internal/usecase/panorama/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsecase_Place(t *testing.T) {
+	t.Parallel()
+
+	location := game.LatLng{Lat: 50.08, Lng: 14.43}
+
+	t.Run("place of location", func(t *testing.T) {
+		t.Parallel()
+
+		resolver := mocks.NewCountryResolver(t)
+		resolver.On("Place", location.Lat, location.Lng).
+			Return(game.Place{CountryCode: "CZ", RegionCode: "CZ-10"})
+
+		uc := panorama.NewUsecase(panorama.Config{}, nil, nil, nil, resolver)
+
+		assert.Equal(t, game.Place{CountryCode: "CZ", RegionCode: "CZ-10"}, uc.Place(location))
+	})
+
+	t.Run("countries can't be resolved", func(t *testing.T) {
+		t.Parallel()
+
+		uc := panorama.NewUsecase(panorama.Config{}, nil, nil, nil, nil)
+
+		assert.Equal(t, game.Place{}, uc.Place(location))
+	})
+}
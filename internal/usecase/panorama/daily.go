@@ -0,0 +1,74 @@
+package panorama
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/rand/v2"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+)
+
+// ErrNotEnoughLocations is returned when provider has less locations than requested.
+var ErrNotEnoughLocations = errors.New("not enough locations")
+
+// DailyLocationIDs selects IDs of distinct provider locations for a daily challenge.
+// Selection is seeded by date and provider, so it always returns the same locations
+// for the same date, as long as provider locations do not change.
+func (uc Usecase) DailyLocationIDs(
+	ctx context.Context,
+	date time.Time,
+	provider game.PanoramaProvider,
+	count int,
+) ([]int, error) {
+	ctx, span := uc.tracer.Start(ctx, "DailyLocationIDs")
+	defer span.End()
+
+	total, err := uc.repo.CountPanoramaLocations(ctx, provider)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("failed to count panorama locations: %w", err)
+	}
+
+	if total < count {
+		return nil, ErrNotEnoughLocations
+	}
+
+	ids, err := uc.repo.GetPanoramaLocationIDs(ctx, provider, dailyOffsets(date, provider, total, count))
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("failed to get panorama locations: %w", err)
+	}
+
+	// locations could be deleted between queries
+	if len(ids) != count {
+		return nil, ErrNotEnoughLocations
+	}
+
+	return ids, nil
+}
+
+// dailyOffsets returns count distinct offsets in range [0, total), seeded by UTC date and provider.
+func dailyOffsets(date time.Time, provider game.PanoramaProvider, total, count int) []int {
+	seed := fnv.New64a()
+	_, _ = seed.Write([]byte(date.UTC().Format(time.DateOnly) + ":" + string(provider)))
+
+	rng := rand.New(rand.NewPCG(seed.Sum64(), 0)) //nolint:gosec // selection must be reproducible, not secure
+
+	picked := make(map[int]struct{}, count)
+	offsets := make([]int, 0, count)
+
+	for len(offsets) < count {
+		offset := rng.IntN(total)
+		if _, ok := picked[offset]; ok {
+			continue
+		}
+
+		picked[offset] = struct{}{}
+		offsets = append(offsets, offset)
+	}
+
+	return offsets
+}
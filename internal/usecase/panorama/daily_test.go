@@ -0,0 +1,153 @@
+package panorama_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/usecase/panorama"
+	"github.com/VasySS/segoya-backend/internal/usecase/panorama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsecase_DailyLocationIDs(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		repo *mocks.PanoramaRepository
+	}
+
+	type args struct {
+		date     time.Time
+		provider game.PanoramaProvider
+		count    int
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		want    []int
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "successfully select locations",
+			args: args{date: date, provider: game.GoogleProvider, count: 5},
+			setup: func(f fields, a args) {
+				f.repo.On("CountPanoramaLocations", mock.Anything, a.provider).
+					Return(1000, nil)
+				f.repo.On("GetPanoramaLocationIDs", mock.Anything, a.provider, mock.AnythingOfType("[]int")).
+					Return([]int{11, 22, 33, 44, 55}, nil)
+			},
+			want:    []int{11, 22, 33, 44, 55},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "not enough provider locations",
+			args: args{date: date, provider: game.SeznamProvider, count: 5},
+			setup: func(f fields, a args) {
+				f.repo.On("CountPanoramaLocations", mock.Anything, a.provider).
+					Return(4, nil)
+			},
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, panorama.ErrNotEnoughLocations)
+			},
+		},
+		{
+			name: "locations were deleted during selection",
+			args: args{date: date, provider: game.GoogleProvider, count: 5},
+			setup: func(f fields, a args) {
+				f.repo.On("CountPanoramaLocations", mock.Anything, a.provider).
+					Return(1000, nil)
+				f.repo.On("GetPanoramaLocationIDs", mock.Anything, a.provider, mock.AnythingOfType("[]int")).
+					Return([]int{11, 22}, nil)
+			},
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, panorama.ErrNotEnoughLocations)
+			},
+		},
+		{
+			name: "error while counting locations",
+			args: args{date: date, provider: game.GoogleProvider, count: 5},
+			setup: func(f fields, a args) {
+				f.repo.On("CountPanoramaLocations", mock.Anything, a.provider).
+					Return(0, errors.New("some repository error"))
+			},
+			want:    nil,
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewPanoramaRepository(t)
+			fs := fields{repo: repo}
+			uc := panorama.NewUsecase(panorama.Config{}, repo, nil, nil, nil)
+
+			tt.setup(fs, tt.args)
+
+			got, err := uc.DailyLocationIDs(t.Context(), tt.args.date, tt.args.provider, tt.args.count)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUsecase_DailyLocationIDsIsDeterministic(t *testing.T) {
+	t.Parallel()
+
+	const (
+		total = 50
+		count = 5
+	)
+
+	date := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+
+	selectOffsets := func(date time.Time, provider game.PanoramaProvider) []int {
+		t.Helper()
+
+		repo := mocks.NewPanoramaRepository(t)
+		uc := panorama.NewUsecase(panorama.Config{}, repo, nil, nil, nil)
+
+		var offsets []int
+
+		repo.On("CountPanoramaLocations", mock.Anything, provider).
+			Return(total, nil)
+		repo.On("GetPanoramaLocationIDs", mock.Anything, provider, mock.AnythingOfType("[]int")).
+			Run(func(args mock.Arguments) {
+				offsets, _ = args.Get(2).([]int)
+			}).
+			Return(make([]int, count), nil)
+
+		_, err := uc.DailyLocationIDs(t.Context(), date, provider, count)
+		require.NoError(t, err)
+
+		return offsets
+	}
+
+	offsets := selectOffsets(date, game.GoogleProvider)
+	require.Len(t, offsets, count)
+
+	seen := make(map[int]bool, count)
+	for _, offset := range offsets {
+		assert.GreaterOrEqual(t, offset, 0)
+		assert.Less(t, offset, total)
+		assert.False(t, seen[offset], "offsets must be distinct")
+
+		seen[offset] = true
+	}
+
+	// time of the day does not change the selection
+	assert.Equal(t, offsets, selectOffsets(date.Add(23*time.Hour), game.GoogleProvider))
+	assert.NotEqual(t, offsets, selectOffsets(date.AddDate(0, 0, 1), game.GoogleProvider))
+	assert.NotEqual(t, offsets, selectOffsets(date, game.YandexProvider))
+}
@@ -0,0 +1,35 @@
+package panorama
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+)
+
+// NewMapStreetview gets a random streetview of a map from the database, skipping excluded location IDs.
+// Streetview ID of the location is found by the map provider, if the location was imported without it.
+func (uc Usecase) NewMapStreetview(ctx context.Context, mapID int, exclude []int) (game.PanoramaMetadata, error) {
+	ctx, span := uc.tracer.Start(ctx, "NewMapStreetview")
+	defer span.End()
+
+	panorama, err := uc.repo.RandomMapStreetview(ctx, mapID, exclude)
+	if err != nil {
+		span.RecordError(err)
+		return game.PanoramaMetadata{}, fmt.Errorf("failed to get random map point from db: %w", err)
+	}
+
+	p, err := uc.providers.Get(panorama.Provider)
+	if err != nil {
+		span.RecordError(err)
+		return game.PanoramaMetadata{}, err
+	}
+
+	panorama, err = p.Metadata(ctx, panorama)
+	if err != nil {
+		span.RecordError(err)
+		return game.PanoramaMetadata{}, fmt.Errorf("failed to locate map point: %w", err)
+	}
+
+	return panorama, nil
+}
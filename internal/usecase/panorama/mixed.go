@@ -0,0 +1,43 @@
+package panorama
+
+import (
+	"math/rand/v2"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+)
+
+// mixedProvider picks a provider for a round of mixed provider game from candidates according to configured
+// weights. Providers without configured weight have weight 1, if all weights are 0 - provider is picked uniformly.
+func (uc Usecase) mixedProvider(allowed []game.PanoramaProvider) (game.PanoramaProvider, error) {
+	weights := make([]int, len(allowed))
+	total := 0
+
+	for i, name := range allowed {
+		if _, err := uc.providers.Get(name); err != nil {
+			return "", err
+		}
+
+		weight, ok := uc.cfg.MixedProviderWeights[name]
+		if !ok {
+			weight = 1
+		}
+
+		weights[i] = max(weight, 0)
+		total += weights[i]
+	}
+
+	if total == 0 {
+		return allowed[rand.IntN(len(allowed))], nil //nolint:gosec // provider choice does not need to be secure
+	}
+
+	n := rand.IntN(total) //nolint:gosec // provider choice does not need to be secure
+	for i, weight := range weights {
+		if n < weight {
+			return allowed[i], nil
+		}
+
+		n -= weight
+	}
+
+	return allowed[len(allowed)-1], nil
+}
@@ -0,0 +1,172 @@
+package panorama_test
+
+import (
+	"testing"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/usecase/panorama"
+	"github.com/VasySS/segoya-backend/internal/usecase/panorama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsecase_NewStreetviewMixed(t *testing.T) {
+	t.Parallel()
+
+	panoMetadata := game.PanoramaMetadata{
+		ID: 432432,
+		LatLng: game.LatLng{
+			Lat: 1.1234,
+			Lng: 2.3456,
+		},
+	}
+
+	googleMetadata := panoMetadata
+	googleMetadata.Provider = game.GoogleProvider
+
+	seznamMetadata := panoMetadata
+	seznamMetadata.Provider = game.SeznamProvider
+
+	type fields struct {
+		google *mocks.Provider
+		seznam *mocks.Provider
+	}
+
+	type args struct {
+		req dto.NewStreetviewRequest
+	}
+
+	tests := []struct {
+		name    string
+		cfg     panorama.Config
+		args    args
+		setup   func(fields, args)
+		want    game.PanoramaMetadata
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "pick only allowed provider",
+			cfg:  panorama.Config{},
+			args: args{req: dto.NewStreetviewRequest{
+				Provider:  game.MixedProvider,
+				Providers: []game.PanoramaProvider{game.SeznamProvider},
+			}},
+			setup: func(f fields, _ args) {
+				f.seznam.On("Random", mock.Anything, []int(nil)).
+					Return(panoMetadata, nil)
+			},
+			want:    seznamMetadata,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "pick provider by weights from all providers",
+			cfg: panorama.Config{MixedProviderWeights: map[game.PanoramaProvider]int{
+				game.GoogleProvider: 1,
+				game.SeznamProvider: 0,
+			}},
+			args: args{req: dto.NewStreetviewRequest{
+				Provider: game.MixedProvider,
+			}},
+			setup: func(f fields, _ args) {
+				f.google.On("Random", mock.Anything, []int(nil)).
+					Return(panoMetadata, nil)
+			},
+			want:    googleMetadata,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "pick provider uniformly when all weights are zero",
+			cfg: panorama.Config{MixedProviderWeights: map[game.PanoramaProvider]int{
+				game.GoogleProvider: 0,
+				game.SeznamProvider: 0,
+			}},
+			args: args{req: dto.NewStreetviewRequest{
+				Provider:  game.MixedProvider,
+				Providers: []game.PanoramaProvider{game.GoogleProvider},
+			}},
+			setup: func(f fields, _ args) {
+				f.google.On("Random", mock.Anything, []int(nil)).
+					Return(panoMetadata, nil)
+			},
+			want:    googleMetadata,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "pick another provider when the picked one has no panoramas",
+			cfg: panorama.Config{MixedProviderWeights: map[game.PanoramaProvider]int{
+				game.GoogleProvider: 1,
+				game.SeznamProvider: 0,
+			}},
+			args: args{req: dto.NewStreetviewRequest{
+				Provider: game.MixedProvider,
+			}},
+			setup: func(f fields, _ args) {
+				f.google.On("Random", mock.Anything, []int(nil)).
+					Return(game.PanoramaMetadata{}, game.ErrPanoramaNotFound)
+
+				f.seznam.On("Random", mock.Anything, []int(nil)).
+					Return(panoMetadata, nil)
+			},
+			want:    seznamMetadata,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "no allowed provider has panoramas",
+			cfg:  panorama.Config{},
+			args: args{req: dto.NewStreetviewRequest{
+				Provider:  game.MixedProvider,
+				Providers: []game.PanoramaProvider{game.GoogleProvider, game.SeznamProvider},
+			}},
+			setup: func(f fields, _ args) {
+				f.google.On("Random", mock.Anything, []int(nil)).
+					Return(game.PanoramaMetadata{}, game.ErrPanoramaNotFound)
+
+				f.seznam.On("Random", mock.Anything, []int(nil)).
+					Return(game.PanoramaMetadata{}, game.ErrPanoramaNotFound)
+			},
+			want: game.PanoramaMetadata{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, game.ErrPanoramaNotFound)
+			},
+		},
+		{
+			name: "unknown allowed provider",
+			cfg:  panorama.Config{},
+			args: args{req: dto.NewStreetviewRequest{
+				Provider:  game.MixedProvider,
+				Providers: []game.PanoramaProvider{game.GoogleProvider, game.YandexProvider},
+			}},
+			setup: func(_ fields, _ args) {},
+			want:  game.PanoramaMetadata{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, game.ErrUnknownProvider)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			google := mocks.NewProvider(t)
+			seznam := mocks.NewProvider(t)
+			fs := fields{google: google, seznam: seznam}
+
+			google.On("Name").Return(game.GoogleProvider)
+			seznam.On("Name").Return(game.SeznamProvider)
+
+			providers, err := panorama.NewRegistry(google, seznam)
+			require.NoError(t, err)
+
+			uc := panorama.NewUsecase(tt.cfg, mocks.NewPanoramaRepository(t), providers, nil, nil)
+
+			tt.setup(fs, tt.args)
+
+			got, err := uc.NewStreetview(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
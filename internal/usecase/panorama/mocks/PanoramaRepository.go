@@ -14,83 +14,27 @@ type PanoramaRepository struct {
 	mock.Mock
 }
 
-// GetGoogleStreetview provides a mock function with given fields: ctx, id
-func (_m *PanoramaRepository) GetGoogleStreetview(ctx context.Context, id int) (game.GoogleStreetview, error) {
-	ret := _m.Called(ctx, id)
+// CountPanoramaLocations provides a mock function with given fields: ctx, provider
+func (_m *PanoramaRepository) CountPanoramaLocations(ctx context.Context, provider game.PanoramaProvider) (int, error) {
+	ret := _m.Called(ctx, provider)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetGoogleStreetview")
+		panic("no return value specified for CountPanoramaLocations")
 	}
 
-	var r0 game.GoogleStreetview
+	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) (game.GoogleStreetview, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, game.PanoramaProvider) (int, error)); ok {
+		return rf(ctx, provider)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) game.GoogleStreetview); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, game.PanoramaProvider) int); ok {
+		r0 = rf(ctx, provider)
 	} else {
-		r0 = ret.Get(0).(game.GoogleStreetview)
+		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, id)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// GetSeznamStreetview provides a mock function with given fields: ctx, id
-func (_m *PanoramaRepository) GetSeznamStreetview(ctx context.Context, id int) (game.SeznamStreetview, error) {
-	ret := _m.Called(ctx, id)
-
-	if len(ret) == 0 {
-		panic("no return value specified for GetSeznamStreetview")
-	}
-
-	var r0 game.SeznamStreetview
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) (game.SeznamStreetview, error)); ok {
-		return rf(ctx, id)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) game.SeznamStreetview); ok {
-		r0 = rf(ctx, id)
-	} else {
-		r0 = ret.Get(0).(game.SeznamStreetview)
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, id)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// GetYandexAirview provides a mock function with given fields: ctx, id
-func (_m *PanoramaRepository) GetYandexAirview(ctx context.Context, id int) (game.YandexAirview, error) {
-	ret := _m.Called(ctx, id)
-
-	if len(ret) == 0 {
-		panic("no return value specified for GetYandexAirview")
-	}
-
-	var r0 game.YandexAirview
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) (game.YandexAirview, error)); ok {
-		return rf(ctx, id)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) game.YandexAirview); ok {
-		r0 = rf(ctx, id)
-	} else {
-		r0 = ret.Get(0).(game.YandexAirview)
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, game.PanoramaProvider) error); ok {
+		r1 = rf(ctx, provider)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -98,27 +42,27 @@ func (_m *PanoramaRepository) GetYandexAirview(ctx context.Context, id int) (gam
 	return r0, r1
 }
 
-// GetYandexStreetview provides a mock function with given fields: ctx, id
-func (_m *PanoramaRepository) GetYandexStreetview(ctx context.Context, id int) (game.YandexStreetview, error) {
-	ret := _m.Called(ctx, id)
+// GetMapLocationBounds provides a mock function with given fields: ctx, mapID
+func (_m *PanoramaRepository) GetMapLocationBounds(ctx context.Context, mapID int) (game.Bounds, error) {
+	ret := _m.Called(ctx, mapID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetYandexStreetview")
+		panic("no return value specified for GetMapLocationBounds")
 	}
 
-	var r0 game.YandexStreetview
+	var r0 game.Bounds
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) (game.YandexStreetview, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (game.Bounds, error)); ok {
+		return rf(ctx, mapID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) game.YandexStreetview); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) game.Bounds); ok {
+		r0 = rf(ctx, mapID)
 	} else {
-		r0 = ret.Get(0).(game.YandexStreetview)
+		r0 = ret.Get(0).(game.Bounds)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, id)
+		r1 = rf(ctx, mapID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -126,27 +70,27 @@ func (_m *PanoramaRepository) GetYandexStreetview(ctx context.Context, id int) (
 	return r0, r1
 }
 
-// RandomGoogleStreetview provides a mock function with given fields: ctx
-func (_m *PanoramaRepository) RandomGoogleStreetview(ctx context.Context) (game.GoogleStreetview, error) {
-	ret := _m.Called(ctx)
+// GetPanoramaLocationBounds provides a mock function with given fields: ctx, providers
+func (_m *PanoramaRepository) GetPanoramaLocationBounds(ctx context.Context, providers []game.PanoramaProvider) (game.Bounds, error) {
+	ret := _m.Called(ctx, providers)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RandomGoogleStreetview")
+		panic("no return value specified for GetPanoramaLocationBounds")
 	}
 
-	var r0 game.GoogleStreetview
+	var r0 game.Bounds
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (game.GoogleStreetview, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, []game.PanoramaProvider) (game.Bounds, error)); ok {
+		return rf(ctx, providers)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) game.GoogleStreetview); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, []game.PanoramaProvider) game.Bounds); ok {
+		r0 = rf(ctx, providers)
 	} else {
-		r0 = ret.Get(0).(game.GoogleStreetview)
+		r0 = ret.Get(0).(game.Bounds)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, []game.PanoramaProvider) error); ok {
+		r1 = rf(ctx, providers)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -154,27 +98,29 @@ func (_m *PanoramaRepository) RandomGoogleStreetview(ctx context.Context) (game.
 	return r0, r1
 }
 
-// RandomSeznamStreetview provides a mock function with given fields: ctx
-func (_m *PanoramaRepository) RandomSeznamStreetview(ctx context.Context) (game.SeznamStreetview, error) {
-	ret := _m.Called(ctx)
+// GetPanoramaLocationIDs provides a mock function with given fields: ctx, provider, offsets
+func (_m *PanoramaRepository) GetPanoramaLocationIDs(ctx context.Context, provider game.PanoramaProvider, offsets []int) ([]int, error) {
+	ret := _m.Called(ctx, provider, offsets)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RandomSeznamStreetview")
+		panic("no return value specified for GetPanoramaLocationIDs")
 	}
 
-	var r0 game.SeznamStreetview
+	var r0 []int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (game.SeznamStreetview, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, game.PanoramaProvider, []int) ([]int, error)); ok {
+		return rf(ctx, provider, offsets)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) game.SeznamStreetview); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, game.PanoramaProvider, []int) []int); ok {
+		r0 = rf(ctx, provider, offsets)
 	} else {
-		r0 = ret.Get(0).(game.SeznamStreetview)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, game.PanoramaProvider, []int) error); ok {
+		r1 = rf(ctx, provider, offsets)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -182,27 +128,29 @@ func (_m *PanoramaRepository) RandomSeznamStreetview(ctx context.Context) (game.
 	return r0, r1
 }
 
-// RandomYandexAirview provides a mock function with given fields: ctx
-func (_m *PanoramaRepository) RandomYandexAirview(ctx context.Context) (game.YandexAirview, error) {
-	ret := _m.Called(ctx)
+// GetRecentLocationIDs provides a mock function with given fields: ctx, userIDs, games
+func (_m *PanoramaRepository) GetRecentLocationIDs(ctx context.Context, userIDs []int, games int) ([]int, error) {
+	ret := _m.Called(ctx, userIDs, games)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RandomYandexAirview")
+		panic("no return value specified for GetRecentLocationIDs")
 	}
 
-	var r0 game.YandexAirview
+	var r0 []int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (game.YandexAirview, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, []int, int) ([]int, error)); ok {
+		return rf(ctx, userIDs, games)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) game.YandexAirview); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, []int, int) []int); ok {
+		r0 = rf(ctx, userIDs, games)
 	} else {
-		r0 = ret.Get(0).(game.YandexAirview)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, []int, int) error); ok {
+		r1 = rf(ctx, userIDs, games)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -210,27 +158,27 @@ func (_m *PanoramaRepository) RandomYandexAirview(ctx context.Context) (game.Yan
 	return r0, r1
 }
 
-// RandomYandexStreetview provides a mock function with given fields: ctx
-func (_m *PanoramaRepository) RandomYandexStreetview(ctx context.Context) (game.YandexStreetview, error) {
-	ret := _m.Called(ctx)
+// RandomMapStreetview provides a mock function with given fields: ctx, mapID, exclude
+func (_m *PanoramaRepository) RandomMapStreetview(ctx context.Context, mapID int, exclude []int) (game.PanoramaMetadata, error) {
+	ret := _m.Called(ctx, mapID, exclude)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RandomYandexStreetview")
+		panic("no return value specified for RandomMapStreetview")
 	}
 
-	var r0 game.YandexStreetview
+	var r0 game.PanoramaMetadata
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (game.YandexStreetview, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) (game.PanoramaMetadata, error)); ok {
+		return rf(ctx, mapID, exclude)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) game.YandexStreetview); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) game.PanoramaMetadata); ok {
+		r0 = rf(ctx, mapID, exclude)
 	} else {
-		r0 = ret.Get(0).(game.YandexStreetview)
+		r0 = ret.Get(0).(game.PanoramaMetadata)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, int, []int) error); ok {
+		r1 = rf(ctx, mapID, exclude)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,30 +3,144 @@ package panorama
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 
+	"github.com/VasySS/segoya-backend/internal/dto"
 	"github.com/VasySS/segoya-backend/internal/entity/game"
 )
 
-// ErrUnknownProvider is returned when provided panorama provider is unknown.
-var ErrUnknownProvider = errors.New("unknown provider")
-
 // NewStreetview creates a new streetview for provided panorama provider.
-func (uc Usecase) NewStreetview(
+// If map ID is not 0, the streetview is chosen from locations of the map instead of all provider locations.
+// For mixed provider games the provider is picked from allowed providers of the request,
+// providers without panoramas are skipped.
+//
+// Locations already played in the game and locations played by the users in their recent games are not repeated.
+// When all locations are exhausted, recently played locations and then locations of the game are allowed again.
+func (uc Usecase) NewStreetview(ctx context.Context, req dto.NewStreetviewRequest) (game.PanoramaMetadata, error) {
+	ctx, span := uc.tracer.Start(ctx, "NewStreetview")
+	defer span.End()
+
+	exclusions, err := uc.exclusions(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		return game.PanoramaMetadata{}, err
+	}
+
+	if req.Provider != game.MixedProvider || req.MapID != 0 {
+		panorama, err := uc.providerStreetview(ctx, req.Provider, req.MapID, exclusions)
+		if err != nil && !errors.Is(err, game.ErrPanoramaNotFound) {
+			span.RecordError(err)
+		}
+
+		return panorama, err
+	}
+
+	candidates := req.Providers
+	if len(candidates) == 0 {
+		candidates = uc.providers.Names()
+	}
+
+	if len(candidates) == 0 {
+		err := fmt.Errorf("%w: no providers are registered", game.ErrUnknownProvider)
+		span.RecordError(err)
+
+		return game.PanoramaMetadata{}, err
+	}
+
+	// providers without panoramas are removed from candidates, until a panorama is found or none are left
+	for len(candidates) != 0 {
+		provider, err := uc.mixedProvider(candidates)
+		if err != nil {
+			span.RecordError(err)
+			return game.PanoramaMetadata{}, fmt.Errorf("failed to pick mixed provider: %w", err)
+		}
+
+		panorama, err := uc.providerStreetview(ctx, provider, 0, exclusions)
+		if errors.Is(err, game.ErrPanoramaNotFound) {
+			candidates = slices.DeleteFunc(slices.Clone(candidates), func(p game.PanoramaProvider) bool {
+				return p == provider
+			})
+
+			continue
+		} else if err != nil {
+			span.RecordError(err)
+			return game.PanoramaMetadata{}, err
+		}
+
+		return panorama, nil
+	}
+
+	return game.PanoramaMetadata{}, game.ErrPanoramaNotFound
+}
+
+// providerStreetview returns a random streetview of the provider (or the map), trying exclusions in order.
+func (uc Usecase) providerStreetview(
+	ctx context.Context,
+	provider game.PanoramaProvider,
+	mapID int,
+	exclusions [][]int,
+) (game.PanoramaMetadata, error) {
+	for _, exclude := range exclusions {
+		panorama, err := uc.randomStreetview(ctx, provider, mapID, exclude)
+		if errors.Is(err, game.ErrPanoramaNotFound) {
+			continue
+		} else if err != nil {
+			return game.PanoramaMetadata{}, err
+		}
+
+		return panorama, nil
+	}
+
+	return game.PanoramaMetadata{}, game.ErrPanoramaNotFound
+}
+
+// exclusions returns lists of location IDs to exclude from random selection, from the longest to the empty one.
+func (uc Usecase) exclusions(ctx context.Context, req dto.NewStreetviewRequest) ([][]int, error) {
+	exclusions := make([][]int, 0, 3)
+
+	if uc.cfg.RecentGamesExcluded > 0 && len(req.UserIDs) != 0 {
+		recent, err := uc.repo.GetRecentLocationIDs(ctx, req.UserIDs, uc.cfg.RecentGamesExcluded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get recent location ids: %w", err)
+		}
+
+		if len(recent) != 0 {
+			exclusions = append(exclusions, append(slices.Clone(req.PlayedLocationIDs), recent...))
+		}
+	}
+
+	if len(req.PlayedLocationIDs) != 0 {
+		exclusions = append(exclusions, req.PlayedLocationIDs)
+	}
+
+	return append(exclusions, nil), nil
+}
+
+// randomStreetview returns a random streetview of the provider (or the map), skipping excluded location IDs.
+func (uc Usecase) randomStreetview(
 	ctx context.Context,
 	provider game.PanoramaProvider,
+	mapID int,
+	exclude []int,
 ) (game.PanoramaMetadata, error) {
-	switch provider {
-	case game.GoogleProvider:
-		return uc.NewGoogleStreetview(ctx)
-	case game.YandexProvider:
-		return uc.NewYandexStreetview(ctx)
-	case game.YandexAirProvider:
-		return uc.NewYandexAirview(ctx)
-	case game.SeznamProvider:
-		return uc.NewSeznamStreetview(ctx)
-	default:
-		return game.PanoramaMetadata{}, ErrUnknownProvider
+	if mapID != 0 {
+		return uc.NewMapStreetview(ctx, mapID, exclude)
+	}
+
+	p, err := uc.providers.Get(provider)
+	if err != nil {
+		return game.PanoramaMetadata{}, err
 	}
+
+	panorama, err := p.Random(ctx, exclude)
+	if err != nil {
+		return game.PanoramaMetadata{}, fmt.Errorf("failed to get random %s panorama: %w", provider, err)
+	}
+
+	panorama.Provider = p.Name()
+
+	return panorama, nil
 }
 
 // GetStreetview returns a streetview by ID for provided panorama provider.
@@ -35,16 +149,42 @@ func (uc Usecase) GetStreetview(
 	provider game.PanoramaProvider,
 	id int,
 ) (game.PanoramaMetadata, error) {
-	switch provider {
-	case game.GoogleProvider:
-		return uc.GetGoogleStreetview(ctx, id)
-	case game.YandexProvider:
-		return uc.GetYandexStreetview(ctx, id)
-	case game.YandexAirProvider:
-		return uc.GetYandexAirview(ctx, id)
-	case game.SeznamProvider:
-		return uc.GetSeznamStreetview(ctx, id)
-	default:
-		return game.PanoramaMetadata{}, ErrUnknownProvider
+	ctx, span := uc.tracer.Start(ctx, "GetStreetview")
+	defer span.End()
+
+	p, err := uc.providers.Get(provider)
+	if err != nil {
+		span.RecordError(err)
+		return game.PanoramaMetadata{}, err
+	}
+
+	panorama, err := p.Get(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		return game.PanoramaMetadata{}, fmt.Errorf("failed to get %s panorama: %w", provider, err)
 	}
+
+	panorama.Provider = p.Name()
+
+	return panorama, nil
+}
+
+// StreetviewIDRequired reports whether every location of provided panorama provider must have a streetview ID.
+func (uc Usecase) StreetviewIDRequired(provider game.PanoramaProvider) (bool, error) {
+	p, err := uc.providers.Get(provider)
+	if err != nil {
+		return false, err
+	}
+
+	return p.StreetviewIDRequired(), nil
+}
+
+// Providers returns names of all registered panorama providers.
+func (uc Usecase) Providers() []game.PanoramaProvider {
+	return uc.providers.Names()
+}
+
+// ValidGameProvider returns true if a game can be played on provided panorama provider.
+func (uc Usecase) ValidGameProvider(provider game.PanoramaProvider) bool {
+	return uc.providers.ValidGameProvider(provider)
 }
@@ -0,0 +1,178 @@
+package panorama_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/usecase/panorama"
+	"github.com/VasySS/segoya-backend/internal/usecase/panorama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsecase_NewStreetview(t *testing.T) {
+	t.Parallel()
+
+	panoMetadata := game.PanoramaMetadata{
+		ID: 432432,
+		LatLng: game.LatLng{
+			Lat: 1.1234,
+			Lng: 2.3456,
+		},
+	}
+
+	// provider of the streetview is set by usecase
+	googleMetadata := panoMetadata
+	googleMetadata.Provider = game.GoogleProvider
+
+	// map locations imported without streetview ID are located by the provider
+	locatedMetadata := googleMetadata
+	locatedMetadata.StreetviewID = "located_streetview_id"
+
+	cfg := panorama.Config{RecentGamesExcluded: 5}
+
+	type fields struct {
+		repo     *mocks.PanoramaRepository
+		provider *mocks.Provider
+	}
+
+	type args struct {
+		req dto.NewStreetviewRequest
+	}
+
+	tests := []struct {
+		name    string
+		cfg     panorama.Config
+		args    args
+		setup   func(fields, args)
+		want    game.PanoramaMetadata
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "exclude locations of the game and recent games",
+			cfg:  cfg,
+			args: args{req: dto.NewStreetviewRequest{
+				Provider:          game.GoogleProvider,
+				UserIDs:           []int{1, 2},
+				PlayedLocationIDs: []int{10, 11},
+			}},
+			setup: func(f fields, a args) {
+				f.repo.On("GetRecentLocationIDs", mock.Anything, a.req.UserIDs, cfg.RecentGamesExcluded).
+					Return([]int{20, 21}, nil)
+				f.provider.On("Random", mock.Anything, []int{10, 11, 20, 21}).
+					Return(panoMetadata, nil)
+			},
+			want:    googleMetadata,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "repeat recent locations when all locations were played recently",
+			cfg:  cfg,
+			args: args{req: dto.NewStreetviewRequest{
+				Provider:          game.GoogleProvider,
+				UserIDs:           []int{1},
+				PlayedLocationIDs: []int{10},
+			}},
+			setup: func(f fields, a args) {
+				f.repo.On("GetRecentLocationIDs", mock.Anything, a.req.UserIDs, cfg.RecentGamesExcluded).
+					Return([]int{20}, nil)
+				f.provider.On("Random", mock.Anything, []int{10, 20}).
+					Return(game.PanoramaMetadata{}, game.ErrPanoramaNotFound)
+				f.provider.On("Random", mock.Anything, []int{10}).
+					Return(panoMetadata, nil)
+			},
+			want:    googleMetadata,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "repeat game locations when the map is exhausted",
+			cfg:  panorama.Config{},
+			args: args{req: dto.NewStreetviewRequest{
+				Provider:          game.GoogleProvider,
+				MapID:             3,
+				UserIDs:           []int{1},
+				PlayedLocationIDs: []int{10},
+			}},
+			setup: func(f fields, a args) {
+				f.repo.On("RandomMapStreetview", mock.Anything, a.req.MapID, []int{10}).
+					Return(game.PanoramaMetadata{}, game.ErrPanoramaNotFound)
+				f.repo.On("RandomMapStreetview", mock.Anything, a.req.MapID, []int(nil)).
+					Return(googleMetadata, nil)
+				f.provider.On("Metadata", mock.Anything, googleMetadata).
+					Return(locatedMetadata, nil)
+			},
+			want:    locatedMetadata,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "no locations at all",
+			cfg:  cfg,
+			args: args{req: dto.NewStreetviewRequest{
+				Provider: game.GoogleProvider,
+				UserIDs:  []int{1},
+			}},
+			setup: func(f fields, a args) {
+				f.repo.On("GetRecentLocationIDs", mock.Anything, a.req.UserIDs, cfg.RecentGamesExcluded).
+					Return([]int{}, nil)
+				f.provider.On("Random", mock.Anything, []int(nil)).
+					Return(game.PanoramaMetadata{}, game.ErrPanoramaNotFound)
+			},
+			want: game.PanoramaMetadata{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, game.ErrPanoramaNotFound)
+			},
+		},
+		{
+			name: "error while getting recent locations",
+			cfg:  cfg,
+			args: args{req: dto.NewStreetviewRequest{
+				Provider: game.GoogleProvider,
+				UserIDs:  []int{1},
+			}},
+			setup: func(f fields, a args) {
+				f.repo.On("GetRecentLocationIDs", mock.Anything, a.req.UserIDs, cfg.RecentGamesExcluded).
+					Return(nil, errors.New("some repository error"))
+			},
+			want:    game.PanoramaMetadata{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "unknown provider",
+			cfg:  panorama.Config{},
+			args: args{req: dto.NewStreetviewRequest{
+				Provider: "unknown",
+			}},
+			setup: func(_ fields, _ args) {},
+			want:  game.PanoramaMetadata{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, game.ErrUnknownProvider)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewPanoramaRepository(t)
+			provider := mocks.NewProvider(t)
+			fs := fields{repo: repo, provider: provider}
+
+			provider.On("Name").Return(game.GoogleProvider)
+
+			providers, err := panorama.NewRegistry(provider)
+			require.NoError(t, err)
+
+			uc := panorama.NewUsecase(tt.cfg, repo, providers, nil, nil)
+
+			tt.setup(fs, tt.args)
+
+			got, err := uc.NewStreetview(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
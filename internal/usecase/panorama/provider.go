@@ -0,0 +1,84 @@
+package panorama
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+)
+
+// ErrDuplicateProvider is returned when a provider with the same name is already registered.
+var ErrDuplicateProvider = errors.New("provider is already registered")
+
+// Provider is a source of panoramas, e.g. Google Street View or a self-hosted panorama set.
+//
+//go:generate go tool mockery --name=Provider
+type Provider interface {
+	// Name returns a unique provider name, that is stored with games and locations.
+	Name() game.PanoramaProvider
+	// Random returns a random panorama, skipping excluded location IDs.
+	// Returns game.ErrPanoramaNotFound if there are no locations left.
+	Random(ctx context.Context, exclude []int) (game.PanoramaMetadata, error)
+	// Get returns a panorama by its location ID.
+	Get(ctx context.Context, id int) (game.PanoramaMetadata, error)
+	// Metadata completes metadata of a provider location (e.g. imported to a map) to the shape clients load
+	// panoramas of the provider by, e.g. finds streetview ID of the location by coordinates, if it has none.
+	// Random and Get return panoramas with complete metadata.
+	Metadata(ctx context.Context, panorama game.PanoramaMetadata) (game.PanoramaMetadata, error)
+	// ScoreDistance returns distance in km, at which player will receive ~60% of score.
+	ScoreDistance() float64
+	// StreetviewIDRequired reports whether panoramas are loaded by streetview ID instead of coordinates,
+	// so every location of the provider must have it.
+	StreetviewIDRequired() bool
+}
+
+// Registry contains all panorama providers available in the application.
+type Registry struct {
+	providers map[game.PanoramaProvider]Provider
+}
+
+// NewRegistry creates a new Registry with provided providers.
+func NewRegistry(providers ...Provider) (*Registry, error) {
+	r := &Registry{
+		providers: make(map[game.PanoramaProvider]Provider, len(providers)),
+	}
+
+	for _, p := range providers {
+		if _, ok := r.providers[p.Name()]; ok {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateProvider, p.Name())
+		}
+
+		r.providers[p.Name()] = p
+	}
+
+	return r, nil
+}
+
+// Get returns a provider by its name.
+func (r *Registry) Get(name game.PanoramaProvider) (Provider, error) {
+	p, ok := r.providers[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", game.ErrUnknownProvider, name)
+	}
+
+	return p, nil
+}
+
+// Names returns names of all registered providers in alphabetical order.
+func (r *Registry) Names() []game.PanoramaProvider {
+	return slices.Sorted(maps.Keys(r.providers))
+}
+
+// ValidGameProvider returns true if a game can be played on the provider - it is registered or mixed.
+func (r *Registry) ValidGameProvider(name game.PanoramaProvider) bool {
+	if name == game.MixedProvider {
+		return true
+	}
+
+	_, ok := r.providers[name]
+
+	return ok
+}
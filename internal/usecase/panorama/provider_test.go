@@ -0,0 +1,127 @@
+package panorama_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/usecase/panorama"
+	"github.com/VasySS/segoya-backend/internal/usecase/panorama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRegistry(t *testing.T) {
+	t.Parallel()
+
+	google := mocks.NewProvider(t)
+	google.On("Name").Return(game.GoogleProvider)
+
+	seznam := mocks.NewProvider(t)
+	seznam.On("Name").Return(game.SeznamProvider)
+
+	registry, err := panorama.NewRegistry(google, seznam)
+	require.NoError(t, err)
+
+	got, err := registry.Get(game.SeznamProvider)
+	require.NoError(t, err)
+	assert.Equal(t, seznam, got)
+
+	_, err = registry.Get(game.YandexProvider)
+	require.ErrorIs(t, err, game.ErrUnknownProvider)
+
+	assert.Equal(t, []game.PanoramaProvider{game.GoogleProvider, game.SeznamProvider}, registry.Names())
+
+	assert.True(t, registry.ValidGameProvider(game.GoogleProvider))
+	assert.True(t, registry.ValidGameProvider(game.MixedProvider))
+	assert.False(t, registry.ValidGameProvider(game.YandexProvider))
+
+	_, err = panorama.NewRegistry(google, seznam, google)
+	require.ErrorIs(t, err, panorama.ErrDuplicateProvider)
+}
+
+func TestUsecase_GetStreetview(t *testing.T) {
+	t.Parallel()
+
+	panoMetadata := game.PanoramaMetadata{
+		ID:           432432,
+		StreetviewID: "some_streetview_id",
+		LatLng: game.LatLng{
+			Lat: 1.1234,
+			Lng: 2.3456,
+		},
+	}
+
+	yandexAirMetadata := panoMetadata
+	yandexAirMetadata.Provider = game.YandexAirProvider
+
+	type fields struct {
+		provider *mocks.Provider
+	}
+
+	type args struct {
+		provider game.PanoramaProvider
+		id       int
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		want    game.PanoramaMetadata
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "successfully get streetview",
+			args: args{provider: game.YandexAirProvider, id: panoMetadata.ID},
+			setup: func(f fields, a args) {
+				f.provider.On("Get", mock.Anything, a.id).
+					Return(panoMetadata, nil)
+			},
+			want:    yandexAirMetadata,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error while getting streetview from provider",
+			args: args{provider: game.YandexAirProvider, id: panoMetadata.ID},
+			setup: func(f fields, a args) {
+				f.provider.On("Get", mock.Anything, a.id).
+					Return(game.PanoramaMetadata{}, errors.New("some provider error"))
+			},
+			want:    game.PanoramaMetadata{},
+			wantErr: assert.Error,
+		},
+		{
+			name:  "unknown provider",
+			args:  args{provider: game.GoogleProvider, id: panoMetadata.ID},
+			setup: func(_ fields, _ args) {},
+			want:  game.PanoramaMetadata{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, game.ErrUnknownProvider)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewProvider(t)
+			fs := fields{provider: provider}
+
+			provider.On("Name").Return(game.YandexAirProvider)
+
+			providers, err := panorama.NewRegistry(provider)
+			require.NoError(t, err)
+
+			uc := panorama.NewUsecase(panorama.Config{}, nil, providers, nil, nil)
+
+			tt.setup(fs, tt.args)
+
+			got, err := uc.GetStreetview(t.Context(), tt.args.provider, tt.args.id)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
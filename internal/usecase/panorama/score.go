@@ -1,39 +1,124 @@
 package panorama
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"math"
 
+	"github.com/VasySS/segoya-backend/internal/dto"
 	"github.com/VasySS/segoya-backend/internal/entity/game"
 )
 
-// CalculateScoreAndDistance returns score (0 to 5000) and distance in meters for provided coordinates.
-// Score is adjusted depending on provider.
-// Based on this:
-// https://stackoverflow.com/questions/65351282
-func (uc Usecase) CalculateScoreAndDistance(
-	provider game.PanoramaProvider,
-	realLat, realLng, userLat, userLng float64,
-) (int, int) {
-	if userLat == 0.0 && userLng == 0.0 {
-		return 0, 0
+// worldDiagonal is the largest distance between two points on Earth in km (half of its circumference),
+// it is used as the diagonal of areas, that span the whole world.
+const worldDiagonal = 20015.0
+
+// ScoreDistance returns distance in km, at which player will receive ~60% of score in a new game.
+// Games on provider locations get score distance of the provider (the largest of allowed providers of mixed
+// provider games). Games on maps get it scaled by the size of map locations relative to locations of the
+// provider, so that maps of small regions are not scored like the whole provider, but never above it.
+// Returns 0 if there are no locations to measure, then score distance of round provider is used.
+func (uc Usecase) ScoreDistance(ctx context.Context, req dto.ScoreDistanceRequest) (float64, error) {
+	ctx, span := uc.tracer.Start(ctx, "ScoreDistance")
+	defer span.End()
+
+	providers, providerDistance, err := uc.scoreDistanceProviders(req)
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+
+	if req.MapID == 0 {
+		return providerDistance, nil
+	}
+
+	mapBounds, err := uc.repo.GetMapLocationBounds(ctx, req.MapID)
+	if errors.Is(err, game.ErrPanoramaNotFound) {
+		return 0, nil
+	} else if err != nil {
+		span.RecordError(err)
+		return 0, fmt.Errorf("failed to get map location bounds: %w", err)
+	}
+
+	providerBounds, err := uc.repo.GetPanoramaLocationBounds(ctx, providers)
+	if errors.Is(err, game.ErrPanoramaNotFound) {
+		return providerDistance, nil
+	} else if err != nil {
+		span.RecordError(err)
+		return 0, fmt.Errorf("failed to get panorama location bounds: %w", err)
+	}
+
+	scale := boundsDiagonal(mapBounds) / boundsDiagonal(providerBounds)
+
+	return min(max(providerDistance*scale, uc.cfg.MinScoreDistance), providerDistance), nil
+}
+
+// scoreDistanceProviders returns providers of locations, that can be played in the game,
+// and the largest score distance of them.
+func (uc Usecase) scoreDistanceProviders(req dto.ScoreDistanceRequest) ([]game.PanoramaProvider, float64, error) {
+	providers := []game.PanoramaProvider{req.Provider}
+	if req.Provider == game.MixedProvider {
+		providers = req.Providers
+		if len(providers) == 0 {
+			providers = uc.providers.Names()
+		}
+	}
+
+	var distance float64
+
+	for _, name := range providers {
+		p, err := uc.providers.Get(name)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		distance = max(distance, p.ScoreDistance())
+	}
+
+	return providers, distance, nil
+}
+
+// boundsDiagonal returns the diagonal of the bounding box in km. Boxes wider than half of the world
+// (which are also boxes of locations on both sides of the antimeridian) span the whole world.
+func boundsDiagonal(b game.Bounds) float64 {
+	if b.MaxLng-b.MinLng > 180 { //nolint:mnd
+		return worldDiagonal
 	}
 
-	// distance in km, at which player will receive ~60% of score
-	var scoreModifier float64
+	return max(distanceMeters(b.MinLat, b.MinLng, b.MaxLat, b.MaxLng)/1000, 1) //nolint:mnd
+}
+
+// CalculateScore returns a score of a guess by the scoring strategy of a game, distance is returned in meters.
+// Score distance is a distance in km, at which player will receive ~60% of score, it is stored with the game.
+// Games without score distance (created before it was introduced) are scored depending on provider,
+// unknown provider always gets 0 score then. Games with unknown scoring mode are scored as classic.
+func (uc Usecase) CalculateScore(req dto.ScoreRequest) game.Score {
+	if req.Guess.Lat == 0.0 && req.Guess.Lng == 0.0 {
+		return game.Score{}
+	}
+
+	distance := distanceMeters(req.Location.Lat, req.Location.Lng, req.Guess.Lat, req.Guess.Lng)
+
+	scoreDistance := req.ScoreDistance
+	if scoreDistance == 0 {
+		p, err := uc.providers.Get(req.Provider)
+		if err != nil {
+			return game.Score{Distance: int(distance)}
+		}
+
+		scoreDistance = p.ScoreDistance()
+	}
 
-	switch provider {
-	case game.SeznamProvider:
-		scoreModifier = 150.0
-	case game.YandexProvider:
-		scoreModifier = 500.0
-	case game.GoogleProvider, game.YandexAirProvider:
-		scoreModifier = 750.0
+	strategy, err := uc.scorings.Get(req.Scoring)
+	if err != nil {
+		strategy = NewClassicScoring()
 	}
 
-	distance := distanceMeters(realLat, realLng, userLat, userLng)
-	score := 5000 * math.Exp(-0.5*math.Pow(((distance/1000)/scoreModifier), 2)) //nolint:staticcheck
+	score := strategy.Score(req, distance/1000, scoreDistance) //nolint:mnd
+	score.Distance = int(distance)
 
-	return int(score), int(distance)
+	return score
 }
 
 // distanceMeters returns distance between two points in meters, based on this:
@@ -1,102 +1,483 @@
 package panorama_test
 
 import (
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/VasySS/segoya-backend/internal/config"
+	"github.com/VasySS/segoya-backend/internal/dto"
 	"github.com/VasySS/segoya-backend/internal/entity/game"
+	panoramaProvider "github.com/VasySS/segoya-backend/internal/infrastructure/panorama"
+	_ "github.com/VasySS/segoya-backend/internal/infrastructure/panorama/providers"
 	"github.com/VasySS/segoya-backend/internal/usecase/panorama"
+	"github.com/VasySS/segoya-backend/internal/usecase/panorama/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
-func TestUsecase_CalculateScoreAndDistance(t *testing.T) {
+func TestUsecase_CalculateScore(t *testing.T) {
 	t.Parallel()
 
-	type args struct {
-		provider game.PanoramaProvider
-		realLat  float64
-		realLng  float64
-		userLat  float64
-		userLng  float64
-	}
+	startedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
 
 	tests := []struct {
-		name         string
-		args         args
-		wantScore    int
-		wantDistance int
+		name  string
+		req   dto.ScoreRequest
+		setup func(r *mocks.CountryResolver)
+		want  game.Score
 	}{
 		{
 			name: "full score",
-			args: args{
-				provider: "google",
-				realLat:  11.22,
-				realLng:  22.33,
-				userLat:  11.22,
-				userLng:  22.33,
+			req: dto.ScoreRequest{
+				Provider: "google",
+				Location: game.LatLng{Lat: 11.22, Lng: 22.33},
+				Guess:    game.LatLng{Lat: 11.22, Lng: 22.33},
 			},
-			wantScore:    5000,
-			wantDistance: 0,
+			want: game.Score{Base: 5000, Distance: 0},
 		},
 		{
 			name: "~60% score (yandex)",
-			args: args{
-				provider: "yandex",
-				realLat:  0.0,
-				realLng:  0.0,
-				userLat:  4.49758,
-				userLng:  0.0,
+			req: dto.ScoreRequest{
+				Provider: "yandex",
+				Location: game.LatLng{Lat: 0.0, Lng: 0.0},
+				Guess:    game.LatLng{Lat: 4.49758, Lng: 0.0},
 			},
-			wantScore:    3032,
-			wantDistance: 500_000,
+			want: game.Score{Base: 3032, Distance: 500_000},
 		},
 		{
 			name: "~60% score (seznam)",
-			args: args{
-				provider: "seznam",
-				realLat:  0.0,
-				realLng:  0.0,
-				userLat:  1.34928,
-				userLng:  0.0,
+			req: dto.ScoreRequest{
+				Provider: "seznam",
+				Location: game.LatLng{Lat: 0.0, Lng: 0.0},
+				Guess:    game.LatLng{Lat: 1.34928, Lng: 0.0},
 			},
-			wantScore:    3032,
-			wantDistance: 150_000,
+			want: game.Score{Base: 3032, Distance: 150_000},
 		},
 		{
 			name: "~60% score (google)",
-			args: args{
-				provider: "google",
-				realLat:  0.0,
-				realLng:  0.0,
-				userLat:  6.74637,
-				userLng:  0.0,
+			req: dto.ScoreRequest{
+				Provider: "google",
+				Location: game.LatLng{Lat: 0.0, Lng: 0.0},
+				Guess:    game.LatLng{Lat: 6.74637, Lng: 0.0},
+			},
+			want: game.Score{Base: 3032, Distance: 750_000},
+		},
+		{
+			name: "~60% score (game score distance)",
+			req: dto.ScoreRequest{
+				Provider:      "google",
+				ScoreDistance: 100,
+				Location:      game.LatLng{Lat: 0.0, Lng: 0.0},
+				Guess:         game.LatLng{Lat: 0.89952, Lng: 0.0},
 			},
-			wantScore:    3032,
-			wantDistance: 750_000,
+			want: game.Score{Base: 3032, Distance: 100_000},
+		},
+		{
+			name: "~14% score at double game score distance",
+			req: dto.ScoreRequest{
+				Provider:      "seznam",
+				ScoreDistance: 25,
+				Location:      game.LatLng{Lat: 0.0, Lng: 0.0},
+				Guess:         game.LatLng{Lat: 0.44976, Lng: 0.0},
+			},
+			want: game.Score{Base: 676, Distance: 50_000},
+		},
+		{
+			name: "almost full score close to location (game score distance)",
+			req: dto.ScoreRequest{
+				Provider:      "yandex",
+				ScoreDistance: 5,
+				Location:      game.LatLng{Lat: 0.0, Lng: 0.0},
+				Guess:         game.LatLng{Lat: 0.0045, Lng: 0.0},
+			},
+			want: game.Score{Base: 4975, Distance: 500},
+		},
+		{
+			name: "unknown provider with game score distance",
+			req: dto.ScoreRequest{
+				Provider:      "unknown",
+				ScoreDistance: 25,
+				Location:      game.LatLng{Lat: 0.0, Lng: 0.0},
+				Guess:         game.LatLng{Lat: 0.22488, Lng: 0.0},
+			},
+			want: game.Score{Base: 3032, Distance: 25_000},
 		},
 		{
 			name: "zero score and distance",
-			args: args{
-				provider: "google",
-				realLat:  11.11,
-				realLng:  22.22,
-				userLat:  0.0,
-				userLng:  0.0,
+			req: dto.ScoreRequest{
+				Provider: "google",
+				Location: game.LatLng{Lat: 11.11, Lng: 22.22},
+				Guess:    game.LatLng{Lat: 0.0, Lng: 0.0},
+			},
+			want: game.Score{},
+		},
+		{
+			name: "unknown provider",
+			req: dto.ScoreRequest{
+				Provider: "unknown",
+				Location: game.LatLng{Lat: 0.0, Lng: 0.0},
+				Guess:    game.LatLng{Lat: 0.0, Lng: 1.34928},
 			},
-			wantScore:    0,
-			wantDistance: 0,
+			want: game.Score{Distance: 150_000},
+		},
+		{
+			name: "unknown scoring mode is scored as classic",
+			req: dto.ScoreRequest{
+				Provider:      "google",
+				Scoring:       "unknown",
+				ScoreDistance: 100,
+				Location:      game.LatLng{Lat: 0.0, Lng: 0.0},
+				Guess:         game.LatLng{Lat: 0.89952, Lng: 0.0},
+			},
+			want: game.Score{Base: 3032, Distance: 100_000},
+		},
+		{
+			name: "hard scoring",
+			req: dto.ScoreRequest{
+				Provider:      "google",
+				Scoring:       game.HardScoring,
+				ScoreDistance: 100,
+				Location:      game.LatLng{Lat: 0.0, Lng: 0.0},
+				Guess:         game.LatLng{Lat: 0.89952, Lng: 0.0},
+			},
+			want: game.Score{Base: 676, Distance: 100_000},
+		},
+		{
+			name: "timed scoring, exact guess in the first quarter of bonus time",
+			req: dto.ScoreRequest{
+				Provider:      "google",
+				Scoring:       game.TimedScoring,
+				ScoreDistance: 100,
+				Location:      game.LatLng{Lat: 11.22, Lng: 22.33},
+				Guess:         game.LatLng{Lat: 11.22, Lng: 22.33},
+				StartedAt:     startedAt,
+				GuessedAt:     startedAt.Add(15 * time.Second),
+			},
+			want: game.Score{Base: 5000, Bonus: 750},
+		},
+		{
+			name: "timed scoring, bonus time is taken from game timer",
+			req: dto.ScoreRequest{
+				Provider:      "google",
+				Scoring:       game.TimedScoring,
+				ScoreDistance: 100,
+				Location:      game.LatLng{Lat: 0.0, Lng: 0.0},
+				Guess:         game.LatLng{Lat: 0.89952, Lng: 0.0},
+				StartedAt:     startedAt,
+				GuessedAt:     startedAt.Add(15 * time.Second),
+				TimerSeconds:  30,
+			},
+			want: game.Score{Base: 3032, Bonus: 303, Distance: 100_000},
+		},
+		{
+			name: "timed scoring, no bonus after bonus time",
+			req: dto.ScoreRequest{
+				Provider:      "google",
+				Scoring:       game.TimedScoring,
+				ScoreDistance: 100,
+				Location:      game.LatLng{Lat: 11.22, Lng: 22.33},
+				Guess:         game.LatLng{Lat: 11.22, Lng: 22.33},
+				StartedAt:     startedAt,
+				GuessedAt:     startedAt.Add(time.Minute),
+			},
+			want: game.Score{Base: 5000},
+		},
+		{
+			name: "country scoring, correct country",
+			req: dto.ScoreRequest{
+				Provider:      "google",
+				Scoring:       game.CountryScoring,
+				ScoreDistance: 100,
+				Location:      game.LatLng{Lat: 0.0, Lng: 0.0},
+				Guess:         game.LatLng{Lat: 0.89952, Lng: 0.0},
+			},
+			setup: func(r *mocks.CountryResolver) {
+				r.On("CountryCode", 0.0, 0.0).Return("GA").Once()
+				r.On("CountryCode", 0.89952, 0.0).Return("GA").Once()
+			},
+			want: game.Score{Base: 3032, Bonus: 500, Distance: 100_000},
+		},
+		{
+			name: "country scoring, wrong country",
+			req: dto.ScoreRequest{
+				Provider:      "google",
+				Scoring:       game.CountryScoring,
+				ScoreDistance: 100,
+				Location:      game.LatLng{Lat: 0.0, Lng: 0.0},
+				Guess:         game.LatLng{Lat: 0.89952, Lng: 0.0},
+			},
+			setup: func(r *mocks.CountryResolver) {
+				r.On("CountryCode", 0.0, 0.0).Return("GA").Once()
+				r.On("CountryCode", 0.89952, 0.0).Return("CM").Once()
+			},
+			want: game.Score{Base: 3032, Distance: 100_000},
+		},
+		{
+			name: "country scoring, location outside of countries",
+			req: dto.ScoreRequest{
+				Provider:      "google",
+				Scoring:       game.CountryScoring,
+				ScoreDistance: 100,
+				Location:      game.LatLng{Lat: 0.0, Lng: 0.0},
+				Guess:         game.LatLng{Lat: 0.89952, Lng: 0.0},
+			},
+			setup: func(r *mocks.CountryResolver) {
+				r.On("CountryCode", 0.0, 0.0).Return("").Once()
+			},
+			want: game.Score{Base: 3032, Distance: 100_000},
 		},
 	}
 
+	providers := newProviders(t)
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			uc := panorama.NewUsecase(panorama.Config{}, nil)
+			resolver := mocks.NewCountryResolver(t)
+			if tt.setup != nil {
+				tt.setup(resolver)
+			}
 
-			score, distance := uc.CalculateScoreAndDistance(tt.args.provider,
-				tt.args.realLat, tt.args.realLng, tt.args.userLat, tt.args.userLng)
-			assert.Equal(t, tt.wantScore, score)
-			assert.Equal(t, tt.wantDistance, distance)
+			scorings, err := panorama.NewScoringRegistry(
+				panorama.NewClassicScoring(),
+				panorama.NewHardScoring(),
+				panorama.NewTimedScoring(1000, time.Minute),
+				panorama.NewCountryScoring(resolver, 500),
+			)
+			require.NoError(t, err)
+
+			uc := panorama.NewUsecase(panorama.Config{}, nil, providers, scorings, nil)
+
+			assert.Equal(t, tt.want, uc.CalculateScore(tt.req))
 		})
 	}
 }
+
+func TestNewScoringRegistry(t *testing.T) {
+	t.Parallel()
+
+	scorings, err := panorama.NewScoringRegistry(panorama.NewClassicScoring(), panorama.NewHardScoring())
+	require.NoError(t, err)
+
+	s, err := scorings.Get(game.HardScoring)
+	require.NoError(t, err)
+	assert.Equal(t, game.HardScoring, s.Mode())
+
+	_, err = scorings.Get(game.TimedScoring)
+	require.ErrorIs(t, err, panorama.ErrUnknownScoring)
+
+	_, err = panorama.NewScoringRegistry(panorama.NewClassicScoring(), panorama.NewClassicScoring())
+	require.ErrorIs(t, err, panorama.ErrDuplicateScoring)
+}
+
+func TestUsecase_ScoreDistance(t *testing.T) {
+	t.Parallel()
+
+	cfg := panorama.Config{
+		MinScoreDistance: 5,
+	}
+
+	// diagonal of the map bounds is ~1111.7 km, half of the diagonal of the provider bounds
+	mapBounds := game.Bounds{MinLat: 0, MinLng: 0, MaxLat: 10, MaxLng: 0}
+	providerBounds := game.Bounds{MinLat: 0, MinLng: 0, MaxLat: 20, MaxLng: 0}
+	worldBounds := game.Bounds{MinLat: -60, MinLng: -170, MaxLat: 70, MaxLng: 170}
+
+	type fields struct {
+		repo *mocks.PanoramaRepository
+	}
+
+	type args struct {
+		req dto.ScoreDistanceRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		want    float64
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "google locations keep provider score distance",
+			args:    args{req: dto.ScoreDistanceRequest{Provider: game.GoogleProvider}},
+			setup:   func(_ fields, _ args) {},
+			want:    750,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "yandex locations keep provider score distance",
+			args:    args{req: dto.ScoreDistanceRequest{Provider: game.YandexProvider}},
+			setup:   func(_ fields, _ args) {},
+			want:    500,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "seznam locations keep provider score distance",
+			args:    args{req: dto.ScoreDistanceRequest{Provider: game.SeznamProvider}},
+			setup:   func(_ fields, _ args) {},
+			want:    150,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "mixed provider with all providers allowed",
+			args:    args{req: dto.ScoreDistanceRequest{Provider: game.MixedProvider}},
+			setup:   func(_ fields, _ args) {},
+			want:    750,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "mixed provider with some providers allowed",
+			args: args{req: dto.ScoreDistanceRequest{
+				Provider:  game.MixedProvider,
+				Providers: []game.PanoramaProvider{game.SeznamProvider, game.YandexProvider},
+			}},
+			setup:   func(_ fields, _ args) {},
+			want:    500,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "map locations are scaled by provider locations",
+			args: args{req: dto.ScoreDistanceRequest{Provider: game.GoogleProvider, MapID: 12}},
+			setup: func(f fields, a args) {
+				f.repo.On("GetMapLocationBounds", mock.Anything, a.req.MapID).
+					Return(mapBounds, nil)
+
+				f.repo.On("GetPanoramaLocationBounds", mock.Anything, []game.PanoramaProvider{game.GoogleProvider}).
+					Return(providerBounds, nil)
+			},
+			want:    375,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "map locations on a part of world-wide provider locations",
+			args: args{req: dto.ScoreDistanceRequest{Provider: game.GoogleProvider, MapID: 12}},
+			setup: func(f fields, a args) {
+				f.repo.On("GetMapLocationBounds", mock.Anything, a.req.MapID).
+					Return(mapBounds, nil)
+
+				f.repo.On("GetPanoramaLocationBounds", mock.Anything, []game.PanoramaProvider{game.GoogleProvider}).
+					Return(worldBounds, nil)
+			},
+			want:    41.66,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "world-wide map locations keep provider score distance",
+			args: args{req: dto.ScoreDistanceRequest{Provider: game.GoogleProvider, MapID: 12}},
+			setup: func(f fields, a args) {
+				f.repo.On("GetMapLocationBounds", mock.Anything, a.req.MapID).
+					Return(worldBounds, nil)
+
+				f.repo.On("GetPanoramaLocationBounds", mock.Anything, []game.PanoramaProvider{game.GoogleProvider}).
+					Return(worldBounds, nil)
+			},
+			want:    750,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "map locations on both sides of the antimeridian don't exceed provider score distance",
+			args: args{req: dto.ScoreDistanceRequest{Provider: game.YandexProvider, MapID: 12}},
+			setup: func(f fields, a args) {
+				f.repo.On("GetMapLocationBounds", mock.Anything, a.req.MapID).
+					Return(game.Bounds{MinLat: 64, MinLng: -179.5, MaxLat: 66, MaxLng: 179.5}, nil)
+
+				f.repo.On("GetPanoramaLocationBounds", mock.Anything, []game.PanoramaProvider{game.YandexProvider}).
+					Return(providerBounds, nil)
+			},
+			want:    500,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "minimal score distance for close locations",
+			args: args{req: dto.ScoreDistanceRequest{Provider: game.GoogleProvider, MapID: 12}},
+			setup: func(f fields, a args) {
+				f.repo.On("GetMapLocationBounds", mock.Anything, a.req.MapID).
+					Return(game.Bounds{MinLat: 1, MinLng: 1, MaxLat: 1.01, MaxLng: 1.01}, nil)
+
+				f.repo.On("GetPanoramaLocationBounds", mock.Anything, []game.PanoramaProvider{game.GoogleProvider}).
+					Return(worldBounds, nil)
+			},
+			want:    cfg.MinScoreDistance,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "no map locations",
+			args: args{req: dto.ScoreDistanceRequest{Provider: game.SeznamProvider, MapID: 12}},
+			setup: func(f fields, a args) {
+				f.repo.On("GetMapLocationBounds", mock.Anything, a.req.MapID).
+					Return(game.Bounds{}, game.ErrPanoramaNotFound)
+			},
+			want:    0,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "no provider locations",
+			args: args{req: dto.ScoreDistanceRequest{Provider: game.SeznamProvider, MapID: 12}},
+			setup: func(f fields, a args) {
+				f.repo.On("GetMapLocationBounds", mock.Anything, a.req.MapID).
+					Return(mapBounds, nil)
+
+				f.repo.On("GetPanoramaLocationBounds", mock.Anything, []game.PanoramaProvider{game.SeznamProvider}).
+					Return(game.Bounds{}, game.ErrPanoramaNotFound)
+			},
+			want:    150,
+			wantErr: assert.NoError,
+		},
+		{
+			name:  "unknown provider",
+			args:  args{req: dto.ScoreDistanceRequest{Provider: "unknown"}},
+			setup: func(_ fields, _ args) {},
+			want:  0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, game.ErrUnknownProvider)
+			},
+		},
+		{
+			name: "repository error",
+			args: args{req: dto.ScoreDistanceRequest{Provider: game.GoogleProvider, MapID: 12}},
+			setup: func(f fields, _ args) {
+				f.repo.On("GetMapLocationBounds", mock.Anything, mock.Anything).
+					Return(game.Bounds{}, errors.New("db error"))
+			},
+			want:    0,
+			wantErr: assert.Error,
+		},
+	}
+
+	providers := newProviders(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fs := fields{repo: mocks.NewPanoramaRepository(t)}
+			tt.setup(fs, tt.args)
+
+			uc := panorama.NewUsecase(cfg, fs.repo, providers, nil, nil)
+
+			got, err := uc.ScoreDistance(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+			assert.InDelta(t, tt.want, got, 0.01)
+		})
+	}
+}
+
+// newProviders returns a registry with the default panorama providers.
+func newProviders(t *testing.T) *panorama.Registry {
+	t.Helper()
+
+	registered := panoramaProvider.New(config.Config{}, nil)
+
+	providers := make([]panorama.Provider, 0, len(registered))
+	for _, p := range registered {
+		providers = append(providers, p)
+	}
+
+	registry, err := panorama.NewRegistry(providers...)
+	require.NoError(t, err)
+
+	return registry
+}
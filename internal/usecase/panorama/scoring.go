@@ -0,0 +1,213 @@
+package panorama
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+)
+
+const (
+	// maxRoundScore is a score of a guess at the exact location of a round.
+	maxRoundScore = 5000
+	// hardScoreDistanceRatio is a part of game score distance, that is used for hard scoring.
+	hardScoreDistanceRatio = 0.5
+)
+
+var (
+	// ErrUnknownScoring is returned when provided scoring mode is unknown.
+	ErrUnknownScoring = errors.New("unknown scoring mode")
+	// ErrDuplicateScoring is returned when a scoring strategy with the same mode is already registered.
+	ErrDuplicateScoring = errors.New("scoring mode is already registered")
+)
+
+// ScoringStrategy calculates a score of a guess for a scoring mode of a game.
+type ScoringStrategy interface {
+	// Mode returns a unique scoring mode, that is stored with games.
+	Mode() game.ScoringMode
+	// Score returns a score of a guess, distance and score distance are in km.
+	Score(req dto.ScoreRequest, distance, scoreDistance float64) game.Score
+}
+
+// CountryResolver resolves countries and regions by coordinates (reverse geocoding).
+//
+//go:generate go tool mockery --name=CountryResolver
+type CountryResolver interface {
+	// CountryCode returns an ISO 3166-1 alpha-2 code of a country,
+	// empty if coordinates are not inside any known country.
+	CountryCode(lat, lng float64) string
+	// Place returns a country and a region, empty if coordinates are not inside any known country.
+	Place(lat, lng float64) game.Place
+}
+
+// ScoringRegistry contains all scoring strategies available in the application.
+type ScoringRegistry struct {
+	strategies map[game.ScoringMode]ScoringStrategy
+}
+
+// NewScoringRegistry creates a new ScoringRegistry with provided strategies.
+func NewScoringRegistry(strategies ...ScoringStrategy) (*ScoringRegistry, error) {
+	r := &ScoringRegistry{
+		strategies: make(map[game.ScoringMode]ScoringStrategy, len(strategies)),
+	}
+
+	for _, s := range strategies {
+		if _, ok := r.strategies[s.Mode()]; ok {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateScoring, s.Mode())
+		}
+
+		r.strategies[s.Mode()] = s
+	}
+
+	return r, nil
+}
+
+// Get returns a scoring strategy by its mode.
+func (r *ScoringRegistry) Get(mode game.ScoringMode) (ScoringStrategy, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownScoring, mode)
+	}
+
+	s, ok := r.strategies[mode]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownScoring, mode)
+	}
+
+	return s, nil
+}
+
+// ClassicScoring scores a guess by distance only.
+type ClassicScoring struct{}
+
+// NewClassicScoring creates a new ClassicScoring.
+func NewClassicScoring() ClassicScoring {
+	return ClassicScoring{}
+}
+
+// Mode returns classic scoring mode.
+func (ClassicScoring) Mode() game.ScoringMode {
+	return game.ClassicScoring
+}
+
+// Score returns a score, that decreases with distance following a gaussian curve, based on this:
+// https://stackoverflow.com/questions/65351282
+func (ClassicScoring) Score(_ dto.ScoreRequest, distance, scoreDistance float64) game.Score {
+	return game.Score{
+		Base: distanceScore(distance, scoreDistance),
+	}
+}
+
+// HardScoring scores a guess by distance, but the score decreases faster than in classic scoring.
+type HardScoring struct{}
+
+// NewHardScoring creates a new HardScoring.
+func NewHardScoring() HardScoring {
+	return HardScoring{}
+}
+
+// Mode returns hard scoring mode.
+func (HardScoring) Mode() game.ScoringMode {
+	return game.HardScoring
+}
+
+// Score returns a classic score for a smaller score distance.
+func (HardScoring) Score(_ dto.ScoreRequest, distance, scoreDistance float64) game.Score {
+	return game.Score{
+		Base: distanceScore(distance, scoreDistance*hardScoreDistanceRatio),
+	}
+}
+
+// TimedScoring adds a bonus for fast guesses to the classic score.
+type TimedScoring struct {
+	maxBonus  int
+	bonusTime time.Duration
+	classic   ClassicScoring
+}
+
+// NewTimedScoring creates a new TimedScoring.
+//
+// maxBonus - bonus for an exact guess made right after the round has started.
+//
+// bonusTime - time after which the bonus is not given for games without timer,
+// timer of the game is used instead for games with it.
+func NewTimedScoring(maxBonus int, bonusTime time.Duration) TimedScoring {
+	return TimedScoring{
+		maxBonus:  maxBonus,
+		bonusTime: bonusTime,
+	}
+}
+
+// Mode returns timed scoring mode.
+func (TimedScoring) Mode() game.ScoringMode {
+	return game.TimedScoring
+}
+
+// Score returns a classic score with a bonus, that decreases linearly with the time of a guess.
+// The bonus is also proportional to the classic score, so that fast random guesses are not rewarded.
+func (s TimedScoring) Score(req dto.ScoreRequest, distance, scoreDistance float64) game.Score {
+	score := s.classic.Score(req, distance, scoreDistance)
+
+	bonusTime := s.bonusTime
+	if req.TimerSeconds > 0 {
+		bonusTime = time.Duration(req.TimerSeconds) * time.Second
+	}
+
+	elapsed := req.GuessedAt.Sub(req.StartedAt)
+	if req.StartedAt.IsZero() || bonusTime <= 0 || elapsed < 0 || elapsed >= bonusTime {
+		return score
+	}
+
+	timeRatio := 1 - float64(elapsed)/float64(bonusTime)
+	score.Bonus = int(float64(s.maxBonus) * float64(score.Base) / maxRoundScore * timeRatio)
+
+	return score
+}
+
+// CountryScoring adds a bonus to the classic score, if a guess is in the same country as the round location.
+type CountryScoring struct {
+	resolver CountryResolver
+	bonus    int
+	classic  ClassicScoring
+}
+
+// NewCountryScoring creates a new CountryScoring.
+//
+// resolver - resolver of countries by coordinates.
+//
+// bonus - bonus for a guess in the correct country.
+func NewCountryScoring(resolver CountryResolver, bonus int) CountryScoring {
+	return CountryScoring{
+		resolver: resolver,
+		bonus:    bonus,
+	}
+}
+
+// Mode returns country scoring mode.
+func (CountryScoring) Mode() game.ScoringMode {
+	return game.CountryScoring
+}
+
+// Score returns a classic score with a bonus for the correct country.
+// Locations outside of known countries (e.g. in the ocean) never receive the bonus.
+func (s CountryScoring) Score(req dto.ScoreRequest, distance, scoreDistance float64) game.Score {
+	score := s.classic.Score(req, distance, scoreDistance)
+
+	country := s.resolver.CountryCode(req.Location.Lat, req.Location.Lng)
+	if country != "" && country == s.resolver.CountryCode(req.Guess.Lat, req.Guess.Lng) {
+		score.Bonus = s.bonus
+	}
+
+	return score
+}
+
+// distanceScore returns a score from 0 to 5000, that is ~60% of max score at score distance.
+func distanceScore(distance, scoreDistance float64) int {
+	if scoreDistance <= 0 {
+		return 0
+	}
+
+	return int(maxRoundScore * math.Exp(-0.5*math.Pow(distance/scoreDistance, 2))) //nolint:mnd
+}
@@ -13,21 +13,22 @@ import (
 //
 //go:generate go tool mockery --name=Repository
 type Repository interface {
-	GetGoogleStreetview(ctx context.Context, id int) (game.GoogleStreetview, error)
-	GetSeznamStreetview(ctx context.Context, id int) (game.SeznamStreetview, error)
-	GetYandexStreetview(ctx context.Context, id int) (game.YandexStreetview, error)
-	GetYandexAirview(ctx context.Context, id int) (game.YandexAirview, error)
-	RandomGoogleStreetview(ctx context.Context) (game.GoogleStreetview, error)
-	RandomSeznamStreetview(ctx context.Context) (game.SeznamStreetview, error)
-	RandomYandexAirview(ctx context.Context) (game.YandexAirview, error)
-	RandomYandexStreetview(ctx context.Context) (game.YandexStreetview, error)
+	RandomMapStreetview(ctx context.Context, mapID int, exclude []int) (game.PanoramaMetadata, error)
+	GetRecentLocationIDs(ctx context.Context, userIDs []int, games int) ([]int, error)
+	CountPanoramaLocations(ctx context.Context, provider game.PanoramaProvider) (int, error)
+	GetPanoramaLocationIDs(ctx context.Context, provider game.PanoramaProvider, offsets []int) ([]int, error)
+	GetPanoramaLocationBounds(ctx context.Context, providers []game.PanoramaProvider) (game.Bounds, error)
+	GetMapLocationBounds(ctx context.Context, mapID int) (game.Bounds, error)
 }
 
 // Usecase contains business logic for panorama metadata management.
 type Usecase struct {
-	cfg    Config
-	repo   Repository
-	tracer trace.Tracer
+	cfg       Config
+	repo      Repository
+	providers *Registry
+	scorings  *ScoringRegistry
+	countries CountryResolver
+	tracer    trace.Tracer
 }
 
 // NewUsecase creates and returns a new Usecase instance with the provided dependencies.
@@ -35,10 +36,25 @@ type Usecase struct {
 // cfg - Configuration settings for the Usecase.
 //
 // repo - Implementation of the Repository interface for accessing panorama metadata.
-func NewUsecase(cfg Config, repo Repository) *Usecase {
+//
+// providers - Registry of panorama providers available for games.
+//
+// scorings - Registry of scoring strategies available for games.
+//
+// countries - Implementation of the CountryResolver interface for resolving countries of locations and guesses.
+func NewUsecase(
+	cfg Config,
+	repo Repository,
+	providers *Registry,
+	scorings *ScoringRegistry,
+	countries CountryResolver,
+) *Usecase {
 	return &Usecase{
-		cfg:    cfg,
-		repo:   repo,
-		tracer: otel.GetTracerProvider().Tracer("PanoramaUsecase"),
+		cfg:       cfg,
+		repo:      repo,
+		providers: providers,
+		scorings:  scorings,
+		countries: countries,
+		tracer:    otel.GetTracerProvider().Tracer("PanoramaUsecase"),
 	}
 }
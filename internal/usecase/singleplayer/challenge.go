@@ -0,0 +1,173 @@
+package singleplayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game/singleplayer"
+)
+
+// NewChallenge creates a shareable challenge from a finished game (or returns the existing one),
+// so that other players can replay its locations with the same settings. Daily challenge games can't be shared.
+func (uc Usecase) NewChallenge(
+	ctx context.Context,
+	req dto.NewSingleplayerChallengeRequest,
+) (singleplayer.Challenge, error) {
+	ctx, span := uc.tracer.Start(ctx, "NewChallenge")
+	defer span.End()
+
+	var response singleplayer.Challenge
+
+	err := uc.repo.RunTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.LockSingleplayerGame(ctx, req.GameID); err != nil {
+			return fmt.Errorf("failed to lock game: %w", err)
+		}
+
+		game, err := uc.repo.GetSingleplayerGame(ctx, req.GameID)
+		if err != nil {
+			return fmt.Errorf("failed to get singleplayer game: %w", err)
+		}
+
+		if game.UserID != req.UserID {
+			return singleplayer.ErrGameWrongUserID
+		}
+
+		// locations must not be revealed before the game is finished
+		if !game.Finished {
+			return singleplayer.ErrGameIsStillActive
+		}
+
+		// locations of the daily challenge are shared by every player of the day
+		if game.IsDaily() {
+			return singleplayer.ErrChallengeFromDailyGame
+		}
+
+		if existing, err := uc.repo.GetSingleplayerChallengeByGameID(ctx, game.ID); err == nil {
+			response = existing
+			return nil
+		} else if !errors.Is(err, singleplayer.ErrChallengeNotFound) {
+			return fmt.Errorf("failed to get existing challenge: %w", err)
+		}
+
+		locationIDs, err := uc.repo.GetSingleplayerGameLocationIDs(ctx, game.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get game locations: %w", err)
+		}
+
+		challengeID, err := uc.repo.NewSingleplayerChallenge(ctx, dto.NewSingleplayerChallengeRequestDB{
+			RequestTime: req.RequestTime,
+			Token:       uc.rnd.NewRandomHexString(uc.cfg.ChallengeTokenLength),
+			GameID:      game.ID,
+			UserID:      game.UserID,
+			LocationIDs: locationIDs,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create challenge: %w", err)
+		}
+
+		challenge, err := uc.repo.GetSingleplayerChallengeByID(ctx, challengeID)
+		if err != nil {
+			return fmt.Errorf("failed to get created challenge: %w", err)
+		}
+
+		response = challenge
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return singleplayer.Challenge{}, fmt.Errorf("failed to create challenge: %w", err)
+	}
+
+	return response, nil
+}
+
+// AcceptChallenge starts a new game with locations and settings of the challenge.
+func (uc Usecase) AcceptChallenge(ctx context.Context, req dto.AcceptSingleplayerChallengeRequest) (int, error) {
+	ctx, span := uc.tracer.Start(ctx, "AcceptChallenge")
+	defer span.End()
+
+	challenge, err := uc.repo.GetSingleplayerChallenge(ctx, req.Token)
+	if err != nil {
+		span.RecordError(err)
+		return 0, fmt.Errorf("failed to get challenge: %w", err)
+	}
+
+	if challenge.UserID == req.UserID {
+		return 0, singleplayer.ErrChallengeAlreadyPlayed
+	}
+
+	gameID, err := uc.NewGame(ctx, dto.NewSingleplayerGameRequest{
+		RequestTime:     req.RequestTime,
+		UserID:          req.UserID,
+		Rounds:          len(challenge.LocationIDs),
+		TimerSeconds:    challenge.TimerSeconds,
+		Provider:        string(challenge.Provider),
+		Providers:       challenge.Providers,
+		ScoreDistance:   challenge.ScoreDistance,
+		Scoring:         challenge.Scoring,
+		MovementAllowed: challenge.MovementAllowed,
+		ChallengeID:     challenge.ID,
+	})
+	if err != nil {
+		span.RecordError(err)
+		return 0, fmt.Errorf("failed to create challenge game: %w", err)
+	}
+
+	return gameID, nil
+}
+
+// GetChallenge returns a challenge with games of its creator and challengers.
+// Guesses are only included after the requesting user finished their own game of the challenge,
+// so that locations are not revealed before playing.
+func (uc Usecase) GetChallenge(
+	ctx context.Context,
+	req dto.GetSingleplayerChallengeRequest,
+) (singleplayer.ChallengeComparison, error) {
+	ctx, span := uc.tracer.Start(ctx, "GetChallenge")
+	defer span.End()
+
+	challenge, err := uc.repo.GetSingleplayerChallenge(ctx, req.Token)
+	if err != nil {
+		span.RecordError(err)
+		return singleplayer.ChallengeComparison{}, fmt.Errorf("failed to get challenge: %w", err)
+	}
+
+	results, err := uc.repo.GetSingleplayerChallengeResults(ctx, challenge.ID)
+	if err != nil {
+		span.RecordError(err)
+		return singleplayer.ChallengeComparison{}, fmt.Errorf("failed to get challenge results: %w", err)
+	}
+
+	finished := slices.ContainsFunc(results, func(res singleplayer.ChallengeResult) bool {
+		return res.UserID == req.UserID && res.Finished
+	})
+	if !finished {
+		return singleplayer.ChallengeComparison{
+			Challenge: challenge,
+			Results:   results,
+		}, nil
+	}
+
+	for i, res := range results {
+		if !res.Finished {
+			continue
+		}
+
+		guesses, err := uc.repo.GetSingleplayerGameGuesses(ctx, res.GameID)
+		if err != nil {
+			span.RecordError(err)
+			return singleplayer.ChallengeComparison{}, fmt.Errorf("failed to get challenge game guesses: %w", err)
+		}
+
+		results[i].Guesses = guesses
+	}
+
+	return singleplayer.ChallengeComparison{
+		Challenge: challenge,
+		Results:   results,
+	}, nil
+}
@@ -0,0 +1,471 @@
+package singleplayer_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	singleplayerEntity "github.com/VasySS/segoya-backend/internal/entity/game/singleplayer"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/repository"
+	"github.com/VasySS/segoya-backend/internal/usecase/singleplayer"
+	"github.com/VasySS/segoya-backend/internal/usecase/singleplayer/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUsecase_NewChallenge(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	cfg := singleplayer.Config{ChallengeTokenLength: 16}
+
+	finishedGame := singleplayerEntity.Game{
+		ID:       123,
+		UserID:   1,
+		Rounds:   3,
+		Finished: true,
+	}
+
+	challenge := singleplayerEntity.Challenge{
+		ID:          7,
+		Token:       "0123456789abcdef",
+		GameID:      finishedGame.ID,
+		UserID:      finishedGame.UserID,
+		LocationIDs: []int{11, 22, 33},
+	}
+
+	type fields struct {
+		rnd  *mocks.RandomGenerator
+		repo *mocks.Repository
+	}
+
+	type args struct {
+		req dto.NewSingleplayerChallengeRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		want    singleplayerEntity.Challenge
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "successfully create challenge",
+			args: args{
+				req: dto.NewSingleplayerChallengeRequest{RequestTime: now, GameID: 123, UserID: 1},
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("LockSingleplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+				fs.repo.On("GetSingleplayerGame", mock.Anything, args.req.GameID).
+					Return(finishedGame, nil)
+				fs.repo.On("GetSingleplayerChallengeByGameID", mock.Anything, finishedGame.ID).
+					Return(singleplayerEntity.Challenge{}, singleplayerEntity.ErrChallengeNotFound)
+				fs.repo.On("GetSingleplayerGameLocationIDs", mock.Anything, finishedGame.ID).
+					Return(challenge.LocationIDs, nil)
+				fs.rnd.On("NewRandomHexString", cfg.ChallengeTokenLength).
+					Return(challenge.Token)
+				fs.repo.On("NewSingleplayerChallenge", mock.Anything, dto.NewSingleplayerChallengeRequestDB{
+					RequestTime: now,
+					Token:       challenge.Token,
+					GameID:      finishedGame.ID,
+					UserID:      finishedGame.UserID,
+					LocationIDs: challenge.LocationIDs,
+				}).Return(challenge.ID, nil)
+				fs.repo.On("GetSingleplayerChallengeByID", mock.Anything, challenge.ID).
+					Return(challenge, nil)
+			},
+			want:    challenge,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "existing challenge of the game is returned",
+			args: args{
+				req: dto.NewSingleplayerChallengeRequest{RequestTime: now, GameID: 123, UserID: 1},
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("LockSingleplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+				fs.repo.On("GetSingleplayerGame", mock.Anything, args.req.GameID).
+					Return(finishedGame, nil)
+				fs.repo.On("GetSingleplayerChallengeByGameID", mock.Anything, finishedGame.ID).
+					Return(challenge, nil)
+			},
+			want:    challenge,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "game belongs to another user",
+			args: args{
+				req: dto.NewSingleplayerChallengeRequest{RequestTime: now, GameID: 123, UserID: 2},
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("LockSingleplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+				fs.repo.On("GetSingleplayerGame", mock.Anything, args.req.GameID).
+					Return(finishedGame, nil)
+			},
+			want: singleplayerEntity.Challenge{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, singleplayerEntity.ErrGameWrongUserID)
+			},
+		},
+		{
+			name: "game is not finished",
+			args: args{
+				req: dto.NewSingleplayerChallengeRequest{RequestTime: now, GameID: 123, UserID: 1},
+			},
+			setup: func(fs fields, args args) {
+				activeGame := finishedGame
+				activeGame.Finished = false
+
+				fs.repo.On("LockSingleplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+				fs.repo.On("GetSingleplayerGame", mock.Anything, args.req.GameID).
+					Return(activeGame, nil)
+			},
+			want: singleplayerEntity.Challenge{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, singleplayerEntity.ErrGameIsStillActive)
+			},
+		},
+		{
+			name: "game is a daily challenge",
+			args: args{
+				req: dto.NewSingleplayerChallengeRequest{RequestTime: now, GameID: 123, UserID: 1},
+			},
+			setup: func(fs fields, args args) {
+				dailyGame := finishedGame
+				dailyGame.DailyChallengeID = 7
+
+				fs.repo.On("LockSingleplayerGame", mock.Anything, args.req.GameID).
+					Return(nil)
+				fs.repo.On("GetSingleplayerGame", mock.Anything, args.req.GameID).
+					Return(dailyGame, nil)
+			},
+			want: singleplayerEntity.Challenge{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, singleplayerEntity.ErrChallengeFromDailyGame)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rnd := mocks.NewRandomGenerator(t)
+			repo := mocks.NewRepository(t)
+			fs := fields{rnd: rnd, repo: repo}
+			uc := singleplayer.NewUsecase(cfg, rnd, repo, mocks.NewPanoramaUsecase(t), mocks.NewMapUsecase(t))
+
+			repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+				Return(func(ctx context.Context, fn repository.TxFunc) error {
+					return fn(ctx)
+				})
+
+			tt.setup(fs, tt.args)
+
+			got, err := uc.NewChallenge(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUsecase_AcceptChallenge(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	challenge := singleplayerEntity.Challenge{
+		ID:              7,
+		Token:           "0123456789abcdef",
+		GameID:          123,
+		UserID:          1,
+		Rounds:          3,
+		TimerSeconds:    60,
+		MovementAllowed: true,
+		Provider:        game.MixedProvider,
+		Providers:       []game.PanoramaProvider{game.GoogleProvider, game.SeznamProvider},
+		ScoreDistance:   42.5,
+		Scoring:         game.HardScoring,
+		LocationIDs:     []int{11, 22, 33},
+		LocationProviders: []game.PanoramaProvider{
+			game.SeznamProvider, game.GoogleProvider, game.SeznamProvider,
+		},
+	}
+
+	type fields struct {
+		repo        *mocks.Repository
+		panoUsecase *mocks.PanoramaUsecase
+	}
+
+	type args struct {
+		req dto.AcceptSingleplayerChallengeRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		want    int
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "successfully accept challenge",
+			args: args{
+				req: dto.AcceptSingleplayerChallengeRequest{RequestTime: now, Token: challenge.Token, UserID: 2},
+			},
+			setup: func(fs fields, args args) {
+				createdGame := singleplayerEntity.Game{
+					ID:              456,
+					UserID:          args.req.UserID,
+					Rounds:          challenge.Rounds,
+					TimerSeconds:    challenge.TimerSeconds,
+					MovementAllowed: challenge.MovementAllowed,
+					Provider:        challenge.Provider,
+					Providers:       challenge.Providers,
+					ScoreDistance:   challenge.ScoreDistance,
+					Scoring:         challenge.Scoring,
+					ChallengeID:     challenge.ID,
+				}
+
+				fs.repo.On("GetSingleplayerChallenge", mock.Anything, args.req.Token).
+					Return(challenge, nil)
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+				fs.repo.On("NewSingleplayerGame", mock.Anything, dto.NewSingleplayerGameRequest{
+					RequestTime:     now,
+					UserID:          args.req.UserID,
+					Rounds:          challenge.Rounds,
+					TimerSeconds:    challenge.TimerSeconds,
+					Provider:        string(challenge.Provider),
+					Providers:       challenge.Providers,
+					ScoreDistance:   challenge.ScoreDistance,
+					Scoring:         challenge.Scoring,
+					MovementAllowed: challenge.MovementAllowed,
+					ChallengeID:     challenge.ID,
+				}).Return(createdGame.ID, nil)
+				fs.repo.On("LockSingleplayerGame", mock.Anything, createdGame.ID).
+					Return(nil)
+				fs.repo.On("GetSingleplayerGame", mock.Anything, createdGame.ID).
+					Return(createdGame, nil)
+				fs.repo.On("GetSingleplayerRound", mock.Anything, createdGame.ID, 0).
+					Return(singleplayerEntity.Round{}, singleplayerEntity.ErrRoundNotFound)
+
+				// first round replays the first location of the challenge on its provider
+				fs.repo.On("GetSingleplayerChallengeByID", mock.Anything, challenge.ID).
+					Return(challenge, nil)
+				fs.panoUsecase.On("GetStreetview", mock.Anything, game.SeznamProvider, challenge.LocationIDs[0]).
+					Return(game.PanoramaMetadata{ID: challenge.LocationIDs[0], Provider: game.SeznamProvider}, nil)
+				fs.repo.On("NewSingleplayerRound", mock.Anything, dto.NewSingleplayerRoundDBRequest{
+					CreatedAt:  now,
+					StartedAt:  now,
+					LocationID: challenge.LocationIDs[0],
+					Provider:   game.SeznamProvider,
+					GameID:     createdGame.ID,
+					RoundNum:   1,
+				}).Return(singleplayerEntity.Round{ID: 1, GameID: createdGame.ID}, nil)
+			},
+			want:    456,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "challenge creator can not accept own challenge",
+			args: args{
+				req: dto.AcceptSingleplayerChallengeRequest{RequestTime: now, Token: challenge.Token, UserID: 1},
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("GetSingleplayerChallenge", mock.Anything, args.req.Token).
+					Return(challenge, nil)
+			},
+			want: 0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, singleplayerEntity.ErrChallengeAlreadyPlayed)
+			},
+		},
+		{
+			name: "challenge already played",
+			args: args{
+				req: dto.AcceptSingleplayerChallengeRequest{RequestTime: now, Token: challenge.Token, UserID: 2},
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("GetSingleplayerChallenge", mock.Anything, args.req.Token).
+					Return(challenge, nil)
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+				fs.repo.On("NewSingleplayerGame", mock.Anything, mock.Anything).
+					Return(0, singleplayerEntity.ErrChallengeAlreadyPlayed)
+			},
+			want: 0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, singleplayerEntity.ErrChallengeAlreadyPlayed)
+			},
+		},
+		{
+			name: "challenge not found",
+			args: args{
+				req: dto.AcceptSingleplayerChallengeRequest{RequestTime: now, Token: "unknown", UserID: 2},
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("GetSingleplayerChallenge", mock.Anything, args.req.Token).
+					Return(singleplayerEntity.Challenge{}, singleplayerEntity.ErrChallengeNotFound)
+			},
+			want: 0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, singleplayerEntity.ErrChallengeNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			panoramaUsecase := mocks.NewPanoramaUsecase(t)
+			fs := fields{repo: repo, panoUsecase: panoramaUsecase}
+			uc := singleplayer.NewUsecase(
+				singleplayer.Config{},
+				mocks.NewRandomGenerator(t),
+				repo,
+				panoramaUsecase,
+				mocks.NewMapUsecase(t),
+			)
+
+			tt.setup(fs, tt.args)
+
+			got, err := uc.AcceptChallenge(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUsecase_GetChallenge(t *testing.T) {
+	t.Parallel()
+
+	challenge := singleplayerEntity.Challenge{
+		ID:     7,
+		Token:  "0123456789abcdef",
+		GameID: 123,
+		UserID: 1,
+	}
+
+	guesses := []singleplayerEntity.Guess{
+		{RoundNum: 1, RoundLat: 1, RoundLng: 2, GuessLat: 3, GuessLng: 4, Score: 1000},
+	}
+
+	// returns new results, because usecase fills guesses in place
+	newResults := func() []singleplayerEntity.ChallengeResult {
+		return []singleplayerEntity.ChallengeResult{
+			{GameID: 123, UserID: 1, Score: 5000, Finished: true},
+			{GameID: 456, UserID: 2, Score: 3000, Finished: true},
+			{GameID: 789, UserID: 3, Score: 1000, Finished: false},
+		}
+	}
+
+	type fields struct {
+		repo *mocks.Repository
+	}
+
+	type args struct {
+		req dto.GetSingleplayerChallengeRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		want    singleplayerEntity.ChallengeComparison
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "guesses are included for player who finished the challenge",
+			args: args{
+				req: dto.GetSingleplayerChallengeRequest{Token: challenge.Token, UserID: 2},
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("GetSingleplayerChallenge", mock.Anything, args.req.Token).
+					Return(challenge, nil)
+				fs.repo.On("GetSingleplayerChallengeResults", mock.Anything, challenge.ID).
+					Return(newResults(), nil)
+				fs.repo.On("GetSingleplayerGameGuesses", mock.Anything, 123).
+					Return(guesses, nil)
+				fs.repo.On("GetSingleplayerGameGuesses", mock.Anything, 456).
+					Return(guesses, nil)
+			},
+			want: singleplayerEntity.ChallengeComparison{
+				Challenge: challenge,
+				Results: []singleplayerEntity.ChallengeResult{
+					{GameID: 123, UserID: 1, Score: 5000, Finished: true, Guesses: guesses},
+					{GameID: 456, UserID: 2, Score: 3000, Finished: true, Guesses: guesses},
+					{GameID: 789, UserID: 3, Score: 1000, Finished: false},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "guesses are hidden until player finished the challenge",
+			args: args{
+				req: dto.GetSingleplayerChallengeRequest{Token: challenge.Token, UserID: 3},
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("GetSingleplayerChallenge", mock.Anything, args.req.Token).
+					Return(challenge, nil)
+				fs.repo.On("GetSingleplayerChallengeResults", mock.Anything, challenge.ID).
+					Return(newResults(), nil)
+			},
+			want: singleplayerEntity.ChallengeComparison{
+				Challenge: challenge,
+				Results:   newResults(),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error getting results",
+			args: args{
+				req: dto.GetSingleplayerChallengeRequest{Token: challenge.Token, UserID: 1},
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("GetSingleplayerChallenge", mock.Anything, args.req.Token).
+					Return(challenge, nil)
+				fs.repo.On("GetSingleplayerChallengeResults", mock.Anything, challenge.ID).
+					Return(nil, errors.New("some repository error"))
+			},
+			want:    singleplayerEntity.ChallengeComparison{},
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			fs := fields{repo: repo}
+			uc := singleplayer.NewUsecase(
+				singleplayer.Config{},
+				mocks.NewRandomGenerator(t),
+				repo,
+				mocks.NewPanoramaUsecase(t),
+				mocks.NewMapUsecase(t),
+			)
+
+			tt.setup(fs, tt.args)
+
+			got, err := uc.GetChallenge(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -10,11 +10,21 @@ import (
 type Config struct {
 	// Initial delay before round starts on frontend (to allow panorama to load).
 	RoundStartDelay time.Duration
+	// Settings of daily challenge games, same for every player.
+	DailyChallengeRounds          int
+	DailyChallengeTimerSeconds    int
+	DailyChallengeMovementAllowed bool
+	// Length of a shareable challenge token.
+	ChallengeTokenLength int
 }
 
 // NewConfig returns a new local config from general config.
 func NewConfig(cfg config.Config) Config {
 	return Config{
-		RoundStartDelay: cfg.Limits.RoundStartDelay,
+		RoundStartDelay:               cfg.Limits.RoundStartDelay,
+		DailyChallengeRounds:          cfg.Limits.DailyRounds,
+		DailyChallengeTimerSeconds:    int(cfg.Limits.DailyTimer.Seconds()),
+		DailyChallengeMovementAllowed: cfg.Limits.DailyMovement,
+		ChallengeTokenLength:          cfg.Limits.ChallengeTokenLength,
 	}
 }
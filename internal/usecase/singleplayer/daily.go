@@ -0,0 +1,123 @@
+package singleplayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/singleplayer"
+)
+
+// GetDailyChallengeLeaderboard returns finished games of a daily challenge ranked by score.
+func (uc Usecase) GetDailyChallengeLeaderboard(
+	ctx context.Context,
+	req dto.GetDailyChallengeLeaderboardRequest,
+) ([]singleplayer.DailyChallengeResult, int, error) {
+	ctx, span := uc.tracer.Start(ctx, "GetDailyChallengeLeaderboard")
+	defer span.End()
+
+	date := req.Date
+	if date.IsZero() {
+		date = req.RequestTime
+	}
+
+	challenge, err := uc.repo.GetDailyChallenge(ctx, challengeDate(date), req.Provider)
+	if errors.Is(err, singleplayer.ErrDailyChallengeNotFound) {
+		// nobody has played the challenge yet
+		return []singleplayer.DailyChallengeResult{}, 0, nil
+	} else if err != nil {
+		span.RecordError(err)
+		return nil, 0, fmt.Errorf("failed to get daily challenge: %w", err)
+	}
+
+	results, total, err := uc.repo.GetDailyChallengeLeaderboard(ctx, dto.GetDailyChallengeLeaderboardRequestDB{
+		ChallengeID: challenge.ID,
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, 0, fmt.Errorf("failed to get daily challenge leaderboard: %w", err)
+	}
+
+	return results, total, nil
+}
+
+// withDailyChallenge replaces game settings with settings of today's daily challenge.
+// Must be called inside a transaction.
+func (uc Usecase) withDailyChallenge(
+	ctx context.Context,
+	req dto.NewSingleplayerGameRequest,
+) (dto.NewSingleplayerGameRequest, error) {
+	// daily challenges are created per provider, so all players of a challenge get the same score modifier
+	if game.PanoramaProvider(req.Provider) == game.MixedProvider {
+		return dto.NewSingleplayerGameRequest{}, singleplayer.ErrDailyChallengeMixedProvider
+	}
+
+	challenge, err := uc.getOrCreateDailyChallenge(ctx, req.RequestTime, game.PanoramaProvider(req.Provider))
+	if err != nil {
+		return dto.NewSingleplayerGameRequest{}, err
+	}
+
+	req.DailyChallengeID = challenge.ID
+	req.Rounds = len(challenge.LocationIDs)
+	req.TimerSeconds = uc.cfg.DailyChallengeTimerSeconds
+	req.MovementAllowed = uc.cfg.DailyChallengeMovementAllowed
+	// daily challenge locations are shared by all players, so custom maps are not used
+	req.MapID = 0
+	req.Providers = nil
+	// all players of a daily challenge are scored the same way
+	req.ScoreDistance = 0
+	req.Scoring = game.ClassicScoring
+
+	return req, nil
+}
+
+// getOrCreateDailyChallenge returns the daily challenge of the request date,
+// its locations are selected by the first player of the day.
+func (uc Usecase) getOrCreateDailyChallenge(
+	ctx context.Context,
+	requestTime time.Time,
+	provider game.PanoramaProvider,
+) (singleplayer.DailyChallenge, error) {
+	date := challengeDate(requestTime)
+
+	challenge, err := uc.repo.GetDailyChallenge(ctx, date, provider)
+	if err == nil {
+		return challenge, nil
+	} else if !errors.Is(err, singleplayer.ErrDailyChallengeNotFound) {
+		return singleplayer.DailyChallenge{}, fmt.Errorf("failed to get daily challenge: %w", err)
+	}
+
+	locationIDs, err := uc.pano.DailyLocationIDs(ctx, date, provider, uc.cfg.DailyChallengeRounds)
+	if err != nil {
+		return singleplayer.DailyChallenge{}, fmt.Errorf("failed to select daily locations: %w", err)
+	}
+
+	// challenge could be created concurrently by another player, then it is left as is
+	if err := uc.repo.NewDailyChallenge(ctx, dto.NewDailyChallengeRequest{
+		RequestTime: requestTime,
+		Date:        date,
+		Provider:    provider,
+		LocationIDs: locationIDs,
+	}); err != nil {
+		return singleplayer.DailyChallenge{}, fmt.Errorf("failed to create daily challenge: %w", err)
+	}
+
+	challenge, err = uc.repo.GetDailyChallenge(ctx, date, provider)
+	if err != nil {
+		return singleplayer.DailyChallenge{}, fmt.Errorf("failed to get created daily challenge: %w", err)
+	}
+
+	return challenge, nil
+}
+
+// challengeDate returns the UTC date a daily challenge is played at.
+func challengeDate(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
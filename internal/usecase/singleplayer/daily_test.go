@@ -0,0 +1,359 @@
+package singleplayer_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	singleplayerEntity "github.com/VasySS/segoya-backend/internal/entity/game/singleplayer"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/repository"
+	"github.com/VasySS/segoya-backend/internal/usecase/singleplayer"
+	"github.com/VasySS/segoya-backend/internal/usecase/singleplayer/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUsecase_NewDailyGame(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC)
+	today := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		repo        *mocks.Repository
+		panoUsecase *mocks.PanoramaUsecase
+	}
+
+	cfg := singleplayer.Config{
+		RoundStartDelay:               5 * time.Second,
+		DailyChallengeRounds:          5,
+		DailyChallengeTimerSeconds:    120,
+		DailyChallengeMovementAllowed: true,
+	}
+
+	// settings from request are replaced with daily challenge settings
+	createGameReq := dto.NewSingleplayerGameRequest{
+		RequestTime:     now,
+		UserID:          1,
+		Rounds:          1,
+		TimerSeconds:    10,
+		Provider:        "google",
+		MovementAllowed: false,
+		Daily:           true,
+		ScoreDistance:   10,
+		Scoring:         game.TimedScoring,
+	}
+
+	challenge := singleplayerEntity.DailyChallenge{
+		ID:          7,
+		Date:        today,
+		Provider:    game.GoogleProvider,
+		LocationIDs: []int{11, 22, 33, 44, 55},
+	}
+
+	dailyGameReq := createGameReq
+	dailyGameReq.DailyChallengeID = challenge.ID
+	dailyGameReq.Rounds = len(challenge.LocationIDs)
+	dailyGameReq.TimerSeconds = cfg.DailyChallengeTimerSeconds
+	dailyGameReq.MovementAllowed = cfg.DailyChallengeMovementAllowed
+	dailyGameReq.ScoreDistance = 555.5
+	dailyGameReq.Scoring = game.ClassicScoring
+
+	// custom score distance and scoring are not allowed, all players of a daily challenge are scored the same way
+	expectScoreDistance := func(fs fields) {
+		fs.panoUsecase.On("ScoreDistance", mock.Anything, dto.ScoreDistanceRequest{Provider: game.GoogleProvider}).
+			Return(dailyGameReq.ScoreDistance, nil)
+	}
+
+	createdGame := singleplayerEntity.Game{
+		ID:               123,
+		UserID:           dailyGameReq.UserID,
+		Rounds:           dailyGameReq.Rounds,
+		TimerSeconds:     dailyGameReq.TimerSeconds,
+		MovementAllowed:  dailyGameReq.MovementAllowed,
+		Provider:         game.GoogleProvider,
+		DailyChallengeID: challenge.ID,
+	}
+
+	// first round is created from the first challenge location
+	expectFirstRound := func(fs fields) {
+		fs.repo.On("LockSingleplayerGame", mock.Anything, createdGame.ID).
+			Return(nil)
+
+		fs.repo.On("GetSingleplayerGame", mock.Anything, createdGame.ID).
+			Return(createdGame, nil)
+
+		fs.repo.On("GetSingleplayerRound", mock.Anything, createdGame.ID, 0).
+			Return(singleplayerEntity.Round{}, singleplayerEntity.ErrRoundNotFound)
+
+		fs.repo.On("GetDailyChallengeByID", mock.Anything, challenge.ID).
+			Return(challenge, nil)
+
+		fs.panoUsecase.On("GetStreetview", mock.Anything, game.GoogleProvider, challenge.LocationIDs[0]).
+			Return(game.PanoramaMetadata{ID: challenge.LocationIDs[0]}, nil)
+
+		fs.repo.On("NewSingleplayerRound", mock.Anything, dto.NewSingleplayerRoundDBRequest{
+			CreatedAt:  now,
+			StartedAt:  now.Add(cfg.RoundStartDelay),
+			LocationID: challenge.LocationIDs[0],
+			GameID:     createdGame.ID,
+			RoundNum:   1,
+		}).Return(singleplayerEntity.Round{ID: 1, GameID: createdGame.ID}, nil)
+	}
+
+	mixedGameReq := createGameReq
+	mixedGameReq.Provider = string(game.MixedProvider)
+
+	tests := []struct {
+		name    string
+		req     dto.NewSingleplayerGameRequest
+		setup   func(fields)
+		want    int
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "successfully create game of existing daily challenge",
+			req:  createGameReq,
+			setup: func(fs fields) {
+				fs.repo.On("GetDailyChallenge", mock.Anything, today, game.GoogleProvider).
+					Return(challenge, nil)
+
+				expectScoreDistance(fs)
+
+				fs.repo.On("NewSingleplayerGame", mock.Anything, dailyGameReq).
+					Return(createdGame.ID, nil)
+
+				expectFirstRound(fs)
+			},
+			want:    createdGame.ID,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "successfully create daily challenge for the first player of the day",
+			req:  createGameReq,
+			setup: func(fs fields) {
+				fs.repo.On("GetDailyChallenge", mock.Anything, today, game.GoogleProvider).
+					Return(singleplayerEntity.DailyChallenge{}, singleplayerEntity.ErrDailyChallengeNotFound).
+					Once()
+
+				fs.panoUsecase.On("DailyLocationIDs", mock.Anything, today, game.GoogleProvider, cfg.DailyChallengeRounds).
+					Return(challenge.LocationIDs, nil)
+
+				fs.repo.On("NewDailyChallenge", mock.Anything, dto.NewDailyChallengeRequest{
+					RequestTime: now,
+					Date:        today,
+					Provider:    game.GoogleProvider,
+					LocationIDs: challenge.LocationIDs,
+				}).Return(nil)
+
+				fs.repo.On("GetDailyChallenge", mock.Anything, today, game.GoogleProvider).
+					Return(challenge, nil).
+					Once()
+
+				expectScoreDistance(fs)
+
+				fs.repo.On("NewSingleplayerGame", mock.Anything, dailyGameReq).
+					Return(createdGame.ID, nil)
+
+				expectFirstRound(fs)
+			},
+			want:    createdGame.ID,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "daily challenge already played",
+			req:  createGameReq,
+			setup: func(fs fields) {
+				fs.repo.On("GetDailyChallenge", mock.Anything, today, game.GoogleProvider).
+					Return(challenge, nil)
+
+				expectScoreDistance(fs)
+
+				fs.repo.On("NewSingleplayerGame", mock.Anything, dailyGameReq).
+					Return(0, singleplayerEntity.ErrDailyChallengeAlreadyPlayed)
+			},
+			want: 0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, singleplayerEntity.ErrDailyChallengeAlreadyPlayed)
+			},
+		},
+		{
+			name: "error selecting daily locations",
+			req:  createGameReq,
+			setup: func(fs fields) {
+				fs.repo.On("GetDailyChallenge", mock.Anything, today, game.GoogleProvider).
+					Return(singleplayerEntity.DailyChallenge{}, singleplayerEntity.ErrDailyChallengeNotFound)
+
+				fs.panoUsecase.On("DailyLocationIDs", mock.Anything, today, game.GoogleProvider, cfg.DailyChallengeRounds).
+					Return(nil, errors.New("not enough locations"))
+			},
+			want:    0,
+			wantErr: assert.Error,
+		},
+		{
+			name:  "daily challenge can't be played with mixed provider",
+			req:   mixedGameReq,
+			setup: func(_ fields) {},
+			want:  0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, singleplayerEntity.ErrDailyChallengeMixedProvider)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			panoramaUsecase := mocks.NewPanoramaUsecase(t)
+			fs := fields{repo: repo, panoUsecase: panoramaUsecase}
+			uc := singleplayer.NewUsecase(
+				cfg,
+				mocks.NewRandomGenerator(t),
+				repo,
+				panoramaUsecase,
+				mocks.NewMapUsecase(t),
+			)
+
+			repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+				Return(func(ctx context.Context, fn repository.TxFunc) error {
+					return fn(ctx)
+				})
+
+			tt.setup(fs)
+
+			got, err := uc.NewGame(t.Context(), tt.req)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUsecase_GetDailyChallengeLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC)
+	today := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+
+	challenge := singleplayerEntity.DailyChallenge{
+		ID:       7,
+		Date:     today,
+		Provider: game.YandexProvider,
+	}
+
+	results := []singleplayerEntity.DailyChallengeResult{
+		{Place: 1, UserID: 2, GameID: 20, Score: 20000},
+		{Place: 2, UserID: 1, GameID: 10, Score: 15000},
+	}
+
+	type fields struct {
+		repo        *mocks.Repository
+		panoUsecase *mocks.PanoramaUsecase
+	}
+
+	type args struct {
+		req dto.GetDailyChallengeLeaderboardRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		setup     func(fields, args)
+		want      []singleplayerEntity.DailyChallengeResult
+		wantTotal int
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "successfully get today's leaderboard",
+			args: args{
+				req: dto.GetDailyChallengeLeaderboardRequest{
+					RequestTime: now,
+					Provider:    game.YandexProvider,
+					Page:        1,
+					PageSize:    10,
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("GetDailyChallenge", mock.Anything, today, args.req.Provider).
+					Return(challenge, nil)
+
+				fs.repo.On("GetDailyChallengeLeaderboard", mock.Anything, dto.GetDailyChallengeLeaderboardRequestDB{
+					ChallengeID: challenge.ID,
+					Page:        args.req.Page,
+					PageSize:    args.req.PageSize,
+				}).Return(results, 2, nil)
+			},
+			want:      results,
+			wantTotal: 2,
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "leaderboard of a day without challenge is empty",
+			args: args{
+				req: dto.GetDailyChallengeLeaderboardRequest{
+					RequestTime: now,
+					Date:        today.AddDate(0, 0, -1),
+					Provider:    game.YandexProvider,
+					Page:        1,
+					PageSize:    10,
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("GetDailyChallenge", mock.Anything, args.req.Date, args.req.Provider).
+					Return(singleplayerEntity.DailyChallenge{}, singleplayerEntity.ErrDailyChallengeNotFound)
+			},
+			want:      []singleplayerEntity.DailyChallengeResult{},
+			wantTotal: 0,
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "error getting leaderboard",
+			args: args{
+				req: dto.GetDailyChallengeLeaderboardRequest{
+					RequestTime: now,
+					Provider:    game.YandexProvider,
+					Page:        1,
+					PageSize:    10,
+				},
+			},
+			setup: func(fs fields, _ args) {
+				fs.repo.On("GetDailyChallenge", mock.Anything, today, game.YandexProvider).
+					Return(challenge, nil)
+
+				fs.repo.On("GetDailyChallengeLeaderboard", mock.Anything, mock.Anything).
+					Return(nil, 0, errors.New("some repository error"))
+			},
+			want:      nil,
+			wantTotal: 0,
+			wantErr:   assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			panoramaUsecase := mocks.NewPanoramaUsecase(t)
+			fs := fields{repo: repo, panoUsecase: panoramaUsecase}
+			uc := singleplayer.NewUsecase(
+				singleplayer.Config{},
+				mocks.NewRandomGenerator(t),
+				repo,
+				panoramaUsecase,
+				mocks.NewMapUsecase(t),
+			)
+
+			tt.setup(fs, tt.args)
+
+			got, total, err := uc.GetDailyChallengeLeaderboard(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantTotal, total)
+		})
+	}
+}
@@ -16,6 +16,32 @@ func (uc Usecase) NewGame(ctx context.Context, req dto.NewSingleplayerGameReques
 	var response int
 
 	err := uc.repo.RunTx(ctx, func(ctx context.Context) error {
+		if req.Daily {
+			dailyReq, err := uc.withDailyChallenge(ctx, req)
+			if err != nil {
+				return fmt.Errorf("failed to apply daily challenge: %w", err)
+			}
+
+			req = dailyReq
+		} else if req.MapID != 0 {
+			mapReq, err := uc.withMap(ctx, req)
+			if err != nil {
+				return fmt.Errorf("failed to apply map: %w", err)
+			}
+
+			req = mapReq
+		}
+
+		// games of accepted challenges are scored like the challenged game, even if it has no score distance
+		if req.ScoreDistance == 0 && req.ChallengeID == 0 {
+			scoreDistance, err := uc.scoreDistance(ctx, req)
+			if err != nil {
+				return fmt.Errorf("failed to get score distance: %w", err)
+			}
+
+			req.ScoreDistance = scoreDistance
+		}
+
 		id, err := uc.repo.NewSingleplayerGame(ctx, req)
 		if err != nil {
 			return fmt.Errorf("failed to create singleplayer game: %w", err)
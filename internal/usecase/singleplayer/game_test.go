@@ -8,6 +8,7 @@ import (
 
 	"github.com/VasySS/segoya-backend/internal/dto"
 	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/gamemap"
 	singleplayerEntity "github.com/VasySS/segoya-backend/internal/entity/game/singleplayer"
 	"github.com/VasySS/segoya-backend/internal/infrastructure/repository"
 	"github.com/VasySS/segoya-backend/internal/usecase/singleplayer"
@@ -47,6 +48,7 @@ func TestUsecase_NewGame(t *testing.T) {
 		cfg         singleplayer.Config
 		repo        *mocks.Repository
 		panoUsecase *mocks.PanoramaUsecase
+		mapUsecase  *mocks.MapUsecase
 	}
 
 	type args struct {
@@ -71,7 +73,15 @@ func TestUsecase_NewGame(t *testing.T) {
 						return fn(ctx)
 					})
 
-				fs.repo.On("NewSingleplayerGame", mock.Anything, args.req).
+				fs.panoUsecase.On("ScoreDistance", mock.Anything, dto.ScoreDistanceRequest{
+					Provider: game.PanoramaProvider(args.req.Provider),
+				}).Return(700.5, nil)
+
+				// score distance is derived from the played area for new games
+				gameReq := args.req
+				gameReq.ScoreDistance = 700.5
+
+				fs.repo.On("NewSingleplayerGame", mock.Anything, gameReq).
 					Return(createdGame.ID, nil)
 
 				fs.repo.On("LockSingleplayerGame", mock.Anything, createdGame.ID).
@@ -86,7 +96,14 @@ func TestUsecase_NewGame(t *testing.T) {
 				createdPanoID := 12341
 				createdStreetviewID := "some_streetview_id"
 
-				fs.panoUsecase.On("NewStreetview", mock.Anything, game.PanoramaProvider(args.req.Provider)).
+				fs.repo.On("GetSingleplayerGameLocationIDs", mock.Anything, createdGame.ID).
+					Return([]int{}, nil)
+
+				fs.panoUsecase.On("NewStreetview", mock.Anything, dto.NewStreetviewRequest{
+					Provider:          game.PanoramaProvider(args.req.Provider),
+					UserIDs:           []int{createdGame.UserID},
+					PlayedLocationIDs: []int{},
+				}).
 					Return(game.PanoramaMetadata{
 						ID:           createdPanoID,
 						StreetviewID: createdStreetviewID,
@@ -106,6 +123,86 @@ func TestUsecase_NewGame(t *testing.T) {
 			want:    createdGame.ID,
 			wantErr: assert.NoError,
 		},
+		{
+			name: "successfully create new game on map",
+			args: args{
+				req: dto.NewSingleplayerGameRequest{
+					RequestTime: now,
+					UserID:      1,
+					Rounds:      3,
+					MapID:       10,
+				},
+			},
+			setup: func(fs fields, args args) {
+				mapGame := createdGame
+				mapGame.Rounds = args.req.Rounds
+				mapGame.Provider = game.SeznamProvider
+				mapGame.MapID = args.req.MapID
+
+				gameReq := args.req
+				gameReq.Provider = string(game.SeznamProvider)
+				gameReq.ScoreDistance = 12.5
+
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+				fs.mapUsecase.On("GetPlayableMap", mock.Anything, dto.GetMapRequest{
+					UserID: args.req.UserID,
+					MapID:  args.req.MapID,
+				}).Return(gamemap.Map{ID: args.req.MapID, Provider: game.SeznamProvider, LocationsCount: 5}, nil)
+				fs.panoUsecase.On("ScoreDistance", mock.Anything, dto.ScoreDistanceRequest{
+					Provider: game.SeznamProvider,
+					MapID:    args.req.MapID,
+				}).Return(gameReq.ScoreDistance, nil)
+				fs.repo.On("NewSingleplayerGame", mock.Anything, gameReq).
+					Return(mapGame.ID, nil)
+				fs.repo.On("LockSingleplayerGame", mock.Anything, mapGame.ID).
+					Return(nil)
+				fs.repo.On("GetSingleplayerGame", mock.Anything, mapGame.ID).
+					Return(mapGame, nil)
+				fs.repo.On("GetSingleplayerRound", mock.Anything, mapGame.ID, 0).
+					Return(singleplayerEntity.Round{}, singleplayerEntity.ErrRoundNotFound)
+				fs.repo.On("GetSingleplayerGameLocationIDs", mock.Anything, mapGame.ID).
+					Return([]int{}, nil)
+				fs.panoUsecase.On("NewStreetview", mock.Anything, dto.NewStreetviewRequest{
+					Provider:          game.SeznamProvider,
+					MapID:             args.req.MapID,
+					UserIDs:           []int{mapGame.UserID},
+					PlayedLocationIDs: []int{},
+				}).
+					Return(game.PanoramaMetadata{ID: 777}, nil)
+				fs.repo.On("NewSingleplayerRound", mock.Anything, mock.MatchedBy(
+					func(req dto.NewSingleplayerRoundDBRequest) bool {
+						return req.LocationID == 777 && req.GameID == mapGame.ID
+					},
+				)).Return(singleplayerEntity.Round{ID: 1, GameID: mapGame.ID}, nil)
+			},
+			want:    createdGame.ID,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error creating game - map has no locations",
+			args: args{
+				req: dto.NewSingleplayerGameRequest{
+					RequestTime: now,
+					UserID:      1,
+					MapID:       10,
+				},
+			},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+				fs.mapUsecase.On("GetPlayableMap", mock.Anything, mock.Anything).
+					Return(gamemap.Map{}, gamemap.ErrEmpty)
+			},
+			want: 0,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, gamemap.ErrEmpty)
+			},
+		},
 		{
 			name: "error creating game - tx error",
 			args: args{
@@ -125,8 +222,9 @@ func TestUsecase_NewGame(t *testing.T) {
 			name: "error creating round - game lock error",
 			args: args{
 				req: dto.NewSingleplayerGameRequest{
-					UserID:      1,
-					RequestTime: now,
+					UserID:        1,
+					RequestTime:   now,
+					ScoreDistance: 50,
 				},
 			},
 			setup: func(fs fields, args args) {
@@ -155,8 +253,9 @@ func TestUsecase_NewGame(t *testing.T) {
 			cfg := singleplayer.Config{
 				RoundStartDelay: 5 * time.Second,
 			}
-			fs := fields{repo: repo, panoUsecase: panoramaUsecase, cfg: cfg}
-			uc := singleplayer.NewUsecase(cfg, repo, panoramaUsecase)
+			mapUsecase := mocks.NewMapUsecase(t)
+			fs := fields{repo: repo, panoUsecase: panoramaUsecase, mapUsecase: mapUsecase, cfg: cfg}
+			uc := singleplayer.NewUsecase(cfg, mocks.NewRandomGenerator(t), repo, panoramaUsecase, mapUsecase)
 
 			tt.setup(fs, tt.args)
 
@@ -251,7 +350,13 @@ func TestUsecase_GetGame(t *testing.T) {
 			fs := fields{repo: repo, panoUsecase: panoramaUsecase}
 			tt.setup(fs, tt.args)
 
-			uc := singleplayer.NewUsecase(singleplayer.Config{}, repo, panoramaUsecase)
+			uc := singleplayer.NewUsecase(
+				singleplayer.Config{},
+				mocks.NewRandomGenerator(t),
+				repo,
+				panoramaUsecase,
+				mocks.NewMapUsecase(t),
+			)
 
 			got, err := uc.GetGame(t.Context(), tt.args.req)
 			tt.wantErr(t, err)
@@ -410,7 +515,13 @@ func TestUsecase_EndGame(t *testing.T) {
 			fs := fields{repo: repo, panoUsecase: panoUsecase}
 			tt.setup(fs, tt.args)
 
-			uc := singleplayer.NewUsecase(singleplayer.Config{}, repo, panoUsecase)
+			uc := singleplayer.NewUsecase(
+				singleplayer.Config{},
+				mocks.NewRandomGenerator(t),
+				repo,
+				panoUsecase,
+				mocks.NewMapUsecase(t),
+			)
 
 			err := uc.EndGame(t.Context(), tt.args.req)
 			tt.wantErr(t, err)
@@ -477,7 +588,13 @@ func TestUsecase_GetGames(t *testing.T) {
 			fs := fields{repo: repo, panoUsecase: panoUsecase}
 			tt.setup(fs, tt.args)
 
-			uc := singleplayer.NewUsecase(singleplayer.Config{}, repo, panoUsecase)
+			uc := singleplayer.NewUsecase(
+				singleplayer.Config{},
+				mocks.NewRandomGenerator(t),
+				repo,
+				panoUsecase,
+				mocks.NewMapUsecase(t),
+			)
 
 			gotGames, gotAmountOfGames, err := uc.GetGames(t.Context(), tt.args.req)
 			tt.wantErr(t, err)
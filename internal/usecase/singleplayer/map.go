@@ -0,0 +1,27 @@
+package singleplayer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+)
+
+// withMap returns the game request with provider of the map, if the user can play on it.
+func (uc Usecase) withMap(
+	ctx context.Context,
+	req dto.NewSingleplayerGameRequest,
+) (dto.NewSingleplayerGameRequest, error) {
+	m, err := uc.maps.GetPlayableMap(ctx, dto.GetMapRequest{
+		UserID: req.UserID,
+		MapID:  req.MapID,
+	})
+	if err != nil {
+		return dto.NewSingleplayerGameRequest{}, fmt.Errorf("failed to get map: %w", err)
+	}
+
+	req.Provider = string(m.Provider)
+	req.Providers = nil
+
+	return req, nil
+}
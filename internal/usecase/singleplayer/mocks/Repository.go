@@ -6,11 +6,15 @@ import (
 	context "context"
 
 	dto "github.com/VasySS/segoya-backend/internal/dto"
+	game "github.com/VasySS/segoya-backend/internal/entity/game"
+
 	gamesingleplayer "github.com/VasySS/segoya-backend/internal/entity/game/singleplayer"
 
 	mock "github.com/stretchr/testify/mock"
 
 	repository "github.com/VasySS/segoya-backend/internal/infrastructure/repository"
+
+	time "time"
 )
 
 // Repository is an autogenerated mock type for the Repository type
@@ -36,6 +40,213 @@ func (_m *Repository) EndSingleplayerGame(ctx context.Context, req dto.EndSingle
 	return r0
 }
 
+// GetDailyChallenge provides a mock function with given fields: ctx, date, provider
+func (_m *Repository) GetDailyChallenge(ctx context.Context, date time.Time, provider game.PanoramaProvider) (gamesingleplayer.DailyChallenge, error) {
+	ret := _m.Called(ctx, date, provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDailyChallenge")
+	}
+
+	var r0 gamesingleplayer.DailyChallenge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, game.PanoramaProvider) (gamesingleplayer.DailyChallenge, error)); ok {
+		return rf(ctx, date, provider)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, game.PanoramaProvider) gamesingleplayer.DailyChallenge); ok {
+		r0 = rf(ctx, date, provider)
+	} else {
+		r0 = ret.Get(0).(gamesingleplayer.DailyChallenge)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, game.PanoramaProvider) error); ok {
+		r1 = rf(ctx, date, provider)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDailyChallengeByID provides a mock function with given fields: ctx, id
+func (_m *Repository) GetDailyChallengeByID(ctx context.Context, id int) (gamesingleplayer.DailyChallenge, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDailyChallengeByID")
+	}
+
+	var r0 gamesingleplayer.DailyChallenge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (gamesingleplayer.DailyChallenge, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) gamesingleplayer.DailyChallenge); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(gamesingleplayer.DailyChallenge)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDailyChallengeLeaderboard provides a mock function with given fields: ctx, req
+func (_m *Repository) GetDailyChallengeLeaderboard(ctx context.Context, req dto.GetDailyChallengeLeaderboardRequestDB) ([]gamesingleplayer.DailyChallengeResult, int, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDailyChallengeLeaderboard")
+	}
+
+	var r0 []gamesingleplayer.DailyChallengeResult
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GetDailyChallengeLeaderboardRequestDB) ([]gamesingleplayer.DailyChallengeResult, int, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GetDailyChallengeLeaderboardRequestDB) []gamesingleplayer.DailyChallengeResult); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]gamesingleplayer.DailyChallengeResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.GetDailyChallengeLeaderboardRequestDB) int); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, dto.GetDailyChallengeLeaderboardRequestDB) error); ok {
+		r2 = rf(ctx, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetSingleplayerChallenge provides a mock function with given fields: ctx, token
+func (_m *Repository) GetSingleplayerChallenge(ctx context.Context, token string) (gamesingleplayer.Challenge, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSingleplayerChallenge")
+	}
+
+	var r0 gamesingleplayer.Challenge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (gamesingleplayer.Challenge, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) gamesingleplayer.Challenge); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(gamesingleplayer.Challenge)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSingleplayerChallengeByGameID provides a mock function with given fields: ctx, gameID
+func (_m *Repository) GetSingleplayerChallengeByGameID(ctx context.Context, gameID int) (gamesingleplayer.Challenge, error) {
+	ret := _m.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSingleplayerChallengeByGameID")
+	}
+
+	var r0 gamesingleplayer.Challenge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (gamesingleplayer.Challenge, error)); ok {
+		return rf(ctx, gameID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) gamesingleplayer.Challenge); ok {
+		r0 = rf(ctx, gameID)
+	} else {
+		r0 = ret.Get(0).(gamesingleplayer.Challenge)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSingleplayerChallengeByID provides a mock function with given fields: ctx, id
+func (_m *Repository) GetSingleplayerChallengeByID(ctx context.Context, id int) (gamesingleplayer.Challenge, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSingleplayerChallengeByID")
+	}
+
+	var r0 gamesingleplayer.Challenge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (gamesingleplayer.Challenge, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) gamesingleplayer.Challenge); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(gamesingleplayer.Challenge)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSingleplayerChallengeResults provides a mock function with given fields: ctx, challengeID
+func (_m *Repository) GetSingleplayerChallengeResults(ctx context.Context, challengeID int) ([]gamesingleplayer.ChallengeResult, error) {
+	ret := _m.Called(ctx, challengeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSingleplayerChallengeResults")
+	}
+
+	var r0 []gamesingleplayer.ChallengeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]gamesingleplayer.ChallengeResult, error)); ok {
+		return rf(ctx, challengeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []gamesingleplayer.ChallengeResult); ok {
+		r0 = rf(ctx, challengeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]gamesingleplayer.ChallengeResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, challengeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSingleplayerGame provides a mock function with given fields: ctx, gameID
 func (_m *Repository) GetSingleplayerGame(ctx context.Context, gameID int) (gamesingleplayer.Game, error) {
 	ret := _m.Called(ctx, gameID)
@@ -94,6 +305,36 @@ func (_m *Repository) GetSingleplayerGameGuesses(ctx context.Context, gameID int
 	return r0, r1
 }
 
+// GetSingleplayerGameLocationIDs provides a mock function with given fields: ctx, gameID
+func (_m *Repository) GetSingleplayerGameLocationIDs(ctx context.Context, gameID int) ([]int, error) {
+	ret := _m.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSingleplayerGameLocationIDs")
+	}
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]int, error)); ok {
+		return rf(ctx, gameID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []int); ok {
+		r0 = rf(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSingleplayerGames provides a mock function with given fields: ctx, req
 func (_m *Repository) GetSingleplayerGames(ctx context.Context, req dto.GetSingleplayerGamesRequest) ([]gamesingleplayer.Game, int, error) {
 	ret := _m.Called(ctx, req)
@@ -177,6 +418,52 @@ func (_m *Repository) LockSingleplayerGame(ctx context.Context, gameID int) erro
 	return r0
 }
 
+// NewDailyChallenge provides a mock function with given fields: ctx, req
+func (_m *Repository) NewDailyChallenge(ctx context.Context, req dto.NewDailyChallengeRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewDailyChallenge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.NewDailyChallengeRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewSingleplayerChallenge provides a mock function with given fields: ctx, req
+func (_m *Repository) NewSingleplayerChallenge(ctx context.Context, req dto.NewSingleplayerChallengeRequestDB) (int, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewSingleplayerChallenge")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.NewSingleplayerChallengeRequestDB) (int, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.NewSingleplayerChallengeRequestDB) int); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.NewSingleplayerChallengeRequestDB) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewSingleplayerGame provides a mock function with given fields: ctx, req
 func (_m *Repository) NewSingleplayerGame(ctx context.Context, req dto.NewSingleplayerGameRequest) (int, error) {
 	ret := _m.Called(ctx, req)
@@ -0,0 +1,67 @@
+package singleplayer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/singleplayer"
+)
+
+// nextPanorama returns a panorama for the next round of the game.
+// Daily challenge and challenge games replay their predefined locations,
+// other games get a random one (from the map, if the game is played on it), that was not played recently.
+func (uc Usecase) nextPanorama(ctx context.Context, g singleplayer.Game) (game.PanoramaMetadata, error) {
+	var (
+		locationIDs []int
+		provider    = g.Provider
+	)
+
+	switch {
+	case g.IsDaily():
+		challenge, err := uc.repo.GetDailyChallengeByID(ctx, g.DailyChallengeID)
+		if err != nil {
+			return game.PanoramaMetadata{}, fmt.Errorf("failed to get daily challenge: %w", err)
+		}
+
+		locationIDs = challenge.LocationIDs
+	case g.IsChallenge():
+		challenge, err := uc.repo.GetSingleplayerChallengeByID(ctx, g.ChallengeID)
+		if err != nil {
+			return game.PanoramaMetadata{}, fmt.Errorf("failed to get challenge: %w", err)
+		}
+
+		locationIDs = challenge.LocationIDs
+		// locations of mixed provider challenges belong to different providers
+		provider = challenge.LocationProvider(g.RoundCurrent)
+	default:
+		playedIDs, err := uc.repo.GetSingleplayerGameLocationIDs(ctx, g.ID)
+		if err != nil {
+			return game.PanoramaMetadata{}, fmt.Errorf("failed to get played locations: %w", err)
+		}
+
+		return uc.pano.NewStreetview(ctx, dto.NewStreetviewRequest{
+			Provider:          g.Provider,
+			Providers:         g.Providers,
+			MapID:             g.MapID,
+			UserIDs:           []int{g.UserID},
+			PlayedLocationIDs: playedIDs,
+		})
+	}
+
+	if g.RoundCurrent >= len(locationIDs) {
+		return game.PanoramaMetadata{}, singleplayer.ErrRoundMaxAmount
+	}
+
+	return uc.pano.GetStreetview(ctx, provider, locationIDs[g.RoundCurrent])
+}
+
+// scoreDistance returns score distance of a new game, derived from the size of its played area.
+func (uc Usecase) scoreDistance(ctx context.Context, req dto.NewSingleplayerGameRequest) (float64, error) {
+	return uc.pano.ScoreDistance(ctx, dto.ScoreDistanceRequest{
+		Provider:  game.PanoramaProvider(req.Provider),
+		Providers: req.Providers,
+		MapID:     req.MapID,
+	})
+}
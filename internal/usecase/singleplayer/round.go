@@ -47,7 +47,7 @@ func (uc Usecase) NewRound(
 			return fmt.Errorf("failed to get current round: %w", err)
 		}
 
-		pano, err := uc.pano.NewStreetview(ctx, game.Provider)
+		pano, err := uc.nextPanorama(ctx, game)
 		if err != nil {
 			return fmt.Errorf("failed to create panorama: %w", err)
 		}
@@ -55,6 +55,7 @@ func (uc Usecase) NewRound(
 		dbReq := dto.NewSingleplayerRoundDBRequest{
 			GameID:     game.ID,
 			LocationID: pano.ID,
+			Provider:   pano.Provider,
 			RoundNum:   game.RoundCurrent + 1,
 			CreatedAt:  req.RequestTime,
 			StartedAt:  req.RequestTime.Add(uc.cfg.RoundStartDelay),
@@ -148,23 +149,33 @@ func (uc Usecase) EndRound(
 			return singleplayer.ErrRoundAlreadyFinished
 		}
 
-		score, distance := uc.pano.CalculateScoreAndDistance(
-			game.Provider, round.Lat, round.Lng, req.Guess.Lat, req.Guess.Lng,
-		)
+		score := uc.pano.CalculateScore(dto.ScoreRequest{
+			Provider:      round.Provider,
+			Scoring:       game.Scoring,
+			ScoreDistance: game.ScoreDistance,
+			Location:      round.Location(),
+			Guess:         req.Guess,
+			StartedAt:     round.StartedAt,
+			GuessedAt:     req.RequestTime,
+			TimerSeconds:  game.TimerSeconds,
+		})
 
 		roundTimerEnd := round.StartedAt.Add(time.Second * time.Duration(game.TimerSeconds))
 		if game.TimerSeconds != 0 && req.RequestTime.After(roundTimerEnd) {
-			// if timer is enabled and round timer has ended, reset score
-			score = 0
+			// if timer is enabled and round timer has ended, the whole score is lost
+			score.Penalty = score.Base + score.Bonus
 		}
 
 		dbReq := dto.NewSingleplayerRoundGuessRequest{
-			RequestTime: req.RequestTime,
-			RoundID:     round.ID,
-			GameID:      req.GameID,
-			Guess:       req.Guess,
-			Score:       score,
-			Distance:    distance,
+			RequestTime:  req.RequestTime,
+			RoundID:      round.ID,
+			GameID:       req.GameID,
+			Guess:        req.Guess,
+			Score:        score.Total(),
+			ScoreBonus:   score.Bonus,
+			ScorePenalty: score.Penalty,
+			Distance:     score.Distance,
+			CountryCode:  uc.pano.Place(req.Guess).CountryCode,
 		}
 
 		if err := uc.repo.NewSingleplayerRoundGuess(ctx, dbReq); err != nil {
@@ -172,8 +183,11 @@ func (uc Usecase) EndRound(
 		}
 
 		response = dto.EndCurrentRoundResponse{
-			Score:    score,
-			Distance: distance,
+			Score:        score.Total(),
+			ScoreBonus:   score.Bonus,
+			ScorePenalty: score.Penalty,
+			Distance:     score.Distance,
+			Location:     round.Location(),
 		}
 
 		return nil
@@ -63,7 +63,13 @@ func TestUsecase_NewRound(t *testing.T) {
 						Finished:     false,
 					}, nil)
 
-				fs.pano.On("NewStreetview", mock.Anything, mock.Anything).
+				fs.repo.On("GetSingleplayerGameLocationIDs", mock.Anything, args.req.GameID).
+					Return([]int{1234}, nil)
+
+				fs.pano.On("NewStreetview", mock.Anything, dto.NewStreetviewRequest{
+					UserIDs:           []int{args.req.UserID},
+					PlayedLocationIDs: []int{1234},
+				}).
 					Return(game.PanoramaMetadata{}, nil)
 
 				fs.repo.On("GetSingleplayerRound", mock.Anything, args.req.GameID, 1).
@@ -155,7 +161,13 @@ func TestUsecase_NewRound(t *testing.T) {
 			fs := fields{repo: repo, pano: panoUsecase}
 			tt.setup(fs, tt.args)
 
-			uc := singleplayer.NewUsecase(singleplayer.Config{}, repo, panoUsecase)
+			uc := singleplayer.NewUsecase(
+				singleplayer.Config{},
+				mocks.NewRandomGenerator(t),
+				repo,
+				panoUsecase,
+				mocks.NewMapUsecase(t),
+			)
 
 			got, err := uc.NewRound(t.Context(), tt.args.req)
 			tt.wantErr(t, err)
@@ -267,7 +279,13 @@ func TestUsecase_GetRound(t *testing.T) {
 			fs := fields{repo: repo, pano: panoUsecase}
 			tt.setup(fs, tt.args)
 
-			uc := singleplayer.NewUsecase(singleplayer.Config{}, repo, panoUsecase)
+			uc := singleplayer.NewUsecase(
+				singleplayer.Config{},
+				mocks.NewRandomGenerator(t),
+				repo,
+				panoUsecase,
+				mocks.NewMapUsecase(t),
+			)
 
 			got, err := uc.GetRound(t.Context(), tt.args.req)
 			tt.wantErr(t, err)
@@ -318,18 +336,22 @@ func TestUsecase_EndRound(t *testing.T) {
 
 				fs.repo.On("GetSingleplayerGame", mock.Anything, args.req.GameID).
 					Return(singleplayerEntity.Game{
-						ID:           args.req.GameID,
-						UserID:       args.req.UserID,
-						Rounds:       5,
-						TimerSeconds: 60,
-						RoundCurrent: 2,
-						Finished:     false,
+						ID:            args.req.GameID,
+						UserID:        args.req.UserID,
+						Rounds:        5,
+						TimerSeconds:  60,
+						RoundCurrent:  2,
+						Provider:      game.MixedProvider,
+						ScoreDistance: 120,
+						Scoring:       game.TimedScoring,
+						Finished:      false,
 					}, nil)
 
 				fs.repo.On("GetSingleplayerRound", mock.Anything, args.req.GameID, 2).
 					Return(singleplayerEntity.Round{
 						ID:        1,
 						GameID:    args.req.GameID,
+						Provider:  game.YandexProvider,
 						Lat:       11.22,
 						Lng:       33.44,
 						Finished:  false,
@@ -337,23 +359,38 @@ func TestUsecase_EndRound(t *testing.T) {
 						StartedAt: args.req.RequestTime.Add(-59 * time.Second),
 					}, nil)
 
-				fs.pano.On("CalculateScoreAndDistance", mock.Anything,
-					11.22, 33.44, args.req.Guess.Lat, args.req.Guess.Lng).
-					Return(1234, 5678, nil)
+				// score of mixed provider game rounds depends on provider of the round
+				fs.pano.On("CalculateScore", dto.ScoreRequest{
+					Provider:      game.YandexProvider,
+					Scoring:       game.TimedScoring,
+					ScoreDistance: 120,
+					Location:      game.LatLng{Lat: 11.22, Lng: 33.44},
+					Guess:         args.req.Guess,
+					StartedAt:     args.req.RequestTime.Add(-59 * time.Second),
+					GuessedAt:     args.req.RequestTime,
+					TimerSeconds:  60,
+				}).
+					Return(game.Score{Base: 1234, Bonus: 16, Distance: 5678})
+				fs.pano.On("Place", args.req.Guess).
+					Return(game.Place{CountryCode: "CZ", RegionCode: "CZ-10"})
 
 				fs.repo.On("NewSingleplayerRoundGuess", mock.Anything, dto.NewSingleplayerRoundGuessRequest{
 					RequestTime: args.req.RequestTime,
 					RoundID:     1,
 					GameID:      args.req.GameID,
 					Guess:       args.req.Guess,
-					Score:       1234,
+					Score:       1250,
+					ScoreBonus:  16,
 					Distance:    5678,
+					CountryCode: "CZ",
 				}).
 					Return(nil)
 			},
 			want: dto.EndCurrentRoundResponse{
-				Score:    1234,
-				Distance: 5678,
+				Score:      1250,
+				ScoreBonus: 16,
+				Distance:   5678,
+				Location:   game.LatLng{Lat: 11.22, Lng: 33.44},
 			},
 			wantErr: assert.NoError,
 		},
@@ -445,20 +482,28 @@ func TestUsecase_EndRound(t *testing.T) {
 						StartedAt: args.req.RequestTime.Add(-61 * time.Second),
 					}, nil)
 
-				fs.pano.On("CalculateScoreAndDistance", mock.Anything,
-					11.22, 33.44, args.req.Guess.Lat, args.req.Guess.Lng).
-					Return(1234, 5678)
+				fs.pano.On("CalculateScore", mock.AnythingOfType("dto.ScoreRequest")).
+					Return(game.Score{Base: 1234, Distance: 5678})
+				fs.pano.On("Place", args.req.Guess).
+					Return(game.Place{})
 
+				// the whole score is lost as a penalty for a late guess
 				fs.repo.On("NewSingleplayerRoundGuess", mock.Anything, dto.NewSingleplayerRoundGuessRequest{
-					RequestTime: args.req.RequestTime,
-					RoundID:     2,
-					GameID:      args.req.GameID,
-					Guess:       args.req.Guess,
-					Score:       0,
-					Distance:    5678,
+					RequestTime:  args.req.RequestTime,
+					RoundID:      2,
+					GameID:       args.req.GameID,
+					Guess:        args.req.Guess,
+					Score:        0,
+					ScorePenalty: 1234,
+					Distance:     5678,
 				}).Return(nil)
 			},
-			want:    dto.EndCurrentRoundResponse{Score: 0, Distance: 5678},
+			want: dto.EndCurrentRoundResponse{
+				Score:        0,
+				ScorePenalty: 1234,
+				Distance:     5678,
+				Location:     game.LatLng{Lat: 11.22, Lng: 33.44},
+			},
 			wantErr: assert.NoError,
 		},
 	}
@@ -470,7 +515,13 @@ func TestUsecase_EndRound(t *testing.T) {
 			repo := mocks.NewRepository(t)
 			panoUsecase := mocks.NewPanoramaUsecase(t)
 			fs := fields{repo: repo, pano: panoUsecase}
-			uc := singleplayer.NewUsecase(singleplayer.Config{}, repo, panoUsecase)
+			uc := singleplayer.NewUsecase(
+				singleplayer.Config{},
+				mocks.NewRandomGenerator(t),
+				repo,
+				panoUsecase,
+				mocks.NewMapUsecase(t),
+			)
 
 			tt.setup(fs, tt.args)
 
@@ -606,7 +657,13 @@ func TestUsecase_GetGameRounds(t *testing.T) {
 			fs := fields{repo: repo, pano: panoUsecase}
 			tt.setup(fs, tt.args)
 
-			uc := singleplayer.NewUsecase(singleplayer.Config{}, repo, panoUsecase)
+			uc := singleplayer.NewUsecase(
+				singleplayer.Config{},
+				mocks.NewRandomGenerator(t),
+				repo,
+				panoUsecase,
+				mocks.NewMapUsecase(t),
+			)
 
 			got, err := uc.GetGameRounds(t.Context(), tt.args.req)
 			tt.wantErr(t, err)
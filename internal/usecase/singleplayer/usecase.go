@@ -4,9 +4,11 @@ package singleplayer
 
 import (
 	"context"
+	"time"
 
 	"github.com/VasySS/segoya-backend/internal/dto"
 	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/gamemap"
 	"github.com/VasySS/segoya-backend/internal/entity/game/singleplayer"
 	"github.com/VasySS/segoya-backend/internal/infrastructure/repository"
 	"go.opentelemetry.io/otel"
@@ -33,6 +35,31 @@ type RoundRepo interface {
 	NewSingleplayerRoundGuess(ctx context.Context, req dto.NewSingleplayerRoundGuessRequest) error
 }
 
+// DailyChallengeRepo defines methods for accessing and modifying daily challenge data.
+type DailyChallengeRepo interface {
+	NewDailyChallenge(ctx context.Context, req dto.NewDailyChallengeRequest) error
+	GetDailyChallenge(
+		ctx context.Context,
+		date time.Time,
+		provider game.PanoramaProvider,
+	) (singleplayer.DailyChallenge, error)
+	GetDailyChallengeByID(ctx context.Context, id int) (singleplayer.DailyChallenge, error)
+	GetDailyChallengeLeaderboard(
+		ctx context.Context,
+		req dto.GetDailyChallengeLeaderboardRequestDB,
+	) ([]singleplayer.DailyChallengeResult, int, error)
+}
+
+// ChallengeRepo defines methods for accessing and modifying challenges of singleplayer games.
+type ChallengeRepo interface {
+	GetSingleplayerGameLocationIDs(ctx context.Context, gameID int) ([]int, error)
+	NewSingleplayerChallenge(ctx context.Context, req dto.NewSingleplayerChallengeRequestDB) (int, error)
+	GetSingleplayerChallenge(ctx context.Context, token string) (singleplayer.Challenge, error)
+	GetSingleplayerChallengeByID(ctx context.Context, id int) (singleplayer.Challenge, error)
+	GetSingleplayerChallengeByGameID(ctx context.Context, gameID int) (singleplayer.Challenge, error)
+	GetSingleplayerChallengeResults(ctx context.Context, challengeID int) ([]singleplayer.ChallengeResult, error)
+}
+
 // Repository provides access to game and round data.
 //
 //go:generate go tool mockery --name=Repository
@@ -40,25 +67,48 @@ type Repository interface {
 	repository.TxManager
 	GameRepo
 	RoundRepo
+	DailyChallengeRepo
+	ChallengeRepo
 }
 
 // PanoramaUsecase defines methods for interacting with streetview panoramas and calculating scores.
 //
 //go:generate go tool mockery --name=PanoramaUsecase
 type PanoramaUsecase interface {
-	NewStreetview(ctx context.Context, provider game.PanoramaProvider) (game.PanoramaMetadata, error)
+	NewStreetview(ctx context.Context, req dto.NewStreetviewRequest) (game.PanoramaMetadata, error)
 	GetStreetview(ctx context.Context, provider game.PanoramaProvider, id int) (game.PanoramaMetadata, error)
-	CalculateScoreAndDistance(
+	DailyLocationIDs(
+		ctx context.Context,
+		date time.Time,
 		provider game.PanoramaProvider,
-		realLat, realLng, userLat, userLng float64,
-	) (score int, distance int)
+		count int,
+	) ([]int, error)
+	ScoreDistance(ctx context.Context, req dto.ScoreDistanceRequest) (float64, error)
+	CalculateScore(req dto.ScoreRequest) game.Score
+	Place(location game.LatLng) game.Place
+}
+
+// MapUsecase provides access to user-created maps.
+//
+//go:generate go tool mockery --name=MapUsecase
+type MapUsecase interface {
+	GetPlayableMap(ctx context.Context, req dto.GetMapRequest) (gamemap.Map, error)
+}
+
+// RandomGenerator provides cryptographically secure random string generation.
+//
+//go:generate go tool mockery --name=RandomGenerator
+type RandomGenerator interface {
+	NewRandomHexString(length int) string
 }
 
 // Usecase contains business logic for singleplayer game management.
 type Usecase struct {
 	cfg    Config
+	rnd    RandomGenerator
 	repo   Repository
 	pano   PanoramaUsecase
+	maps   MapUsecase
 	tracer trace.Tracer
 }
 
@@ -66,14 +116,26 @@ type Usecase struct {
 //
 // cfg - Configuration settings for the Usecase.
 //
+// rnd - Instance of RandomGenerator for generating challenge tokens.
+//
 // repo - Implementation of the Repository interface for accessing game and round data.
 //
 // pano - Implementation of the PanoramaUsecase interface for handling panoramas and score calculations.
-func NewUsecase(cfg Config, repo Repository, pano PanoramaUsecase) *Usecase {
+//
+// maps - Implementation of the MapUsecase interface for accessing user-created maps.
+func NewUsecase(
+	cfg Config,
+	rnd RandomGenerator,
+	repo Repository,
+	pano PanoramaUsecase,
+	maps MapUsecase,
+) *Usecase {
 	return &Usecase{
 		cfg:    cfg,
+		rnd:    rnd,
 		repo:   repo,
 		pano:   pano,
+		maps:   maps,
 		tracer: otel.GetTracerProvider().Tracer("SingleplayerUsecase"),
 	}
 }
@@ -0,0 +1,23 @@
+package streak
+
+import (
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/config"
+)
+
+// Config contains configuration for country streak usecase.
+type Config struct {
+	// Initial delay before round starts on frontend (to allow panorama to load).
+	RoundStartDelay time.Duration
+	// Amount of random panoramas, that are tried for a round until one inside of a known country is found.
+	CountryLocationAttempts int
+}
+
+// NewConfig returns a new local config from general config.
+func NewConfig(cfg config.Config) Config {
+	return Config{
+		RoundStartDelay:         cfg.Limits.RoundStartDelay,
+		CountryLocationAttempts: 10,
+	}
+}
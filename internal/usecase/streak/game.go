@@ -0,0 +1,61 @@
+package streak
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game/streak"
+)
+
+// NewGame initializes a new country streak game and starts the first round.
+func (uc Usecase) NewGame(ctx context.Context, req dto.NewStreakGameRequest) (int, error) {
+	ctx, span := uc.tracer.Start(ctx, "NewGame")
+	defer span.End()
+
+	var response int
+
+	err := uc.repo.RunTx(ctx, func(ctx context.Context) error {
+		id, err := uc.repo.NewStreakGame(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to create streak game: %w", err)
+		}
+
+		_, err = uc.NewRound(ctx, dto.NewStreakRoundRequest{
+			RequestTime: req.RequestTime,
+			GameID:      id,
+			UserID:      req.UserID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create round: %w", err)
+		}
+
+		response = id
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return 0, fmt.Errorf("failed to create game: %w", err)
+	}
+
+	return response, nil
+}
+
+// GetGame returns a country streak game by ID.
+func (uc Usecase) GetGame(ctx context.Context, req dto.GetStreakGameRequest) (streak.Game, error) {
+	ctx, span := uc.tracer.Start(ctx, "GetGame")
+	defer span.End()
+
+	game, err := uc.repo.GetStreakGame(ctx, req.GameID)
+	if err != nil {
+		span.RecordError(err)
+		return streak.Game{}, fmt.Errorf("failed to get streak game: %w", err)
+	}
+
+	if game.UserID != req.UserID {
+		return streak.Game{}, streak.ErrGameWrongUserID
+	}
+
+	return game, nil
+}
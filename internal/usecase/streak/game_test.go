@@ -0,0 +1,174 @@
+package streak_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	streakEntity "github.com/VasySS/segoya-backend/internal/entity/game/streak"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/repository"
+	"github.com/VasySS/segoya-backend/internal/usecase/streak"
+	"github.com/VasySS/segoya-backend/internal/usecase/streak/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUsecase_NewGame(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	createGameReq := dto.NewStreakGameRequest{
+		RequestTime:     now,
+		UserID:          1,
+		TimerSeconds:    60,
+		MovementAllowed: true,
+		Provider:        "google",
+	}
+
+	type fields struct {
+		repo *mocks.Repository
+		pano *mocks.PanoramaUsecase
+	}
+
+	type args struct {
+		req dto.NewStreakGameRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		want    int
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "successfully create game with the first round",
+			args: args{req: createGameReq},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("NewStreakGame", mock.Anything, args.req).
+					Return(123, nil)
+
+				fs.repo.On("LockStreakGame", mock.Anything, 123).
+					Return(nil)
+
+				fs.repo.On("GetStreakGame", mock.Anything, 123).
+					Return(streakEntity.Game{
+						ID:       123,
+						UserID:   args.req.UserID,
+						Provider: args.req.Provider,
+					}, nil)
+
+				fs.repo.On("GetStreakRound", mock.Anything, 123, 0).
+					Return(streakEntity.Round{}, streakEntity.ErrRoundNotFound)
+
+				fs.repo.On("GetStreakGameLocationIDs", mock.Anything, 123).
+					Return(nil, nil)
+
+				pano := game.PanoramaMetadata{
+					ID:          5,
+					LatLng:      game.LatLng{Lat: 50, Lng: 14},
+					Provider:    "google",
+					CountryCode: "CZ",
+				}
+
+				fs.pano.On("NewStreetview", mock.Anything, dto.NewStreetviewRequest{
+					Provider: args.req.Provider,
+					UserIDs:  []int{args.req.UserID},
+				}).
+					Return(pano, nil)
+
+				fs.repo.On("NewStreakRound", mock.Anything, mock.MatchedBy(func(req dto.NewStreakRoundDBRequest) bool {
+					return req.GameID == 123 && req.RoundNum == 1 && req.CountryCode == "CZ"
+				})).
+					Return(streakEntity.Round{ID: 1, GameID: 123, RoundNum: 1}, nil)
+			},
+			want:    123,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error creating game",
+			args: args{req: createGameReq},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("NewStreakGame", mock.Anything, args.req).
+					Return(0, errors.New("db error"))
+			},
+			want:    0,
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			panoUsecase := mocks.NewPanoramaUsecase(t)
+			fs := fields{repo: repo, pano: panoUsecase}
+			tt.setup(fs, tt.args)
+
+			uc := streak.NewUsecase(streak.Config{CountryLocationAttempts: 1}, repo, panoUsecase)
+
+			got, err := uc.NewGame(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUsecase_GetGame(t *testing.T) {
+	t.Parallel()
+
+	req := dto.GetStreakGameRequest{
+		RequestTime: time.Now().UTC(),
+		GameID:      123,
+		UserID:      1,
+	}
+
+	tests := []struct {
+		name    string
+		game    streakEntity.Game
+		want    streakEntity.Game
+		wantErr error
+	}{
+		{
+			name: "game of user",
+			game: streakEntity.Game{ID: req.GameID, UserID: req.UserID, Streak: 4},
+			want: streakEntity.Game{ID: req.GameID, UserID: req.UserID, Streak: 4},
+		},
+		{
+			name:    "game of another user",
+			game:    streakEntity.Game{ID: req.GameID, UserID: 2},
+			want:    streakEntity.Game{},
+			wantErr: streakEntity.ErrGameWrongUserID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			repo.On("GetStreakGame", mock.Anything, req.GameID).Return(tt.game, nil)
+
+			uc := streak.NewUsecase(streak.Config{}, repo, mocks.NewPanoramaUsecase(t))
+
+			got, err := uc.GetGame(t.Context(), req)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
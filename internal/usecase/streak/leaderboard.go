@@ -0,0 +1,40 @@
+package streak
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game/streak"
+)
+
+// GetBestStreak returns the longest streak among finished games of the user.
+func (uc Usecase) GetBestStreak(ctx context.Context, userID int) (streak.BestStreak, error) {
+	ctx, span := uc.tracer.Start(ctx, "GetBestStreak")
+	defer span.End()
+
+	best, err := uc.repo.GetBestStreak(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return streak.BestStreak{}, fmt.Errorf("failed to get best streak: %w", err)
+	}
+
+	return best, nil
+}
+
+// GetLeaderboard returns best streaks of users, ranked by streak.
+func (uc Usecase) GetLeaderboard(
+	ctx context.Context,
+	req dto.GetStreakLeaderboardRequest,
+) ([]streak.LeaderboardResult, int, error) {
+	ctx, span := uc.tracer.Start(ctx, "GetLeaderboard")
+	defer span.End()
+
+	results, resultsTotal, err := uc.repo.GetStreakLeaderboard(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		return nil, 0, fmt.Errorf("failed to get streak leaderboard: %w", err)
+	}
+
+	return results, resultsTotal, nil
+}
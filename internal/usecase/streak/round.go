@@ -0,0 +1,221 @@
+package streak
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/streak"
+)
+
+// NewRound creates a new country streak round or returns an existing one if it's not finished.
+func (uc Usecase) NewRound(ctx context.Context, req dto.NewStreakRoundRequest) (streak.Round, error) {
+	ctx, span := uc.tracer.Start(ctx, "NewRound")
+	defer span.End()
+
+	var response streak.Round
+
+	err := uc.repo.RunTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.LockStreakGame(ctx, req.GameID); err != nil {
+			return fmt.Errorf("failed to lock game: %w", err)
+		}
+
+		g, err := uc.repo.GetStreakGame(ctx, req.GameID)
+		if err != nil {
+			return fmt.Errorf("failed to get streak game: %w", err)
+		}
+
+		if g.UserID != req.UserID {
+			return streak.ErrGameWrongUserID
+		}
+
+		if g.Finished {
+			return streak.ErrGameFinished
+		}
+
+		if existingRound, err := uc.repo.GetStreakRound(ctx, g.ID, g.RoundCurrent); err == nil {
+			if !existingRound.Finished {
+				response = existingRound
+				return nil
+			}
+		} else if !errors.Is(err, streak.ErrRoundNotFound) {
+			return fmt.Errorf("failed to get current round: %w", err)
+		}
+
+		pano, err := uc.nextPanorama(ctx, g)
+		if err != nil {
+			return fmt.Errorf("failed to create panorama: %w", err)
+		}
+
+		round, err := uc.repo.NewStreakRound(ctx, dto.NewStreakRoundDBRequest{
+			CreatedAt:   req.RequestTime,
+			StartedAt:   req.RequestTime.Add(uc.cfg.RoundStartDelay),
+			GameID:      g.ID,
+			LocationID:  pano.ID,
+			Provider:    pano.Provider,
+			RoundNum:    g.RoundCurrent + 1,
+			CountryCode: pano.CountryCode,
+		})
+		if err != nil {
+			return fmt.Errorf("error creating streak round: %w", err)
+		}
+
+		response = round
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return streak.Round{}, fmt.Errorf("failed to create new round: %w", err)
+	}
+
+	return response, nil
+}
+
+// GetRound returns current country streak round by game ID.
+func (uc Usecase) GetRound(ctx context.Context, req dto.GetStreakRoundRequest) (streak.Round, error) {
+	ctx, span := uc.tracer.Start(ctx, "GetRound")
+	defer span.End()
+
+	var response streak.Round
+
+	err := uc.repo.RunTx(ctx, func(ctx context.Context) error {
+		g, err := uc.repo.GetStreakGame(ctx, req.GameID)
+		if err != nil {
+			return fmt.Errorf("failed to get streak game: %w", err)
+		}
+
+		if g.UserID != req.UserID {
+			return streak.ErrGameWrongUserID
+		}
+
+		round, err := uc.repo.GetStreakRound(ctx, g.ID, g.RoundCurrent)
+		if err != nil {
+			return fmt.Errorf("failed to get round: %w", err)
+		}
+
+		response = round
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return streak.Round{}, fmt.Errorf("failed to get round: %w", err)
+	}
+
+	return response, nil
+}
+
+// EndRound checks a guessed country of the current round. A correct guess increases the streak,
+// a wrong one (or a guess made after the round timer has ended) finishes the game.
+func (uc Usecase) EndRound(ctx context.Context, req dto.EndStreakRoundRequest) (dto.EndStreakRoundResponse, error) {
+	ctx, span := uc.tracer.Start(ctx, "EndRound")
+	defer span.End()
+
+	guessCountry := req.CountryCode
+	if guessCountry == "" {
+		if req.Guess == nil {
+			return dto.EndStreakRoundResponse{}, streak.ErrEmptyGuess
+		}
+
+		guessCountry = uc.pano.Place(*req.Guess).CountryCode
+	}
+
+	var response dto.EndStreakRoundResponse
+
+	err := uc.repo.RunTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.LockStreakGame(ctx, req.GameID); err != nil {
+			return fmt.Errorf("failed to lock game: %w", err)
+		}
+
+		g, err := uc.repo.GetStreakGame(ctx, req.GameID)
+		if err != nil {
+			return fmt.Errorf("failed to get streak game: %w", err)
+		}
+
+		if g.UserID != req.UserID {
+			return streak.ErrGameWrongUserID
+		}
+
+		round, err := uc.repo.GetStreakRound(ctx, g.ID, g.RoundCurrent)
+		if err != nil {
+			return fmt.Errorf("failed to get current round from db: %w", err)
+		}
+
+		if round.Finished {
+			return streak.ErrRoundAlreadyFinished
+		}
+
+		correct := round.IsCorrect(guessCountry)
+
+		roundTimerEnd := round.StartedAt.Add(time.Second * time.Duration(g.TimerSeconds))
+		if g.TimerSeconds != 0 && req.RequestTime.After(roundTimerEnd) {
+			// if timer is enabled and round timer has ended, the round is lost
+			correct = false
+		}
+
+		if err := uc.repo.NewStreakRoundGuess(ctx, dto.NewStreakRoundGuessRequest{
+			RequestTime: req.RequestTime,
+			RoundID:     round.ID,
+			GameID:      g.ID,
+			CountryCode: guessCountry,
+			Correct:     correct,
+		}); err != nil {
+			return fmt.Errorf("failed to set user guess in db: %w", err)
+		}
+
+		response = dto.EndStreakRoundResponse{
+			Correct:      correct,
+			Country:      round.CountryCode,
+			GuessCountry: guessCountry,
+			Streak:       g.Streak,
+			Finished:     !correct,
+			Location:     round.Location(),
+		}
+
+		if correct {
+			response.Streak++
+		}
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return dto.EndStreakRoundResponse{}, fmt.Errorf("failed to end round: %w", err)
+	}
+
+	return response, nil
+}
+
+// nextPanorama returns a random panorama for the next round of the game, that was not played in it yet.
+// Country of the panorama is saved with its location, panoramas outside of known countries
+// (e.g. on ships or in disputed areas) can't be guessed, so they are skipped.
+func (uc Usecase) nextPanorama(ctx context.Context, g streak.Game) (game.PanoramaMetadata, error) {
+	playedIDs, err := uc.repo.GetStreakGameLocationIDs(ctx, g.ID)
+	if err != nil {
+		return game.PanoramaMetadata{}, fmt.Errorf("failed to get played locations: %w", err)
+	}
+
+	for range uc.cfg.CountryLocationAttempts {
+		pano, err := uc.pano.NewStreetview(ctx, dto.NewStreetviewRequest{
+			Provider:          g.Provider,
+			Providers:         g.Providers,
+			UserIDs:           []int{g.UserID},
+			PlayedLocationIDs: playedIDs,
+		})
+		if err != nil {
+			return game.PanoramaMetadata{}, fmt.Errorf("failed to get streetview: %w", err)
+		}
+
+		if pano.CountryCode != "" {
+			return pano, nil
+		}
+
+		playedIDs = append(playedIDs, pano.ID)
+	}
+
+	return game.PanoramaMetadata{}, streak.ErrNoCountryLocation
+}
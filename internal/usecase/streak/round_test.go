@@ -0,0 +1,425 @@
+package streak_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	streakEntity "github.com/VasySS/segoya-backend/internal/entity/game/streak"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/repository"
+	"github.com/VasySS/segoya-backend/internal/usecase/streak"
+	"github.com/VasySS/segoya-backend/internal/usecase/streak/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUsecase_NewRound(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	newRoundReq := dto.NewStreakRoundRequest{
+		RequestTime: now,
+		GameID:      123,
+		UserID:      456,
+	}
+
+	activeGame := streakEntity.Game{
+		ID:           newRoundReq.GameID,
+		UserID:       newRoundReq.UserID,
+		RoundCurrent: 1,
+		Provider:     "google",
+		Streak:       1,
+	}
+
+	ocean := game.PanoramaMetadata{ID: 10, LatLng: game.LatLng{Lat: 0, Lng: -30}, Provider: "google"}
+	land := game.PanoramaMetadata{ID: 11, LatLng: game.LatLng{Lat: 50, Lng: 14}, Provider: "google", CountryCode: "CZ"}
+
+	type fields struct {
+		repo *mocks.Repository
+		pano *mocks.PanoramaUsecase
+	}
+
+	type args struct {
+		req dto.NewStreakRoundRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		want    streakEntity.Round
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "panorama outside of countries is skipped",
+			args: args{req: newRoundReq},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockStreakGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetStreakGame", mock.Anything, args.req.GameID).
+					Return(activeGame, nil)
+
+				fs.repo.On("GetStreakRound", mock.Anything, args.req.GameID, 1).
+					Return(streakEntity.Round{ID: 1, RoundNum: 1, Finished: true}, nil)
+
+				fs.repo.On("GetStreakGameLocationIDs", mock.Anything, args.req.GameID).
+					Return([]int{1234}, nil)
+
+				fs.pano.On("NewStreetview", mock.Anything, dto.NewStreetviewRequest{
+					Provider:          "google",
+					UserIDs:           []int{args.req.UserID},
+					PlayedLocationIDs: []int{1234},
+				}).
+					Return(ocean, nil).Once()
+
+				fs.pano.On("NewStreetview", mock.Anything, dto.NewStreetviewRequest{
+					Provider:          "google",
+					UserIDs:           []int{args.req.UserID},
+					PlayedLocationIDs: []int{1234, ocean.ID},
+				}).
+					Return(land, nil).Once()
+
+				fs.repo.On("NewStreakRound", mock.Anything, dto.NewStreakRoundDBRequest{
+					CreatedAt:   args.req.RequestTime,
+					StartedAt:   args.req.RequestTime.Add(time.Second),
+					GameID:      args.req.GameID,
+					LocationID:  land.ID,
+					Provider:    land.Provider,
+					RoundNum:    2,
+					CountryCode: "CZ",
+				}).
+					Return(streakEntity.Round{ID: 2, RoundNum: 2, CountryCode: "CZ"}, nil)
+			},
+			want:    streakEntity.Round{ID: 2, RoundNum: 2, CountryCode: "CZ"},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "unfinished round is returned",
+			args: args{req: newRoundReq},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockStreakGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetStreakGame", mock.Anything, args.req.GameID).
+					Return(activeGame, nil)
+
+				fs.repo.On("GetStreakRound", mock.Anything, args.req.GameID, 1).
+					Return(streakEntity.Round{ID: 1, RoundNum: 1}, nil)
+			},
+			want:    streakEntity.Round{ID: 1, RoundNum: 1},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "no panoramas inside of countries",
+			args: args{req: newRoundReq},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockStreakGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				fs.repo.On("GetStreakGame", mock.Anything, args.req.GameID).
+					Return(activeGame, nil)
+
+				fs.repo.On("GetStreakRound", mock.Anything, args.req.GameID, 1).
+					Return(streakEntity.Round{ID: 1, RoundNum: 1, Finished: true}, nil)
+
+				fs.repo.On("GetStreakGameLocationIDs", mock.Anything, args.req.GameID).
+					Return(nil, nil)
+
+				fs.pano.On("NewStreetview", mock.Anything, mock.Anything).
+					Return(ocean, nil).Times(2)
+			},
+			want: streakEntity.Round{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, streakEntity.ErrNoCountryLocation)
+			},
+		},
+		{
+			name: "game is finished",
+			args: args{req: newRoundReq},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockStreakGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				finishedGame := activeGame
+				finishedGame.Finished = true
+
+				fs.repo.On("GetStreakGame", mock.Anything, args.req.GameID).
+					Return(finishedGame, nil)
+			},
+			want: streakEntity.Round{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, streakEntity.ErrGameFinished)
+			},
+		},
+		{
+			name: "game of another user",
+			args: args{req: newRoundReq},
+			setup: func(fs fields, args args) {
+				fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+					Return(func(ctx context.Context, fn repository.TxFunc) error {
+						return fn(ctx)
+					})
+
+				fs.repo.On("LockStreakGame", mock.Anything, args.req.GameID).
+					Return(nil)
+
+				otherGame := activeGame
+				otherGame.UserID = 777
+
+				fs.repo.On("GetStreakGame", mock.Anything, args.req.GameID).
+					Return(otherGame, nil)
+			},
+			want: streakEntity.Round{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, streakEntity.ErrGameWrongUserID)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			panoUsecase := mocks.NewPanoramaUsecase(t)
+			fs := fields{repo: repo, pano: panoUsecase}
+			tt.setup(fs, tt.args)
+
+			uc := streak.NewUsecase(
+				streak.Config{RoundStartDelay: time.Second, CountryLocationAttempts: 2},
+				repo,
+				panoUsecase,
+			)
+
+			got, err := uc.NewRound(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUsecase_EndRound(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	clicked := game.LatLng{Lat: 48, Lng: 16}
+
+	activeGame := streakEntity.Game{
+		ID:           123,
+		UserID:       456,
+		RoundCurrent: 3,
+		TimerSeconds: 60,
+		Streak:       2,
+	}
+
+	currentRound := streakEntity.Round{
+		ID:          3,
+		GameID:      activeGame.ID,
+		Lat:         50,
+		Lng:         14,
+		CountryCode: "CZ",
+		RoundNum:    3,
+		StartedAt:   now.Add(-time.Second * 30),
+	}
+
+	type fields struct {
+		repo *mocks.Repository
+		pano *mocks.PanoramaUsecase
+	}
+
+	type args struct {
+		req dto.EndStreakRoundRequest
+	}
+
+	// expectRound sets up locking and getting of the game and its current round.
+	expectRound := func(fs fields, round streakEntity.Round) {
+		fs.repo.On("RunTx", mock.Anything, mock.AnythingOfType("repository.TxFunc")).
+			Return(func(ctx context.Context, fn repository.TxFunc) error {
+				return fn(ctx)
+			})
+
+		fs.repo.On("LockStreakGame", mock.Anything, activeGame.ID).
+			Return(nil)
+
+		fs.repo.On("GetStreakGame", mock.Anything, activeGame.ID).
+			Return(activeGame, nil)
+
+		fs.repo.On("GetStreakRound", mock.Anything, activeGame.ID, activeGame.RoundCurrent).
+			Return(round, nil)
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(fields, args)
+		want    dto.EndStreakRoundResponse
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "correct named country increases streak",
+			args: args{req: dto.EndStreakRoundRequest{
+				RequestTime: now,
+				GameID:      activeGame.ID,
+				UserID:      activeGame.UserID,
+				CountryCode: "CZ",
+				Guess:       &clicked,
+			}},
+			setup: func(fs fields, args args) {
+				expectRound(fs, currentRound)
+
+				fs.repo.On("NewStreakRoundGuess", mock.Anything, dto.NewStreakRoundGuessRequest{
+					RequestTime: args.req.RequestTime,
+					RoundID:     currentRound.ID,
+					GameID:      activeGame.ID,
+					CountryCode: "CZ",
+					Correct:     true,
+				}).
+					Return(nil)
+			},
+			want: dto.EndStreakRoundResponse{
+				Correct:      true,
+				Country:      "CZ",
+				GuessCountry: "CZ",
+				Streak:       3,
+				Finished:     false,
+				Location:     currentRound.Location(),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "wrong clicked country finishes game",
+			args: args{req: dto.EndStreakRoundRequest{
+				RequestTime: now,
+				GameID:      activeGame.ID,
+				UserID:      activeGame.UserID,
+				Guess:       &clicked,
+			}},
+			setup: func(fs fields, args args) {
+				fs.pano.On("Place", clicked).Return(game.Place{CountryCode: "AT"})
+
+				expectRound(fs, currentRound)
+
+				fs.repo.On("NewStreakRoundGuess", mock.Anything, dto.NewStreakRoundGuessRequest{
+					RequestTime: args.req.RequestTime,
+					RoundID:     currentRound.ID,
+					GameID:      activeGame.ID,
+					CountryCode: "AT",
+					Correct:     false,
+				}).
+					Return(nil)
+			},
+			want: dto.EndStreakRoundResponse{
+				Correct:      false,
+				Country:      "CZ",
+				GuessCountry: "AT",
+				Streak:       2,
+				Finished:     true,
+				Location:     currentRound.Location(),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "correct country after round timer has ended",
+			args: args{req: dto.EndStreakRoundRequest{
+				RequestTime: now.Add(time.Minute),
+				GameID:      activeGame.ID,
+				UserID:      activeGame.UserID,
+				CountryCode: "CZ",
+			}},
+			setup: func(fs fields, args args) {
+				expectRound(fs, currentRound)
+
+				fs.repo.On("NewStreakRoundGuess", mock.Anything, dto.NewStreakRoundGuessRequest{
+					RequestTime: args.req.RequestTime,
+					RoundID:     currentRound.ID,
+					GameID:      activeGame.ID,
+					CountryCode: "CZ",
+					Correct:     false,
+				}).
+					Return(nil)
+			},
+			want: dto.EndStreakRoundResponse{
+				Correct:      false,
+				Country:      "CZ",
+				GuessCountry: "CZ",
+				Streak:       2,
+				Finished:     true,
+				Location:     currentRound.Location(),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "round is already finished",
+			args: args{req: dto.EndStreakRoundRequest{
+				RequestTime: now,
+				GameID:      activeGame.ID,
+				UserID:      activeGame.UserID,
+				CountryCode: "CZ",
+			}},
+			setup: func(fs fields, _ args) {
+				finishedRound := currentRound
+				finishedRound.Finished = true
+
+				expectRound(fs, finishedRound)
+			},
+			want: dto.EndStreakRoundResponse{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, streakEntity.ErrRoundAlreadyFinished)
+			},
+		},
+		{
+			name: "empty guess",
+			args: args{req: dto.EndStreakRoundRequest{
+				RequestTime: now,
+				GameID:      activeGame.ID,
+				UserID:      activeGame.UserID,
+			}},
+			setup: func(_ fields, _ args) {},
+			want:  dto.EndStreakRoundResponse{},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, streakEntity.ErrEmptyGuess)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepository(t)
+			panoUsecase := mocks.NewPanoramaUsecase(t)
+			fs := fields{repo: repo, pano: panoUsecase}
+			tt.setup(fs, tt.args)
+
+			uc := streak.NewUsecase(streak.Config{}, repo, panoUsecase)
+
+			got, err := uc.EndRound(t.Context(), tt.args.req)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
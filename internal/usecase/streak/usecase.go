@@ -0,0 +1,80 @@
+// Package streak manages country streak game sessions, where players guess countries of panoramas
+// until the first miss, and their best streaks.
+package streak
+
+import (
+	"context"
+
+	"github.com/VasySS/segoya-backend/internal/dto"
+	"github.com/VasySS/segoya-backend/internal/entity/game"
+	"github.com/VasySS/segoya-backend/internal/entity/game/streak"
+	"github.com/VasySS/segoya-backend/internal/infrastructure/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GameRepo defines methods for accessing and modifying country streak game data.
+type GameRepo interface {
+	LockStreakGame(ctx context.Context, gameID int) error
+	NewStreakGame(ctx context.Context, req dto.NewStreakGameRequest) (int, error)
+	GetStreakGame(ctx context.Context, gameID int) (streak.Game, error)
+	GetStreakGameLocationIDs(ctx context.Context, gameID int) ([]int, error)
+}
+
+// RoundRepo defines methods for accessing and modifying country streak round data.
+type RoundRepo interface {
+	NewStreakRound(ctx context.Context, req dto.NewStreakRoundDBRequest) (streak.Round, error)
+	GetStreakRound(ctx context.Context, gameID, roundNum int) (streak.Round, error)
+	NewStreakRoundGuess(ctx context.Context, req dto.NewStreakRoundGuessRequest) error
+}
+
+// LeaderboardRepo defines methods for accessing best streaks of users.
+type LeaderboardRepo interface {
+	GetBestStreak(ctx context.Context, userID int) (streak.BestStreak, error)
+	GetStreakLeaderboard(
+		ctx context.Context,
+		req dto.GetStreakLeaderboardRequest,
+	) ([]streak.LeaderboardResult, int, error)
+}
+
+// Repository provides access to country streak game data.
+//
+//go:generate go tool mockery --name=Repository
+type Repository interface {
+	repository.TxManager
+	GameRepo
+	RoundRepo
+	LeaderboardRepo
+}
+
+// PanoramaUsecase defines methods for choosing streetview panoramas and resolving their countries.
+//
+//go:generate go tool mockery --name=PanoramaUsecase
+type PanoramaUsecase interface {
+	NewStreetview(ctx context.Context, req dto.NewStreetviewRequest) (game.PanoramaMetadata, error)
+	Place(location game.LatLng) game.Place
+}
+
+// Usecase contains business logic for country streak game management.
+type Usecase struct {
+	cfg    Config
+	repo   Repository
+	pano   PanoramaUsecase
+	tracer trace.Tracer
+}
+
+// NewUsecase creates and returns a new Usecase instance with the provided dependencies.
+//
+// cfg - Configuration settings for the Usecase.
+//
+// repo - Implementation of the Repository interface for accessing game and round data.
+//
+// pano - Implementation of the PanoramaUsecase interface for choosing panoramas and resolving their countries.
+func NewUsecase(cfg Config, repo Repository, pano PanoramaUsecase) *Usecase {
+	return &Usecase{
+		cfg:    cfg,
+		repo:   repo,
+		pano:   pano,
+		tracer: otel.GetTracerProvider().Tracer("StreakUsecase"),
+	}
+}
@@ -4,5 +4,5 @@ CREATE TEMP TABLE google_temp (
     pano_id TEXT,
     lat NUMERIC,
     lng NUMERIC,
-    provider panorama_provider
+    provider VARCHAR
 );
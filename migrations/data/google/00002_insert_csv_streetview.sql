@@ -1,4 +1,4 @@
 
-INSERT INTO panorama_location (lat, lng, provider)
-SELECT lat, lng, provider
+INSERT INTO panorama_location (streetview_id, lat, lng, provider)
+SELECT NULLIF(pano_id, ''), lat, lng, provider
 FROM google_temp;
@@ -1,4 +1,5 @@
-// Package data contains logic to run data migrations on Postgres (panorama locations from CSV).
+// Package data contains logic to run data migrations on Postgres
+// (panorama locations from CSV with their bounds and countries).
 package data
 
 import (
@@ -74,6 +75,10 @@ func MigrateAllCSVData(ctx context.Context, pool *pgxpool.Pool) error {
 		slog.Info("data migration completed", slog.String("name", migration.CreateTableSQLPath))
 	}
 
+	if err := refreshProviderBounds(ctx, tx); err != nil {
+		return err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
@@ -125,6 +130,30 @@ func processDataMigration(
 	return nil
 }
 
+// refreshProviderBounds recalculates bounding boxes of (not imported) locations of every provider,
+// so that they don't have to be calculated for every new game.
+func refreshProviderBounds(ctx context.Context, conn pgx.Tx) error {
+	query := `
+		INSERT INTO panorama_provider_bounds (provider, min_lat, min_lng, max_lat, max_lng)
+		SELECT provider, MIN(lat), MIN(lng), MAX(lat), MAX(lng)
+		FROM panorama_location
+		WHERE NOT imported
+		GROUP BY provider
+		ON CONFLICT (provider) DO UPDATE
+		SET
+			min_lat = EXCLUDED.min_lat,
+			min_lng = EXCLUDED.min_lng,
+			max_lat = EXCLUDED.max_lat,
+			max_lng = EXCLUDED.max_lng
+	`
+
+	if _, err := conn.Exec(ctx, query); err != nil {
+		return fmt.Errorf("failed to refresh provider bounds: %w", err)
+	}
+
+	return nil
+}
+
 func parseCSVRows(migration Migration) ([][]any, []string, error) {
 	// Read embedded CSV data
 	csvFile, err := dataFiles.Open(migration.CSVPath)
@@ -0,0 +1,137 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/VasySS/segoya-backend/internal/infrastructure/geocoder"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// placesBatchSize is the amount of rows, which places are resolved and updated at once.
+const placesBatchSize = 10000
+
+// placesTable is a table with coordinates, which country (and region) codes are backfilled.
+type placesTable struct {
+	Name string
+	// WithRegion is true if the table has a region code column.
+	WithRegion bool
+}
+
+//nolint:gochecknoglobals
+var placesTables = []placesTable{
+	{Name: "panorama_location", WithRegion: true},
+	{Name: "singleplayer_round_guess"},
+	{Name: "multiplayer_round_user"},
+}
+
+type placeRow struct {
+	ID  int
+	Lat float64
+	Lng float64
+}
+
+// BackfillPlaces resolves countries and regions of panorama locations and guesses, which don't have them yet
+// (e.g. added from CSV or saved before reverse geocoding was introduced), using the boundaries dataset file.
+// Rows outside of known countries stay without codes, so they are checked again on the next run.
+func BackfillPlaces(ctx context.Context, pool *pgxpool.Pool, datasetPath string) error {
+	g, err := geocoder.Open(datasetPath)
+	if err != nil {
+		return fmt.Errorf("failed to load geocoder: %w", err)
+	}
+
+	for _, table := range placesTables {
+		slog.Info("backfilling places", slog.String("table", table.Name))
+
+		updated, err := backfillTablePlaces(ctx, pool, g, table)
+		if err != nil {
+			return err
+		}
+
+		slog.Info("places backfilled", slog.String("table", table.Name), slog.Int("updated", updated))
+	}
+
+	return nil
+}
+
+// backfillTablePlaces updates rows of the table in batches ordered by ID, returns the amount of updated rows.
+func backfillTablePlaces(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	g *geocoder.Geocoder,
+	table placesTable,
+) (int, error) {
+	//nolint:gosec // table names are constants
+	selectQuery := fmt.Sprintf(`
+		SELECT id, lat, lng
+		FROM %s
+		WHERE country_code IS NULL AND id > @last_id
+		ORDER BY id
+		LIMIT @limit
+	`, table.Name)
+
+	regionSet := ""
+	if table.WithRegion {
+		regionSet = ", region_code = NULLIF(p.region_code, '')"
+	}
+
+	//nolint:gosec // table names are constants
+	updateQuery := fmt.Sprintf(`
+		UPDATE %s AS t
+		SET country_code = p.country_code%s
+		FROM UNNEST(@ids::BIGINT[], @country_codes::VARCHAR[], @region_codes::VARCHAR[])
+			AS p(id, country_code, region_code)
+		WHERE t.id = p.id
+	`, table.Name, regionSet)
+
+	var (
+		lastID  int
+		updated int
+	)
+
+	for {
+		rows, err := pool.Query(ctx, selectQuery, pgx.NamedArgs{"last_id": lastID, "limit": placesBatchSize})
+		if err != nil {
+			return 0, fmt.Errorf("failed to select %s rows: %w", table.Name, err)
+		}
+
+		batch, err := pgx.CollectRows(rows, pgx.RowToStructByPos[placeRow])
+		if err != nil {
+			return 0, fmt.Errorf("failed to collect %s rows: %w", table.Name, err)
+		}
+
+		if len(batch) == 0 {
+			return updated, nil
+		}
+
+		lastID = batch[len(batch)-1].ID
+
+		ids := make([]int, 0, len(batch))
+		countryCodes := make([]string, 0, len(batch))
+		regionCodes := make([]string, 0, len(batch))
+
+		for _, row := range batch {
+			place := g.Place(row.Lat, row.Lng)
+			if place.CountryCode == "" {
+				continue
+			}
+
+			ids = append(ids, row.ID)
+			countryCodes = append(countryCodes, place.CountryCode)
+			regionCodes = append(regionCodes, place.RegionCode)
+		}
+
+		tag, err := pool.Exec(ctx, updateQuery, pgx.NamedArgs{
+			"ids":           ids,
+			"country_codes": countryCodes,
+			"region_codes":  regionCodes,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to update %s places: %w", table.Name, err)
+		}
+
+		updated += int(tag.RowsAffected())
+	}
+}
@@ -3,5 +3,5 @@ CREATE TEMP TABLE seznam_temp (
     id BIGINT,
     lat NUMERIC,
     lng NUMERIC,
-    provider panorama_provider
+    provider VARCHAR
 );
@@ -3,5 +3,5 @@ CREATE TEMP TABLE yandex_streetview_temp (
     id BIGINT,
     lat NUMERIC,
     lng NUMERIC,
-    provider panorama_provider
+    provider VARCHAR
 );
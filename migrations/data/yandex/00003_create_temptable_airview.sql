@@ -4,5 +4,5 @@ CREATE TEMP TABLE yandex_airview_temp (
     ya_id TEXT,
     lat NUMERIC,
     lng NUMERIC,
-    provider panorama_provider
+    provider VARCHAR
 );
@@ -19,6 +19,8 @@ type env struct {
 	PostgresPassword string `env:"PG_PASS" env-required:"true"`
 	PostgresHost     string `env:"PG_HOST" env-required:"true"`
 	PostgresDatabase string `env:"PG_DB"   env-required:"true"`
+	// GeocoderDatasetPath is a path of the boundaries dataset for backfilling places
+	GeocoderDatasetPath string `env:"GEOCODER_DATASET_PATH" env-default:"./internal/infrastructure/geocoder/boundaries.geojson.gz"`
 }
 
 var (
@@ -36,7 +38,7 @@ Commands:
 	up                  Migrate the DB to the most recent version available
 	up-to VERSION       Migrate the DB to a specific VERSION
 	up-with-data        Migrate the DB to the most recent version available with data migrations
-	data-only           Run only data migrations
+	data-only           Run only data migrations (including countries of locations and guesses)
 	down                Roll back the version by 1
 	down-to VERSION     Roll back to the specified VERSION
 	version             Print the current version
@@ -106,6 +108,13 @@ func main() {
 			slog.Error("unable to migrate data", slog.Any("error", err))
 			return
 		}
+
+		slog.Info("resolving countries of locations and guesses")
+
+		if err := dataMigrations.BackfillPlaces(ctx, pool, parsedENV.GeocoderDatasetPath); err != nil {
+			slog.Error("unable to backfill places", slog.Any("error", err))
+			return
+		}
 	}
 }
 
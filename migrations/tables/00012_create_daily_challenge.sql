@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS daily_challenge (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    challenge_date DATE NOT NULL,
+    provider panorama_provider NOT NULL,
+    location_ids BIGINT[] NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    UNIQUE (challenge_date, provider)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS daily_challenge;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE singleplayer_game
+    ADD COLUMN daily_challenge_id BIGINT REFERENCES daily_challenge(id);
+
+-- every user can play a daily challenge only once
+CREATE UNIQUE INDEX singleplayer_game_daily_challenge_id_user_id_idx
+    ON singleplayer_game (daily_challenge_id, user_id)
+    WHERE daily_challenge_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS singleplayer_game_daily_challenge_id_user_id_idx;
+
+ALTER TABLE singleplayer_game
+    DROP COLUMN IF EXISTS daily_challenge_id;
+-- +goose StatementEnd
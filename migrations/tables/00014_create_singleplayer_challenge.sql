@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS singleplayer_challenge (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    token TEXT NOT NULL UNIQUE,
+    game_id BIGINT NOT NULL UNIQUE,
+    user_id BIGINT NOT NULL,
+    location_ids BIGINT[] NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (game_id) REFERENCES singleplayer_game(id),
+    FOREIGN KEY (user_id) REFERENCES user_info(id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS singleplayer_challenge;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE singleplayer_game
+    ADD COLUMN challenge_id BIGINT REFERENCES singleplayer_challenge(id);
+
+-- every user can accept a challenge only once
+CREATE UNIQUE INDEX singleplayer_game_challenge_id_user_id_idx
+    ON singleplayer_game (challenge_id, user_id)
+    WHERE challenge_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS singleplayer_game_challenge_id_user_id_idx;
+
+ALTER TABLE singleplayer_game
+    DROP COLUMN IF EXISTS challenge_id;
+-- +goose StatementEnd
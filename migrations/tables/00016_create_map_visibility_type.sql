@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+DO $$ BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'map_visibility') THEN
+        CREATE TYPE map_visibility AS ENUM ('public', 'unlisted', 'private');
+    END IF;
+END $$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TYPE IF EXISTS map_visibility;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS game_map_location (
+    map_id BIGINT NOT NULL,
+    location_id BIGINT NOT NULL,
+    PRIMARY KEY (map_id, location_id),
+    FOREIGN KEY (map_id) REFERENCES game_map(id),
+    FOREIGN KEY (location_id) REFERENCES panorama_location(id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS game_map_location;
+-- +goose StatementEnd
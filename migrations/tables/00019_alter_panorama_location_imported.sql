@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- locations imported by users to their maps are not used in regular games
+ALTER TABLE panorama_location
+    ADD COLUMN imported BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE panorama_location
+    DROP COLUMN IF EXISTS imported;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE singleplayer_game
+    ADD COLUMN map_id BIGINT REFERENCES game_map(id);
+
+ALTER TABLE multiplayer_game
+    ADD COLUMN map_id BIGINT REFERENCES game_map(id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE multiplayer_game
+    DROP COLUMN IF EXISTS map_id;
+
+ALTER TABLE singleplayer_game
+    DROP COLUMN IF EXISTS map_id;
+-- +goose StatementEnd
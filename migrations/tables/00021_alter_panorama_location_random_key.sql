@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- random_key is used to pick a random location with an index scan instead of sorting all locations
+ALTER TABLE panorama_location
+    ADD COLUMN random_key DOUBLE PRECISION NOT NULL DEFAULT random();
+
+CREATE INDEX panorama_location_provider_random_key_idx ON panorama_location (provider, random_key)
+    WHERE NOT imported;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS panorama_location_provider_random_key_idx;
+
+ALTER TABLE panorama_location
+    DROP COLUMN IF EXISTS random_key;
+-- +goose StatementEnd
@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+-- panorama providers are registered in the application, so adding one does not need a new enum value
+ALTER TABLE panorama_location
+    ALTER COLUMN provider TYPE VARCHAR USING provider::TEXT;
+
+ALTER TABLE singleplayer_game
+    ALTER COLUMN provider TYPE VARCHAR USING provider::TEXT;
+
+ALTER TABLE multiplayer_game
+    ALTER COLUMN provider TYPE VARCHAR USING provider::TEXT;
+
+ALTER TABLE daily_challenge
+    ALTER COLUMN provider TYPE VARCHAR USING provider::TEXT;
+
+ALTER TABLE game_map
+    ALTER COLUMN provider TYPE VARCHAR USING provider::TEXT;
+
+DROP TYPE IF EXISTS panorama_provider;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- mixed provider games and providers added after the enum was dropped have no enum value,
+-- so the migration can't be reverted while they are stored
+DO $$
+DECLARE
+    known VARCHAR[] := ARRAY['google', 'yandex', 'yandex_air', 'seznam'];
+BEGIN
+    IF EXISTS (SELECT 1 FROM panorama_location WHERE provider <> ALL (known))
+        OR EXISTS (SELECT 1 FROM singleplayer_game WHERE provider <> ALL (known))
+        OR EXISTS (SELECT 1 FROM multiplayer_game WHERE provider <> ALL (known))
+        OR EXISTS (SELECT 1 FROM daily_challenge WHERE provider <> ALL (known))
+        OR EXISTS (SELECT 1 FROM game_map WHERE provider <> ALL (known)) THEN
+        RAISE EXCEPTION 'irreversible migration: providers without panorama_provider enum value are stored';
+    END IF;
+END $$;
+
+CREATE TYPE panorama_provider AS ENUM ('google', 'yandex', 'yandex_air', 'seznam');
+
+ALTER TABLE panorama_location
+    ALTER COLUMN provider TYPE panorama_provider USING provider::panorama_provider;
+
+ALTER TABLE singleplayer_game
+    ALTER COLUMN provider TYPE panorama_provider USING provider::panorama_provider;
+
+ALTER TABLE multiplayer_game
+    ALTER COLUMN provider TYPE panorama_provider USING provider::panorama_provider;
+
+ALTER TABLE daily_challenge
+    ALTER COLUMN provider TYPE panorama_provider USING provider::panorama_provider;
+
+ALTER TABLE game_map
+    ALTER COLUMN provider TYPE panorama_provider USING provider::panorama_provider;
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+-- providers are allowed providers of mixed provider games, empty array means all providers
+ALTER TABLE singleplayer_game
+    ADD COLUMN providers VARCHAR[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE multiplayer_game
+    ADD COLUMN providers VARCHAR[] NOT NULL DEFAULT '{}';
+
+-- rounds of mixed provider games are played on different providers
+ALTER TABLE singleplayer_round
+    ADD COLUMN provider VARCHAR;
+
+UPDATE singleplayer_round AS sr
+SET provider = pl.provider
+FROM panorama_location AS pl
+WHERE pl.id = sr.location_id;
+
+ALTER TABLE singleplayer_round
+    ALTER COLUMN provider SET NOT NULL;
+
+ALTER TABLE multiplayer_round
+    ADD COLUMN provider VARCHAR;
+
+UPDATE multiplayer_round AS mr
+SET provider = pl.provider
+FROM panorama_location AS pl
+WHERE pl.id = mr.location_id;
+
+ALTER TABLE multiplayer_round
+    ALTER COLUMN provider SET NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE multiplayer_round
+    DROP COLUMN IF EXISTS provider;
+
+ALTER TABLE singleplayer_round
+    DROP COLUMN IF EXISTS provider;
+
+ALTER TABLE multiplayer_game
+    DROP COLUMN IF EXISTS providers;
+
+ALTER TABLE singleplayer_game
+    DROP COLUMN IF EXISTS providers;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- score distance is a distance in km, at which a guess receives ~60% of score,
+-- games without it (created before it was introduced) are scored by provider constants
+ALTER TABLE singleplayer_game
+    ADD COLUMN score_distance DOUBLE PRECISION;
+
+ALTER TABLE multiplayer_game
+    ADD COLUMN score_distance DOUBLE PRECISION;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE multiplayer_game
+    DROP COLUMN IF EXISTS score_distance;
+
+ALTER TABLE singleplayer_game
+    DROP COLUMN IF EXISTS score_distance;
+-- +goose StatementEnd
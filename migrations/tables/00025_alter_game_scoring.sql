@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE singleplayer_game
+    ADD COLUMN scoring VARCHAR NOT NULL DEFAULT 'classic';
+
+ALTER TABLE multiplayer_game
+    ADD COLUMN scoring VARCHAR NOT NULL DEFAULT 'classic';
+
+-- score of a guess is its base score for distance plus bonus minus penalty
+ALTER TABLE singleplayer_round_guess
+    ADD COLUMN score_bonus BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN score_penalty BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE multiplayer_round_user
+    ADD COLUMN score_bonus BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN score_penalty BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE multiplayer_round_user
+    DROP COLUMN IF EXISTS score_penalty,
+    DROP COLUMN IF EXISTS score_bonus;
+
+ALTER TABLE singleplayer_round_guess
+    DROP COLUMN IF EXISTS score_penalty,
+    DROP COLUMN IF EXISTS score_bonus;
+
+ALTER TABLE multiplayer_game
+    DROP COLUMN IF EXISTS scoring;
+
+ALTER TABLE singleplayer_game
+    DROP COLUMN IF EXISTS scoring;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- codes are resolved by offline reverse geocoding, NULL for locations outside of known boundaries
+-- and for locations, which were not processed by data migration yet
+ALTER TABLE panorama_location
+    ADD COLUMN country_code VARCHAR(2),
+    ADD COLUMN region_code VARCHAR;
+
+CREATE INDEX panorama_location_country_code_idx ON panorama_location (country_code);
+
+ALTER TABLE singleplayer_round_guess
+    ADD COLUMN country_code VARCHAR(2);
+
+ALTER TABLE multiplayer_round_user
+    ADD COLUMN country_code VARCHAR(2);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE multiplayer_round_user
+    DROP COLUMN IF EXISTS country_code;
+
+ALTER TABLE singleplayer_round_guess
+    DROP COLUMN IF EXISTS country_code;
+
+DROP INDEX IF EXISTS panorama_location_country_code_idx;
+
+ALTER TABLE panorama_location
+    DROP COLUMN IF EXISTS region_code,
+    DROP COLUMN IF EXISTS country_code;
+-- +goose StatementEnd
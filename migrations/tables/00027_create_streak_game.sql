@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS streak_game (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    user_id BIGINT NOT NULL,
+    provider VARCHAR NOT NULL,
+    providers VARCHAR[] NOT NULL DEFAULT '{}',
+    movement_allowed BOOLEAN NOT NULL,
+    timer_seconds BIGINT NOT NULL,
+    -- amount of correctly guessed rounds in a row, the game finishes on the first miss
+    streak BIGINT NOT NULL DEFAULT 0,
+    finished BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL,
+    ended_at TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES user_info(id)
+);
+
+CREATE INDEX streak_game_user_id_created_at_idx ON streak_game (user_id, created_at DESC);
+CREATE INDEX streak_game_user_id_streak_idx ON streak_game (user_id, streak DESC) WHERE finished;
+
+CREATE TABLE IF NOT EXISTS streak_round (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    game_id BIGINT NOT NULL,
+    location_id BIGINT NOT NULL,
+    provider VARCHAR NOT NULL,
+    round_num BIGINT NOT NULL,
+    -- country of the location, resolved when the round is created
+    country_code VARCHAR(2) NOT NULL,
+    -- guessed country, NULL if a clicked point was outside of known countries
+    guess_country_code VARCHAR(2),
+    correct BOOLEAN NOT NULL DEFAULT FALSE,
+    finished BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL,
+    started_at TIMESTAMP NOT NULL,
+    ended_at TIMESTAMP,
+    FOREIGN KEY (game_id) REFERENCES streak_game(id),
+    FOREIGN KEY (location_id) REFERENCES panorama_location(id),
+    UNIQUE (game_id, round_num)
+);
+
+CREATE INDEX streak_round_location_id_idx ON streak_round (location_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS streak_round;
+DROP TABLE IF EXISTS streak_game;
+-- +goose StatementEnd
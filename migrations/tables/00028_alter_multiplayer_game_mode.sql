@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE multiplayer_game
+    ADD COLUMN mode VARCHAR NOT NULL DEFAULT 'classic';
+
+-- players of battle royale games are eliminated in some round, NULL while the player is still in game
+ALTER TABLE multiplayer_game_user
+    ADD COLUMN eliminated_round BIGINT,
+    ADD COLUMN eliminated_at TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE multiplayer_game_user
+    DROP COLUMN IF EXISTS eliminated_at,
+    DROP COLUMN IF EXISTS eliminated_round;
+
+ALTER TABLE multiplayer_game
+    DROP COLUMN IF EXISTS mode;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- health players of duel games start with, NULL for games of other modes
+ALTER TABLE multiplayer_game
+    ADD COLUMN health BIGINT;
+
+-- remaining health of duel players
+ALTER TABLE multiplayer_game_user
+    ADD COLUMN health BIGINT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE multiplayer_game_user
+    DROP COLUMN IF EXISTS health;
+
+ALTER TABLE multiplayer_game
+    DROP COLUMN IF EXISTS health;
+-- +goose StatementEnd
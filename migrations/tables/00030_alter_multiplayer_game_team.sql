@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- how scores of team members are combined into a team score, NULL for games without teams
+ALTER TABLE multiplayer_game
+    ADD COLUMN team_scoring VARCHAR;
+
+-- team of the player, NULL for games without teams
+ALTER TABLE multiplayer_game_user
+    ADD COLUMN team BIGINT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE multiplayer_game_user
+    DROP COLUMN IF EXISTS team;
+
+ALTER TABLE multiplayer_game
+    DROP COLUMN IF EXISTS team_scoring;
+-- +goose StatementEnd
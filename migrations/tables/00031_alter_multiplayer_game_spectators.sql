@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- whether users outside of the game can watch it
+ALTER TABLE multiplayer_game
+    ADD COLUMN spectators BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- delay of round results sent to spectators (to prevent ghosting)
+ALTER TABLE multiplayer_game
+    ADD COLUMN spectator_delay_seconds BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE multiplayer_game
+    DROP COLUMN IF EXISTS spectator_delay_seconds;
+
+ALTER TABLE multiplayer_game
+    DROP COLUMN IF EXISTS spectators;
+-- +goose StatementEnd
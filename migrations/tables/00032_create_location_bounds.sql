@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+-- bounding boxes of (not imported) locations of providers, used for score distance of new games,
+-- refreshed by data migrations, which are the only way to add such locations
+CREATE TABLE IF NOT EXISTS panorama_provider_bounds (
+    provider VARCHAR PRIMARY KEY,
+    min_lat FLOAT NOT NULL,
+    min_lng FLOAT NOT NULL,
+    max_lat FLOAT NOT NULL,
+    max_lng FLOAT NOT NULL
+);
+
+INSERT INTO panorama_provider_bounds (provider, min_lat, min_lng, max_lat, max_lng)
+SELECT provider, MIN(lat), MIN(lng), MAX(lat), MAX(lng)
+FROM panorama_location
+WHERE NOT imported
+GROUP BY provider;
+
+-- bounding box of map locations, refreshed when locations are added to the map, NULL for empty maps
+ALTER TABLE game_map
+    ADD COLUMN min_lat FLOAT,
+    ADD COLUMN min_lng FLOAT,
+    ADD COLUMN max_lat FLOAT,
+    ADD COLUMN max_lng FLOAT;
+
+UPDATE game_map AS gm
+SET
+    min_lat = b.min_lat,
+    min_lng = b.min_lng,
+    max_lat = b.max_lat,
+    max_lng = b.max_lng
+FROM (
+    SELECT gml.map_id, MIN(pl.lat) AS min_lat, MIN(pl.lng) AS min_lng, MAX(pl.lat) AS max_lat, MAX(pl.lng) AS max_lng
+    FROM game_map_location AS gml
+    JOIN panorama_location AS pl
+        ON pl.id = gml.location_id
+    GROUP BY gml.map_id
+) AS b
+WHERE gm.id = b.map_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE game_map
+    DROP COLUMN IF EXISTS max_lng,
+    DROP COLUMN IF EXISTS max_lat,
+    DROP COLUMN IF EXISTS min_lng,
+    DROP COLUMN IF EXISTS min_lat;
+
+DROP TABLE IF EXISTS panorama_provider_bounds;
+-- +goose StatementEnd